@@ -159,6 +159,11 @@ const (
 
 	MgoStatsEnabled = "MGO_STATS_ENABLED"
 
+	// MgoTxnSlowLogThreshold, if set to a valid time.Duration string,
+	// enables logging of mgo/txn transactions that take at least that
+	// long to run.
+	MgoTxnSlowLogThreshold = "MGO_TXN_SLOW_LOG_THRESHOLD"
+
 	// LoggingOverride will set the logging for this agent to the value
 	// specified. Model configuration will be ignored and this value takes
 	// precidence for the agent.
@@ -357,6 +362,12 @@ type ConfigWriter interface {
 
 	// Write writes the agent configuration.
 	Write() error
+
+	// WriteUpdate persists the difference between the given previous
+	// rendering and the current configuration as a versioned delta,
+	// falling back to a full write when a delta cannot be recorded
+	// safely.
+	WriteUpdate(previous []byte) error
 }
 
 type ConfigSetter interface {
@@ -561,6 +572,11 @@ func ReadConfig(configFilePath string) (ConfigSetterWriter, error) {
 	if err != nil {
 		return nil, errors.Annotatef(err, "cannot read agent config %q", configFilePath)
 	}
+	// Replay any delta updates recorded alongside the config file.
+	configData, err = applyConfigDeltas(configFilePath, configData)
+	if err != nil {
+		return nil, errors.Annotatef(err, "cannot read agent config %q", configFilePath)
+	}
 	format, config, err = parseConfigData(configData)
 	if err != nil {
 		return nil, err
@@ -665,7 +681,17 @@ func (c *configInternal) Write() error {
 	if err := os.MkdirAll(configDir, 0755); err != nil {
 		return fmt.Errorf("cannot create agent config dir %q: %v", configDir, err)
 	}
-	return utils.AtomicWriteFile(c.configFilePath, data, 0600)
+	if err := utils.AtomicWriteFile(c.configFilePath, data, 0600); err != nil {
+		return err
+	}
+	// The full rendering now holds everything, so any deltas recorded
+	// against the previous rendering are stale. If the removal is
+	// interrupted the leftover file is ignored on read, as its base
+	// checksum no longer matches.
+	if err := os.Remove(configDeltaPath(c.configFilePath)); err != nil && !os.IsNotExist(err) {
+		return errors.Trace(err)
+	}
+	return nil
 }
 
 func requiredError(what string) error {
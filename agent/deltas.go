@@ -0,0 +1,267 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package agent
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"sort"
+
+	"github.com/juju/errors"
+	"github.com/juju/utils/v2"
+	goyaml "gopkg.in/yaml.v2"
+)
+
+// This file implements the agent config delta update protocol. Small
+// frequent updates - a controller address change fans out to every
+// agent in a model - used to rewrite the whole agent.conf each time.
+// Updates made through WriteUpdate instead persist only the changed
+// keys, as versioned deltas in a sidecar file next to agent.conf,
+// which ReadConfig replays over the base file. The delta file records
+// the checksum of the base rendering it applies to, so deltas left
+// behind by an interrupted compaction are recognised as stale and
+// ignored rather than replayed onto the wrong base. Whenever a delta
+// cannot be recorded safely, or enough have accumulated, the update
+// falls back to a full write, which compacts everything back into
+// agent.conf.
+
+const (
+	// deltaFormatVersion is the version of the delta file layout.
+	// Files with any other version are not understood, and a full
+	// write is used instead.
+	deltaFormatVersion = 1
+
+	// maxConfigDeltas bounds how many deltas may accumulate against
+	// the base file before an update compacts them into a full write,
+	// limiting both the file growth and the replay cost on read.
+	maxConfigDeltas = 10
+)
+
+// configDelta holds one versioned set of key changes.
+type configDelta struct {
+	Version int                    `yaml:"version"`
+	Changed map[string]interface{} `yaml:"changed,omitempty"`
+	Removed []string               `yaml:"removed,omitempty"`
+}
+
+// configDeltaFile is the on-disk shape of the delta sidecar file.
+type configDeltaFile struct {
+	Format       int           `yaml:"format"`
+	BaseChecksum string        `yaml:"base-checksum"`
+	Deltas       []configDelta `yaml:"deltas"`
+}
+
+// configDeltaPath returns the sidecar file holding the deltas for the
+// given agent config file.
+func configDeltaPath(configFilePath string) string {
+	return configFilePath + ".deltas"
+}
+
+// renderingChecksum identifies a config rendering, so stale deltas can
+// be told apart from ones recorded against the current base file.
+func renderingChecksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// splitRendering splits a rendered config into its leading format line
+// and the yaml document body.
+func splitRendering(data []byte) (string, []byte, error) {
+	i := bytes.IndexByte(data, '\n')
+	if i == -1 {
+		return "", nil, errors.Errorf("invalid agent config format: %s", string(data))
+	}
+	return string(data[:i]), data[i+1:], nil
+}
+
+// renderingAsMap parses a rendered config into its format line and the
+// yaml document as a generic map.
+func renderingAsMap(data []byte) (string, map[interface{}]interface{}, error) {
+	formatLine, body, err := splitRendering(data)
+	if err != nil {
+		return "", nil, errors.Trace(err)
+	}
+	var doc map[interface{}]interface{}
+	if err := goyaml.Unmarshal(body, &doc); err != nil {
+		return "", nil, errors.Trace(err)
+	}
+	return formatLine, doc, nil
+}
+
+// renderingsEquivalent reports whether two renderings describe the
+// same configuration. Replaying deltas marshals the document from a
+// map, so key order may legitimately differ from a struct rendering.
+func renderingsEquivalent(a, b []byte) (bool, error) {
+	aFormat, aDoc, err := renderingAsMap(a)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	bFormat, bDoc, err := renderingAsMap(b)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	return aFormat == bFormat && reflect.DeepEqual(aDoc, bDoc), nil
+}
+
+// computeConfigDelta returns the delta that turns the previous
+// rendering into the current one.
+func computeConfigDelta(previous, current []byte) (configDelta, error) {
+	var delta configDelta
+	prevFormat, prevDoc, err := renderingAsMap(previous)
+	if err != nil {
+		return delta, errors.Trace(err)
+	}
+	curFormat, curDoc, err := renderingAsMap(current)
+	if err != nil {
+		return delta, errors.Trace(err)
+	}
+	if prevFormat != curFormat {
+		// A format upgrade changes the whole document shape; that is
+		// full write territory.
+		return delta, errors.Errorf("agent config format changed from %q to %q", prevFormat, curFormat)
+	}
+	delta.Changed = make(map[string]interface{})
+	for key, value := range curDoc {
+		name, ok := key.(string)
+		if !ok {
+			return delta, errors.Errorf("non-string key %v in agent config", key)
+		}
+		if previous, found := prevDoc[key]; !found || !reflect.DeepEqual(previous, value) {
+			delta.Changed[name] = value
+		}
+	}
+	for key := range prevDoc {
+		if _, found := curDoc[key]; !found {
+			delta.Removed = append(delta.Removed, fmt.Sprint(key))
+		}
+	}
+	sort.Strings(delta.Removed)
+	return delta, nil
+}
+
+// readConfigDeltaFile reads the delta sidecar file for the given
+// config file, reporting whether one exists.
+func readConfigDeltaFile(configFilePath string) (configDeltaFile, bool, error) {
+	var file configDeltaFile
+	data, err := ioutil.ReadFile(configDeltaPath(configFilePath))
+	if os.IsNotExist(err) {
+		return file, false, nil
+	}
+	if err != nil {
+		return file, false, errors.Trace(err)
+	}
+	if err := goyaml.Unmarshal(data, &file); err != nil {
+		return file, false, errors.Annotate(err, "parsing agent config deltas")
+	}
+	if file.Format != deltaFormatVersion {
+		return file, false, errors.Errorf("unsupported agent config delta format %d", file.Format)
+	}
+	return file, true, nil
+}
+
+// applyConfigDeltas replays any recorded deltas over the given base
+// rendering, returning the effective rendering. Deltas whose base
+// checksum does not match are stale - a full write has superseded
+// them - and are ignored.
+func applyConfigDeltas(configFilePath string, base []byte) ([]byte, error) {
+	file, found, err := readConfigDeltaFile(configFilePath)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !found {
+		return base, nil
+	}
+	if file.BaseChecksum != renderingChecksum(base) {
+		logger.Debugf("ignoring stale config deltas for %q", configFilePath)
+		return base, nil
+	}
+	formatLine, doc, err := renderingAsMap(base)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	lastVersion := 0
+	for _, delta := range file.Deltas {
+		if delta.Version <= lastVersion {
+			return nil, errors.Errorf("agent config delta versions out of order")
+		}
+		lastVersion = delta.Version
+		for key, value := range delta.Changed {
+			doc[key] = value
+		}
+		for _, key := range delta.Removed {
+			delete(doc, key)
+		}
+	}
+	body, err := goyaml.Marshal(doc)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return append([]byte(formatLine+"\n"), body...), nil
+}
+
+// readEffectiveConfig returns the base rendering of the config file
+// and the effective rendering with any deltas applied.
+func readEffectiveConfig(configFilePath string) (base, effective []byte, err error) {
+	base, err = ioutil.ReadFile(configFilePath)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	effective, err = applyConfigDeltas(configFilePath, base)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	return base, effective, nil
+}
+
+// WriteUpdate implements ConfigWriter. It persists the difference
+// between the given previous rendering and the current configuration
+// as a versioned delta, and falls back to a full write whenever a
+// delta cannot be recorded safely: the previous rendering does not
+// match what is on disk, the delta file is unreadable, the config
+// format changed, or enough deltas have accumulated that compaction
+// is due.
+func (c *configInternal) WriteUpdate(previous []byte) error {
+	current, err := c.Render()
+	if err != nil {
+		return err
+	}
+	base, effective, err := readEffectiveConfig(c.configFilePath)
+	if err != nil {
+		return c.Write()
+	}
+	if same, err := renderingsEquivalent(effective, previous); err != nil || !same {
+		// The caller's view of the config has diverged from disk;
+		// a delta recorded now could replay incorrectly.
+		return c.Write()
+	}
+	delta, err := computeConfigDelta(previous, current)
+	if err != nil {
+		return c.Write()
+	}
+	file, found, err := readConfigDeltaFile(c.configFilePath)
+	if !found || err != nil || file.BaseChecksum != renderingChecksum(base) {
+		file = configDeltaFile{
+			Format:       deltaFormatVersion,
+			BaseChecksum: renderingChecksum(base),
+		}
+	}
+	delta.Version = 1
+	if n := len(file.Deltas); n > 0 {
+		delta.Version = file.Deltas[n-1].Version + 1
+	}
+	file.Deltas = append(file.Deltas, delta)
+	if len(file.Deltas) > maxConfigDeltas {
+		return c.Write()
+	}
+	data, err := goyaml.Marshal(file)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return utils.AtomicWriteFile(configDeltaPath(c.configFilePath), data, 0600)
+}
@@ -0,0 +1,166 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package agent
+
+import (
+	"io/ioutil"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	goyaml "gopkg.in/yaml.v2"
+
+	"github.com/juju/juju/testing"
+)
+
+type deltasSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&deltasSuite{})
+
+// writtenTestConfig returns a config that has already been fully
+// written to disk, along with its rendering and config file path.
+func writtenTestConfig(c *gc.C) (*configInternal, []byte, string) {
+	config := newTestConfig(c)
+	err := config.Write()
+	c.Assert(err, jc.ErrorIsNil)
+	rendering, err := config.Render()
+	c.Assert(err, jc.ErrorIsNil)
+	return config, rendering, ConfigPath(config.DataDir(), config.Tag())
+}
+
+func readDeltaFile(c *gc.C, configPath string) configDeltaFile {
+	data, err := ioutil.ReadFile(configDeltaPath(configPath))
+	c.Assert(err, jc.ErrorIsNil)
+	var file configDeltaFile
+	err = goyaml.Unmarshal(data, &file)
+	c.Assert(err, jc.ErrorIsNil)
+	return file
+}
+
+func (*deltasSuite) TestWriteUpdateRecordsDelta(c *gc.C) {
+	config, previous, configPath := writtenTestConfig(c)
+	base, err := ioutil.ReadFile(configPath)
+	c.Assert(err, jc.ErrorIsNil)
+
+	config.SetValue("foo", "bar")
+	err = config.WriteUpdate(previous)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The base file is untouched; only the sidecar records the change.
+	after, err := ioutil.ReadFile(configPath)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(after, jc.DeepEquals, base)
+
+	file := readDeltaFile(c, configPath)
+	c.Assert(file.Format, gc.Equals, deltaFormatVersion)
+	c.Assert(file.BaseChecksum, gc.Equals, renderingChecksum(base))
+	c.Assert(file.Deltas, gc.HasLen, 1)
+	c.Assert(file.Deltas[0].Version, gc.Equals, 1)
+
+	readConfig, err := ReadConfig(configPath)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(readConfig, jc.DeepEquals, config)
+}
+
+func (*deltasSuite) TestWriteUpdateChainsDeltas(c *gc.C) {
+	config, previous, configPath := writtenTestConfig(c)
+
+	config.SetValue("foo", "bar")
+	err := config.WriteUpdate(previous)
+	c.Assert(err, jc.ErrorIsNil)
+
+	previous, err = config.Render()
+	c.Assert(err, jc.ErrorIsNil)
+	config.SetValue("foo", "")
+	config.SetValue("wibble", "wobble")
+	err = config.WriteUpdate(previous)
+	c.Assert(err, jc.ErrorIsNil)
+
+	file := readDeltaFile(c, configPath)
+	c.Assert(file.Deltas, gc.HasLen, 2)
+	c.Assert(file.Deltas[1].Version, gc.Equals, 2)
+
+	readConfig, err := ReadConfig(configPath)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(readConfig, jc.DeepEquals, config)
+	c.Assert(readConfig.Value("foo"), gc.Equals, "")
+	c.Assert(readConfig.Value("wibble"), gc.Equals, "wobble")
+}
+
+func (*deltasSuite) TestFullWriteRemovesDeltas(c *gc.C) {
+	config, previous, configPath := writtenTestConfig(c)
+
+	config.SetValue("foo", "bar")
+	err := config.WriteUpdate(previous)
+	c.Assert(err, jc.ErrorIsNil)
+	assertFileExists(c, configDeltaPath(configPath))
+
+	err = config.Write()
+	c.Assert(err, jc.ErrorIsNil)
+	assertFileNotExist(c, configDeltaPath(configPath))
+
+	readConfig, err := ReadConfig(configPath)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(readConfig, jc.DeepEquals, config)
+}
+
+func (*deltasSuite) TestWriteUpdateCompacts(c *gc.C) {
+	config, _, configPath := writtenTestConfig(c)
+
+	// Accumulate updates past the limit; the last one compacts
+	// everything back into the base file.
+	for i := 0; i <= maxConfigDeltas; i++ {
+		previous, err := config.Render()
+		c.Assert(err, jc.ErrorIsNil)
+		config.SetValue("counter", string(rune('a'+i)))
+		err = config.WriteUpdate(previous)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+	assertFileNotExist(c, configDeltaPath(configPath))
+
+	readConfig, err := ReadConfig(configPath)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(readConfig, jc.DeepEquals, config)
+}
+
+func (*deltasSuite) TestWriteUpdateDivergedPreviousFallsBack(c *gc.C) {
+	config, _, configPath := writtenTestConfig(c)
+
+	config.SetValue("foo", "bar")
+	err := config.WriteUpdate([]byte("# format 2.0\nsomething: else\n"))
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The previous rendering did not match the disk state, so the
+	// update became a full write and no delta was recorded.
+	assertFileNotExist(c, configDeltaPath(configPath))
+
+	readConfig, err := ReadConfig(configPath)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(readConfig, jc.DeepEquals, config)
+}
+
+func (*deltasSuite) TestStaleDeltasIgnored(c *gc.C) {
+	config, _, configPath := writtenTestConfig(c)
+
+	// A sidecar recorded against some other base - say, left behind by
+	// an interrupted compaction - must not be replayed.
+	stale := configDeltaFile{
+		Format:       deltaFormatVersion,
+		BaseChecksum: "not the checksum of the base file",
+		Deltas: []configDelta{{
+			Version: 1,
+			Changed: map[string]interface{}{"values": map[string]string{"foo": "bar"}},
+		}},
+	}
+	data, err := goyaml.Marshal(stale)
+	c.Assert(err, jc.ErrorIsNil)
+	err = ioutil.WriteFile(configDeltaPath(configPath), data, 0600)
+	c.Assert(err, jc.ErrorIsNil)
+
+	readConfig, err := ReadConfig(configPath)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(readConfig, jc.DeepEquals, config)
+	c.Assert(readConfig.Value("foo"), gc.Equals, "")
+}
@@ -4,8 +4,6 @@
 package action
 
 import (
-	"time"
-
 	"github.com/juju/errors"
 
 	"github.com/juju/juju/apiserver/params"
@@ -13,9 +11,14 @@ import (
 
 // RunOnAllMachines runs the command on all the machines with the specified
 // timeout.
-func (c *Client) RunOnAllMachines(commands string, timeout time.Duration) (EnqueuedActions, error) {
+func (c *Client) RunOnAllMachines(run RunParams) (EnqueuedActions, error) {
 	var results params.EnqueuedActions
-	args := params.RunParams{Commands: commands, Timeout: timeout}
+	args := params.RunParams{
+		Commands:     run.Commands,
+		Timeout:      run.Timeout,
+		OutputLimit:  run.OutputLimit,
+		CaptureFiles: run.CaptureFiles,
+	}
 	err := c.facade.FacadeCall("RunOnAllMachines", args, &results)
 	if err != nil {
 		return EnqueuedActions{}, errors.Trace(err)
@@ -33,6 +36,8 @@ func (c *Client) Run(run RunParams) (EnqueuedActions, error) {
 		Applications:    run.Applications,
 		Units:           run.Units,
 		WorkloadContext: run.WorkloadContext,
+		OutputLimit:     run.OutputLimit,
+		CaptureFiles:    run.CaptureFiles,
 	}
 	var results params.EnqueuedActions
 	err := c.facade.FacadeCall("Run", args, &results)
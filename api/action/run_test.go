@@ -46,7 +46,7 @@ func (s *actionSuite) TestRunOnAllMachines(c *gc.C) {
 		BestVersion: 6,
 	}
 	client := action.NewClient(apiCaller)
-	result, err := client.RunOnAllMachines("pwd", time.Millisecond)
+	result, err := client.RunOnAllMachines(action.RunParams{Commands: "pwd", Timeout: time.Millisecond})
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(result, jc.DeepEquals, action.EnqueuedActions{
 		OperationID: "1",
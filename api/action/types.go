@@ -100,6 +100,14 @@ type RunParams struct {
 	// WorkloadContext for CAAS is true when the Commands should be run on
 	// the workload not the operator.
 	WorkloadContext bool
+
+	// OutputLimit is the maximum number of bytes of stdout and stderr
+	// to record for each target, 0 meaning no limit.
+	OutputLimit int
+
+	// CaptureFiles lists files to capture from each target once the
+	// commands complete.
+	CaptureFiles []string
 }
 
 func unmarshallEnqueuedActions(in params.EnqueuedActions) (EnqueuedActions, error) {
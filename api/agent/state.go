@@ -12,11 +12,13 @@ import (
 	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/api/common"
 	"github.com/juju/juju/api/common/cloudspec"
+	apiwatcher "github.com/juju/juju/api/watcher"
 	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/watcher"
 )
 
 // State provides access to an agent's view of the state.
@@ -79,6 +81,77 @@ func (st *State) StateServingInfo() (controller.StateServingInfo, error) {
 	}, nil
 }
 
+// RecordHealth reports the worker health of the agent with the given
+// tag. It returns an error satisfying errors.IsNotSupported if the
+// controller is too old to record agent health.
+func (st *State) RecordHealth(tag names.Tag, workers []params.WorkerHealth) error {
+	if st.facade.BestAPIVersion() < 4 {
+		return errors.NotSupportedf("agent health on server")
+	}
+	var results params.ErrorResults
+	args := params.AgentHealthArgs{
+		Args: []params.AgentHealthArg{{Tag: tag.String(), Workers: workers}},
+	}
+	err := st.facade.FacadeCall("RecordHealth", args, &results)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// DisabledWorkers returns the names of the agent workers that have been
+// administratively disabled for the machine with the given tag,
+// including any disabled model-wide. It returns an error satisfying
+// errors.IsNotSupported if the controller is too old to disable
+// workers.
+func (st *State) DisabledWorkers(tag names.Tag) ([]string, error) {
+	if st.facade.BestAPIVersion() < 4 {
+		return nil, errors.NotSupportedf("disabled workers on server")
+	}
+	var results params.StringsResults
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: tag.String()}},
+	}
+	err := st.facade.FacadeCall("DisabledWorkers", args, &results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return result.Result, nil
+}
+
+// WatchDisabledWorkers returns a watcher that notifies when the set of
+// disabled workers affecting the machine with the given tag changes. It
+// returns an error satisfying errors.IsNotSupported if the controller
+// is too old to disable workers.
+func (st *State) WatchDisabledWorkers(tag names.Tag) (watcher.NotifyWatcher, error) {
+	if st.facade.BestAPIVersion() < 4 {
+		return nil, errors.NotSupportedf("disabled workers on server")
+	}
+	var results params.NotifyWatchResults
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: tag.String()}},
+	}
+	err := st.facade.FacadeCall("WatchDisabledWorkers", args, &results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return apiwatcher.NewNotifyWatcher(st.facade.RawAPICaller(), result), nil
+}
+
 // IsMaster reports whether the connected machine
 // agent lives at the same network address as the primary
 // mongo server for the replica set.
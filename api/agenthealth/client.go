@@ -0,0 +1,44 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package agenthealth
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/apiserver/params"
+)
+
+// Client allows access to the agent health API end point.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the agent health API.
+func NewClient(st base.APICallCloser) *Client {
+	frontend, backend := base.NewClientFacade(st, "AgentHealth")
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// AgentHealth returns the most recent self-reported worker health for
+// the agent with the given tag.
+func (c *Client) AgentHealth(tag names.Tag) (params.AgentHealthResult, error) {
+	var results params.AgentHealthResults
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: tag.String()}},
+	}
+	if err := c.facade.FacadeCall("AgentHealth", args, &results); err != nil {
+		return params.AgentHealthResult{}, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return params.AgentHealthResult{}, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return params.AgentHealthResult{}, result.Error
+	}
+	return result, nil
+}
@@ -0,0 +1,33 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package agentpresence
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/apiserver/params"
+)
+
+// Client allows access to the agent presence API end point.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the agent presence API.
+func NewClient(st base.APICallCloser) *Client {
+	frontend, backend := base.NewClientFacade(st, "AgentPresence")
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// AgentPresence returns the connection details that the API server holds
+// for the agents of the current model.
+func (c *Client) AgentPresence() ([]params.AgentPresenceConnection, error) {
+	var result params.AgentPresenceResult
+	if err := c.facade.FacadeCall("AgentPresence", nil, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return result.Connections, nil
+}
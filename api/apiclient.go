@@ -431,6 +431,9 @@ func (st *state) connectStream(path string, attrs url.Values, extraHeaders http.
 		// fragmentation, we default to largeish frames.
 		ReadBufferSize:  websocketFrameSize,
 		WriteBufferSize: websocketFrameSize,
+		// Offer permessage-deflate; compression is only used if the
+		// server is configured to accept it.
+		EnableCompression: true,
 	}
 	var requestHeader http.Header
 	if st.tag != "" {
@@ -728,6 +731,9 @@ func gorillaDialWebsocket(ctx context.Context, urlStr string, tlsConfig *tls.Con
 		// fragmentation, we default to largeish frames.
 		ReadBufferSize:  websocketFrameSize,
 		WriteBufferSize: websocketFrameSize,
+		// Offer permessage-deflate; compression is only used if the
+		// server is configured to accept it.
+		EnableCompression: true,
 	}
 	// Note: no extra headers.
 	c, resp, err := dialer.Dial(urlStr, nil)
@@ -809,6 +809,126 @@ func (c *Client) SetConfig(branchName, application, configYAML string, config ma
 	return results.OneError()
 }
 
+// StageConfig records charm configuration changes on an application as a
+// staged changeset without applying them.
+func (c *Client) StageConfig(application, configYAML string, config map[string]string) error {
+	if c.BestAPIVersion() < 15 {
+		return errors.NotSupportedf("staged config changes by this version of Juju")
+	}
+	args := params.ConfigSetArgs{
+		Args: []params.ConfigSet{{
+			ApplicationName: application,
+			Config:          config,
+			ConfigYAML:      configYAML,
+		}},
+	}
+	var results params.ErrorResults
+	err := c.facade.FacadeCall("StageConfig", args, &results)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// StagedConfig returns the application's staged changeset together with a
+// preview of the effective config and the hooks that applying it would
+// run.
+func (c *Client) StagedConfig(application string) (*params.StagedConfigResult, error) {
+	if c.BestAPIVersion() < 15 {
+		return nil, errors.NotSupportedf("staged config changes by this version of Juju")
+	}
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: names.NewApplicationTag(application).String()}},
+	}
+	var results params.StagedConfigResults
+	err := c.facade.FacadeCall("StagedConfig", args, &results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &result, nil
+}
+
+// ApplyStagedConfig applies the application's staged changeset to its
+// charm config and discards it.
+func (c *Client) ApplyStagedConfig(application string) error {
+	return c.stagedConfigOp("ApplyStagedConfig", application)
+}
+
+// DiscardStagedConfig removes the application's staged changeset without
+// applying it.
+func (c *Client) DiscardStagedConfig(application string) error {
+	return c.stagedConfigOp("DiscardStagedConfig", application)
+}
+
+func (c *Client) stagedConfigOp(method, application string) error {
+	if c.BestAPIVersion() < 15 {
+		return errors.NotSupportedf("staged config changes by this version of Juju")
+	}
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: names.NewApplicationTag(application).String()}},
+	}
+	var results params.ErrorResults
+	err := c.facade.FacadeCall(method, args, &results)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// ConfigHistory returns the application's recorded config changes,
+// newest first. A limit of zero returns the full retained history.
+func (c *Client) ConfigHistory(application string, limit int) ([]params.ConfigHistoryEntry, error) {
+	if c.BestAPIVersion() < 15 {
+		return nil, errors.NotSupportedf("config history by this version of Juju")
+	}
+	args := params.ConfigHistoryArgs{
+		Args: []params.ConfigHistoryArg{{
+			ApplicationTag: names.NewApplicationTag(application).String(),
+			Limit:          limit,
+		}},
+	}
+	var results params.ConfigHistoryResults
+	err := c.facade.FacadeCall("ConfigHistory", args, &results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return result.Entries, nil
+}
+
+// RollbackConfig reverts the application config change recorded as the
+// given revision.
+func (c *Client) RollbackConfig(application string, revision int) error {
+	if c.BestAPIVersion() < 15 {
+		return errors.NotSupportedf("config rollback by this version of Juju")
+	}
+	args := params.ConfigRollbackArgs{
+		Args: []params.ConfigRollback{{
+			ApplicationTag: names.NewApplicationTag(application).String(),
+			Revision:       revision,
+		}},
+	}
+	var results params.ErrorResults
+	err := c.facade.FacadeCall("RollbackConfig", args, &results)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
 // UnsetApplicationConfig resets configuration options on an application.
 func (c *Client) UnsetApplicationConfig(branchName, application string, options []string) error {
 	args := params.ApplicationConfigUnsetArgs{
@@ -858,6 +978,75 @@ func (c *Client) ResolveUnitErrors(units []string, all, retry bool) error {
 	return errors.Trace(results.Combine())
 }
 
+// ResolveUnitErrorsBySelector clears errors on all errored units of
+// the named application, or of every application if appName is empty,
+// returning a result for each matched unit.
+func (c *Client) ResolveUnitErrorsBySelector(appName string, retry bool) ([]params.BulkUnitResult, error) {
+	if c.BestAPIVersion() < 16 {
+		return nil, errors.NotSupportedf("resolving unit errors by selector")
+	}
+	if appName != "" && !names.IsValidApplication(appName) {
+		return nil, errors.NotValidf("application name %q", appName)
+	}
+	args := params.UnitErrorSelectors{
+		Selectors: []params.UnitErrorSelector{{Application: appName}},
+		Retry:     retry,
+	}
+	var results params.BulkUnitResults
+	if err := c.facade.FacadeCall("ResolveUnitErrorsBySelector", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return results.Results, nil
+}
+
+// SyncCharmFiles pushes changed local charm files to the named
+// application's units without a full charm upgrade, as part of
+// `juju refresh --path --watch`.
+func (c *Client) SyncCharmFiles(appName string, files []params.CharmSyncFile) error {
+	if c.BestAPIVersion() < 17 {
+		return errors.NotSupportedf("live charm file sync by this version of Juju")
+	}
+	if !names.IsValidApplication(appName) {
+		return errors.NotValidf("application name %q", appName)
+	}
+	args := params.ApplicationCharmSync{
+		ApplicationName: appName,
+		Files:           files,
+	}
+	return c.facade.FacadeCall("SyncCharmFiles", args, nil)
+}
+
+// EffectiveConstraints previews the constraints that would take effect
+// once the supplied constraints are merged with the model constraints.
+// If an application name is given and the constraints are empty, the
+// application's current constraints are used as the proposal.
+func (c *Client) EffectiveConstraints(appName string, cons constraints.Value) (constraints.Value, error) {
+	if c.BestAPIVersion() < 17 {
+		return constraints.Value{}, errors.NotSupportedf("effective constraints preview by this version of Juju")
+	}
+	if appName != "" && !names.IsValidApplication(appName) {
+		return constraints.Value{}, errors.NotValidf("application name %q", appName)
+	}
+	args := params.EffectiveConstraintsArgs{
+		Args: []params.EffectiveConstraintsArg{{
+			ApplicationName: appName,
+			Constraints:     cons,
+		}},
+	}
+	var results params.EffectiveConstraintsResults
+	if err := c.facade.FacadeCall("EffectiveConstraints", args, &results); err != nil {
+		return constraints.Value{}, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return constraints.Value{}, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return constraints.Value{}, result.Error
+	}
+	return result.Constraints, nil
+}
+
 func validateApplicationScale(scale, scaleChange int) error {
 	if scale < 0 && scaleChange == 0 {
 		return errors.NotValidf("scale < 0")
@@ -1387,3 +1387,98 @@ func (s *applicationSuite) TestUnexpose(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(called, jc.IsTrue)
 }
+
+func (s *applicationSuite) TestSyncCharmFiles(c *gc.C) {
+	var called bool
+	client := application.NewClient(basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string, version int, id, request string, a, response interface{}) error {
+				called = true
+				c.Assert(request, gc.Equals, "SyncCharmFiles")
+				args, ok := a.(params.ApplicationCharmSync)
+				c.Assert(ok, jc.IsTrue)
+				c.Assert(args, jc.DeepEquals, params.ApplicationCharmSync{
+					ApplicationName: "foo",
+					Files: []params.CharmSyncFile{
+						{Path: "src/charm.py", Content: []byte("content"), Mode: 0755},
+						{Path: "README.md", Removed: true},
+					},
+				})
+				c.Assert(response, gc.IsNil)
+				return nil
+			},
+		),
+		BestVersion: 17,
+	})
+	err := client.SyncCharmFiles("foo", []params.CharmSyncFile{
+		{Path: "src/charm.py", Content: []byte("content"), Mode: 0755},
+		{Path: "README.md", Removed: true},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(called, jc.IsTrue)
+}
+
+func (s *applicationSuite) TestSyncCharmFilesNotSupported(c *gc.C) {
+	client := application.NewClient(basetesting.BestVersionCaller{
+		BestVersion: 16,
+	})
+	err := client.SyncCharmFiles("foo", nil)
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+}
+
+func (s *applicationSuite) TestSyncCharmFilesInvalidApplicationName(c *gc.C) {
+	client := application.NewClient(basetesting.BestVersionCaller{
+		BestVersion: 17,
+	})
+	err := client.SyncCharmFiles("Not Valid", nil)
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+}
+
+func (s *applicationSuite) TestEffectiveConstraints(c *gc.C) {
+	proposed := constraints.MustParse("mem=4G spaces=dmz")
+	merged := constraints.MustParse("mem=4G spaces=internal,dmz")
+	var called bool
+	client := application.NewClient(basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string, version int, id, request string, a, response interface{}) error {
+				called = true
+				c.Assert(request, gc.Equals, "EffectiveConstraints")
+				args, ok := a.(params.EffectiveConstraintsArgs)
+				c.Assert(ok, jc.IsTrue)
+				c.Assert(args, jc.DeepEquals, params.EffectiveConstraintsArgs{
+					Args: []params.EffectiveConstraintsArg{{
+						ApplicationName: "foo",
+						Constraints:     proposed,
+					}},
+				})
+				result, ok := response.(*params.EffectiveConstraintsResults)
+				c.Assert(ok, jc.IsTrue)
+				result.Results = []params.EffectiveConstraintsResult{{
+					Constraints: merged,
+				}}
+				return nil
+			},
+		),
+		BestVersion: 17,
+	})
+	cons, err := client.EffectiveConstraints("foo", proposed)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(called, jc.IsTrue)
+	c.Assert(cons, jc.DeepEquals, merged)
+}
+
+func (s *applicationSuite) TestEffectiveConstraintsNotSupported(c *gc.C) {
+	client := application.NewClient(basetesting.BestVersionCaller{
+		BestVersion: 16,
+	})
+	_, err := client.EffectiveConstraints("foo", constraints.Value{})
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+}
+
+func (s *applicationSuite) TestEffectiveConstraintsInvalidApplicationName(c *gc.C) {
+	client := application.NewClient(basetesting.BestVersionCaller{
+		BestVersion: 17,
+	})
+	_, err := client.EffectiveConstraints("Not Valid", constraints.Value{})
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+}
@@ -0,0 +1,45 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package applicationmover
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/apiserver/params"
+)
+
+// Client provides access to the application mover facade, used to move
+// applications between models on the same controller.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient returns a new ApplicationMover client.
+func NewClient(caller base.APICallCloser) *Client {
+	frontend, backend := base.NewClientFacade(caller, "ApplicationMover")
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// MoveApplication moves the named application from the current model to
+// the target model on the same controller.
+func (c *Client) MoveApplication(appName, targetModelUUID string, severRelations bool) error {
+	if !names.IsValidApplication(appName) {
+		return errors.NotValidf("application name %q", appName)
+	}
+	args := params.MoveApplicationsParams{
+		Applications: []params.MoveApplicationParams{{
+			ApplicationTag: names.NewApplicationTag(appName).String(),
+			TargetModelTag: names.NewModelTag(targetModelUUID).String(),
+			SeverRelations: severRelations,
+		}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall("MoveApplications", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
@@ -0,0 +1,156 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package authentication
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-macaroon-bakery/macaroon-bakery/v3/httpbakery"
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"gopkg.in/httprequest.v1"
+)
+
+const deviceCodeMethod = "device-code"
+
+// DeviceCodeInteractionInfo is the interaction information supplied by
+// an identity provider that supports the OIDC device authorization
+// grant (RFC 8628).
+type DeviceCodeInteractionInfo struct {
+	// AuthorizationURL is the device authorization endpoint used to
+	// obtain a device code and user code.
+	AuthorizationURL string `json:"authorization-url"`
+
+	// TokenURL is the endpoint polled with the device code until the
+	// user has approved or denied the authorization request.
+	TokenURL string `json:"token-url"`
+}
+
+// DeviceCodeResult holds the response from the device authorization
+// endpoint, using the field names defined by RFC 8628.
+type DeviceCodeResult struct {
+	// DeviceCode is the code used when polling the token endpoint.
+	DeviceCode string `json:"device_code"`
+
+	// UserCode is the code the user enters at the verification URI.
+	UserCode string `json:"user_code"`
+
+	// VerificationURI is the URI the user visits to authorize the
+	// request.
+	VerificationURI string `json:"verification_uri"`
+
+	// ExpiresIn is the lifetime of the device code in seconds.
+	ExpiresIn int `json:"expires_in"`
+
+	// Interval is the minimum number of seconds to wait between
+	// polling requests.
+	Interval int `json:"interval"`
+}
+
+// DeviceCodeLoginResponse holds the response from polling the token
+// endpoint. Error uses the error codes defined by RFC 8628, such as
+// "authorization_pending" and "access_denied".
+type DeviceCodeLoginResponse struct {
+	Token *httpbakery.DischargeToken `json:"token,omitempty"`
+	Error string                     `json:"error,omitempty"`
+}
+
+type deviceCodeStartRequest struct {
+	httprequest.Route `httprequest:"POST"`
+}
+
+type deviceCodeLoginRequest struct {
+	httprequest.Route `httprequest:"POST"`
+	DeviceCode        string `httprequest:"device_code,form"`
+}
+
+// DeviceCodeInteractor is a httpbakery.Interactor that authenticates
+// with an external identity provider using the OIDC device
+// authorization grant. The user is shown a code and a verification URI
+// to visit on another device, so no local web browser is required.
+type DeviceCodeInteractor struct {
+	display func(userCode, verificationURI string) error
+	clock   clock.Clock
+}
+
+// NewDeviceCodeInteractor returns a new DeviceCodeInteractor. The
+// display function is called with the user code and verification URI
+// once the device authorization request has been accepted.
+func NewDeviceCodeInteractor(display func(userCode, verificationURI string) error) httpbakery.Interactor {
+	return &DeviceCodeInteractor{
+		display: display,
+		clock:   clock.WallClock,
+	}
+}
+
+// Kind implements httpbakery.Interactor.Kind.
+func (i DeviceCodeInteractor) Kind() string {
+	return deviceCodeMethod
+}
+
+// Interact implements httpbakery.Interactor for the DeviceCodeInteractor.
+func (i DeviceCodeInteractor) Interact(ctx context.Context, client *httpbakery.Client, location string, interactionRequiredErr *httpbakery.Error) (*httpbakery.DischargeToken, error) {
+	var p DeviceCodeInteractionInfo
+	if err := interactionRequiredErr.InteractionMethod(deviceCodeMethod, &p); err != nil {
+		return nil, errors.Trace(err)
+	}
+	authURL, err := relativeURL(location, p.AuthorizationURL)
+	if err != nil {
+		return nil, errors.Annotatef(err, "invalid url %q", p.AuthorizationURL)
+	}
+	tokenURL, err := relativeURL(location, p.TokenURL)
+	if err != nil {
+		return nil, errors.Annotatef(err, "invalid url %q", p.TokenURL)
+	}
+	httpReqClient := &httprequest.Client{
+		Doer: client,
+	}
+
+	var dcr DeviceCodeResult
+	if err := httpReqClient.CallURL(ctx, authURL.String(), &deviceCodeStartRequest{}, &dcr); err != nil {
+		return nil, errors.Annotate(err, "cannot get device code")
+	}
+	if dcr.DeviceCode == "" || dcr.UserCode == "" {
+		return nil, errors.New("no device code found in response")
+	}
+	if err := i.display(dcr.UserCode, dcr.VerificationURI); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	interval := time.Duration(dcr.Interval) * time.Second
+	var deadline time.Time
+	if dcr.ExpiresIn > 0 {
+		deadline = i.clock.Now().Add(time.Duration(dcr.ExpiresIn) * time.Second)
+	}
+	for {
+		var lresp DeviceCodeLoginResponse
+		lr := &deviceCodeLoginRequest{DeviceCode: dcr.DeviceCode}
+		if err := httpReqClient.CallURL(ctx, tokenURL.String(), lr, &lresp); err != nil {
+			return nil, errors.Annotate(err, "cannot get device code token")
+		}
+		switch lresp.Error {
+		case "":
+			if lresp.Token == nil {
+				return nil, errors.New("no token found in device code response")
+			}
+			return lresp.Token, nil
+		case "authorization_pending":
+			// The user has not yet approved the request; keep polling.
+		case "slow_down":
+			// RFC 8628 requires the polling interval grow by 5 seconds.
+			interval += 5 * time.Second
+		default:
+			return nil, errors.Errorf("device code authorization failed: %s", lresp.Error)
+		}
+		if !deadline.IsZero() && i.clock.Now().Add(interval).After(deadline) {
+			return nil, errors.New("device code expired waiting for authorization")
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-i.clock.After(interval):
+		}
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package authentication_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/go-macaroon-bakery/macaroon-bakery/v3/httpbakery"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/httprequest.v1"
+
+	"github.com/juju/juju/api/authentication"
+)
+
+type DeviceCodeInteractorSuite struct {
+	testing.IsolationSuite
+
+	client *httpbakery.Client
+	server *httptest.Server
+
+	mu        sync.Mutex
+	pollCount int
+	responses []authentication.DeviceCodeLoginResponse
+}
+
+var _ = gc.Suite(&DeviceCodeInteractorSuite{})
+
+func (s *DeviceCodeInteractorSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.client = httpbakery.NewClient()
+	s.pollCount = 0
+	s.responses = nil
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/device", func(w http.ResponseWriter, r *http.Request) {
+		httprequest.WriteJSON(w, http.StatusOK, authentication.DeviceCodeResult{
+			DeviceCode:      "device-code-1",
+			UserCode:        "ABCD-EFGH",
+			VerificationURI: "https://idp.example.com/device",
+			ExpiresIn:       300,
+			Interval:        0,
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if code := r.Form.Get("device_code"); code != "device-code-1" {
+			httprequest.WriteJSON(w, http.StatusOK, authentication.DeviceCodeLoginResponse{
+				Error: "invalid_grant",
+			})
+			return
+		}
+		s.mu.Lock()
+		resp := s.responses[s.pollCount]
+		s.pollCount++
+		s.mu.Unlock()
+		httprequest.WriteJSON(w, http.StatusOK, resp)
+	})
+	s.server = httptest.NewServer(mux)
+	s.AddCleanup(func(c *gc.C) { s.server.Close() })
+}
+
+func (s *DeviceCodeInteractorSuite) interactionRequiredError(c *gc.C) *httpbakery.Error {
+	info := authentication.DeviceCodeInteractionInfo{
+		AuthorizationURL: s.server.URL + "/device",
+		TokenURL:         s.server.URL + "/token",
+	}
+	infoData, err := json.Marshal(info)
+	c.Assert(err, jc.ErrorIsNil)
+	msgData := json.RawMessage(infoData)
+	return &httpbakery.Error{
+		Code: httpbakery.ErrInteractionRequired,
+		Info: &httpbakery.ErrorInfo{
+			InteractionMethods: map[string]*json.RawMessage{
+				"device-code": &msgData,
+			},
+		},
+	}
+}
+
+func (s *DeviceCodeInteractorSuite) TestKind(c *gc.C) {
+	v := authentication.NewDeviceCodeInteractor(nil)
+	c.Assert(v.Kind(), gc.Equals, "device-code")
+}
+
+func (s *DeviceCodeInteractorSuite) TestInteract(c *gc.C) {
+	s.responses = []authentication.DeviceCodeLoginResponse{{
+		Token: &httpbakery.DischargeToken{Kind: "device-code", Value: []byte("token")},
+	}}
+
+	var userCode, verificationURI string
+	v := authentication.NewDeviceCodeInteractor(func(code, uri string) error {
+		userCode, verificationURI = code, uri
+		return nil
+	})
+	token, err := v.Interact(context.TODO(), s.client, s.server.URL, s.interactionRequiredError(c))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(userCode, gc.Equals, "ABCD-EFGH")
+	c.Assert(verificationURI, gc.Equals, "https://idp.example.com/device")
+	c.Assert(token.Kind, gc.Equals, "device-code")
+	c.Assert(string(token.Value), gc.Equals, "token")
+}
+
+func (s *DeviceCodeInteractorSuite) TestInteractPollsUntilAuthorized(c *gc.C) {
+	s.responses = []authentication.DeviceCodeLoginResponse{{
+		Error: "authorization_pending",
+	}, {
+		Error: "authorization_pending",
+	}, {
+		Token: &httpbakery.DischargeToken{Kind: "device-code", Value: []byte("token")},
+	}}
+
+	v := authentication.NewDeviceCodeInteractor(func(code, uri string) error { return nil })
+	token, err := v.Interact(context.TODO(), s.client, s.server.URL, s.interactionRequiredError(c))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(string(token.Value), gc.Equals, "token")
+	c.Assert(s.pollCount, gc.Equals, 3)
+}
+
+func (s *DeviceCodeInteractorSuite) TestInteractAccessDenied(c *gc.C) {
+	s.responses = []authentication.DeviceCodeLoginResponse{{
+		Error: "access_denied",
+	}}
+
+	v := authentication.NewDeviceCodeInteractor(func(code, uri string) error { return nil })
+	_, err := v.Interact(context.TODO(), s.client, s.server.URL, s.interactionRequiredError(c))
+	c.Assert(err, gc.ErrorMatches, "device code authorization failed: access_denied")
+}
+
+func (s *DeviceCodeInteractorSuite) TestInteractDisplayError(c *gc.C) {
+	v := authentication.NewDeviceCodeInteractor(func(code, uri string) error {
+		return errors.New("no terminal")
+	})
+	_, err := v.Interact(context.TODO(), s.client, s.server.URL, s.interactionRequiredError(c))
+	c.Assert(err, gc.ErrorMatches, "no terminal")
+}
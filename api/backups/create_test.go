@@ -41,7 +41,7 @@ func (s *createSuite) TestCreate(c *gc.C) {
 	)
 	defer cleanup()
 
-	result, err := s.client.Create("important", false, false)
+	result, err := s.client.Create("important", false, false, false)
 	c.Assert(err, jc.ErrorIsNil)
 	c.Log(result)
 	meta := backupstesting.UpdateNotes(s.Meta, "important")
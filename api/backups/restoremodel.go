@@ -0,0 +1,27 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/apiserver/params"
+)
+
+// RestoreModel sends a request to restore the given model from the
+// identified backup into the running controller. It returns the
+// number of model documents reconstructed.
+func (c *Client) RestoreModel(backupID, modelUUID string) (int, error) {
+	var result params.BackupsRestoreModelResult
+	args := params.BackupsRestoreModelArgs{
+		BackupID:  backupID,
+		ModelUUID: modelUUID,
+	}
+
+	if err := c.facade.FacadeCall("RestoreModel", args, &result); err != nil {
+		return 0, errors.Trace(err)
+	}
+
+	return result.RestoredDocs, nil
+}
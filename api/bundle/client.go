@@ -57,17 +57,36 @@ func (c *Client) GetChangesMapArgs(bundleURL, bundleDataYAML string) (params.Bun
 	return result, nil
 }
 
+// GetBundleDiff returns a structured diff between the given bundle data
+// and the current model, computed server side.
+func (c *Client) GetBundleDiff(bundleDataYAML string, includeAnnotations bool) (params.BundleDiffResult, error) {
+	var result params.BundleDiffResult
+	if bestVer := c.BestAPIVersion(); bestVer < 7 {
+		return result, errors.Errorf("this controller version does not support server-side bundle diff.")
+	}
+	if err := c.facade.FacadeCall("GetBundleDiff", params.BundleDiffParams{
+		BundleDataYAML:     bundleDataYAML,
+		IncludeAnnotations: includeAnnotations,
+	}, &result); err != nil {
+		return result, errors.Trace(err)
+	}
+	return result, nil
+}
+
 // ExportBundle exports the current model configuration.
-func (c *Client) ExportBundle(includeDefaults bool) (string, error) {
+func (c *Client) ExportBundle(includeDefaults, excludeMachines bool) (string, error) {
 	var result params.StringResult
 	if bestVer := c.BestAPIVersion(); bestVer < 2 {
 		return "", errors.Errorf("this controller version does not support bundle export feature.")
 	} else if bestVer < 5 && includeDefaults {
 		return "", errors.Errorf("this controller version does not support bundle export with charm defaults.")
+	} else if bestVer < 6 && excludeMachines {
+		return "", errors.Errorf("this controller version does not support bundle export without machines.")
 	}
 
 	arg := params.ExportBundleParams{
 		IncludeCharmDefaults: includeDefaults,
+		ExcludeMachines:      excludeMachines,
 	}
 	if err := c.facade.FacadeCall("ExportBundle", arg, &result); err != nil {
 		return "", errors.Trace(err)
@@ -286,7 +286,7 @@ func (s *bundleMockSuite) TestFailExportBundlev1(c *gc.C) {
 			return nil
 		}, 1,
 	)
-	result, err := client.ExportBundle(false)
+	result, err := client.ExportBundle(false, false)
 	c.Assert(err, gc.NotNil)
 	c.Assert(err.Error(), gc.Equals, "this controller version does not support bundle export feature.")
 	c.Assert(result, jc.DeepEquals, "")
@@ -310,12 +310,89 @@ func (s *bundleMockSuite) TestFailExportBundleWithDefaults(c *gc.C) {
 			return nil
 		}, 4,
 	)
-	result, err := client.ExportBundle(true)
+	result, err := client.ExportBundle(true, false)
 	c.Assert(err, gc.NotNil)
 	c.Assert(err.Error(), gc.Equals, "this controller version does not support bundle export with charm defaults.")
 	c.Assert(result, jc.DeepEquals, "")
 }
 
+func (s *bundleMockSuite) TestFailExportBundleWithoutMachines(c *gc.C) {
+	client := newClient(
+		func(objType string,
+			version int,
+			id,
+			request string,
+			args,
+			response interface{},
+		) error {
+			c.Check(objType, gc.Equals, "Bundle")
+			c.Check(id, gc.Equals, "")
+			c.Check(request, gc.Equals, "ExportBundle")
+			c.Assert(args, gc.Equals, nil)
+			result := response.(*params.StringResult)
+			result.Result = ""
+			return nil
+		}, 5,
+	)
+	result, err := client.ExportBundle(false, true)
+	c.Assert(err, gc.NotNil)
+	c.Assert(err.Error(), gc.Equals, "this controller version does not support bundle export without machines.")
+	c.Assert(result, jc.DeepEquals, "")
+}
+
+func (s *bundleMockSuite) TestGetBundleDiff(c *gc.C) {
+	bundleYAML := `applications:
+	ubuntu:
+		charm: ubuntu
+		num_units: 1`
+	client := newClient(
+		func(objType string,
+			version int,
+			id,
+			request string,
+			args,
+			response interface{},
+		) error {
+			c.Check(objType, gc.Equals, "Bundle")
+			c.Check(id, gc.Equals, "")
+			c.Check(request, gc.Equals, "GetBundleDiff")
+			c.Assert(args, jc.DeepEquals, params.BundleDiffParams{
+				BundleDataYAML:     bundleYAML,
+				IncludeAnnotations: true,
+			})
+			result := response.(*params.BundleDiffResult)
+			result.DiffYAML = "{}\n"
+			result.Empty = true
+			return nil
+		}, 7,
+	)
+	result, err := client.GetBundleDiff(bundleYAML, true)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.BundleDiffResult{
+		DiffYAML: "{}\n",
+		Empty:    true,
+	})
+}
+
+func (s *bundleMockSuite) TestFailGetBundleDiff(c *gc.C) {
+	client := newClient(
+		func(objType string,
+			version int,
+			id,
+			request string,
+			args,
+			response interface{},
+		) error {
+			c.Assert(args, gc.Equals, nil)
+			return nil
+		}, 6,
+	)
+	result, err := client.GetBundleDiff("applications: {}", false)
+	c.Assert(err, gc.NotNil)
+	c.Assert(err.Error(), gc.Equals, "this controller version does not support server-side bundle diff.")
+	c.Assert(result, jc.DeepEquals, params.BundleDiffResult{})
+}
+
 func (s *bundleMockSuite) TestExportBundleLatest(c *gc.C) {
 	bundle := `applications:
 	ubuntu:
@@ -344,7 +421,7 @@ func (s *bundleMockSuite) TestExportBundleLatest(c *gc.C) {
 			return nil
 		}, 5,
 	)
-	result, err := client.ExportBundle(true)
+	result, err := client.ExportBundle(true, false)
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(result, jc.DeepEquals, bundle)
 }
@@ -364,7 +441,7 @@ func (s *bundleMockSuite) TestExportBundleNotNilParamsErrorv2(c *gc.C) {
 			return result.Error
 		}, 2,
 	)
-	result, err := client.ExportBundle(false)
+	result, err := client.ExportBundle(false, false)
 	c.Assert(err, gc.NotNil)
 	c.Assert(result, jc.DeepEquals, "")
 	c.Check(err.Error(), gc.Matches, "export failed nothing to export as there are no applications")
@@ -383,7 +460,7 @@ func (s *bundleMockSuite) TestExportBundleFailNoParamsErrorv2(c *gc.C) {
 			return errors.New("foo")
 		}, 2,
 	)
-	result, err := client.ExportBundle(false)
+	result, err := client.ExportBundle(false, false)
 	c.Assert(err, gc.NotNil)
 	c.Assert(result, jc.DeepEquals, "")
 	c.Check(err.Error(), gc.Matches, "foo")
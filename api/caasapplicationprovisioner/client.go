@@ -125,6 +125,7 @@ type ProvisioningInfo struct {
 	CharmURL             *charm.URL
 	Trust                bool
 	Scale                int
+	WorkloadPatch        string
 }
 
 // ProvisioningInfo returns the info needed to provision an operator for an application.
@@ -172,6 +173,7 @@ func (c *Client) ProvisioningInfo(applicationName string) (ProvisioningInfo, err
 		CharmModifiedVersion: r.CharmModifiedVersion,
 		Trust:                r.Trust,
 		Scale:                r.Scale,
+		WorkloadPatch:        r.WorkloadPatch,
 	}
 
 	for _, fs := range r.Filesystems {
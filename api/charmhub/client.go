@@ -66,10 +66,13 @@ func (c *Client) Find(query string, options ...FindOption) ([]FindResponse, erro
 		Category:         opts.category,
 		Channel:          opts.channel,
 		CharmType:        opts.charmType,
+		Base:             opts.base,
 		Platforms:        opts.platforms,
 		Publisher:        opts.publisher,
 		RelationRequires: opts.relationRequires,
 		RelationProvides: opts.relationProvides,
+		Limit:            opts.limit,
+		Offset:           opts.offset,
 	}
 
 	var result params.CharmHubEntityFindResult
@@ -106,10 +109,13 @@ type findOptions struct {
 	category         string
 	channel          string
 	charmType        string
+	base             string
 	platforms        string
 	publisher        string
 	relationRequires string
 	relationProvides string
+	limit            int
+	offset           int
 }
 
 // WithFindCategory sets the category on the option.
@@ -133,6 +139,13 @@ func WithFindType(charmType string) FindOption {
 	}
 }
 
+// WithFindBase sets the base on the option.
+func WithFindBase(base string) FindOption {
+	return func(findOptions *findOptions) {
+		findOptions.base = base
+	}
+}
+
 // WithFindPlatforms sets the charmPlatforms on the option.
 func WithFindPlatforms(platforms string) FindOption {
 	return func(findOptions *findOptions) {
@@ -161,6 +174,21 @@ func WithFindRelationProvides(relationProvides string) FindOption {
 	}
 }
 
+// WithFindLimit caps the number of results returned.
+func WithFindLimit(limit int) FindOption {
+	return func(findOptions *findOptions) {
+		findOptions.limit = limit
+	}
+}
+
+// WithFindOffset skips the given number of results, for paginating through
+// a large result set.
+func WithFindOffset(offset int) FindOption {
+	return func(findOptions *findOptions) {
+		findOptions.offset = offset
+	}
+}
+
 // Create a findOptions instance with default values.
 func newFindOptions() *findOptions {
 	return &findOptions{}
@@ -8,6 +8,7 @@ import (
 	"github.com/juju/charm/v9"
 	charmresource "github.com/juju/charm/v9/resource"
 	"github.com/juju/errors"
+	"github.com/juju/names/v4"
 	"gopkg.in/macaroon.v2"
 
 	"github.com/juju/juju/api/base"
@@ -241,3 +242,73 @@ func (c *Client) ListCharmResources(curl *charm.URL, origin apicharm.Origin) ([]
 
 	return resources, nil
 }
+
+// CharmRelease describes a single channel, base and architecture combination
+// that a charm has been released for.
+type CharmRelease struct {
+	Channel      string
+	OS           string
+	Series       string
+	Architecture string
+}
+
+// SupportMatrix returns every channel, base and architecture combination the
+// given charm has been released for.
+// SupportMatrix is only supported in version 6 and above.
+func (c *Client) SupportMatrix(curl *charm.URL, origin apicharm.Origin) ([]CharmRelease, error) {
+	if c.facade.BestAPIVersion() < 6 {
+		return nil, errors.NotSupportedf("support matrix")
+	}
+
+	args := params.CharmURLAndOrigins{
+		Entities: []params.CharmURLAndOrigin{{
+			CharmURL: curl.String(),
+			Origin:   origin.ParamsCharmOrigin(),
+		}},
+	}
+	var results params.CharmSupportMatrixResults
+	if err := c.facade.FacadeCall("SupportMatrix", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if n := len(results.Results); n != 1 {
+		return nil, errors.Errorf("expected 1 result, received %d", n)
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, errors.Trace(result.Error)
+	}
+
+	releases := make([]CharmRelease, len(result.Releases))
+	for i, release := range result.Releases {
+		releases[i] = CharmRelease{
+			Channel:      release.Channel,
+			OS:           release.OS,
+			Series:       release.Series,
+			Architecture: release.Architecture,
+		}
+	}
+	return releases, nil
+}
+
+// CharmDiff returns the metadata, config and action differences between
+// the charm revision currently deployed by the named application and
+// the given revision of the same charm.
+// CharmDiff is only supported in version 5 and above.
+func (c *Client) CharmDiff(appName string, revision int) (params.CharmDiff, error) {
+	if c.facade.BestAPIVersion() < 5 {
+		return params.CharmDiff{}, errors.NotSupportedf("charm diff")
+	}
+
+	args := params.CharmDiffArgs{
+		ApplicationTag: names.NewApplicationTag(appName).String(),
+		Revision:       revision,
+	}
+	var result params.CharmDiffResult
+	if err := c.facade.FacadeCall("CharmDiff", args, &result); err != nil {
+		return params.CharmDiff{}, errors.Trace(err)
+	}
+	if result.Error != nil {
+		return params.CharmDiff{}, errors.Trace(result.Error)
+	}
+	return result.Diff, nil
+}
@@ -192,6 +192,62 @@ func (s *charmsMockSuite) TestGetDownloadInfoIsNotSupported(c *gc.C) {
 	c.Assert(errors.IsNotSupported(err), jc.IsTrue)
 }
 
+func (s *charmsMockSuite) TestSupportMatrix(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	curl := charm.MustParseURL("ch:a-charm")
+	origin := params.CharmOrigin{Source: "charm-hub"}
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+
+	facadeArgs := params.CharmURLAndOrigins{
+		Entities: []params.CharmURLAndOrigin{
+			{CharmURL: curl.String(), Origin: apicharm.APICharmOrigin(origin).ParamsCharmOrigin()},
+		},
+	}
+
+	var results params.CharmSupportMatrixResults
+
+	p := params.CharmSupportMatrixResults{
+		Results: []params.CharmSupportMatrixResult{{
+			Releases: []params.CharmSupportedRelease{{
+				Channel:      "latest/stable",
+				OS:           "ubuntu",
+				Series:       "focal",
+				Architecture: arch.DefaultArchitecture,
+			}},
+		}},
+	}
+
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(6)
+	mockFacadeCaller.EXPECT().FacadeCall("SupportMatrix", facadeArgs, &results).SetArg(2, p).Return(nil)
+
+	client := charms.NewClientWithFacade(mockFacadeCaller)
+	got, err := client.SupportMatrix(curl, apicharm.APICharmOrigin(origin))
+	c.Assert(err, gc.IsNil)
+	c.Assert(got, jc.DeepEquals, []charms.CharmRelease{{
+		Channel:      "latest/stable",
+		OS:           "ubuntu",
+		Series:       "focal",
+		Architecture: arch.DefaultArchitecture,
+	}})
+}
+
+func (s *charmsMockSuite) TestSupportMatrixIsNotSupported(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(5)
+
+	client := charms.NewClientWithFacade(mockFacadeCaller)
+
+	curl := charm.MustParseURL("ch:a-charm")
+	_, err := client.SupportMatrix(curl, apicharm.APICharmOrigin(params.CharmOrigin{Source: "charm-hub"}))
+	c.Assert(errors.IsNotSupported(err), jc.IsTrue)
+}
+
 func (s *charmsMockSuite) TestAddCharm(c *gc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -51,8 +51,17 @@ type Client struct {
 
 // Status returns the status of the juju model.
 func (c *Client) Status(patterns []string) (*params.FullStatus, error) {
+	return c.StatusFiltered(patterns, nil)
+}
+
+// StatusFiltered returns the status of the juju model, restricted to
+// the named status sections ("machines", "applications",
+// "remote-applications", "offers", "relations" or "branches") when
+// include is not empty. Older servers ignore the section filter and
+// return the full status.
+func (c *Client) StatusFiltered(patterns, include []string) (*params.FullStatus, error) {
 	var result params.FullStatus
-	p := params.StatusParams{Patterns: patterns}
+	p := params.StatusParams{Patterns: patterns, Include: include}
 	if err := c.facade.FacadeCall("FullStatus", p, &result); err != nil {
 		return nil, err
 	}
@@ -286,6 +295,19 @@ func (c *Client) SetModelAgentVersion(version version.Number, ignoreAgentVersion
 	return c.facade.FacadeCall("SetModelAgentVersion", args, nil)
 }
 
+// PrecheckModelUpgrade asks the controller to run the registered model
+// upgrade prechecks against the given target version and returns the
+// readiness report. No state is changed.
+func (c *Client) PrecheckModelUpgrade(targetVersion version.Number) (params.PrecheckModelUpgradeResults, error) {
+	var results params.PrecheckModelUpgradeResults
+	if c.facade.BestAPIVersion() < 3 {
+		return results, errors.NotSupportedf("model upgrade prechecks by this controller")
+	}
+	args := params.PrecheckModelUpgrade{TargetVersion: targetVersion}
+	err := c.facade.FacadeCall("PrecheckModelUpgrade", args, &results)
+	return results, err
+}
+
 // AbortCurrentUpgrade aborts and archives the current upgrade
 // synchronisation record, if any.
 func (c *Client) AbortCurrentUpgrade() error {
@@ -364,6 +364,34 @@ func (c *Client) UpdateCloud(cloud jujucloud.Cloud) error {
 	return results.OneError()
 }
 
+// PreviewUpdateCloud reports the changes that updating the cloud would
+// make on the current controller, along with the results of revalidating
+// the stored credentials for the cloud against the models using them,
+// without applying the update.
+func (c *Client) PreviewUpdateCloud(cloud jujucloud.Cloud) (params.CloudUpdatePreviewResult, error) {
+	if c.BestAPIVersion() < 7 {
+		return params.CloudUpdatePreviewResult{}, errors.New("previewing a cloud update is not supported by this version of Juju")
+	}
+	args := params.UpdateCloudArgs{
+		Clouds: []params.AddCloudArgs{{
+			Name:  cloud.Name,
+			Cloud: common.CloudToParams(cloud),
+		}},
+	}
+	var results params.CloudUpdatePreviewResults
+	if err := c.facade.FacadeCall("PreviewUpdateCloud", args, &results); err != nil {
+		return params.CloudUpdatePreviewResult{}, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return params.CloudUpdatePreviewResult{}, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return params.CloudUpdatePreviewResult{}, result.Error
+	}
+	return result, nil
+}
+
 // RemoveCloud removes a cloud from the current controller.
 func (c *Client) RemoveCloud(cloud string) error {
 	if bestVer := c.BestAPIVersion(); bestVer < 2 {
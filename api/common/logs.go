@@ -38,6 +38,12 @@ type DebugLogParams struct {
 	// ExcludeModule lists logging modules to exclude from the response. If a
 	// module is specified, all the submodules are also excluded.
 	ExcludeModule []string
+	// IncludeModuleRegex lists regular expressions to match against logging
+	// module names; only matching lines are included in the response.
+	IncludeModuleRegex []string
+	// ExcludeModuleRegex lists regular expressions to match against logging
+	// module names; matching lines are excluded from the response.
+	ExcludeModuleRegex []string
 	// ExcludeLabel lists logging labels to exclude from the response.
 	ExcludeLabel []string
 
@@ -49,8 +55,14 @@ type DebugLogParams struct {
 	// starting filtering. If backlog is zero and replay is false, then there
 	// may be an initial delay until the next matching log message is written.
 	Backlog uint
+	// BacklogDuration tells the server to only return records logged within
+	// this duration before now. It cannot be combined with StartTime.
+	BacklogDuration time.Duration
 	// Level specifies the minimum logging level to be sent back in the response.
 	Level loggo.Level
+	// LevelMax specifies the maximum logging level to be sent back in the
+	// response, allowing a level range to be requested together with Level.
+	LevelMax loggo.Level
 	// Replay tells the server to start at the start of the log file rather
 	// than the end. If replay is true, backlog is ignored.
 	Replay bool
@@ -64,12 +76,14 @@ type DebugLogParams struct {
 
 func (args DebugLogParams) URLQuery() url.Values {
 	attrs := url.Values{
-		"includeEntity": args.IncludeEntity,
-		"includeModule": args.IncludeModule,
-		"includeLabel":  args.IncludeLabel,
-		"excludeEntity": args.ExcludeEntity,
-		"excludeModule": args.ExcludeModule,
-		"excludeLabel":  args.ExcludeLabel,
+		"includeEntity":      args.IncludeEntity,
+		"includeModule":      args.IncludeModule,
+		"includeModuleRegex": args.IncludeModuleRegex,
+		"includeLabel":       args.IncludeLabel,
+		"excludeEntity":      args.ExcludeEntity,
+		"excludeModule":      args.ExcludeModule,
+		"excludeModuleRegex": args.ExcludeModuleRegex,
+		"excludeLabel":       args.ExcludeLabel,
 	}
 	if args.Replay {
 		attrs.Set("replay", fmt.Sprint(args.Replay))
@@ -83,9 +97,15 @@ func (args DebugLogParams) URLQuery() url.Values {
 	if args.Backlog > 0 {
 		attrs.Set("backlog", fmt.Sprint(args.Backlog))
 	}
+	if args.BacklogDuration > 0 {
+		attrs.Set("backlogDuration", args.BacklogDuration.String())
+	}
 	if args.Level != loggo.UNSPECIFIED {
 		attrs.Set("level", fmt.Sprint(args.Level))
 	}
+	if args.LevelMax != loggo.UNSPECIFIED {
+		attrs.Set("maxLevel", fmt.Sprint(args.LevelMax))
+	}
 	if !args.StartTime.IsZero() {
 		attrs.Set("startTime", args.StartTime.Format(time.RFC3339Nano))
 	}
@@ -298,6 +298,71 @@ func (c *Client) ConfigSet(values map[string]interface{}) error {
 	)
 }
 
+// ConfigSchema returns a description of every controller config and
+// model default attribute known to the controller, keyed on schema
+// name ("controller-config" and "model-defaults").
+func (c *Client) ConfigSchema() (map[string][]params.ConfigSchemaAttr, error) {
+	if c.BestAPIVersion() < 11 {
+		return nil, errors.Errorf("this controller version doesn't support the config schema API")
+	}
+	var result params.ConfigSchemaResult
+	if err := c.facade.FacadeCall("ConfigSchema", nil, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return result.Schemas, nil
+}
+
+// DrainControllerNode asks the controller to drain the controller
+// node running on the given machine ahead of maintenance, handing its
+// replica set vote to another controller.
+func (c *Client) DrainControllerNode(machineId string) error {
+	if c.BestAPIVersion() < 11 {
+		return errors.Errorf("this controller version doesn't support draining controller nodes")
+	}
+	args := params.Entities{Entities: []params.Entity{
+		{Tag: names.NewMachineTag(machineId).String()},
+	}}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall("DrainControllerNode", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(results.OneError())
+}
+
+// DrainStatus describes drain progress for a controller node.
+type DrainStatus struct {
+	Draining bool
+	HasVote  bool
+	Ready    bool
+}
+
+// ControllerNodeDrainStatus reports drain progress for the controller
+// node running on the given machine.
+func (c *Client) ControllerNodeDrainStatus(machineId string) (DrainStatus, error) {
+	if c.BestAPIVersion() < 11 {
+		return DrainStatus{}, errors.Errorf("this controller version doesn't support draining controller nodes")
+	}
+	args := params.Entities{Entities: []params.Entity{
+		{Tag: names.NewMachineTag(machineId).String()},
+	}}
+	var results params.DrainStatusResults
+	if err := c.facade.FacadeCall("ControllerNodeDrainStatus", args, &results); err != nil {
+		return DrainStatus{}, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return DrainStatus{}, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return DrainStatus{}, errors.Trace(result.Error)
+	}
+	return DrainStatus{
+		Draining: result.Draining,
+		HasVote:  result.HasVote,
+		Ready:    result.Ready,
+	}, nil
+}
+
 // MigrationSpec holds the details required to start the migration of
 // a single model.
 type MigrationSpec struct {
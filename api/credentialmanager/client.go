@@ -5,9 +5,11 @@ package credentialmanager
 
 import (
 	"github.com/juju/errors"
+	"github.com/juju/names/v4"
 
 	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/apiserver/params"
+	jujucloud "github.com/juju/juju/cloud"
 )
 
 // Client allows access to the credential management API end point.
@@ -35,3 +37,81 @@ func (c *Client) InvalidateModelCredential(reason string) error {
 	}
 	return nil
 }
+
+// StageCredential uploads candidate content for an existing credential
+// without changing the active content.
+func (c *Client) StageCredential(tag names.CloudCredentialTag, credential jujucloud.Credential) error {
+	if c.BestAPIVersion() < 2 {
+		return errors.New("staged credential rotation is not supported by this version of Juju")
+	}
+	in := params.TaggedCredential{
+		Tag: tag.String(),
+		Credential: params.CloudCredential{
+			AuthType:   string(credential.AuthType()),
+			Attributes: credential.Attributes(),
+		},
+	}
+	var result params.ErrorResult
+	err := c.facade.FacadeCall("StageCredential", in, &result)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if result.Error != nil {
+		return errors.Trace(result.Error)
+	}
+	return nil
+}
+
+// CommitStagedCredential asks the controller to validate the content
+// staged for a credential against the models using the credential and,
+// if valid everywhere or forced, to switch the active content to it.
+// Per-model validation results are returned along with any overall error.
+func (c *Client) CommitStagedCredential(tag names.CloudCredentialTag, force bool) ([]params.UpdateCredentialModelResult, error) {
+	if c.BestAPIVersion() < 2 {
+		return nil, errors.New("staged credential rotation is not supported by this version of Juju")
+	}
+	in := params.CommitStagedCredentialArg{CredentialTag: tag.String(), Force: force}
+	var result params.UpdateCredentialResult
+	err := c.facade.FacadeCall("CommitStagedCredential", in, &result)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if result.Error != nil {
+		return result.Models, errors.Trace(result.Error)
+	}
+	return result.Models, nil
+}
+
+// RollbackCredential restores a credential's active content to what it
+// was before the last staged rotation was committed.
+func (c *Client) RollbackCredential(tag names.CloudCredentialTag) error {
+	if c.BestAPIVersion() < 2 {
+		return errors.New("staged credential rotation is not supported by this version of Juju")
+	}
+	var result params.ErrorResult
+	err := c.facade.FacadeCall("RollbackCredential", params.Entity{Tag: tag.String()}, &result)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if result.Error != nil {
+		return errors.Trace(result.Error)
+	}
+	return nil
+}
+
+// DiscardStagedCredential drops any candidate content staged for a
+// credential.
+func (c *Client) DiscardStagedCredential(tag names.CloudCredentialTag) error {
+	if c.BestAPIVersion() < 2 {
+		return errors.New("staged credential rotation is not supported by this version of Juju")
+	}
+	var result params.ErrorResult
+	err := c.facade.FacadeCall("DiscardStagedCredential", params.Entity{Tag: tag.String()}, &result)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if result.Error != nil {
+		return errors.Trace(result.Error)
+	}
+	return nil
+}
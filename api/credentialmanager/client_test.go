@@ -5,6 +5,7 @@ package credentialmanager_test
 
 import (
 	"github.com/juju/errors"
+	"github.com/juju/names/v4"
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
@@ -13,6 +14,7 @@ import (
 	"github.com/juju/juju/api/credentialmanager"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cloud"
 )
 
 var _ = gc.Suite(&CredentialManagerSuite{})
@@ -56,3 +58,105 @@ func (s *CredentialManagerSuite) TestInvalidateModelCredentialError(c *gc.C) {
 	err := client.InvalidateModelCredential("")
 	c.Assert(err, gc.ErrorMatches, "foo")
 }
+
+func (s *CredentialManagerSuite) TestStageCredential(c *gc.C) {
+	tag := names.NewCloudCredentialTag("foo/bob/bar")
+	apiCaller := apitesting.BestVersionCaller{
+		APICallerFunc: apitesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+			c.Check(objType, gc.Equals, "CredentialManager")
+			c.Check(request, gc.Equals, "StageCredential")
+			c.Assert(arg, jc.DeepEquals, params.TaggedCredential{
+				Tag: tag.String(),
+				Credential: params.CloudCredential{
+					AuthType:   "userpass",
+					Attributes: map[string]string{"username": "fred"},
+				},
+			})
+			c.Assert(result, gc.FitsTypeOf, &params.ErrorResult{})
+			*(result.(*params.ErrorResult)) = params.ErrorResult{}
+			return nil
+		}),
+		BestVersion: 2,
+	}
+
+	client := credentialmanager.NewClient(apiCaller)
+	err := client.StageCredential(tag, cloud.NewCredential(cloud.UserPassAuthType, map[string]string{"username": "fred"}))
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *CredentialManagerSuite) TestStageCredentialNotSupported(c *gc.C) {
+	apiCaller := apitesting.BestVersionCaller{
+		APICallerFunc: apitesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+			return errors.New("should not be called")
+		}),
+		BestVersion: 1,
+	}
+
+	client := credentialmanager.NewClient(apiCaller)
+	err := client.StageCredential(names.NewCloudCredentialTag("foo/bob/bar"), cloud.NewCredential(cloud.EmptyAuthType, nil))
+	c.Assert(err, gc.ErrorMatches, "staged credential rotation is not supported by this version of Juju")
+}
+
+func (s *CredentialManagerSuite) TestCommitStagedCredential(c *gc.C) {
+	tag := names.NewCloudCredentialTag("foo/bob/bar")
+	models := []params.UpdateCredentialModelResult{
+		{ModelUUID: "deadbeef-0bad-400d-8000-4b1d0d06f00d", ModelName: "default"},
+	}
+	apiCaller := apitesting.BestVersionCaller{
+		APICallerFunc: apitesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+			c.Check(objType, gc.Equals, "CredentialManager")
+			c.Check(request, gc.Equals, "CommitStagedCredential")
+			c.Assert(arg, jc.DeepEquals, params.CommitStagedCredentialArg{CredentialTag: tag.String(), Force: true})
+			c.Assert(result, gc.FitsTypeOf, &params.UpdateCredentialResult{})
+			*(result.(*params.UpdateCredentialResult)) = params.UpdateCredentialResult{
+				CredentialTag: tag.String(),
+				Models:        models,
+			}
+			return nil
+		}),
+		BestVersion: 2,
+	}
+
+	client := credentialmanager.NewClient(apiCaller)
+	got, err := client.CommitStagedCredential(tag, true)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(got, jc.DeepEquals, models)
+}
+
+func (s *CredentialManagerSuite) TestRollbackCredential(c *gc.C) {
+	tag := names.NewCloudCredentialTag("foo/bob/bar")
+	apiCaller := apitesting.BestVersionCaller{
+		APICallerFunc: apitesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+			c.Check(objType, gc.Equals, "CredentialManager")
+			c.Check(request, gc.Equals, "RollbackCredential")
+			c.Assert(arg, jc.DeepEquals, params.Entity{Tag: tag.String()})
+			c.Assert(result, gc.FitsTypeOf, &params.ErrorResult{})
+			*(result.(*params.ErrorResult)) = params.ErrorResult{}
+			return nil
+		}),
+		BestVersion: 2,
+	}
+
+	client := credentialmanager.NewClient(apiCaller)
+	err := client.RollbackCredential(tag)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *CredentialManagerSuite) TestDiscardStagedCredential(c *gc.C) {
+	tag := names.NewCloudCredentialTag("foo/bob/bar")
+	apiCaller := apitesting.BestVersionCaller{
+		APICallerFunc: apitesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+			c.Check(objType, gc.Equals, "CredentialManager")
+			c.Check(request, gc.Equals, "DiscardStagedCredential")
+			c.Assert(arg, jc.DeepEquals, params.Entity{Tag: tag.String()})
+			c.Assert(result, gc.FitsTypeOf, &params.ErrorResult{})
+			*(result.(*params.ErrorResult)) = params.ErrorResult{}
+			return nil
+		}),
+		BestVersion: 2,
+	}
+
+	client := credentialmanager.NewClient(apiCaller)
+	err := client.DiscardStagedCredential(tag)
+	c.Assert(err, jc.ErrorIsNil)
+}
@@ -0,0 +1,49 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package customevents
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/apiserver/params"
+)
+
+// Client allows access to the custom events API end point.
+type Client struct {
+	base.ClientFacade
+	st     base.APICallCloser
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the custom events api.
+func NewClient(st base.APICallCloser) *Client {
+	frontend, backend := base.NewClientFacade(st, "CustomEvents")
+	return &Client{ClientFacade: frontend, st: st, facade: backend}
+}
+
+// TriggerCustomEvent queues the named custom event for the given unit
+// or application and returns the id of the queued event.
+func (c *Client) TriggerCustomEvent(receiver names.Tag, name string, eventParams map[string]interface{}) (string, error) {
+	args := params.TriggerCustomEventArgs{
+		Events: []params.TriggerCustomEventArg{{
+			Receiver: receiver.String(),
+			Name:     name,
+			Params:   eventParams,
+		}},
+	}
+	var results params.StringResults
+	if err := c.facade.FacadeCall("TriggerCustomEvents", args, &results); err != nil {
+		return "", errors.Trace(err)
+	}
+	if count := len(results.Results); count != 1 {
+		return "", errors.Errorf("expected 1 result, got %d", count)
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return "", errors.Trace(result.Error)
+	}
+	return result.Result, nil
+}
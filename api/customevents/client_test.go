@@ -0,0 +1,72 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package customevents_test
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	basetesting "github.com/juju/juju/api/base/testing"
+	"github.com/juju/juju/api/customevents"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/testing"
+)
+
+type CustomEventsSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&CustomEventsSuite{})
+
+func (s *CustomEventsSuite) TestTriggerCustomEvent(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Check(objType, gc.Equals, "CustomEvents")
+			c.Check(id, gc.Equals, "")
+			c.Check(request, gc.Equals, "TriggerCustomEvents")
+
+			args, ok := a.(params.TriggerCustomEventArgs)
+			c.Assert(ok, jc.IsTrue)
+			c.Assert(args, jc.DeepEquals, params.TriggerCustomEventArgs{
+				Events: []params.TriggerCustomEventArg{{
+					Receiver: "unit-mysql-0",
+					Name:     "rotate-certs",
+					Params:   map[string]interface{}{"expiry": "30d"},
+				}},
+			})
+
+			if results, ok := result.(*params.StringResults); ok {
+				results.Results = []params.StringResult{{Result: "42"}}
+			}
+			return nil
+		})
+
+	client := customevents.NewClient(apiCaller)
+	id, err := client.TriggerCustomEvent(
+		names.NewUnitTag("mysql/0"), "rotate-certs",
+		map[string]interface{}{"expiry": "30d"},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(id, gc.Equals, "42")
+}
+
+func (s *CustomEventsSuite) TestTriggerCustomEventFacadeCallError(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			return errors.New("facade failure")
+		})
+	client := customevents.NewClient(apiCaller)
+	_, err := client.TriggerCustomEvent(names.NewUnitTag("mysql/0"), "rotate-certs", nil)
+	c.Assert(err, gc.ErrorMatches, "facade failure")
+}
@@ -0,0 +1,44 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package dnsconfigurer
+
+import (
+	"github.com/juju/juju/api/base"
+	apiwatcher "github.com/juju/juju/api/watcher"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/core/watcher"
+)
+
+// Facade provides access to the DNSConfigurer API facade.
+type Facade struct {
+	caller base.FacadeCaller
+}
+
+// NewFacade creates a new client-side DNSConfigurer facade.
+func NewFacade(caller base.APICaller) *Facade {
+	return &Facade{
+		caller: base.NewFacadeCaller(caller, "DNSConfigurer"),
+	}
+}
+
+// WatchForDNSConfigChanges returns a NotifyWatcher waiting for the
+// DNS configuration to change.
+func (f *Facade) WatchForDNSConfigChanges() (watcher.NotifyWatcher, error) {
+	var result params.NotifyWatchResult
+	err := f.caller.FacadeCall("WatchForDNSConfigChanges", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return apiwatcher.NewNotifyWatcher(f.caller.RawAPICaller(), result), nil
+}
+
+// DNSConfig returns the DNS configuration the machine should apply.
+func (f *Facade) DNSConfig() (servers, searchDomains []string, err error) {
+	var result params.DNSConfigResult
+	err = f.caller.FacadeCall("DNSConfig", nil, &result)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result.Servers, result.SearchDomains, nil
+}
@@ -13,17 +13,20 @@ package api
 var facadeVersions = map[string]int{
 	"Action":                       7,
 	"ActionPruner":                 1,
-	"Agent":                        3,
+	"Agent":                        4,
+	"AgentHealth":                  1,
+	"AgentPresence":                1,
 	"AgentTools":                   1,
 	"AllModelWatcher":              2,
 	"AllWatcher":                   1,
 	"Annotations":                  2,
-	"Application":                  13,
+	"Application":                  17,
+	"ApplicationMover":             1,
 	"ApplicationOffers":            3,
 	"ApplicationScaler":            1,
-	"Backups":                      3,
+	"Backups":                      4,
 	"Block":                        2,
-	"Bundle":                       5,
+	"Bundle":                       7,
 	"CAASAgent":                    2,
 	"CAASAdmission":                1,
 	"CAASApplication":              1,
@@ -39,52 +42,57 @@ var facadeVersions = map[string]int{
 	"CharmHub":                     1,
 	"CharmDownloader":              1,
 	"CharmRevisionUpdater":         2,
-	"Charms":                       4,
+	"Charms":                       6,
 	"Cleaner":                      2,
 	"Client":                       3,
 	"Cloud":                        7,
 	"Controller":                   11,
-	"CredentialManager":            1,
+	"CredentialManager":            2,
 	"CredentialValidator":          2,
 	"CrossController":              1,
 	"CrossModelRelations":          2,
+	"CustomEvents":                 1,
 	"Deployer":                     1,
 	"DiskManager":                  2,
+	"DNSConfigurer":                1,
 	"EntityWatcher":                2,
 	"ExternalControllerUpdater":    1,
 	"FanConfigurer":                1,
 	"FilesystemAttachmentsWatcher": 2,
-	"Firewaller":                   7,
-	"FirewallRules":                1,
-	"HighAvailability":             2,
+	"Firewaller":                   8,
+	"FirewallRules":                2,
+	"HighAvailability":             3,
 	"HostKeyReporter":              1,
 	"ImageManager":                 2,
 	"ImageMetadata":                3,
 	"ImageMetadataManager":         1,
 	"InstanceMutater":              2,
-	"InstancePoller":               4,
+	"InstancePoller":               5,
 	"KeyManager":                   1,
 	"KeyUpdater":                   1,
+	"LeadershipPinning":            1,
 	"LeadershipService":            2,
 	"LifeFlag":                     1,
 	"LogForwarding":                1,
 	"Logger":                       1,
-	"MachineActions":               1,
-	"MachineManager":               6,
+	"LogNoise":                     1,
+	"MachineActions":               2,
+	"MachineManager":               11,
 	"MachineUndertaker":            1,
-	"Machiner":                     5,
+	"Machiner":                     6,
 	"MeterStatus":                  2,
 	"MetricsAdder":                 2,
 	"MetricsDebug":                 2,
 	"MetricsManager":               1,
 	"MigrationFlag":                1,
-	"MigrationMaster":              3,
+	"MigrationMaster":              4,
 	"MigrationMinion":              1,
 	"MigrationStatusWatcher":       1,
 	"MigrationTarget":              1,
-	"ModelConfig":                  2,
-	"ModelGeneration":              4,
-	"ModelManager":                 9,
+	"ModelConfig":                  4,
+	"ModelGeneration":              5,
+	"ModelManager":                 10,
+	"ModelMetadata":                1,
 	"ModelSummaryWatcher":          1,
 	"ModelUpgrader":                1,
 	"NotifyWatcher":                1,
@@ -100,27 +108,32 @@ var facadeVersions = map[string]int{
 	"RelationUnitsWatcher":         1,
 	"RemoteRelations":              2,
 	"RemoteRelationWatcher":        1,
+	"RequestCanceller":             1,
 	"Resources":                    2,
 	"ResourcesHookContext":         1,
 	"Resumer":                      2,
 	"RetryStrategy":                1,
 	"SecretsRotationWatcher":       1,
 	"Secrets":                      1,
+	"ServiceAccounts":              1,
 	"SecretsManager":               1,
 	"Singular":                     2,
-	"Spaces":                       6,
+	"Spaces":                       7,
 	"SSHClient":                    2,
 	"StatusHistory":                2,
-	"Storage":                      6,
+	"Storage":                      7,
 	"StorageProvisioner":           4,
 	"StringsWatcher":               1,
 	"Subnets":                      4,
+	"Telemetry":                    1,
 	"Undertaker":                   1,
 	"UnitAssigner":                 1,
-	"Uniter":                       18,
+	"UnitMover":                    1,
+	"Uniter":                       19,
 	"Upgrader":                     1,
 	"UpgradeSeries":                3,
 	"UpgradeSteps":                 2,
+	"UserLeases":                   1,
 	"UserManager":                  2,
 	"VolumeAttachmentsWatcher":     2,
 	"VolumeAttachmentPlansWatcher": 1,
@@ -258,6 +258,26 @@ func (c *Client) FirewallRules(knownServices ...string) ([]params.FirewallRule,
 	return results.Rules, nil
 }
 
+// WatchFirewallRules returns a StringsWatcher that notifies of changes
+// to the firewall rules for the model.
+func (c *Client) WatchFirewallRules() (watcher.StringsWatcher, error) {
+	if c.BestAPIVersion() < 8 {
+		// WatchFirewallRules() was introduced in FirewallerAPIV8.
+		return nil, errors.NotImplementedf("WatchFirewallRules() (need V8+)")
+	}
+
+	var result params.StringsWatchResult
+	err := c.facade.FacadeCall("WatchFirewallRules", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	if err := result.Error; err != nil {
+		return nil, result.Error
+	}
+	w := apiwatcher.NewStringsWatcher(c.facade.RawAPICaller(), result)
+	return w, nil
+}
+
 // AllSpaceInfos returns the details about the known spaces and their
 // associated subnets.
 func (c *Client) AllSpaceInfos() (network.SpaceInfos, error) {
@@ -44,6 +44,31 @@ func (c *Client) SetFirewallRule(service string, whiteListCidrs []string) error
 	return results.OneError()
 }
 
+// SetFirewallRuleEnabled enables or disables a firewall rule. A disabled
+// rule is retained, but no longer applied.
+func (c *Client) SetFirewallRuleEnabled(service string, enabled bool) error {
+	if c.BestAPIVersion() < 2 {
+		return errors.NewNotSupported(nil, "enabling or disabling firewall rules is not supported by this version of Juju")
+	}
+	serviceValue := params.KnownServiceValue(service)
+	if err := serviceValue.Validate(); err != nil {
+		return errors.Trace(err)
+	}
+
+	args := params.FirewallRulesEnabledArgs{
+		Args: []params.FirewallRuleEnabled{
+			{
+				KnownService: serviceValue,
+				Enabled:      enabled,
+			}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall("SetFirewallRulesEnabled", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
 // ListFirewallRules returns all the firewall rules.
 func (c *Client) ListFirewallRules() ([]params.FirewallRule, error) {
 	var results params.ListFirewallRulesResults
@@ -52,3 +77,21 @@ func (c *Client) ListFirewallRules() ([]params.FirewallRule, error) {
 	}
 	return results.Rules, nil
 }
+
+// ListFirewallRulesHistory returns the recorded change history of all
+// the firewall rules.
+func (c *Client) ListFirewallRulesHistory() ([]params.FirewallRuleHistory, error) {
+	if c.BestAPIVersion() < 2 {
+		return nil, errors.NewNotSupported(nil, "firewall rule history is not supported by this version of Juju")
+	}
+	args := params.KnownServiceArgs{
+		KnownServices: []params.KnownServiceValue{
+			params.SSHRule, params.JujuControllerRule, params.JujuApplicationOfferRule,
+		},
+	}
+	var results params.ListFirewallRulesHistoryResults
+	if err := c.facade.FacadeCall("FirewallRulesHistory", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return results.Rules, nil
+}
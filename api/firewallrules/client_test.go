@@ -86,6 +86,59 @@ func (s *FirewallRulesSuite) TestSetFirewallRuleInvalid(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, `known service "foo" not valid`)
 }
 
+func (s *FirewallRulesSuite) TestSetFirewallRuleEnabled(c *gc.C) {
+	called := false
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string,
+				version int,
+				id, request string,
+				a, result interface{},
+			) error {
+				c.Check(objType, gc.Equals, "FirewallRules")
+				c.Check(id, gc.Equals, "")
+				c.Check(request, gc.Equals, "SetFirewallRulesEnabled")
+
+				args, ok := a.(params.FirewallRulesEnabledArgs)
+				c.Assert(ok, jc.IsTrue)
+				c.Assert(args.Args, gc.HasLen, 1)
+				c.Assert(args.Args[0].KnownService, gc.Equals, params.SSHRule)
+				c.Assert(args.Args[0].Enabled, jc.IsFalse)
+
+				called = true
+				if results, ok := result.(*params.ErrorResults); ok {
+					results.Results = []params.ErrorResult{{}}
+				}
+				return nil
+			}),
+		BestVersion: 2,
+	}
+
+	client := firewallrules.NewClient(apiCaller)
+	err := client.SetFirewallRuleEnabled("ssh", false)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(called, jc.IsTrue)
+}
+
+func (s *FirewallRulesSuite) TestSetFirewallRuleEnabledNotSupported(c *gc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string,
+				version int,
+				id, request string,
+				a, result interface{},
+			) error {
+				c.Fail()
+				return errors.New("unexpected")
+			}),
+		BestVersion: 1,
+	}
+
+	client := firewallrules.NewClient(apiCaller)
+	err := client.SetFirewallRuleEnabled("ssh", false)
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+}
+
 func (s *FirewallRulesSuite) TestList(c *gc.C) {
 	called := false
 	apiCaller := basetesting.APICallerFunc(
@@ -120,6 +173,66 @@ func (s *FirewallRulesSuite) TestList(c *gc.C) {
 	}})
 }
 
+func (s *FirewallRulesSuite) TestListHistory(c *gc.C) {
+	called := false
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string,
+				version int,
+				id, request string,
+				a, result interface{},
+			) error {
+				c.Check(objType, gc.Equals, "FirewallRules")
+				c.Check(id, gc.Equals, "")
+				c.Check(request, gc.Equals, "FirewallRulesHistory")
+
+				called = true
+				if results, ok := result.(*params.ListFirewallRulesHistoryResults); ok {
+					results.Rules = []params.FirewallRuleHistory{{
+						KnownService: params.SSHRule,
+						Changes: []params.FirewallRuleChange{{
+							WhitelistCIDRS: []string{"192.168.1.0/32"},
+							UpdatedBy:      "fred",
+						}},
+					}}
+				}
+				return nil
+			}),
+		BestVersion: 2,
+	}
+
+	client := firewallrules.NewClient(apiCaller)
+	results, err := client.ListFirewallRulesHistory()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(called, jc.IsTrue)
+	c.Assert(results, jc.DeepEquals, []params.FirewallRuleHistory{{
+		KnownService: params.SSHRule,
+		Changes: []params.FirewallRuleChange{{
+			WhitelistCIDRS: []string{"192.168.1.0/32"},
+			UpdatedBy:      "fred",
+		}},
+	}})
+}
+
+func (s *FirewallRulesSuite) TestListHistoryNotSupported(c *gc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string,
+				version int,
+				id, request string,
+				a, result interface{},
+			) error {
+				c.Fail()
+				return errors.New("unexpected")
+			}),
+		BestVersion: 1,
+	}
+
+	client := firewallrules.NewClient(apiCaller)
+	_, err := client.ListFirewallRulesHistory()
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+}
+
 func (s *FirewallRulesSuite) TestListError(c *gc.C) {
 	called := false
 	apiCaller := basetesting.APICallerFunc(
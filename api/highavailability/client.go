@@ -27,14 +27,32 @@ func NewClient(caller base.APICallCloser) *Client {
 func (c *Client) EnableHA(
 	numControllers int, cons constraints.Value, placement []string,
 ) (params.ControllersChanges, error) {
+	return c.enableHA(params.ControllersSpec{
+		NumControllers: numControllers,
+		Constraints:    cons,
+		Placement:      placement,
+	})
+}
+
+// EnableHAReplacing is like EnableHA, but additionally demotes the named
+// dead controller machine so that a replacement is added in its place.
+func (c *Client) EnableHAReplacing(
+	numControllers int, cons constraints.Value, placement []string, replaceMachine string,
+) (params.ControllersChanges, error) {
+	if c.BestAPIVersion() < 3 {
+		return params.ControllersChanges{}, errors.New("this controller version doesn't support replacing a controller machine")
+	}
+	return c.enableHA(params.ControllersSpec{
+		NumControllers: numControllers,
+		Constraints:    cons,
+		Placement:      placement,
+		Replace:        replaceMachine,
+	})
+}
 
+func (c *Client) enableHA(spec params.ControllersSpec) (params.ControllersChanges, error) {
 	var results params.ControllersChangeResults
-	arg := params.ControllersSpecs{
-		Specs: []params.ControllersSpec{{
-			NumControllers: numControllers,
-			Constraints:    cons,
-			Placement:      placement,
-		}}}
+	arg := params.ControllersSpecs{Specs: []params.ControllersSpec{spec}}
 
 	err := c.facade.FacadeCall("EnableHA", arg, &results)
 	if err != nil {
@@ -152,6 +152,33 @@ func (m *Machine) SetInstanceStatus(status status.Status, message string, data m
 	return result.OneError()
 }
 
+// SetHardwareCharacteristics replaces the recorded hardware
+// characteristics for this machine with the values reported by the
+// provider.
+func (m *Machine) SetHardwareCharacteristics(hc instance.HardwareCharacteristics) error {
+	var result params.ErrorResults
+	args := params.SetMachinesHardware{
+		MachineHardware: []params.MachineHardwareArg{{
+			Tag: m.tag.String(),
+			Hardware: params.MachineHardware{
+				Arch:             hc.Arch,
+				Mem:              hc.Mem,
+				RootDisk:         hc.RootDisk,
+				RootDiskSource:   hc.RootDiskSource,
+				Cores:            hc.CpuCores,
+				CpuPower:         hc.CpuPower,
+				Tags:             hc.Tags,
+				AvailabilityZone: hc.AvailabilityZone,
+			},
+		}},
+	}
+	err := m.facade.FacadeCall("SetHardwareCharacteristics", args, &result)
+	if err != nil {
+		return err
+	}
+	return result.OneError()
+}
+
 // SetProviderNetworkConfig updates the provider addresses for this machine.
 func (m *Machine) SetProviderNetworkConfig(ifList network.InterfaceInfos) (network.ProviderAddresses, bool, error) {
 	var results params.SetProviderNetworkConfigResults
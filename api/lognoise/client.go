@@ -0,0 +1,40 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package lognoise
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/apiserver/params"
+)
+
+// Client allows access to the log noise API end point.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the log noise API.
+func NewClient(st base.APICallCloser) *Client {
+	frontend, backend := base.NewClientFacade(st, "LogNoise")
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// Report returns up to limit agents in the current model ordered by
+// the number of log messages each has written over the given window,
+// noisiest first.
+func (c *Client) Report(window time.Duration, limit int) ([]params.NoisyAgent, error) {
+	args := params.LogNoiseRequest{
+		Window: window,
+		Limit:  limit,
+	}
+	var result params.LogNoiseResult
+	if err := c.facade.FacadeCall("Report", args, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return result.Agents, nil
+}
@@ -132,6 +132,27 @@ func (c *Client) ActionFinish(tag names.ActionTag, status string, actionResults
 	return results.OneError()
 }
 
+// ActionLog records a progress message against a running action. Messages
+// are silently dropped when the controller does not support them, since
+// losing a progress message never warrants failing the action itself.
+func (c *Client) ActionLog(tag names.ActionTag, message string) error {
+	if c.facade.BestAPIVersion() < 2 {
+		return nil
+	}
+
+	var results params.ErrorResults
+	args := params.ActionMessageParams{
+		Messages: []params.EntityString{{Tag: tag.String(), Value: message}},
+	}
+
+	err := c.facade.FacadeCall("LogActionsMessages", args, &results)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	return results.OneError()
+}
+
 // RunningActions returns a list of actions running for the given machine tag.
 func (c *Client) RunningActions(agent names.MachineTag) ([]params.ActionResult, error) {
 	var results params.ActionsByReceivers
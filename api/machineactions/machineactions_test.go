@@ -207,6 +207,53 @@ func (s *ClientSuite) TestActionBeginTooManyResults(c *gc.C) {
 	stub.CheckCalls(c, expectedCalls)
 }
 
+func (s *ClientSuite) TestActionLogSuccess(c *gc.C) {
+	tag := names.NewActionTag(utils.MustNewUUID().String())
+	message := "stubmsg"
+	expectedCalls := []jujutesting.StubCall{{
+		"MachineActions.LogActionsMessages",
+		[]interface{}{"", params.ActionMessageParams{
+			Messages: []params.EntityString{{Tag: tag.String(), Value: message}},
+		}},
+	}}
+	var stub jujutesting.Stub
+
+	apiCaller := apitesting.BestVersionCaller{
+		APICallerFunc: apitesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+			stub.AddCall(objType+"."+request, id, arg)
+			c.Check(result, gc.FitsTypeOf, &params.ErrorResults{})
+			*(result.(*params.ErrorResults)) = params.ErrorResults{
+				Results: []params.ErrorResult{{}},
+			}
+			return nil
+		}),
+		BestVersion: 2,
+	}
+
+	client := machineactions.NewClient(apiCaller)
+	err := client.ActionLog(tag, message)
+	c.Assert(err, jc.ErrorIsNil)
+	stub.CheckCalls(c, expectedCalls)
+}
+
+func (s *ClientSuite) TestActionLogOldController(c *gc.C) {
+	tag := names.NewActionTag(utils.MustNewUUID().String())
+	var stub jujutesting.Stub
+
+	apiCaller := apitesting.BestVersionCaller{
+		APICallerFunc: apitesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+			stub.AddCall(objType+"."+request, id, arg)
+			return nil
+		}),
+		BestVersion: 1,
+	}
+
+	client := machineactions.NewClient(apiCaller)
+	err := client.ActionLog(tag, "stubmsg")
+	c.Assert(err, jc.ErrorIsNil)
+	stub.CheckCalls(c, nil)
+}
+
 func (s *ClientSuite) TestActionFinishSuccess(c *gc.C) {
 	tag := names.NewActionTag(utils.MustNewUUID().String())
 	status := "stubstatus"
@@ -265,3 +265,168 @@ func (client *Client) GetUpgradeSeriesMessages(machineName, watcherId string) ([
 
 	return result.Result, nil
 }
+
+// ReenrollMachine issues fresh agent credentials for the named
+// manually provisioned machine and returns the new password, so the
+// agent can be re-installed against the existing machine entity.
+func (client *Client) ReenrollMachine(machineName string) (string, error) {
+	if client.BestAPIVersion() < 7 {
+		return "", errors.NotSupportedf("ReenrollMachine")
+	}
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: names.NewMachineTag(machineName).String()}},
+	}
+	var results params.ReenrollMachineResults
+	if err := client.facade.FacadeCall("ReenrollMachine", args, &results); err != nil {
+		return "", errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return "", errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return "", result.Error
+	}
+	return result.Password, nil
+}
+
+// SetMaintenanceWindows replaces the scheduled maintenance windows for
+// the entity with the given tag - a machine, or the model for
+// model-wide windows. Passing no windows removes any existing ones.
+func (client *Client) SetMaintenanceWindows(tag names.Tag, windows []params.MaintenanceWindow) error {
+	if client.BestAPIVersion() < 8 {
+		return errors.NotSupportedf("SetMaintenanceWindows")
+	}
+	args := params.SetMaintenanceWindowsArgs{
+		Args: []params.EntityMaintenanceWindows{{
+			Tag:     tag.String(),
+			Windows: windows,
+		}},
+	}
+	var results params.ErrorResults
+	if err := client.facade.FacadeCall("SetMaintenanceWindows", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// MaintenanceWindows returns the scheduled maintenance windows for the
+// entity with the given tag - a machine, or the model for model-wide
+// windows.
+func (client *Client) MaintenanceWindows(tag names.Tag) ([]params.MaintenanceWindow, error) {
+	if client.BestAPIVersion() < 8 {
+		return nil, errors.NotSupportedf("MaintenanceWindows")
+	}
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: tag.String()}},
+	}
+	var results params.MaintenanceWindowsResults
+	if err := client.facade.FacadeCall("MaintenanceWindows", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return result.Windows, nil
+}
+
+// SetDisabledWorkers replaces the set of agent workers disabled for the
+// entity with the given tag - a machine, or the model to disable a
+// worker everywhere. Passing no workers re-enables everything.
+func (client *Client) SetDisabledWorkers(tag names.Tag, workers []string) error {
+	if client.BestAPIVersion() < 9 {
+		return errors.NotSupportedf("SetDisabledWorkers")
+	}
+	args := params.SetDisabledWorkersArgs{
+		Args: []params.EntityDisabledWorkers{{
+			Tag:     tag.String(),
+			Workers: workers,
+		}},
+	}
+	var results params.ErrorResults
+	if err := client.facade.FacadeCall("SetDisabledWorkers", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// DisabledWorkers returns the agent workers disabled for the entity
+// with the given tag - a machine, or the model for workers disabled
+// model-wide.
+func (client *Client) DisabledWorkers(tag names.Tag) ([]string, error) {
+	if client.BestAPIVersion() < 9 {
+		return nil, errors.NotSupportedf("DisabledWorkers")
+	}
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: tag.String()}},
+	}
+	var results params.DisabledWorkersResults
+	if err := client.facade.FacadeCall("DisabledWorkers", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return result.Workers, nil
+}
+
+// RefreshHardware asks the controller to re-read the hardware
+// characteristics of the given machines from the provider and record
+// them in the model.
+func (client *Client) RefreshHardware(machines []names.MachineTag) ([]error, error) {
+	if client.BestAPIVersion() < 10 {
+		return nil, errors.NotSupportedf("RefreshHardware")
+	}
+	args := params.Entities{
+		Entities: make([]params.Entity, len(machines)),
+	}
+	for i, tag := range machines {
+		args.Entities[i] = params.Entity{Tag: tag.String()}
+	}
+	var results params.ErrorResults
+	if err := client.facade.FacadeCall("RefreshHardware", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != len(machines) {
+		return nil, errors.Errorf("expected %d results, got %d", len(machines), len(results.Results))
+	}
+	errs := make([]error, len(results.Results))
+	for i, result := range results.Results {
+		if result.Error != nil {
+			errs[i] = result.Error
+		}
+	}
+	return errs, nil
+}
+
+// ConsoleOutput returns the provider console output for the given
+// machine, so boot failures can be debugged without locating the
+// instance in the cloud console.
+func (client *Client) ConsoleOutput(machine names.MachineTag) (string, error) {
+	if client.BestAPIVersion() < 11 {
+		return "", errors.NotSupportedf("ConsoleOutput")
+	}
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: machine.String()}},
+	}
+	var results params.StringResults
+	if err := client.facade.FacadeCall("ConsoleOutput", args, &results); err != nil {
+		return "", errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return "", errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return "", result.Error
+	}
+	return result.Result, nil
+}
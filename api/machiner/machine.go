@@ -159,3 +159,21 @@ func (m *Machine) RecordAgentStartInformation(hostname string) error {
 	}
 	return result.OneError()
 }
+
+// SetOSInfo reports the machine's kernel version, OS patch level and
+// reboot-required flag. It is a no-op when connected to an older
+// controller that does not support recording OS information.
+func (m *Machine) SetOSInfo(info params.MachineOSInfo) error {
+	if m.st.facade.BestAPIVersion() < 6 {
+		return nil
+	}
+	info.Tag = m.tag.String()
+	args := params.SetMachineOSInfoArgs{
+		Args: []params.MachineOSInfo{info},
+	}
+	var result params.ErrorResults
+	if err := m.st.facade.FacadeCall("SetOSInfo", args, &result); err != nil {
+		return err
+	}
+	return result.OneError()
+}
@@ -69,6 +69,22 @@ func (c *Client) ModelUnset(keys ...string) error {
 	return c.facade.FacadeCall("ModelUnset", args, nil)
 }
 
+// ApplyModelConfig replaces the model-managed settings with the given
+// set, resetting keys that are absent from it, and returns a diff of
+// what changed. The whole set is validated and applied atomically.
+func (c *Client) ApplyModelConfig(config map[string]interface{}) (params.ModelConfigDiffResult, error) {
+	var result params.ModelConfigDiffResult
+	if c.BestAPIVersion() < 4 {
+		return result, errors.NotSupportedf("ApplyModelConfig on v%d facade", c.BestAPIVersion())
+	}
+	args := params.ModelConfigApplyArgs{Config: config}
+	err := c.facade.FacadeCall("ApplyModelConfig", args, &result)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	return result, nil
+}
+
 // SetSLALevel sets the support level for the given model.
 func (c *Client) SetSLALevel(level, owner string, creds []byte) error {
 	args := params.ModelSLA{
@@ -91,6 +107,28 @@ func (c *Client) SLALevel() (string, error) {
 	return result.Result, nil
 }
 
+// FeatureFlags returns the names of the feature flags enabled on the model.
+func (c *Client) FeatureFlags() ([]string, error) {
+	if c.BestAPIVersion() < 3 {
+		return nil, errors.NotSupportedf("FeatureFlags on v%d facade", c.BestAPIVersion())
+	}
+	var result params.ModelFeatureFlagsResult
+	err := c.facade.FacadeCall("FeatureFlags", nil, &result)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return result.Flags, nil
+}
+
+// SetFeatureFlag enables or disables the named feature flag on the model.
+func (c *Client) SetFeatureFlag(flag string, enabled bool) error {
+	if c.BestAPIVersion() < 3 {
+		return errors.NotSupportedf("SetFeatureFlag on v%d facade", c.BestAPIVersion())
+	}
+	args := params.ModelFeatureFlag{Flag: flag, Enabled: enabled}
+	return c.facade.FacadeCall("SetFeatureFlag", args, nil)
+}
+
 // Sequences returns all sequence names and next values.
 func (c *Client) Sequences() (map[string]int, error) {
 	if c.BestAPIVersion() < 2 {
@@ -54,6 +54,40 @@ func (c *Client) AbortBranch(branchName string) error {
 	return nil
 }
 
+// RevertBranch aborts an existing branch, reverting any units tracking it
+// to the configuration of the current model generation.
+func (c *Client) RevertBranch(branchName string) error {
+	var result params.ErrorResult
+	err := c.facade.FacadeCall("RevertBranch", argForBranch(branchName), &result)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if result.Error != nil {
+		return errors.Trace(result.Error)
+	}
+	return nil
+}
+
+// StageCharmRevision stages an upgrade of the application's charm to the
+// given revision under the branch. The upgrade is applied when the branch
+// is committed.
+func (c *Client) StageCharmRevision(branchName, appName string, revision int) error {
+	var result params.ErrorResult
+	arg := params.BranchCharmRevisionArg{
+		BranchName:      branchName,
+		ApplicationName: appName,
+		Revision:        revision,
+	}
+	err := c.facade.FacadeCall("StageCharmRevision", arg, &result)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if result.Error != nil {
+		return errors.Trace(result.Error)
+	}
+	return nil
+}
+
 // CommitBranch commits the branch with the input name to the model,
 // effectively completing it and applying all branch changes across the model.
 // The new generation ID of the model is returned.
@@ -68,6 +68,34 @@ func (s *modelGenerationSuite) TestAbortBranch(c *gc.C) {
 	c.Assert(err, gc.IsNil)
 }
 
+func (s *modelGenerationSuite) TestRevertBranch(c *gc.C) {
+	defer s.setUpMocks(c).Finish()
+
+	resultSource := params.ErrorResult{}
+	arg := params.BranchArg{BranchName: s.branchName}
+	s.fCaller.EXPECT().FacadeCall("RevertBranch", arg, gomock.Any()).SetArg(2, resultSource).Return(nil)
+
+	api := modelgeneration.NewStateFromCaller(s.fCaller)
+	err := api.RevertBranch(s.branchName)
+	c.Assert(err, gc.IsNil)
+}
+
+func (s *modelGenerationSuite) TestStageCharmRevision(c *gc.C) {
+	defer s.setUpMocks(c).Finish()
+
+	resultSource := params.ErrorResult{}
+	arg := params.BranchCharmRevisionArg{
+		BranchName:      s.branchName,
+		ApplicationName: "mysql",
+		Revision:        3,
+	}
+	s.fCaller.EXPECT().FacadeCall("StageCharmRevision", arg, gomock.Any()).SetArg(2, resultSource).Return(nil)
+
+	api := modelgeneration.NewStateFromCaller(s.fCaller)
+	err := api.StageCharmRevision(s.branchName, "mysql", 3)
+	c.Assert(err, gc.IsNil)
+}
+
 func (s *modelGenerationSuite) TestTrackBranchSuccess(c *gc.C) {
 	defer s.setUpMocks(c).Finish()
 
@@ -583,6 +583,56 @@ func (c *Client) UnsetModelDefaults(cloud, region string, keys ...string) error
 	return result.OneError()
 }
 
+// SetRegionStoragePoolDefaults updates the default storage pools created
+// in new models on the given cloud region. Each entry in update adds or
+// replaces the definition of the named pool; each name in remove drops it.
+func (c *Client) SetRegionStoragePoolDefaults(cloud, region string, update map[string]map[string]interface{}, remove ...string) error {
+	if bestVer := c.BestAPIVersion(); bestVer < 10 {
+		return errors.NotImplementedf("SetRegionStoragePoolDefaults in version %v", bestVer)
+	}
+	args := params.SetRegionStoragePoolDefaults{
+		Defaults: []params.RegionStoragePoolDefaults{{
+			CloudTag:    names.NewCloudTag(cloud).String(),
+			CloudRegion: region,
+			Update:      update,
+			Remove:      remove,
+		}},
+	}
+	var result params.ErrorResults
+	err := c.facade.FacadeCall("SetRegionStoragePoolDefaults", args, &result)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return result.OneError()
+}
+
+// RegionStoragePoolDefaults returns the default storage pools created in
+// new models on the given cloud region, keyed by pool name.
+func (c *Client) RegionStoragePoolDefaults(cloud, region string) (map[string]map[string]interface{}, error) {
+	if bestVer := c.BestAPIVersion(); bestVer < 10 {
+		return nil, errors.NotImplementedf("RegionStoragePoolDefaults in version %v", bestVer)
+	}
+	args := params.RegionStoragePoolDefaultsSpecs{
+		Specs: []params.RegionStoragePoolDefaultsSpec{{
+			CloudTag:    names.NewCloudTag(cloud).String(),
+			CloudRegion: region,
+		}},
+	}
+	var results params.RegionStoragePoolDefaultsResults
+	err := c.facade.FacadeCall("RegionStoragePoolDefaults", args, &results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, errors.Trace(result.Error)
+	}
+	return result.Pools, nil
+}
+
 // ChangeModelCredential replaces cloud credential for a given model with the provided one.
 func (c *Client) ChangeModelCredential(model names.ModelTag, credential names.CloudCredentialTag) error {
 	if bestVer := c.BestAPIVersion(); bestVer < 5 {
@@ -411,6 +411,80 @@ func (s *modelmanagerSuite) TestUnsetModelDefaults(c *gc.C) {
 	c.Assert(called, jc.IsTrue)
 }
 
+func (s *modelmanagerSuite) TestSetRegionStoragePoolDefaults(c *gc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		BestVersion: 10,
+		APICallerFunc: func(objType string, version int, id, request string, arg, result interface{}) error {
+			c.Check(objType, gc.Equals, "ModelManager")
+			c.Check(id, gc.Equals, "")
+			c.Check(request, gc.Equals, "SetRegionStoragePoolDefaults")
+			c.Check(arg, jc.DeepEquals, params.SetRegionStoragePoolDefaults{
+				Defaults: []params.RegionStoragePoolDefaults{{
+					CloudTag:    "cloud-mycloud",
+					CloudRegion: "region",
+					Update: map[string]map[string]interface{}{
+						"fast": {"type": "ebs", "volume-type": "io1"},
+					},
+					Remove: []string{"slow"},
+				}}})
+			c.Assert(result, gc.FitsTypeOf, &params.ErrorResults{})
+			*(result.(*params.ErrorResults)) = params.ErrorResults{
+				Results: []params.ErrorResult{{Error: nil}},
+			}
+			return nil
+		},
+	}
+	client := modelmanager.NewClient(apiCaller)
+	err := client.SetRegionStoragePoolDefaults("mycloud", "region", map[string]map[string]interface{}{
+		"fast": {"type": "ebs", "volume-type": "io1"},
+	}, "slow")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *modelmanagerSuite) TestSetRegionStoragePoolDefaultsOldVersionFails(c *gc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		BestVersion: 9,
+		APICallerFunc: func(objType string, version int, id, request string, arg, result interface{}) error {
+			c.Fail()
+			return nil
+		},
+	}
+	client := modelmanager.NewClient(apiCaller)
+	err := client.SetRegionStoragePoolDefaults("mycloud", "region", nil)
+	c.Assert(err, gc.ErrorMatches, "SetRegionStoragePoolDefaults in version 9 not implemented")
+}
+
+func (s *modelmanagerSuite) TestRegionStoragePoolDefaults(c *gc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		BestVersion: 10,
+		APICallerFunc: func(objType string, version int, id, request string, arg, result interface{}) error {
+			c.Check(objType, gc.Equals, "ModelManager")
+			c.Check(id, gc.Equals, "")
+			c.Check(request, gc.Equals, "RegionStoragePoolDefaults")
+			c.Check(arg, jc.DeepEquals, params.RegionStoragePoolDefaultsSpecs{
+				Specs: []params.RegionStoragePoolDefaultsSpec{{
+					CloudTag:    "cloud-mycloud",
+					CloudRegion: "region",
+				}}})
+			c.Assert(result, gc.FitsTypeOf, &params.RegionStoragePoolDefaultsResults{})
+			*(result.(*params.RegionStoragePoolDefaultsResults)) = params.RegionStoragePoolDefaultsResults{
+				Results: []params.RegionStoragePoolDefaultsResult{{
+					Pools: map[string]map[string]interface{}{
+						"fast": {"type": "ebs", "volume-type": "io1"},
+					},
+				}},
+			}
+			return nil
+		},
+	}
+	client := modelmanager.NewClient(apiCaller)
+	pools, err := client.RegionStoragePoolDefaults("mycloud", "region")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(pools, jc.DeepEquals, map[string]map[string]interface{}{
+		"fast": {"type": "ebs", "volume-type": "io1"},
+	})
+}
+
 func (s *modelmanagerSuite) TestModelStatus(c *gc.C) {
 	apiCaller := basetesting.BestVersionCaller{
 		BestVersion: 4,
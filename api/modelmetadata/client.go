@@ -0,0 +1,34 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelmetadata
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/apiserver/params"
+)
+
+// Client allows access to the model metadata API end point.
+type Client struct {
+	base.ClientFacade
+	st     base.APICallCloser
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the model metadata api.
+func NewClient(st base.APICallCloser) *Client {
+	frontend, backend := base.NewClientFacade(st, "ModelMetadata")
+	return &Client{ClientFacade: frontend, st: st, facade: backend}
+}
+
+// Metadata returns the compact metadata view of the model: names,
+// UUIDs, versions and headline entity counts.
+func (c *Client) Metadata() (params.ModelMetadataResult, error) {
+	var result params.ModelMetadataResult
+	if err := c.facade.FacadeCall("Metadata", nil, &result); err != nil {
+		return result, errors.Trace(err)
+	}
+	return result, nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelmetadata_test
+
+import (
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	basetesting "github.com/juju/juju/api/base/testing"
+	"github.com/juju/juju/api/modelmetadata"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/testing"
+)
+
+type ModelMetadataSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&ModelMetadataSuite{})
+
+func (s *ModelMetadataSuite) TestMetadata(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Check(objType, gc.Equals, "ModelMetadata")
+			c.Check(id, gc.Equals, "")
+			c.Check(request, gc.Equals, "Metadata")
+			c.Check(a, gc.IsNil)
+
+			if res, ok := result.(*params.ModelMetadataResult); ok {
+				res.Name = "prod"
+				res.UUID = testing.ModelTag.Id()
+				res.AgentVersion = "2.9.17"
+				res.ApplicationCount = 3
+				res.UnitCount = 7
+				res.MachineCount = 4
+			}
+			return nil
+		})
+
+	client := modelmetadata.NewClient(apiCaller)
+	result, err := client.Metadata()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.ModelMetadataResult{
+		Name:             "prod",
+		UUID:             testing.ModelTag.Id(),
+		AgentVersion:     "2.9.17",
+		ApplicationCount: 3,
+		UnitCount:        7,
+		MachineCount:     4,
+	})
+}
+
+func (s *ModelMetadataSuite) TestMetadataFacadeCallError(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			return errors.New("facade failure")
+		})
+	client := modelmetadata.NewClient(apiCaller)
+	_, err := client.Metadata()
+	c.Assert(err, gc.ErrorMatches, "facade failure")
+}
@@ -6,10 +6,13 @@ package client
 import (
 	"context"
 	"io"
+	"math/rand"
 	"net/http"
 	"path"
+	"time"
 
 	"github.com/juju/errors"
+	"github.com/juju/loggo"
 
 	api "github.com/juju/juju/api/resources"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
@@ -17,6 +20,31 @@ import (
 	"github.com/juju/juju/resource"
 )
 
+var logger = loggo.GetLogger("juju.api.resources.client")
+
+const (
+	// maxDownloadDeferrals is the number of controller download
+	// deferrals honoured before a resource fetch is failed.
+	maxDownloadDeferrals = 10
+
+	// defaultDownloadDeferralDelay is used when a deferral carries no
+	// usable retry hint.
+	defaultDownloadDeferralDelay = 10 * time.Second
+)
+
+// downloadDeferralDelay returns the retry delay hinted by the
+// controller, with jitter added so that retries from many units do not
+// arrive in lockstep.
+func downloadDeferralDelay(err error) time.Duration {
+	delay := defaultDownloadDeferralDelay
+	if perr, ok := err.(*params.Error); ok {
+		if secs, ok := perr.Info["retry-after-seconds"].(float64); ok && secs > 0 {
+			delay = time.Duration(secs) * time.Second
+		}
+	}
+	return delay + time.Duration(rand.Int63n(int64(delay)/2+1))
+}
+
 // FacadeCaller exposes the raw API caller functionality needed here.
 type FacadeCaller interface {
 	// FacadeCall makes an API request.
@@ -66,12 +94,28 @@ func (c *UnitFacadeClient) GetResource(resourceName string) (resource.Resource,
 	if err != nil {
 		return resource.Resource{}, nil, errors.Annotate(err, "failed to build API request")
 	}
-	if err := c.Do(c.ctx, req, &response); err != nil {
-		return resource.Resource{}, nil, errors.Annotate(err, "HTTP request failed")
+	// The controller defers downloads with a retry hint when its
+	// download limits are reached; honour a bounded number of these
+	// deferrals before giving up.
+	for attempt := 0; ; attempt++ {
+		err = c.Do(c.ctx, req, &response)
+		if err == nil {
+			break
+		}
+		if attempt >= maxDownloadDeferrals || !params.IsCodeRetryAfter(errors.Cause(err)) {
+			return resource.Resource{}, nil, errors.Annotate(err, "HTTP request failed")
+		}
+		delay := downloadDeferralDelay(errors.Cause(err))
+		logger.Debugf("resource download for %q deferred by controller; retrying in %s", resourceName, delay)
+		select {
+		case <-time.After(delay):
+		case <-c.ctx.Done():
+			return resource.Resource{}, nil, errors.Trace(c.ctx.Err())
+		}
 	}
 
 	// HACK(katco): Combine this into one request?
-	resourceInfo, err := c.getResourceInfo(resourceName)
+	resourceInfo, err := c.GetResourceInfo(resourceName)
 	if err != nil {
 		return resource.Resource{}, nil, errors.Trace(err)
 	}
@@ -81,7 +125,9 @@ func (c *UnitFacadeClient) GetResource(resourceName string) (resource.Resource,
 	return resourceInfo, response.Body, nil
 }
 
-func (c *UnitFacadeClient) getResourceInfo(resourceName string) (resource.Resource, error) {
+// GetResourceInfo returns the resource info for the given name without
+// opening the content.
+func (c *UnitFacadeClient) GetResourceInfo(resourceName string) (resource.Resource, error) {
 	var response params.UnitResourcesResult
 
 	args := params.ListUnitResourcesArgs{
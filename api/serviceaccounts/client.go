@@ -0,0 +1,127 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package serviceaccounts
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/apiserver/params"
+)
+
+// Client provides methods that the Juju client command uses to manage
+// service accounts and their tokens on the controller.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new `Client` based on an existing authenticated API
+// connection.
+func NewClient(st base.APICallCloser) *Client {
+	frontend, backend := base.NewClientFacade(st, "ServiceAccounts")
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// AddServiceAccount creates a new service account with the given name
+// and returns its tag.
+func (c *Client) AddServiceAccount(name string) (names.UserTag, error) {
+	if !names.IsValidUserName(name) {
+		return names.UserTag{}, errors.Errorf("invalid service account name %q", name)
+	}
+	args := params.AddServiceAccounts{
+		Accounts: []params.AddServiceAccount{{Name: name}},
+	}
+	var results params.AddServiceAccountResults
+	if err := c.facade.FacadeCall("AddServiceAccounts", args, &results); err != nil {
+		return names.UserTag{}, errors.Trace(err)
+	}
+	if count := len(results.Results); count != 1 {
+		return names.UserTag{}, errors.Errorf("expected 1 result, got %d", count)
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return names.UserTag{}, errors.Trace(result.Error)
+	}
+	tag, err := names.ParseUserTag(result.Tag)
+	if err != nil {
+		return names.UserTag{}, errors.Trace(err)
+	}
+	return tag, nil
+}
+
+// RemoveServiceAccount removes the service account with the given tag,
+// invalidating all of its tokens.
+func (c *Client) RemoveServiceAccount(tag names.UserTag) error {
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: tag.String()}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall("RemoveServiceAccounts", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// IssueToken mints a new token for the named service account, scoped
+// to the supplied model UUIDs and facade names. The token secret is
+// only returned here and cannot be recovered later.
+func (c *Client) IssueToken(name string, models, facades []string, expiry *time.Time) (id, token string, _ error) {
+	args := params.IssueServiceAccountTokens{
+		Tokens: []params.IssueServiceAccountToken{{
+			Name:    name,
+			Models:  models,
+			Facades: facades,
+			Expiry:  expiry,
+		}},
+	}
+	var results params.IssueServiceAccountTokenResults
+	if err := c.facade.FacadeCall("IssueTokens", args, &results); err != nil {
+		return "", "", errors.Trace(err)
+	}
+	if count := len(results.Results); count != 1 {
+		return "", "", errors.Errorf("expected 1 result, got %d", count)
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return "", "", errors.Trace(result.Error)
+	}
+	return result.TokenId, result.Token, nil
+}
+
+// RevokeToken revokes the identified token, immediately invalidating
+// it.
+func (c *Client) RevokeToken(name, tokenID string) error {
+	args := params.RevokeServiceAccountTokens{
+		Tokens: []params.RevokeServiceAccountToken{{
+			Name:    name,
+			TokenId: tokenID,
+		}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall("RevokeTokens", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// ListServiceAccounts returns all service accounts along with their
+// token metadata.
+func (c *Client) ListServiceAccounts() ([]params.ServiceAccountInfo, error) {
+	var results params.ServiceAccountInfoResults
+	if err := c.facade.FacadeCall("ListServiceAccounts", nil, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	var infos []params.ServiceAccountInfo
+	for _, result := range results.Results {
+		if result.Error != nil {
+			return nil, errors.Trace(result.Error)
+		}
+		infos = append(infos, *result.Result)
+	}
+	return infos, nil
+}
@@ -129,6 +129,25 @@ func (api *API) ReloadSpaces() error {
 	return err
 }
 
+// ReconcileSpaces returns a plan describing the differences between the
+// spaces and subnets reported by the provider and those recorded in the
+// model. If apply is true, the plan is also applied.
+func (api *API) ReconcileSpaces(apply bool) (params.ReconcileSpacesResult, error) {
+	var response params.ReconcileSpacesResult
+	if api.facade.BestAPIVersion() < 7 {
+		return response, errors.NewNotSupported(nil, "Controller does not support reconciling spaces")
+	}
+	args := params.ReconcileSpacesArgs{Apply: apply}
+	err := api.facade.FacadeCall("ReconcileSpaces", args, &response)
+	if err != nil {
+		if params.IsCodeNotSupported(err) {
+			return response, errors.NewNotSupported(nil, err.Error())
+		}
+		return response, errors.Trace(err)
+	}
+	return response, nil
+}
+
 // RenameSpace attempts to rename a space from the old name to a new name.
 func (api *API) RenameSpace(oldName string, newName string) error {
 	var response params.ErrorResults
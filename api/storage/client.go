@@ -325,6 +325,41 @@ func (c *Client) Detach(storageIds []string, force *bool, maxWait *time.Duration
 	return results.Results, nil
 }
 
+// Move moves the specified storage instances to units of the same
+// application on the given machine, detaching and reattaching them as
+// required.
+func (c *Client) Move(storageIds []string, machineId string) ([]params.ErrorResult, error) {
+	if c.BestAPIVersion() < 7 {
+		return nil, errors.NotSupportedf("moving storage by this version of Juju")
+	}
+	if !names.IsValidMachine(machineId) {
+		return nil, errors.NotValidf("machine ID %q", machineId)
+	}
+	in := params.MoveStorageParams{
+		Moves: make([]params.MoveStorageParam, len(storageIds)),
+	}
+	for i, storageId := range storageIds {
+		if !names.IsValidStorage(storageId) {
+			return nil, errors.NotValidf("storage ID %q", storageId)
+		}
+		in.Moves[i] = params.MoveStorageParam{
+			StorageTag: names.NewStorageTag(storageId).String(),
+			MachineTag: names.NewMachineTag(machineId).String(),
+		}
+	}
+	out := params.ErrorResults{}
+	if err := c.facade.FacadeCall("MoveStorage", in, &out); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(out.Results) != len(storageIds) {
+		return nil, errors.Errorf(
+			"expected %d result(s), got %d",
+			len(storageIds), len(out.Results),
+		)
+	}
+	return out.Results, nil
+}
+
 // Import imports storage into the model.
 func (c *Client) Import(
 	kind storage.StorageKind,
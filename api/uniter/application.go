@@ -168,3 +168,38 @@ func (s *Application) Status(unitName string) (params.ApplicationStatusResult, e
 func (s *Application) WatchLeadershipSettings() (watcher.NotifyWatcher, error) {
 	return s.st.LeadershipSettings.WatchLeadershipSettings(s.tag.Id())
 }
+
+// WatchCharmSync returns a watcher notifying of changes to the
+// application's charm sync overlay, which holds charm files
+// live-synced by `juju refresh --path --watch`.
+func (s *Application) WatchCharmSync() (watcher.NotifyWatcher, error) {
+	if s.st.facade.BestAPIVersion() < 19 {
+		return nil, errors.NotImplementedf("WatchCharmSync() (need V19+)")
+	}
+	return common.Watch(s.st.facade, "WatchCharmSync", s.st.unitTag)
+}
+
+// CharmSync returns the application's current charm sync overlay
+// generation and files. A zero generation means no files have been
+// synced since the last full charm refresh.
+func (s *Application) CharmSync() (int, []params.CharmSyncFile, error) {
+	if s.st.facade.BestAPIVersion() < 19 {
+		return 0, nil, errors.NotImplementedf("CharmSync() (need V19+)")
+	}
+	var results params.CharmSyncResults
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: s.st.unitTag.String()}},
+	}
+	err := s.st.facade.FacadeCall("CharmSync", args, &results)
+	if err != nil {
+		return 0, nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return 0, nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return 0, nil, result.Error
+	}
+	return result.Generation, result.Files, nil
+}
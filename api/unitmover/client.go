@@ -0,0 +1,65 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package unitmover
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/apiserver/params"
+)
+
+// Client provides access to the unit mover facade, used to reassign
+// units to other machines in the same model.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient returns a new UnitMover client.
+func NewClient(caller base.APICallCloser) *Client {
+	frontend, backend := base.NewClientFacade(caller, "UnitMover")
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// MoveUnit schedules the named unit to be replaced by a new unit of the
+// same application on the given machine. It returns the id of the move
+// operation and the name of the replacement unit.
+func (c *Client) MoveUnit(unitName, machineId string) (moveId, newUnit string, err error) {
+	if !names.IsValidUnit(unitName) {
+		return "", "", errors.NotValidf("unit name %q", unitName)
+	}
+	if !names.IsValidMachine(machineId) {
+		return "", "", errors.NotValidf("machine id %q", machineId)
+	}
+	args := params.MoveUnitsParams{
+		Units: []params.MoveUnitParams{{
+			UnitTag:    names.NewUnitTag(unitName).String(),
+			MachineTag: names.NewMachineTag(machineId).String(),
+		}},
+	}
+	var results params.MoveUnitResults
+	if err := c.facade.FacadeCall("MoveUnits", args, &results); err != nil {
+		return "", "", errors.Trace(err)
+	}
+	if n := len(results.Results); n != 1 {
+		return "", "", errors.Errorf("expected 1 result, got %d", n)
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return "", "", result.Error
+	}
+	return result.MoveId, result.NewUnit, nil
+}
+
+// UnitMoves returns the progress of all unit move operations in the
+// model, oldest first.
+func (c *Client) UnitMoves() ([]params.UnitMoveResult, error) {
+	var results params.UnitMoveResults
+	if err := c.facade.FacadeCall("UnitMoves", nil, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return results.Results, nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package userleases
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/apiserver/params"
+)
+
+// Client allows access to the user leases API end point.
+type Client struct {
+	base.ClientFacade
+	st     base.APICallCloser
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the user leases api.
+func NewClient(st base.APICallCloser) *Client {
+	frontend, backend := base.NewClientFacade(st, "UserLeases")
+	return &Client{ClientFacade: frontend, st: st, facade: backend}
+}
+
+// ClaimLease acquires or extends the named user lease on behalf of the
+// connected entity for the given duration.
+func (c *Client) ClaimLease(name string, duration time.Duration) error {
+	args := params.UserLeaseClaims{
+		Claims: []params.UserLeaseClaim{{
+			Name:     name,
+			Duration: duration,
+		}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall("ClaimLeases", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// ReleaseLease releases the named user lease held by the connected
+// entity.
+func (c *Client) ReleaseLease(name string) error {
+	args := params.UserLeaseNames{Names: []string{name}}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall("ReleaseLeases", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// WaitUntilExpired returns once the named user lease is no longer held.
+func (c *Client) WaitUntilExpired(name string) error {
+	args := params.UserLeaseNames{Names: []string{name}}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall("WaitUntilExpired", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// Leases returns all user leases in the model with their holders.
+func (c *Client) Leases() (map[string]string, error) {
+	var result params.UserLeasesResult
+	if err := c.facade.FacadeCall("Leases", nil, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if result.Error != nil {
+		return nil, errors.Trace(result.Error)
+	}
+	return result.Leases, nil
+}
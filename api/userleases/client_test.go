@@ -0,0 +1,117 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package userleases_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	basetesting "github.com/juju/juju/api/base/testing"
+	"github.com/juju/juju/api/userleases"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/testing"
+)
+
+type UserLeasesSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&UserLeasesSuite{})
+
+func (s *UserLeasesSuite) TestClaimLease(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Check(objType, gc.Equals, "UserLeases")
+			c.Check(id, gc.Equals, "")
+			c.Check(request, gc.Equals, "ClaimLeases")
+
+			args, ok := a.(params.UserLeaseClaims)
+			c.Assert(ok, jc.IsTrue)
+			c.Assert(args, jc.DeepEquals, params.UserLeaseClaims{
+				Claims: []params.UserLeaseClaim{{
+					Name:     "cron-leader",
+					Duration: time.Minute,
+				}},
+			})
+
+			if results, ok := result.(*params.ErrorResults); ok {
+				results.Results = []params.ErrorResult{{}}
+			}
+			return nil
+		})
+
+	client := userleases.NewClient(apiCaller)
+	err := client.ClaimLease("cron-leader", time.Minute)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *UserLeasesSuite) TestReleaseLease(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Check(request, gc.Equals, "ReleaseLeases")
+
+			args, ok := a.(params.UserLeaseNames)
+			c.Assert(ok, jc.IsTrue)
+			c.Assert(args, jc.DeepEquals, params.UserLeaseNames{
+				Names: []string{"cron-leader"},
+			})
+
+			if results, ok := result.(*params.ErrorResults); ok {
+				results.Results = []params.ErrorResult{{}}
+			}
+			return nil
+		})
+
+	client := userleases.NewClient(apiCaller)
+	err := client.ReleaseLease("cron-leader")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *UserLeasesSuite) TestLeases(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Check(request, gc.Equals, "Leases")
+
+			if res, ok := result.(*params.UserLeasesResult); ok {
+				res.Leases = map[string]string{"cron-leader": "user-admin"}
+			}
+			return nil
+		})
+
+	client := userleases.NewClient(apiCaller)
+	leases, err := client.Leases()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(leases, jc.DeepEquals, map[string]string{
+		"cron-leader": "user-admin",
+	})
+}
+
+func (s *UserLeasesSuite) TestClaimLeaseFacadeCallError(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			return errors.New("facade failure")
+		})
+	client := userleases.NewClient(apiCaller)
+	err := client.ClaimLease("cron-leader", time.Minute)
+	c.Assert(err, gc.ErrorMatches, "facade failure")
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/juju/version/v2"
 
 	"github.com/juju/juju/api"
+	"github.com/juju/juju/apiserver/authentication"
 	"github.com/juju/juju/apiserver/common"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
@@ -151,6 +152,14 @@ func (a *admin) login(ctx context.Context, req params.LoginRequest, loginVersion
 		return fail, errors.Trace(err)
 	}
 
+	// Connections authenticated with a facade-scoped service account
+	// token only get access to the facades in the token's scope.
+	if sa, ok := a.root.entity.(*authentication.ServiceAccountEntity); ok {
+		if facades := sa.Token.Facades(); len(facades) > 0 {
+			apiRoot = restrictRoot(apiRoot, serviceAccountFacadesOnly(facades))
+		}
+	}
+
 	var facadeFilters []facadeFilterFunc
 	var modelTag string
 	if authResult.anonymousLogin {
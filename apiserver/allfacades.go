@@ -9,6 +9,7 @@ import (
 	"github.com/juju/errors"
 	"github.com/juju/names/v4"
 
+	"github.com/juju/juju/apiserver/common"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/apiserver/facades/agent/agent"
@@ -19,6 +20,7 @@ import (
 	"github.com/juju/juju/apiserver/facades/agent/credentialvalidator"
 	"github.com/juju/juju/apiserver/facades/agent/deployer"
 	"github.com/juju/juju/apiserver/facades/agent/diskmanager"
+	"github.com/juju/juju/apiserver/facades/agent/dnsconfigurer"
 	"github.com/juju/juju/apiserver/facades/agent/fanconfigurer"
 	"github.com/juju/juju/apiserver/facades/agent/hostkeyreporter"
 	"github.com/juju/juju/apiserver/facades/agent/instancemutater"
@@ -45,8 +47,11 @@ import (
 	"github.com/juju/juju/apiserver/facades/agent/upgradeseries"
 	"github.com/juju/juju/apiserver/facades/agent/upgradesteps"
 	"github.com/juju/juju/apiserver/facades/client/action"
-	"github.com/juju/juju/apiserver/facades/client/annotations" // ModelUser Write
-	"github.com/juju/juju/apiserver/facades/client/application" // ModelUser Write
+	"github.com/juju/juju/apiserver/facades/client/agenthealth"   // ModelUser Read
+	"github.com/juju/juju/apiserver/facades/client/agentpresence" // ModelUser Read
+	"github.com/juju/juju/apiserver/facades/client/annotations"   // ModelUser Write
+	"github.com/juju/juju/apiserver/facades/client/application"   // ModelUser Write
+	"github.com/juju/juju/apiserver/facades/client/applicationmover"
 	"github.com/juju/juju/apiserver/facades/client/applicationoffers"
 	"github.com/juju/juju/apiserver/facades/client/backups" // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/block"   // ModelUser Write
@@ -57,23 +62,29 @@ import (
 	"github.com/juju/juju/apiserver/facades/client/cloud"      // ModelUser Read
 	"github.com/juju/juju/apiserver/facades/client/controller" // ModelUser Admin (although some methods check for read only)
 	"github.com/juju/juju/apiserver/facades/client/credentialmanager"
+	"github.com/juju/juju/apiserver/facades/client/customevents"
 	"github.com/juju/juju/apiserver/facades/client/firewallrules"
 	"github.com/juju/juju/apiserver/facades/client/highavailability" // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/imagemanager"     // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/imagemetadatamanager"
 	"github.com/juju/juju/apiserver/facades/client/keymanager"     // ModelUser Write
+	"github.com/juju/juju/apiserver/facades/client/lognoise"       // ModelUser Read
 	"github.com/juju/juju/apiserver/facades/client/machinemanager" // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/metricsdebug"   // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/modelconfig"    // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/modelgeneration"
-	"github.com/juju/juju/apiserver/facades/client/modelmanager" // ModelUser Write
+	"github.com/juju/juju/apiserver/facades/client/modelmanager"  // ModelUser Write
+	"github.com/juju/juju/apiserver/facades/client/modelmetadata" // ModelUser Read
 	"github.com/juju/juju/apiserver/facades/client/payloads"
 	"github.com/juju/juju/apiserver/facades/client/resources"
 	"github.com/juju/juju/apiserver/facades/client/secrets"
+	"github.com/juju/juju/apiserver/facades/client/serviceaccounts"
 	"github.com/juju/juju/apiserver/facades/client/spaces"    // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/sshclient" // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/storage"
 	"github.com/juju/juju/apiserver/facades/client/subnets"
+	"github.com/juju/juju/apiserver/facades/client/unitmover"
+	"github.com/juju/juju/apiserver/facades/client/userleases"
 	"github.com/juju/juju/apiserver/facades/client/usermanager"
 	"github.com/juju/juju/apiserver/facades/controller/actionpruner"
 	"github.com/juju/juju/apiserver/facades/controller/agenttools"
@@ -142,28 +153,41 @@ func AllFacades() *facade.Registry {
 	reg("ActionPruner", 1, actionpruner.NewAPI)
 	reg("Agent", 2, agent.NewAgentAPIV2)
 	reg("Agent", 3, agent.NewAgentAPIV3)
+	reg("Agent", 4, agent.NewAgentAPIV4) // Adds RecordHealth.
+	reg("AgentHealth", 1, agenthealth.NewAPI)
+	reg("AgentPresence", 1, agentpresence.NewFacade)
 	reg("AgentTools", 1, agenttools.NewFacade)
 	reg("Annotations", 2, annotations.NewAPI)
 
 	reg("Application", 13, application.NewFacadeV13)
+	reg("Application", 14, application.NewFacadeV14)
+	reg("Application", 15, application.NewFacadeV15) // Adds staged config changesets and config history.
+	reg("Application", 16, application.NewFacadeV16) // Adds ResolveUnitErrorsBySelector.
+	reg("Application", 17, application.NewFacadeV17) // Adds SyncCharmFiles.
 
+	reg("ApplicationMover", 1, applicationmover.NewFacade)
 	reg("ApplicationOffers", 1, applicationoffers.NewOffersAPI)
 	reg("ApplicationOffers", 2, applicationoffers.NewOffersAPIV2)
 	reg("ApplicationOffers", 3, applicationoffers.NewOffersAPIV3) // Add user to consume offers details  args.
 	reg("ApplicationScaler", 1, applicationscaler.NewAPI)
 	reg("Backups", 3, backups.NewFacadeV3)
+	reg("Backups", 4, backups.NewFacadeV4) // Adds remote storage listing and pruning.
 	reg("Block", 2, block.NewAPI)
 	reg("Bundle", 1, bundle.NewFacadeV1)
 	reg("Bundle", 2, bundle.NewFacadeV2)
 	reg("Bundle", 3, bundle.NewFacadeV3)
 	reg("Bundle", 4, bundle.NewFacadeV4)
 	reg("Bundle", 5, bundle.NewFacadeV5)
+	reg("Bundle", 6, bundle.NewFacadeV6)
+	reg("Bundle", 7, bundle.NewFacadeV7)
 	reg("CharmHub", 1, charmhub.NewFacade)
 	reg("CharmDownloader", 1, charmdownloader.NewFacadeV1)
 	reg("CharmRevisionUpdater", 2, charmrevisionupdater.NewCharmRevisionUpdaterAPI)
 	reg("Charms", 2, charms.NewFacadeV2)
 	reg("Charms", 3, charms.NewFacadeV3)
 	reg("Charms", 4, charms.NewFacadeV4)
+	reg("Charms", 5, charms.NewFacadeV5) // Adds CharmDiff.
+	reg("Charms", 6, charms.NewFacadeV6) // Adds SupportMatrix.
 	reg("Cleaner", 2, cleaner.NewCleanerAPI)
 	reg("Client", 1, client.NewFacadeV1)
 	reg("Client", 2, client.NewFacadeV2)
@@ -204,21 +228,27 @@ func AllFacades() *facade.Registry {
 	reg("CrossModelRelations", 1, crossmodelrelations.NewStateCrossModelRelationsAPIV1)
 	reg("CrossModelRelations", 2, crossmodelrelations.NewStateCrossModelRelationsAPI) // Adds WatchRelationChanges, removes WatchRelationUnits
 	reg("CrossController", 1, crosscontroller.NewStateCrossControllerAPI)
-	reg("CredentialManager", 1, credentialmanager.NewCredentialManagerAPI)
+	reg("CredentialManager", 1, credentialmanager.NewCredentialManagerAPIv1)
+	reg("CredentialManager", 2, credentialmanager.NewCredentialManagerAPI) // adds staged credential rotation
+	reg("CustomEvents", 1, customevents.NewFacade)
 	reg("CredentialValidator", 1, credentialvalidator.NewCredentialValidatorAPIv1)
 	reg("CredentialValidator", 2, credentialvalidator.NewCredentialValidatorAPI) // adds WatchModelCredential
 	reg("ExternalControllerUpdater", 1, externalcontrollerupdater.NewStateAPI)
 
 	reg("Deployer", 1, deployer.NewDeployerAPI)
 	reg("DiskManager", 2, diskmanager.NewDiskManagerAPI)
+	reg("DNSConfigurer", 1, dnsconfigurer.NewDNSConfigurerAPI)
 	reg("FanConfigurer", 1, fanconfigurer.NewFanConfigurerAPI)
 	reg("Firewaller", 3, firewaller.NewStateFirewallerAPIV3)
 	reg("Firewaller", 4, firewaller.NewStateFirewallerAPIV4)
 	reg("Firewaller", 5, firewaller.NewStateFirewallerAPIV5)
 	reg("Firewaller", 6, firewaller.NewStateFirewallerAPIV6)
 	reg("Firewaller", 7, firewaller.NewStateFirewallerAPIV7)
-	reg("FirewallRules", 1, firewallrules.NewFacade)
-	reg("HighAvailability", 2, highavailability.NewHighAvailabilityAPI)
+	reg("Firewaller", 8, firewaller.NewStateFirewallerAPIV8) // Adds WatchFirewallRules.
+	reg("FirewallRules", 1, firewallrules.NewFacadeV1)
+	reg("FirewallRules", 2, firewallrules.NewFacade) // Adds SetFirewallRulesEnabled and FirewallRulesHistory.
+	reg("HighAvailability", 2, highavailability.NewHighAvailabilityAPIV2)
+	reg("HighAvailability", 3, highavailability.NewHighAvailabilityAPI)
 	reg("HostKeyReporter", 1, hostkeyreporter.NewFacade)
 	reg("ImageManager", 2, imagemanager.NewImageManagerAPI)
 	reg("ImageMetadata", 3, imagemetadata.NewAPI)
@@ -230,24 +260,34 @@ func AllFacades() *facade.Registry {
 
 	reg("InstancePoller", 3, instancepoller.NewFacadeV3)
 	reg("InstancePoller", 4, instancepoller.NewFacade)
+	reg("InstancePoller", 5, instancepoller.NewFacade) // Adds SetHardwareCharacteristics.
 	reg("KeyManager", 1, keymanager.NewKeyManagerAPI)
 	reg("KeyUpdater", 1, keyupdater.NewKeyUpdaterAPI)
 
+	reg("LeadershipPinning", 1, common.NewLeadershipPinningFromContext)
 	reg("LeadershipService", 2, leadership.NewLeadershipServiceFacade)
 
 	reg("LifeFlag", 1, lifeflag.NewExternalFacade)
 	reg("Logger", 1, loggerapi.NewLoggerAPI)
 	reg("LogForwarding", 1, logfwd.NewFacade)
-	reg("MachineActions", 1, machineactions.NewExternalFacade)
+	reg("LogNoise", 1, lognoise.NewFacade)
+	reg("MachineActions", 1, machineactions.NewExternalFacadeV1)
+	reg("MachineActions", 2, machineactions.NewExternalFacade) // Adds LogActionsMessages.
 
 	reg("MachineManager", 2, machinemanager.NewFacade)
 	reg("MachineManager", 3, machinemanager.NewFacade)   // Adds DestroyMachine and ForceDestroyMachine.
 	reg("MachineManager", 4, machinemanager.NewFacadeV4) // Adds DestroyMachineWithParams.
 	reg("MachineManager", 5, machinemanager.NewFacadeV5) // Adds UpgradeSeriesPrepare, removes UpdateMachineSeries.
 	reg("MachineManager", 6, machinemanager.NewFacadeV6) // DestroyMachinesWithParams gains maxWait.
+	reg("MachineManager", 7, machinemanager.NewFacade)   // Adds ReenrollMachine and SetContainerNetworkDevices.
+	reg("MachineManager", 8, machinemanager.NewFacade)   // Adds maintenance window scheduling.
+	reg("MachineManager", 9, machinemanager.NewFacade)   // Adds runtime worker disabling.
+	reg("MachineManager", 10, machinemanager.NewFacade)  // Adds RefreshHardware.
+	reg("MachineManager", 11, machinemanager.NewFacade)  // Adds ConsoleOutput.
 
 	reg("MachineUndertaker", 1, machineundertaker.NewFacade)
 	reg("Machiner", 5, machine.NewMachinerAPI) // Removes SetProviderNetworkConfig, adds RecordAgentHostAndStartTime.
+	reg("Machiner", 6, machine.NewMachinerAPI) // Adds SetOSInfo.
 
 	reg("MeterStatus", 1, meterstatus.NewMeterStatusFacadeV1)
 	reg("MeterStatus", 2, meterstatus.NewMeterStatusFacade)
@@ -258,23 +298,29 @@ func AllFacades() *facade.Registry {
 	reg("MigrationFlag", 1, migrationflag.NewFacade)
 	reg("MigrationMaster", 1, migrationmaster.NewMigrationMasterFacadeV1)
 	reg("MigrationMaster", 2, migrationmaster.NewMigrationMasterFacadeV2)
-	reg("MigrationMaster", 3, migrationmaster.NewMigrationMasterFacade) // Adds MinionReportTimeout.
+	reg("MigrationMaster", 3, migrationmaster.NewMigrationMasterFacadeV3)
+	reg("MigrationMaster", 4, migrationmaster.NewMigrationMasterFacade) // Export accepts excluded kinds.
 	reg("MigrationMinion", 1, migrationminion.NewFacade)
 	reg("MigrationTarget", 1, migrationtarget.NewFacade)
 
 	reg("ModelConfig", 2, modelconfig.NewFacadeV2)
+	reg("ModelConfig", 3, modelconfig.NewFacadeV3)
+	reg("ModelConfig", 4, modelconfig.NewFacadeV4) // Adds ApplyModelConfig.
 	reg("ModelGeneration", 1, modelgeneration.NewModelGenerationFacade)
 	reg("ModelGeneration", 2, modelgeneration.NewModelGenerationFacadeV2)
 	reg("ModelGeneration", 3, modelgeneration.NewModelGenerationFacadeV3)
 	reg("ModelGeneration", 4, modelgeneration.NewModelGenerationFacadeV4)
+	reg("ModelGeneration", 5, modelgeneration.NewModelGenerationFacadeV5) // Adds StageCharmRevision and RevertBranch.
 	reg("ModelManager", 2, modelmanager.NewFacadeV2)
 	reg("ModelManager", 3, modelmanager.NewFacadeV3)
 	reg("ModelManager", 4, modelmanager.NewFacadeV4)
-	reg("ModelManager", 5, modelmanager.NewFacadeV5) // Adds ChangeModelCredential
-	reg("ModelManager", 6, modelmanager.NewFacadeV6) // Adds cloud specific default config
-	reg("ModelManager", 7, modelmanager.NewFacadeV7) // DestroyModels gains 'force' and max-wait' parameters.
-	reg("ModelManager", 8, modelmanager.NewFacadeV8) // ModelInfo gains credential validity in return.
-	reg("ModelManager", 9, modelmanager.NewFacadeV9) // Adds ValidateModelUpgrade
+	reg("ModelManager", 5, modelmanager.NewFacadeV5)   // Adds ChangeModelCredential
+	reg("ModelManager", 6, modelmanager.NewFacadeV6)   // Adds cloud specific default config
+	reg("ModelManager", 7, modelmanager.NewFacadeV7)   // DestroyModels gains 'force' and max-wait' parameters.
+	reg("ModelManager", 8, modelmanager.NewFacadeV8)   // ModelInfo gains credential validity in return.
+	reg("ModelManager", 9, modelmanager.NewFacadeV9)   // Adds ValidateModelUpgrade
+	reg("ModelManager", 10, modelmanager.NewFacadeV10) // Adds model templates
+	reg("ModelMetadata", 1, modelmetadata.NewFacade)
 	reg("ModelUpgrader", 1, modelupgrader.NewStateFacade)
 
 	reg("Payloads", 1, payloads.NewFacade)
@@ -296,6 +342,8 @@ func AllFacades() *facade.Registry {
 	reg("RemoteRelations", 1, remoterelations.NewAPIv1)
 	reg("RemoteRelations", 2, remoterelations.NewAPI) // Adds UpdateControllersForModels and WatchLocalRelationChanges.
 
+	reg("RequestCanceller", 1, NewRequestCanceller)
+
 	reg("Resources", 1, resources.NewFacadeV1)
 	reg("Resources", 2, resources.NewFacadeV2)
 	reg("ResourcesHookContext", 1, resourceshookcontext.NewStateFacade)
@@ -304,6 +352,7 @@ func AllFacades() *facade.Registry {
 	reg("RetryStrategy", 1, retrystrategy.NewRetryStrategyAPI)
 	reg("Singular", 2, singular.NewExternalFacade)
 	reg("Secrets", 1, secrets.NewSecretsAPI)
+	reg("ServiceAccounts", 1, serviceaccounts.NewServiceAccountsAPI)
 	reg("SecretsManager", 1, secretsmanager.NewSecretManagerAPI)
 
 	reg("SSHClient", 1, sshclient.NewFacade)
@@ -313,26 +362,31 @@ func AllFacades() *facade.Registry {
 	reg("Spaces", 3, spaces.NewAPIv3)
 	reg("Spaces", 4, spaces.NewAPIv4)
 	reg("Spaces", 5, spaces.NewAPIv5)
-	reg("Spaces", 6, spaces.NewAPI)
+	reg("Spaces", 6, spaces.NewAPIv6)
+	reg("Spaces", 7, spaces.NewAPI) // Adds ReconcileSpaces.
 
 	reg("StatusHistory", 2, statushistory.NewAPI)
 
 	reg("Storage", 3, storage.NewStorageAPIV3)
 	reg("Storage", 4, storage.NewStorageAPIV4) // changes Destroy() method signature.
 	reg("Storage", 5, storage.NewStorageAPIV5) // Update and Delete storage pools and CreatePool bulk calls.
-	reg("Storage", 6, storage.NewStorageAPI)   // modify Remove to support force and maxWait; add DetachStorage to support force and maxWait.
+	reg("Storage", 6, storage.NewStorageAPIV6) // modify Remove to support force and maxWait; add DetachStorage to support force and maxWait.
+	reg("Storage", 7, storage.NewStorageAPI)   // Adds MoveStorage.
 
 	reg("StorageProvisioner", 4, storageprovisioner.NewFacadeV4)
 	reg("Subnets", 2, subnets.NewAPIv2)
 	reg("Subnets", 3, subnets.NewAPIv3)
 	reg("Subnets", 4, subnets.NewAPI) // Adds SubnetsByCIDR; removes AllSpaces.
+	reg("Telemetry", 1, charmrevisionupdater.NewTelemetryFacade)
 	reg("Undertaker", 1, undertaker.NewUndertakerAPI)
 	reg("UnitAssigner", 1, unitassigner.New)
+	reg("UnitMover", 1, unitmover.NewFacade)
 
 	// Deprecated: V16 of the uniter facade retained to allow upgrading from 2.8.9 (LTS).
 	reg("Uniter", 16, uniter.NewUniterAPIV16)
 	reg("Uniter", 17, uniter.NewUniterAPIV17)
-	reg("Uniter", 18, uniter.NewUniterAPI)
+	reg("Uniter", 18, uniter.NewUniterAPIV18)
+	reg("Uniter", 19, uniter.NewUniterAPI) // Adds WatchCharmSync and CharmSync.
 
 	reg("Upgrader", 1, upgrader.NewUpgraderFacade)
 
@@ -342,6 +396,7 @@ func AllFacades() *facade.Registry {
 
 	reg("UpgradeSteps", 1, upgradesteps.NewFacadeV1)
 	reg("UpgradeSteps", 2, upgradesteps.NewFacadeV2)
+	reg("UserLeases", 1, userleases.NewFacade)
 	reg("UserManager", 1, usermanager.NewUserManagerAPI)
 	reg("UserManager", 2, usermanager.NewUserManagerAPI) // Adds ResetPassword
 
@@ -43,6 +43,7 @@ import (
 	"github.com/juju/juju/core/auditlog"
 	"github.com/juju/juju/core/cache"
 	"github.com/juju/juju/core/lease"
+	"github.com/juju/juju/core/lognoise"
 	"github.com/juju/juju/core/multiwatcher"
 	"github.com/juju/juju/core/presence"
 	"github.com/juju/juju/pubsub/apiserver"
@@ -68,23 +69,23 @@ type Server struct {
 	shared *sharedServerContext
 
 	// tag of the machine where the API server is running.
-	tag                    names.Tag
-	dataDir                string
-	logDir                 string
-	facades                *facade.Registry
-	authenticator          httpcontext.LocalMacaroonAuthenticator
-	offerAuthCtxt          *crossmodel.AuthContext
-	lastConnectionID       uint64
-	newObserver            observer.ObserverFactory
-	allowModelAccess       bool
-	logSinkWriter          io.WriteCloser
-	logsinkRateLimitConfig logsink.RateLimitConfig
-	dbloggers              dbloggers
-	getAuditConfig         func() auditlog.Config
-	upgradeComplete        func() bool
-	mux                    *apiserverhttp.Mux
-	metricsCollector       *Collector
-	execEmbeddedCommand    ExecEmbeddedCommandFunc
+	tag                 names.Tag
+	dataDir             string
+	logDir              string
+	facades             *facade.Registry
+	authenticator       httpcontext.LocalMacaroonAuthenticator
+	offerAuthCtxt       *crossmodel.AuthContext
+	lastConnectionID    uint64
+	newObserver         observer.ObserverFactory
+	allowModelAccess    bool
+	logSinkWriter       io.WriteCloser
+	logsinkRateLimiter  *logsink.RateLimiter
+	dbloggers           dbloggers
+	getAuditConfig      func() auditlog.Config
+	upgradeComplete     func() bool
+	mux                 *apiserverhttp.Mux
+	metricsCollector    *Collector
+	execEmbeddedCommand ExecEmbeddedCommandFunc
 
 	// mu guards the fields below it.
 	mu sync.Mutex
@@ -107,6 +108,12 @@ type Server struct {
 	agentRateLimitRate time.Duration
 	agentRateLimit     *ratelimit.Bucket
 
+	// websocketCompression records whether the server offers
+	// permessage-deflate compression on API connections. It comes from
+	// controller config, and can be updated on the fly; it only affects
+	// connections made after the change.
+	websocketCompression bool
+
 	// registerIntrospectionHandlers is a function that will
 	// call a function with (path, http.Handler) tuples. This
 	// is to support registering the handlers underneath the
@@ -280,6 +287,7 @@ func newServer(cfg ServerConfig) (_ *Server, err error) {
 		multiwatcherFactory: cfg.MultiwatcherFactory,
 		centralHub:          cfg.Hub,
 		presence:            cfg.Presence,
+		logNoise:            lognoise.New(cfg.Clock),
 		leaseManager:        cfg.LeaseManager,
 		controllerConfig:    controllerConfig,
 		raftOpQueue:         cfg.RaftOpQueue,
@@ -303,11 +311,12 @@ func newServer(cfg ServerConfig) (_ *Server, err error) {
 		allowModelAccess:              cfg.AllowModelAccess,
 		publicDNSName_:                cfg.PublicDNSName,
 		registerIntrospectionHandlers: cfg.RegisterIntrospectionHandlers,
-		logsinkRateLimitConfig: logsink.RateLimitConfig{
-			Refill: cfg.LogSinkConfig.RateLimitRefill,
-			Burst:  cfg.LogSinkConfig.RateLimitBurst,
-			Clock:  cfg.Clock,
-		},
+		logsinkRateLimiter: logsink.NewRateLimiter(logsink.RateLimitConfig{
+			Refill:    cfg.LogSinkConfig.RateLimitRefill,
+			Burst:     cfg.LogSinkConfig.RateLimitBurst,
+			Clock:     cfg.Clock,
+			PerEntity: true,
+		}),
 		getAuditConfig: cfg.GetAuditConfig,
 		dbloggers: dbloggers{
 			clock:                 cfg.Clock,
@@ -320,6 +329,8 @@ func newServer(cfg ServerConfig) (_ *Server, err error) {
 		healthStatus: "starting",
 	}
 	srv.updateAgentRateLimiter(controllerConfig)
+	srv.updateLogSinkRateLimiter(controllerConfig)
+	srv.updateWebsocketCompression(controllerConfig)
 
 	// We are able to get the current controller config before subscribing to changes
 	// because the changes are only ever published in response to an API call,
@@ -332,6 +343,8 @@ func newServer(cfg ServerConfig) (_ *Server, err error) {
 				return
 			}
 			srv.updateAgentRateLimiter(data.Config)
+			srv.updateLogSinkRateLimiter(data.Config)
+			srv.updateWebsocketCompression(data.Config)
 		})
 	if err != nil {
 		logger.Criticalf("programming error in subscribe function: %v", err)
@@ -395,8 +408,9 @@ func (srv *Server) Report() map[string]interface{} {
 	srv.mu.Lock()
 	defer srv.mu.Unlock()
 	result := map[string]interface{}{
-		"agent-ratelimit-max":  srv.agentRateLimitMax,
-		"agent-ratelimit-rate": srv.agentRateLimitRate,
+		"agent-ratelimit-max":   srv.agentRateLimitMax,
+		"agent-ratelimit-rate":  srv.agentRateLimitRate,
+		"websocket-compression": srv.websocketCompression,
 	}
 
 	if srv.publicDNSName_ != "" {
@@ -440,6 +454,20 @@ func (srv *Server) updateAgentRateLimiter(cfg controller.Config) {
 	}
 }
 
+func (srv *Server) updateWebsocketCompression(cfg controller.Config) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	srv.websocketCompression = cfg.AgentWebsocketCompression()
+}
+
+func (srv *Server) updateLogSinkRateLimiter(cfg controller.Config) {
+	// When the controller config doesn't set the logsink limits, the
+	// values from the agent configuration remain in force.
+	if burst, refill, ok := cfg.LogSinkRateLimit(); ok {
+		srv.logsinkRateLimiter.Update(burst, refill)
+	}
+}
+
 type rateClock struct {
 	clock.Clock
 }
@@ -606,9 +634,9 @@ func (srv *Server) endpoints() []apihttp.Endpoint {
 		tagKindAuthorizer{names.MachineTagKind, names.ControllerAgentTagKind, names.UserTagKind, names.ApplicationTagKind})
 	pubsubHandler := newPubSubHandler(httpCtxt, srv.shared.centralHub)
 	logSinkHandler := logsink.NewHTTPHandler(
-		newAgentLogWriteCloserFunc(httpCtxt, srv.logSinkWriter, &srv.dbloggers),
+		newAgentLogWriteCloserFunc(httpCtxt, srv.logSinkWriter, &srv.dbloggers, srv.shared.logNoise),
 		httpCtxt.stop(),
-		&srv.logsinkRateLimitConfig,
+		srv.logsinkRateLimiter,
 		logsinkMetricsCollectorWrapper{collector: srv.metricsCollector},
 		controllerModelUUID,
 	)
@@ -670,7 +698,34 @@ func (srv *Server) endpoints() []apihttp.Endpoint {
 			return nil
 		},
 	}
+	downloadLimiter := newResourceDownloadLimiter()
 	unitResourcesHandler := &UnitResourcesHandler{
+		AcquireSlot: func(req *http.Request) (func(), error) {
+			tag, err := names.ParseUnitTag(req.URL.Query().Get(":unit"))
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			appName, err := names.UnitApplication(tag.Id())
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			cfg, err := srv.shared.statePool.SystemState().ControllerConfig()
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			if !downloadLimiter.Acquire(appName, cfg.ControllerResourceDownloadLimit(), cfg.ApplicationResourceDownloadLimit()) {
+				srv.metricsCollector.ResourceDownloadDeferrals.WithLabelValues(appName).Inc()
+				return nil, apiservererrors.NewRetryAfterError(
+					fmt.Sprintf("resource download limit for %q reached", appName),
+					resourceDownloadRetryAfter,
+				)
+			}
+			srv.metricsCollector.ResourceDownloads.WithLabelValues(appName).Inc()
+			return func() {
+				srv.metricsCollector.ResourceDownloads.WithLabelValues(appName).Dec()
+				downloadLimiter.Release(appName)
+			}, nil
+		},
 		NewOpener: func(req *http.Request, tagKinds ...string) (resource.Opener, state.PoolHelper, error) {
 			st, _, err := httpCtxt.stateForRequestAuthenticatedTag(req, tagKinds...)
 			if err != nil {
@@ -778,6 +833,14 @@ func (srv *Server) endpoints() []apihttp.Endpoint {
 	}, {
 		pattern: modelRoutePrefix + "/backups",
 		handler: backupHandler,
+	}, {
+		// The model health endpoint is unauthenticated so that
+		// monitoring probes can use it; it serves nothing unless
+		// enabled via controller config.
+		pattern:         modelRoutePrefix + "/health",
+		methods:         []string{"GET"},
+		handler:         &modelHealthHandler{ctxt: httpCtxt},
+		unauthenticated: true,
 	}, {
 		pattern:    "/migrate/charms",
 		handler:    migrateCharmsHTTPHandler,
@@ -928,7 +991,14 @@ func (srv *Server) apiHandler(w http.ResponseWriter, req *http.Request) {
 	apiObserver.Join(req, connectionID)
 	defer apiObserver.Leave()
 
-	websocket.Serve(w, req, func(conn *websocket.Conn) {
+	srv.mu.Lock()
+	serve := websocket.Serve
+	if srv.websocketCompression {
+		serve = websocket.ServeCompressed
+	}
+	srv.mu.Unlock()
+
+	serve(w, req, func(conn *websocket.Conn) {
 		modelUUID := httpcontext.RequestModelUUID(req)
 		logger.Tracef("got a request for model %q", modelUUID)
 		if err := srv.serveConn(
@@ -32,6 +32,10 @@ const (
 
 	// MetricLabelStatus defines a status constant for the Requests Label
 	MetricLabelStatus = "status"
+
+	// MetricLabelApplication defines an application constant for the
+	// ResourceDownloads and ResourceDownloadDeferrals Labels
+	MetricLabelApplication = "application"
 )
 
 // MetricAPIConnectionsLabelNames defines a series of labels for the
@@ -69,6 +73,12 @@ var MetricTotalRequestsLabelNames = []string{
 	MetricLabelHost,
 }
 
+// MetricResourceDownloadLabelNames defines a series of labels for the
+// ResourceDownloads and ResourceDownloadDeferrals metrics.
+var MetricResourceDownloadLabelNames = []string{
+	MetricLabelApplication,
+}
+
 // Collector is a prometheus.Collector that collects metrics based
 // on apiserver status.
 type Collector struct {
@@ -77,6 +87,8 @@ type Collector struct {
 	LoginAttempts      prometheus.Gauge
 	APIConnections     *prometheus.GaugeVec
 	APIRequestDuration *prometheus.SummaryVec
+	APIRequestSize     *prometheus.SummaryVec
+	APIReplySize       *prometheus.SummaryVec
 
 	PingFailureCount *prometheus.CounterVec
 
@@ -86,6 +98,9 @@ type Collector struct {
 	TotalRequests         *prometheus.CounterVec
 	TotalRequestErrors    *prometheus.CounterVec
 	TotalRequestsDuration *prometheus.SummaryVec
+
+	ResourceDownloads         *prometheus.GaugeVec
+	ResourceDownloadDeferrals *prometheus.CounterVec
 }
 
 // NewMetricsCollector returns a new Collector.
@@ -121,6 +136,28 @@ func NewMetricsCollector() *Collector {
 				0.99: 0.001,
 			},
 		}, metricobserver.MetricLabelNames),
+		APIRequestSize: prometheus.NewSummaryVec(prometheus.SummaryOpts{
+			Namespace: apiserverMetricsNamespace,
+			Subsystem: apiserverSubsystemNamespace,
+			Name:      "request_size_bytes",
+			Help:      "Size of Juju API request payloads in bytes.",
+			Objectives: map[float64]float64{
+				0.5:  0.05,
+				0.9:  0.01,
+				0.99: 0.001,
+			},
+		}, metricobserver.MetricLabelNames),
+		APIReplySize: prometheus.NewSummaryVec(prometheus.SummaryOpts{
+			Namespace: apiserverMetricsNamespace,
+			Subsystem: apiserverSubsystemNamespace,
+			Name:      "reply_size_bytes",
+			Help:      "Size of Juju API reply payloads in bytes.",
+			Objectives: map[float64]float64{
+				0.5:  0.05,
+				0.9:  0.01,
+				0.99: 0.001,
+			},
+		}, metricobserver.MetricLabelNames),
 
 		PingFailureCount: prometheus.NewCounterVec(prometheus.CounterOpts{
 			Namespace: apiserverMetricsNamespace,
@@ -165,6 +202,19 @@ func NewMetricsCollector() *Collector {
 				0.99: 0.001,
 			},
 		}, MetricTotalRequestsLabelNames),
+
+		ResourceDownloads: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: apiserverMetricsNamespace,
+			Subsystem: apiserverSubsystemNamespace,
+			Name:      "resource_downloads",
+			Help:      "Current number of unit resource downloads being served",
+		}, MetricResourceDownloadLabelNames),
+		ResourceDownloadDeferrals: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: apiserverMetricsNamespace,
+			Subsystem: apiserverSubsystemNamespace,
+			Name:      "resource_download_deferrals_total",
+			Help:      "Total number of unit resource downloads deferred due to download limits",
+		}, MetricResourceDownloadLabelNames),
 	}
 }
 
@@ -174,12 +224,16 @@ func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
 	c.APIConnections.Describe(ch)
 	c.LoginAttempts.Describe(ch)
 	c.APIRequestDuration.Describe(ch)
+	c.APIRequestSize.Describe(ch)
+	c.APIReplySize.Describe(ch)
 	c.PingFailureCount.Describe(ch)
 	c.LogWriteCount.Describe(ch)
 	c.LogReadCount.Describe(ch)
 	c.TotalRequests.Describe(ch)
 	c.TotalRequestErrors.Describe(ch)
 	c.TotalRequestsDuration.Describe(ch)
+	c.ResourceDownloads.Describe(ch)
+	c.ResourceDownloadDeferrals.Describe(ch)
 }
 
 // Collect is part of the prometheus.Collector interface.
@@ -188,10 +242,14 @@ func (c *Collector) Collect(ch chan<- prometheus.Metric) {
 	c.APIConnections.Collect(ch)
 	c.LoginAttempts.Collect(ch)
 	c.APIRequestDuration.Collect(ch)
+	c.APIRequestSize.Collect(ch)
+	c.APIReplySize.Collect(ch)
 	c.PingFailureCount.Collect(ch)
 	c.LogWriteCount.Collect(ch)
 	c.LogReadCount.Collect(ch)
 	c.TotalRequests.Collect(ch)
 	c.TotalRequestErrors.Collect(ch)
 	c.TotalRequestsDuration.Collect(ch)
+	c.ResourceDownloads.Collect(ch)
+	c.ResourceDownloadDeferrals.Collect(ch)
 }
@@ -36,18 +36,23 @@ func (s *apiservermetricsSuite) TestDescribe(c *gc.C) {
 	for desc := range ch {
 		descs = append(descs, desc)
 	}
-	c.Assert(descs, gc.HasLen, 10)
+	c.Assert(descs, gc.HasLen, 14)
 	c.Assert(descs[0].String(), gc.Matches, `.*fqName: "juju_apiserver_connections_total".*`)
 	c.Assert(descs[1].String(), gc.Matches, `.*fqName: "juju_apiserver_connections".*`)
 	c.Assert(descs[2].String(), gc.Matches, `.*fqName: "juju_apiserver_active_login_attempts".*`)
 	c.Assert(descs[3].String(), gc.Matches, `.*fqName: "juju_apiserver_request_duration_seconds".*`)
-	c.Assert(descs[4].String(), gc.Matches, `.*fqName: "juju_apiserver_ping_failure_count".*`)
-	c.Assert(descs[5].String(), gc.Matches, `.*fqName: "juju_apiserver_log_write_count".*`)
-	c.Assert(descs[6].String(), gc.Matches, `.*fqName: "juju_apiserver_log_read_count".*`)
+	c.Assert(descs[4].String(), gc.Matches, `.*fqName: "juju_apiserver_request_size_bytes".*`)
+	c.Assert(descs[5].String(), gc.Matches, `.*fqName: "juju_apiserver_reply_size_bytes".*`)
+	c.Assert(descs[6].String(), gc.Matches, `.*fqName: "juju_apiserver_ping_failure_count".*`)
+	c.Assert(descs[7].String(), gc.Matches, `.*fqName: "juju_apiserver_log_write_count".*`)
+	c.Assert(descs[8].String(), gc.Matches, `.*fqName: "juju_apiserver_log_read_count".*`)
 
-	c.Assert(descs[7].String(), gc.Matches, `.*fqName: "juju_apiserver_outbound_requests_total".*`)
-	c.Assert(descs[8].String(), gc.Matches, `.*fqName: "juju_apiserver_outbound_request_errors_total".*`)
-	c.Assert(descs[9].String(), gc.Matches, `.*fqName: "juju_apiserver_outbound_request_duration_seconds".*`)
+	c.Assert(descs[9].String(), gc.Matches, `.*fqName: "juju_apiserver_outbound_requests_total".*`)
+	c.Assert(descs[10].String(), gc.Matches, `.*fqName: "juju_apiserver_outbound_request_errors_total".*`)
+	c.Assert(descs[11].String(), gc.Matches, `.*fqName: "juju_apiserver_outbound_request_duration_seconds".*`)
+
+	c.Assert(descs[12].String(), gc.Matches, `.*fqName: "juju_apiserver_resource_downloads".*`)
+	c.Assert(descs[13].String(), gc.Matches, `.*fqName: "juju_apiserver_resource_download_deferrals_total".*`)
 }
 
 func (s *apiservermetricsSuite) TestCollect(c *gc.C) {
@@ -92,6 +97,11 @@ func (s *apiservermetricsSuite) TestLabelNames(c *gc.C) {
 			labels:  apiserver.MetricTotalRequestsWithStatusLabelNames,
 			checker: jc.IsTrue,
 		},
+		{
+			name:    "resource download label names",
+			labels:  apiserver.MetricResourceDownloadLabelNames,
+			checker: jc.IsTrue,
+		},
 		{
 			name:    "total requests label names",
 			labels:  apiserver.MetricTotalRequestsLabelNames,
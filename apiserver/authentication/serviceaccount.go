@@ -0,0 +1,68 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package authentication
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/state"
+)
+
+// ServiceAccountFinder looks up service accounts by token.
+// It is implemented by *state.State.
+type ServiceAccountFinder interface {
+	ServiceAccountByToken(token string) (*state.ServiceAccount, state.ServiceAccountToken, error)
+}
+
+// ServiceAccountAuthenticator performs authentication for service
+// accounts. Service accounts have no passwords or macaroons; they
+// present one of their tokens as the login credentials.
+type ServiceAccountAuthenticator struct {
+	ServiceAccounts ServiceAccountFinder
+}
+
+var _ EntityAuthenticator = (*ServiceAccountAuthenticator)(nil)
+
+// ServiceAccountEntity is the state entity returned from a successful
+// service account login. It carries the token that authenticated the
+// connection so that the API server can enforce the token's scopes.
+type ServiceAccountEntity struct {
+	*state.ServiceAccount
+
+	// Token is the token the connection authenticated with.
+	Token state.ServiceAccountToken
+}
+
+// Authenticate authenticates the service account with the specified
+// tag. The supplied credentials must be one of the account's current,
+// unexpired tokens.
+func (s *ServiceAccountAuthenticator) Authenticate(
+	_ context.Context,
+	_ EntityFinder,
+	tag names.Tag,
+	req params.LoginRequest,
+) (state.Entity, error) {
+	userTag, ok := tag.(names.UserTag)
+	if !ok || userTag.Domain() != state.ServiceAccountDomain {
+		return nil, errors.Trace(apiservererrors.ErrBadRequest)
+	}
+	if req.Credentials == "" {
+		return nil, errors.Trace(apiservererrors.ErrNoCreds)
+	}
+	account, token, err := s.ServiceAccounts.ServiceAccountByToken(req.Credentials)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if account.UserTag() != userTag {
+		// The token is valid, but belongs to a different account to
+		// the one named in the login request.
+		return nil, errors.Unauthorizedf("invalid service account token")
+	}
+	return &ServiceAccountEntity{ServiceAccount: account, Token: token}, nil
+}
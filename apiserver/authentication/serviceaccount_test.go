@@ -0,0 +1,93 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package authentication_test
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/authentication"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/juju/testing"
+	"github.com/juju/juju/state"
+)
+
+type serviceAccountAuthenticatorSuite struct {
+	testing.JujuConnSuite
+	account *state.ServiceAccount
+	token   string
+	tokenID string
+}
+
+var _ = gc.Suite(&serviceAccountAuthenticatorSuite{})
+
+func (s *serviceAccountAuthenticatorSuite) SetUpTest(c *gc.C) {
+	s.JujuConnSuite.SetUpTest(c)
+
+	account, err := s.State.AddServiceAccount("ci-deploy", "admin")
+	c.Assert(err, jc.ErrorIsNil)
+	s.account = account
+	token, tokenMetadata, err := account.AddToken(nil, nil, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	s.token = token
+	s.tokenID = tokenMetadata.ID()
+}
+
+func (s *serviceAccountAuthenticatorSuite) authenticator() *authentication.ServiceAccountAuthenticator {
+	return &authentication.ServiceAccountAuthenticator{ServiceAccounts: s.State}
+}
+
+func (s *serviceAccountAuthenticatorSuite) TestValidToken(c *gc.C) {
+	entity, err := s.authenticator().Authenticate(context.TODO(), nil, s.account.UserTag(), params.LoginRequest{
+		Credentials: s.token,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(entity.Tag(), gc.Equals, s.account.Tag())
+
+	sa, ok := entity.(*authentication.ServiceAccountEntity)
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(sa.Token.ID(), gc.Equals, s.tokenID)
+}
+
+func (s *serviceAccountAuthenticatorSuite) TestUnknownToken(c *gc.C) {
+	_, err := s.authenticator().Authenticate(context.TODO(), nil, s.account.UserTag(), params.LoginRequest{
+		Credentials: "not-a-token",
+	})
+	c.Assert(err, jc.Satisfies, errors.IsUnauthorized)
+}
+
+func (s *serviceAccountAuthenticatorSuite) TestRevokedToken(c *gc.C) {
+	err := s.account.RevokeToken(s.tokenID)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.authenticator().Authenticate(context.TODO(), nil, s.account.UserTag(), params.LoginRequest{
+		Credentials: s.token,
+	})
+	c.Assert(err, jc.Satisfies, errors.IsUnauthorized)
+}
+
+func (s *serviceAccountAuthenticatorSuite) TestTokenForOtherAccount(c *gc.C) {
+	other, err := s.State.AddServiceAccount("release-bot", "admin")
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.authenticator().Authenticate(context.TODO(), nil, other.UserTag(), params.LoginRequest{
+		Credentials: s.token,
+	})
+	c.Assert(err, jc.Satisfies, errors.IsUnauthorized)
+	c.Assert(err, gc.ErrorMatches, "invalid service account token")
+}
+
+func (s *serviceAccountAuthenticatorSuite) TestNoCredentials(c *gc.C) {
+	_, err := s.authenticator().Authenticate(context.TODO(), nil, s.account.UserTag(), params.LoginRequest{})
+	c.Assert(err, gc.ErrorMatches, "no credentials provided")
+}
+
+func (s *serviceAccountAuthenticatorSuite) TestNotAServiceAccountTag(c *gc.C) {
+	_, err := s.authenticator().Authenticate(context.TODO(), nil, names.NewUserTag("bob"), params.LoginRequest{
+		Credentials: s.token,
+	})
+	c.Assert(err, gc.ErrorMatches, "invalid request")
+}
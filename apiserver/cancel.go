@@ -0,0 +1,57 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/apiserver/common"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/state"
+)
+
+// requestCanceller cancels an in-flight request on an API connection.
+// It is implemented by *rpc.Conn.
+type requestCanceller interface {
+	CancelRequest(requestId uint64) bool
+}
+
+// RequestCancellerAPI lets a client cancel its own long-running
+// requests that are still in flight on the same connection, instead
+// of letting them run to completion in the background.
+type RequestCancellerAPI struct {
+	canceller requestCanceller
+}
+
+// NewRequestCanceller returns a RequestCancellerAPI scoped to the
+// current connection.
+func NewRequestCanceller(st *state.State, resources facade.Resources, authorizer facade.Authorizer) (*RequestCancellerAPI, error) {
+	res, ok := resources.Get("requestCanceller").(common.ValueResource)
+	if !ok {
+		return nil, errors.NotSupportedf("cancelling requests on this connection")
+	}
+	canceller, ok := res.Value.(requestCanceller)
+	if !ok {
+		return nil, errors.NotSupportedf("cancelling requests on this connection")
+	}
+	return &RequestCancellerAPI{canceller: canceller}, nil
+}
+
+// Cancel cancels the identified in-flight requests on the calling
+// connection. A request that has already completed (or never existed)
+// yields a NotFound error. Cancelled requests are only interrupted if
+// the method being run honours its request context.
+func (a *RequestCancellerAPI) Cancel(args params.CancelRequestsArgs) params.ErrorResults {
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.RequestIds)),
+	}
+	for i, id := range args.RequestIds {
+		if !a.canceller.CancelRequest(id) {
+			results.Results[i].Error = apiservererrors.ServerError(errors.NotFoundf("request %d", id))
+		}
+	}
+	return results
+}
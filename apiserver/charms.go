@@ -7,6 +7,7 @@ import (
 	"archive/zip"
 	"bytes"
 	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/hex"
 	"io"
 	"io/ioutil"
@@ -439,14 +440,18 @@ func RepackageAndUploadCharm(st *state.State, archive *charm.CharmArchive, curl
 		return errors.Annotate(err, "cannot open version file")
 	}
 
-	// Bundle the charm and calculate its sha256 hash at the same time.
+	// Bundle the charm and calculate its hashes at the same time. The
+	// SHA384 hash allows the storage layer to reference a blob another
+	// model already uploaded instead of storing it again.
 	var repackagedArchive bytes.Buffer
 	hash := sha256.New()
-	err = charmDir.ArchiveTo(io.MultiWriter(hash, &repackagedArchive))
+	hash384 := sha512.New384()
+	err = charmDir.ArchiveTo(io.MultiWriter(hash, hash384, &repackagedArchive))
 	if err != nil {
 		return errors.Annotate(err, "cannot repackage uploaded charm")
 	}
 	bundleSHA256 := hex.EncodeToString(hash.Sum(nil))
+	bundleSHA384 := hex.EncodeToString(hash384.Sum(nil))
 
 	// Now we need to repackage it with the reserved URL, upload it to
 	// provider storage and update the state.
@@ -460,10 +465,11 @@ func RepackageAndUploadCharm(st *state.State, archive *charm.CharmArchive, curl
 
 	return charmStorage.Store(curl, downloader.DownloadedCharm{
 		Charm:        archive,
-		CharmData:    &repackagedArchive,
+		CharmData:    bytes.NewReader(repackagedArchive.Bytes()),
 		CharmVersion: version,
 		Size:         int64(repackagedArchive.Len()),
 		SHA256:       bundleSHA256,
+		SHA384:       bundleSHA384,
 		LXDProfile:   charmDir.LXDProfile(),
 	})
 }
@@ -435,6 +435,10 @@ func validateIngressNetworks(backend Backend, networks []string) error {
 	if errors.IsNotFound(err) {
 		return nil
 	}
+	// A disabled rule imposes no restrictions.
+	if rule.Disabled() {
+		return nil
+	}
 	var whitelistCIDRs, requestedCIDRs []*net.IPNet
 	if err := parseCIDRs(&whitelistCIDRs, rule.WhitelistCIDRs()); err != nil {
 		return errors.Trace(err)
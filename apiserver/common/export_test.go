@@ -3,9 +3,19 @@
 
 package common
 
+import (
+	"github.com/juju/clock"
+)
+
 var (
 	MachineJobFromParams    = machineJobFromParams
 	EnvtoolsFindTools       = &envtoolsFindTools
 	SendMetrics             = &sendMetrics
 	MockableDestroyMachines = destroyMachines
 )
+
+// SetLeadershipPinningClock sets the clock used to schedule automatic
+// release of expiring leadership pins.
+func SetLeadershipPinningClock(api *LeadershipPinning, clk clock.Clock) {
+	api.clock = clk
+}
@@ -4,6 +4,9 @@
 package common
 
 import (
+	"time"
+
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/names/v4"
 
@@ -71,6 +74,7 @@ func NewLeadershipPinning(
 		modelTag:   modelTag,
 		pinner:     pinner,
 		authorizer: authorizer,
+		clock:      clock.WallClock,
 	}, nil
 }
 
@@ -81,6 +85,7 @@ type LeadershipPinning struct {
 	modelTag   names.ModelTag
 	pinner     leadership.Pinner
 	authorizer facade.Authorizer
+	clock      clock.Clock
 }
 
 // PinnedLeadership returns all pinned applications and the entities that
@@ -100,6 +105,87 @@ func (a *LeadershipPinning) PinnedLeadership() (params.PinnedLeadershipResult, e
 	return result, nil
 }
 
+// PinLeadership pins the leadership of the requested applications on
+// behalf of the requesting operator. Only model admins may pin leadership
+// this way; the operator's user tag is recorded as the pinning entity,
+// and the call itself is captured by the controller audit log. If a
+// duration is supplied, the pin is automatically released after it
+// elapses.
+func (a *LeadershipPinning) PinLeadership(args params.PinLeadershipBulkParams) (params.PinApplicationsResults, error) {
+	tag, err := a.operatorTag()
+	if err != nil {
+		return params.PinApplicationsResults{}, errors.Trace(err)
+	}
+	results := make([]params.PinApplicationResult, len(args.Params))
+	for i, arg := range args.Params {
+		appTag, err := names.ParseApplicationTag(arg.ApplicationTag)
+		if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results[i].ApplicationName = appTag.Id()
+		if err := a.pinner.PinLeadership(appTag.Id(), tag.String()); err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		if arg.DurationSeconds > 0 {
+			a.scheduleUnpin(appTag.Id(), tag.String(), time.Duration(arg.DurationSeconds*float64(time.Second)))
+		}
+	}
+	return params.PinApplicationsResults{Results: results}, nil
+}
+
+// UnpinLeadership releases leadership pins previously created by the
+// requesting operator for the given applications. Only model admins may
+// unpin leadership this way.
+func (a *LeadershipPinning) UnpinLeadership(args params.Entities) (params.PinApplicationsResults, error) {
+	tag, err := a.operatorTag()
+	if err != nil {
+		return params.PinApplicationsResults{}, errors.Trace(err)
+	}
+	results := make([]params.PinApplicationResult, len(args.Entities))
+	for i, arg := range args.Entities {
+		appTag, err := names.ParseApplicationTag(arg.Tag)
+		if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results[i].ApplicationName = appTag.Id()
+		if err := a.pinner.UnpinLeadership(appTag.Id(), tag.String()); err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return params.PinApplicationsResults{Results: results}, nil
+}
+
+// operatorTag returns the tag of the requesting operator, checking that
+// they are a client with admin access to the model.
+func (a *LeadershipPinning) operatorTag() (names.Tag, error) {
+	if !a.authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+	canAccess, err := a.authorizer.HasPermission(permission.AdminAccess, a.modelTag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !canAccess {
+		return nil, apiservererrors.ErrPerm
+	}
+	return a.authorizer.GetAuthTag(), nil
+}
+
+// scheduleUnpin releases the operator's pin for the given application
+// once the duration elapses. The timer runs on the API server that
+// handled the pin request; the pin can be released earlier with an
+// explicit UnpinLeadership call.
+func (a *LeadershipPinning) scheduleUnpin(appName, entity string, duration time.Duration) {
+	a.clock.AfterFunc(duration, func() {
+		if err := a.pinner.UnpinLeadership(appName, entity); err != nil {
+			logger.Errorf("releasing expired leadership pin for %q: %v", appName, err)
+		}
+	})
+}
+
 // PinApplicationLeaders pins leadership for applications based on the auth
 // tag provided.
 func (a *LeadershipPinning) PinApplicationLeaders() (params.PinApplicationsResults, error) {
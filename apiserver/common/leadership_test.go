@@ -4,7 +4,10 @@
 package common_test
 
 import (
+	"time"
+
 	"github.com/golang/mock/gomock"
+	"github.com/juju/clock/testclock"
 	"github.com/juju/errors"
 	"github.com/juju/names/v4"
 	jc "github.com/juju/testing/checkers"
@@ -230,3 +233,79 @@ func (s *LeadershipSuite) pinApplicationsSuccessResults() []params.PinApplicatio
 	}
 	return results
 }
+
+func (s *LeadershipSuite) TestPinLeadershipOperatorSuccess(c *gc.C) {
+	s.authTag = names.NewUserTag("admin")
+	defer s.setup(c).Finish()
+
+	s.pinner.EXPECT().PinLeadership("redis", s.authTag.String()).Return(nil)
+
+	res, err := s.api.PinLeadership(params.PinLeadershipBulkParams{
+		Params: []params.PinLeadershipParams{{ApplicationTag: "application-redis"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(res, gc.DeepEquals, params.PinApplicationsResults{
+		Results: []params.PinApplicationResult{{ApplicationName: "redis"}},
+	})
+}
+
+func (s *LeadershipSuite) TestPinLeadershipOperatorExpiry(c *gc.C) {
+	s.authTag = names.NewUserTag("admin")
+	defer s.setup(c).Finish()
+
+	clk := testclock.NewClock(time.Now())
+	common.SetLeadershipPinningClock(s.api, clk)
+
+	unpinned := make(chan struct{})
+	s.pinner.EXPECT().PinLeadership("redis", s.authTag.String()).Return(nil)
+	s.pinner.EXPECT().UnpinLeadership("redis", s.authTag.String()).DoAndReturn(
+		func(string, string) error {
+			close(unpinned)
+			return nil
+		})
+
+	_, err := s.api.PinLeadership(params.PinLeadershipBulkParams{
+		Params: []params.PinLeadershipParams{{
+			ApplicationTag:  "application-redis",
+			DurationSeconds: 30,
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(clk.WaitAdvance(30*time.Second, coretesting.LongWait, 1), jc.ErrorIsNil)
+	select {
+	case <-unpinned:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for automatic unpin")
+	}
+}
+
+func (s *LeadershipSuite) TestUnpinLeadershipOperatorSuccess(c *gc.C) {
+	s.authTag = names.NewUserTag("admin")
+	defer s.setup(c).Finish()
+
+	s.pinner.EXPECT().UnpinLeadership("redis", s.authTag.String()).Return(nil)
+
+	res, err := s.api.UnpinLeadership(params.Entities{
+		Entities: []params.Entity{{Tag: "application-redis"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(res, gc.DeepEquals, params.PinApplicationsResults{
+		Results: []params.PinApplicationResult{{ApplicationName: "redis"}},
+	})
+}
+
+func (s *LeadershipSuite) TestPinLeadershipOperatorPermissionDenied(c *gc.C) {
+	s.authTag = names.NewUserTag("some-random-cat")
+	defer s.setup(c).Finish()
+
+	_, err := s.api.PinLeadership(params.PinLeadershipBulkParams{
+		Params: []params.PinLeadershipParams{{ApplicationTag: "application-redis"}},
+	})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+
+	_, err = s.api.UnpinLeadership(params.Entities{
+		Entities: []params.Entity{{Tag: "application-redis"}},
+	})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
@@ -110,6 +110,7 @@ func VolumeToState(v params.Volume) (names.VolumeTag, state.VolumeInfo, error) {
 		"", // pool is set by state
 		v.Info.VolumeId,
 		v.Info.Persistent,
+		v.Info.AvailabilityZone,
 	}, nil
 }
 
@@ -134,6 +135,7 @@ func VolumeInfoFromState(info state.VolumeInfo) params.VolumeInfo {
 		info.Pool,
 		info.Size,
 		info.Persistent,
+		info.AvailabilityZone,
 	}
 }
 
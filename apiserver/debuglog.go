@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"regexp"
 	"strconv"
 	"syscall"
 	"time"
@@ -77,11 +78,19 @@ func newDebugLogHandler(
 //   excludeEntity -> []string - lists entity tags to exclude from the response
 //      - as with include, it may finish with a '*'
 //   excludeModule -> []string - lists logging modules to exclude from the response
+//   includeModuleRegex -> []string - lists logging module regular expressions to
+//      include in the response
+//   excludeModuleRegex -> []string - lists logging module regular expressions to
+//      exclude from the response
 //   limit -> uint - show *at most* this many lines
 //   backlog -> uint
 //      - go back this many lines from the end before starting to filter
 //      - has no meaning if 'replay' is true
+//   backlogDuration -> string - a duration e.g. "10m"
+//      - only return records logged within this duration before now
+//      - cannot be combined with 'startTime'
 //   level -> string one of [TRACE, DEBUG, INFO, WARNING, ERROR]
+//   maxLevel -> string - as with level, but an upper bound on the levels returned
 //   replay -> string - one of [true, false], if true, start the file from the start
 //   noTail -> string - one of [true, false], if true, existing logs are sent back,
 //      - but the command does not wait for new ones.
@@ -180,18 +189,22 @@ func (s *debugLogSocketImpl) sendLogRecord(record *params.LogMessage) error {
 
 // debugLogParams contains the parsed debuglog API request parameters.
 type debugLogParams struct {
-	startTime     time.Time
-	maxLines      uint
-	fromTheStart  bool
-	noTail        bool
-	backlog       uint
-	filterLevel   loggo.Level
-	includeEntity []string
-	excludeEntity []string
-	includeModule []string
-	excludeModule []string
-	includeLabel  []string
-	excludeLabel  []string
+	startTime          time.Time
+	maxLines           uint
+	fromTheStart       bool
+	noTail             bool
+	backlog            uint
+	backlogDuration    time.Duration
+	filterLevel        loggo.Level
+	filterLevelMax     loggo.Level
+	includeEntity      []string
+	excludeEntity      []string
+	includeModule      []string
+	excludeModule      []string
+	includeModuleRegex []string
+	excludeModuleRegex []string
+	includeLabel       []string
+	excludeLabel       []string
 }
 
 func readDebugLogParams(queryMap url.Values) (debugLogParams, error) {
@@ -229,6 +242,14 @@ func readDebugLogParams(queryMap url.Values) (debugLogParams, error) {
 		params.backlog = uint(num)
 	}
 
+	if value := queryMap.Get("backlogDuration"); value != "" {
+		duration, err := time.ParseDuration(value)
+		if err != nil || duration < 0 {
+			return params, errors.Errorf("backlogDuration value %q is not a valid duration", value)
+		}
+		params.backlogDuration = duration
+	}
+
 	if value := queryMap.Get("level"); value != "" {
 		var ok bool
 		level, ok := loggo.ParseLevel(value)
@@ -239,6 +260,18 @@ func readDebugLogParams(queryMap url.Values) (debugLogParams, error) {
 		params.filterLevel = level
 	}
 
+	if value := queryMap.Get("maxLevel"); value != "" {
+		level, ok := loggo.ParseLevel(value)
+		if !ok || level < loggo.TRACE || level > loggo.ERROR {
+			return params, errors.Errorf("maxLevel value %q is not one of %q, %q, %q, %q, %q",
+				value, loggo.TRACE, loggo.DEBUG, loggo.INFO, loggo.WARNING, loggo.ERROR)
+		}
+		if level < params.filterLevel {
+			return params, errors.Errorf("maxLevel value %q is below level value %q", level, params.filterLevel)
+		}
+		params.filterLevelMax = level
+	}
+
 	if value := queryMap.Get("startTime"); value != "" {
 		startTime, err := time.Parse(time.RFC3339Nano, value)
 		if err != nil {
@@ -247,11 +280,25 @@ func readDebugLogParams(queryMap url.Values) (debugLogParams, error) {
 		params.startTime = startTime
 	}
 
+	if params.backlogDuration > 0 && !params.startTime.IsZero() {
+		return params, errors.New("backlogDuration cannot be combined with startTime")
+	}
+
 	params.includeEntity = queryMap["includeEntity"]
 	params.excludeEntity = queryMap["excludeEntity"]
 	params.includeModule = queryMap["includeModule"]
 	params.excludeModule = queryMap["excludeModule"]
 
+	params.includeModuleRegex = queryMap["includeModuleRegex"]
+	params.excludeModuleRegex = queryMap["excludeModuleRegex"]
+	for _, exprs := range [][]string{params.includeModuleRegex, params.excludeModuleRegex} {
+		for _, expr := range exprs {
+			if _, err := regexp.Compile(expr); err != nil {
+				return params, errors.Errorf("module regex %q is not a valid regular expression", expr)
+			}
+		}
+	}
+
 	if label, ok := queryMap["includeLabel"]; ok {
 		params.includeLabel = label
 	}
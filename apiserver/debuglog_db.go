@@ -31,7 +31,7 @@ func handleDebugLogDBRequest(
 	socket debugLogSocket,
 	stop <-chan struct{},
 ) error {
-	params := makeLogTailerParams(reqParams)
+	params := makeLogTailerParams(clock, reqParams)
 	tailer, err := newLogTailer(st, params)
 	if err != nil {
 		return errors.Trace(err)
@@ -67,18 +67,24 @@ func handleDebugLogDBRequest(
 	}
 }
 
-func makeLogTailerParams(reqParams debugLogParams) state.LogTailerParams {
+func makeLogTailerParams(clock clock.Clock, reqParams debugLogParams) state.LogTailerParams {
 	params := state.LogTailerParams{
-		MinLevel:      reqParams.filterLevel,
-		NoTail:        reqParams.noTail,
-		StartTime:     reqParams.startTime,
-		InitialLines:  int(reqParams.backlog),
-		IncludeEntity: reqParams.includeEntity,
-		ExcludeEntity: reqParams.excludeEntity,
-		IncludeModule: reqParams.includeModule,
-		ExcludeModule: reqParams.excludeModule,
-		IncludeLabel:  reqParams.includeLabel,
-		ExcludeLabel:  reqParams.excludeLabel,
+		MinLevel:           reqParams.filterLevel,
+		MaxLevel:           reqParams.filterLevelMax,
+		NoTail:             reqParams.noTail,
+		StartTime:          reqParams.startTime,
+		InitialLines:       int(reqParams.backlog),
+		IncludeEntity:      reqParams.includeEntity,
+		ExcludeEntity:      reqParams.excludeEntity,
+		IncludeModule:      reqParams.includeModule,
+		ExcludeModule:      reqParams.excludeModule,
+		IncludeModuleRegex: reqParams.includeModuleRegex,
+		ExcludeModuleRegex: reqParams.excludeModuleRegex,
+		IncludeLabel:       reqParams.includeLabel,
+		ExcludeLabel:       reqParams.excludeLabel,
+	}
+	if reqParams.backlogDuration > 0 {
+		params.StartTime = clock.Now().Add(-reqParams.backlogDuration)
 	}
 	if reqParams.fromTheStart {
 		params.InitialLines = 0
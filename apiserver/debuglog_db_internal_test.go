@@ -37,17 +37,20 @@ func (s *debugLogDBIntSuite) SetUpTest(c *gc.C) {
 func (s *debugLogDBIntSuite) TestParamConversion(c *gc.C) {
 	t1 := time.Date(2016, 11, 30, 10, 51, 0, 0, time.UTC)
 	reqParams := debugLogParams{
-		fromTheStart:  false,
-		noTail:        true,
-		backlog:       11,
-		startTime:     t1,
-		filterLevel:   loggo.INFO,
-		includeEntity: []string{"foo"},
-		includeModule: []string{"bar"},
-		includeLabel:  []string{"xxx"},
-		excludeEntity: []string{"baz"},
-		excludeModule: []string{"qux"},
-		excludeLabel:  []string{"yyy"},
+		fromTheStart:       false,
+		noTail:             true,
+		backlog:            11,
+		startTime:          t1,
+		filterLevel:        loggo.INFO,
+		filterLevelMax:     loggo.WARNING,
+		includeEntity:      []string{"foo"},
+		includeModule:      []string{"bar"},
+		includeModuleRegex: []string{"bar.*"},
+		includeLabel:       []string{"xxx"},
+		excludeEntity:      []string{"baz"},
+		excludeModule:      []string{"qux"},
+		excludeModuleRegex: []string{"qux.*"},
+		excludeLabel:       []string{"yyy"},
 	}
 
 	called := false
@@ -59,12 +62,15 @@ func (s *debugLogDBIntSuite) TestParamConversion(c *gc.C) {
 		c.Assert(params.StartTime, gc.Equals, t1)
 		c.Assert(params.NoTail, jc.IsTrue)
 		c.Assert(params.MinLevel, gc.Equals, loggo.INFO)
+		c.Assert(params.MaxLevel, gc.Equals, loggo.WARNING)
 		c.Assert(params.InitialLines, gc.Equals, 11)
 		c.Assert(params.IncludeEntity, jc.DeepEquals, []string{"foo"})
 		c.Assert(params.IncludeModule, jc.DeepEquals, []string{"bar"})
+		c.Assert(params.IncludeModuleRegex, jc.DeepEquals, []string{"bar.*"})
 		c.Assert(params.IncludeLabel, jc.DeepEquals, []string{"xxx"})
 		c.Assert(params.ExcludeEntity, jc.DeepEquals, []string{"baz"})
 		c.Assert(params.ExcludeModule, jc.DeepEquals, []string{"qux"})
+		c.Assert(params.ExcludeModuleRegex, jc.DeepEquals, []string{"qux.*"})
 		c.Assert(params.ExcludeLabel, jc.DeepEquals, []string{"yyy"})
 
 		return newFakeLogTailer(), nil
@@ -77,6 +83,27 @@ func (s *debugLogDBIntSuite) TestParamConversion(c *gc.C) {
 	c.Assert(called, jc.IsTrue)
 }
 
+func (s *debugLogDBIntSuite) TestParamConversionBacklogDuration(c *gc.C) {
+	reqParams := debugLogParams{
+		backlogDuration: 10 * time.Minute,
+	}
+
+	called := false
+	s.PatchValue(&newLogTailer, func(_ state.LogTailerState, params state.LogTailerParams) (state.LogTailer, error) {
+		called = true
+
+		c.Assert(params.StartTime, gc.Equals, s.clock.Now().Add(-10*time.Minute))
+
+		return newFakeLogTailer(), nil
+	})
+
+	stop := make(chan struct{})
+	close(stop) // Stop the request immediately.
+	err := handleDebugLogDBRequest(s.clock, s.timeout, nil, reqParams, s.sock, stop)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(called, jc.IsTrue)
+}
+
 func (s *debugLogDBIntSuite) TestParamConversionReplay(c *gc.C) {
 	reqParams := debugLogParams{
 		fromTheStart: true,
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/loggo"
@@ -126,6 +127,8 @@ func ServerErrorAndStatus(err error) (*params.Error, int) {
 		//
 		// See https://www.w3.org/Protocols/rfc2616/rfc2616-sec10.html#sec10.4.10
 		status = http.StatusConflict
+	case params.CodeRetryAfter:
+		status = http.StatusTooManyRequests
 	case params.CodeNotLeader:
 		status = http.StatusTemporaryRedirect
 	case params.CodeLeaseError:
@@ -239,6 +242,10 @@ func ServerError(err error) *params.Error {
 		code = params.CodeNotLeader
 		rawErr := errors.Cause(err).(*NotLeaderError)
 		info = rawErr.AsMap()
+	case IsRetryAfterError(err):
+		code = params.CodeRetryAfter
+		rawErr := errors.Cause(err).(*RetryAfterError)
+		info = rawErr.AsMap()
 	case IsDeadlineExceededError(err):
 		code = params.CodeDeadlineExceeded
 	case lease.IsLeaseError(err):
@@ -352,6 +359,19 @@ func RestoreError(err error) error {
 		serverAddress, _ := e.Info["server-address"].(string)
 		serverID, _ := e.Info["server-id"].(string)
 		return NewNotLeaderError(serverAddress, serverID)
+	case params.IsCodeRetryAfter(err):
+		e, ok := err.(*params.Error)
+		if !ok {
+			return err
+		}
+		var seconds float64
+		switch v := e.Info["retry-after-seconds"].(type) {
+		case float64:
+			seconds = v
+		case int:
+			seconds = float64(v)
+		}
+		return NewRetryAfterError(msg, time.Duration(seconds)*time.Second)
 	case params.IsCodeDeadlineExceeded(err):
 		return NewDeadlineExceededError(msg)
 	case params.IsLeaseError(err):
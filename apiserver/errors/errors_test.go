@@ -8,6 +8,7 @@ import (
 	stderrors "errors"
 	"net/http"
 	"reflect"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v4"
@@ -244,6 +245,11 @@ var errorTransformTests = []struct {
 	err:    apiservererrors.NewDeadlineExceededError("enqueueing deadline exceeded"),
 	code:   params.CodeDeadlineExceeded,
 	status: http.StatusInternalServerError,
+}, {
+	err:        apiservererrors.NewRetryAfterError("controller overloaded", time.Minute),
+	code:       params.CodeRetryAfter,
+	status:     http.StatusTooManyRequests,
+	helperFunc: params.IsCodeRetryAfter,
 }, {
 	err:        lease.ErrHeld,
 	code:       params.CodeLeaseError,
@@ -6,6 +6,7 @@ package errors
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-macaroon-bakery/macaroon-bakery/v3/bakery"
 	"github.com/juju/errors"
@@ -222,6 +223,58 @@ func IsNotLeaderError(err error) bool {
 	return ok
 }
 
+// RetryAfterError is returned when the controller is too overloaded to
+// service a request, typically a bulk agent operation. It carries a hint
+// for how long the client should back off before retrying; clients are
+// expected to add their own jitter to the delay so that retries from a
+// large population of agents do not arrive in lockstep.
+type RetryAfterError struct {
+	message    string
+	retryAfter time.Duration
+}
+
+func (e *RetryAfterError) Error() string {
+	return e.message
+}
+
+// RetryAfter returns the hinted delay to wait before retrying.
+func (e *RetryAfterError) RetryAfter() time.Duration {
+	return e.retryAfter
+}
+
+// AsMap returns a map of the error. Useful when crossing the facade boundary
+// and wanting information in the client.
+func (e *RetryAfterError) AsMap() map[string]interface{} {
+	return map[string]interface{}{
+		"retry-after-seconds": int(e.retryAfter / time.Second),
+	}
+}
+
+// NewRetryAfterError creates a new RetryAfterError with the underlying
+// message and the delay to hint to the client.
+func NewRetryAfterError(message string, retryAfter time.Duration) error {
+	return &RetryAfterError{
+		message:    message,
+		retryAfter: retryAfter,
+	}
+}
+
+// IsRetryAfterError returns true if the error is a RetryAfterError.
+func IsRetryAfterError(err error) bool {
+	_, ok := errors.Cause(err).(*RetryAfterError)
+	return ok
+}
+
+// RetryAfterDelay returns the backoff hint carried by a RetryAfterError,
+// if err is one, together with whether it was.
+func RetryAfterDelay(err error) (time.Duration, bool) {
+	e, ok := errors.Cause(err).(*RetryAfterError)
+	if !ok {
+		return 0, false
+	}
+	return e.retryAfter, true
+}
+
 // DeadlineExceededError creates a typed error for when a raft operation is
 // enqueued, but the deadline is exceeded.
 type DeadlineExceededError struct {
@@ -26,6 +26,7 @@ type Context struct {
 	MultiwatcherFactory_ multiwatcher.Factory
 	ID_                  string
 	RequestRecorder_     facade.RequestRecorder
+	LogNoise_            facade.LogNoise
 	Cancel_              <-chan struct{}
 
 	LeadershipClaimer_ leadership.Claimer
@@ -34,6 +35,9 @@ type Context struct {
 	LeadershipPinner_  leadership.Pinner
 	LeadershipReader_  leadership.Reader
 	SingularClaimer_   lease.Claimer
+	UserLeaseClaimer_  lease.Claimer
+	UserLeaseRevoker_  lease.Revoker
+	UserLeaseReader_   lease.Reader
 	Raft_              facade.RaftContext
 	// Identity is not part of the facade.Context interface, but is instead
 	// used to make sure that the context objects are the same.
@@ -111,6 +115,11 @@ func (context Context) ModelPresence(modelUUID string) facade.ModelPresence {
 	return nil
 }
 
+// LogNoise implements facade.Context.
+func (context Context) LogNoise() facade.LogNoise {
+	return context.LogNoise_
+}
+
 // LeadershipClaimer implements facade.Context.
 func (context Context) LeadershipClaimer(modelUUID string) (leadership.Claimer, error) {
 	return context.LeadershipClaimer_, nil
@@ -141,6 +150,21 @@ func (context Context) SingularClaimer() (lease.Claimer, error) {
 	return context.SingularClaimer_, nil
 }
 
+// UserLeaseClaimer implements facade.Context.
+func (context Context) UserLeaseClaimer() (lease.Claimer, error) {
+	return context.UserLeaseClaimer_, nil
+}
+
+// UserLeaseRevoker implements facade.Context.
+func (context Context) UserLeaseRevoker() (lease.Revoker, error) {
+	return context.UserLeaseRevoker_, nil
+}
+
+// UserLeaseReader implements facade.Context.
+func (context Context) UserLeaseReader() (lease.Reader, error) {
+	return context.UserLeaseReader_, nil
+}
+
 func (context Context) Raft() facade.RaftContext {
 	return context.Raft_
 }
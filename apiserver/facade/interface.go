@@ -13,6 +13,7 @@ import (
 	"github.com/juju/juju/core/cache"
 	"github.com/juju/juju/core/leadership"
 	"github.com/juju/juju/core/lease"
+	"github.com/juju/juju/core/lognoise"
 	"github.com/juju/juju/core/multiwatcher"
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/presence"
@@ -54,6 +55,18 @@ type LeadershipContext interface {
 	// SingularClaimer returns a lease.Claimer for singular leases for
 	// this context's model.
 	SingularClaimer() (lease.Claimer, error)
+
+	// UserLeaseClaimer returns a lease.Claimer for user-defined leases
+	// in this context's model.
+	UserLeaseClaimer() (lease.Claimer, error)
+
+	// UserLeaseRevoker returns a lease.Revoker for user-defined leases
+	// in this context's model.
+	UserLeaseRevoker() (lease.Revoker, error)
+
+	// UserLeaseReader returns a lease.Reader for user-defined leases
+	// in this context's model.
+	UserLeaseReader() (lease.Reader, error)
 }
 
 // RaftContext describes methods for handling raft related capabilities.
@@ -142,6 +155,10 @@ type Context interface {
 	// the current model presence.
 	Presence() Presence
 
+	// LogNoise returns an instance that is able to report the
+	// noisiest log-writing agents known to this API server.
+	LogNoise() LogNoise
+
 	// Hub returns the central hub that the API server holds.
 	// At least at this stage, facades only need to publish events.
 	Hub() Hub
@@ -251,6 +268,15 @@ type ModelPresence interface {
 	AgentStatus(agent string) (presence.Status, error)
 }
 
+// LogNoise represents the record of recent log writes made through the
+// API servers' logsink endpoint.
+type LogNoise interface {
+	// NoisiestAgents returns up to limit agents in the given model,
+	// ordered by the number of log messages each has written over the
+	// given window, noisiest first.
+	NoisiestAgents(modelUUID string, window time.Duration, limit int) []lognoise.Entry
+}
+
 // Hub represents the central hub that the API server has.
 type Hub interface {
 	Publish(topic string, data interface{}) (func(), error)
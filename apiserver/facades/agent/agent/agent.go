@@ -22,6 +22,121 @@ import (
 	"github.com/juju/juju/state/watcher"
 )
 
+// AgentAPIV4 implements version 4 of the API provided to an agent.
+// It adds RecordHealth, DisabledWorkers and WatchDisabledWorkers.
+type AgentAPIV4 struct {
+	*AgentAPIV3
+}
+
+// NewAgentAPIV4 returns an object implementing version 4 of the Agent API
+// with the given authorizer representing the currently logged in client.
+func NewAgentAPIV4(st *state.State, resources facade.Resources, auth facade.Authorizer) (*AgentAPIV4, error) {
+	v3, err := NewAgentAPIV3(st, resources, auth)
+	if err != nil {
+		return nil, err
+	}
+	return &AgentAPIV4{v3}, nil
+}
+
+// RecordHealth records the worker health reported by the calling
+// agent so that it can be inspected from the controller.
+func (api *AgentAPIV4) RecordHealth(args params.AgentHealthArgs) (params.ErrorResults, error) {
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Args)),
+	}
+	for i, arg := range args.Args {
+		tag, err := names.ParseTag(arg.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		// Agents may only report on themselves.
+		if !api.auth.AuthOwner(tag) {
+			results.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+		workers := make([]state.WorkerHealth, len(arg.Workers))
+		for j, w := range arg.Workers {
+			workers[j] = state.WorkerHealth{
+				Name:       w.Name,
+				State:      w.State,
+				Error:      w.Error,
+				StartCount: w.StartCount,
+			}
+		}
+		results.Results[i].Error = apiservererrors.ServerError(api.st.SetAgentHealth(tag, workers))
+	}
+	return results, nil
+}
+
+// DisabledWorkers returns the names of the agent workers that have been
+// administratively disabled for each given machine, including any
+// disabled model-wide.
+func (api *AgentAPIV4) DisabledWorkers(args params.Entities) (params.StringsResults, error) {
+	results := params.StringsResults{
+		Results: make([]params.StringsResult, len(args.Entities)),
+	}
+	for i, entity := range args.Entities {
+		machineTag, err := api.authDisabledWorkersTag(entity.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		workers, err := api.st.MachineDisabledWorkers(machineTag.Id())
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Result = workers
+	}
+	return results, nil
+}
+
+// WatchDisabledWorkers returns a NotifyWatcher for changes to the set
+// of disabled workers affecting each given machine.
+func (api *AgentAPIV4) WatchDisabledWorkers(args params.Entities) (params.NotifyWatchResults, error) {
+	results := params.NotifyWatchResults{
+		Results: make([]params.NotifyWatchResult, len(args.Entities)),
+	}
+	for i, entity := range args.Entities {
+		machineTag, err := api.authDisabledWorkersTag(entity.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		watch := api.st.WatchDisabledWorkers(machineTag)
+		// Consume the initial event. Technically, API calls to Watch
+		// 'transmit' the initial event in the Watch response. But
+		// NotifyWatchers have no state to transmit.
+		if _, ok := <-watch.Changes(); ok {
+			results.Results[i].NotifyWatcherId = api.resources.Register(watch)
+		} else {
+			err = watcher.EnsureErr(watch)
+			results.Results[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return results, nil
+}
+
+// authDisabledWorkersTag parses the given tag and ensures that it is
+// the machine tag of the calling agent; only machine agents apply
+// worker disabling.
+func (api *AgentAPIV4) authDisabledWorkersTag(tag string) (names.MachineTag, error) {
+	parsed, err := names.ParseTag(tag)
+	if err != nil {
+		return names.MachineTag{}, errors.Trace(err)
+	}
+	// Agents may only inspect their own disabled workers.
+	if !api.auth.AuthOwner(parsed) {
+		return names.MachineTag{}, apiservererrors.ErrPerm
+	}
+	machineTag, ok := parsed.(names.MachineTag)
+	if !ok {
+		return names.MachineTag{}, errors.NotSupportedf("disabled workers for %q", parsed)
+	}
+	return machineTag, nil
+}
+
 // AgentAPIV3 implements the version 3 of the API provided to an agent.
 type AgentAPIV3 struct {
 	*common.PasswordChanger
@@ -211,6 +211,63 @@ func (s *agentSuite) TestSetPasswordsShort(c *gc.C) {
 		"password is only 3 bytes long, and is not a valid Agent password")
 }
 
+func (s *agentSuite) TestRecordHealth(c *gc.C) {
+	api, err := agent.NewAgentAPIV4(s.State, s.resources, s.authorizer)
+	c.Assert(err, jc.ErrorIsNil)
+	workers := []params.WorkerHealth{{
+		Name:       "machiner",
+		State:      "started",
+		StartCount: 1,
+	}}
+	results, err := api.RecordHealth(params.AgentHealthArgs{
+		Args: []params.AgentHealthArg{
+			{Tag: "machine-0", Workers: workers},
+			{Tag: "machine-1", Workers: workers},
+			{Tag: "machine-42", Workers: workers},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{
+			{apiservertesting.ErrUnauthorized},
+			{nil},
+			{apiservertesting.ErrUnauthorized},
+		},
+	})
+	health, err := s.State.AgentHealth(s.machine1.Tag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(health.Workers, jc.DeepEquals, []state.WorkerHealth{{
+		Name:       "machiner",
+		State:      "started",
+		StartCount: 1,
+	}})
+}
+
+func (s *agentSuite) TestDisabledWorkers(c *gc.C) {
+	model, err := s.State.Model()
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.State.SetDisabledWorkers(model.ModelTag(), []string{"instance-mutater"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.State.SetDisabledWorkers(s.machine1.Tag(), []string{"fan-configurer"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	api, err := agent.NewAgentAPIV4(s.State, s.resources, s.authorizer)
+	c.Assert(err, jc.ErrorIsNil)
+	results, err := api.DisabledWorkers(params.Entities{Entities: []params.Entity{
+		{Tag: "machine-0"},
+		{Tag: "machine-1"},
+		{Tag: "machine-42"},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.DeepEquals, params.StringsResults{
+		Results: []params.StringsResult{
+			{Error: apiservertesting.ErrUnauthorized},
+			{Result: []string{"fan-configurer", "instance-mutater"}},
+			{Error: apiservertesting.ErrUnauthorized},
+		},
+	})
+}
+
 func (s *agentSuite) TestClearReboot(c *gc.C) {
 	api, err := agent.NewAgentAPIV2(s.State, s.resources, s.authorizer)
 	c.Assert(err, jc.ErrorIsNil)
@@ -0,0 +1,106 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+package dnsconfigurer
+
+import (
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/apiserver/common"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/state/watcher"
+)
+
+// DNSConfigurer defines the methods on the dnsconfigurer API endpoint.
+type DNSConfigurer interface {
+	WatchForDNSConfigChanges() (params.NotifyWatchResult, error)
+	DNSConfig() (params.DNSConfigResult, error)
+}
+
+// Machine defines the machine methods this facade needs, so they can
+// be mocked for testing.
+type Machine interface {
+	DNSConfigOverride() (servers, searchDomains []string)
+	Watch() state.NotifyWatcher
+}
+
+type DNSConfigurerAPI struct {
+	model     state.ModelAccessor
+	machine   Machine
+	resources facade.Resources
+}
+
+var _ DNSConfigurer = (*DNSConfigurerAPI)(nil)
+
+// NewDNSConfigurerAPI creates a new DNSConfigurer API endpoint on server-side.
+func NewDNSConfigurerAPI(st *state.State, resources facade.Resources, authorizer facade.Authorizer) (*DNSConfigurerAPI, error) {
+	model, err := st.Model()
+	if err != nil {
+		return nil, err
+	}
+	var machine Machine
+	if tag, ok := authorizer.GetAuthTag().(names.MachineTag); ok {
+		machine, err = st.Machine(tag.Id())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return NewDNSConfigurerAPIForModel(model, machine, resources, authorizer)
+}
+
+func NewDNSConfigurerAPIForModel(model state.ModelAccessor, machine Machine, resources facade.Resources, authorizer facade.Authorizer) (*DNSConfigurerAPI, error) {
+	// Only machine agents have access to the dnsconfigurer service.
+	if !authorizer.AuthMachineAgent() {
+		return nil, apiservererrors.ErrPerm
+	}
+
+	return &DNSConfigurerAPI{
+		model:     model,
+		machine:   machine,
+		resources: resources,
+	}, nil
+}
+
+// WatchForDNSConfigChanges returns a NotifyWatcher that observes
+// changes to the model's DNS configuration or to the machine's
+// override of it.
+func (m *DNSConfigurerAPI) WatchForDNSConfigChanges() (params.NotifyWatchResult, error) {
+	result := params.NotifyWatchResult{}
+	watchers := []state.NotifyWatcher{m.model.WatchForModelConfigChanges()}
+	if m.machine != nil {
+		watchers = append(watchers, m.machine.Watch())
+	}
+	watch := common.NewMultiNotifyWatcher(watchers...)
+	// Consume the initial event. Technically, API
+	// calls to Watch 'transmit' the initial event
+	// in the Watch response. But NotifyWatchers
+	// have no state to transmit.
+	if _, ok := <-watch.Changes(); ok {
+		result.NotifyWatcherId = m.resources.Register(watch)
+	} else {
+		return result, watcher.EnsureErr(watch)
+	}
+	return result, nil
+}
+
+// DNSConfig returns the DNS configuration the machine should apply,
+// taking any machine-specific override into account.
+func (m *DNSConfigurerAPI) DNSConfig() (params.DNSConfigResult, error) {
+	result := params.DNSConfigResult{}
+	config, err := m.model.ModelConfig()
+	if err != nil {
+		return result, err
+	}
+	result.Servers = config.DNSServers()
+	result.SearchDomains = config.DNSSearchDomains()
+	if m.machine != nil {
+		servers, searchDomains := m.machine.DNSConfigOverride()
+		if len(servers) > 0 || len(searchDomains) > 0 {
+			result.Servers = servers
+			result.SearchDomains = searchDomains
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,158 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package dnsconfigurer_test
+
+import (
+	"fmt"
+
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/facades/agent/dnsconfigurer"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/testing"
+)
+
+type dnsconfigurerSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&dnsconfigurerSuite{})
+
+type fakeModelAccessor struct {
+	modelConfig      *config.Config
+	modelConfigError error
+}
+
+func (*fakeModelAccessor) WatchForModelConfigChanges() state.NotifyWatcher {
+	return apiservertesting.NewFakeNotifyWatcher()
+}
+
+func (f *fakeModelAccessor) ModelConfig() (*config.Config, error) {
+	if f.modelConfigError != nil {
+		return nil, f.modelConfigError
+	}
+	return f.modelConfig, nil
+}
+
+type fakeMachine struct {
+	servers       []string
+	searchDomains []string
+}
+
+func (f *fakeMachine) DNSConfigOverride() ([]string, []string) {
+	return f.servers, f.searchDomains
+}
+
+func (*fakeMachine) Watch() state.NotifyWatcher {
+	return apiservertesting.NewFakeNotifyWatcher()
+}
+
+func modelConfig(c *gc.C, attrs testing.Attrs) *config.Config {
+	cfg, err := config.New(config.UseDefaults, testing.FakeConfig().Merge(attrs))
+	c.Assert(err, jc.ErrorIsNil)
+	return cfg
+}
+
+func (s *dnsconfigurerSuite) TestWatchSuccess(c *gc.C) {
+	resources := common.NewResources()
+	authorizer := apiservertesting.FakeAuthorizer{
+		Tag: names.NewMachineTag("0"),
+	}
+	s.AddCleanup(func(_ *gc.C) { resources.StopAll() })
+	e, err := dnsconfigurer.NewDNSConfigurerAPIForModel(
+		&fakeModelAccessor{},
+		&fakeMachine{},
+		resources,
+		authorizer,
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	result, err := e.WatchForDNSConfigChanges()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.NotifyWatcherId, gc.Equals, "1")
+	c.Assert(result.Error, gc.IsNil)
+	c.Assert(resources.Count(), gc.Equals, 1)
+}
+
+func (s *dnsconfigurerSuite) TestWatchAuthFailed(c *gc.C) {
+	resources := common.NewResources()
+	authorizer := apiservertesting.FakeAuthorizer{
+		Tag: names.NewUserTag("vito"),
+	}
+	s.AddCleanup(func(_ *gc.C) { resources.StopAll() })
+	_, err := dnsconfigurer.NewDNSConfigurerAPIForModel(
+		&fakeModelAccessor{},
+		&fakeMachine{},
+		resources,
+		authorizer,
+	)
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
+
+func (s *dnsconfigurerSuite) TestDNSConfigFromModel(c *gc.C) {
+	authorizer := apiservertesting.FakeAuthorizer{
+		Tag: names.NewMachineTag("0"),
+	}
+	e, err := dnsconfigurer.NewDNSConfigurerAPIForModel(
+		&fakeModelAccessor{
+			modelConfig: modelConfig(c, testing.Attrs{
+				"dns-servers":        "10.0.0.1, 10.0.0.2",
+				"dns-search-domains": "example.com",
+			}),
+		},
+		&fakeMachine{},
+		nil,
+		authorizer,
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	result, err := e.DNSConfig()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Servers, gc.DeepEquals, []string{"10.0.0.1", "10.0.0.2"})
+	c.Check(result.SearchDomains, gc.DeepEquals, []string{"example.com"})
+}
+
+func (s *dnsconfigurerSuite) TestDNSConfigMachineOverride(c *gc.C) {
+	authorizer := apiservertesting.FakeAuthorizer{
+		Tag: names.NewMachineTag("0"),
+	}
+	e, err := dnsconfigurer.NewDNSConfigurerAPIForModel(
+		&fakeModelAccessor{
+			modelConfig: modelConfig(c, testing.Attrs{
+				"dns-servers": "10.0.0.1",
+			}),
+		},
+		&fakeMachine{
+			servers:       []string{"192.168.1.1"},
+			searchDomains: []string{"internal.example.com"},
+		},
+		nil,
+		authorizer,
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	result, err := e.DNSConfig()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Servers, gc.DeepEquals, []string{"192.168.1.1"})
+	c.Check(result.SearchDomains, gc.DeepEquals, []string{"internal.example.com"})
+}
+
+func (s *dnsconfigurerSuite) TestDNSConfigFetchError(c *gc.C) {
+	authorizer := apiservertesting.FakeAuthorizer{
+		Tag: names.NewMachineTag("0"),
+	}
+	e, err := dnsconfigurer.NewDNSConfigurerAPIForModel(
+		&fakeModelAccessor{
+			modelConfigError: fmt.Errorf("pow"),
+		},
+		&fakeMachine{},
+		nil,
+		authorizer,
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = e.DNSConfig()
+	c.Assert(err, gc.ErrorMatches, "pow")
+}
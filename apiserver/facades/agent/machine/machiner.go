@@ -186,3 +186,32 @@ func (api *MachinerAPI) RecordAgentStartInformation(args params.RecordAgentStart
 	}
 	return results, nil
 }
+
+// SetOSInfo records the kernel version, OS patch level and
+// reboot-required flag reported by machine agents.
+func (api *MachinerAPI) SetOSInfo(args params.SetMachineOSInfoArgs) (params.ErrorResults, error) {
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Args)),
+	}
+	canModify, err := api.getCanModify()
+	if err != nil {
+		return results, err
+	}
+
+	for i, arg := range args.Args {
+		m, err := api.getMachine(arg.Tag, canModify)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		info := state.MachineOSInfo{
+			KernelVersion:  arg.KernelVersion,
+			OSPatchLevel:   arg.OSPatchLevel,
+			RebootRequired: arg.RebootRequired,
+		}
+		if err := m.SetOSInfo(info); err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return results, nil
+}
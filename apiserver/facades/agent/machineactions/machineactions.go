@@ -7,6 +7,7 @@
 package machineactions
 
 import (
+	"github.com/juju/errors"
 	"github.com/juju/names/v4"
 
 	"github.com/juju/juju/apiserver/common"
@@ -23,6 +24,15 @@ type Backend interface {
 	ConvertActions(ar state.ActionReceiver, fn common.GetActionsFn) ([]params.ActionResult, error)
 }
 
+// FacadeV1 implements the V1 machineactions interface,
+// which lacks LogActionsMessages.
+type FacadeV1 struct {
+	*Facade
+}
+
+// LogActionsMessages is not available in V1.
+func (*FacadeV1) LogActionsMessages(_, _ struct{}) {}
+
 // Facade implements the machineactions interface and is the concrete
 // implementation of the api end point.
 type Facade struct {
@@ -66,6 +76,28 @@ func (f *Facade) FinishActions(args params.ActionExecutionResults) params.ErrorR
 	return common.FinishActions(args, actionFn)
 }
 
+// LogActionsMessages records the log messages against the specified actions.
+func (f *Facade) LogActionsMessages(args params.ActionMessageParams) params.ErrorResults {
+	actionFn := common.AuthAndActionFromTagFn(f.accessMachine, f.backend.ActionByTag)
+
+	oneActionMessage := func(actionTag string, message string) error {
+		action, err := actionFn(actionTag)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		return action.Log(message)
+	}
+
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Messages)),
+	}
+	for i, actionMessage := range args.Messages {
+		result.Results[i].Error = apiservererrors.ServerError(
+			oneActionMessage(actionMessage.Tag, actionMessage.Value))
+	}
+	return result
+}
+
 // WatchActionNotifications returns a StringsWatcher for observing
 // incoming action calls to a machine.
 func (f *Facade) WatchActionNotifications(args params.Entities) params.StringsWatchResults {
@@ -103,6 +103,20 @@ func (mr *MockMachineMockRecorder) Constraints() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Constraints", reflect.TypeOf((*MockMachine)(nil).Constraints))
 }
 
+// ContainerNetworkDevices mocks base method.
+func (m *MockMachine) ContainerNetworkDevices() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainerNetworkDevices")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// ContainerNetworkDevices indicates an expected call of ContainerNetworkDevices.
+func (mr *MockMachineMockRecorder) ContainerNetworkDevices() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainerNetworkDevices", reflect.TypeOf((*MockMachine)(nil).ContainerNetworkDevices))
+}
+
 // ContainerType mocks base method.
 func (m *MockMachine) ContainerType() instance.ContainerType {
 	m.ctrl.T.Helper()
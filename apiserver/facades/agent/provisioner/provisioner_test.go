@@ -1929,6 +1929,7 @@ func (s *provisionerMockSuite) expectManuallyProvisionedHostsUseDHCPForContainer
 	// PopulateContainerLinkLayerDevices expectation will not be satisfied.
 	hExp.IsManual().Return(true, nil)
 	hExp.InstanceId().Return(instance.Id("manual:10.0.0.66"), nil)
+	hExp.ContainerNetworkDevices().Return(nil).AnyTimes()
 }
 
 // expectNetworkingEnviron stubs an environ that supports container networking.
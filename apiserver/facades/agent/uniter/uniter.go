@@ -42,9 +42,9 @@ var logger = loggo.GetLogger("juju.apiserver.uniter")
 // TODO (manadart 2020-10-21): Remove the ModelUUID method
 // from the next version of this facade.
 
-// UniterAPI implements the latest version (v18) of the Uniter API, which
-// augments the payload of the CommitHookChanges API call and introduces
-// the OpenedMachinePortRanges call as a replacement for AllMachinePorts.
+// UniterAPI implements the latest version (v19) of the Uniter API,
+// which adds WatchCharmSync and CharmSync for applying charm files
+// live-synced by `juju refresh --path --watch`.
 type UniterAPI struct {
 	*common.LifeGetter
 	*StatusAPI
@@ -83,10 +83,17 @@ type UniterAPI struct {
 	cloudSpecer     cloudspec.CloudSpecer
 }
 
+// UniterAPIV18 implements version (v18) of the Uniter API, which
+// augments the payload of the CommitHookChanges API call and introduces
+// the OpenedMachinePortRanges call as a replacement for AllMachinePorts.
+type UniterAPIV18 struct {
+	UniterAPI
+}
+
 // UniterAPIV17 implements version (v17) of the Uniter API, which adds
 // CloudSpec v2
 type UniterAPIV17 struct {
-	UniterAPI
+	UniterAPIV18
 }
 
 // UniterAPIV16 implements version (v16) of the Uniter API.
@@ -184,9 +191,20 @@ func NewUniterAPI(context facade.Context) (*UniterAPI, error) {
 	}, nil
 }
 
+// NewUniterAPIV18 creates an instance of the V18 uniter API.
+func NewUniterAPIV18(context facade.Context) (*UniterAPIV18, error) {
+	uniterAPI, err := NewUniterAPI(context)
+	if err != nil {
+		return nil, err
+	}
+	return &UniterAPIV18{
+		UniterAPI: *uniterAPI,
+	}, nil
+}
+
 // NewUniterAPIV17 creates an instance of the V17 uniter API.
 func NewUniterAPIV17(context facade.Context) (*UniterAPIV17, error) {
-	uniterAPI, err := NewUniterAPI(context)
+	uniterAPI, err := NewUniterAPIV18(context)
 	if err != nil {
 		return nil, err
 	}
@@ -204,7 +222,7 @@ func NewUniterAPIV17(context facade.Context) (*UniterAPIV17, error) {
 		common.AuthFuncForTag(m.ModelTag()),
 	)
 	return &UniterAPIV17{
-		UniterAPI: *uniterAPI,
+		UniterAPIV18: *uniterAPI,
 	}, nil
 }
 
@@ -3110,3 +3128,94 @@ func (u *UniterAPI) LXDProfileRequired(args params.CharmURLs) (params.BoolResult
 func (u *UniterAPI) CanApplyLXDProfile(args params.Entities) (params.BoolResults, error) {
 	return u.lxdProfileAPI.CanApplyLXDProfile(args)
 }
+
+// WatchCharmSync returns a NotifyWatcher for observing changes to the
+// charm sync overlay of each given unit's application. The overlay
+// holds charm files live-synced by `juju refresh --path --watch`.
+func (u *UniterAPI) WatchCharmSync(args params.Entities) (params.NotifyWatchResults, error) {
+	result := params.NotifyWatchResults{
+		Results: make([]params.NotifyWatchResult, len(args.Entities)),
+	}
+	canAccess, err := u.accessUnit()
+	if err != nil {
+		return params.NotifyWatchResults{}, errors.Trace(err)
+	}
+	for i, entity := range args.Entities {
+		tag, err := names.ParseUnitTag(entity.Tag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+		if !canAccess(tag) {
+			result.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+		unit, err := u.getUnit(tag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		app, err := unit.Application()
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		watch := app.WatchCharmSync()
+		// Consume the initial event.
+		if _, ok := <-watch.Changes(); ok {
+			result.Results[i].NotifyWatcherId = u.resources.Register(watch)
+		} else {
+			result.Results[i].Error = apiservererrors.ServerError(watcher.EnsureErr(watch))
+		}
+	}
+	return result, nil
+}
+
+// CharmSync returns the charm sync overlay for each given unit's
+// application. Units with no overlay get a zero generation and no
+// files.
+func (u *UniterAPI) CharmSync(args params.Entities) (params.CharmSyncResults, error) {
+	result := params.CharmSyncResults{
+		Results: make([]params.CharmSyncResult, len(args.Entities)),
+	}
+	canAccess, err := u.accessUnit()
+	if err != nil {
+		return params.CharmSyncResults{}, errors.Trace(err)
+	}
+	for i, entity := range args.Entities {
+		tag, err := names.ParseUnitTag(entity.Tag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+		if !canAccess(tag) {
+			result.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+		appName, err := names.UnitApplication(tag.Id())
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		sync, err := u.st.CharmSync(appName)
+		if errors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		result.Results[i].Generation = sync.Generation()
+		files := make([]params.CharmSyncFile, len(sync.Files()))
+		for j, f := range sync.Files() {
+			files[j] = params.CharmSyncFile{
+				Path:    f.Path,
+				Content: f.Content,
+				Mode:    uint32(f.Mode),
+				Removed: f.Removed,
+			}
+		}
+		result.Results[i].Files = files
+	}
+	return result, nil
+}
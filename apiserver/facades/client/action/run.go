@@ -100,7 +100,7 @@ func (a *ActionAPI) Run(run params.RunParams) (results params.EnqueuedActions, e
 		machines[i] = names.NewMachineTag(machineId)
 	}
 
-	actionParams, err := a.createRunActionsParams(append(units, machines...), run.Commands, run.Timeout, run.WorkloadContext, run.Parallel, run.ExecutionGroup)
+	actionParams, err := a.createRunActionsParams(append(units, machines...), run.Commands, run.Timeout, run.WorkloadContext, run.Parallel, run.ExecutionGroup, run.OutputLimit, run.CaptureFiles)
 	if err != nil {
 		return results, errors.Trace(err)
 	}
@@ -134,7 +134,7 @@ func (a *ActionAPI) RunOnAllMachines(run params.RunParams) (results params.Enque
 		machineTags[i] = machine.Tag()
 	}
 
-	actionParams, err := a.createRunActionsParams(machineTags, run.Commands, run.Timeout, false, run.Parallel, run.ExecutionGroup)
+	actionParams, err := a.createRunActionsParams(machineTags, run.Commands, run.Timeout, false, run.Parallel, run.ExecutionGroup, run.OutputLimit, run.CaptureFiles)
 	if err != nil {
 		return results, errors.Trace(err)
 	}
@@ -148,6 +148,8 @@ func (a *ActionAPI) createRunActionsParams(
 	workloadContext bool,
 	parallel *bool,
 	executionGroup *string,
+	outputLimit int,
+	captureFiles []string,
 ) (params.Actions, error) {
 	apiActionParams := params.Actions{Actions: []params.Action{}}
 
@@ -155,10 +157,25 @@ func (a *ActionAPI) createRunActionsParams(
 		return apiActionParams, errors.NewNotSupported(nil, fmt.Sprintf("cannot use %q as an action command", quotedCommands))
 	}
 
+	if outputLimit < 0 {
+		return apiActionParams, errors.NotValidf("negative output limit")
+	}
 	actionParams := map[string]interface{}{}
 	actionParams["command"] = quotedCommands
 	actionParams["timeout"] = timeout.Nanoseconds()
 	actionParams["workload-context"] = workloadContext
+	if outputLimit > 0 {
+		actionParams["output-limit"] = outputLimit
+	}
+	if len(captureFiles) > 0 {
+		// The parameters are serialised to JSON, so the paths need to
+		// be a []interface{} to satisfy the action schema validation.
+		files := make([]interface{}, len(captureFiles))
+		for i, f := range captureFiles {
+			files[i] = f
+		}
+		actionParams["capture-files"] = files
+	}
 
 	for _, tag := range actionReceiverTags {
 		apiActionParams.Actions = append(apiActionParams.Actions, params.Action{
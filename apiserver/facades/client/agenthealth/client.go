@@ -0,0 +1,113 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package agenthealth
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/state"
+)
+
+// Backend defines the state methods this facade needs, so they can be
+// mocked for testing.
+type Backend interface {
+	ModelTag() names.ModelTag
+	AgentHealth(names.Tag) (state.AgentHealth, error)
+}
+
+var getState = func(st *state.State) (Backend, error) {
+	m, err := st.Model()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return stateShim{st, m}, nil
+}
+
+type stateShim struct {
+	*state.State
+	model *state.Model
+}
+
+func (s stateShim) ModelTag() names.ModelTag {
+	return s.model.ModelTag()
+}
+
+// API implements the AgentHealth facade, which exposes the worker
+// health reports recorded by agents.
+type API struct {
+	backend    Backend
+	authorizer facade.Authorizer
+}
+
+// NewAPI returns a new agent health API facade.
+func NewAPI(
+	st *state.State,
+	resources facade.Resources,
+	authorizer facade.Authorizer,
+) (*API, error) {
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+	backend, err := getState(st)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &API{
+		backend:    backend,
+		authorizer: authorizer,
+	}, nil
+}
+
+func (api *API) checkCanRead() error {
+	canRead, err := api.authorizer.HasPermission(permission.ReadAccess, api.backend.ModelTag())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !canRead {
+		return apiservererrors.ErrPerm
+	}
+	return nil
+}
+
+// AgentHealth returns the most recent self-reported worker health for
+// each of the given agents.
+func (api *API) AgentHealth(args params.Entities) (params.AgentHealthResults, error) {
+	if err := api.checkCanRead(); err != nil {
+		return params.AgentHealthResults{}, errors.Trace(err)
+	}
+	results := params.AgentHealthResults{
+		Results: make([]params.AgentHealthResult, len(args.Entities)),
+	}
+	for i, entity := range args.Entities {
+		tag, err := names.ParseTag(entity.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		health, err := api.backend.AgentHealth(tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		workers := make([]params.WorkerHealth, len(health.Workers))
+		for j, w := range health.Workers {
+			workers[j] = params.WorkerHealth{
+				Name:       w.Name,
+				State:      w.State,
+				Error:      w.Error,
+				StartCount: w.StartCount,
+			}
+		}
+		results.Results[i] = params.AgentHealthResult{
+			Updated: health.Updated,
+			Workers: workers,
+		}
+	}
+	return results, nil
+}
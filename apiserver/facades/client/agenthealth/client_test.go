@@ -0,0 +1,95 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package agenthealth_test
+
+import (
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/facades/client/agenthealth"
+	"github.com/juju/juju/apiserver/params"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/state"
+	coretesting "github.com/juju/juju/testing"
+)
+
+func TestPackage(t *stdtesting.T) {
+	gc.TestingT(t)
+}
+
+type agentHealthSuite struct {
+	coretesting.BaseSuite
+
+	backend    *mockBackend
+	authorizer apiservertesting.FakeAuthorizer
+	api        *agenthealth.API
+}
+
+var _ = gc.Suite(&agentHealthSuite{})
+
+func (s *agentHealthSuite) SetUpTest(c *gc.C) {
+	s.BaseSuite.SetUpTest(c)
+	s.backend = &mockBackend{
+		health: make(map[string]state.AgentHealth),
+	}
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag:         names.NewUserTag("admin"),
+		HasWriteTag: names.NewUserTag("admin"),
+	}
+	s.api = agenthealth.NewAPIForTest(s.backend, s.authorizer)
+}
+
+func (s *agentHealthSuite) TestAgentHealth(c *gc.C) {
+	updated := time.Date(2021, 11, 1, 12, 0, 0, 0, time.UTC)
+	s.backend.health["machine-1"] = state.AgentHealth{
+		Updated: updated,
+		Workers: []state.WorkerHealth{{
+			Name:       "machiner",
+			State:      "started",
+			StartCount: 1,
+		}},
+	}
+	results, err := s.api.AgentHealth(params.Entities{
+		Entities: []params.Entity{{Tag: "machine-1"}, {Tag: "machine-2"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 2)
+	c.Assert(results.Results[0], jc.DeepEquals, params.AgentHealthResult{
+		Updated: updated,
+		Workers: []params.WorkerHealth{{
+			Name:       "machiner",
+			State:      "started",
+			StartCount: 1,
+		}},
+	})
+	c.Assert(results.Results[1].Error, jc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *agentHealthSuite) TestAgentHealthPermission(c *gc.C) {
+	s.authorizer.Tag = names.NewUserTag("intruder")
+	api := agenthealth.NewAPIForTest(s.backend, s.authorizer)
+	_, err := api.AgentHealth(params.Entities{})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
+
+type mockBackend struct {
+	health map[string]state.AgentHealth
+}
+
+func (b *mockBackend) ModelTag() names.ModelTag {
+	return names.NewModelTag(coretesting.ModelTag.Id())
+}
+
+func (b *mockBackend) AgentHealth(tag names.Tag) (state.AgentHealth, error) {
+	health, ok := b.health[tag.String()]
+	if !ok {
+		return state.AgentHealth{}, errors.NotFoundf("agent health for %q", tag)
+	}
+	return health, nil
+}
@@ -0,0 +1,17 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package agenthealth
+
+import (
+	"github.com/juju/juju/apiserver/facade"
+)
+
+// NewAPIForTest returns an API over the given backend, bypassing the
+// state lookup done by NewAPI.
+func NewAPIForTest(backend Backend, authorizer facade.Authorizer) *API {
+	return &API{
+		backend:    backend,
+		authorizer: authorizer,
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package agentpresence
+
+import (
+	"sort"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/presence"
+)
+
+// API exposes the agent connection details that the API servers have
+// recorded for a model.
+type API struct {
+	authorizer facade.Authorizer
+	modelTag   names.ModelTag
+	presence   facade.Presence
+}
+
+// NewFacade creates a new AgentPresence facade.
+func NewFacade(ctx facade.Context) (*API, error) {
+	m, err := ctx.State().Model()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return NewAPI(ctx.Auth(), m.ModelTag(), ctx.Presence())
+}
+
+// NewAPI returns a new AgentPresence API facade.
+func NewAPI(authorizer facade.Authorizer, modelTag names.ModelTag, modelPresence facade.Presence) (*API, error) {
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+	return &API{
+		authorizer: authorizer,
+		modelTag:   modelTag,
+		presence:   modelPresence,
+	}, nil
+}
+
+func (api *API) checkCanRead() error {
+	canRead, err := api.authorizer.HasPermission(permission.ReadAccess, api.modelTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !canRead {
+		return apiservererrors.ErrPerm
+	}
+	return nil
+}
+
+// AgentPresence returns the connection details held for every agent
+// connected to the model, including when each connection was last seen
+// and how many connections have been observed for the agent; a climbing
+// count indicates a flapping agent.
+func (api *API) AgentPresence() (params.AgentPresenceResult, error) {
+	if err := api.checkCanRead(); err != nil {
+		return params.AgentPresenceResult{}, errors.Trace(err)
+	}
+	// The full connection details, rather than the alive/missing summary
+	// used for status, are only available from the recorder's connection
+	// collection.
+	connections, ok := api.presence.ModelPresence(api.modelTag.Id()).(presence.Connections)
+	if !ok {
+		return params.AgentPresenceResult{}, errors.NotSupportedf("agent presence")
+	}
+	values := connections.Values()
+	result := params.AgentPresenceResult{
+		Connections: make([]params.AgentPresenceConnection, len(values)),
+	}
+	for i, value := range values {
+		result.Connections[i] = params.AgentPresenceConnection{
+			AgentTag:        value.Agent,
+			ControllerAgent: value.ControllerAgent,
+			Server:          value.Server,
+			Status:          value.Status.String(),
+			LastSeen:        value.LastSeen,
+			ConnectionCount: value.ConnectionCount,
+		}
+	}
+	sort.Slice(result.Connections, func(i, j int) bool {
+		left, right := result.Connections[i], result.Connections[j]
+		if left.AgentTag != right.AgentTag {
+			return left.AgentTag < right.AgentTag
+		}
+		return left.Server < right.Server
+	})
+	return result, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package agentpresence_test
+
+import (
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/names/v4"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/facades/client/agentpresence"
+	"github.com/juju/juju/apiserver/params"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/core/presence"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type facadeSuite struct {
+	testing.IsolationSuite
+	recorder presence.Recorder
+}
+
+var _ = gc.Suite(&facadeSuite{})
+
+func (s *facadeSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	// By using a testing clock with a zero time, the times are always empty.
+	s.recorder = presence.New(testclock.NewClock(time.Time{}))
+	s.recorder.Enable()
+
+	modelUUID := coretesting.ModelTag.Id()
+	s.recorder.Connect("machine-0", modelUUID, "machine-0", 100, false, "")
+	s.recorder.Connect("machine-0", modelUUID, "unit-mysql-0", 101, false, "")
+	s.recorder.Connect("machine-1", "deadbeef-1bad-500d-9000-4b1d0d06f00d", "machine-5", 102, false, "")
+
+	// The mysql unit agent bounces, coming back through a new connection.
+	s.recorder.Disconnect("machine-0", 101)
+	s.recorder.Connect("machine-0", modelUUID, "unit-mysql-0", 103, false, "")
+}
+
+func (s *facadeSuite) newAPI(c *gc.C, user names.UserTag) *agentpresence.API {
+	authorizer := apiservertesting.FakeAuthorizer{Tag: user}
+	api, err := agentpresence.NewAPI(authorizer, coretesting.ModelTag, stubPresence{s.recorder})
+	c.Assert(err, jc.ErrorIsNil)
+	return api
+}
+
+func (s *facadeSuite) TestAuthRejectsNonClient(c *gc.C) {
+	authorizer := apiservertesting.FakeAuthorizer{Tag: names.NewMachineTag("0")}
+	api, err := agentpresence.NewAPI(authorizer, coretesting.ModelTag, stubPresence{s.recorder})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+	c.Assert(api, gc.IsNil)
+}
+
+func (s *facadeSuite) TestAgentPresencePermissionDenied(c *gc.C) {
+	api := s.newAPI(c, names.NewUserTag("bob"))
+	_, err := api.AgentPresence()
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
+
+func (s *facadeSuite) TestAgentPresence(c *gc.C) {
+	api := s.newAPI(c, names.NewUserTag("read"))
+	result, err := api.AgentPresence()
+	c.Assert(err, jc.ErrorIsNil)
+	// Only connections for the requested model are reported, sorted by
+	// agent, and the bounced mysql agent shows two connections.
+	c.Assert(result.Connections, jc.DeepEquals, []params.AgentPresenceConnection{{
+		AgentTag:        "machine-0",
+		Server:          "machine-0",
+		Status:          "alive",
+		ConnectionCount: 1,
+	}, {
+		AgentTag:        "unit-mysql-0",
+		Server:          "machine-0",
+		Status:          "alive",
+		ConnectionCount: 2,
+	}})
+}
+
+func (s *facadeSuite) TestAgentPresenceServerDown(c *gc.C) {
+	s.recorder.ServerDown("machine-0")
+
+	api := s.newAPI(c, names.NewUserTag("read"))
+	result, err := api.AgentPresence()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Connections, gc.HasLen, 2)
+	for _, conn := range result.Connections {
+		c.Check(conn.Status, gc.Equals, "missing")
+	}
+}
+
+type stubPresence struct {
+	recorder presence.Recorder
+}
+
+func (s stubPresence) ModelPresence(modelUUID string) facade.ModelPresence {
+	return s.recorder.Connections().ForModel(modelUUID)
+}
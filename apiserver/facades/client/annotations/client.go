@@ -14,8 +14,12 @@ import (
 	"github.com/juju/juju/state"
 )
 
-var getState = func(st *state.State, m *state.Model) annotationAccess {
-	return stateShim{st, m}
+var getState = func(st *state.State) (annotationAccess, error) {
+	m, err := st.Model()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return stateShim{st, m}, nil
 }
 
 // Annotations defines the methods on the service API end point.
@@ -40,13 +44,13 @@ func NewAPI(
 	if !authorizer.AuthClient() {
 		return nil, apiservererrors.ErrPerm
 	}
-	m, err := st.Model()
+	access, err := getState(st)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 
 	return &API{
-		access:     getState(st, m),
+		access:     access,
 		authorizer: authorizer,
 	}, nil
 }
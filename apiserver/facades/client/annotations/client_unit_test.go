@@ -0,0 +1,113 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package annotations_test
+
+import (
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/facades/client/annotations"
+	"github.com/juju/juju/apiserver/params"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	coretesting "github.com/juju/juju/testing"
+)
+
+// annotationUnitSuite exercises the annotations facade against an
+// in-memory state double, so it runs without a mongo.
+type annotationUnitSuite struct {
+	coretesting.BaseSuite
+
+	st  *apiservertesting.FakeState
+	api *annotations.API
+}
+
+var _ = gc.Suite(&annotationUnitSuite{})
+
+func (s *annotationUnitSuite) SetUpTest(c *gc.C) {
+	s.BaseSuite.SetUpTest(c)
+
+	s.st = apiservertesting.NewFakeState(names.NewModelTag(coretesting.ModelTag.Id()))
+	annotations.PatchGetState(s, s.st)
+
+	adminTag := names.NewUserTag("admin")
+	auth := apiservertesting.FakeAuthorizer{
+		Tag:      adminTag,
+		AdminTag: adminTag,
+	}
+	var err error
+	s.api, err = annotations.NewAPI(nil, nil, auth)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *annotationUnitSuite) TestSetGetAnnotations(c *gc.C) {
+	machineTag := names.NewMachineTag("0")
+	s.st.AddEntity(machineTag)
+
+	setResults := s.api.Set(params.AnnotationsSet{Annotations: []params.EntityAnnotations{{
+		EntityTag:   machineTag.String(),
+		Annotations: map[string]string{"mine": "best"},
+	}}})
+	c.Assert(setResults.Results, gc.HasLen, 0)
+
+	getResults := s.api.Get(params.Entities{Entities: []params.Entity{{Tag: machineTag.String()}}})
+	c.Assert(getResults.Results, gc.HasLen, 1)
+	c.Assert(getResults.Results[0].Error.Error, gc.IsNil)
+	c.Assert(getResults.Results[0].Annotations, jc.DeepEquals, map[string]string{"mine": "best"})
+}
+
+func (s *annotationUnitSuite) TestUnsetAnnotations(c *gc.C) {
+	machineTag := names.NewMachineTag("0")
+	s.st.AddEntity(machineTag)
+
+	set := func(pairs map[string]string) {
+		results := s.api.Set(params.AnnotationsSet{Annotations: []params.EntityAnnotations{{
+			EntityTag:   machineTag.String(),
+			Annotations: pairs,
+		}}})
+		c.Assert(results.Results, gc.HasLen, 0)
+	}
+	set(map[string]string{"mine": "best", "yours": "worst"})
+	set(map[string]string{"yours": ""})
+
+	getResults := s.api.Get(params.Entities{Entities: []params.Entity{{Tag: machineTag.String()}}})
+	c.Assert(getResults.Results, gc.HasLen, 1)
+	c.Assert(getResults.Results[0].Annotations, jc.DeepEquals, map[string]string{"mine": "best"})
+}
+
+func (s *annotationUnitSuite) TestSetAnnotationsInvalidKey(c *gc.C) {
+	machineTag := names.NewMachineTag("0")
+	s.st.AddEntity(machineTag)
+
+	results := s.api.Set(params.AnnotationsSet{Annotations: []params.EntityAnnotations{{
+		EntityTag:   machineTag.String(),
+		Annotations: map[string]string{"invalid.key": "best"},
+	}}})
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.ErrorMatches, `.*invalid key "invalid.key".*`)
+}
+
+func (s *annotationUnitSuite) TestGetUnknownEntity(c *gc.C) {
+	getResults := s.api.Get(params.Entities{Entities: []params.Entity{{Tag: "machine-42"}}})
+	c.Assert(getResults.Results, gc.HasLen, 1)
+	c.Assert(getResults.Results[0].Error.Error, gc.ErrorMatches, ".*permission denied.*")
+}
+
+func (s *annotationUnitSuite) TestAnnotationsRemovedWithEntity(c *gc.C) {
+	machineTag := names.NewMachineTag("0")
+	s.st.AddEntity(machineTag)
+
+	results := s.api.Set(params.AnnotationsSet{Annotations: []params.EntityAnnotations{{
+		EntityTag:   machineTag.String(),
+		Annotations: map[string]string{"mine": "best"},
+	}}})
+	c.Assert(results.Results, gc.HasLen, 0)
+
+	s.st.RemoveEntity(machineTag)
+	s.st.AddEntity(machineTag)
+
+	getResults := s.api.Get(params.Entities{Entities: []params.Entity{{Tag: machineTag.String()}}})
+	c.Assert(getResults.Results, gc.HasLen, 1)
+	c.Assert(getResults.Results[0].Annotations, gc.HasLen, 0)
+}
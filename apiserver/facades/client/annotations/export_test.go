@@ -0,0 +1,18 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package annotations
+
+import (
+	"github.com/juju/juju/state"
+)
+
+type Patcher interface {
+	PatchValue(ptr, value interface{})
+}
+
+func PatchGetState(p Patcher, access annotationAccess) {
+	p.PatchValue(&getState, func(*state.State) (annotationAccess, error) {
+		return access, nil
+	})
+}
@@ -10,12 +10,14 @@ import (
 	"fmt"
 	"math"
 	"net"
+	"os"
 	"reflect"
 	"strings"
 	"time"
 
 	"github.com/juju/charm/v9"
 	csparams "github.com/juju/charmrepo/v7/csclient/params"
+	"github.com/juju/collections/set"
 	"github.com/juju/errors"
 	"github.com/juju/loggo"
 	"github.com/juju/names/v4"
@@ -64,6 +66,27 @@ var logger = loggo.GetLogger("juju.apiserver.application")
 
 // APIv13 provides the Application API facade for version 13.
 type APIv13 struct {
+	*APIv14
+}
+
+// APIv14 provides the Application API facade for version 14. It is
+// otherwise identical to V13 with the exception that V14 adds
+// SetRelationsFrozen.
+type APIv14 struct {
+	*APIv15
+}
+
+// APIv15 provides the Application API facade for version 15. It adds
+// staged config changesets via StageConfig, StagedConfig,
+// ApplyStagedConfig and DiscardStagedConfig, along with config history
+// via ConfigHistory and RollbackConfig.
+type APIv15 struct {
+	*APIv16
+}
+
+// APIv16 provides the Application API facade for version 16. It adds
+// ResolveUnitErrorsBySelector.
+type APIv16 struct {
 	*APIBase
 }
 
@@ -98,13 +121,43 @@ type APIBase struct {
 }
 
 func NewFacadeV13(ctx facade.Context) (*APIv13, error) {
-	api, err := newFacadeBase(ctx)
+	api, err := NewFacadeV14(ctx)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 	return &APIv13{api}, nil
 }
 
+func NewFacadeV14(ctx facade.Context) (*APIv14, error) {
+	api, err := NewFacadeV15(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIv14{api}, nil
+}
+
+func NewFacadeV15(ctx facade.Context) (*APIv15, error) {
+	api, err := NewFacadeV16(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIv15{api}, nil
+}
+
+func NewFacadeV16(ctx facade.Context) (*APIv16, error) {
+	api, err := NewFacadeV17(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIv16{api}, nil
+}
+
+// NewFacadeV17 returns the latest application facade. V17 adds
+// SyncCharmFiles.
+func NewFacadeV17(ctx facade.Context) (*APIBase, error) {
+	return newFacadeBase(ctx)
+}
+
 type caasBrokerInterface interface {
 	ValidateStorageClass(config map[string]interface{}) error
 	Version() (*version.Number, error)
@@ -430,6 +483,9 @@ func caasPrecheck(
 	if _, err := k8s.CaasServiceToK8s(caas.ServiceType(serviceType)); err != nil {
 		return errors.NotValidf("service type %q", serviceType)
 	}
+	if err := k8s.ValidateWorkloadPatch(args.Config[k8s.WorkloadPatchConfigKey]); err != nil {
+		return errors.Annotatef(err, "invalid %s", k8s.WorkloadPatchConfigKey)
+	}
 
 	cfg, err := model.ModelConfig()
 	if err != nil {
@@ -841,7 +897,7 @@ func (api *APIBase) setConfig(app Application, generation, settingsYAML string,
 
 	var configChanged bool
 	if len(charmSettings) != 0 {
-		if err = app.UpdateCharmConfig(generation, charmSettings); err != nil {
+		if err = app.UpdateCharmConfigAs(generation, api.callerName(), charmSettings); err != nil {
 			return errors.Annotate(err, "updating charm config settings")
 		}
 		configChanged = true
@@ -2030,6 +2086,46 @@ func (api *APIBase) SetRelationsSuspended(args params.RelationSuspendedArgs) (pa
 	return statusResults, nil
 }
 
+// SetRelationsFrozen is not available in V13 API or less.
+// Mask the new method from V13 API or less.
+func (api *APIv13) SetRelationsFrozen(_, _ struct{}) {}
+
+// SetRelationsFrozen sets the frozen status of the specified relations.
+// A frozen relation remains established but does not admit new units
+// into scope until it is resumed, allowing operators to quiesce a
+// relation before a large topology change without removing it.
+func (api *APIBase) SetRelationsFrozen(args params.RelationFreezeArgs) (params.ErrorResults, error) {
+	var statusResults params.ErrorResults
+	if err := api.checkCanWrite(); err != nil {
+		return statusResults, errors.Trace(err)
+	}
+	if err := api.check.ChangeAllowed(); err != nil {
+		return statusResults, errors.Trace(err)
+	}
+
+	changeOne := func(arg params.RelationFreezeArg) error {
+		rel, err := api.backend.Relation(arg.RelationId)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if rel.Frozen() == arg.Frozen {
+			return nil
+		}
+		message := arg.Message
+		if !arg.Frozen {
+			message = ""
+		}
+		return errors.Trace(rel.SetFrozen(arg.Frozen, message))
+	}
+	results := make([]params.ErrorResult, len(args.Args))
+	for i, arg := range args.Args {
+		err := changeOne(arg)
+		results[i].Error = apiservererrors.ServerError(err)
+	}
+	statusResults.Results = results
+	return statusResults, nil
+}
+
 // Consume adds remote applications to the model without creating any
 // relations.
 func (api *APIBase) Consume(args params.ConsumeApplicationArgs) (params.ErrorResults, error) {
@@ -2375,7 +2471,7 @@ func (api *APIBase) unsetApplicationConfig(arg params.ApplicationUnset) error {
 		if arg.BranchName == "" {
 			arg.BranchName = model.GenerationMaster
 		}
-		if err := app.UpdateCharmConfig(arg.BranchName, charmSettings); err != nil {
+		if err := app.UpdateCharmConfigAs(arg.BranchName, api.callerName(), charmSettings); err != nil {
 			return errors.Annotate(err, "updating application charm settings")
 		}
 	}
@@ -2422,6 +2518,129 @@ func (api *APIBase) ResolveUnitErrors(p params.UnitsResolved) (params.ErrorResul
 	return result, nil
 }
 
+// ResolveUnitErrorsBySelector is not available via the V15 API.
+func (api *APIv15) ResolveUnitErrorsBySelector(_, _ struct{}) {}
+
+// ResolveUnitErrorsBySelector marks every errored unit matching one of
+// the given selectors as resolved, returning a result for each
+// matched unit.
+func (api *APIBase) ResolveUnitErrorsBySelector(args params.UnitErrorSelectors) (params.BulkUnitResults, error) {
+	var result params.BulkUnitResults
+	if err := api.checkCanWrite(); err != nil {
+		return result, errors.Trace(err)
+	}
+	if err := api.check.ChangeAllowed(); err != nil {
+		return result, errors.Trace(err)
+	}
+
+	// Validate named applications up front so a typo yields an error
+	// rather than silently matching nothing.
+	applications := set.NewStrings()
+	matchAll := false
+	for _, selector := range args.Selectors {
+		if selector.Application == "" {
+			matchAll = true
+			continue
+		}
+		if _, err := api.backend.Application(selector.Application); err != nil {
+			return result, errors.Trace(err)
+		}
+		applications.Add(selector.Application)
+	}
+
+	unitsInError, err := api.backend.UnitsInError()
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	for _, unit := range unitsInError {
+		appName, err := names.UnitApplication(unit.UnitTag().Id())
+		if err != nil {
+			return result, errors.Trace(err)
+		}
+		if !matchAll && !applications.Contains(appName) {
+			continue
+		}
+		unitResult := params.BulkUnitResult{UnitTag: unit.UnitTag().String()}
+		if err := unit.Resolve(args.Retry); err != nil {
+			unitResult.Error = apiservererrors.ServerError(err)
+		}
+		result.Results = append(result.Results, unitResult)
+	}
+	return result, nil
+}
+
+// SyncCharmFiles is not available via the V16 API.
+func (api *APIv16) SyncCharmFiles(_, _ struct{}) {}
+
+// SyncCharmFiles merges the supplied files into the application's
+// charm sync overlay, from which its units live-apply them without a
+// full charm upgrade. Only applications deployed from a local charm
+// path may be synced.
+func (api *APIBase) SyncCharmFiles(args params.ApplicationCharmSync) error {
+	if err := api.checkCanWrite(); err != nil {
+		return errors.Trace(err)
+	}
+	if err := api.check.ChangeAllowed(); err != nil {
+		return errors.Trace(err)
+	}
+	app, err := api.backend.Application(args.ApplicationName)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	curl, _ := app.CharmURL()
+	if curl.Schema != charm.Local.String() {
+		return errors.NotSupportedf("syncing files to application %q: charm %q is not a local charm", args.ApplicationName, curl)
+	}
+	files := make([]state.CharmSyncFile, len(args.Files))
+	for i, f := range args.Files {
+		files[i] = state.CharmSyncFile{
+			Path:    f.Path,
+			Content: f.Content,
+			Mode:    os.FileMode(f.Mode),
+			Removed: f.Removed,
+		}
+	}
+	return api.backend.UpdateCharmSync(args.ApplicationName, files)
+}
+
+// EffectiveConstraints is not available via the V16 API.
+func (api *APIv16) EffectiveConstraints(_, _ struct{}) {}
+
+// EffectiveConstraints previews the constraints that would take effect
+// for each supplied constraint set once merged with the model
+// constraints, including any additive merge semantics configured via
+// the additive-constraints model config attribute, without deploying
+// anything.
+func (api *APIBase) EffectiveConstraints(args params.EffectiveConstraintsArgs) (params.EffectiveConstraintsResults, error) {
+	if err := api.checkCanRead(); err != nil {
+		return params.EffectiveConstraintsResults{}, errors.Trace(err)
+	}
+	results := params.EffectiveConstraintsResults{
+		Results: make([]params.EffectiveConstraintsResult, len(args.Args)),
+	}
+	for i, arg := range args.Args {
+		proposed := arg.Constraints
+		if arg.ApplicationName != "" && constraints.IsEmpty(&proposed) {
+			app, err := api.backend.Application(arg.ApplicationName)
+			if err != nil {
+				results.Results[i].Error = apiservererrors.ServerError(err)
+				continue
+			}
+			if proposed, err = app.Constraints(); err != nil {
+				results.Results[i].Error = apiservererrors.ServerError(err)
+				continue
+			}
+		}
+		merged, err := api.backend.ResolveConstraints(proposed)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Constraints = merged
+	}
+	return results, nil
+}
+
 // ApplicationsInfo returns applications information.
 func (api *APIBase) ApplicationsInfo(in params.Entities) (params.ApplicationInfoResults, error) {
 	// Get all the space infos before iterating over the application infos.
@@ -66,7 +66,7 @@ type ApplicationSuite struct {
 	env          environs.Environ
 	blockChecker mockBlockChecker
 	authorizer   apiservertesting.FakeAuthorizer
-	api          *application.APIv13
+	api          *application.APIv14
 	deployParams map[string]application.DeployApplicationParams
 }
 
@@ -98,7 +98,7 @@ func (s *ApplicationSuite) setAPIUser(c *gc.C, user names.UserTag) {
 		s.caasBroker,
 	)
 	c.Assert(err, jc.ErrorIsNil)
-	s.api = &application.APIv13{api}
+	s.api = &application.APIv14{&application.APIv15{&application.APIv16{api}}}
 }
 
 func (s *ApplicationSuite) SetUpTest(c *gc.C) {
@@ -1528,6 +1528,60 @@ func (s *ApplicationSuite) TestSetRelationSuspendedPermissionDenied(c *gc.C) {
 	s.relation.CheckNoCalls(c)
 }
 
+func (s *ApplicationSuite) TestSetRelationFrozen(c *gc.C) {
+	results, err := s.api.SetRelationsFrozen(params.RelationFreezeArgs{
+		Args: []params.RelationFreezeArg{{
+			RelationId: 123,
+			Frozen:     true,
+			Message:    "database failover",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.OneError(), gc.IsNil)
+	c.Assert(s.relation.frozen, jc.IsTrue)
+	c.Assert(s.relation.frozenReason, gc.Equals, "database failover")
+}
+
+func (s *ApplicationSuite) TestSetRelationFrozenFalse(c *gc.C) {
+	s.relation.frozen = true
+	s.relation.frozenReason = "reason"
+	results, err := s.api.SetRelationsFrozen(params.RelationFreezeArgs{
+		Args: []params.RelationFreezeArg{{
+			RelationId: 123,
+			Frozen:     false,
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.OneError(), gc.IsNil)
+	c.Assert(s.relation.frozen, jc.IsFalse)
+	c.Assert(s.relation.frozenReason, gc.Equals, "")
+}
+
+func (s *ApplicationSuite) TestBlockSetRelationFrozen(c *gc.C) {
+	s.blockChecker.SetErrors(errors.New("blocked"))
+	_, err := s.api.SetRelationsFrozen(params.RelationFreezeArgs{
+		Args: []params.RelationFreezeArg{{
+			RelationId: 123,
+			Frozen:     true,
+		}},
+	})
+	c.Assert(err, gc.ErrorMatches, "blocked")
+	s.blockChecker.CheckCallNames(c, "ChangeAllowed")
+	s.relation.CheckNoCalls(c)
+}
+
+func (s *ApplicationSuite) TestSetRelationFrozenPermissionDenied(c *gc.C) {
+	s.setAPIUser(c, names.NewUserTag("fred"))
+	_, err := s.api.SetRelationsFrozen(params.RelationFreezeArgs{
+		Args: []params.RelationFreezeArg{{
+			RelationId: 123,
+			Frozen:     true,
+		}},
+	})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+	s.relation.CheckNoCalls(c)
+}
+
 func (s *ApplicationSuite) TestConsumeIdempotent(c *gc.C) {
 	for i := 0; i < 2; i++ {
 		results, err := s.api.Consume(params.ConsumeApplicationArgs{
@@ -2021,6 +2075,37 @@ func (s *ApplicationSuite) TestResolveUnitErrorsAll(c *gc.C) {
 	unit.CheckCall(c, 0, "Resolve", true)
 }
 
+func (s *ApplicationSuite) TestResolveUnitErrorsBySelector(c *gc.C) {
+	result, err := s.api.APIv16.ResolveUnitErrorsBySelector(params.UnitErrorSelectors{
+		Selectors: []params.UnitErrorSelector{{Application: "postgresql"}},
+		Retry:     true,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, gc.DeepEquals, params.BulkUnitResults{
+		Results: []params.BulkUnitResult{{UnitTag: "unit-postgresql-0"}},
+	})
+
+	unit := s.backend.applications["postgresql"].units[0]
+	unit.CheckCallNames(c, "Resolve")
+	unit.CheckCall(c, 0, "Resolve", true)
+}
+
+func (s *ApplicationSuite) TestResolveUnitErrorsBySelectorNoMatch(c *gc.C) {
+	result, err := s.api.APIv16.ResolveUnitErrorsBySelector(params.UnitErrorSelectors{
+		Selectors: []params.UnitErrorSelector{{Application: "postgresql-subordinate"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 0)
+	s.backend.applications["postgresql"].units[0].CheckNoCalls(c)
+}
+
+func (s *ApplicationSuite) TestResolveUnitErrorsBySelectorUnknownApplication(c *gc.C) {
+	_, err := s.api.APIv16.ResolveUnitErrorsBySelector(params.UnitErrorSelectors{
+		Selectors: []params.UnitErrorSelector{{Application: "fortress"}},
+	})
+	c.Assert(err, gc.ErrorMatches, `application "fortress" not found`)
+}
+
 func (s *ApplicationSuite) TestBlockResolveUnitErrors(c *gc.C) {
 	s.blockChecker.SetErrors(errors.New("blocked"))
 	_, err := s.api.ResolveUnitErrors(params.UnitsResolved{})
@@ -54,6 +54,8 @@ type Backend interface {
 	OfferConnectionForRelation(string) (OfferConnection, error)
 	SaveEgressNetworks(relationKey string, cidrs []string) (state.RelationNetworks, error)
 	Branch(string) (Generation, error)
+	UpdateCharmSync(string, []state.CharmSyncFile) error
+	ResolveConstraints(constraints.Value) (constraints.Value, error)
 	state.EndpointBinding
 }
 
@@ -99,7 +101,14 @@ type Application interface {
 	SetMinUnits(int) error
 	UpdateApplicationSeries(string, bool) error
 	UpdateCharmConfig(string, charm.Settings) error
+	UpdateCharmConfigAs(string, string, charm.Settings) error
 	UpdateApplicationConfig(application.ConfigAttributes, []string, environschema.Fields, schema.Defaults) error
+	ConfigHistory(int) ([]state.ConfigHistoryEntry, error)
+	RollbackCharmConfig(int, string) error
+	StageCharmConfig(charm.Settings) error
+	StagedCharmConfig() (charm.Settings, error)
+	ApplyStagedCharmConfig() error
+	DiscardStagedCharmConfig() error
 	SetScale(int, int64, bool) error
 	ChangeScale(int) (int, error)
 	AgentTools() (*tools.Tools, error)
@@ -158,6 +167,9 @@ type Relation interface {
 	SetSuspended(bool, string) error
 	Suspended() bool
 	SuspendedReason() string
+	SetFrozen(bool, string) error
+	Frozen() bool
+	FrozenReason() string
 }
 
 type RelationUnit interface {
@@ -0,0 +1,100 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/params"
+)
+
+// callerName returns the name of the authenticated API caller, for
+// recording provenance against config changes.
+func (api *APIBase) callerName() string {
+	if tag := api.authorizer.GetAuthTag(); tag != nil {
+		return tag.Id()
+	}
+	return ""
+}
+
+// ConfigHistory is not available via the V14 API.
+func (api *APIv14) ConfigHistory(_ struct{}) {}
+
+// ConfigHistory returns the recorded charm config changes for each of
+// the specified applications, newest first.
+func (api *APIBase) ConfigHistory(args params.ConfigHistoryArgs) (params.ConfigHistoryResults, error) {
+	if err := api.checkCanRead(); err != nil {
+		return params.ConfigHistoryResults{}, errors.Trace(err)
+	}
+	results := params.ConfigHistoryResults{
+		Results: make([]params.ConfigHistoryResult, len(args.Args)),
+	}
+	for i, arg := range args.Args {
+		entries, err := api.configHistory(arg)
+		results.Results[i].Entries = entries
+		results.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return results, nil
+}
+
+func (api *APIBase) configHistory(arg params.ConfigHistoryArg) ([]params.ConfigHistoryEntry, error) {
+	tag, err := names.ParseApplicationTag(arg.ApplicationTag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	app, err := api.backend.Application(tag.Id())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	history, err := app.ConfigHistory(arg.Limit)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	entries := make([]params.ConfigHistoryEntry, len(history))
+	for i, entry := range history {
+		entries[i] = params.ConfigHistoryEntry{
+			Revision:  entry.Revision,
+			UpdatedBy: entry.UpdatedBy,
+			Timestamp: entry.Updated,
+			Changes:   entry.Changes,
+			Previous:  entry.Previous,
+		}
+	}
+	return entries, nil
+}
+
+// RollbackConfig is not available via the V14 API.
+func (api *APIv14) RollbackConfig(_ struct{}) {}
+
+// RollbackConfig reverts the charm config change recorded as the given
+// revision for each of the specified applications. The rollback is
+// itself recorded as a new revision.
+func (api *APIBase) RollbackConfig(args params.ConfigRollbackArgs) (params.ErrorResults, error) {
+	var result params.ErrorResults
+	if err := api.checkCanWrite(); err != nil {
+		return result, errors.Trace(err)
+	}
+	if err := api.check.ChangeAllowed(); err != nil {
+		return result, errors.Trace(err)
+	}
+	result.Results = make([]params.ErrorResult, len(args.Args))
+	for i, arg := range args.Args {
+		tag, err := names.ParseApplicationTag(arg.ApplicationTag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		app, err := api.backend.Application(tag.Id())
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		result.Results[i].Error = apiservererrors.ServerError(
+			app.RollbackCharmConfig(arg.Revision, api.callerName()))
+	}
+	return result, nil
+}
@@ -854,6 +854,8 @@ type mockRelation struct {
 	message         string
 	suspended       bool
 	suspendedReason string
+	frozen          bool
+	frozenReason    string
 }
 
 func (r *mockRelation) Tag() names.Tag {
@@ -932,6 +934,23 @@ func (r *mockRelation) SuspendedReason() string {
 	return r.suspendedReason
 }
 
+func (r *mockRelation) SetFrozen(frozen bool, reason string) error {
+	r.MethodCall(r, "SetFrozen")
+	r.frozen = frozen
+	r.frozenReason = reason
+	return r.NextErr()
+}
+
+func (r *mockRelation) Frozen() bool {
+	r.MethodCall(r, "Frozen")
+	return r.frozen
+}
+
+func (r *mockRelation) FrozenReason() string {
+	r.MethodCall(r, "FrozenReason")
+	return r.frozenReason
+}
+
 func (r *mockRelation) Destroy() error {
 	r.MethodCall(r, "Destroy")
 	return r.NextErr()
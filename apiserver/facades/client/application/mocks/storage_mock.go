@@ -77,6 +77,20 @@ func (mr *MockStorageMockRecorder) PutAndCheckHash(arg0, arg1, arg2, arg3 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutAndCheckHash", reflect.TypeOf((*MockStorage)(nil).PutAndCheckHash), arg0, arg1, arg2, arg3)
 }
 
+// PutByHash mocks base method
+func (m *MockStorage) PutByHash(arg0 string, arg1 io.ReadSeeker, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutByHash", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutByHash indicates an expected call of PutByHash
+func (mr *MockStorageMockRecorder) PutByHash(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutByHash", reflect.TypeOf((*MockStorage)(nil).PutByHash), arg0, arg1, arg2)
+}
+
 // Remove mocks base method
 func (m *MockStorage) Remove(arg0 string) error {
 	m.ctrl.T.Helper()
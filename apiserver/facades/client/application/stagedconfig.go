@@ -0,0 +1,171 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/core/model"
+)
+
+// StageConfig is not available via the V14 API.
+func (api *APIv14) StageConfig(_ struct{}) {}
+
+// StageConfig records charm config changes for the specified applications
+// as staged changesets without applying them. A staged changeset can be
+// previewed with StagedConfig and then applied or discarded.
+func (api *APIBase) StageConfig(args params.ConfigSetArgs) (params.ErrorResults, error) {
+	var result params.ErrorResults
+	if err := api.checkCanWrite(); err != nil {
+		return result, errors.Trace(err)
+	}
+	if err := api.check.ChangeAllowed(); err != nil {
+		return result, errors.Trace(err)
+	}
+	result.Results = make([]params.ErrorResult, len(args.Args))
+	for i, arg := range args.Args {
+		result.Results[i].Error = apiservererrors.ServerError(api.stageConfig(arg))
+	}
+	return result, nil
+}
+
+func (api *APIBase) stageConfig(arg params.ConfigSet) error {
+	app, err := api.backend.Application(arg.ApplicationName)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	ch, _, err := app.Charm()
+	if err != nil {
+		return errors.Annotate(err, "obtaining charm for this application")
+	}
+
+	appConfig, _, charmSettings, err := parseCharmSettings(api.modelType, ch, app.Name(), arg.Config, arg.ConfigYAML)
+	if err != nil {
+		return errors.Annotate(err, "parsing settings for application")
+	}
+	// Staging only covers charm config; application config changes are
+	// applied immediately by SetConfigs and have no hook implications.
+	if len(appConfig.Attributes()) > 0 {
+		return errors.NotSupportedf("staging application config values")
+	}
+	if len(charmSettings) == 0 {
+		return errors.New("no charm config settings to stage")
+	}
+	return errors.Annotate(app.StageCharmConfig(charmSettings), "staging charm config settings")
+}
+
+// StagedConfig is not available via the V14 API.
+func (api *APIv14) StagedConfig(_ struct{}) {}
+
+// StagedConfig returns the staged charm config changeset for each of the
+// specified applications, along with the effective config that would
+// result from applying it and the hooks that applying it would run.
+func (api *APIBase) StagedConfig(args params.Entities) (params.StagedConfigResults, error) {
+	if err := api.checkCanRead(); err != nil {
+		return params.StagedConfigResults{}, errors.Trace(err)
+	}
+	results := params.StagedConfigResults{
+		Results: make([]params.StagedConfigResult, len(args.Entities)),
+	}
+	for i, arg := range args.Entities {
+		tag, err := names.ParseApplicationTag(arg.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		staged, effective, hooks, err := api.stagedConfig(tag.Id())
+		results.Results[i].Staged = staged
+		results.Results[i].Effective = effective
+		results.Results[i].Hooks = hooks
+		results.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return results, nil
+}
+
+func (api *APIBase) stagedConfig(appName string) (map[string]interface{}, map[string]interface{}, []string, error) {
+	app, err := api.backend.Application(appName)
+	if err != nil {
+		return nil, nil, nil, errors.Trace(err)
+	}
+	staged, err := app.StagedCharmConfig()
+	if err != nil {
+		return nil, nil, nil, errors.Trace(err)
+	}
+	current, err := app.CharmConfig(model.GenerationMaster)
+	if err != nil {
+		return nil, nil, nil, errors.Trace(err)
+	}
+	ch, _, err := app.Charm()
+	if err != nil {
+		return nil, nil, nil, errors.Trace(err)
+	}
+	defaults := ch.Config().DefaultSettings()
+
+	effective := make(map[string]interface{}, len(current))
+	for name, value := range current {
+		effective[name] = value
+	}
+	changed := false
+	for name, value := range staged {
+		if value == nil {
+			value = defaults[name]
+		}
+		if effective[name] != value {
+			changed = true
+		}
+		effective[name] = value
+	}
+
+	var hooks []string
+	if changed {
+		hooks = []string{"config-changed"}
+	}
+	return staged, effective, hooks, nil
+}
+
+// ApplyStagedConfig is not available via the V14 API.
+func (api *APIv14) ApplyStagedConfig(_ struct{}) {}
+
+// ApplyStagedConfig applies the staged changeset for each of the
+// specified applications to their charm config and discards it.
+func (api *APIBase) ApplyStagedConfig(args params.Entities) (params.ErrorResults, error) {
+	return api.stagedConfigOp(args, Application.ApplyStagedCharmConfig)
+}
+
+// DiscardStagedConfig is not available via the V14 API.
+func (api *APIv14) DiscardStagedConfig(_ struct{}) {}
+
+// DiscardStagedConfig removes the staged changeset for each of the
+// specified applications without applying it.
+func (api *APIBase) DiscardStagedConfig(args params.Entities) (params.ErrorResults, error) {
+	return api.stagedConfigOp(args, Application.DiscardStagedCharmConfig)
+}
+
+func (api *APIBase) stagedConfigOp(args params.Entities, op func(Application) error) (params.ErrorResults, error) {
+	var result params.ErrorResults
+	if err := api.checkCanWrite(); err != nil {
+		return result, errors.Trace(err)
+	}
+	if err := api.check.ChangeAllowed(); err != nil {
+		return result, errors.Trace(err)
+	}
+	result.Results = make([]params.ErrorResult, len(args.Entities))
+	for i, arg := range args.Entities {
+		tag, err := names.ParseApplicationTag(arg.Tag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		app, err := api.backend.Application(tag.Id())
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		result.Results[i].Error = apiservererrors.ServerError(op(app))
+	}
+	return result, nil
+}
@@ -534,6 +534,106 @@ func (mr *MockApplicationMockRecorder) UpdateApplicationSeries(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplicationSeries", reflect.TypeOf((*MockApplication)(nil).UpdateApplicationSeries), arg0, arg1)
 }
 
+// UpdateCharmConfigAs mocks base method
+func (m *MockApplication) UpdateCharmConfigAs(arg0, arg1 string, arg2 v9.Settings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCharmConfigAs", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCharmConfigAs indicates an expected call of UpdateCharmConfigAs
+func (mr *MockApplicationMockRecorder) UpdateCharmConfigAs(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCharmConfigAs", reflect.TypeOf((*MockApplication)(nil).UpdateCharmConfigAs), arg0, arg1, arg2)
+}
+
+// ConfigHistory mocks base method
+func (m *MockApplication) ConfigHistory(arg0 int) ([]state.ConfigHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigHistory", arg0)
+	ret0, _ := ret[0].([]state.ConfigHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfigHistory indicates an expected call of ConfigHistory
+func (mr *MockApplicationMockRecorder) ConfigHistory(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigHistory", reflect.TypeOf((*MockApplication)(nil).ConfigHistory), arg0)
+}
+
+// RollbackCharmConfig mocks base method
+func (m *MockApplication) RollbackCharmConfig(arg0 int, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackCharmConfig", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackCharmConfig indicates an expected call of RollbackCharmConfig
+func (mr *MockApplicationMockRecorder) RollbackCharmConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackCharmConfig", reflect.TypeOf((*MockApplication)(nil).RollbackCharmConfig), arg0, arg1)
+}
+
+// StageCharmConfig mocks base method
+func (m *MockApplication) StageCharmConfig(arg0 v9.Settings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StageCharmConfig", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StageCharmConfig indicates an expected call of StageCharmConfig
+func (mr *MockApplicationMockRecorder) StageCharmConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StageCharmConfig", reflect.TypeOf((*MockApplication)(nil).StageCharmConfig), arg0)
+}
+
+// StagedCharmConfig mocks base method
+func (m *MockApplication) StagedCharmConfig() (v9.Settings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StagedCharmConfig")
+	ret0, _ := ret[0].(v9.Settings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StagedCharmConfig indicates an expected call of StagedCharmConfig
+func (mr *MockApplicationMockRecorder) StagedCharmConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StagedCharmConfig", reflect.TypeOf((*MockApplication)(nil).StagedCharmConfig))
+}
+
+// ApplyStagedCharmConfig mocks base method
+func (m *MockApplication) ApplyStagedCharmConfig() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyStagedCharmConfig")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyStagedCharmConfig indicates an expected call of ApplyStagedCharmConfig
+func (mr *MockApplicationMockRecorder) ApplyStagedCharmConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyStagedCharmConfig", reflect.TypeOf((*MockApplication)(nil).ApplyStagedCharmConfig))
+}
+
+// DiscardStagedCharmConfig mocks base method
+func (m *MockApplication) DiscardStagedCharmConfig() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiscardStagedCharmConfig")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DiscardStagedCharmConfig indicates an expected call of DiscardStagedCharmConfig
+func (mr *MockApplicationMockRecorder) DiscardStagedCharmConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscardStagedCharmConfig", reflect.TypeOf((*MockApplication)(nil).DiscardStagedCharmConfig))
+}
+
 // UpdateCharmConfig mocks base method
 func (m *MockApplication) UpdateCharmConfig(arg0 string, arg1 v9.Settings) error {
 	m.ctrl.T.Helper()
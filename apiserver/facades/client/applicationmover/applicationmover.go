@@ -0,0 +1,95 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package applicationmover
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/state"
+)
+
+// API implements the application mover facade, used to move applications
+// between models on the same controller.
+type API struct {
+	state      *state.State
+	pool       *state.StatePool
+	authorizer facade.Authorizer
+}
+
+// NewFacade creates a new server-side application mover API end point.
+func NewFacade(ctx facade.Context) (*API, error) {
+	authorizer := ctx.Auth()
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+	return &API{
+		state:      ctx.State(),
+		pool:       ctx.StatePool(),
+		authorizer: authorizer,
+	}, nil
+}
+
+// checkAdmin ensures the caller is a controller superuser or an admin
+// of the model managed by the given state.
+func (api *API) checkAdmin(st *state.State) error {
+	admin, err := api.authorizer.HasPermission(permission.SuperuserAccess, st.ControllerTag())
+	if err != nil && !errors.IsNotFound(err) {
+		return errors.Trace(err)
+	}
+	if !admin {
+		model, err := st.Model()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		admin, err = api.authorizer.HasPermission(permission.AdminAccess, model.ModelTag())
+		if err != nil && !errors.IsNotFound(err) {
+			return errors.Trace(err)
+		}
+	}
+	if !admin {
+		return apiservererrors.ErrPerm
+	}
+	return nil
+}
+
+// MoveApplications moves each of the specified applications from the
+// current model into the named target model on the same controller.
+func (api *API) MoveApplications(args params.MoveApplicationsParams) (params.ErrorResults, error) {
+	if err := api.checkAdmin(api.state); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Applications)),
+	}
+	for i, arg := range args.Applications {
+		err := api.moveApplication(arg)
+		results.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return results, nil
+}
+
+func (api *API) moveApplication(arg params.MoveApplicationParams) error {
+	appTag, err := names.ParseApplicationTag(arg.ApplicationTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	modelTag, err := names.ParseModelTag(arg.TargetModelTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	target, err := api.pool.Get(modelTag.Id())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer target.Release()
+	if err := api.checkAdmin(target.State); err != nil {
+		return errors.Trace(err)
+	}
+	return api.state.MoveApplication(target.State, appTag.Id(), arg.SeverRelations)
+}
@@ -0,0 +1,94 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package applicationmover_test
+
+import (
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/facade/facadetest"
+	"github.com/juju/juju/apiserver/facades/client/applicationmover"
+	"github.com/juju/juju/apiserver/params"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/juju/testing"
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/testing/factory"
+)
+
+type moverSuite struct {
+	testing.JujuConnSuite
+
+	authorizer apiservertesting.FakeAuthorizer
+	api        *applicationmover.API
+}
+
+var _ = gc.Suite(&moverSuite{})
+
+func (s *moverSuite) SetUpTest(c *gc.C) {
+	s.JujuConnSuite.SetUpTest(c)
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag:      s.AdminUserTag(c),
+		AdminTag: s.AdminUserTag(c),
+	}
+	api, err := applicationmover.NewFacade(facadetest.Context{
+		State_:     s.State,
+		StatePool_: s.StatePool,
+		Auth_:      s.authorizer,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	s.api = api
+}
+
+func (s *moverSuite) makeTargetModel(c *gc.C) *state.State {
+	targetSt := s.Factory.MakeModel(c, nil)
+	s.AddCleanup(func(*gc.C) { targetSt.Close() })
+	return targetSt
+}
+
+func (s *moverSuite) TestMoveApplications(c *gc.C) {
+	app := s.Factory.MakeApplication(c, &factory.ApplicationParams{
+		Charm: s.AddTestingCharm(c, "mysql"),
+	})
+	targetSt := s.makeTargetModel(c)
+
+	results, err := s.api.MoveApplications(params.MoveApplicationsParams{
+		Applications: []params.MoveApplicationParams{{
+			ApplicationTag: app.Tag().String(),
+			TargetModelTag: names.NewModelTag(targetSt.ModelUUID()).String(),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+
+	_, err = targetSt.Application(app.Name())
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *moverSuite) TestMoveApplicationsInvalidTag(c *gc.C) {
+	results, err := s.api.MoveApplications(params.MoveApplicationsParams{
+		Applications: []params.MoveApplicationParams{{
+			ApplicationTag: "not-a-tag",
+			TargetModelTag: names.NewModelTag(s.State.ModelUUID()).String(),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.ErrorMatches, `"not-a-tag" is not a valid tag`)
+}
+
+func (s *moverSuite) TestMoveApplicationsPermission(c *gc.C) {
+	authorizer := apiservertesting.FakeAuthorizer{
+		Tag: names.NewUserTag("fred"),
+	}
+	api, err := applicationmover.NewFacade(facadetest.Context{
+		State_:     s.State,
+		StatePool_: s.StatePool,
+		Auth_:      authorizer,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = api.MoveApplications(params.MoveApplicationsParams{})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
@@ -31,6 +31,7 @@ type Backend interface {
 	ModelType() state.ModelType
 	ControllerTag() names.ControllerTag
 	ModelConfig() (*config.Config, error)
+	ModelExists(uuid string) (bool, error)
 	ControllerConfig() (controller.Config, error)
 	StateServingInfo() (controller.StateServingInfo, error)
 	ControllerNodes() ([]state.ControllerNode, error)
@@ -137,6 +138,8 @@ func CreateResult(meta *backups.Metadata, filename string) params.BackupsMetadat
 		result.Finished = *meta.Finished
 	}
 	result.Notes = meta.Notes
+	result.Incremental = meta.Incremental
+	result.BasedOn = meta.BasedOn
 
 	result.Model = meta.Origin.Model
 	result.Machine = meta.Origin.Machine
@@ -169,6 +172,8 @@ func MetadataFromResult(result params.BackupsMetadataResult) *backups.Metadata {
 	meta.Origin.Version = result.Version
 	meta.Origin.Series = result.Series
 	meta.Notes = result.Notes
+	meta.Incremental = result.Incremental
+	meta.BasedOn = result.BasedOn
 	meta.FormatVersion = result.FormatVersion
 	meta.Controller = backups.ControllerMetadata{
 		UUID:              result.ControllerUUID,
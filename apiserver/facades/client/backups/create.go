@@ -64,11 +64,55 @@ func (a *API) Create(args params.BackupsCreateArgs) (params.BackupsMetadataResul
 	}
 	meta.Controller.HANodes = int64(len(nodes))
 
+	if args.Incremental {
+		base, err := latestCompletedBackup(backupsMethods)
+		if err != nil {
+			return result, errors.Annotate(err, "cannot resolve the base for an incremental backup")
+		}
+		meta.Incremental = true
+		meta.BasedOn = base.ID()
+		dbInfo.IncrementalSince = base.Finished
+	}
+
 	fileName, err := backupsMethods.Create(meta, a.paths, dbInfo, args.KeepCopy, args.NoDownload)
 	if err != nil {
 		return result, errors.Trace(err)
 	}
 
+	// Stream the archive to the remote object store, if one is
+	// configured on the controller.
+	controllerCfg, err := a.backend.ControllerConfig()
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	if target := controllerCfg.BackupStorageURL(); target != "" {
+		if err := uploadRemote(target, meta, fileName); err != nil {
+			return result, errors.Annotate(err, "backup created but could not be streamed to remote storage")
+		}
+	}
+
 	result = CreateResult(meta, fileName)
 	return result, nil
 }
+
+// latestCompletedBackup returns the metadata of the most recently
+// finished backup, to use as the base of an incremental backup.
+func latestCompletedBackup(backupsMethods backups.Backups) (*backups.Metadata, error) {
+	metas, err := backupsMethods.List()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var latest *backups.Metadata
+	for _, meta := range metas {
+		if meta.Finished == nil {
+			continue
+		}
+		if latest == nil || meta.Finished.After(*latest.Finished) {
+			latest = meta
+		}
+	}
+	if latest == nil {
+		return nil, errors.NotFoundf("completed backup to base the incremental backup on")
+	}
+	return latest, nil
+}
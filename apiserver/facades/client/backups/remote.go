@@ -0,0 +1,83 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"os"
+
+	"github.com/juju/errors"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/state/backups"
+)
+
+var openRemoteStorage = backups.OpenRemoteStorage
+
+// remoteStorage returns the remote object store configured via the
+// backup-storage-url controller config attribute.
+func (a *API) remoteStorage() (backups.RemoteStorage, error) {
+	controllerCfg, err := a.backend.ControllerConfig()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	target := controllerCfg.BackupStorageURL()
+	if target == "" {
+		return nil, errors.NotFoundf("remote backup storage (backup-storage-url) configured")
+	}
+	return openRemoteStorage(target)
+}
+
+// ListRemote returns the names of the backup archives held in the
+// remote object store configured for the controller.
+func (a *API) ListRemote() (params.BackupsListRemoteResult, error) {
+	var result params.BackupsListRemoteResult
+	stor, err := a.remoteStorage()
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	names, err := stor.List()
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	result.Names = names
+	return result, nil
+}
+
+// RemoveRemote prunes the named backup archives from the remote
+// object store configured for the controller.
+func (a *API) RemoveRemote(args params.BackupsRemoveRemoteArgs) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Names)),
+	}
+	stor, err := a.remoteStorage()
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	for i, name := range args.Names {
+		err := stor.Remove(name)
+		result.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return result, nil
+}
+
+// uploadRemote streams the archive just created at archivePath to the
+// remote object store identified by target.
+func uploadRemote(target string, meta *backups.Metadata, archivePath string) error {
+	stor, err := openRemoteStorage(target)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	archive, err := os.Open(archivePath)
+	if err != nil {
+		return errors.Annotate(err, "while opening backup archive")
+	}
+	defer archive.Close()
+	info, err := archive.Stat()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	name := meta.Started.Format(backups.FilenameTemplate)
+	return errors.Trace(stor.Put(name, archive, info.Size()))
+}
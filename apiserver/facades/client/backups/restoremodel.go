@@ -0,0 +1,90 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"fmt"
+
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/state/backups"
+)
+
+var logger = loggo.GetLogger("juju.apiserver.backups")
+
+var newDBRestorer = backups.NewDBRestorer
+
+// RestoreModel reconstructs a single model from a stored controller
+// backup into the running controller. The backup's database dump is
+// loaded into a scratch database and the model's documents are copied
+// from there, so every other model on the controller is left alone.
+func (a *API) RestoreModel(args params.BackupsRestoreModelArgs) (params.BackupsRestoreModelResult, error) {
+	var result params.BackupsRestoreModelResult
+	if !names.IsValidModel(args.ModelUUID) {
+		return result, errors.NotValidf("model UUID %q", args.ModelUUID)
+	}
+	exists, err := a.backend.ModelExists(args.ModelUUID)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	if exists {
+		return result, errors.AlreadyExistsf("model %q", args.ModelUUID)
+	}
+
+	backupsMethods, closer := newBackups(a.backend)
+	defer closer.Close()
+
+	_, archive, err := backupsMethods.Get(args.BackupID)
+	if err != nil {
+		return result, errors.Annotate(err, "while fetching backup archive")
+	}
+	defer archive.Close()
+
+	ws, err := backups.NewArchiveWorkspaceReader(archive)
+	if err != nil {
+		return result, errors.Annotate(err, "while unpacking backup archive")
+	}
+	defer ws.Close()
+
+	session := a.backend.MongoSession().Copy()
+	defer session.Close()
+
+	mgoInfo, err := mongoInfo(a.paths.DataDir, a.machineID)
+	if err != nil {
+		return result, errors.Annotatef(err, "getting mongo info")
+	}
+	dbInfo, err := backups.NewDBInfo(mgoInfo, session)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+
+	// Load the dump into a scratch database named after the model, so
+	// concurrent restores of different models do not collide.
+	scratchDB := fmt.Sprintf("jujurestore%s", args.ModelUUID[:8])
+	restorer, err := newDBRestorer(dbInfo, scratchDB)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	defer func() {
+		if err := session.DB(scratchDB).DropDatabase(); err != nil {
+			logger.Errorf("could not drop scratch database %q: %v", scratchDB, err)
+		}
+	}()
+	if err := restorer.Restore(ws.DBDumpDir); err != nil {
+		return result, errors.Trace(err)
+	}
+
+	restored, err := backups.RestoreModelDocs(session, scratchDB, args.ModelUUID)
+	if err != nil {
+		return result, errors.Annotatef(err, "while restoring model %q", args.ModelUUID)
+	}
+	if restored == 0 {
+		return result, errors.NotFoundf("model %q in backup %q", args.ModelUUID, args.BackupID)
+	}
+	result.RestoredDocs = restored
+	return result, nil
+}
@@ -34,8 +34,13 @@ func (s *stateShim) MachineSeries(id string) (string, error) {
 	return m.Series(), nil
 }
 
-// NewFacadeV3 provides the required signature for facade registration.
-func NewFacadeV3(st *state.State, resources facade.Resources, authorizer facade.Authorizer) (*API, error) {
+// APIv3 serves backup-specific API methods for version 3.
+type APIv3 struct {
+	*API
+}
+
+// NewFacadeV4 provides the required signature for version 4 facade registration.
+func NewFacadeV4(st *state.State, resources facade.Resources, authorizer facade.Authorizer) (*API, error) {
 	model, err := st.Model()
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -43,6 +48,24 @@ func NewFacadeV3(st *state.State, resources facade.Resources, authorizer facade.
 	return NewAPI(&stateShim{st, model}, resources, authorizer)
 }
 
+// NewFacadeV3 provides the required signature for version 3 facade registration.
+func NewFacadeV3(st *state.State, resources facade.Resources, authorizer facade.Authorizer) (*APIv3, error) {
+	api, err := NewFacadeV4(st, resources, authorizer)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIv3{api}, nil
+}
+
+// ListRemote is not available in version 3.
+func (*APIv3) ListRemote(_, _ struct{}) {}
+
+// RemoveRemote is not available in version 3.
+func (*APIv3) RemoveRemote(_, _ struct{}) {}
+
+// RestoreModel is not available in version 3.
+func (*APIv3) RestoreModel(_, _ struct{}) {}
+
 // ControllerTag disambiguates the ControllerTag method pending further
 // refactoring to separate model functionality from state functionality.
 func (s *stateShim) ControllerTag() names.ControllerTag {
@@ -45,19 +45,23 @@ func NewAPI(
 		return nil, apiservererrors.ErrPerm
 	}
 
-	m, err := st.Model()
+	access, err := getState(st)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 
 	return &API{
-		access:     getState(st, m),
+		access:     access,
 		authorizer: authorizer,
 	}, nil
 }
 
-var getState = func(st *state.State, m *state.Model) blockAccess {
-	return stateShim{st, m}
+var getState = func(st *state.State) (blockAccess, error) {
+	m, err := st.Model()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return stateShim{st, m}, nil
 }
 
 func (a *API) checkCanRead() error {
@@ -4,34 +4,41 @@
 package block_test
 
 import (
+	"github.com/juju/names/v4"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/apiserver/common"
 	"github.com/juju/juju/apiserver/facades/client/block"
 	"github.com/juju/juju/apiserver/params"
-	"github.com/juju/juju/apiserver/testing"
-	jujutesting "github.com/juju/juju/juju/testing"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
 	"github.com/juju/juju/state"
+	coretesting "github.com/juju/juju/testing"
 )
 
 type blockSuite struct {
-	// TODO(anastasiamac) mock to remove JujuConnSuite
-	jujutesting.JujuConnSuite
+	coretesting.BaseSuite
+
+	st  *apiservertesting.FakeState
 	api *block.API
 }
 
 var _ = gc.Suite(&blockSuite{})
 
 func (s *blockSuite) SetUpTest(c *gc.C) {
-	s.JujuConnSuite.SetUpTest(c)
+	s.BaseSuite.SetUpTest(c)
 
-	var err error
-	auth := testing.FakeAuthorizer{
-		Tag:        s.AdminUserTag(c),
+	s.st = apiservertesting.NewFakeState(names.NewModelTag(coretesting.ModelTag.Id()))
+	block.PatchGetState(s, s.st)
+
+	adminTag := names.NewUserTag("admin")
+	auth := apiservertesting.FakeAuthorizer{
+		Tag:        adminTag,
+		AdminTag:   adminTag,
 		Controller: true,
 	}
-	s.api, err = block.NewAPI(s.State, common.NewResources(), auth)
+	var err error
+	s.api, err = block.NewAPI(nil, common.NewResources(), auth)
 	c.Assert(err, jc.ErrorIsNil)
 }
 
@@ -0,0 +1,18 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package block
+
+import (
+	"github.com/juju/juju/state"
+)
+
+type Patcher interface {
+	PatchValue(ptr, value interface{})
+}
+
+func PatchGetState(p Patcher, access blockAccess) {
+	p.PatchValue(&getState, func(*state.State) (blockAccess, error) {
+		return access, nil
+	})
+}
@@ -64,6 +64,20 @@ type APIv4 struct {
 // identical to V4 with the exception that the V5 adds an arg to export
 // bundle to control what is exported..
 type APIv5 struct {
+	*APIv6
+}
+
+// APIv6 provides the Bundle API facade for version 6. It is otherwise
+// identical to V5 with the exception that the V6 adds an arg to export
+// bundle to control whether machines and placement are exported.
+type APIv6 struct {
+	*APIv7
+}
+
+// APIv7 provides the Bundle API facade for version 7. It is otherwise
+// identical to V6 with the exception that the V7 adds GetBundleDiff,
+// which compares a bundle against the current model server side.
+type APIv7 struct {
 	*BundleAPI
 }
 
@@ -119,13 +133,33 @@ func NewFacadeV4(ctx facade.Context) (*APIv4, error) {
 // NewFacadeV5 provides the signature required for facade registration
 // for version 5.
 func NewFacadeV5(ctx facade.Context) (*APIv5, error) {
-	api, err := newFacade(ctx)
+	api, err := NewFacadeV6(ctx)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 	return &APIv5{api}, nil
 }
 
+// NewFacadeV6 provides the signature required for facade registration
+// for version 6.
+func NewFacadeV6(ctx facade.Context) (*APIv6, error) {
+	api, err := NewFacadeV7(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIv6{api}, nil
+}
+
+// NewFacadeV7 provides the signature required for facade registration
+// for version 7.
+func NewFacadeV7(ctx facade.Context) (*APIv7, error) {
+	api, err := newFacade(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIv7{api}, nil
+}
+
 // NewFacade provides the required signature for facade registration.
 func newFacade(ctx facade.Context) (*BundleAPI, error) {
 	authorizer := ctx.Auth()
@@ -167,7 +201,7 @@ func NewBundleAPIv1(
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	return &APIv1{&APIv2{&APIv3{&APIv4{&APIv5{api}}}}}, nil
+	return &APIv1{&APIv2{&APIv3{&APIv4{&APIv5{&APIv6{&APIv7{api}}}}}}}, nil
 }
 
 func (b *BundleAPI) checkCanRead() error {
@@ -373,6 +407,167 @@ func getChangesMapArgs(
 	return results, err
 }
 
+// GetBundleDiff is not in V6 API or less.
+// Mask the new method from V6 API or less.
+func (u *APIv6) GetBundleDiff() (_, _ struct{}) { return }
+
+// GetBundleDiff returns a structured diff between the given bundle data
+// and the current model: applications missing on either side, charm,
+// channel, config and constraint changes, relation additions and machine
+// differences. The diff is computed server side, so clients do not need
+// to reconstruct the model from status output.
+// V7 GetBundleDiff is not supported on anything less than v7.
+func (b *BundleAPI) GetBundleDiff(args params.BundleDiffParams) (params.BundleDiffResult, error) {
+	fail := func(failErr error) (params.BundleDiffResult, error) {
+		return params.BundleDiffResult{}, apiservererrors.ServerError(failErr)
+	}
+
+	if err := b.checkCanRead(); err != nil {
+		return fail(err)
+	}
+
+	bundleData, err := charm.ReadBundleData(strings.NewReader(args.BundleDataYAML))
+	if err != nil {
+		return fail(errors.Annotate(err, "cannot read bundle YAML"))
+	}
+
+	model, err := b.backend.ExportPartial(b.backend.GetExportConfig())
+	if err != nil {
+		return fail(err)
+	}
+	modelRep, err := b.modelRepresentation(model)
+	if err != nil {
+		return fail(err)
+	}
+
+	diff, err := bundlechanges.BuildDiff(bundlechanges.DiffConfig{
+		Bundle:             bundleData,
+		Model:              modelRep,
+		IncludeAnnotations: args.IncludeAnnotations,
+		Logger:             loggo.GetLogger("juju.apiserver.bundlediff"),
+	})
+	if err != nil {
+		return fail(err)
+	}
+	out, err := yaml.Marshal(diff)
+	if err != nil {
+		return fail(err)
+	}
+	return params.BundleDiffResult{
+		DiffYAML: string(out),
+		Empty:    diff.Empty(),
+	}, nil
+}
+
+// modelRepresentation converts an exported model description into the
+// model representation used by the bundle differ.
+func (b *BundleAPI) modelRepresentation(model description.Model) (*bundlechanges.Model, error) {
+	spaceInfos, err := b.backend.AllSpaceInfos()
+	if err != nil {
+		return nil, errors.Annotate(err, "unable to retrieve all space information")
+	}
+
+	isCAAS := model.Type() == description.CAAS
+	applications := make(map[string]*bundlechanges.Application)
+	for _, application := range model.Applications() {
+		curl, err := charm.ParseURL(application.CharmURL())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		// Expose only the charm name for charmhub charms, matching
+		// the aliases used in bundles.
+		charmAlias := application.CharmURL()
+		if charm.CharmHub.Matches(curl.Schema) {
+			charmAlias = curl.Name
+		}
+		var channel string
+		if origin := application.CharmOrigin(); origin != nil {
+			channel = origin.Channel()
+		}
+		if channel == "" {
+			channel = application.Channel()
+		}
+		exposedEndpoints, err := mapExposedEndpoints(application.ExposedEndpoints(), spaceInfos)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		app := &bundlechanges.Application{
+			Name:        application.Name(),
+			Charm:       charmAlias,
+			Revision:    curl.Revision,
+			Channel:     channel,
+			Options:     application.CharmConfig(),
+			Annotations: application.Annotations(),
+			Exposed:     application.Exposed(),
+			Series:      application.Series(),
+		}
+		if len(exposedEndpoints) != 0 {
+			app.ExposedEndpoints = make(map[string]bundlechanges.ExposedEndpoint)
+			for endpoint, exposeDetails := range exposedEndpoints {
+				app.ExposedEndpoints[endpoint] = bundlechanges.ExposedEndpoint{
+					ExposeToSpaces: exposeDetails.ExposeToSpaces,
+					ExposeToCIDRs:  exposeDetails.ExposeToCIDRs,
+				}
+			}
+		}
+		if result := b.constraints(application.Constraints()); len(result) != 0 {
+			app.Constraints = strings.Join(result, " ")
+		}
+		if application.Subordinate() {
+			// The differ only inspects the length of SubordinateTo, to
+			// decide whether unit counts should be compared, so the
+			// principal names are not needed here.
+			app.SubordinateTo = []string{application.Name()}
+		}
+		if isCAAS {
+			app.Scale = len(application.Units())
+			app.Placement = application.Placement()
+		} else {
+			for _, unit := range application.Units() {
+				app.Units = append(app.Units, bundlechanges.Unit{
+					Name:    unit.Tag().Id(),
+					Machine: unit.Machine().Id(),
+				})
+			}
+		}
+		applications[application.Name()] = app
+	}
+
+	machines := make(map[string]*bundlechanges.Machine)
+	machineMap := make(map[string]string)
+	for _, machine := range model.Machines() {
+		id := machine.Tag().Id()
+		machines[id] = &bundlechanges.Machine{
+			ID:          id,
+			Series:      machine.Series(),
+			Annotations: machine.Annotations(),
+		}
+		// Existing machines always map to themselves when diffing.
+		machineMap[id] = id
+	}
+
+	mod := &bundlechanges.Model{
+		Applications: applications,
+		Machines:     machines,
+		MachineMap:   machineMap,
+	}
+	for _, relation := range model.Relations() {
+		endpoints := relation.Endpoints()
+		// All relations have two endpoints except peers.
+		if len(endpoints) != 2 {
+			continue
+		}
+		mod.Relations = append(mod.Relations, bundlechanges.Relation{
+			App1:      endpoints[0].ApplicationName(),
+			Endpoint1: endpoints[0].Name(),
+			App2:      endpoints[1].ApplicationName(),
+			Endpoint2: endpoints[1].Name(),
+		})
+	}
+	return mod, nil
+}
+
 // ExportBundle v4 did not have any parameters.
 func (b *APIv4) ExportBundle() (params.StringResult, error) {
 	return b.APIv5.ExportBundle(params.ExportBundleParams{})
@@ -400,6 +595,12 @@ func (b *BundleAPI) ExportBundle(arg params.ExportBundleParams) (params.StringRe
 		return fail(err)
 	}
 
+	// Optionally strip machine placement so the bundle can be deployed
+	// without reproducing the source model's machine layout.
+	if arg.ExcludeMachines {
+		removeMachinePlacement(bundleData)
+	}
+
 	// Split the bundle into a base and overlay bundle and encode as a
 	// yaml multi-doc.
 	base, overlay, err := charm.ExtractBaseAndOverlayParts(bundleData)
@@ -445,6 +646,17 @@ func (b *BundleAPI) ExportBundle(arg params.ExportBundleParams) (params.StringRe
 	return params.StringResult{Result: output}, nil
 }
 
+// removeMachinePlacement strips the machines section and any application
+// placement directives from the bundle.
+func removeMachinePlacement(bd *charm.BundleData) {
+	bd.Machines = nil
+	for _, spec := range bd.Applications {
+		if spec != nil {
+			spec.To = nil
+		}
+	}
+}
+
 // bundleOutput has the same top level keys as the charm.BundleData
 // but in a more user oriented output order, with the description first,
 // then the distro series, then the apps, machines and releations.
@@ -26,7 +26,7 @@ import (
 type bundleSuite struct {
 	coretesting.BaseSuite
 	auth     *apiservertesting.FakeAuthorizer
-	facade   *bundle.APIv5
+	facade   *bundle.APIv7
 	st       *mockState
 	modelTag names.ModelTag
 }
@@ -44,14 +44,14 @@ func (s *bundleSuite) SetUpTest(c *gc.C) {
 	s.facade = s.makeAPI(c)
 }
 
-func (s *bundleSuite) makeAPI(c *gc.C) *bundle.APIv5 {
+func (s *bundleSuite) makeAPI(c *gc.C) *bundle.APIv7 {
 	api, err := bundle.NewBundleAPI(
 		s.st,
 		s.auth,
 		s.modelTag,
 	)
 	c.Assert(err, jc.ErrorIsNil)
-	return &bundle.APIv5{api}
+	return &bundle.APIv7{api}
 }
 
 func (s *bundleSuite) TestGetChangesBundleContentError(c *gc.C) {
@@ -307,7 +307,7 @@ func (s *bundleSuite) TestGetChangesSuccessV1(c *gc.C) {
         `,
 	}
 	api := s.makeAPI(c)
-	apiv1 := &bundle.APIv1{&bundle.APIv2{&bundle.APIv3{&bundle.APIv4{api}}}}
+	apiv1 := &bundle.APIv1{&bundle.APIv2{&bundle.APIv3{&bundle.APIv4{&bundle.APIv5{&bundle.APIv6{api}}}}}}
 
 	r, err := apiv1.GetChanges(args)
 	c.Assert(err, jc.ErrorIsNil)
@@ -811,6 +811,135 @@ applications:
 	s.st.CheckCall(c, 0, "ExportPartial", s.st.GetExportConfig())
 }
 
+func (s *bundleSuite) TestExportBundleExcludeMachines(c *gc.C) {
+	s.st.model = description.NewModel(description.ModelArgs{Owner: names.NewUserTag("magic"),
+		Config: map[string]interface{}{
+			"name": "awesome",
+			"uuid": "some-uuid",
+		},
+		CloudRegion: "some-region"})
+
+	app := s.st.model.AddApplication(s.minimalApplicationArgs(description.IAAS))
+	app.SetStatus(minimalStatusArgs())
+
+	u := app.AddUnit(minimalUnitArgs(app.Type()))
+	u.SetAgentStatus(minimalStatusArgs())
+
+	s.st.model.SetStatus(description.StatusArgs{Value: "available"})
+
+	result, err := s.facade.ExportBundle(params.ExportBundleParams{ExcludeMachines: true})
+	c.Assert(err, jc.ErrorIsNil)
+	expectedResult := params.StringResult{Result: `
+series: trusty
+applications:
+  ubuntu:
+    charm: cs:trusty/ubuntu
+    channel: stable
+    num_units: 1
+    options:
+      key: value
+    bindings:
+      another: alpha
+      juju-info: vlan2
+`[1:]}
+
+	c.Assert(result, gc.Equals, expectedResult)
+	s.st.CheckCall(c, 0, "ExportPartial", s.st.GetExportConfig())
+}
+
+func (s *bundleSuite) TestGetBundleDiffEmpty(c *gc.C) {
+	s.st.model = description.NewModel(description.ModelArgs{Owner: names.NewUserTag("magic"),
+		Config: map[string]interface{}{
+			"name": "awesome",
+			"uuid": "some-uuid",
+		},
+		CloudRegion: "some-region"})
+
+	app := s.st.model.AddApplication(s.minimalApplicationArgs(description.IAAS))
+	app.SetStatus(minimalStatusArgs())
+	u := app.AddUnit(minimalUnitArgs(app.Type()))
+	u.SetAgentStatus(minimalStatusArgs())
+	s.st.model.AddMachine(description.MachineArgs{
+		Id:     names.NewMachineTag("0"),
+		Series: "trusty",
+	})
+	s.st.model.SetStatus(description.StatusArgs{Value: "available"})
+
+	result, err := s.facade.GetBundleDiff(params.BundleDiffParams{
+		BundleDataYAML: `
+series: trusty
+applications:
+  ubuntu:
+    charm: cs:trusty/ubuntu
+    channel: stable
+    num_units: 1
+    to: ["0"]
+    options:
+      key: value
+machines:
+  "0": {}
+`[1:],
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Empty, jc.IsTrue)
+	c.Assert(result.DiffYAML, gc.Equals, "{}\n")
+	s.st.CheckCall(c, 0, "ExportPartial", s.st.GetExportConfig())
+}
+
+func (s *bundleSuite) TestGetBundleDiff(c *gc.C) {
+	s.st.model = description.NewModel(description.ModelArgs{Owner: names.NewUserTag("magic"),
+		Config: map[string]interface{}{
+			"name": "awesome",
+			"uuid": "some-uuid",
+		},
+		CloudRegion: "some-region"})
+
+	app := s.st.model.AddApplication(s.minimalApplicationArgs(description.IAAS))
+	app.SetStatus(minimalStatusArgs())
+	u := app.AddUnit(minimalUnitArgs(app.Type()))
+	u.SetAgentStatus(minimalStatusArgs())
+	s.st.model.AddMachine(description.MachineArgs{
+		Id:     names.NewMachineTag("0"),
+		Series: "trusty",
+	})
+	s.st.model.SetStatus(description.StatusArgs{Value: "available"})
+
+	result, err := s.facade.GetBundleDiff(params.BundleDiffParams{
+		BundleDataYAML: `
+series: trusty
+applications:
+  ubuntu:
+    charm: cs:trusty/ubuntu
+    channel: stable
+    num_units: 2
+    to: ["0"]
+machines:
+  "0": {}
+`[1:],
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Empty, jc.IsFalse)
+	c.Assert(result.DiffYAML, gc.Equals, `
+applications:
+  ubuntu:
+    num_units:
+      bundle: 2
+      model: 1
+    options:
+      key:
+        bundle: null
+        model: value
+`[1:])
+}
+
+func (s *bundleSuite) TestGetBundleDiffBundleContentError(c *gc.C) {
+	result, err := s.facade.GetBundleDiff(params.BundleDiffParams{
+		BundleDataYAML: ":",
+	})
+	c.Assert(err, gc.ErrorMatches, `cannot read bundle YAML: unmarshal document 0: yaml: did not find expected key`)
+	c.Assert(result, gc.Equals, params.BundleDiffResult{})
+}
+
 func (s *bundleSuite) TestExportBundleWithApplicationResources(c *gc.C) {
 	s.st.model = description.NewModel(description.ModelArgs{Owner: names.NewUserTag("magic"),
 		Config: map[string]interface{}{
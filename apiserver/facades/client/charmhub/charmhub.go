@@ -160,6 +160,9 @@ func populateFindOptions(arg params.Query) []charmhub.FindOption {
 	if arg.CharmType != "" {
 		options = append(options, charmhub.WithFindType(arg.CharmType))
 	}
+	if arg.Base != "" {
+		options = append(options, charmhub.WithFindBase(arg.Base))
+	}
 	if arg.Platforms != "" {
 		options = append(options, charmhub.WithFindPlatforms(arg.Platforms))
 	}
@@ -172,6 +175,12 @@ func populateFindOptions(arg params.Query) []charmhub.FindOption {
 	if arg.RelationProvides != "" {
 		options = append(options, charmhub.WithFindRelationProvides(arg.RelationProvides))
 	}
+	if arg.Limit > 0 {
+		options = append(options, charmhub.WithFindLimit(arg.Limit))
+	}
+	if arg.Offset > 0 {
+		options = append(options, charmhub.WithFindOffset(arg.Offset))
+	}
 
 	return options
 }
@@ -58,6 +58,14 @@ type APIv2 struct {
 }
 
 type APIv3 struct {
+	*APIv4
+}
+
+type APIv4 struct {
+	*APIv5
+}
+
+type APIv5 struct {
 	*API
 }
 
@@ -96,28 +104,42 @@ func (a *API) checkCanWrite() error {
 // NewFacadeV2 provides the signature required for facade V2 registration.
 // It is unknown where V1 is.
 func NewFacadeV2(ctx facade.Context) (*APIv2, error) {
-	v4, err := NewFacadeV4(ctx)
+	v3, err := NewFacadeV3(ctx)
 	if err != nil {
 		return nil, nil
 	}
-	return &APIv2{
-		APIv3: &APIv3{
-			API: v4,
-		},
-	}, nil
+	return &APIv2{APIv3: v3}, nil
 }
 
 // NewFacadeV3 provides the signature required for facade V3 registration.
 func NewFacadeV3(ctx facade.Context) (*APIv3, error) {
-	api, err := NewFacadeV4(ctx)
+	v4, err := NewFacadeV4(ctx)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	return &APIv3{API: api}, nil
+	return &APIv3{APIv4: v4}, nil
 }
 
 // NewFacadeV4 provides the signature required for facade V4 registration.
-func NewFacadeV4(ctx facade.Context) (*API, error) {
+func NewFacadeV4(ctx facade.Context) (*APIv4, error) {
+	v5, err := NewFacadeV5(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIv4{APIv5: v5}, nil
+}
+
+// NewFacadeV5 provides the signature required for facade V5 registration.
+func NewFacadeV5(ctx facade.Context) (*APIv5, error) {
+	api, err := NewFacadeV6(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIv5{API: api}, nil
+}
+
+// NewFacadeV6 provides the signature required for facade V6 registration.
+func NewFacadeV6(ctx facade.Context) (*API, error) {
 	authorizer := ctx.Auth()
 	if !authorizer.AuthClient() {
 		return nil, apiservererrors.ErrPerm
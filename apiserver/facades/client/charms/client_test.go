@@ -64,6 +64,7 @@ func (ctx *charmsSuiteContext) StatePool() *state.StatePool                   {
 func (ctx *charmsSuiteContext) ID() string                                    { return "" }
 func (ctx *charmsSuiteContext) RequestRecorder() facade.RequestRecorder       { return nil }
 func (ctx *charmsSuiteContext) Presence() facade.Presence                     { return nil }
+func (ctx *charmsSuiteContext) LogNoise() facade.LogNoise                     { return nil }
 func (ctx *charmsSuiteContext) Hub() facade.Hub                               { return nil }
 func (ctx *charmsSuiteContext) Controller() *cache.Controller                 { return nil }
 func (ctx *charmsSuiteContext) CachedModel(uuid string) (*cache.Model, error) { return nil, nil }
@@ -75,6 +76,9 @@ func (ctx *charmsSuiteContext) LeadershipChecker() (leadership.Checker, error)
 func (ctx *charmsSuiteContext) LeadershipPinner(string) (leadership.Pinner, error)   { return nil, nil }
 func (ctx *charmsSuiteContext) LeadershipReader(string) (leadership.Reader, error)   { return nil, nil }
 func (ctx *charmsSuiteContext) SingularClaimer() (lease.Claimer, error)              { return nil, nil }
+func (ctx *charmsSuiteContext) UserLeaseClaimer() (lease.Claimer, error)             { return nil, nil }
+func (ctx *charmsSuiteContext) UserLeaseRevoker() (lease.Revoker, error)             { return nil, nil }
+func (ctx *charmsSuiteContext) UserLeaseReader() (lease.Reader, error)               { return nil, nil }
 func (ctx *charmsSuiteContext) Raft() facade.RaftContext                             { return nil }
 
 func (s *charmsSuite) SetUpTest(c *gc.C) {
@@ -86,7 +90,7 @@ func (s *charmsSuite) SetUpTest(c *gc.C) {
 	}
 
 	var err error
-	s.api, err = charms.NewFacadeV4(&charmsSuiteContext{cs: s})
+	s.api, err = charms.NewFacadeV6(&charmsSuiteContext{cs: s})
 	c.Assert(err, jc.ErrorIsNil)
 }
 
@@ -670,6 +674,77 @@ func (s *charmsMockSuite) TestCheckCharmPlacementWithHeterogeneous(c *gc.C) {
 	c.Assert(result.OneError(), gc.ErrorMatches, "charm can not be placed in a heterogeneous environment")
 }
 
+func (s *charmsMockSuite) TestSupportMatrix(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	curl := charm.MustParseURL("ch:testme")
+	s.state.EXPECT().ModelConstraints().Return(constraints.Value{}, nil)
+	s.repoFactory.EXPECT().GetCharmRepository(gomock.Any()).Return(s.repository, nil)
+	s.repository.EXPECT().SupportMatrix(
+		curl,
+		gomock.AssignableToTypeOf(corecharm.Origin{}),
+		nil, // no macaroons
+	).Return([]corecharm.SupportedRelease{{
+		Channel: "latest/stable",
+		Platform: corecharm.Platform{
+			Architecture: arch.DefaultArchitecture,
+			OS:           "ubuntu",
+			Series:       "focal",
+		},
+	}, {
+		Channel: "latest/stable",
+		Platform: corecharm.Platform{
+			Architecture: arch.DefaultArchitecture,
+			OS:           "ubuntu",
+			Series:       "bionic",
+		},
+	}}, nil)
+
+	api := s.api(c)
+
+	result, err := api.SupportMatrix(params.CharmURLAndOrigins{
+		Entities: []params.CharmURLAndOrigin{{
+			CharmURL: curl.String(),
+			Origin: params.CharmOrigin{
+				Source:       corecharm.CharmHub.String(),
+				Architecture: arch.DefaultArchitecture,
+			},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 1)
+	c.Assert(result.Results[0].Error, gc.IsNil)
+	c.Assert(result.Results[0].Releases, jc.DeepEquals, []params.CharmSupportedRelease{{
+		Channel:      "latest/stable",
+		OS:           "ubuntu",
+		Series:       "focal",
+		Architecture: arch.DefaultArchitecture,
+	}, {
+		Channel:      "latest/stable",
+		OS:           "ubuntu",
+		Series:       "bionic",
+		Architecture: arch.DefaultArchitecture,
+	}})
+}
+
+func (s *charmsMockSuite) TestSupportMatrixNotSupportedForCharmStore(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	api := s.api(c)
+
+	result, err := api.SupportMatrix(params.CharmURLAndOrigins{
+		Entities: []params.CharmURLAndOrigin{{
+			CharmURL: "cs:testme",
+			Origin: params.CharmOrigin{
+				Source: corecharm.CharmStore.String(),
+			},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 1)
+	c.Assert(result.Results[0].Error, gc.ErrorMatches, `support matrix for charm "testme" not supported`)
+}
+
 func (s *charmsMockSuite) api(c *gc.C) *charms.API {
 	api, err := charms.NewCharmsAPI(
 		s.authorizer,
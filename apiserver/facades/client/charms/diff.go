@@ -0,0 +1,167 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package charms
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/juju/charm/v9"
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/params"
+)
+
+// CharmDiff isn't on the v4 API.
+func (a *APIv4) CharmDiff(_ struct{}) {}
+
+// CharmDiff compares the charm revision currently deployed by an
+// application against another revision of the same charm and reports
+// the metadata, config and action differences. Both revisions must be
+// present in the controller's charm store.
+func (a *API) CharmDiff(args params.CharmDiffArgs) (params.CharmDiffResult, error) {
+	var result params.CharmDiffResult
+	if err := a.checkCanRead(); err != nil {
+		return result, errors.Trace(err)
+	}
+	appTag, err := names.ParseApplicationTag(args.ApplicationTag)
+	if err != nil {
+		result.Error = apiservererrors.ServerError(err)
+		return result, nil
+	}
+	diff, err := a.charmDiff(appTag.Id(), args.Revision)
+	if err != nil {
+		result.Error = apiservererrors.ServerError(err)
+		return result, nil
+	}
+	result.Diff = *diff
+	return result, nil
+}
+
+func (a *API) charmDiff(appName string, revision int) (*params.CharmDiff, error) {
+	app, err := a.backendState.Application(appName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	curl, ok := app.CharmURL()
+	if !ok {
+		return nil, errors.Errorf("application %q has no charm url set", appName)
+	}
+	oldCharm, err := a.backendState.Charm(curl)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	newCurl := curl.WithRevision(revision)
+	newCharm, err := a.backendState.Charm(newCurl)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.NotFoundf("charm %q in the controller's charm store", newCurl)
+		}
+		return nil, errors.Trace(err)
+	}
+
+	diff := &params.CharmDiff{
+		Old: curl.String(),
+		New: newCurl.String(),
+	}
+	diffOptions(diff, oldCharm.Config(), newCharm.Config())
+	diffRelations(diff, oldCharm.Meta(), newCharm.Meta())
+	diffActions(diff, oldCharm.Actions(), newCharm.Actions())
+	return diff, nil
+}
+
+func diffOptions(diff *params.CharmDiff, oldConfig, newConfig *charm.Config) {
+	var oldOptions, newOptions map[string]charm.Option
+	if oldConfig != nil {
+		oldOptions = oldConfig.Options
+	}
+	if newConfig != nil {
+		newOptions = newConfig.Options
+	}
+	for name, newOpt := range newOptions {
+		oldOpt, ok := oldOptions[name]
+		if !ok {
+			diff.AddedOptions = append(diff.AddedOptions, name)
+			continue
+		}
+		if oldOpt.Type == newOpt.Type && reflect.DeepEqual(oldOpt.Default, newOpt.Default) {
+			continue
+		}
+		if diff.ChangedOptions == nil {
+			diff.ChangedOptions = make(map[string]params.CharmOptionChange)
+		}
+		diff.ChangedOptions[name] = params.CharmOptionChange{
+			OldType:    oldOpt.Type,
+			NewType:    newOpt.Type,
+			OldDefault: oldOpt.Default,
+			NewDefault: newOpt.Default,
+		}
+	}
+	for name := range oldOptions {
+		if _, ok := newOptions[name]; !ok {
+			diff.RemovedOptions = append(diff.RemovedOptions, name)
+		}
+	}
+	sort.Strings(diff.AddedOptions)
+	sort.Strings(diff.RemovedOptions)
+}
+
+func diffRelations(diff *params.CharmDiff, oldMeta, newMeta *charm.Meta) {
+	oldEndpoints := relationEndpoints(oldMeta)
+	newEndpoints := relationEndpoints(newMeta)
+	for endpoint := range newEndpoints {
+		if !oldEndpoints[endpoint] {
+			diff.AddedRelations = append(diff.AddedRelations, endpoint)
+		}
+	}
+	for endpoint := range oldEndpoints {
+		if !newEndpoints[endpoint] {
+			diff.RemovedRelations = append(diff.RemovedRelations, endpoint)
+		}
+	}
+	sort.Strings(diff.AddedRelations)
+	sort.Strings(diff.RemovedRelations)
+}
+
+// relationEndpoints flattens a charm's relation declarations into
+// "role name:interface" descriptors for comparison.
+func relationEndpoints(meta *charm.Meta) map[string]bool {
+	endpoints := make(map[string]bool)
+	if meta == nil {
+		return endpoints
+	}
+	for _, relations := range []map[string]charm.Relation{
+		meta.Provides, meta.Requires, meta.Peers,
+	} {
+		for _, rel := range relations {
+			endpoints[fmt.Sprintf("%s %s:%s", rel.Role, rel.Name, rel.Interface)] = true
+		}
+	}
+	return endpoints
+}
+
+func diffActions(diff *params.CharmDiff, oldActions, newActions *charm.Actions) {
+	var oldSpecs, newSpecs map[string]charm.ActionSpec
+	if oldActions != nil {
+		oldSpecs = oldActions.ActionSpecs
+	}
+	if newActions != nil {
+		newSpecs = newActions.ActionSpecs
+	}
+	for name := range newSpecs {
+		if _, ok := oldSpecs[name]; !ok {
+			diff.AddedActions = append(diff.AddedActions, name)
+		}
+	}
+	for name := range oldSpecs {
+		if _, ok := newSpecs[name]; !ok {
+			diff.RemovedActions = append(diff.RemovedActions, name)
+		}
+	}
+	sort.Strings(diff.AddedActions)
+	sort.Strings(diff.RemovedActions)
+}
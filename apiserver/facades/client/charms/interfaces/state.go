@@ -47,6 +47,7 @@ type BackendState interface {
 // the same names.
 type Application interface {
 	AllUnits() ([]Unit, error)
+	CharmURL() (*charm.URL, bool)
 	Constraints() (constraints.Value, error)
 	IsPrincipal() bool
 }
@@ -121,6 +121,21 @@ func (mr *MockRepositoryMockRecorder) ResolveWithPreferredChannel(arg0, arg1, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveWithPreferredChannel", reflect.TypeOf((*MockRepository)(nil).ResolveWithPreferredChannel), arg0, arg1, arg2)
 }
 
+// SupportMatrix mocks base method.
+func (m *MockRepository) SupportMatrix(arg0 *charm.URL, arg1 charm0.Origin, arg2 macaroon.Slice) ([]charm0.SupportedRelease, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SupportMatrix", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]charm0.SupportedRelease)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SupportMatrix indicates an expected call of SupportMatrix.
+func (mr *MockRepositoryMockRecorder) SupportMatrix(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupportMatrix", reflect.TypeOf((*MockRepository)(nil).SupportMatrix), arg0, arg1, arg2)
+}
+
 // MockRepositoryFactory is a mock of RepositoryFactory interface.
 type MockRepositoryFactory struct {
 	ctrl     *gomock.Controller
@@ -386,6 +386,21 @@ func (mr *MockApplicationMockRecorder) AllUnits() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllUnits", reflect.TypeOf((*MockApplication)(nil).AllUnits))
 }
 
+// CharmURL mocks base method.
+func (m *MockApplication) CharmURL() (*charm.URL, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CharmURL")
+	ret0, _ := ret[0].(*charm.URL)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// CharmURL indicates an expected call of CharmURL.
+func (mr *MockApplicationMockRecorder) CharmURL() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CharmURL", reflect.TypeOf((*MockApplication)(nil).CharmURL))
+}
+
 // Constraints mocks base method.
 func (m *MockApplication) Constraints() (constraints.Value, error) {
 	m.ctrl.T.Helper()
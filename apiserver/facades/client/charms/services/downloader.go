@@ -46,7 +46,13 @@ func NewCharmDownloader(cfg CharmDownloaderConfig) (*charmdownloader.Downloader,
 		}),
 	}
 
-	return charmdownloader.NewDownloader(cfg.Logger.Child("charmdownloader"), storage, repoFactory), nil
+	ctrlCfg, err := cfg.StateBackend.ControllerConfig()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	policy := charmdownloader.AttestationPolicy(ctrlCfg.CharmAttestationPolicy())
+
+	return charmdownloader.NewDownloader(cfg.Logger.Child("charmdownloader"), storage, repoFactory, policy), nil
 }
 
 // repoFactoryShim wraps a CharmRepoFactory and is compatible with the
@@ -34,5 +34,6 @@ type ModelBackend interface {
 // Storage describes an API for storing and deleting blobs.
 type Storage interface {
 	Put(path string, r io.Reader, length int64) error
+	PutByHash(path string, r io.ReadSeeker, hash string) error
 	Remove(path string) error
 }
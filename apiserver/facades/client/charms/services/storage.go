@@ -5,6 +5,7 @@ package services
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/juju/charm/v9"
 	"github.com/juju/errors"
@@ -70,7 +71,7 @@ func (s *CharmStorage) Store(charmURL *charm.URL, downloadedCharm charmdownloade
 	if err != nil {
 		return errors.Annotate(err, "cannot generate charm archive name")
 	}
-	if err := storage.Put(storagePath, downloadedCharm.CharmData, downloadedCharm.Size); err != nil {
+	if err := s.put(storage, storagePath, downloadedCharm); err != nil {
 		return errors.Annotate(err, "cannot add charm to storage")
 	}
 
@@ -106,6 +107,29 @@ func (s *CharmStorage) Store(charmURL *charm.URL, downloadedCharm charmdownloade
 	return nil
 }
 
+// put writes the charm blob to storage at storagePath. When the blob's
+// SHA384 hash is known and its data is seekable, a by-hash put is
+// attempted first so that a blob another model already uploaded is
+// referenced in place rather than stored again.
+func (s *CharmStorage) put(storage Storage, storagePath string, downloadedCharm charmdownloader.DownloadedCharm) error {
+	if seeker, ok := downloadedCharm.CharmData.(io.ReadSeeker); ok && downloadedCharm.SHA384 != "" {
+		err := storage.PutByHash(storagePath, seeker, downloadedCharm.SHA384)
+		if err == nil {
+			s.logger.Debugf("reusing already-stored charm blob for %q", storagePath)
+			return nil
+		}
+		if !errors.IsNotFound(err) {
+			return errors.Trace(err)
+		}
+		// The blobstore holds no content with this hash; rewind and
+		// upload the data as usual.
+		if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return errors.Trace(storage.Put(storagePath, downloadedCharm.CharmData, downloadedCharm.Size))
+}
+
 // charmArchiveStoragePath returns a string that is suitable as a
 // storage path, using a random UUID to avoid colliding with concurrent
 // uploads.
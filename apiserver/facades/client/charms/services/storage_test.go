@@ -6,10 +6,12 @@ package services
 import (
 	"errors"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/golang/mock/gomock"
 	"github.com/juju/charm/v9"
+	jujuerrors "github.com/juju/errors"
 	"github.com/juju/loggo"
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
@@ -112,6 +114,102 @@ func (s *storageTestSuite) TestStoreBlobAlreadyStored(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil) // charm already uploaded by someone; no error
 }
 
+func (s *storageTestSuite) TestStorePutByHashHit(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	curl := charm.MustParseURL("ch:ubuntu-lite")
+	expStoreCharmPath := fmt.Sprintf("charms/%s-%s", curl.String(), s.uuid)
+	blob := strings.NewReader("the-blob")
+	dlCharm := downloader.DownloadedCharm{
+		CharmData: blob,
+		Size:      7337,
+		SHA256:    "d357",
+		SHA384:    "f384",
+	}
+
+	s.stateBackend.EXPECT().ModelUUID().Return("the-model-uuid")
+	// Another model already uploaded a blob with this hash, so no
+	// regular Put follows.
+	s.storageBackend.EXPECT().PutByHash(expStoreCharmPath, blob, "f384").Return(nil)
+	s.stateBackend.EXPECT().UpdateUploadedCharm(state.CharmInfo{
+		StoragePath: expStoreCharmPath,
+		ID:          curl,
+		SHA256:      "d357",
+	}).Return(nil, nil)
+
+	err := s.storage.Store(curl, dlCharm)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *storageTestSuite) TestStorePutByHashMissFallsBackToPut(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	curl := charm.MustParseURL("ch:ubuntu-lite")
+	expStoreCharmPath := fmt.Sprintf("charms/%s-%s", curl.String(), s.uuid)
+	blob := strings.NewReader("the-blob")
+	dlCharm := downloader.DownloadedCharm{
+		CharmData: blob,
+		Size:      7337,
+		SHA256:    "d357",
+		SHA384:    "f384",
+	}
+
+	s.stateBackend.EXPECT().ModelUUID().Return("the-model-uuid")
+	s.storageBackend.EXPECT().PutByHash(expStoreCharmPath, blob, "f384").DoAndReturn(
+		func(_ string, r io.ReadSeeker, _ string) error {
+			// Consume the reader as the real blobstore would before
+			// discovering the hash is unknown.
+			_, err := io.Copy(io.Discard, r)
+			c.Assert(err, jc.ErrorIsNil)
+			return jujuerrors.NotFoundf("content with hash %q", "f384")
+		})
+	s.storageBackend.EXPECT().Put(expStoreCharmPath, blob, int64(7337)).DoAndReturn(
+		func(_ string, r io.Reader, _ int64) error {
+			// The data must have been rewound for the regular upload.
+			data, err := io.ReadAll(r)
+			c.Assert(err, jc.ErrorIsNil)
+			c.Assert(string(data), gc.Equals, "the-blob")
+			return nil
+		})
+	s.stateBackend.EXPECT().UpdateUploadedCharm(state.CharmInfo{
+		StoragePath: expStoreCharmPath,
+		ID:          curl,
+		SHA256:      "d357",
+	}).Return(nil, nil)
+
+	err := s.storage.Store(curl, dlCharm)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *storageTestSuite) TestStorePutByHashSeekFailure(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	curl := charm.MustParseURL("ch:ubuntu-lite")
+	expStoreCharmPath := fmt.Sprintf("charms/%s-%s", curl.String(), s.uuid)
+	blob := &failingSeeker{Reader: strings.NewReader("the-blob")}
+	dlCharm := downloader.DownloadedCharm{
+		CharmData: blob,
+		Size:      7337,
+		SHA384:    "f384",
+	}
+
+	s.stateBackend.EXPECT().ModelUUID().Return("the-model-uuid")
+	s.storageBackend.EXPECT().PutByHash(expStoreCharmPath, blob, "f384").Return(
+		jujuerrors.NotFoundf("content with hash %q", "f384"))
+
+	err := s.storage.Store(curl, dlCharm)
+	c.Assert(err, gc.ErrorMatches, "cannot add charm to storage: seek failed")
+}
+
+// failingSeeker reads normally but cannot be rewound.
+type failingSeeker struct {
+	*strings.Reader
+}
+
+func (*failingSeeker) Seek(offset int64, whence int) (int64, error) {
+	return 0, errors.New("seek failed")
+}
+
 func (s *storageTestSuite) setupMocks(c *gc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 	s.stateBackend = NewMockStateBackend(ctrl)
@@ -0,0 +1,86 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package charms
+
+import (
+	"github.com/juju/charm/v9"
+	"github.com/juju/errors"
+	"gopkg.in/macaroon.v2"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/params"
+	corecharm "github.com/juju/juju/core/charm"
+)
+
+// SupportMatrix isn't on the v5 API.
+func (a *APIv5) SupportMatrix(_ struct{}) {}
+
+// SupportMatrix returns, for each given charm, the full matrix of channel,
+// base and architecture combinations the charm has been released for, as
+// reported by charmhub.
+func (a *API) SupportMatrix(args params.CharmURLAndOrigins) (params.CharmSupportMatrixResults, error) {
+	logger.Tracef("SupportMatrix %+v", args)
+	if err := a.checkCanRead(); err != nil {
+		return params.CharmSupportMatrixResults{}, errors.Trace(err)
+	}
+
+	results := params.CharmSupportMatrixResults{
+		Results: make([]params.CharmSupportMatrixResult, len(args.Entities)),
+	}
+	for i, arg := range args.Entities {
+		releases, err := a.oneSupportMatrix(arg)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Releases = releases
+	}
+	return results, nil
+}
+
+func (a *API) oneSupportMatrix(arg params.CharmURLAndOrigin) ([]params.CharmSupportedRelease, error) {
+	curl, err := charm.ParseURL(arg.CharmURL)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !charm.CharmHub.Matches(curl.Schema) {
+		return nil, errors.NotSupportedf("support matrix for charm %q", curl.Name)
+	}
+
+	defaultArch, err := a.getDefaultArch()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	charmOrigin, err := normalizeCharmOrigin(arg.Origin, defaultArch)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	repo, err := a.getCharmRepository(corecharm.Source(charmOrigin.Source))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var macaroons macaroon.Slice
+	if arg.Macaroon != nil {
+		macaroons = append(macaroons, arg.Macaroon)
+	}
+
+	releases, err := repo.SupportMatrix(curl, convertParamsOrigin(charmOrigin), macaroons)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	results := make([]params.CharmSupportedRelease, len(releases))
+	for i, release := range releases {
+		results[i] = params.CharmSupportedRelease{
+			Channel:      release.Channel,
+			OS:           release.Platform.OS,
+			Series:       release.Platform.Series,
+			Architecture: release.Platform.Architecture,
+		}
+	}
+	return results, nil
+}
@@ -39,6 +39,8 @@ type Backend interface {
 	AllApplicationOffers() ([]*crossmodel.ApplicationOffer, error)
 	AllRemoteApplications() ([]*state.RemoteApplication, error)
 	AllMachines() ([]*state.Machine, error)
+	AllMaintenanceWindows() (map[string][]state.MaintenanceWindow, error)
+	AllDisabledWorkers() (map[string][]string, error)
 	AllModelUUIDs() ([]string, error)
 	AllIPAddresses() ([]*state.Address, error)
 	AllLinkLayerDevices() ([]*state.LinkLayerDevice, error)
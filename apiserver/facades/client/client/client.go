@@ -4,6 +4,7 @@
 package client
 
 import (
+	stdcontext "context"
 	"fmt"
 	"strings"
 	"time"
@@ -196,7 +197,7 @@ func newFacade(ctx facade.Context) (*Client, error) {
 	return NewClient(
 		&stateShim{st, model, nil},
 		&poolShim{ctx.StatePool()},
-		modelConfigAPI,
+		modelConfigAPI.ModelConfigAPIV2,
 		resources,
 		authorizer,
 		presence,
@@ -662,7 +663,7 @@ func (c *Client) AgentVersion() (params.AgentVersionResult, error) {
 }
 
 // SetModelAgentVersion sets the model agent version.
-func (c *Client) SetModelAgentVersion(args params.SetModelAgentVersion) error {
+func (c *Client) SetModelAgentVersion(ctx stdcontext.Context, args params.SetModelAgentVersion) error {
 	if err := c.checkCanWrite(); err != nil {
 		return err
 	}
@@ -676,9 +677,11 @@ func (c *Client) SetModelAgentVersion(args params.SetModelAgentVersion) error {
 	if err != nil {
 		return errors.Trace(err)
 	}
-	// Check IAAS clouds.
+	// Check IAAS clouds. The provider call is scoped to the request
+	// context so that it is abandoned if the request is cancelled.
 	if env, ok := envOrBroker.(environs.InstanceBroker); ok {
-		if err := environs.CheckProviderAPI(env, c.callContext); err != nil {
+		callCtx := context.WithCancellation(ctx, c.callContext)
+		if err := environs.CheckProviderAPI(env, callCtx); err != nil {
 			return err
 		}
 	}
@@ -737,6 +740,26 @@ func (c *Client) SetModelAgentVersion(args params.SetModelAgentVersion) error {
 	return c.api.stateAccessor.SetModelAgentVersion(args.Version, args.IgnoreAgentVersions)
 }
 
+// PrecheckModelUpgrade runs the registered upgrade prechecks against the
+// model for the given target version and returns a readiness report. No
+// state is changed; the model agent version is left alone.
+func (c *Client) PrecheckModelUpgrade(args params.PrecheckModelUpgrade) (params.PrecheckModelUpgradeResults, error) {
+	if err := c.checkCanRead(); err != nil {
+		return params.PrecheckModelUpgradeResults{}, err
+	}
+	checkResults := upgrades.RunModelPrechecks(c.api.state(), args.TargetVersion)
+	results := params.PrecheckModelUpgradeResults{
+		Results: make([]params.ModelUpgradePrecheckResult, len(checkResults)),
+	}
+	for i, r := range checkResults {
+		results.Results[i] = params.ModelUpgradePrecheckResult{
+			Name:  r.Name,
+			Error: apiservererrors.ServerError(r.Err),
+		}
+	}
+	return results, nil
+}
+
 // CheckMongoStatusForUpgrade returns an error if the replicaset is not in a good
 // enough state for an upgrade to continue. Exported for testing.
 func (c *Client) CheckMongoStatusForUpgrade(session MongoSession) error {
@@ -4,6 +4,7 @@
 package client_test
 
 import (
+	stdcontext "context"
 	"fmt"
 	"sort"
 	"strconv"
@@ -282,7 +283,7 @@ func (s *serverSuite) TestSetModelAgentVersion(c *gc.C) {
 	args := params.SetModelAgentVersion{
 		Version: version.MustParse(validVersion.String()),
 	}
-	err := s.client.SetModelAgentVersion(args)
+	err := s.client.SetModelAgentVersion(stdcontext.Background(), args)
 	c.Assert(err, jc.ErrorIsNil)
 	s.assertModelVersion(c, s.State, validVersion.String())
 }
@@ -293,7 +294,7 @@ func (s *serverSuite) TestSetModelAgentVersionOldModels(c *gc.C) {
 	args := params.SetModelAgentVersion{
 		Version: version.MustParse(fmt.Sprintf("%d.0.0", jujuversion.Current.Major)),
 	}
-	err = s.client.SetModelAgentVersion(args)
+	err = s.client.SetModelAgentVersion(stdcontext.Background(), args)
 	c.Assert(err, gc.ErrorMatches, `
 these models must first be upgraded to at least 2.8.9 before upgrading the controller:
  -admin/controller`[1:])
@@ -324,7 +325,7 @@ func (s *serverSuite) TestSetModelAgentVersionForced(c *gc.C) {
 	args := params.SetModelAgentVersion{
 		Version: version.MustParse(validVersion.String()),
 	}
-	err = s.client.SetModelAgentVersion(args)
+	err = s.client.SetModelAgentVersion(stdcontext.Background(), args)
 	c.Check(err, gc.ErrorMatches, "some agents have not upgraded to the current model version .*: unit-wordpress-0")
 	// Version hasn't changed
 	s.assertModelVersion(c, s.State, currentVersion)
@@ -335,7 +336,7 @@ func (s *serverSuite) TestSetModelAgentVersionForced(c *gc.C) {
 		Version:             to,
 		IgnoreAgentVersions: true,
 	}
-	err = s.client.SetModelAgentVersion(args)
+	err = s.client.SetModelAgentVersion(stdcontext.Background(), args)
 	c.Assert(err, jc.ErrorIsNil)
 	s.assertModelVersion(c, s.State, to.String())
 }
@@ -358,7 +359,7 @@ func (s *serverSuite) TestControllerModelSetModelAgentVersionBlockedByImportingM
 	args := params.SetModelAgentVersion{
 		Version: version.MustParse(validVersion.String()),
 	}
-	err := s.client.SetModelAgentVersion(args)
+	err := s.client.SetModelAgentVersion(stdcontext.Background(), args)
 	c.Assert(err, gc.ErrorMatches, `model "some-user/to-migrate" is importing, upgrade blocked`)
 }
 
@@ -368,7 +369,7 @@ func (s *serverSuite) TestControllerModelSetModelAgentVersionBlockedByExportingM
 	args := params.SetModelAgentVersion{
 		Version: version.MustParse(validVersion.String()),
 	}
-	err := s.client.SetModelAgentVersion(args)
+	err := s.client.SetModelAgentVersion(stdcontext.Background(), args)
 	c.Assert(err, gc.ErrorMatches, `model "some-user/to-migrate" is exporting, upgrade blocked`)
 }
 
@@ -388,7 +389,7 @@ func (s *serverSuite) TestUserModelSetModelAgentVersionNotAffectedByMigration(c
 		return &mockEnviron{}, nil
 	}
 
-	err := client.SetModelAgentVersion(args)
+	err := client.SetModelAgentVersion(stdcontext.Background(), args)
 	c.Assert(err, jc.ErrorIsNil)
 
 	s.assertModelVersion(c, otherSt, "2.0.4")
@@ -403,7 +404,7 @@ func (s *serverSuite) TestControllerModelSetModelAgentVersionChecksReplicaset(c
 	args := params.SetModelAgentVersion{
 		Version: version.MustParse(validVersion.String()),
 	}
-	err := s.client.SetModelAgentVersion(args)
+	err := s.client.SetModelAgentVersion(stdcontext.Background(), args)
 	c.Assert(err.Error(), gc.Equals, "checking replicaset status: boom")
 }
 
@@ -425,7 +426,7 @@ func (s *serverSuite) TestUserModelSetModelAgentVersionSkipsMongoCheck(c *gc.C)
 		return &mockEnviron{}, nil
 	}
 
-	err := apiserverClient.SetModelAgentVersion(args)
+	err := apiserverClient.SetModelAgentVersion(stdcontext.Background(), args)
 	c.Assert(err, jc.ErrorIsNil)
 
 	s.assertModelVersion(c, otherSt, "2.0.4")
@@ -450,7 +451,7 @@ func (s *serverSuite) assertCheckProviderAPI(c *gc.C, envError error, expectErr
 	args := params.SetModelAgentVersion{
 		Version: version.MustParse(validVersion.String()),
 	}
-	err := s.client.SetModelAgentVersion(args)
+	err := s.client.SetModelAgentVersion(stdcontext.Background(), args)
 	c.Assert(env.allInstancesCalled, jc.IsTrue)
 	if expectErr != "" {
 		c.Assert(err, gc.ErrorMatches, expectErr)
@@ -493,7 +494,7 @@ func (s *serverSuite) assertCheckCAASProviderAPI(c *gc.C, envError error, expect
 	args := params.SetModelAgentVersion{
 		Version: version.MustParse(validVersion.String()),
 	}
-	err := s.client.SetModelAgentVersion(args)
+	err := s.client.SetModelAgentVersion(stdcontext.Background(), args)
 	c.Assert(env.getMetadataCalled, jc.IsTrue)
 	if expectErr != "" {
 		c.Assert(err, gc.ErrorMatches, expectErr)
@@ -514,7 +515,7 @@ func (s *serverSuite) assertSetModelAgentVersion(c *gc.C) {
 	args := params.SetModelAgentVersion{
 		Version: version.MustParse(validVersion.String()),
 	}
-	err := s.client.SetModelAgentVersion(args)
+	err := s.client.SetModelAgentVersion(stdcontext.Background(), args)
 	c.Assert(err, jc.ErrorIsNil)
 	modelConfig, err := s.Model.ModelConfig()
 	c.Assert(err, jc.ErrorIsNil)
@@ -527,7 +528,7 @@ func (s *serverSuite) assertSetModelAgentVersionBlocked(c *gc.C, msg string) {
 	args := params.SetModelAgentVersion{
 		Version: version.MustParse(validVersion.String()),
 	}
-	err := s.client.SetModelAgentVersion(args)
+	err := s.client.SetModelAgentVersion(stdcontext.Background(), args)
 	s.AssertBlocked(c, err, msg)
 }
 
@@ -4,6 +4,7 @@
 package client
 
 import (
+	stdcontext "context"
 	"sort"
 	"strings"
 	"time"
@@ -210,11 +211,49 @@ func (c *Client) StatusHistory(request params.StatusHistoryRequests) params.Stat
 	return results
 }
 
+// Status sections that clients can select via StatusParams.Include.
+const (
+	statusSectionMachines           = "machines"
+	statusSectionApplications       = "applications"
+	statusSectionRemoteApplications = "remote-applications"
+	statusSectionOffers             = "offers"
+	statusSectionRelations          = "relations"
+	statusSectionBranches           = "branches"
+)
+
+// parseStatusIncludes validates the requested status sections. An
+// empty request selects every section.
+func parseStatusIncludes(include []string) (set.Strings, error) {
+	known := set.NewStrings(
+		statusSectionMachines,
+		statusSectionApplications,
+		statusSectionRemoteApplications,
+		statusSectionOffers,
+		statusSectionRelations,
+		statusSectionBranches,
+	)
+	sections := set.NewStrings()
+	for _, section := range include {
+		if !known.Contains(section) {
+			return nil, errors.NotValidf("status section %q", section)
+		}
+		sections.Add(section)
+	}
+	return sections, nil
+}
+
 // FullStatus gives the information needed for juju status over the api
-func (c *Client) FullStatus(args params.StatusParams) (params.FullStatus, error) {
+func (c *Client) FullStatus(ctx stdcontext.Context, args params.StatusParams) (params.FullStatus, error) {
 	if err := c.checkCanRead(); err != nil {
 		return params.FullStatus{}, err
 	}
+	sections, err := parseStatusIncludes(args.Include)
+	if err != nil {
+		return params.FullStatus{}, errors.Trace(err)
+	}
+	wanted := func(section string) bool {
+		return sections.IsEmpty() || sections.Contains(section)
+	}
 
 	var noStatus params.FullStatus
 	var context statusContext
@@ -240,21 +279,32 @@ func (c *Client) FullStatus(args params.StatusParams) (params.FullStatus, error)
 	if context.status, err = context.model.LoadModelStatus(); err != nil {
 		return noStatus, errors.Annotate(err, "could not load model status values")
 	}
+	// Fetching the full status issues a long series of state queries;
+	// stop early if the request has been cancelled rather than letting
+	// them all run to completion for a client that has gone away.
+	if err = ctx.Err(); err != nil {
+		return noStatus, errors.Trace(err)
+	}
 	if context.allAppsUnitsCharmBindings, err =
 		fetchAllApplicationsAndUnits(c.api.stateAccessor, context.model, context.spaceInfos); err != nil {
 		return noStatus, errors.Annotate(err, "could not fetch applications and units")
 	}
-	if context.consumerRemoteApplications, err =
-		fetchConsumerRemoteApplications(c.api.stateAccessor); err != nil {
-		return noStatus, errors.Annotate(err, "could not fetch remote applications")
+	if wanted(statusSectionRemoteApplications) {
+		if context.consumerRemoteApplications, err =
+			fetchConsumerRemoteApplications(c.api.stateAccessor); err != nil {
+			return noStatus, errors.Annotate(err, "could not fetch remote applications")
+		}
 	}
 	// Only admins can see offer details.
-	if err := c.checkIsAdmin(); err == nil {
+	if err := c.checkIsAdmin(); err == nil && wanted(statusSectionOffers) {
 		if context.offers, err =
 			fetchOffers(c.api.stateAccessor, context.allAppsUnitsCharmBindings.applications); err != nil {
 			return noStatus, errors.Annotate(err, "could not fetch application offers")
 		}
 	}
+	if err = ctx.Err(); err != nil {
+		return noStatus, errors.Trace(err)
+	}
 	if err = context.fetchMachines(c.api.stateAccessor); err != nil {
 		return noStatus, errors.Annotate(err, "could not fetch machines")
 	}
@@ -276,6 +326,9 @@ func (c *Client) FullStatus(args params.StatusParams) (params.FullStatus, error)
 			context.primaryHAMachine = &primaryHAMachine
 		}
 	}
+	if err = ctx.Err(); err != nil {
+		return noStatus, errors.Trace(err)
+	}
 	// These may be empty when machines have not finished deployment.
 	if context.ipAddresses, context.spaces, context.linkLayerDevices, err =
 		fetchNetworkInterfaces(c.api.stateAccessor, context.spaceInfos); err != nil {
@@ -435,16 +488,29 @@ func (c *Client) FullStatus(args params.StatusParams) (params.FullStatus, error)
 	if err != nil {
 		return noStatus, errors.Annotate(err, "cannot determine model status")
 	}
-	return params.FullStatus{
+	fullStatus := params.FullStatus{
 		Model:               modelStatus,
-		Machines:            context.processMachines(),
-		Applications:        context.processApplications(),
-		RemoteApplications:  context.processRemoteApplications(),
-		Offers:              context.processOffers(),
-		Relations:           context.processRelations(),
 		ControllerTimestamp: context.controllerTimestamp,
-		Branches:            context.processBranches(),
-	}, nil
+	}
+	if wanted(statusSectionMachines) {
+		fullStatus.Machines = context.processMachines()
+	}
+	if wanted(statusSectionApplications) {
+		fullStatus.Applications = context.processApplications()
+	}
+	if wanted(statusSectionRemoteApplications) {
+		fullStatus.RemoteApplications = context.processRemoteApplications()
+	}
+	if wanted(statusSectionOffers) {
+		fullStatus.Offers = context.processOffers()
+	}
+	if wanted(statusSectionRelations) {
+		fullStatus.Relations = context.processRelations()
+	}
+	if wanted(statusSectionBranches) {
+		fullStatus.Branches = context.processBranches()
+	}
+	return fullStatus, nil
 }
 
 func filterBranches(ctxBranches map[string]cache.Branch, matchedApps, matchedForBranches set.Strings) map[string]cache.Branch {
@@ -583,6 +649,12 @@ type statusContext struct {
 	// remote applications: application name -> application
 	consumerRemoteApplications map[string]*state.RemoteApplication
 
+	// maintenanceWindows: entity tag -> scheduled maintenance windows.
+	maintenanceWindows map[string][]state.MaintenanceWindow
+
+	// disabledWorkers: entity tag -> administratively disabled workers.
+	disabledWorkers map[string][]string
+
 	// opened ports by machine.
 	openPortRangesByMachine map[string]state.MachinePortRanges
 
@@ -619,6 +691,14 @@ func (context *statusContext) fetchMachines(st Backend) error {
 	if err != nil {
 		return err
 	}
+	context.maintenanceWindows, err = st.AllMaintenanceWindows()
+	if err != nil {
+		return err
+	}
+	context.disabledWorkers, err = st.AllDisabledWorkers()
+	if err != nil {
+		return err
+	}
 	// AllMachines gives us machines sorted by id.
 	for _, m := range machines {
 		context.allMachines[m.Id()] = m
@@ -994,6 +1074,38 @@ func (c *statusContext) processMachines() map[string]params.MachineStatus {
 	return machinesMap
 }
 
+// machineDisabledWorkers collates the agent workers administratively
+// disabled for the given machine: its own, merged with any disabled
+// model-wide.
+func (c *statusContext) machineDisabledWorkers(machineTag string) []string {
+	merged := set.NewStrings()
+	for _, tag := range []string{machineTag, c.model.ModelTag().String()} {
+		merged = merged.Union(set.NewStrings(c.disabledWorkers[tag]...))
+	}
+	if merged.IsEmpty() {
+		return nil
+	}
+	return merged.SortedValues()
+}
+
+// machineMaintenanceWindows collates the scheduled maintenance windows
+// that apply to the given machine: its own, followed by any defined
+// model-wide.
+func (c *statusContext) machineMaintenanceWindows(machineTag string) []params.MaintenanceWindow {
+	var result []params.MaintenanceWindow
+	for _, tag := range []string{machineTag, c.model.ModelTag().String()} {
+		for _, w := range c.maintenanceWindows[tag] {
+			result = append(result, params.MaintenanceWindow{
+				Start:    w.Start,
+				Duration: w.Duration,
+				Repeat:   w.Repeat,
+				Comment:  w.Comment,
+			})
+		}
+	}
+	return result
+}
+
 func (c *statusContext) makeMachineStatus(machine *state.Machine, appStatusInfo applicationStatusInfo) (status params.MachineStatus) {
 	machineID := machine.Id()
 	ipAddresses := c.ipAddresses[machineID]
@@ -1007,6 +1119,8 @@ func (c *statusContext) makeMachineStatus(machine *state.Machine, appStatusInfo
 
 	status.Series = machine.Series()
 	status.Jobs = paramsJobsFromJobs(machine.Jobs())
+	status.MaintenanceWindows = c.machineMaintenanceWindows(machine.Tag().String())
+	status.DisabledWorkers = c.machineDisabledWorkers(machine.Tag().String())
 	node, wantsVote := c.controllerNodes[machineID]
 	status.WantsVote = wantsVote
 	if wantsVote {
@@ -1107,6 +1221,14 @@ func (c *statusContext) makeMachineStatus(machine *state.Machine, appStatusInfo
 	if hc != nil {
 		status.Hardware = hc.String()
 	}
+
+	// OS information is only available once the machine agent has
+	// reported it, so ignore errors here.
+	if osInfo, err := machine.OSInfo(); err == nil {
+		status.KernelVersion = osInfo.KernelVersion
+		status.OSPatchLevel = osInfo.OSPatchLevel
+		status.RebootRequired = osInfo.RebootRequired
+	}
 	status.Containers = make(map[string]params.MachineStatus)
 
 	lxdProfiles := make(map[string]params.LXDProfile)
@@ -1330,7 +1452,11 @@ func (context *statusContext) processApplication(application *state.Application)
 	serviceInfo, err := application.ServiceInfo()
 	if err == nil {
 		processedStatus.ProviderId = serviceInfo.ProviderId()
-		if len(serviceInfo.Addresses()) > 0 {
+		// An external DNS name is the most reliably reachable
+		// endpoint, especially on dual-stack clusters.
+		if names := serviceInfo.ExternalNames(); len(names) > 0 {
+			processedStatus.PublicAddress = names[0]
+		} else if len(serviceInfo.Addresses()) > 0 {
 			processedStatus.PublicAddress = serviceInfo.Addresses()[0].Value
 		}
 	} else {
@@ -4,6 +4,7 @@
 package client_test
 
 import (
+	stdcontext "context"
 	"time"
 
 	"github.com/golang/mock/gomock"
@@ -1132,7 +1133,7 @@ func (s *filteringBranchesSuite) TestFullStatusBranchNoFilter(c *gc.C) {
 
 	client := s.clientForTest(c)
 
-	status, err := client.FullStatus(params.StatusParams{})
+	status, err := client.FullStatus(stdcontext.Background(), params.StatusParams{})
 	c.Assert(err, jc.ErrorIsNil)
 	c.Logf("%#v", status.Branches)
 	b, ok := status.Branches["apple"]
@@ -1148,7 +1149,7 @@ func (s *filteringBranchesSuite) TestFullStatusBranchFilterUnit(c *gc.C) {
 
 	client := s.clientForTest(c)
 
-	status, err := client.FullStatus(params.StatusParams{
+	status, err := client.FullStatus(stdcontext.Background(), params.StatusParams{
 		Patterns: []string{s.appA + "/0"},
 	})
 	c.Assert(err, jc.ErrorIsNil)
@@ -1166,7 +1167,7 @@ func (s *filteringBranchesSuite) TestFullStatusBranchFilterApplication(c *gc.C)
 
 	client := s.clientForTest(c)
 
-	status, err := client.FullStatus(params.StatusParams{
+	status, err := client.FullStatus(stdcontext.Background(), params.StatusParams{
 		Patterns: []string{s.appB},
 	})
 	c.Assert(err, jc.ErrorIsNil)
@@ -1185,7 +1186,7 @@ func (s *filteringBranchesSuite) TestFullStatusBranchFilterSubordinateUnit(c *gc
 
 	client := s.clientForTest(c)
 
-	status, err := client.FullStatus(params.StatusParams{
+	status, err := client.FullStatus(stdcontext.Background(), params.StatusParams{
 		Patterns: []string{s.subB + "/0"},
 	})
 	c.Assert(err, jc.ErrorIsNil)
@@ -1204,7 +1205,7 @@ func (s *filteringBranchesSuite) TestFullStatusBranchFilterTwoBranchesSubordinat
 
 	client := s.clientForTest(c)
 
-	status, err := client.FullStatus(params.StatusParams{
+	status, err := client.FullStatus(stdcontext.Background(), params.StatusParams{
 		Patterns: []string{s.appB + "/0"},
 	})
 	c.Assert(err, jc.ErrorIsNil)
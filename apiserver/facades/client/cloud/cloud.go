@@ -7,6 +7,7 @@ package cloud
 
 import (
 	"fmt"
+	"reflect"
 	"sort"
 	"strings"
 
@@ -44,6 +45,7 @@ type CloudV7 interface {
 	UpdateCredentialsCheckModels(args params.UpdateCredentialArgs) (params.UpdateCredentialResults, error)
 	UserCredentials(args params.UserClouds) (params.StringsResults, error)
 	UpdateCloud(cloudArgs params.UpdateCloudArgs) (params.ErrorResults, error)
+	PreviewUpdateCloud(cloudArgs params.UpdateCloudArgs) (params.CloudUpdatePreviewResults, error)
 }
 
 // CloudV6 defines the methods on the cloud API facade, version 6.
@@ -1102,6 +1104,136 @@ func (api *CloudAPI) UpdateCloud(cloudArgs params.UpdateCloudArgs) (params.Error
 // UpdateCloud did not exist before V4.
 func (*CloudAPIV3) UpdateCloud(_, _ struct{}) {}
 
+// PreviewUpdateCloud reports the changes that UpdateCloud would make to
+// existing clouds, and revalidates the caller's stored credentials for
+// each cloud against the models using them, without modifying anything.
+func (api *CloudAPI) PreviewUpdateCloud(cloudArgs params.UpdateCloudArgs) (params.CloudUpdatePreviewResults, error) {
+	results := params.CloudUpdatePreviewResults{
+		Results: make([]params.CloudUpdatePreviewResult, len(cloudArgs.Clouds)),
+	}
+	isAdmin, err := api.authorizer.HasPermission(permission.SuperuserAccess, api.ctlrBackend.ControllerTag())
+	if err != nil && !errors.IsNotFound(err) {
+		return results, errors.Trace(err)
+	} else if !isAdmin {
+		return results, apiservererrors.ServerError(apiservererrors.ErrPerm)
+	}
+	for i, aCloud := range cloudArgs.Clouds {
+		existing, err := api.backend.Cloud(aCloud.Name)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Changes = cloudChanges(existing, common.CloudFromParams(aCloud.Name, aCloud.Cloud))
+		models, err := api.cloudCredentialModels(aCloud.Name)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Models = models
+	}
+	return results, nil
+}
+
+// cloudCredentialModels revalidates the caller's stored credentials for
+// the named cloud against each of the models using them.
+func (api *CloudAPI) cloudCredentialModels(cloudName string) ([]params.UpdateCredentialModelResult, error) {
+	credentials, err := api.backend.CloudCredentials(api.apiUser, cloudName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var results []params.UpdateCredentialModelResult
+	for tagId, credential := range credentials {
+		if !names.IsValidCloudCredential(tagId) {
+			return nil, errors.NotValidf("cloud credential ID %q", tagId)
+		}
+		tag := names.NewCloudCredentialTag(tagId)
+		content := cloud.NewCredential(cloud.AuthType(credential.AuthType), credential.Attributes)
+		models, err := api.credentialModels(tag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		for uuid, name := range models {
+			model := params.UpdateCredentialModelResult{
+				ModelUUID: uuid,
+				ModelName: name,
+			}
+			model.Errors = api.validateCredentialForModel(uuid, tag, &content)
+			results = append(results, model)
+		}
+	}
+	// Since the models come from a map, for consistency ensure that
+	// they are returned sorted by model uuid.
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].ModelUUID < results[j].ModelUUID
+	})
+	return results, nil
+}
+
+// cloudChanges returns the attribute level differences between two
+// definitions of the same cloud.
+func cloudChanges(old, new cloud.Cloud) []params.CloudAttributeChange {
+	var changes []params.CloudAttributeChange
+	add := func(attribute, oldVal, newVal string) {
+		if oldVal == newVal {
+			return
+		}
+		changes = append(changes, params.CloudAttributeChange{
+			Attribute: attribute,
+			Old:       oldVal,
+			New:       newVal,
+		})
+	}
+	add("type", old.Type, new.Type)
+	add("endpoint", old.Endpoint, new.Endpoint)
+	add("identity-endpoint", old.IdentityEndpoint, new.IdentityEndpoint)
+	add("storage-endpoint", old.StorageEndpoint, new.StorageEndpoint)
+	add("auth-types", authTypeNames(old.AuthTypes), authTypeNames(new.AuthTypes))
+	if !reflect.DeepEqual(old.CACertificates, new.CACertificates) {
+		changes = append(changes, params.CloudAttributeChange{
+			Attribute: "ca-certificates",
+			Old:       fmt.Sprintf("%d certificate(s)", len(old.CACertificates)),
+			New:       fmt.Sprintf("%d certificate(s)", len(new.CACertificates)),
+		})
+	}
+	oldRegions := make(map[string]cloud.Region)
+	for _, region := range old.Regions {
+		oldRegions[region.Name] = region
+	}
+	add("regions", regionNames(old.Regions), regionNames(new.Regions))
+	for _, region := range new.Regions {
+		existing, ok := oldRegions[region.Name]
+		if !ok {
+			continue
+		}
+		prefix := "region " + region.Name + " "
+		add(prefix+"endpoint", existing.Endpoint, region.Endpoint)
+		add(prefix+"identity-endpoint", existing.IdentityEndpoint, region.IdentityEndpoint)
+		add(prefix+"storage-endpoint", existing.StorageEndpoint, region.StorageEndpoint)
+	}
+	return changes
+}
+
+func authTypeNames(authTypes cloud.AuthTypes) string {
+	names := make([]string, len(authTypes))
+	for i, authType := range authTypes {
+		names[i] = string(authType)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+func regionNames(regions []cloud.Region) string {
+	names := make([]string, len(regions))
+	for i, region := range regions {
+		names[i] = region.Name
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// PreviewUpdateCloud did not exist before V7.
+func (*CloudAPIV6) PreviewUpdateCloud(_, _ struct{}) {}
+
 // RemoveClouds removes the specified clouds from the controller.
 // If a cloud is in use (has models deployed to it), the removal will fail.
 func (api *CloudAPI) RemoveClouds(args params.Entities) (params.ErrorResults, error) {
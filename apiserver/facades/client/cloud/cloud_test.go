@@ -449,6 +449,52 @@ func (s *cloudSuite) TestUpdateNonExistentCloud(c *gc.C) {
 	c.Assert(results.Results[0].Error, gc.ErrorMatches, fmt.Sprintf("cloud %q not found", updatedCloud.Name))
 }
 
+func (s *cloudSuite) TestPreviewUpdateCloud(c *gc.C) {
+	updatedCloud := cloud.Cloud{
+		Name:      "dummy",
+		Type:      "dummy",
+		AuthTypes: []cloud.AuthType{cloud.EmptyAuthType, cloud.UserPassAuthType},
+		Endpoint:  "global-endpoint",
+		Regions:   []cloud.Region{{Name: "nether", Endpoint: "endpoint-updated"}},
+	}
+	results, err := s.api.PreviewUpdateCloud(params.UpdateCloudArgs{
+		[]params.AddCloudArgs{{
+			Name:  "dummy",
+			Cloud: common.CloudToParams(updatedCloud),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	s.backend.CheckCallNames(c, "Cloud", "CloudCredentials", "CredentialModels", "CredentialModels")
+
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	c.Assert(results.Results[0].Changes, jc.DeepEquals, []params.CloudAttributeChange{
+		{Attribute: "endpoint", Old: "", New: "global-endpoint"},
+		{Attribute: "region nether endpoint", Old: "endpoint", New: "endpoint-updated"},
+	})
+	c.Assert(results.Results[0].Models, gc.HasLen, 0)
+}
+
+func (s *cloudSuite) TestPreviewUpdateCloudNonAdminPerm(c *gc.C) {
+	s.setTestAPIForUser(c, names.NewUserTag("frank"))
+	updatedCloud := cloud.Cloud{
+		Name:      "dummy",
+		Type:      "dummy",
+		AuthTypes: []cloud.AuthType{cloud.EmptyAuthType, cloud.UserPassAuthType},
+	}
+	results, err := s.api.PreviewUpdateCloud(params.UpdateCloudArgs{
+		[]params.AddCloudArgs{{
+			Name:  "dummy",
+			Cloud: common.CloudToParams(updatedCloud),
+		}},
+	})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+	s.backend.CheckNoCalls(c)
+
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+}
+
 func (s *cloudSuite) TestListCloudInfo(c *gc.C) {
 	result, err := s.api.ListCloudInfo(params.ListCloudsRequest{
 		UserTag: "user-fred",
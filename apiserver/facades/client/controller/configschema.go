@@ -0,0 +1,93 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller
+
+import (
+	"sort"
+
+	"github.com/juju/errors"
+	"gopkg.in/juju/environschema.v1"
+
+	"github.com/juju/juju/apiserver/params"
+	jujucontroller "github.com/juju/juju/controller"
+	"github.com/juju/juju/environs/config"
+)
+
+// Schema namespaces reported by ConfigSchema.
+const (
+	controllerConfigSchemaName = "controller-config"
+	modelDefaultsSchemaName    = "model-defaults"
+)
+
+// ConfigSchema describes every controller config and model default
+// attribute known to the controller: its type, documentation, default
+// value and whether it may be updated after bootstrap. Clients can use
+// the result to validate and document configuration without hard
+// coding knowledge of the attributes.
+func (c *ControllerAPI) ConfigSchema() (params.ConfigSchemaResult, error) {
+	var result params.ConfigSchemaResult
+	if err := c.checkIsSuperUser(); err != nil {
+		return result, errors.Trace(err)
+	}
+	modelDefaults, err := modelDefaultsSchema()
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	result.Schemas = map[string][]params.ConfigSchemaAttr{
+		controllerConfigSchemaName: controllerConfigSchema(),
+		modelDefaultsSchemaName:    modelDefaults,
+	}
+	return result, nil
+}
+
+func controllerConfigSchema() []params.ConfigSchemaAttr {
+	fields := jujucontroller.ConfigSchema
+	defaults := jujucontroller.ConfigDefaults()
+	attrs := make([]params.ConfigSchemaAttr, 0, len(fields))
+	for _, name := range sortedFieldNames(fields) {
+		field := fields[name]
+		attrs = append(attrs, params.ConfigSchemaAttr{
+			Name:    name,
+			Type:    string(field.Type),
+			Doc:     field.Description,
+			Default: defaults[name],
+			Mutable: jujucontroller.AllowedUpdateConfigAttributes.Contains(name),
+			Secret:  field.Secret,
+		})
+	}
+	return attrs
+}
+
+func modelDefaultsSchema() ([]params.ConfigSchemaAttr, error) {
+	fields, err := config.Schema(nil)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defaults := config.ConfigDefaults()
+	attrs := make([]params.ConfigSchemaAttr, 0, len(fields))
+	for _, name := range sortedFieldNames(fields) {
+		field := fields[name]
+		attrs = append(attrs, params.ConfigSchemaAttr{
+			Name:    name,
+			Type:    string(field.Type),
+			Doc:     field.Description,
+			Default: defaults[name],
+			Mutable: !field.Immutable,
+			Secret:  field.Secret,
+		})
+	}
+	return attrs, nil
+}
+
+func sortedFieldNames(fields environschema.Fields) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ConfigSchema isn't on the v10 API.
+func (c *ControllerAPIv10) ConfigSchema(_, _ struct{}) {}
@@ -0,0 +1,60 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller
+
+import (
+	"sort"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/params"
+	jujucontroller "github.com/juju/juju/controller"
+)
+
+var _ = gc.Suite(&configSchemaSuite{})
+
+type configSchemaSuite struct{}
+
+func indexSchemaAttrs(attrs []params.ConfigSchemaAttr) map[string]params.ConfigSchemaAttr {
+	byName := make(map[string]params.ConfigSchemaAttr)
+	for _, attr := range attrs {
+		byName[attr.Name] = attr
+	}
+	return byName
+}
+
+func (s *configSchemaSuite) TestControllerConfigSchema(c *gc.C) {
+	attrs := controllerConfigSchema()
+	c.Assert(len(attrs), gc.Equals, len(jujucontroller.ConfigSchema))
+	names := make([]string, len(attrs))
+	for i, attr := range attrs {
+		names[i] = attr.Name
+	}
+	c.Assert(sort.StringsAreSorted(names), jc.IsTrue)
+
+	byName := indexSchemaAttrs(attrs)
+	apiPort := byName[jujucontroller.APIPort]
+	c.Assert(apiPort.Type, gc.Equals, "int")
+	c.Assert(apiPort.Default, gc.Equals, jujucontroller.DefaultAPIPort)
+	c.Assert(apiPort.Mutable, jc.IsFalse)
+
+	maxBackups := byName[jujucontroller.AuditLogMaxBackups]
+	c.Assert(maxBackups.Default, gc.Equals, jujucontroller.DefaultAuditLogMaxBackups)
+	c.Assert(maxBackups.Mutable, jc.IsTrue)
+}
+
+func (s *configSchemaSuite) TestModelDefaultsSchema(c *gc.C) {
+	attrs, err := modelDefaultsSchema()
+	c.Assert(err, jc.ErrorIsNil)
+
+	byName := indexSchemaAttrs(attrs)
+	uuid := byName["uuid"]
+	c.Assert(uuid.Type, gc.Equals, "string")
+	c.Assert(uuid.Mutable, jc.IsFalse)
+
+	logging := byName["logging-config"]
+	c.Assert(logging.Type, gc.Equals, "string")
+	c.Assert(logging.Mutable, jc.IsTrue)
+}
@@ -777,6 +777,69 @@ func (c *ControllerAPI) ConfigSet(args params.ControllerConfigSet) error {
 // ConfigSet isn't on the v4 API.
 func (c *ControllerAPIv4) ConfigSet(_, _ struct{}) {}
 
+// DrainControllerNode flags the given controller nodes as draining
+// ahead of maintenance. A draining node gives up its desire to vote so
+// that the peergrouper moves its replica set vote, and the singular
+// workers that follow it, to another controller. Draining the only
+// voting node is refused.
+func (c *ControllerAPI) DrainControllerNode(args params.Entities) (params.ErrorResults, error) {
+	if err := c.checkIsSuperUser(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Entities)),
+	}
+	for i, arg := range args.Entities {
+		tag, err := names.ParseMachineTag(arg.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		if err := c.state.DrainControllerNode(tag.Id()); err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return results, nil
+}
+
+// ControllerNodeDrainStatus reports drain progress for the given
+// controller nodes. A node is ready for maintenance once it is
+// draining and no longer holds a replica set vote.
+func (c *ControllerAPI) ControllerNodeDrainStatus(args params.Entities) (params.DrainStatusResults, error) {
+	if err := c.checkIsSuperUser(); err != nil {
+		return params.DrainStatusResults{}, errors.Trace(err)
+	}
+	results := params.DrainStatusResults{
+		Results: make([]params.DrainStatusResult, len(args.Entities)),
+	}
+	for i, arg := range args.Entities {
+		tag, err := names.ParseMachineTag(arg.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		node, err := c.state.ControllerNode(tag.Id())
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Draining = node.IsDraining()
+		results.Results[i].HasVote = node.HasVote()
+		results.Results[i].Ready = node.IsDraining() && !node.HasVote()
+	}
+	return results, nil
+}
+
+// Mask the drain methods from the v10 API. The API reflection code in
+// rpc/rpcreflect/type.go:newMethod skips 2-argument methods, so this
+// removes the methods as far as the RPC machinery is concerned.
+
+// DrainControllerNode isn't on the v10 API.
+func (c *ControllerAPIv10) DrainControllerNode(_, _ struct{}) {}
+
+// ControllerNodeDrainStatus isn't on the v10 API.
+func (c *ControllerAPIv10) ControllerNodeDrainStatus(_, _ struct{}) {}
+
 // runMigrationPrechecks runs prechecks on the migration and updates
 // information in targetInfo as needed based on information
 // retrieved from the target controller.
@@ -0,0 +1,77 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller
+
+import (
+	"github.com/juju/errors"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/state"
+)
+
+// defaultCleanupBatchSize bounds the number of orphaned relation
+// documents removed in a single transaction when the client does not
+// specify a batch size.
+const defaultCleanupBatchSize = 100
+
+// CleanupOrphanedRelations checks every model on the controller for
+// relation scope and settings documents whose relation no longer
+// exists, and removes them unless a dry run was requested. Such
+// documents can be left behind by interrupted force-removals and
+// accumulate on long-lived controllers. The per-model results report
+// the documents found (or removed) so a dry run can be reviewed before
+// cleaning for real.
+func (c *ControllerAPI) CleanupOrphanedRelations(args params.CleanupOrphanedRelationsArgs) (params.CleanupOrphanedRelationsResults, error) {
+	var results params.CleanupOrphanedRelationsResults
+	if err := c.checkIsSuperUser(); err != nil {
+		return results, errors.Trace(err)
+	}
+	batchSize := args.BatchSize
+	if batchSize == 0 {
+		batchSize = defaultCleanupBatchSize
+	}
+	if batchSize < 0 {
+		return results, errors.NotValidf("batch size %d", batchSize)
+	}
+
+	modelUUIDs, err := c.state.AllModelUUIDs()
+	if err != nil {
+		return results, errors.Trace(err)
+	}
+	for _, modelUUID := range modelUUIDs {
+		st, err := c.statePool.Get(modelUUID)
+		if err != nil {
+			// This model could have been removed.
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return results, errors.Trace(err)
+		}
+		defer st.Release()
+
+		result := params.OrphanedRelationDocsResult{ModelUUID: modelUUID}
+		var report state.OrphanedRelationDocs
+		if args.DryRun {
+			report, err = st.OrphanedRelationDocs()
+		} else {
+			report, err = st.RemoveOrphanedRelationDocs(batchSize)
+		}
+		if err != nil {
+			result.Error = apiservererrors.ServerError(err)
+		} else {
+			result.ScopeDocs = report.ScopeDocs
+			result.SettingsDocs = report.SettingsDocs
+		}
+		results.Results = append(results.Results, result)
+	}
+	return results, nil
+}
+
+// Mask the new method from the V10 API. The API reflection code in
+// rpc/rpcreflect/type.go:newMethod skips 2-argument methods, so this
+// removes the method as far as the RPC machinery is concerned.
+
+// CleanupOrphanedRelations isn't on the v10 API.
+func (c *ControllerAPIv10) CleanupOrphanedRelations(_, _ struct{}) {}
@@ -0,0 +1,185 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/apiserver/common"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/params"
+	jujucontroller "github.com/juju/juju/controller"
+)
+
+// settings namespaces carried in a portable controller settings document.
+const (
+	controllerConfigNamespace = "controller-config"
+	cloudsNamespace           = "clouds"
+	credentialsNamespace      = "credentials"
+)
+
+// ExportControllerSettings returns a portable document describing the
+// controller-scoped settings namespaces: controller config, cloud
+// definitions and cloud credential metadata. Credential secret
+// attributes are never included, so the document can be kept with
+// ordinary configuration management artefacts and used to rebuild a
+// controller's configuration separately from full backups.
+func (c *ControllerAPI) ExportControllerSettings() (params.ControllerSettingsExportResult, error) {
+	var result params.ControllerSettingsExportResult
+	if err := c.checkIsSuperUser(); err != nil {
+		return result, errors.Trace(err)
+	}
+
+	cfg, err := c.state.ControllerConfig()
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	doc := params.ControllerSettingsDocument{
+		Version:          params.ControllerSettingsDocumentVersion,
+		ControllerConfig: cfg,
+	}
+
+	clouds, err := c.state.Clouds()
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	doc.Clouds = make(map[string]params.Cloud)
+	for tag, cld := range clouds {
+		doc.Clouds[tag.Id()] = common.CloudToParams(cld)
+	}
+
+	credentials, err := c.state.AllControllerCloudCredentials()
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	for _, credential := range credentials {
+		doc.Credentials = append(doc.Credentials, params.CredentialMetadata{
+			Cloud:    credential.Cloud,
+			Owner:    credential.Owner,
+			Name:     credential.Name,
+			AuthType: credential.AuthType,
+		})
+	}
+
+	result.Document = doc
+	return result, nil
+}
+
+// ImportControllerSettings verifies a previously exported controller
+// settings document and applies its namespaces to this controller.
+// Only controller config attributes that may be changed after bootstrap
+// are applied; cloud definitions are added or updated as required.
+// Credential metadata is verified against the stored credentials but
+// never applied, since the document carries no secret material.
+func (c *ControllerAPI) ImportControllerSettings(args params.ControllerSettingsImportArgs) (params.ControllerSettingsImportResults, error) {
+	var results params.ControllerSettingsImportResults
+	if err := c.checkIsSuperUser(); err != nil {
+		return results, errors.Trace(err)
+	}
+	doc := args.Document
+	if doc.Version != params.ControllerSettingsDocumentVersion {
+		return results, errors.NotValidf("controller settings document version %d", doc.Version)
+	}
+
+	addResult := func(namespace string, applied bool, err error) {
+		results.Results = append(results.Results, params.ControllerSettingsNamespaceResult{
+			Namespace: namespace,
+			Applied:   applied,
+			Error:     apiservererrors.ServerError(err),
+		})
+	}
+
+	if len(doc.ControllerConfig) > 0 {
+		applied, err := c.importControllerConfig(doc.ControllerConfig, args.DryRun)
+		addResult(controllerConfigNamespace, applied, err)
+	}
+	if len(doc.Clouds) > 0 {
+		applied, err := c.importClouds(doc.Clouds, args.DryRun)
+		addResult(cloudsNamespace, applied, err)
+	}
+	if len(doc.Credentials) > 0 {
+		addResult(credentialsNamespace, false, c.verifyCredentialMetadata(doc.Credentials))
+	}
+	return results, nil
+}
+
+// importControllerConfig applies the attributes from the document that
+// are allowed to change after bootstrap, ignoring immutable ones such
+// as the CA cert which necessarily differ between controllers.
+func (c *ControllerAPI) importControllerConfig(cfg map[string]interface{}, dryRun bool) (bool, error) {
+	updateAttrs := make(map[string]interface{})
+	for name, value := range cfg {
+		if jujucontroller.AllowedUpdateConfigAttributes.Contains(name) {
+			updateAttrs[name] = value
+		}
+	}
+	if len(updateAttrs) == 0 || dryRun {
+		return false, nil
+	}
+	if err := c.state.UpdateControllerConfig(updateAttrs, nil); err != nil {
+		return false, errors.Trace(err)
+	}
+	return true, nil
+}
+
+// importClouds adds or updates the cloud definitions from the document.
+func (c *ControllerAPI) importClouds(clouds map[string]params.Cloud, dryRun bool) (bool, error) {
+	if dryRun {
+		return false, nil
+	}
+	existing, err := c.state.Clouds()
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	existingNames := make(map[string]bool)
+	for tag := range existing {
+		existingNames[tag.Id()] = true
+	}
+	var applied bool
+	for name, pCloud := range clouds {
+		cld := common.CloudFromParams(name, pCloud)
+		if existingNames[name] {
+			err = c.state.UpdateCloud(cld)
+		} else {
+			err = c.state.AddCloud(cld, c.apiUser.Id())
+		}
+		if err != nil {
+			return applied, errors.Annotatef(err, "importing cloud %q", name)
+		}
+		applied = true
+	}
+	return applied, nil
+}
+
+// verifyCredentialMetadata checks that each credential named in the
+// document exists on this controller, reporting the first that does
+// not. The metadata is never applied as the secret attributes are not
+// part of the document.
+func (c *ControllerAPI) verifyCredentialMetadata(metadata []params.CredentialMetadata) error {
+	credentials, err := c.state.AllControllerCloudCredentials()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	stored := make(map[params.CredentialMetadata]bool)
+	for _, credential := range credentials {
+		stored[params.CredentialMetadata{
+			Cloud:    credential.Cloud,
+			Owner:    credential.Owner,
+			Name:     credential.Name,
+			AuthType: credential.AuthType,
+		}] = true
+	}
+	for _, m := range metadata {
+		if !stored[m] {
+			return errors.NotFoundf("credential %q for cloud %q owned by %q", m.Name, m.Cloud, m.Owner)
+		}
+	}
+	return nil
+}
+
+// ExportControllerSettings isn't on the v10 API.
+func (c *ControllerAPIv10) ExportControllerSettings(_, _ struct{}) {}
+
+// ImportControllerSettings isn't on the v10 API.
+func (c *ControllerAPIv10) ImportControllerSettings(_, _ struct{}) {}
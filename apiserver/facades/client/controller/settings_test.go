@@ -0,0 +1,104 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller_test
+
+import (
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/params"
+	corecontroller "github.com/juju/juju/controller"
+)
+
+func (s *controllerSuite) TestExportControllerSettings(c *gc.C) {
+	result, err := s.controller.ExportControllerSettings()
+	c.Assert(err, jc.ErrorIsNil)
+
+	doc := result.Document
+	c.Assert(doc.Version, gc.Equals, params.ControllerSettingsDocumentVersion)
+
+	cfg, err := s.State.ControllerConfig()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(doc.ControllerConfig[corecontroller.ControllerUUIDKey], gc.Equals, cfg.ControllerUUID())
+
+	cld, ok := doc.Clouds["dummy"]
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(cld.Type, gc.Equals, "dummy")
+}
+
+func (s *controllerSuite) TestImportControllerSettingsVersionMismatch(c *gc.C) {
+	_, err := s.controller.ImportControllerSettings(params.ControllerSettingsImportArgs{
+		Document: params.ControllerSettingsDocument{Version: 99},
+	})
+	c.Assert(err, gc.ErrorMatches, "controller settings document version 99 not valid")
+}
+
+func (s *controllerSuite) TestImportControllerSettingsDryRun(c *gc.C) {
+	exported, err := s.controller.ExportControllerSettings()
+	c.Assert(err, jc.ErrorIsNil)
+	original, err := s.State.ControllerConfig()
+	c.Assert(err, jc.ErrorIsNil)
+
+	doc := exported.Document
+	doc.ControllerConfig[corecontroller.MaxDebugLogDuration] = "2h"
+
+	results, err := s.controller.ImportControllerSettings(params.ControllerSettingsImportArgs{
+		Document: doc,
+		DryRun:   true,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 2)
+	for _, result := range results.Results {
+		c.Assert(result.Error, gc.IsNil)
+		c.Assert(result.Applied, jc.IsFalse)
+	}
+
+	// Nothing was changed.
+	cfg, err := s.State.ControllerConfig()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.MaxDebugLogDuration(), gc.Equals, original.MaxDebugLogDuration())
+}
+
+func (s *controllerSuite) TestImportControllerSettingsAppliesConfig(c *gc.C) {
+	results, err := s.controller.ImportControllerSettings(params.ControllerSettingsImportArgs{
+		Document: params.ControllerSettingsDocument{
+			Version: params.ControllerSettingsDocumentVersion,
+			ControllerConfig: map[string]interface{}{
+				corecontroller.MaxDebugLogDuration: "2h",
+				// Immutable attributes are silently skipped.
+				corecontroller.CACertKey: "not-a-cert",
+			},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	c.Assert(results.Results[0].Applied, jc.IsTrue)
+
+	cfg, err := s.State.ControllerConfig()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.MaxDebugLogDuration(), gc.Equals, 2*time.Hour)
+}
+
+func (s *controllerSuite) TestImportControllerSettingsUnknownCredential(c *gc.C) {
+	results, err := s.controller.ImportControllerSettings(params.ControllerSettingsImportArgs{
+		Document: params.ControllerSettingsDocument{
+			Version: params.ControllerSettingsDocumentVersion,
+			Credentials: []params.CredentialMetadata{{
+				Cloud:    "dummy",
+				Owner:    "admin",
+				Name:     "missing",
+				AuthType: "empty",
+			}},
+		},
+		DryRun: true,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Namespace, gc.Equals, "credentials")
+	c.Assert(results.Results[0].Applied, jc.IsFalse)
+	c.Assert(results.Results[0].Error, gc.ErrorMatches, `credential "missing" for cloud "dummy" owned by "admin" not found`)
+}
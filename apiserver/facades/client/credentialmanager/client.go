@@ -4,37 +4,227 @@
 package credentialmanager
 
 import (
+	"sort"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
 	"github.com/juju/juju/apiserver/common/credentialcommon"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cloud"
+	"github.com/juju/juju/core/permission"
 )
 
 // CredentialManager defines the methods on credentialmanager API endpoint.
 type CredentialManager interface {
 	InvalidateModelCredential(params.InvalidateCredentialArg) (params.ErrorResult, error)
+	StageCredential(params.TaggedCredential) (params.ErrorResult, error)
+	CommitStagedCredential(params.CommitStagedCredentialArg) (params.UpdateCredentialResult, error)
+	RollbackCredential(params.Entity) (params.ErrorResult, error)
+	DiscardStagedCredential(params.Entity) (params.ErrorResult, error)
 }
 
 type CredentialManagerAPI struct {
 	*credentialcommon.CredentialManagerAPI
 
-	resources facade.Resources
+	backend    Backend
+	pool       ModelPoolBackend
+	resources  facade.Resources
+	authorizer facade.Authorizer
+	apiUser    names.UserTag
+}
+
+// CredentialManagerAPIv1 implements the v1 API, which does not have
+// the staged credential rotation methods.
+type CredentialManagerAPIv1 struct {
+	*CredentialManagerAPI
 }
 
 var _ CredentialManager = (*CredentialManagerAPI)(nil)
 
 // NewCredentialManagerAPI creates a new CredentialManager API endpoint on server-side.
 func NewCredentialManagerAPI(ctx facade.Context) (*CredentialManagerAPI, error) {
-	return internalNewCredentialManagerAPI(newStateShim(ctx.State()), ctx.Resources(), ctx.Auth())
+	return internalNewCredentialManagerAPI(newStateShim(ctx.State()), newStatePoolShim(ctx.StatePool()), ctx.Resources(), ctx.Auth())
 }
 
-func internalNewCredentialManagerAPI(backend credentialcommon.StateBackend, resources facade.Resources, authorizer facade.Authorizer) (*CredentialManagerAPI, error) {
+// NewCredentialManagerAPIv1 creates a new v1 CredentialManager API endpoint on server-side.
+func NewCredentialManagerAPIv1(ctx facade.Context) (*CredentialManagerAPIv1, error) {
+	api, err := NewCredentialManagerAPI(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &CredentialManagerAPIv1{api}, nil
+}
+
+func internalNewCredentialManagerAPI(backend Backend, pool ModelPoolBackend, resources facade.Resources, authorizer facade.Authorizer) (*CredentialManagerAPI, error) {
 	if authorizer.GetAuthTag() == nil || !authorizer.AuthClient() {
 		return nil, apiservererrors.ErrPerm
 	}
+	apiUser, _ := authorizer.GetAuthTag().(names.UserTag)
 
 	return &CredentialManagerAPI{
 		resources:            resources,
 		CredentialManagerAPI: credentialcommon.NewCredentialManagerAPI(backend),
+		backend:              backend,
+		pool:                 pool,
+		authorizer:           authorizer,
+		apiUser:              apiUser,
 	}, nil
 }
+
+// checkCredentialAccess ensures that the api user is the credential owner
+// or a controller admin.
+func (api *CredentialManagerAPI) checkCredentialAccess(tag names.CloudCredentialTag) error {
+	if tag.Owner() == api.apiUser {
+		return nil
+	}
+	isAdmin, err := api.authorizer.HasPermission(permission.SuperuserAccess, api.backend.ControllerTag())
+	if err != nil && !errors.IsNotFound(err) {
+		return errors.Trace(err)
+	}
+	if !isAdmin {
+		return apiservererrors.ErrPerm
+	}
+	return nil
+}
+
+// StageCredential uploads candidate content for an existing credential
+// without changing the content that the models using it see. The candidate
+// is switched in with CommitStagedCredential or dropped with
+// DiscardStagedCredential.
+func (api *CredentialManagerAPI) StageCredential(arg params.TaggedCredential) (params.ErrorResult, error) {
+	tag, err := names.ParseCloudCredentialTag(arg.Tag)
+	if err != nil {
+		return params.ErrorResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	if err := api.checkCredentialAccess(tag); err != nil {
+		return params.ErrorResult{}, errors.Trace(err)
+	}
+	in := cloud.NewCredential(cloud.AuthType(arg.Credential.AuthType), arg.Credential.Attributes)
+	return params.ErrorResult{Error: apiservererrors.ServerError(api.backend.StageCloudCredential(tag, in))}, nil
+}
+
+// CommitStagedCredential validates the content staged for a credential
+// against every model using the credential and, if the content is valid
+// everywhere or the commit is forced, atomically switches the active
+// content to it. The previous content is retained so the switch can be
+// undone with RollbackCredential. Model validation errors are returned
+// separately and do not contribute to the overall method error status.
+func (api *CredentialManagerAPI) CommitStagedCredential(arg params.CommitStagedCredentialArg) (params.UpdateCredentialResult, error) {
+	result := params.UpdateCredentialResult{CredentialTag: arg.CredentialTag}
+	tag, err := names.ParseCloudCredentialTag(arg.CredentialTag)
+	if err != nil {
+		result.Error = apiservererrors.ServerError(err)
+		return result, nil
+	}
+	if err := api.checkCredentialAccess(tag); err != nil {
+		return result, errors.Trace(err)
+	}
+	stored, err := api.backend.CloudCredential(tag)
+	if err != nil {
+		result.Error = apiservererrors.ServerError(err)
+		return result, nil
+	}
+	staged, ok := stored.StagedContent()
+	if !ok {
+		result.Error = apiservererrors.ServerError(errors.NotFoundf("staged content for cloud credential %q", tag.Id()))
+		return result, nil
+	}
+
+	models, err := api.backend.CredentialModels(tag)
+	if err != nil && !errors.IsNotFound(err) {
+		result.Error = apiservererrors.ServerError(err)
+		return result, nil
+	}
+	var modelsErred bool
+	if len(models) > 0 {
+		var modelsResult []params.UpdateCredentialModelResult
+		for uuid, name := range models {
+			model := params.UpdateCredentialModelResult{
+				ModelUUID: uuid,
+				ModelName: name,
+			}
+			model.Errors = api.validateCredentialForModel(uuid, tag, &staged)
+			modelsResult = append(modelsResult, model)
+			if len(model.Errors) > 0 {
+				modelsErred = true
+			}
+		}
+		// Since we get a map above, for consistency ensure that models
+		// are added sorted by model uuid.
+		sort.Slice(modelsResult, func(i, j int) bool {
+			return modelsResult[i].ModelUUID < modelsResult[j].ModelUUID
+		})
+		result.Models = modelsResult
+	}
+	if modelsErred && !arg.Force {
+		result.Error = apiservererrors.ServerError(errors.New("some models are not going to be visible with staged credential content"))
+		return result, nil
+	}
+	result.Error = apiservererrors.ServerError(api.backend.CommitStagedCloudCredential(tag))
+	return result, nil
+}
+
+// RollbackCredential restores a credential's active content to what it
+// was before the last staged rotation was committed.
+func (api *CredentialManagerAPI) RollbackCredential(arg params.Entity) (params.ErrorResult, error) {
+	tag, err := names.ParseCloudCredentialTag(arg.Tag)
+	if err != nil {
+		return params.ErrorResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	if err := api.checkCredentialAccess(tag); err != nil {
+		return params.ErrorResult{}, errors.Trace(err)
+	}
+	return params.ErrorResult{Error: apiservererrors.ServerError(api.backend.RollbackCloudCredential(tag))}, nil
+}
+
+// DiscardStagedCredential drops any candidate content staged for a
+// credential without touching the active content.
+func (api *CredentialManagerAPI) DiscardStagedCredential(arg params.Entity) (params.ErrorResult, error) {
+	tag, err := names.ParseCloudCredentialTag(arg.Tag)
+	if err != nil {
+		return params.ErrorResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	if err := api.checkCredentialAccess(tag); err != nil {
+		return params.ErrorResult{}, errors.Trace(err)
+	}
+	return params.ErrorResult{Error: apiservererrors.ServerError(api.backend.DiscardStagedCloudCredential(tag))}, nil
+}
+
+func (api *CredentialManagerAPI) validateCredentialForModel(modelUUID string, tag names.CloudCredentialTag, credential *cloud.Credential) []params.ErrorResult {
+	var result []params.ErrorResult
+
+	m, callContext, err := api.pool.GetModelCallContext(modelUUID)
+	if err != nil {
+		return append(result, params.ErrorResult{apiservererrors.ServerError(err)})
+	}
+
+	modelErrors, err := validateNewCredentialForModelFunc(
+		m,
+		callContext,
+		tag,
+		credential,
+		false,
+	)
+	if err != nil {
+		return append(result, params.ErrorResult{apiservererrors.ServerError(err)})
+	}
+	if len(modelErrors.Results) > 0 {
+		return append(result, modelErrors.Results...)
+	}
+	return result
+}
+
+var validateNewCredentialForModelFunc = credentialcommon.ValidateNewModelCredential
+
+// Mask out new methods from the older API versions. The API reflection
+// code in rpc/rpcreflect/type.go:newMethod skips 2-argument methods,
+// so this removes the method as far as the RPC machinery is concerned.
+//
+// Staged credential rotation did not exist before V2.
+func (*CredentialManagerAPIv1) StageCredential(_, _ struct{})         {}
+func (*CredentialManagerAPIv1) CommitStagedCredential(_, _ struct{})  {}
+func (*CredentialManagerAPIv1) RollbackCredential(_, _ struct{})      {}
+func (*CredentialManagerAPIv1) DiscardStagedCredential(_, _ struct{}) {}
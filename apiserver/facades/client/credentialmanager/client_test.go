@@ -11,10 +11,15 @@ import (
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/common/credentialcommon"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facades/client/credentialmanager"
 	"github.com/juju/juju/apiserver/params"
 	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/cloud"
+	"github.com/juju/juju/environs/context"
+	"github.com/juju/juju/state"
+	statetesting "github.com/juju/juju/state/testing"
 	coretesting "github.com/juju/juju/testing"
 )
 
@@ -24,15 +29,20 @@ type CredentialManagerSuite struct {
 	resources  *common.Resources
 	authorizer apiservertesting.FakeAuthorizer
 	backend    *testBackend
+	pool       *testPool
 
 	api *credentialmanager.CredentialManagerAPI
 }
 
 var _ = gc.Suite(&CredentialManagerSuite{})
 
+// credentialTag is owned by the api user set up in SetUpTest.
+var credentialTag = names.NewCloudCredentialTag("meep/read/one")
+
 func (s *CredentialManagerSuite) SetUpTest(c *gc.C) {
 	s.BaseSuite.SetUpTest(c)
 	s.backend = newMockBackend()
+	s.pool = &testPool{}
 
 	s.resources = common.NewResources()
 	s.authorizer = apiservertesting.FakeAuthorizer{
@@ -41,7 +51,7 @@ func (s *CredentialManagerSuite) SetUpTest(c *gc.C) {
 	}
 	s.AddCleanup(func(_ *gc.C) { s.resources.StopAll() })
 
-	api, err := credentialmanager.NewCredentialManagerAPIForTest(s.backend, s.resources, s.authorizer)
+	api, err := credentialmanager.NewCredentialManagerAPIForTest(s.backend, s.pool, s.resources, s.authorizer)
 	c.Assert(err, jc.ErrorIsNil)
 	s.api = api
 }
@@ -50,7 +60,7 @@ func (s *CredentialManagerSuite) TestInvalidateModelCredentialUnauthorized(c *gc
 	s.authorizer = apiservertesting.FakeAuthorizer{
 		Tag: names.NewMachineTag("0"),
 	}
-	_, err := credentialmanager.NewCredentialManagerAPIForTest(s.backend, s.resources, s.authorizer)
+	_, err := credentialmanager.NewCredentialManagerAPIForTest(s.backend, s.pool, s.resources, s.authorizer)
 	c.Assert(err, gc.ErrorMatches, "permission denied")
 }
 
@@ -74,6 +84,103 @@ func (s *CredentialManagerSuite) TestInvalidateModelCredentialError(c *gc.C) {
 	})
 }
 
+func (s *CredentialManagerSuite) TestStageCredential(c *gc.C) {
+	result, err := s.api.StageCredential(params.TaggedCredential{
+		Tag: credentialTag.String(),
+		Credential: params.CloudCredential{
+			AuthType:   "userpass",
+			Attributes: map[string]string{"username": "admin", "password": "s3cret"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, gc.DeepEquals, params.ErrorResult{})
+	s.backend.CheckCalls(c, []testing.StubCall{
+		{"StageCloudCredential", []interface{}{credentialTag, cloud.NewCredential(
+			cloud.UserPassAuthType,
+			map[string]string{"username": "admin", "password": "s3cret"},
+		)}},
+	})
+}
+
+func (s *CredentialManagerSuite) TestStageCredentialPermissionDenied(c *gc.C) {
+	_, err := s.api.StageCredential(params.TaggedCredential{
+		Tag: names.NewCloudCredentialTag("meep/bruce/two").String(),
+	})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+	s.backend.CheckNoCalls(c)
+}
+
+func (s *CredentialManagerSuite) TestCommitStagedCredential(c *gc.C) {
+	staged := cloud.NewCredential(cloud.UserPassAuthType, map[string]string{"username": "admin"})
+	stored := statetesting.NewEmptyCredential()
+	stored.SetStagedContent(staged)
+	s.backend.credential = stored
+	s.backend.models = map[string]string{coretesting.ModelTag.Id(): "mymodel"}
+	s.PatchValue(credentialmanager.ValidateNewCredentialForModelFunc,
+		func(_ credentialcommon.PersistentBackend, _ context.ProviderCallContext, tag names.CloudCredentialTag, credential *cloud.Credential, _ bool) (params.ErrorResults, error) {
+			c.Check(tag, gc.Equals, credentialTag)
+			c.Check(*credential, jc.DeepEquals, staged)
+			return params.ErrorResults{}, nil
+		},
+	)
+
+	result, err := s.api.CommitStagedCredential(params.CommitStagedCredentialArg{CredentialTag: credentialTag.String()})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Error, gc.IsNil)
+	c.Assert(result.Models, jc.DeepEquals, []params.UpdateCredentialModelResult{
+		{ModelUUID: coretesting.ModelTag.Id(), ModelName: "mymodel"},
+	})
+	s.backend.CheckCallNames(c, "CloudCredential", "CredentialModels", "CommitStagedCloudCredential")
+}
+
+func (s *CredentialManagerSuite) TestCommitStagedCredentialModelsInvalid(c *gc.C) {
+	stored := statetesting.NewEmptyCredential()
+	stored.SetStagedContent(cloud.NewCredential(cloud.UserPassAuthType, nil))
+	s.backend.credential = stored
+	s.backend.models = map[string]string{coretesting.ModelTag.Id(): "mymodel"}
+	s.PatchValue(credentialmanager.ValidateNewCredentialForModelFunc,
+		func(_ credentialcommon.PersistentBackend, _ context.ProviderCallContext, _ names.CloudCredentialTag, _ *cloud.Credential, _ bool) (params.ErrorResults, error) {
+			return params.ErrorResults{Results: []params.ErrorResult{
+				{Error: apiservererrors.ServerError(errors.New("cannot list instances"))},
+			}}, nil
+		},
+	)
+
+	result, err := s.api.CommitStagedCredential(params.CommitStagedCredentialArg{CredentialTag: credentialTag.String()})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Error, gc.ErrorMatches, "some models are not going to be visible with staged credential content")
+	c.Assert(result.Models, gc.HasLen, 1)
+	c.Assert(result.Models[0].Errors, gc.HasLen, 1)
+	s.backend.CheckCallNames(c, "CloudCredential", "CredentialModels")
+}
+
+func (s *CredentialManagerSuite) TestCommitStagedCredentialNothingStaged(c *gc.C) {
+	s.backend.credential = statetesting.NewEmptyCredential()
+
+	result, err := s.api.CommitStagedCredential(params.CommitStagedCredentialArg{CredentialTag: credentialTag.String()})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Error, gc.ErrorMatches, `staged content for cloud credential "meep/read/one" not found`)
+	s.backend.CheckCallNames(c, "CloudCredential")
+}
+
+func (s *CredentialManagerSuite) TestRollbackCredential(c *gc.C) {
+	result, err := s.api.RollbackCredential(params.Entity{Tag: credentialTag.String()})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, gc.DeepEquals, params.ErrorResult{})
+	s.backend.CheckCalls(c, []testing.StubCall{
+		{"RollbackCloudCredential", []interface{}{credentialTag}},
+	})
+}
+
+func (s *CredentialManagerSuite) TestDiscardStagedCredential(c *gc.C) {
+	result, err := s.api.DiscardStagedCredential(params.Entity{Tag: credentialTag.String()})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, gc.DeepEquals, params.ErrorResult{})
+	s.backend.CheckCalls(c, []testing.StubCall{
+		{"DiscardStagedCloudCredential", []interface{}{credentialTag}},
+	})
+}
+
 func newMockBackend() *testBackend {
 	return &testBackend{
 		Stub: &testing.Stub{},
@@ -82,9 +189,52 @@ func newMockBackend() *testBackend {
 
 type testBackend struct {
 	*testing.Stub
+
+	credential state.Credential
+	models     map[string]string
 }
 
 func (b *testBackend) InvalidateModelCredential(reason string) error {
 	b.AddCall("InvalidateModelCredential", reason)
 	return b.NextErr()
 }
+
+func (b *testBackend) ControllerTag() names.ControllerTag {
+	return coretesting.ControllerTag
+}
+
+func (b *testBackend) CloudCredential(tag names.CloudCredentialTag) (state.Credential, error) {
+	b.AddCall("CloudCredential", tag)
+	return b.credential, b.NextErr()
+}
+
+func (b *testBackend) CredentialModels(tag names.CloudCredentialTag) (map[string]string, error) {
+	b.AddCall("CredentialModels", tag)
+	return b.models, b.NextErr()
+}
+
+func (b *testBackend) StageCloudCredential(tag names.CloudCredentialTag, credential cloud.Credential) error {
+	b.AddCall("StageCloudCredential", tag, credential)
+	return b.NextErr()
+}
+
+func (b *testBackend) CommitStagedCloudCredential(tag names.CloudCredentialTag) error {
+	b.AddCall("CommitStagedCloudCredential", tag)
+	return b.NextErr()
+}
+
+func (b *testBackend) RollbackCloudCredential(tag names.CloudCredentialTag) error {
+	b.AddCall("RollbackCloudCredential", tag)
+	return b.NextErr()
+}
+
+func (b *testBackend) DiscardStagedCloudCredential(tag names.CloudCredentialTag) error {
+	b.AddCall("DiscardStagedCloudCredential", tag)
+	return b.NextErr()
+}
+
+type testPool struct{}
+
+func (*testPool) GetModelCallContext(modelUUID string) (credentialcommon.PersistentBackend, context.ProviderCallContext, error) {
+	return nil, nil, nil
+}
@@ -4,10 +4,11 @@
 package credentialmanager
 
 import (
-	"github.com/juju/juju/apiserver/common/credentialcommon"
 	"github.com/juju/juju/apiserver/facade"
 )
 
-func NewCredentialManagerAPIForTest(b credentialcommon.StateBackend, resources facade.Resources, authorizer facade.Authorizer) (*CredentialManagerAPI, error) {
-	return internalNewCredentialManagerAPI(b, resources, authorizer)
+var ValidateNewCredentialForModelFunc = &validateNewCredentialForModelFunc
+
+func NewCredentialManagerAPIForTest(b Backend, pool ModelPoolBackend, resources facade.Resources, authorizer facade.Authorizer) (*CredentialManagerAPI, error) {
+	return internalNewCredentialManagerAPI(b, pool, resources, authorizer)
 }
@@ -4,14 +4,58 @@
 package credentialmanager
 
 import (
+	"github.com/juju/names/v4"
+
 	"github.com/juju/juju/apiserver/common/credentialcommon"
+	"github.com/juju/juju/cloud"
+	"github.com/juju/juju/environs/context"
 	"github.com/juju/juju/state"
 )
 
+// Backend exposes the state functionality needed by the credential
+// manager facade.
+type Backend interface {
+	credentialcommon.StateBackend
+
+	ControllerTag() names.ControllerTag
+	CloudCredential(tag names.CloudCredentialTag) (state.Credential, error)
+	CredentialModels(tag names.CloudCredentialTag) (map[string]string, error)
+	StageCloudCredential(tag names.CloudCredentialTag, credential cloud.Credential) error
+	CommitStagedCloudCredential(tag names.CloudCredentialTag) error
+	RollbackCloudCredential(tag names.CloudCredentialTag) error
+	DiscardStagedCloudCredential(tag names.CloudCredentialTag) error
+}
+
 type stateShim struct {
 	*state.State
 }
 
-func newStateShim(st *state.State) credentialcommon.StateBackend {
+func newStateShim(st *state.State) Backend {
 	return &stateShim{st}
 }
+
+// ModelPoolBackend defines the pool of models used to validate staged
+// credential content.
+type ModelPoolBackend interface {
+	// GetModelCallContext gets everything that is needed to make cloud
+	// calls on behalf of the given model.
+	GetModelCallContext(modelUUID string) (credentialcommon.PersistentBackend, context.ProviderCallContext, error)
+}
+
+type statePoolShim struct {
+	*state.StatePool
+}
+
+func newStatePoolShim(pool *state.StatePool) ModelPoolBackend {
+	return statePoolShim{pool}
+}
+
+// GetModelCallContext implements ModelPoolBackend.GetModelCallContext.
+func (s statePoolShim) GetModelCallContext(modelUUID string) (credentialcommon.PersistentBackend, context.ProviderCallContext, error) {
+	modelState, err := s.StatePool.Get(modelUUID)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer modelState.Release()
+	return credentialcommon.NewPersistentBackend(modelState.State), context.CallContext(modelState.State), err
+}
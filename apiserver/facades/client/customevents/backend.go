@@ -0,0 +1,53 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package customevents
+
+import (
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/state"
+)
+
+// Backend defines the state functionality required by the customevents
+// facade.
+type Backend interface {
+	ModelTag() names.ModelTag
+
+	// EnqueueCustomEvent queues a custom event for the unit or
+	// application identified by the given tag and returns the id of
+	// the queued event.
+	EnqueueCustomEvent(receiver names.Tag, name string, params map[string]interface{}) (string, error)
+}
+
+// BlockChecker defines the block-checking functionality required by
+// the customevents facade. This is implemented by
+// apiserver/common.BlockChecker.
+type BlockChecker interface {
+	ChangeAllowed() error
+}
+
+type stateShim struct {
+	*state.State
+	*state.Model
+}
+
+// NewStateBackend converts a state.State into a Backend.
+func NewStateBackend(st *state.State) (Backend, error) {
+	m, err := st.Model()
+	if err != nil {
+		return nil, err
+	}
+	return &stateShim{
+		State: st,
+		Model: m,
+	}, nil
+}
+
+func (s *stateShim) EnqueueCustomEvent(receiver names.Tag, name string, params map[string]interface{}) (string, error) {
+	event, err := s.State.EnqueueCustomEvent(receiver, name, params)
+	if err != nil {
+		return "", err
+	}
+	return event.Id(), nil
+}
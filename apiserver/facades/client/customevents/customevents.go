@@ -0,0 +1,96 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package customevents
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/apiserver/common"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/core/permission"
+)
+
+var logger = loggo.GetLogger("juju.apiserver.customevents")
+
+// API provides the customevents facade APIs for v1.
+type API struct {
+	backend    Backend
+	authorizer facade.Authorizer
+	check      BlockChecker
+}
+
+// NewFacade provides the signature required for facade registration.
+func NewFacade(ctx facade.Context) (*API, error) {
+	backend, err := NewStateBackend(ctx.State())
+	if err != nil {
+		return nil, errors.Annotate(err, "getting state")
+	}
+	blockChecker := common.NewBlockChecker(ctx.State())
+	return NewAPI(
+		backend,
+		ctx.Auth(),
+		blockChecker,
+	)
+}
+
+// NewAPI returns a new customevents API facade.
+func NewAPI(
+	backend Backend,
+	authorizer facade.Authorizer,
+	blockChecker BlockChecker,
+) (*API, error) {
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+	return &API{
+		backend:    backend,
+		authorizer: authorizer,
+		check:      blockChecker,
+	}, nil
+}
+
+func (api *API) checkCanWrite() error {
+	allowed, err := api.authorizer.HasPermission(permission.WriteAccess, api.backend.ModelTag())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !allowed {
+		return apiservererrors.ErrPerm
+	}
+	return nil
+}
+
+// TriggerCustomEvents queues the given custom events for their unit or
+// application receivers. The returned results hold the ids of the
+// queued events.
+func (api *API) TriggerCustomEvents(args params.TriggerCustomEventArgs) (params.StringResults, error) {
+	var results params.StringResults
+	if err := api.checkCanWrite(); err != nil {
+		return results, errors.Trace(err)
+	}
+	if err := api.check.ChangeAllowed(); err != nil {
+		return results, errors.Trace(err)
+	}
+
+	results.Results = make([]params.StringResult, len(args.Events))
+	for i, arg := range args.Events {
+		receiver, err := names.ParseTag(arg.Receiver)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		logger.Debugf("triggering custom event %q for %q", arg.Name, receiver.Id())
+		id, err := api.backend.EnqueueCustomEvent(receiver, arg.Name, arg.Params)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Result = id
+	}
+	return results, nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package customevents_test
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/facades/client/customevents"
+	"github.com/juju/juju/apiserver/params"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type CustomEventsSuite struct {
+	testing.IsolationSuite
+	backend mockBackend
+
+	blockChecker mockBlockChecker
+	authorizer   apiservertesting.FakeAuthorizer
+	api          *customevents.API
+}
+
+var _ = gc.Suite(&CustomEventsSuite{})
+
+func (s *CustomEventsSuite) setAPIUser(c *gc.C, user names.UserTag) {
+	s.authorizer.Tag = user
+	api, err := customevents.NewAPI(
+		&s.backend,
+		s.authorizer,
+		&s.blockChecker,
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	s.api = api
+}
+
+func (s *CustomEventsSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag: names.NewUserTag("admin"),
+	}
+	s.backend = mockBackend{
+		modelUUID: coretesting.ModelTag.Id(),
+	}
+	s.blockChecker = mockBlockChecker{}
+	api, err := customevents.NewAPI(
+		&s.backend,
+		s.authorizer,
+		&s.blockChecker,
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	s.api = api
+}
+
+func (s *CustomEventsSuite) TestTriggerCustomEvents(c *gc.C) {
+	result, err := s.api.TriggerCustomEvents(params.TriggerCustomEventArgs{
+		Events: []params.TriggerCustomEventArg{{
+			Receiver: "unit-mysql-0",
+			Name:     "rotate-certs",
+			Params:   map[string]interface{}{"expiry": "30d"},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.StringResults{
+		Results: []params.StringResult{{Result: "1"}},
+	})
+	c.Assert(s.backend.enqueued, jc.DeepEquals, []enqueuedEvent{{
+		receiver: names.NewUnitTag("mysql/0"),
+		name:     "rotate-certs",
+		params:   map[string]interface{}{"expiry": "30d"},
+	}})
+}
+
+func (s *CustomEventsSuite) TestTriggerCustomEventsBadTag(c *gc.C) {
+	result, err := s.api.TriggerCustomEvents(params.TriggerCustomEventArgs{
+		Events: []params.TriggerCustomEventArg{{
+			Receiver: "not a tag",
+			Name:     "rotate-certs",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 1)
+	c.Assert(result.Results[0].Error, gc.ErrorMatches, `"not a tag" is not a valid tag`)
+	c.Assert(s.backend.enqueued, gc.HasLen, 0)
+}
+
+func (s *CustomEventsSuite) TestTriggerCustomEventsPermission(c *gc.C) {
+	s.setAPIUser(c, names.NewUserTag("mary"))
+	_, err := s.api.TriggerCustomEvents(params.TriggerCustomEventArgs{
+		Events: []params.TriggerCustomEventArg{{
+			Receiver: "unit-mysql-0",
+			Name:     "rotate-certs",
+		}},
+	})
+	c.Assert(err, gc.ErrorMatches, ".*permission denied.*")
+	c.Assert(s.backend.enqueued, gc.HasLen, 0)
+}
+
+func (s *CustomEventsSuite) TestTriggerCustomEventsBlocked(c *gc.C) {
+	s.blockChecker.SetErrors(errors.New("blocked"))
+	_, err := s.api.TriggerCustomEvents(params.TriggerCustomEventArgs{
+		Events: []params.TriggerCustomEventArg{{
+			Receiver: "unit-mysql-0",
+			Name:     "rotate-certs",
+		}},
+	})
+	c.Assert(err, gc.ErrorMatches, "blocked")
+	s.blockChecker.CheckCallNames(c, "ChangeAllowed")
+	c.Assert(s.backend.enqueued, gc.HasLen, 0)
+}
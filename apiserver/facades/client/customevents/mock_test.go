@@ -0,0 +1,55 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package customevents_test
+
+import (
+	"strconv"
+
+	"github.com/juju/names/v4"
+	jtesting "github.com/juju/testing"
+
+	"github.com/juju/juju/apiserver/facades/client/customevents"
+)
+
+type enqueuedEvent struct {
+	receiver names.Tag
+	name     string
+	params   map[string]interface{}
+}
+
+type mockBackend struct {
+	jtesting.Stub
+	customevents.Backend
+
+	modelUUID string
+	enqueued  []enqueuedEvent
+}
+
+func (m *mockBackend) ModelTag() names.ModelTag {
+	m.MethodCall(m, "ModelTag")
+	m.PopNoErr()
+	return names.NewModelTag(m.modelUUID)
+}
+
+func (m *mockBackend) EnqueueCustomEvent(receiver names.Tag, name string, params map[string]interface{}) (string, error) {
+	m.MethodCall(m, "EnqueueCustomEvent", receiver, name, params)
+	if err := m.NextErr(); err != nil {
+		return "", err
+	}
+	m.enqueued = append(m.enqueued, enqueuedEvent{
+		receiver: receiver,
+		name:     name,
+		params:   params,
+	})
+	return strconv.Itoa(len(m.enqueued)), nil
+}
+
+type mockBlockChecker struct {
+	jtesting.Stub
+}
+
+func (c *mockBlockChecker) ChangeAllowed() error {
+	c.MethodCall(c, "ChangeAllowed")
+	return c.NextErr()
+}
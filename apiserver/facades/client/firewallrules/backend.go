@@ -7,6 +7,7 @@ import (
 	"github.com/juju/errors"
 	"github.com/juju/names/v4"
 
+	"github.com/juju/juju/core/network/firewall"
 	"github.com/juju/juju/state"
 )
 
@@ -15,8 +16,10 @@ import (
 // with the same names.
 type Backend interface {
 	ModelTag() names.ModelTag
-	SaveFirewallRule(state.FirewallRule) error
+	SaveFirewallRule(rule state.FirewallRule, updatedBy string) error
+	SetFirewallRuleEnabled(service firewall.WellKnownServiceType, enabled bool, updatedBy string) error
 	ListFirewallRules() ([]*state.FirewallRule, error)
+	FirewallRuleHistory(service firewall.WellKnownServiceType) ([]state.FirewallRuleChange, error)
 }
 
 // BlockChecker defines the block-checking functionality required by
@@ -48,12 +51,22 @@ func NewStateBackend(st *state.State) (Backend, error) {
 	}, nil
 }
 
-func (s stateShim) SaveFirewallRule(rule state.FirewallRule) error {
+func (s stateShim) SaveFirewallRule(rule state.FirewallRule, updatedBy string) error {
 	api := state.NewFirewallRules(s.State)
-	return api.Save(rule)
+	return api.Save(rule, updatedBy)
+}
+
+func (s stateShim) SetFirewallRuleEnabled(service firewall.WellKnownServiceType, enabled bool, updatedBy string) error {
+	api := state.NewFirewallRules(s.State)
+	return api.SetEnabled(service, enabled, updatedBy)
 }
 
 func (s stateShim) ListFirewallRules() ([]*state.FirewallRule, error) {
 	api := state.NewFirewallRules(s.State)
 	return api.AllRules()
 }
+
+func (s stateShim) FirewallRuleHistory(service firewall.WellKnownServiceType) ([]state.FirewallRuleChange, error) {
+	api := state.NewFirewallRules(s.State)
+	return api.RuleHistory(service)
+}
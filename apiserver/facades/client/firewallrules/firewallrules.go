@@ -19,13 +19,29 @@ import (
 
 var logger = loggo.GetLogger("juju.apiserver.firewallrules")
 
-// API provides the firewallrules facade APIs for v1.
+// APIv1 provides the firewallrules facade APIs for v1.
+type APIv1 struct {
+	*API
+}
+
+// API provides the firewallrules facade APIs for v2. It adds
+// enabling/disabling of rules and access to their change history.
 type API struct {
 	backend    Backend
 	authorizer facade.Authorizer
 	check      BlockChecker
 }
 
+// NewFacadeV1 provides the signature required for facade registration
+// for version 1.
+func NewFacadeV1(ctx facade.Context) (*APIv1, error) {
+	api, err := NewFacade(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIv1{api}, nil
+}
+
 // NewFacade provides the signature required for facade registration.
 func NewFacade(ctx facade.Context) (*API, error) {
 	backend, err := NewStateBackend(ctx.State())
@@ -89,13 +105,76 @@ func (api *API) SetFirewallRules(args params.FirewallRuleArgs) (params.ErrorResu
 	for i, arg := range args.Args {
 		logger.Debugf("saving firewall rule %+v", arg)
 		err := api.backend.SaveFirewallRule(state.NewFirewallRule(
-			firewall.WellKnownServiceType(arg.KnownService), arg.WhitelistCIDRS))
+			firewall.WellKnownServiceType(arg.KnownService), arg.WhitelistCIDRS), api.apiUser())
 		results[i].Error = apiservererrors.ServerError(err)
 	}
 	errResults.Results = results
 	return errResults, nil
 }
 
+// apiUser identifies the authenticated user for audit purposes.
+func (api *API) apiUser() string {
+	return api.authorizer.GetAuthTag().Id()
+}
+
+// SetFirewallRulesEnabled isn't on the v1 API.
+func (api *APIv1) SetFirewallRulesEnabled(_, _ struct{}) {}
+
+// SetFirewallRulesEnabled enables or disables the specified firewall
+// rules. Disabled rules are retained, but not applied, until they are
+// enabled again.
+func (api *API) SetFirewallRulesEnabled(args params.FirewallRulesEnabledArgs) (params.ErrorResults, error) {
+	var errResults params.ErrorResults
+	if err := api.checkAdmin(); err != nil {
+		return errResults, errors.Trace(err)
+	}
+	if err := api.check.ChangeAllowed(); err != nil {
+		return errResults, errors.Trace(err)
+	}
+
+	results := make([]params.ErrorResult, len(args.Args))
+	for i, arg := range args.Args {
+		logger.Debugf("setting firewall rule %v enabled to %v", arg.KnownService, arg.Enabled)
+		err := api.backend.SetFirewallRuleEnabled(
+			firewall.WellKnownServiceType(arg.KnownService), arg.Enabled, api.apiUser())
+		results[i].Error = apiservererrors.ServerError(err)
+	}
+	errResults.Results = results
+	return errResults, nil
+}
+
+// FirewallRulesHistory isn't on the v1 API.
+func (api *APIv1) FirewallRulesHistory(_, _ struct{}) {}
+
+// FirewallRulesHistory returns the recorded changes for the specified
+// firewall rule, oldest first.
+func (api *API) FirewallRulesHistory(args params.KnownServiceArgs) (params.ListFirewallRulesHistoryResults, error) {
+	var result params.ListFirewallRulesHistoryResults
+	if err := api.checkCanRead(); err != nil {
+		return result, errors.Trace(err)
+	}
+	for _, knownService := range args.KnownServices {
+		changes, err := api.backend.FirewallRuleHistory(firewall.WellKnownServiceType(knownService))
+		if err != nil && !errors.IsNotFound(err) {
+			return result, errors.Trace(err)
+		}
+		if err != nil {
+			continue
+		}
+		history := params.FirewallRuleHistory{KnownService: knownService}
+		for _, change := range changes {
+			history.Changes = append(history.Changes, params.FirewallRuleChange{
+				WhitelistCIDRS: change.WhitelistCIDRs,
+				Disabled:       change.Disabled,
+				UpdatedBy:      change.UpdatedBy,
+				Updated:        change.Updated,
+			})
+		}
+		result.Rules = append(result.Rules, history)
+	}
+	return result, nil
+}
+
 // ListFirewallRules returns all the firewall rules.
 func (api *API) ListFirewallRules() (params.ListFirewallRulesResults, error) {
 	var listResults params.ListFirewallRulesResults
@@ -111,6 +190,12 @@ func (api *API) ListFirewallRules() (params.ListFirewallRulesResults, error) {
 		listResults.Rules[i] = params.FirewallRule{
 			KnownService:   params.KnownServiceValue(r.WellKnownService()),
 			WhitelistCIDRS: r.WhitelistCIDRs(),
+			Disabled:       r.Disabled(),
+			UpdatedBy:      r.UpdatedBy(),
+		}
+		if updated := r.UpdatedAt(); !updated.IsZero() {
+			updated := updated
+			listResults.Rules[i].Updated = &updated
 		}
 	}
 	return listResults, nil
@@ -4,6 +4,8 @@
 package firewallrules_test
 
 import (
+	"time"
+
 	"github.com/juju/errors"
 	"github.com/juju/names/v4"
 	"github.com/juju/testing"
@@ -50,6 +52,8 @@ func (s *FirewallRulesSuite) SetUpTest(c *gc.C) {
 	s.backend = mockBackend{
 		modelUUID: coretesting.ModelTag.Id(),
 		rules:     make(map[string]state.FirewallRule),
+		enabled:   make(map[string]bool),
+		history:   make(map[string][]state.FirewallRuleChange),
 	}
 	s.blockChecker = mockBlockChecker{}
 	api, err := firewallrules.NewAPI(
@@ -103,6 +107,81 @@ func (s *FirewallRulesSuite) TestSetFirewallRulesBlocked(c *gc.C) {
 	c.Assert(s.backend.rules, gc.HasLen, 0)
 }
 
+func (s *FirewallRulesSuite) TestSetFirewallRulesEnabled(c *gc.C) {
+	result, err := s.api.SetFirewallRulesEnabled(params.FirewallRulesEnabledArgs{
+		Args: []params.FirewallRuleEnabled{{
+			KnownService: "ssh",
+			Enabled:      false,
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.ErrorResults{[]params.ErrorResult{{Error: nil}}})
+	s.backend.CheckCall(c, 1, "SetFirewallRuleEnabled", firewall.SSHRule, false, "admin")
+}
+
+func (s *FirewallRulesSuite) TestSetFirewallRulesEnabledPermission(c *gc.C) {
+	s.setAPIUser(c, names.NewUserTag("mary"))
+	_, err := s.api.SetFirewallRulesEnabled(params.FirewallRulesEnabledArgs{
+		Args: []params.FirewallRuleEnabled{{
+			KnownService: "ssh",
+			Enabled:      false,
+		}},
+	})
+	c.Assert(err, gc.ErrorMatches, ".*permission denied.*")
+	c.Assert(s.backend.enabled, gc.HasLen, 0)
+}
+
+func (s *FirewallRulesSuite) TestSetFirewallRulesEnabledBlocked(c *gc.C) {
+	s.blockChecker.SetErrors(errors.New("blocked"))
+	_, err := s.api.SetFirewallRulesEnabled(params.FirewallRulesEnabledArgs{
+		Args: []params.FirewallRuleEnabled{{
+			KnownService: "ssh",
+			Enabled:      false,
+		}},
+	})
+	c.Assert(err, gc.ErrorMatches, "blocked")
+	s.blockChecker.CheckCallNames(c, "ChangeAllowed")
+	c.Assert(s.backend.enabled, gc.HasLen, 0)
+}
+
+func (s *FirewallRulesSuite) TestFirewallRulesHistory(c *gc.C) {
+	updated := time.Date(2026, 8, 1, 10, 0, 0, 0, time.UTC)
+	s.backend.history["ssh"] = []state.FirewallRuleChange{{
+		WhitelistCIDRs: []string{"1.2.3.4/8"},
+		UpdatedBy:      "fred",
+		Updated:        updated,
+	}, {
+		Disabled:  true,
+		UpdatedBy: "mary",
+		Updated:   updated.Add(time.Hour),
+	}}
+	result, err := s.api.FirewallRulesHistory(params.KnownServiceArgs{
+		KnownServices: []params.KnownServiceValue{params.SSHRule, params.JujuControllerRule},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.ListFirewallRulesHistoryResults{
+		Rules: []params.FirewallRuleHistory{{
+			KnownService: params.SSHRule,
+			Changes: []params.FirewallRuleChange{{
+				WhitelistCIDRS: []string{"1.2.3.4/8"},
+				UpdatedBy:      "fred",
+				Updated:        updated,
+			}, {
+				Disabled:  true,
+				UpdatedBy: "mary",
+				Updated:   updated.Add(time.Hour),
+			}},
+		}}})
+}
+
+func (s *FirewallRulesSuite) TestFirewallRulesHistoryPermission(c *gc.C) {
+	s.setAPIUser(c, names.NewUserTag("mary"))
+	_, err := s.api.FirewallRulesHistory(params.KnownServiceArgs{
+		KnownServices: []params.KnownServiceValue{params.SSHRule},
+	})
+	c.Assert(err, gc.ErrorMatches, ".*permission denied.*")
+}
+
 func (s *FirewallRulesSuite) TestListFirewallRules(c *gc.C) {
 	result, err := s.api.ListFirewallRules()
 	c.Assert(err, jc.ErrorIsNil)
@@ -4,6 +4,7 @@
 package firewallrules_test
 
 import (
+	"github.com/juju/errors"
 	"github.com/juju/names/v4"
 	jtesting "github.com/juju/testing"
 
@@ -18,6 +19,8 @@ type mockBackend struct {
 
 	modelUUID string
 	rules     map[string]state.FirewallRule
+	enabled   map[string]bool
+	history   map[string][]state.FirewallRuleChange
 }
 
 func (m *mockBackend) GetBlockForType(t state.BlockType) (state.Block, bool, error) {
@@ -30,13 +33,30 @@ func (m *mockBackend) ModelTag() names.ModelTag {
 	return names.NewModelTag(m.modelUUID)
 }
 
-func (m *mockBackend) SaveFirewallRule(rule state.FirewallRule) error {
-	m.MethodCall(m, "SaveFirewallRule")
+func (m *mockBackend) SaveFirewallRule(rule state.FirewallRule, updatedBy string) error {
+	m.MethodCall(m, "SaveFirewallRule", updatedBy)
 	m.PopNoErr()
 	m.rules[string(rule.WellKnownService())] = rule
 	return nil
 }
 
+func (m *mockBackend) SetFirewallRuleEnabled(service firewall.WellKnownServiceType, enabled bool, updatedBy string) error {
+	m.MethodCall(m, "SetFirewallRuleEnabled", service, enabled, updatedBy)
+	m.PopNoErr()
+	m.enabled[string(service)] = enabled
+	return nil
+}
+
+func (m *mockBackend) FirewallRuleHistory(service firewall.WellKnownServiceType) ([]state.FirewallRuleChange, error) {
+	m.MethodCall(m, "FirewallRuleHistory", service)
+	m.PopNoErr()
+	changes, ok := m.history[string(service)]
+	if !ok {
+		return nil, errors.NotFoundf("firewall rules for service %v", service)
+	}
+	return changes, nil
+}
+
 func (m *mockBackend) ListFirewallRules() ([]*state.FirewallRule, error) {
 	m.MethodCall(m, "ListFirewallRules")
 	m.PopNoErr()
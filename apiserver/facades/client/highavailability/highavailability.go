@@ -42,6 +42,21 @@ type HighAvailabilityAPI struct {
 
 var _ HighAvailability = (*HighAvailabilityAPI)(nil)
 
+// HighAvailabilityAPIV2 implements v2 of the high availability facade,
+// which predates support for replacing a dead controller machine.
+type HighAvailabilityAPIV2 struct {
+	HighAvailabilityAPI
+}
+
+// NewHighAvailabilityAPIV2 creates a new server-side highavailability v2 API end point.
+func NewHighAvailabilityAPIV2(st *state.State, resources facade.Resources, authorizer facade.Authorizer) (*HighAvailabilityAPIV2, error) {
+	api, err := NewHighAvailabilityAPI(st, resources, authorizer)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &HighAvailabilityAPIV2{*api}, nil
+}
+
 // NewHighAvailabilityAPI creates a new server-side highavailability API end point.
 func NewHighAvailabilityAPI(st *state.State, resources facade.Resources, authorizer facade.Authorizer) (*HighAvailabilityAPI, error) {
 	// Only clients can access the high availability facade.
@@ -91,6 +106,18 @@ func (api *HighAvailabilityAPI) EnableHA(args params.ControllersSpecs) (params.C
 	return results, nil
 }
 
+// EnableHA adds controller machines as necessary to ensure the
+// controller has the number of machines specified.
+// V2 does not support replacing a controller machine.
+func (api *HighAvailabilityAPIV2) EnableHA(args params.ControllersSpecs) (params.ControllersChangeResults, error) {
+	for _, spec := range args.Specs {
+		if spec.Replace != "" {
+			return params.ControllersChangeResults{}, errors.New("replacing a controller machine is not supported by this API version")
+		}
+	}
+	return api.HighAvailabilityAPI.EnableHA(args)
+}
+
 func (api *HighAvailabilityAPI) enableHASingle(st *state.State, spec params.ControllersSpec) (
 	params.ControllersChanges, error,
 ) {
@@ -103,6 +130,10 @@ func (api *HighAvailabilityAPI) enableHASingle(st *state.State, spec params.Cont
 		return params.ControllersChanges{}, errors.Trace(err)
 	}
 
+	if spec.Replace != "" && !names.IsValidMachine(spec.Replace) {
+		return params.ControllersChanges{}, errors.Errorf("invalid machine id %q", spec.Replace)
+	}
+
 	controllerIds, err := st.ControllerIds()
 	if err != nil {
 		return params.ControllersChanges{}, err
@@ -143,7 +174,12 @@ func (api *HighAvailabilityAPI) enableHASingle(st *state.State, spec params.Cont
 	}
 
 	// Might be nicer to pass the spec itself to this method.
-	changes, err := st.EnableHA(spec.NumControllers, spec.Constraints, spec.Series, spec.Placement)
+	var changes state.ControllersChanges
+	if spec.Replace != "" {
+		changes, err = st.EnableHAReplacing(spec.NumControllers, spec.Constraints, spec.Series, spec.Placement, spec.Replace)
+	} else {
+		changes, err = st.EnableHA(spec.NumControllers, spec.Constraints, spec.Series, spec.Placement)
+	}
 	if err != nil {
 		return params.ControllersChanges{}, err
 	}
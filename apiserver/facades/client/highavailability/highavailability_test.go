@@ -570,6 +570,50 @@ func (s *clientSuite) TestEnableHAMultipleSpecs(c *gc.C) {
 	c.Check(results.Results, gc.HasLen, 0)
 }
 
+func (s *clientSuite) TestEnableHAReplace(c *gc.C) {
+	enableHAResult, err := s.enableHA(c, 3, emptyCons, defaultSeries, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(enableHAResult.Added, gc.DeepEquals, []string{"machine-1", "machine-2"})
+
+	arg := params.ControllersSpecs{
+		Specs: []params.ControllersSpec{{Replace: "1"}},
+	}
+	results, err := s.haServer.EnableHA(arg)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	result := results.Results[0]
+	c.Assert(result.Error, gc.IsNil)
+	c.Assert(result.Result.Removed, gc.DeepEquals, []string{"machine-1"})
+	c.Assert(result.Result.Added, gc.DeepEquals, []string{"machine-3"})
+
+	m, err := s.State.Machine("1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(m.Life(), gc.Equals, state.Dying)
+	node, err := s.State.ControllerNode("1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(node.WantsVote(), jc.IsFalse)
+}
+
+func (s *clientSuite) TestEnableHAReplaceInvalidMachine(c *gc.C) {
+	arg := params.ControllersSpecs{
+		Specs: []params.ControllersSpec{{Replace: "not-a-machine"}},
+	}
+	results, err := s.haServer.EnableHA(arg)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.ErrorMatches, `invalid machine id "not-a-machine"`)
+}
+
+func (s *clientSuite) TestEnableHAReplaceNotSupportedV2(c *gc.C) {
+	haServerV2, err := highavailability.NewHighAvailabilityAPIV2(s.State, s.resources, s.authoriser)
+	c.Assert(err, jc.ErrorIsNil)
+	arg := params.ControllersSpecs{
+		Specs: []params.ControllersSpec{{Replace: "1"}},
+	}
+	_, err = haServerV2.EnableHA(arg)
+	c.Assert(err, gc.ErrorMatches, "replacing a controller machine is not supported by this API version")
+}
+
 func (s *clientSuite) TestEnableHANoSpecs(c *gc.C) {
 	arg := params.ControllersSpecs{
 		Specs: []params.ControllersSpec{},
@@ -0,0 +1,74 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package lognoise
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/core/permission"
+)
+
+// API reports on the agents that have written the most log messages
+// through this API server recently.
+type API struct {
+	authorizer facade.Authorizer
+	modelTag   names.ModelTag
+	logNoise   facade.LogNoise
+}
+
+// NewFacade creates a new LogNoise facade.
+func NewFacade(ctx facade.Context) (*API, error) {
+	m, err := ctx.State().Model()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return NewAPI(ctx.Auth(), m.ModelTag(), ctx.LogNoise())
+}
+
+// NewAPI returns a new LogNoise API facade.
+func NewAPI(authorizer facade.Authorizer, modelTag names.ModelTag, logNoise facade.LogNoise) (*API, error) {
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+	return &API{
+		authorizer: authorizer,
+		modelTag:   modelTag,
+		logNoise:   logNoise,
+	}, nil
+}
+
+func (api *API) checkCanRead() error {
+	canRead, err := api.authorizer.HasPermission(permission.ReadAccess, api.modelTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !canRead {
+		return apiservererrors.ErrPerm
+	}
+	return nil
+}
+
+// Report returns the agents in the model that have written the most
+// log messages over the requested window, noisiest first. Only the
+// writes seen by this API server are counted.
+func (api *API) Report(args params.LogNoiseRequest) (params.LogNoiseResult, error) {
+	if err := api.checkCanRead(); err != nil {
+		return params.LogNoiseResult{}, errors.Trace(err)
+	}
+	entries := api.logNoise.NoisiestAgents(api.modelTag.Id(), args.Window, args.Limit)
+	result := params.LogNoiseResult{
+		Agents: make([]params.NoisyAgent, len(entries)),
+	}
+	for i, entry := range entries {
+		result.Agents[i] = params.NoisyAgent{
+			AgentTag: entry.Entity,
+			Count:    entry.Count,
+		}
+	}
+	return result, nil
+}
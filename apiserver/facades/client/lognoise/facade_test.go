@@ -0,0 +1,83 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package lognoise_test
+
+import (
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/names/v4"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/facades/client/lognoise"
+	"github.com/juju/juju/apiserver/params"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	corelognoise "github.com/juju/juju/core/lognoise"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type facadeSuite struct {
+	testing.IsolationSuite
+	recorder *corelognoise.Recorder
+}
+
+var _ = gc.Suite(&facadeSuite{})
+
+func (s *facadeSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.recorder = corelognoise.New(testclock.NewClock(time.Time{}))
+
+	modelUUID := coretesting.ModelTag.Id()
+	for i := 0; i < 3; i++ {
+		s.recorder.Record(modelUUID, "unit-mysql-0")
+	}
+	s.recorder.Record(modelUUID, "machine-0")
+	// Writes in other models are not reported.
+	s.recorder.Record("deadbeef-1bad-500d-9000-4b1d0d06f00d", "machine-5")
+}
+
+func (s *facadeSuite) newAPI(c *gc.C, user names.UserTag) *lognoise.API {
+	authorizer := apiservertesting.FakeAuthorizer{Tag: user}
+	api, err := lognoise.NewAPI(authorizer, coretesting.ModelTag, s.recorder)
+	c.Assert(err, jc.ErrorIsNil)
+	return api
+}
+
+func (s *facadeSuite) TestAuthRejectsNonClient(c *gc.C) {
+	authorizer := apiservertesting.FakeAuthorizer{Tag: names.NewMachineTag("0")}
+	api, err := lognoise.NewAPI(authorizer, coretesting.ModelTag, s.recorder)
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+	c.Assert(api, gc.IsNil)
+}
+
+func (s *facadeSuite) TestReportPermissionDenied(c *gc.C) {
+	api := s.newAPI(c, names.NewUserTag("bob"))
+	_, err := api.Report(params.LogNoiseRequest{})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
+
+func (s *facadeSuite) TestReport(c *gc.C) {
+	api := s.newAPI(c, names.NewUserTag("read"))
+	result, err := api.Report(params.LogNoiseRequest{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Agents, jc.DeepEquals, []params.NoisyAgent{{
+		AgentTag: "unit-mysql-0",
+		Count:    3,
+	}, {
+		AgentTag: "machine-0",
+		Count:    1,
+	}})
+}
+
+func (s *facadeSuite) TestReportLimit(c *gc.C) {
+	api := s.newAPI(c, names.NewUserTag("read"))
+	result, err := api.Report(params.LogNoiseRequest{Limit: 1})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Agents, jc.DeepEquals, []params.NoisyAgent{{
+		AgentTag: "unit-mysql-0",
+		Count:    3,
+	}})
+}
@@ -0,0 +1,79 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package machinemanager
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/apiserver/common"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/environs"
+	environscloudspec "github.com/juju/juju/environs/cloudspec"
+	"github.com/juju/juju/state/stateenvirons"
+)
+
+// ConsoleOutput returns the provider console output for the supplied
+// machines, so boot failures can be debugged from the client without
+// locating the instances in the cloud's own console.
+func (mm *MachineManagerAPI) ConsoleOutput(args params.Entities) (params.StringResults, error) {
+	return consoleOutput(mm, environs.GetEnviron, args)
+}
+
+func consoleOutput(mm *MachineManagerAPI, getEnviron environGetFunc, args params.Entities) (params.StringResults, error) {
+	results := params.StringResults{
+		Results: make([]params.StringResult, len(args.Entities)),
+	}
+	if err := mm.authorizer.CanRead(); err != nil {
+		return results, err
+	}
+	model, err := mm.st.Model()
+	if err != nil {
+		return results, errors.Trace(err)
+	}
+	cloudSpec := func() (environscloudspec.CloudSpec, error) {
+		return stateenvirons.CloudSpecForModel(model)
+	}
+	env, err := getEnviron(common.EnvironConfigGetterFuncs{
+		CloudSpecFunc:   cloudSpec,
+		ModelConfigFunc: model.Config,
+	}, environs.New)
+	if err != nil {
+		return results, errors.Trace(err)
+	}
+	console, ok := env.(environs.InstanceConsole)
+	if !ok {
+		return results, errors.NotSupportedf("console output on this provider")
+	}
+	for i, arg := range args.Entities {
+		output, err := consoleOutputOne(mm, console, arg.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Result = output
+	}
+	return results, nil
+}
+
+func consoleOutputOne(mm *MachineManagerAPI, console environs.InstanceConsole, tag string) (string, error) {
+	machineTag, err := names.ParseMachineTag(tag)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	machine, err := mm.st.Machine(machineTag.Id())
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	instId, err := machine.InstanceId()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	output, err := console.ConsoleOutput(mm.callContext, instId)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return output, nil
+}
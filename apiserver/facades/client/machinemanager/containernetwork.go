@@ -0,0 +1,45 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package machinemanager
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/params"
+)
+
+// SetContainerNetworkDevices records, for each supplied host machine,
+// the host devices or bridges that containers provisioned on it should
+// be attached to. The bridge policy consults these overrides instead of
+// its automatic device selection. An empty device list clears the
+// override for a machine.
+func (mm *MachineManagerAPI) SetContainerNetworkDevices(args params.SetContainerNetworkDevicesArgs) (params.ErrorResults, error) {
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Args)),
+	}
+	if err := mm.authorizer.CanWrite(); err != nil {
+		return results, err
+	}
+	if err := mm.check.ChangeAllowed(); err != nil {
+		return results, errors.Trace(err)
+	}
+	for i, arg := range args.Args {
+		tag, err := names.ParseMachineTag(arg.MachineTag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		machine, err := mm.st.Machine(tag.Id())
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		if err := machine.SetContainerNetworkDevices(arg.Devices); err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return results, nil
+}
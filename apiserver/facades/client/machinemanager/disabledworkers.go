@@ -0,0 +1,76 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package machinemanager
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/params"
+)
+
+// SetDisabledWorkers replaces the set of agent workers disabled for the
+// given machines or models. Affected agents stop the named workers at
+// runtime, without being restarted or reconfigured, and restart them
+// when they are re-enabled. Passing no workers for an entity re-enables
+// everything.
+func (mm *MachineManagerAPI) SetDisabledWorkers(args params.SetDisabledWorkersArgs) (params.ErrorResults, error) {
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Args)),
+	}
+	if err := mm.authorizer.CanWrite(); err != nil {
+		return results, err
+	}
+	for i, arg := range args.Args {
+		tag, err := parseDisabledWorkersTag(arg.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		if err := mm.st.SetDisabledWorkers(tag, arg.Workers); err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return results, nil
+}
+
+// DisabledWorkers returns the agent workers disabled for the given
+// machines or models.
+func (mm *MachineManagerAPI) DisabledWorkers(args params.Entities) (params.DisabledWorkersResults, error) {
+	results := params.DisabledWorkersResults{
+		Results: make([]params.DisabledWorkersResult, len(args.Entities)),
+	}
+	if err := mm.authorizer.CanRead(); err != nil {
+		return results, err
+	}
+	for i, arg := range args.Entities {
+		tag, err := parseDisabledWorkersTag(arg.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		workers, err := mm.st.DisabledWorkers(tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Workers = workers
+	}
+	return results, nil
+}
+
+// parseDisabledWorkersTag accepts the machine and model tags that
+// workers can be disabled against.
+func parseDisabledWorkersTag(tag string) (names.Tag, error) {
+	parsed, err := names.ParseTag(tag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	switch parsed.(type) {
+	case names.MachineTag, names.ModelTag:
+		return parsed, nil
+	}
+	return nil, errors.NotSupportedf("disabling workers for %q", tag)
+}
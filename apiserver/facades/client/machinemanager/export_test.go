@@ -4,4 +4,5 @@
 package machinemanager
 
 var InstanceTypes = instanceTypes
+var RefreshHardware = refreshHardware
 var IsSeriesLessThan = isSeriesLessThan
@@ -0,0 +1,88 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package machinemanager
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/apiserver/common"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/environs"
+	environscloudspec "github.com/juju/juju/environs/cloudspec"
+	"github.com/juju/juju/environs/instances"
+	"github.com/juju/juju/state/stateenvirons"
+)
+
+// RefreshHardware re-reads the hardware characteristics of the supplied
+// machines from the provider and records them in state, so that changes
+// made after provisioning - such as a resize or a MAAS recommission -
+// become visible. The provider must be able to report hardware for
+// existing instances.
+func (mm *MachineManagerAPI) RefreshHardware(args params.Entities) (params.ErrorResults, error) {
+	return refreshHardware(mm, environs.GetEnviron, args)
+}
+
+func refreshHardware(mm *MachineManagerAPI, getEnviron environGetFunc, args params.Entities) (params.ErrorResults, error) {
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Entities)),
+	}
+	if err := mm.authorizer.CanWrite(); err != nil {
+		return results, err
+	}
+	model, err := mm.st.Model()
+	if err != nil {
+		return results, errors.Trace(err)
+	}
+	cloudSpec := func() (environscloudspec.CloudSpec, error) {
+		return stateenvirons.CloudSpecForModel(model)
+	}
+	env, err := getEnviron(common.EnvironConfigGetterFuncs{
+		CloudSpecFunc:   cloudSpec,
+		ModelConfigFunc: model.Config,
+	}, environs.New)
+	if err != nil {
+		return results, errors.Trace(err)
+	}
+	for i, arg := range args.Entities {
+		err := refreshOneHardware(mm, env, arg.Tag)
+		results.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return results, nil
+}
+
+func refreshOneHardware(mm *MachineManagerAPI, env environs.Environ, tag string) error {
+	machineTag, err := names.ParseMachineTag(tag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	machine, err := mm.st.Machine(machineTag.Id())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	instId, err := machine.InstanceId()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	insts, err := env.Instances(mm.callContext, []instance.Id{instId})
+	if err == environs.ErrNoInstances || err == environs.ErrPartialInstances {
+		return errors.NotFoundf("instance %q", instId)
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	hw, ok := insts[0].(instances.InstanceHardware)
+	if !ok {
+		return errors.NotSupportedf("refreshing hardware characteristics on this provider")
+	}
+	hc, err := hw.HardwareCharacteristics(mm.callContext)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if hc == nil {
+		return nil
+	}
+	return errors.Trace(machine.SetHardwareCharacteristics(*hc))
+}
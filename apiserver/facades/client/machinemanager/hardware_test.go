@@ -0,0 +1,177 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package machinemanager_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/facades/client/machinemanager"
+	"github.com/juju/juju/apiserver/facades/client/machinemanager/mocks"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/environs/context"
+	"github.com/juju/juju/environs/instances"
+)
+
+type refreshHardwareSuite struct{}
+
+var _ = gc.Suite(&refreshHardwareSuite{})
+
+func (b *mockBackend) Machine(id string) (machinemanager.Machine, error) {
+	if m, found := b.machines[id]; found {
+		return m, nil
+	}
+	return nil, errors.NotFoundf("machine %v", id)
+}
+
+func (m *mockEnviron) Instances(ctx context.ProviderCallContext, ids []instance.Id) ([]instances.Instance, error) {
+	out := make([]instances.Instance, len(ids))
+	var found int
+	for i, id := range ids {
+		if inst, ok := m.instances[id]; ok {
+			out[i] = inst
+			found++
+		}
+	}
+	if found == 0 {
+		return nil, environs.ErrNoInstances
+	} else if found != len(ids) {
+		return out, environs.ErrPartialInstances
+	}
+	return out, nil
+}
+
+// mockHardwareMachine stubs out just enough of the Machine interface
+// for hardware refresh tests.
+type mockHardwareMachine struct {
+	machinemanager.Machine
+
+	instId instance.Id
+	hw     *instance.HardwareCharacteristics
+}
+
+func (m *mockHardwareMachine) InstanceId() (instance.Id, error) {
+	if m.instId == "" {
+		return "", errors.NotProvisionedf("machine")
+	}
+	return m.instId, nil
+}
+
+func (m *mockHardwareMachine) SetHardwareCharacteristics(hc instance.HardwareCharacteristics) error {
+	m.hw = &hc
+	return nil
+}
+
+// mockHardwareInstance reports hardware characteristics.
+type mockHardwareInstance struct {
+	instances.Instance
+
+	hw *instance.HardwareCharacteristics
+}
+
+func (i *mockHardwareInstance) HardwareCharacteristics(context.ProviderCallContext) (*instance.HardwareCharacteristics, error) {
+	return i.hw, nil
+}
+
+// plainInstance does not implement instances.InstanceHardware.
+type plainInstance struct {
+	instances.Instance
+}
+
+func (s *refreshHardwareSuite) newAPI(c *gc.C, ctrl *gomock.Controller, backend *mockBackend) *machinemanager.MachineManagerAPI {
+	leadership := mocks.NewMockLeadership(ctrl)
+	authorizer := testing.FakeAuthorizer{
+		Tag:        names.NewUserTag("admin"),
+		Controller: true,
+	}
+	api, err := machinemanager.NewMachineManagerAPI(backend,
+		backend,
+		&mockPool{},
+		machinemanager.ModelAuthorizer{
+			Authorizer: authorizer,
+			ModelTag:   backend.ModelTag(),
+		},
+		context.NewEmptyCloudCallContext(),
+		common.NewResources(),
+		leadership,
+		nil,
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	return api
+}
+
+func (s *refreshHardwareSuite) TestRefreshHardware(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	machine := &mockHardwareMachine{instId: "inst-0"}
+	backend := &mockBackend{
+		machines: map[string]machinemanager.Machine{"0": machine},
+	}
+	api := s.newAPI(c, ctrl, backend)
+
+	mem := uint64(8192)
+	env := mockEnviron{
+		instances: map[instance.Id]instances.Instance{
+			"inst-0": &mockHardwareInstance{hw: &instance.HardwareCharacteristics{Mem: &mem}},
+		},
+	}
+	fakeEnvironGet := func(st environs.EnvironConfigGetter,
+		newEnviron environs.NewEnvironFunc,
+	) (environs.Environ, error) {
+		return &env, nil
+	}
+
+	r, err := machinemanager.RefreshHardware(api, fakeEnvironGet, params.Entities{
+		Entities: []params.Entity{
+			{Tag: "machine-0"},
+			{Tag: "machine-42"},
+			{Tag: "unit-foo-0"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(r.Results, gc.HasLen, 3)
+	c.Assert(r.Results[0].Error, gc.IsNil)
+	c.Assert(r.Results[1].Error, gc.ErrorMatches, "machine 42 not found")
+	c.Assert(r.Results[2].Error, gc.ErrorMatches, `"unit-foo-0" is not a valid machine tag`)
+	c.Assert(machine.hw, gc.NotNil)
+	c.Assert(machine.hw.Mem, jc.DeepEquals, &mem)
+}
+
+func (s *refreshHardwareSuite) TestRefreshHardwareNotSupportedByProvider(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	machine := &mockHardwareMachine{instId: "inst-0"}
+	backend := &mockBackend{
+		machines: map[string]machinemanager.Machine{"0": machine},
+	}
+	api := s.newAPI(c, ctrl, backend)
+
+	env := mockEnviron{
+		instances: map[instance.Id]instances.Instance{
+			"inst-0": &plainInstance{},
+		},
+	}
+	fakeEnvironGet := func(st environs.EnvironConfigGetter,
+		newEnviron environs.NewEnvironFunc,
+	) (environs.Environ, error) {
+		return &env, nil
+	}
+
+	r, err := machinemanager.RefreshHardware(api, fakeEnvironGet, params.Entities{
+		Entities: []params.Entity{{Tag: "machine-0"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(r.Results, gc.HasLen, 1)
+	c.Assert(r.Results[0].Error, gc.ErrorMatches, "refreshing hardware characteristics on this provider not supported")
+	c.Assert(machine.hw, gc.IsNil)
+}
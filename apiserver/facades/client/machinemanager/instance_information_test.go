@@ -19,6 +19,7 @@ import (
 	"github.com/juju/juju/apiserver/testing"
 	"github.com/juju/juju/cloud"
 	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/environs"
 	environscloudspec "github.com/juju/juju/environs/cloudspec"
 	"github.com/juju/juju/environs/config"
@@ -112,6 +113,7 @@ type mockBackend struct {
 	storagecommon.StorageAccess
 
 	cloudSpec environscloudspec.CloudSpec
+	machines  map[string]machinemanager.Machine
 }
 
 func (st *mockBackend) VolumeAccess() storagecommon.VolumeAccess {
@@ -154,7 +156,8 @@ type mockEnviron struct {
 	machinemanager.Backend
 	jujutesting.Stub
 
-	results map[constraints.Value]instances.InstanceTypesWithCostMetadata
+	results   map[constraints.Value]instances.InstanceTypesWithCostMetadata
+	instances map[instance.Id]instances.Instance
 }
 
 func (m *mockEnviron) InstanceTypes(ctx context.ProviderCallContext, c constraints.Value) (instances.InstanceTypesWithCostMetadata, error) {
@@ -0,0 +1,94 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package machinemanager
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/state"
+)
+
+// SetMaintenanceWindows replaces the scheduled maintenance windows for
+// the given machines or models. During an open window Juju defers
+// non-urgent automatic operations against the affected machines.
+// Passing no windows for an entity removes any existing ones.
+func (mm *MachineManagerAPI) SetMaintenanceWindows(args params.SetMaintenanceWindowsArgs) (params.ErrorResults, error) {
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Args)),
+	}
+	if err := mm.authorizer.CanWrite(); err != nil {
+		return results, err
+	}
+	for i, arg := range args.Args {
+		tag, err := parseMaintenanceWindowTag(arg.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		windows := make([]state.MaintenanceWindow, len(arg.Windows))
+		for j, w := range arg.Windows {
+			windows[j] = state.MaintenanceWindow{
+				Start:    w.Start,
+				Duration: w.Duration,
+				Repeat:   w.Repeat,
+				Comment:  w.Comment,
+			}
+		}
+		if err := mm.st.SetMaintenanceWindows(tag, windows); err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return results, nil
+}
+
+// MaintenanceWindows returns the scheduled maintenance windows for the
+// given machines or models.
+func (mm *MachineManagerAPI) MaintenanceWindows(args params.Entities) (params.MaintenanceWindowsResults, error) {
+	results := params.MaintenanceWindowsResults{
+		Results: make([]params.MaintenanceWindowsResult, len(args.Entities)),
+	}
+	if err := mm.authorizer.CanRead(); err != nil {
+		return results, err
+	}
+	for i, arg := range args.Entities {
+		tag, err := parseMaintenanceWindowTag(arg.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		windows, err := mm.st.MaintenanceWindows(tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		converted := make([]params.MaintenanceWindow, len(windows))
+		for j, w := range windows {
+			converted[j] = params.MaintenanceWindow{
+				Start:    w.Start,
+				Duration: w.Duration,
+				Repeat:   w.Repeat,
+				Comment:  w.Comment,
+			}
+		}
+		results.Results[i].Windows = converted
+	}
+	return results, nil
+}
+
+// parseMaintenanceWindowTag accepts the machine and model tags that
+// maintenance windows can be scheduled against.
+func parseMaintenanceWindowTag(tag string) (names.Tag, error) {
+	parsed, err := names.ParseTag(tag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	switch parsed.(type) {
+	case names.MachineTag, names.ModelTag:
+		return parsed, nil
+	}
+	return nil, errors.NotSupportedf("maintenance windows for %q", tag)
+}
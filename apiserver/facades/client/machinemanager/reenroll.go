@@ -0,0 +1,69 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package machinemanager
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	"github.com/juju/utils/v2"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/params"
+)
+
+// ReenrollMachine issues fresh agent credentials for manually
+// provisioned machines whose agents have been wiped. The machine
+// entity is kept; re-installing the agent with the returned password
+// reattaches it to the existing machine rather than requiring a
+// remove and re-add cycle. Only manually provisioned machines can be
+// re-enrolled: machines managed by a provider get their credentials
+// through the provisioner.
+func (mm *MachineManagerAPI) ReenrollMachine(args params.Entities) (params.ReenrollMachineResults, error) {
+	results := params.ReenrollMachineResults{
+		Results: make([]params.ReenrollMachineResult, len(args.Entities)),
+	}
+	if err := mm.authorizer.CanWrite(); err != nil {
+		return results, err
+	}
+	if err := mm.check.ChangeAllowed(); err != nil {
+		return results, errors.Trace(err)
+	}
+	for i, arg := range args.Entities {
+		tag, err := names.ParseMachineTag(arg.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Machine = tag.String()
+		password, err := mm.reenrollMachine(tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Password = password
+	}
+	return results, nil
+}
+
+func (mm *MachineManagerAPI) reenrollMachine(tag names.MachineTag) (string, error) {
+	machine, err := mm.st.Machine(tag.Id())
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	manual, err := machine.IsManual()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if !manual {
+		return "", errors.NotSupportedf("re-enrolling machine %q not provisioned manually", tag.Id())
+	}
+	password, err := utils.RandomPassword()
+	if err != nil {
+		return "", errors.Annotate(err, "generating password")
+	}
+	if err := machine.SetPassword(password); err != nil {
+		return "", errors.Annotatef(err, "setting password for machine %q", tag.Id())
+	}
+	return password, nil
+}
@@ -32,6 +32,10 @@ type Backend interface {
 	AddOneMachine(template state.MachineTemplate) (*state.Machine, error)
 	AddMachineInsideNewMachine(template, parentTemplate state.MachineTemplate, containerType instance.ContainerType) (*state.Machine, error)
 	AddMachineInsideMachine(template state.MachineTemplate, parentId string, containerType instance.ContainerType) (*state.Machine, error)
+	SetMaintenanceWindows(tag names.Tag, windows []state.MaintenanceWindow) error
+	MaintenanceWindows(tag names.Tag) ([]state.MaintenanceWindow, error)
+	SetDisabledWorkers(tag names.Tag, workers []string) error
+	DisabledWorkers(tag names.Tag) ([]string, error)
 }
 
 type BackendState interface {
@@ -67,10 +71,15 @@ type Machine interface {
 	WatchUpgradeSeriesNotifications() (state.NotifyWatcher, error)
 	GetUpgradeSeriesMessages() ([]string, bool, error)
 	IsManager() bool
+	IsManual() (bool, error)
+	SetPassword(string) error
+	SetContainerNetworkDevices([]string) error
 	IsLockedForSeriesUpgrade() (bool, error)
 	UpgradeSeriesStatus() (model.UpgradeSeriesStatus, error)
 	SetUpgradeSeriesStatus(model.UpgradeSeriesStatus, string) error
 	ApplicationNames() ([]string, error)
+	InstanceId() (instance.Id, error)
+	SetHardwareCharacteristics(instance.HardwareCharacteristics) error
 }
 
 type Application interface {
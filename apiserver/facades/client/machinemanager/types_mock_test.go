@@ -10,6 +10,7 @@ import (
 	v9 "github.com/juju/charm/v9"
 	charmhub "github.com/juju/juju/charmhub"
 	transport "github.com/juju/juju/charmhub/transport"
+	instance "github.com/juju/juju/core/instance"
 	model "github.com/juju/juju/core/model"
 	status "github.com/juju/juju/core/status"
 	state "github.com/juju/juju/state"
@@ -142,6 +143,21 @@ func (mr *MockMachineMockRecorder) Id() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Id", reflect.TypeOf((*MockMachine)(nil).Id))
 }
 
+// InstanceId mocks base method
+func (m *MockMachine) InstanceId() (instance.Id, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstanceId")
+	ret0, _ := ret[0].(instance.Id)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstanceId indicates an expected call of InstanceId
+func (mr *MockMachineMockRecorder) InstanceId() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceId", reflect.TypeOf((*MockMachine)(nil).InstanceId))
+}
+
 // IsLockedForSeriesUpgrade mocks base method
 func (m *MockMachine) IsLockedForSeriesUpgrade() (bool, error) {
 	m.ctrl.T.Helper()
@@ -171,6 +187,21 @@ func (mr *MockMachineMockRecorder) IsManager() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsManager", reflect.TypeOf((*MockMachine)(nil).IsManager))
 }
 
+// IsManual mocks base method
+func (m *MockMachine) IsManual() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsManual")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsManual indicates an expected call of IsManual
+func (mr *MockMachineMockRecorder) IsManual() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsManual", reflect.TypeOf((*MockMachine)(nil).IsManual))
+}
+
 // Principals mocks base method
 func (m *MockMachine) Principals() []string {
 	m.ctrl.T.Helper()
@@ -213,6 +244,34 @@ func (mr *MockMachineMockRecorder) Series() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Series", reflect.TypeOf((*MockMachine)(nil).Series))
 }
 
+// SetContainerNetworkDevices mocks base method
+func (m *MockMachine) SetContainerNetworkDevices(arg0 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetContainerNetworkDevices", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetContainerNetworkDevices indicates an expected call of SetContainerNetworkDevices
+func (mr *MockMachineMockRecorder) SetContainerNetworkDevices(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetContainerNetworkDevices", reflect.TypeOf((*MockMachine)(nil).SetContainerNetworkDevices), arg0)
+}
+
+// SetHardwareCharacteristics mocks base method
+func (m *MockMachine) SetHardwareCharacteristics(arg0 instance.HardwareCharacteristics) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHardwareCharacteristics", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHardwareCharacteristics indicates an expected call of SetHardwareCharacteristics
+func (mr *MockMachineMockRecorder) SetHardwareCharacteristics(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHardwareCharacteristics", reflect.TypeOf((*MockMachine)(nil).SetHardwareCharacteristics), arg0)
+}
+
 // SetKeepInstance mocks base method
 func (m *MockMachine) SetKeepInstance(arg0 bool) error {
 	m.ctrl.T.Helper()
@@ -227,6 +286,20 @@ func (mr *MockMachineMockRecorder) SetKeepInstance(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetKeepInstance", reflect.TypeOf((*MockMachine)(nil).SetKeepInstance), arg0)
 }
 
+// SetPassword mocks base method
+func (m *MockMachine) SetPassword(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPassword", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPassword indicates an expected call of SetPassword
+func (mr *MockMachineMockRecorder) SetPassword(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPassword", reflect.TypeOf((*MockMachine)(nil).SetPassword), arg0)
+}
+
 // SetUpgradeSeriesStatus mocks base method
 func (m *MockMachine) SetUpgradeSeriesStatus(arg0 model.UpgradeSeriesStatus, arg1 string) error {
 	m.ctrl.T.Helper()
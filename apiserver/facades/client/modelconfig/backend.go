@@ -23,6 +23,9 @@ type Backend interface {
 	SetSLA(level, owner string, credentials []byte) error
 	SLALevel() (string, error)
 	SpaceByName(string) error
+	FeatureFlags() ([]string, error)
+	EnableFeatureFlag(string) error
+	DisableFeatureFlag(string) error
 }
 
 type stateShim struct {
@@ -52,6 +55,18 @@ func (st stateShim) SpaceByName(name string) error {
 	return err
 }
 
+func (st stateShim) FeatureFlags() ([]string, error) {
+	return st.model.FeatureFlags()
+}
+
+func (st stateShim) EnableFeatureFlag(flag string) error {
+	return st.model.EnableFeatureFlag(flag)
+}
+
+func (st stateShim) DisableFeatureFlag(flag string) error {
+	return st.model.DisableFeatureFlag(flag)
+}
+
 // NewStateBackend creates a backend for the facade to use.
 func NewStateBackend(m *state.Model) Backend {
 	return stateShim{m.State(), m}
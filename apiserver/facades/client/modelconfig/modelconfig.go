@@ -4,6 +4,10 @@
 package modelconfig
 
 import (
+	"reflect"
+	"sort"
+	"strings"
+
 	"github.com/juju/errors"
 	"github.com/juju/loggo"
 
@@ -16,8 +20,8 @@ import (
 	"github.com/juju/juju/state"
 )
 
-// NewFacadeV2 is used for API registration.
-func NewFacadeV2(ctx facade.Context) (*ModelConfigAPIV2, error) {
+// NewFacadeV3 is used for API registration.
+func NewFacadeV3(ctx facade.Context) (*ModelConfigAPIV3, error) {
 	auth := ctx.Auth()
 
 	model, err := ctx.State().Model()
@@ -27,6 +31,24 @@ func NewFacadeV2(ctx facade.Context) (*ModelConfigAPIV2, error) {
 	return NewModelConfigAPI(NewStateBackend(model), auth)
 }
 
+// NewFacadeV4 is used for API registration.
+func NewFacadeV4(ctx facade.Context) (*ModelConfigAPIV4, error) {
+	v3, err := NewFacadeV3(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &ModelConfigAPIV4{v3}, nil
+}
+
+// NewFacadeV2 is used for API registration.
+func NewFacadeV2(ctx facade.Context) (*ModelConfigAPIV2, error) {
+	v3, err := NewFacadeV3(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return v3.ModelConfigAPIV2, nil
+}
+
 // ModelConfigAPI provides the base implementation of the methods.
 type ModelConfigAPI struct {
 	backend Backend
@@ -34,13 +56,23 @@ type ModelConfigAPI struct {
 	check   *common.BlockChecker
 }
 
-// ModelConfigAPIV2 is currently the latest.
+// ModelConfigAPIV2 hides the feature flag methods.
 type ModelConfigAPIV2 struct {
 	*ModelConfigAPI
 }
 
+// ModelConfigAPIV3 hides ApplyModelConfig.
+type ModelConfigAPIV3 struct {
+	*ModelConfigAPIV2
+}
+
+// ModelConfigAPIV4 is currently the latest.
+type ModelConfigAPIV4 struct {
+	*ModelConfigAPIV3
+}
+
 // NewModelConfigAPI creates a new instance of the ModelConfig Facade.
-func NewModelConfigAPI(backend Backend, authorizer facade.Authorizer) (*ModelConfigAPIV2, error) {
+func NewModelConfigAPI(backend Backend, authorizer facade.Authorizer) (*ModelConfigAPIV3, error) {
 	if !authorizer.AuthClient() {
 		return nil, apiservererrors.ErrPerm
 	}
@@ -49,7 +81,7 @@ func NewModelConfigAPI(backend Backend, authorizer facade.Authorizer) (*ModelCon
 		auth:    authorizer,
 		check:   common.NewBlockChecker(backend),
 	}
-	return &ModelConfigAPIV2{client}, nil
+	return &ModelConfigAPIV3{&ModelConfigAPIV2{client}}, nil
 }
 
 func (c *ModelConfigAPI) checkCanWrite() error {
@@ -115,9 +147,50 @@ func (c *ModelConfigAPI) ModelGet() (params.ModelConfigResults, error) {
 			Source: val.Source,
 		}
 	}
+
+	// Any feature flags enabled on the model are surfaced alongside
+	// the regular config so they show up in model-config output.
+	flags, err := c.backend.FeatureFlags()
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	if len(flags) > 0 {
+		result.Config["feature-flags"] = params.ConfigValue{
+			Value:  strings.Join(flags, ","),
+			Source: "model",
+		}
+	}
 	return result, nil
 }
 
+// FeatureFlags returns the names of the feature flags enabled on the model.
+func (c *ModelConfigAPIV3) FeatureFlags() (params.ModelFeatureFlagsResult, error) {
+	result := params.ModelFeatureFlagsResult{}
+	if err := c.canReadModel(); err != nil {
+		return result, errors.Trace(err)
+	}
+	flags, err := c.backend.FeatureFlags()
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	result.Flags = flags
+	return result, nil
+}
+
+// SetFeatureFlag enables or disables a feature flag on the model.
+func (c *ModelConfigAPIV3) SetFeatureFlag(arg params.ModelFeatureFlag) error {
+	if err := c.checkCanWrite(); err != nil {
+		return err
+	}
+	if err := c.check.ChangeAllowed(); err != nil {
+		return errors.Trace(err)
+	}
+	if arg.Enabled {
+		return errors.Trace(c.backend.EnableFeatureFlag(arg.Flag))
+	}
+	return errors.Trace(c.backend.DisableFeatureFlag(arg.Flag))
+}
+
 // ModelSet implements the server-side part of the
 // set-model-config CLI command.
 func (c *ModelConfigAPI) ModelSet(args params.ModelSet) error {
@@ -146,6 +219,87 @@ func (c *ModelConfigAPI) ModelSet(args params.ModelSet) error {
 	return c.backend.UpdateModelConfig(attrs, nil, checkAgentVersion, checkLogTrace, checkDefaultSpace, checkCharmhubURL)
 }
 
+// ApplyModelConfig implements the server-side part of
+// model-config --file. The supplied attributes are treated as the
+// complete set of model-managed config: keys currently set on the
+// model but absent from the supplied set are reset to their defaults,
+// and the whole set is validated and applied in a single transaction,
+// so a failure leaves the config untouched. The returned diff
+// describes the changes that were made.
+func (c *ModelConfigAPIV4) ApplyModelConfig(args params.ModelConfigApplyArgs) (params.ModelConfigDiffResult, error) {
+	result := params.ModelConfigDiffResult{}
+	if err := c.checkCanWrite(); err != nil {
+		return result, err
+	}
+	if err := c.check.ChangeAllowed(); err != nil {
+		return result, errors.Trace(err)
+	}
+
+	current, err := c.backend.ModelConfigValues()
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+
+	attrs := config.ProcessDeprecatedAttributes(args.Config)
+	updates := make(map[string]interface{})
+	for key, value := range attrs {
+		cur, found := current[key]
+		switch {
+		case !found || cur.Source != config.JujuModelConfigSource:
+			if result.Added == nil {
+				result.Added = make(map[string]interface{})
+			}
+			result.Added[key] = value
+			updates[key] = value
+		case !reflect.DeepEqual(cur.Value, value):
+			if result.Changed == nil {
+				result.Changed = make(map[string]params.ModelConfigDelta)
+			}
+			result.Changed[key] = params.ModelConfigDelta{Old: cur.Value, New: value}
+			updates[key] = value
+		}
+	}
+	var removes []string
+	for key, cur := range current {
+		if cur.Source != config.JujuModelConfigSource {
+			continue
+		}
+		if _, ok := attrs[key]; ok {
+			continue
+		}
+		if !isImplicitlyResettable(key) {
+			continue
+		}
+		removes = append(removes, key)
+	}
+	sort.Strings(removes)
+	result.Reset = removes
+
+	if len(updates) == 0 && len(removes) == 0 {
+		return result, nil
+	}
+	err = c.backend.UpdateModelConfig(updates, removes,
+		c.checkAgentVersion(), c.checkLogTrace(), c.checkDefaultSpace(), c.checkCharmhubURL())
+	if err != nil {
+		return params.ModelConfigDiffResult{}, errors.Trace(err)
+	}
+	return result, nil
+}
+
+// isImplicitlyResettable reports whether the attribute may be reset to
+// its default because it is absent from an ApplyModelConfig set.
+// Immutable attributes and authorized-keys - which model-config output
+// deliberately omits - must never be reset that way.
+func isImplicitlyResettable(key string) bool {
+	switch key {
+	case config.NameKey, config.TypeKey, config.UUIDKey,
+		config.AgentVersionKey, config.CharmHubURLKey,
+		config.AuthorizedKeysKey:
+		return false
+	}
+	return true
+}
+
 func (c *ModelConfigAPI) checkLogTrace() state.ValidateConfigFunc {
 	return func(updateAttrs map[string]interface{}, removeAttrs []string, oldConfig *config.Config) error {
 		spec, ok := updateAttrs["logging-config"]
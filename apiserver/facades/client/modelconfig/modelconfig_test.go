@@ -14,6 +14,7 @@ import (
 	"github.com/juju/juju/apiserver/params"
 	apiservertesting "github.com/juju/juju/apiserver/testing"
 	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/feature"
 	"github.com/juju/juju/provider/dummy"
 	_ "github.com/juju/juju/provider/dummy"
 	"github.com/juju/juju/state"
@@ -24,7 +25,8 @@ type modelconfigSuite struct {
 	gitjujutesting.IsolationSuite
 	backend    *mockBackend
 	authorizer apiservertesting.FakeAuthorizer
-	api        *modelconfig.ModelConfigAPIV2
+	api        *modelconfig.ModelConfigAPIV3
+	apiV4      *modelconfig.ModelConfigAPIV4
 }
 
 var _ = gc.Suite(&modelconfigSuite{})
@@ -47,6 +49,7 @@ func (s *modelconfigSuite) SetUpTest(c *gc.C) {
 	var err error
 	s.api, err = modelconfig.NewModelConfigAPI(s.backend, &s.authorizer)
 	c.Assert(err, jc.ErrorIsNil)
+	s.apiV4 = &modelconfig.ModelConfigAPIV4{s.api}
 }
 
 func (s *modelconfigSuite) TestModelGet(c *gc.C) {
@@ -225,16 +228,136 @@ func (s *modelconfigSuite) TestModelUnsetMissing(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *modelconfigSuite) TestApplyModelConfig(c *gc.C) {
+	s.backend.cfg["http-proxy"] = config.ConfigValue{"http://proxy2", "controller"}
+	result, err := s.apiV4.ApplyModelConfig(params.ModelConfigApplyArgs{
+		Config: map[string]interface{}{
+			"ftp-proxy":  "http://proxy-new",
+			"http-proxy": "http://proxy2",
+			"some-key":   "value",
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.ModelConfigDiffResult{
+		Added: map[string]interface{}{
+			// http-proxy is inherited, so setting it on the model is
+			// an addition even though the value is unchanged.
+			"http-proxy": "http://proxy2",
+			"some-key":   "value",
+		},
+		Changed: map[string]params.ModelConfigDelta{
+			"ftp-proxy": {Old: "http://proxy", New: "http://proxy-new"},
+		},
+	})
+	s.assertConfigValue(c, "ftp-proxy", "http://proxy-new")
+	s.assertConfigValue(c, "some-key", "value")
+}
+
+func (s *modelconfigSuite) TestApplyModelConfigResetsAbsentKeys(c *gc.C) {
+	result, err := s.apiV4.ApplyModelConfig(params.ModelConfigApplyArgs{
+		Config: map[string]interface{}{},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.ModelConfigDiffResult{
+		Reset: []string{"ftp-proxy"},
+	})
+	s.assertConfigValueMissing(c, "ftp-proxy")
+	// Immutable attributes and authorized-keys are never implicitly reset.
+	s.assertConfigValue(c, "type", "dummy")
+	s.assertConfigValue(c, "agent-version", "1.2.3.4")
+	s.assertConfigValue(c, "authorized-keys", testing.FakeAuthKeys)
+	s.assertConfigValue(c, "charmhub-url", "http://meshuggah.rocks")
+}
+
+func (s *modelconfigSuite) TestApplyModelConfigNoChanges(c *gc.C) {
+	result, err := s.apiV4.ApplyModelConfig(params.ModelConfigApplyArgs{
+		Config: map[string]interface{}{
+			"ftp-proxy": "http://proxy",
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.ModelConfigDiffResult{})
+}
+
+func (s *modelconfigSuite) TestApplyModelConfigCannotChangeAgentVersion(c *gc.C) {
+	old, err := config.New(config.UseDefaults, dummy.SampleConfig().Merge(testing.Attrs{
+		"agent-version": "1.2.3.4",
+	}))
+	c.Assert(err, jc.ErrorIsNil)
+	s.backend.old = old
+	_, err = s.apiV4.ApplyModelConfig(params.ModelConfigApplyArgs{
+		Config: map[string]interface{}{
+			"ftp-proxy":     "http://proxy",
+			"agent-version": "9.9.9",
+		},
+	})
+	c.Assert(err, gc.ErrorMatches, "agent-version cannot be changed")
+	s.assertConfigValue(c, "agent-version", "1.2.3.4")
+}
+
+func (s *modelconfigSuite) TestApplyModelConfigRequiresWriteAccess(c *gc.C) {
+	s.authorizer.Tag = names.NewUserTag("read")
+	_, err := s.apiV4.ApplyModelConfig(params.ModelConfigApplyArgs{
+		Config: map[string]interface{}{"some-key": "value"},
+	})
+	c.Assert(errors.Cause(err), gc.ErrorMatches, "permission denied")
+}
+
+func (s *modelconfigSuite) TestBlockApplyModelConfig(c *gc.C) {
+	s.blockAllChanges(c, "TestBlockApplyModelConfig")
+	_, err := s.apiV4.ApplyModelConfig(params.ModelConfigApplyArgs{
+		Config: map[string]interface{}{"some-key": "value"},
+	})
+	s.assertBlocked(c, err, "TestBlockApplyModelConfig")
+}
+
+func (s *modelconfigSuite) TestSetFeatureFlag(c *gc.C) {
+	err := s.api.SetFeatureFlag(params.ModelFeatureFlag{Flag: feature.StrictMigration, Enabled: true})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.backend.flags, jc.DeepEquals, []string{feature.StrictMigration})
+
+	result, err := s.api.FeatureFlags()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Flags, jc.DeepEquals, []string{feature.StrictMigration})
+
+	err = s.api.SetFeatureFlag(params.ModelFeatureFlag{Flag: feature.StrictMigration, Enabled: false})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.backend.flags, gc.HasLen, 0)
+}
+
+func (s *modelconfigSuite) TestSetFeatureFlagRequiresWriteAccess(c *gc.C) {
+	s.authorizer.Tag = names.NewUserTag("read")
+	err := s.api.SetFeatureFlag(params.ModelFeatureFlag{Flag: feature.StrictMigration, Enabled: true})
+	c.Assert(errors.Cause(err), gc.ErrorMatches, "permission denied")
+}
+
+func (s *modelconfigSuite) TestBlockSetFeatureFlag(c *gc.C) {
+	s.blockAllChanges(c, "TestBlockSetFeatureFlag")
+	err := s.api.SetFeatureFlag(params.ModelFeatureFlag{Flag: feature.StrictMigration, Enabled: true})
+	s.assertBlocked(c, err, "TestBlockSetFeatureFlag")
+}
+
+func (s *modelconfigSuite) TestModelGetIncludesFeatureFlags(c *gc.C) {
+	s.backend.flags = []string{feature.StrictMigration, feature.ActionsV2}
+	result, err := s.api.ModelGet()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Config["feature-flags"], jc.DeepEquals, params.ConfigValue{
+		Value:  "strict-migration,actions-v2",
+		Source: "model",
+	})
+}
+
 func (s *modelconfigSuite) TestSetSupportCredentals(c *gc.C) {
 	err := s.api.SetSLALevel(params.ModelSLA{params.ModelSLAInfo{"level", "bob"}, []byte("foobar")})
 	c.Assert(err, jc.ErrorIsNil)
 }
 
 type mockBackend struct {
-	cfg config.ConfigValues
-	old *config.Config
-	b   state.BlockType
-	msg string
+	cfg   config.ConfigValues
+	old   *config.Config
+	b     state.BlockType
+	msg   string
+	flags []string
 }
 
 func (m *mockBackend) ModelConfigValues() (config.ConfigValues, error) {
@@ -288,6 +411,30 @@ func (m *mockBackend) SpaceByName(string) error {
 	return nil
 }
 
+func (m *mockBackend) FeatureFlags() ([]string, error) {
+	return m.flags, nil
+}
+
+func (m *mockBackend) EnableFeatureFlag(flag string) error {
+	for _, f := range m.flags {
+		if f == flag {
+			return nil
+		}
+	}
+	m.flags = append(m.flags, flag)
+	return nil
+}
+
+func (m *mockBackend) DisableFeatureFlag(flag string) error {
+	for i, f := range m.flags {
+		if f == flag {
+			m.flags = append(m.flags[:i], m.flags[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
 type mockBlock struct {
 	state.Block
 	t state.BlockType
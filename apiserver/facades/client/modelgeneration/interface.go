@@ -48,7 +48,10 @@ type Generation interface {
 	AssignedUnits() map[string][]string
 	Commit(string) (int, error)
 	Abort(string) error
+	AbortWithRevert(string) error
 	Config() map[string]settings.ItemChanges
+	CharmRevisions() map[string]int
+	UpdateCharmRevision(string, int) error
 	GenerationId() int
 }
 
@@ -229,6 +229,20 @@ func (mr *MockGenerationMockRecorder) Abort(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Abort", reflect.TypeOf((*MockGeneration)(nil).Abort), arg0)
 }
 
+// AbortWithRevert mocks base method
+func (m *MockGeneration) AbortWithRevert(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbortWithRevert", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AbortWithRevert indicates an expected call of AbortWithRevert
+func (mr *MockGenerationMockRecorder) AbortWithRevert(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortWithRevert", reflect.TypeOf((*MockGeneration)(nil).AbortWithRevert), arg0)
+}
+
 // AssignAllUnits mocks base method
 func (m *MockGeneration) AssignAllUnits(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -299,6 +313,20 @@ func (mr *MockGenerationMockRecorder) BranchName() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BranchName", reflect.TypeOf((*MockGeneration)(nil).BranchName))
 }
 
+// CharmRevisions mocks base method
+func (m *MockGeneration) CharmRevisions() map[string]int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CharmRevisions")
+	ret0, _ := ret[0].(map[string]int)
+	return ret0
+}
+
+// CharmRevisions indicates an expected call of CharmRevisions
+func (mr *MockGenerationMockRecorder) CharmRevisions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CharmRevisions", reflect.TypeOf((*MockGeneration)(nil).CharmRevisions))
+}
+
 // Commit mocks base method
 func (m *MockGeneration) Commit(arg0 string) (int, error) {
 	m.ctrl.T.Helper()
@@ -398,6 +426,20 @@ func (mr *MockGenerationMockRecorder) GenerationId() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerationId", reflect.TypeOf((*MockGeneration)(nil).GenerationId))
 }
 
+// UpdateCharmRevision mocks base method
+func (m *MockGeneration) UpdateCharmRevision(arg0 string, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCharmRevision", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCharmRevision indicates an expected call of UpdateCharmRevision
+func (mr *MockGenerationMockRecorder) UpdateCharmRevision(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCharmRevision", reflect.TypeOf((*MockGeneration)(nil).UpdateCharmRevision), arg0, arg1)
+}
+
 // MockApplication is a mock of Application interface
 type MockApplication struct {
 	ctrl     *gomock.Controller
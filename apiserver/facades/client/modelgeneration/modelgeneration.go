@@ -29,10 +29,14 @@ type API struct {
 	modelCache        ModelCache
 }
 
-type APIV3 struct {
+type APIV4 struct {
 	*API
 }
 
+type APIV3 struct {
+	*APIV4
+}
+
 type APIV2 struct {
 	*APIV3
 }
@@ -41,8 +45,8 @@ type APIV1 struct {
 	*APIV2
 }
 
-// NewModelGenerationFacadeV4 provides the signature required for facade registration.
-func NewModelGenerationFacadeV4(ctx facade.Context) (*API, error) {
+// NewModelGenerationFacadeV5 provides the signature required for facade registration.
+func NewModelGenerationFacadeV5(ctx facade.Context) (*API, error) {
 	authorizer := ctx.Auth()
 	st := &stateShim{State: ctx.State()}
 	m, err := st.Model()
@@ -56,6 +60,15 @@ func NewModelGenerationFacadeV4(ctx facade.Context) (*API, error) {
 	return NewModelGenerationAPI(st, authorizer, m, &modelCacheShim{Model: mc})
 }
 
+// NewModelGenerationFacadeV4 provides the signature required for facade registration.
+func NewModelGenerationFacadeV4(ctx facade.Context) (*APIV4, error) {
+	v5, err := NewModelGenerationFacadeV5(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &APIV4{v5}, nil
+}
+
 // NewModelGenerationFacadeV3 provides the signature required for facade registration.
 func NewModelGenerationFacadeV3(ctx facade.Context) (*APIV3, error) {
 	v4, err := NewModelGenerationFacadeV4(ctx)
@@ -192,6 +205,33 @@ func (api *API) TrackBranch(arg params.BranchTrackArg) (params.ErrorResults, err
 	return result, nil
 }
 
+// StageCharmRevision is not available via the V4 API.
+func (api *APIV4) StageCharmRevision(_ struct{}) {}
+
+// StageCharmRevision stages an upgrade of the input application's charm to
+// the input revision under the input branch. The upgrade is applied to the
+// application when the branch is committed.
+func (api *API) StageCharmRevision(arg params.BranchCharmRevisionArg) (params.ErrorResult, error) {
+	result := params.ErrorResult{}
+
+	isModelAdmin, err := api.hasAdminAccess()
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	if !isModelAdmin && !api.isControllerAdmin {
+		return result, apiservererrors.ErrPerm
+	}
+
+	branch, err := api.model.Branch(arg.BranchName)
+	if err != nil {
+		result.Error = apiservererrors.ServerError(err)
+		return result, nil
+	}
+
+	result.Error = apiservererrors.ServerError(branch.UpdateCharmRevision(arg.ApplicationName, arg.Revision))
+	return result, nil
+}
+
 // CommitBranch commits the input branch, making its changes applicable to
 // the whole model and marking it complete.
 func (api *API) CommitBranch(arg params.BranchArg) (params.IntResult, error) {
@@ -244,6 +284,35 @@ func (api *API) AbortBranch(arg params.BranchArg) (params.ErrorResult, error) {
 	return result, nil
 }
 
+// RevertBranch is not available via the V4 API.
+func (api *APIV4) RevertBranch(_ struct{}) {}
+
+// RevertBranch aborts the input branch even if it has assigned units,
+// removing their tracking data so that they revert to the configuration
+// of the current model generation.
+func (api *API) RevertBranch(arg params.BranchArg) (params.ErrorResult, error) {
+	result := params.ErrorResult{}
+
+	isModelAdmin, err := api.hasAdminAccess()
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	if !isModelAdmin && !api.isControllerAdmin {
+		return result, apiservererrors.ErrPerm
+	}
+
+	branch, err := api.model.Branch(arg.BranchName)
+	if err != nil {
+		result.Error = apiservererrors.ServerError(err)
+		return result, nil
+	}
+
+	if err := branch.AbortWithRevert(api.apiUser.Name()); err != nil {
+		result.Error = apiservererrors.ServerError(err)
+	}
+	return result, nil
+}
+
 // BranchInfo will return details of branch identified by the input argument,
 // including units on the branch and the configuration disjoint with the
 // master generation.
@@ -355,6 +424,7 @@ func (api *API) ListCommits() (params.BranchResults, error) {
 
 func (api *API) oneBranchInfo(branch Generation, detailed bool) (params.Generation, error) {
 	deltas := branch.Config()
+	charmRevisions := branch.CharmRevisions()
 
 	var apps []params.GenerationApplication
 	for appName, tracking := range branch.AssignedUnits() {
@@ -379,7 +449,8 @@ func (api *API) oneBranchInfo(branch Generation, detailed bool) (params.Generati
 		}
 		branchApp.ConfigChanges = deltas[appName].EffectiveChanges(defaults)
 
-		// TODO (manadart 2019-04-12): Charm URL.
+		// Include any charm upgrade staged for the application.
+		branchApp.CharmRevision = charmRevisions[appName]
 
 		// TODO (manadart 2019-04-12): Resources.
 
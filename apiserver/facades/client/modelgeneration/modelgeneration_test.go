@@ -126,6 +126,21 @@ func (s *modelGenerationSuite) TestTrackBranchWithTooManyNumUnits(c *gc.C) {
 	c.Check(result.Results, gc.DeepEquals, []params.ErrorResult(nil))
 }
 
+func (s *modelGenerationSuite) TestStageCharmRevisionSuccess(c *gc.C) {
+	defer s.setupModelGenerationAPI(c).Finish()
+	s.expectUpdateCharmRevision("redis", 4)
+	s.expectBranch()
+
+	arg := params.BranchCharmRevisionArg{
+		BranchName:      s.newBranchName,
+		ApplicationName: "redis",
+		Revision:        4,
+	}
+	result, err := s.api.StageCharmRevision(arg)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Error, gc.IsNil)
+}
+
 func (s *modelGenerationSuite) TestCommitBranchSuccess(c *gc.C) {
 	defer s.setupModelGenerationAPI(c).Finish()
 	s.expectCommit()
@@ -146,6 +161,16 @@ func (s *modelGenerationSuite) TestAbortBranchSuccess(c *gc.C) {
 	c.Assert(result, gc.DeepEquals, params.ErrorResult{Error: nil})
 }
 
+func (s *modelGenerationSuite) TestRevertBranchSuccess(c *gc.C) {
+	defer s.setupModelGenerationAPI(c).Finish()
+	s.expectAbortWithRevert()
+	s.expectBranch()
+
+	result, err := s.api.RevertBranch(s.newBranchArg())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, gc.DeepEquals, params.ErrorResult{Error: nil})
+}
+
 func (s *modelGenerationSuite) TestHasActiveBranchTrue(c *gc.C) {
 	defer s.setupModelGenerationAPI(c).Finish()
 	s.expectHasActiveBranch(nil)
@@ -181,6 +206,7 @@ func (s *modelGenerationSuite) testBranchInfo(c *gc.C, branchNames []string, det
 	units := []string{"redis/0", "redis/1", "redis/2"}
 
 	s.expectConfig()
+	s.expectCharmRevisions()
 	s.expectBranchName()
 	s.expectAssignedUnits(units[:2])
 	s.expectCreated()
@@ -218,6 +244,7 @@ func (s *modelGenerationSuite) testBranchInfo(c *gc.C, branchNames []string, det
 		"databases": 16,
 		"port":      8000,
 	})
+	c.Check(genApp.CharmRevision, gc.Equals, 4)
 
 	// Unit lists are only populated when detailed is true.
 	if detailed {
@@ -291,6 +318,14 @@ func (s *modelGenerationSuite) expectAbort() {
 	s.mockGen.EXPECT().Abort(s.apiUser).Return(nil)
 }
 
+func (s *modelGenerationSuite) expectAbortWithRevert() {
+	s.mockGen.EXPECT().AbortWithRevert(s.apiUser).Return(nil)
+}
+
+func (s *modelGenerationSuite) expectUpdateCharmRevision(appName string, revision int) {
+	s.mockGen.EXPECT().UpdateCharmRevision(appName, revision).Return(nil)
+}
+
 func (s *modelGenerationSuite) expectCommit() {
 	s.mockGen.EXPECT().Commit(s.apiUser).Return(3, nil)
 }
@@ -319,6 +354,10 @@ func (s *modelGenerationSuite) expectConfig() {
 	}})
 }
 
+func (s *modelGenerationSuite) expectCharmRevisions() {
+	s.mockGen.EXPECT().CharmRevisions().Return(map[string]int{"redis": 4})
+}
+
 func (s *modelGenerationSuite) setupMockApp(ctrl *gomock.Controller, units []string) {
 	mockApp := mocks.NewMockApplication(ctrl)
 	mockApp.EXPECT().DefaultCharmConfig().Return(map[string]interface{}{
@@ -29,6 +29,7 @@ import (
 	"github.com/juju/juju/caas"
 	jujucloud "github.com/juju/juju/cloud"
 	"github.com/juju/juju/controller/modelmanager"
+	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/environs"
@@ -39,6 +40,7 @@ import (
 	"github.com/juju/juju/feature"
 	"github.com/juju/juju/state"
 	"github.com/juju/juju/state/stateenvirons"
+	"github.com/juju/juju/storage"
 	"github.com/juju/juju/tools"
 )
 
@@ -49,6 +51,17 @@ var (
 	supportedFeaturesGetter = stateenvirons.SupportedFeatures
 )
 
+// ModelManagerV10 defines the methods on the version 10 facade for the
+// modelmanager API endpoint.
+type ModelManagerV10 interface {
+	ModelManagerV9
+	SaveModelTemplates(args params.ModelTemplates) (params.ErrorResults, error)
+	ModelTemplates() (params.ModelTemplatesResult, error)
+	RemoveModelTemplates(args params.ModelTemplateNames) (params.ErrorResults, error)
+	SetRegionStoragePoolDefaults(args params.SetRegionStoragePoolDefaults) (params.ErrorResults, error)
+	RegionStoragePoolDefaults(args params.RegionStoragePoolDefaultsSpecs) (params.RegionStoragePoolDefaultsResults, error)
+}
+
 // ModelManagerV9 defines the methods on the version 9 facade for the
 // modelmanager API endpoint.
 type ModelManagerV9 interface {
@@ -164,10 +177,16 @@ type ModelManagerAPI struct {
 	callContext context.ProviderCallContext
 }
 
+// ModelManagerAPIV9 provides a way to wrap the different calls between
+// version 9 and version 10 of the model manager API
+type ModelManagerAPIV9 struct {
+	*ModelManagerAPI
+}
+
 // ModelManagerAPIV8 provides a way to wrap the different calls between
-// version 8 and version 8 of the model manager API
+// version 8 and version 9 of the model manager API
 type ModelManagerAPIV8 struct {
-	*ModelManagerAPI
+	*ModelManagerAPIV9
 }
 
 // ModelManagerAPIV7 provides a way to wrap the different calls between
@@ -207,18 +226,19 @@ type ModelManagerAPIV2 struct {
 }
 
 var (
-	_ ModelManagerV9 = (*ModelManagerAPI)(nil)
-	_ ModelManagerV8 = (*ModelManagerAPIV8)(nil)
-	_ ModelManagerV7 = (*ModelManagerAPIV7)(nil)
-	_ ModelManagerV6 = (*ModelManagerAPIV6)(nil)
-	_ ModelManagerV5 = (*ModelManagerAPIV5)(nil)
-	_ ModelManagerV4 = (*ModelManagerAPIV4)(nil)
-	_ ModelManagerV3 = (*ModelManagerAPIV3)(nil)
-	_ ModelManagerV2 = (*ModelManagerAPIV2)(nil)
+	_ ModelManagerV10 = (*ModelManagerAPI)(nil)
+	_ ModelManagerV9  = (*ModelManagerAPIV9)(nil)
+	_ ModelManagerV8  = (*ModelManagerAPIV8)(nil)
+	_ ModelManagerV7  = (*ModelManagerAPIV7)(nil)
+	_ ModelManagerV6  = (*ModelManagerAPIV6)(nil)
+	_ ModelManagerV5  = (*ModelManagerAPIV5)(nil)
+	_ ModelManagerV4  = (*ModelManagerAPIV4)(nil)
+	_ ModelManagerV3  = (*ModelManagerAPIV3)(nil)
+	_ ModelManagerV2  = (*ModelManagerAPIV2)(nil)
 )
 
-// NewFacadeV9 is used for API registration.
-func NewFacadeV9(ctx facade.Context) (*ModelManagerAPI, error) {
+// NewFacadeV10 is used for API registration.
+func NewFacadeV10(ctx facade.Context) (*ModelManagerAPI, error) {
 	st := ctx.State()
 	pool := ctx.StatePool()
 	ctlrSt := pool.SystemState()
@@ -258,6 +278,15 @@ func NewFacadeV9(ctx facade.Context) (*ModelManagerAPI, error) {
 	)
 }
 
+// NewFacadeV9 is used for API registration.
+func NewFacadeV9(ctx facade.Context) (*ModelManagerAPIV9, error) {
+	v10, err := NewFacadeV10(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ModelManagerAPIV9{v10}, nil
+}
+
 // NewFacadeV8 is used for API registration.
 func NewFacadeV8(ctx facade.Context) (*ModelManagerAPIV8, error) {
 	v9, err := NewFacadeV9(ctx)
@@ -514,6 +543,30 @@ func (m *ModelManagerAPI) CreateModel(args params.ModelCreateArgs) (params.Model
 		return result, errors.Annotatef(apiservererrors.ErrPerm, "%q permission does not permit creation of models for different owners", permission.AddModelAccess)
 	}
 
+	// If a template was requested, apply its config underneath any
+	// values given explicitly in the request, and hold on to its
+	// constraints to apply once the model exists.
+	var templateCons constraints.Value
+	if args.Template != "" {
+		backend, err := m.templateBackend()
+		if err != nil {
+			return result, errors.Trace(err)
+		}
+		template, err := backend.ModelTemplate(args.Template)
+		if err != nil {
+			return result, errors.Annotate(err, "getting model template")
+		}
+		templateCons, err = constraints.Parse(template.Constraints())
+		if err != nil {
+			return result, errors.Annotatef(err, "parsing constraints from model template %q", args.Template)
+		}
+		merged := template.Config()
+		for k, v := range args.Config {
+			merged[k] = v
+		}
+		args.Config = merged
+	}
+
 	cloud, err := m.state.Cloud(cloudTag.Id())
 	if err != nil {
 		if errors.IsNotFound(err) && args.CloudTag != "" {
@@ -608,6 +661,21 @@ func (m *ModelManagerAPI) CreateModel(args params.ModelCreateArgs) (params.Model
 	if err != nil {
 		return result, errors.Trace(err)
 	}
+
+	if !constraints.IsEmpty(&templateCons) {
+		newSt, releaser, err := m.state.GetBackend(model.ModelTag().Id())
+		if err != nil {
+			return result, errors.Trace(err)
+		}
+		defer releaser()
+		if setter, ok := newSt.(interface {
+			SetModelConstraints(constraints.Value) error
+		}); ok {
+			if err := setter.SetModelConstraints(templateCons); err != nil {
+				return result, errors.Annotatef(err, "applying constraints from model template %q", args.Template)
+			}
+		}
+	}
 	return m.getModelInfo(model.ModelTag())
 }
 
@@ -1799,3 +1867,192 @@ func (*ModelManagerAPIV5) ModelDefaultsForClouds(_, _ struct{}) {}
 
 // ValidateModelUpgrade did not exist prior to v9.
 func (*ModelManagerAPIV8) ValidateModelUpgrade(_, _ struct{}) {}
+
+// SaveModelTemplates did not exist prior to v10.
+func (*ModelManagerAPIV9) SaveModelTemplates(_, _ struct{}) {}
+
+// ModelTemplates did not exist prior to v10.
+func (*ModelManagerAPIV9) ModelTemplates(_, _ struct{}) {}
+
+// RemoveModelTemplates did not exist prior to v10.
+func (*ModelManagerAPIV9) RemoveModelTemplates(_, _ struct{}) {}
+
+// SetRegionStoragePoolDefaults did not exist prior to v10.
+func (*ModelManagerAPIV9) SetRegionStoragePoolDefaults(_, _ struct{}) {}
+
+// RegionStoragePoolDefaults did not exist prior to v10.
+func (*ModelManagerAPIV9) RegionStoragePoolDefaults(_, _ struct{}) {}
+
+// modelTemplateBackend defines the controller state methods used to
+// manage model templates. It is implemented by *state.State; declaring
+// it here keeps the wider ModelManagerBackend interface (and its test
+// fakes) unchanged.
+type modelTemplateBackend interface {
+	SetModelTemplate(name string, config map[string]interface{}, cons string) error
+	ModelTemplate(name string) (*state.ModelTemplate, error)
+	AllModelTemplates() ([]*state.ModelTemplate, error)
+	RemoveModelTemplate(name string) error
+}
+
+func (m *ModelManagerAPI) templateBackend() (modelTemplateBackend, error) {
+	backend, ok := m.ctlrState.(modelTemplateBackend)
+	if !ok {
+		return nil, errors.NotSupportedf("model templates")
+	}
+	return backend, nil
+}
+
+// SaveModelTemplates creates or replaces the given controller-level
+// model templates. Only controller admins may manage templates.
+func (m *ModelManagerAPI) SaveModelTemplates(args params.ModelTemplates) (params.ErrorResults, error) {
+	var results params.ErrorResults
+	controllerAdmin, err := m.authorizer.HasPermission(permission.SuperuserAccess, m.state.ControllerTag())
+	if err != nil {
+		return results, errors.Trace(err)
+	}
+	if !controllerAdmin {
+		return results, apiservererrors.ErrPerm
+	}
+	backend, err := m.templateBackend()
+	if err != nil {
+		return results, errors.Trace(err)
+	}
+	results.Results = make([]params.ErrorResult, len(args.Templates))
+	for i, template := range args.Templates {
+		if _, err := constraints.Parse(template.Constraints); err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		err := backend.SetModelTemplate(template.Name, template.Config, template.Constraints)
+		results.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return results, nil
+}
+
+// ModelTemplates returns all the model templates defined on the
+// controller. Any authenticated user may list templates, so that
+// users with add-model permission can create models from them.
+func (m *ModelManagerAPI) ModelTemplates() (params.ModelTemplatesResult, error) {
+	var result params.ModelTemplatesResult
+	backend, err := m.templateBackend()
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	templates, err := backend.AllModelTemplates()
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	result.Templates = make([]params.ModelTemplate, len(templates))
+	for i, template := range templates {
+		result.Templates[i] = params.ModelTemplate{
+			Name:        template.Name(),
+			Config:      template.Config(),
+			Constraints: template.Constraints(),
+		}
+	}
+	return result, nil
+}
+
+// RemoveModelTemplates removes the named controller-level model
+// templates. Only controller admins may manage templates.
+func (m *ModelManagerAPI) RemoveModelTemplates(args params.ModelTemplateNames) (params.ErrorResults, error) {
+	var results params.ErrorResults
+	controllerAdmin, err := m.authorizer.HasPermission(permission.SuperuserAccess, m.state.ControllerTag())
+	if err != nil {
+		return results, errors.Trace(err)
+	}
+	if !controllerAdmin {
+		return results, apiservererrors.ErrPerm
+	}
+	backend, err := m.templateBackend()
+	if err != nil {
+		return results, errors.Trace(err)
+	}
+	results.Results = make([]params.ErrorResult, len(args.Names))
+	for i, name := range args.Names {
+		results.Results[i].Error = apiservererrors.ServerError(backend.RemoveModelTemplate(name))
+	}
+	return results, nil
+}
+
+// regionDefaultsBackend defines the controller state methods used to
+// manage per-region model defaults beyond plain config attributes. It
+// is implemented by *state.State; declaring it here keeps the wider
+// ModelManagerBackend interface (and its test fakes) unchanged.
+type regionDefaultsBackend interface {
+	UpdateRegionStoragePoolDefaults(cloud, region string, update map[string]storage.Attrs, remove []string) error
+	RegionStoragePoolDefaults(cloud, region string) (map[string]storage.Attrs, error)
+}
+
+func (m *ModelManagerAPI) regionDefaultsBackend() (regionDefaultsBackend, error) {
+	backend, ok := m.ctlrState.(regionDefaultsBackend)
+	if !ok {
+		return nil, errors.NotSupportedf("per-region storage pool defaults")
+	}
+	return backend, nil
+}
+
+// SetRegionStoragePoolDefaults updates the default storage pools
+// created in new models on the given cloud regions. Only controller
+// admins may change the defaults.
+func (m *ModelManagerAPI) SetRegionStoragePoolDefaults(args params.SetRegionStoragePoolDefaults) (params.ErrorResults, error) {
+	results := params.ErrorResults{Results: make([]params.ErrorResult, len(args.Defaults))}
+	if !m.isAdmin {
+		return results, apiservererrors.ErrPerm
+	}
+	if err := m.check.ChangeAllowed(); err != nil {
+		return results, errors.Trace(err)
+	}
+	backend, err := m.regionDefaultsBackend()
+	if err != nil {
+		return results, errors.Trace(err)
+	}
+	for i, arg := range args.Defaults {
+		cloudTag, err := names.ParseCloudTag(arg.CloudTag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		update := make(map[string]storage.Attrs, len(arg.Update))
+		for name, attrs := range arg.Update {
+			update[name] = storage.Attrs(attrs)
+		}
+		results.Results[i].Error = apiservererrors.ServerError(
+			backend.UpdateRegionStoragePoolDefaults(cloudTag.Id(), arg.CloudRegion, update, arg.Remove),
+		)
+	}
+	return results, nil
+}
+
+// RegionStoragePoolDefaults returns the default storage pools created
+// in new models on the given cloud regions.
+func (m *ModelManagerAPI) RegionStoragePoolDefaults(args params.RegionStoragePoolDefaultsSpecs) (params.RegionStoragePoolDefaultsResults, error) {
+	results := params.RegionStoragePoolDefaultsResults{
+		Results: make([]params.RegionStoragePoolDefaultsResult, len(args.Specs)),
+	}
+	if !m.isAdmin {
+		return results, apiservererrors.ErrPerm
+	}
+	backend, err := m.regionDefaultsBackend()
+	if err != nil {
+		return results, errors.Trace(err)
+	}
+	for i, arg := range args.Specs {
+		cloudTag, err := names.ParseCloudTag(arg.CloudTag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		pools, err := backend.RegionStoragePoolDefaults(cloudTag.Id(), arg.CloudRegion)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		result := make(map[string]map[string]interface{}, len(pools))
+		for name, attrs := range pools {
+			result[name] = map[string]interface{}(attrs)
+		}
+		results.Results[i].Pools = result
+	}
+	return results, nil
+}
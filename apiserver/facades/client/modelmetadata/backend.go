@@ -0,0 +1,76 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelmetadata
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	"github.com/juju/version/v2"
+
+	"github.com/juju/juju/state"
+)
+
+// ModelCounts holds the headline entity counts for a model.
+type ModelCounts struct {
+	Applications int
+	Units        int
+	Machines     int
+}
+
+// Backend defines the state functionality required by the
+// modelmetadata facade.
+type Backend interface {
+	ModelTag() names.ModelTag
+	Name() string
+	Type() state.ModelType
+	CloudName() string
+	CloudRegion() string
+	ControllerUUID() string
+	AgentVersion() (version.Number, error)
+
+	// Counts returns the number of applications, units and machines
+	// in the model.
+	Counts() (ModelCounts, error)
+}
+
+type stateShim struct {
+	*state.State
+	*state.Model
+}
+
+// NewStateBackend converts a state.State into a Backend.
+func NewStateBackend(st *state.State) (Backend, error) {
+	m, err := st.Model()
+	if err != nil {
+		return nil, err
+	}
+	return &stateShim{
+		State: st,
+		Model: m,
+	}, nil
+}
+
+// ControllerUUID disambiguates the method shared by state.State and
+// state.Model.
+func (s *stateShim) ControllerUUID() string {
+	return s.Model.ControllerUUID()
+}
+
+func (s *stateShim) Counts() (ModelCounts, error) {
+	var counts ModelCounts
+	apps, err := s.State.AllApplications()
+	if err != nil {
+		return counts, errors.Trace(err)
+	}
+	counts.Applications = len(apps)
+	for _, app := range apps {
+		counts.Units += app.UnitCount()
+	}
+	machines, err := s.State.AllMachines()
+	if err != nil {
+		return counts, errors.Trace(err)
+	}
+	counts.Machines = len(machines)
+	return counts, nil
+}
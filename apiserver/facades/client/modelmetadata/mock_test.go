@@ -0,0 +1,79 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelmetadata_test
+
+import (
+	"github.com/juju/names/v4"
+	jtesting "github.com/juju/testing"
+	"github.com/juju/version/v2"
+
+	"github.com/juju/juju/apiserver/facades/client/modelmetadata"
+	"github.com/juju/juju/state"
+)
+
+type mockBackend struct {
+	jtesting.Stub
+	modelmetadata.Backend
+
+	modelUUID      string
+	name           string
+	modelType      state.ModelType
+	cloud          string
+	cloudRegion    string
+	controllerUUID string
+	agentVersion   version.Number
+	counts         modelmetadata.ModelCounts
+}
+
+func (m *mockBackend) ModelTag() names.ModelTag {
+	m.MethodCall(m, "ModelTag")
+	m.PopNoErr()
+	return names.NewModelTag(m.modelUUID)
+}
+
+func (m *mockBackend) Name() string {
+	m.MethodCall(m, "Name")
+	m.PopNoErr()
+	return m.name
+}
+
+func (m *mockBackend) Type() state.ModelType {
+	m.MethodCall(m, "Type")
+	m.PopNoErr()
+	return m.modelType
+}
+
+func (m *mockBackend) CloudName() string {
+	m.MethodCall(m, "CloudName")
+	m.PopNoErr()
+	return m.cloud
+}
+
+func (m *mockBackend) CloudRegion() string {
+	m.MethodCall(m, "CloudRegion")
+	m.PopNoErr()
+	return m.cloudRegion
+}
+
+func (m *mockBackend) ControllerUUID() string {
+	m.MethodCall(m, "ControllerUUID")
+	m.PopNoErr()
+	return m.controllerUUID
+}
+
+func (m *mockBackend) AgentVersion() (version.Number, error) {
+	m.MethodCall(m, "AgentVersion")
+	if err := m.NextErr(); err != nil {
+		return version.Number{}, err
+	}
+	return m.agentVersion, nil
+}
+
+func (m *mockBackend) Counts() (modelmetadata.ModelCounts, error) {
+	m.MethodCall(m, "Counts")
+	if err := m.NextErr(); err != nil {
+		return modelmetadata.ModelCounts{}, err
+	}
+	return m.counts, nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelmetadata
+
+import (
+	"github.com/juju/errors"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/core/permission"
+)
+
+// API provides the modelmetadata facade APIs for v1.
+type API struct {
+	backend    Backend
+	authorizer facade.Authorizer
+}
+
+// NewFacade provides the signature required for facade registration.
+func NewFacade(ctx facade.Context) (*API, error) {
+	backend, err := NewStateBackend(ctx.State())
+	if err != nil {
+		return nil, errors.Annotate(err, "getting state")
+	}
+	return NewAPI(backend, ctx.Auth())
+}
+
+// NewAPI returns a new modelmetadata API facade.
+func NewAPI(backend Backend, authorizer facade.Authorizer) (*API, error) {
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+	return &API{
+		backend:    backend,
+		authorizer: authorizer,
+	}, nil
+}
+
+func (api *API) checkCanRead() error {
+	allowed, err := api.authorizer.HasPermission(permission.ReadAccess, api.backend.ModelTag())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !allowed {
+		return apiservererrors.ErrPerm
+	}
+	return nil
+}
+
+// Metadata returns a compact metadata view of the model: names, UUIDs,
+// versions and headline entity counts. It is intended for integrations
+// with strict size budgets that would otherwise poll FullStatus.
+func (api *API) Metadata() (params.ModelMetadataResult, error) {
+	var result params.ModelMetadataResult
+	if err := api.checkCanRead(); err != nil {
+		return result, errors.Trace(err)
+	}
+
+	result.Name = api.backend.Name()
+	result.UUID = api.backend.ModelTag().Id()
+	result.Type = string(api.backend.Type())
+	result.ControllerUUID = api.backend.ControllerUUID()
+	result.Cloud = api.backend.CloudName()
+	result.CloudRegion = api.backend.CloudRegion()
+
+	agentVersion, err := api.backend.AgentVersion()
+	if err != nil {
+		return result, errors.Annotate(err, "getting agent version")
+	}
+	result.AgentVersion = agentVersion.String()
+
+	counts, err := api.backend.Counts()
+	if err != nil {
+		return result, errors.Annotate(err, "counting model entities")
+	}
+	result.ApplicationCount = counts.Applications
+	result.UnitCount = counts.Units
+	result.MachineCount = counts.Machines
+	return result, nil
+}
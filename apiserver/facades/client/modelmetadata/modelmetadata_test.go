@@ -0,0 +1,90 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelmetadata_test
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/version/v2"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/facades/client/modelmetadata"
+	"github.com/juju/juju/apiserver/params"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/state"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type ModelMetadataSuite struct {
+	testing.IsolationSuite
+	backend mockBackend
+
+	authorizer apiservertesting.FakeAuthorizer
+	api        *modelmetadata.API
+}
+
+var _ = gc.Suite(&ModelMetadataSuite{})
+
+func (s *ModelMetadataSuite) setAPIUser(c *gc.C, user names.UserTag) {
+	s.authorizer.Tag = user
+	api, err := modelmetadata.NewAPI(&s.backend, s.authorizer)
+	c.Assert(err, jc.ErrorIsNil)
+	s.api = api
+}
+
+func (s *ModelMetadataSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag: names.NewUserTag("admin"),
+	}
+	s.backend = mockBackend{
+		modelUUID:      coretesting.ModelTag.Id(),
+		name:           "prod",
+		modelType:      state.ModelTypeIAAS,
+		cloud:          "aws",
+		cloudRegion:    "us-east-1",
+		controllerUUID: coretesting.ControllerTag.Id(),
+		agentVersion:   version.MustParse("2.9.17"),
+		counts: modelmetadata.ModelCounts{
+			Applications: 3,
+			Units:        7,
+			Machines:     4,
+		},
+	}
+	api, err := modelmetadata.NewAPI(&s.backend, s.authorizer)
+	c.Assert(err, jc.ErrorIsNil)
+	s.api = api
+}
+
+func (s *ModelMetadataSuite) TestMetadata(c *gc.C) {
+	result, err := s.api.Metadata()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.ModelMetadataResult{
+		Name:             "prod",
+		UUID:             coretesting.ModelTag.Id(),
+		Type:             "iaas",
+		ControllerUUID:   coretesting.ControllerTag.Id(),
+		Cloud:            "aws",
+		CloudRegion:      "us-east-1",
+		AgentVersion:     "2.9.17",
+		ApplicationCount: 3,
+		UnitCount:        7,
+		MachineCount:     4,
+	})
+}
+
+func (s *ModelMetadataSuite) TestMetadataPermission(c *gc.C) {
+	s.setAPIUser(c, names.NewUserTag("mary"))
+	_, err := s.api.Metadata()
+	c.Assert(err, gc.ErrorMatches, ".*permission denied.*")
+}
+
+func (s *ModelMetadataSuite) TestMetadataCountsError(c *gc.C) {
+	// The first eight calls pop nil errors; Counts is the ninth.
+	s.backend.SetErrors(nil, nil, nil, nil, nil, nil, nil, nil, errors.New("boom"))
+	_, err := s.api.Metadata()
+	c.Assert(err, gc.ErrorMatches, "counting model entities: boom")
+}
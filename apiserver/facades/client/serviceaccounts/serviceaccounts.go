@@ -0,0 +1,210 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package serviceaccounts
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/apiserver/common"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/state"
+)
+
+// ServiceAccountsAPI implements the service accounts interface and is
+// the concrete implementation of the api end point. Service accounts
+// are non-human principals that authenticate with scoped, revocable
+// tokens rather than passwords; every API connection they make is
+// attributed to the user "<name>@serviceaccount" in the audit log.
+type ServiceAccountsAPI struct {
+	state      *state.State
+	authorizer facade.Authorizer
+	check      *common.BlockChecker
+	apiUser    names.UserTag
+}
+
+// NewServiceAccountsAPI provides the signature required for facade
+// registration.
+func NewServiceAccountsAPI(
+	st *state.State,
+	resources facade.Resources,
+	authorizer facade.Authorizer,
+) (*ServiceAccountsAPI, error) {
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+
+	// Since we know this is a user tag (because AuthClient is true),
+	// we just do the type assertion to the UserTag.
+	apiUser, _ := authorizer.GetAuthTag().(names.UserTag)
+
+	return &ServiceAccountsAPI{
+		state:      st,
+		authorizer: authorizer,
+		check:      common.NewBlockChecker(st),
+		apiUser:    apiUser,
+	}, nil
+}
+
+func (api *ServiceAccountsAPI) checkControllerAdminAccess() error {
+	isAdmin, err := api.authorizer.HasPermission(permission.SuperuserAccess, api.state.ControllerTag())
+	if err != nil && !errors.IsNotFound(err) {
+		return errors.Trace(err)
+	}
+	if !isAdmin {
+		return apiservererrors.ErrPerm
+	}
+	return nil
+}
+
+// AddServiceAccounts creates new service accounts with the supplied
+// names. The accounts start with no tokens.
+func (api *ServiceAccountsAPI) AddServiceAccounts(args params.AddServiceAccounts) (params.AddServiceAccountResults, error) {
+	var result params.AddServiceAccountResults
+	if err := api.checkControllerAdminAccess(); err != nil {
+		return result, err
+	}
+	if err := api.check.ChangeAllowed(); err != nil {
+		return result, errors.Trace(err)
+	}
+
+	result.Results = make([]params.AddServiceAccountResult, len(args.Accounts))
+	for i, arg := range args.Accounts {
+		account, err := api.state.AddServiceAccount(arg.Name, api.apiUser.Id())
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(errors.Annotate(err, "failed to create service account"))
+			continue
+		}
+		result.Results[i].Tag = account.UserTag().String()
+	}
+	return result, nil
+}
+
+// RemoveServiceAccounts removes the service accounts with the supplied
+// tags, invalidating all of their tokens.
+func (api *ServiceAccountsAPI) RemoveServiceAccounts(args params.Entities) (params.ErrorResults, error) {
+	var result params.ErrorResults
+	if err := api.checkControllerAdminAccess(); err != nil {
+		return result, err
+	}
+	if err := api.check.ChangeAllowed(); err != nil {
+		return result, errors.Trace(err)
+	}
+
+	result.Results = make([]params.ErrorResult, len(args.Entities))
+	for i, arg := range args.Entities {
+		account, err := api.getServiceAccount(arg.Tag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		if err := account.Remove(); err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return result, nil
+}
+
+// IssueTokens mints new tokens for the named service accounts, scoped
+// to the supplied models and facades. The token secrets are returned
+// exactly once and cannot be recovered later.
+func (api *ServiceAccountsAPI) IssueTokens(args params.IssueServiceAccountTokens) (params.IssueServiceAccountTokenResults, error) {
+	var result params.IssueServiceAccountTokenResults
+	if err := api.checkControllerAdminAccess(); err != nil {
+		return result, err
+	}
+	if err := api.check.ChangeAllowed(); err != nil {
+		return result, errors.Trace(err)
+	}
+
+	result.Results = make([]params.IssueServiceAccountTokenResult, len(args.Tokens))
+	for i, arg := range args.Tokens {
+		account, err := api.state.ServiceAccount(arg.Name)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		secret, token, err := account.AddToken(arg.Models, arg.Facades, arg.Expiry)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		result.Results[i].TokenId = token.ID()
+		result.Results[i].Token = secret
+	}
+	return result, nil
+}
+
+// RevokeTokens revokes the identified tokens, immediately invalidating
+// them.
+func (api *ServiceAccountsAPI) RevokeTokens(args params.RevokeServiceAccountTokens) (params.ErrorResults, error) {
+	var result params.ErrorResults
+	if err := api.checkControllerAdminAccess(); err != nil {
+		return result, err
+	}
+	if err := api.check.ChangeAllowed(); err != nil {
+		return result, errors.Trace(err)
+	}
+
+	result.Results = make([]params.ErrorResult, len(args.Tokens))
+	for i, arg := range args.Tokens {
+		account, err := api.state.ServiceAccount(arg.Name)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		if err := account.RevokeToken(arg.TokenId); err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return result, nil
+}
+
+// ListServiceAccounts returns all service accounts along with their
+// token metadata. Token secrets are never returned.
+func (api *ServiceAccountsAPI) ListServiceAccounts() (params.ServiceAccountInfoResults, error) {
+	var results params.ServiceAccountInfoResults
+	if err := api.checkControllerAdminAccess(); err != nil {
+		return results, err
+	}
+
+	accounts, err := api.state.AllServiceAccounts()
+	if err != nil {
+		return results, errors.Trace(err)
+	}
+	results.Results = make([]params.ServiceAccountInfoResult, len(accounts))
+	for i, account := range accounts {
+		info := &params.ServiceAccountInfo{
+			Name:        account.Name(),
+			Tag:         account.UserTag().String(),
+			CreatedBy:   account.CreatedBy(),
+			DateCreated: account.DateCreated(),
+		}
+		for _, token := range account.Tokens() {
+			info.Tokens = append(info.Tokens, params.ServiceAccountTokenInfo{
+				TokenId:     token.ID(),
+				Models:      token.Models(),
+				Facades:     token.Facades(),
+				DateCreated: token.DateCreated(),
+				Expiry:      token.ExpiryTime(),
+			})
+		}
+		results.Results[i] = params.ServiceAccountInfoResult{Result: info}
+	}
+	return results, nil
+}
+
+func (api *ServiceAccountsAPI) getServiceAccount(tag string) (*state.ServiceAccount, error) {
+	userTag, err := names.ParseUserTag(tag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if userTag.Domain() != state.ServiceAccountDomain {
+		return nil, errors.NotValidf("service account tag %q", tag)
+	}
+	return api.state.ServiceAccount(userTag.Name())
+}
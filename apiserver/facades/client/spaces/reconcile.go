@@ -0,0 +1,84 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package spaces
+
+import (
+	"github.com/juju/errors"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/environs/space"
+)
+
+// ReconcileSpaces is not available via the V6 API.
+func (api *APIv6) ReconcileSpaces(_, _ struct{}) {}
+
+// ReconcileSpaces compares the spaces and subnets reported by the
+// provider with those recorded in the model and returns a plan
+// describing the drift - missing subnets, stale spaces and moved
+// subnets. If Apply is true and the plan is not empty, it is applied
+// by reloading spaces from the provider.
+func (api *API) ReconcileSpaces(args params.ReconcileSpacesArgs) (params.ReconcileSpacesResult, error) {
+	var result params.ReconcileSpacesResult
+
+	canRead, err := api.auth.HasPermission(permission.ReadAccess, api.backing.ModelTag())
+	if err != nil && !errors.IsNotFound(err) {
+		return result, errors.Trace(err)
+	}
+	if !canRead {
+		return result, apiservererrors.ServerError(apiservererrors.ErrPerm)
+	}
+
+	env, err := environs.GetEnviron(api.backing, environs.New)
+	if err != nil {
+		return result, errors.Annotate(err, "getting environ")
+	}
+	netEnv, ok := environs.SupportsNetworking(env)
+	if !ok {
+		return result, errors.NotSupportedf("spaces discovery in a non-networking environ")
+	}
+	canDiscoverSpaces, err := netEnv.SupportsSpaceDiscovery(api.context)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	if !canDiscoverSpaces {
+		return result, errors.NotSupportedf("spaces discovery on this provider")
+	}
+
+	providerSpaces, err := netEnv.Spaces(api.context)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	modelSpaces, err := api.backing.AllSpaceInfos()
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+
+	plan := space.Reconcile(providerSpaces, modelSpaces)
+	for _, missing := range plan.MissingSubnets {
+		result.MissingSubnets = append(result.MissingSubnets, params.ReconcileMissingSubnet{
+			CIDR:  missing.CIDR,
+			Space: missing.SpaceName,
+		})
+	}
+	result.StaleSpaces = plan.StaleSpaces
+	for _, moved := range plan.MovedSubnets {
+		result.MovedSubnets = append(result.MovedSubnets, params.ReconcileMovedSubnet{
+			CIDR:      moved.CIDR,
+			SpaceFrom: moved.SpaceFrom,
+			SpaceTo:   moved.SpaceTo,
+		})
+	}
+
+	if args.Apply && !plan.IsEmpty() {
+		// ReloadSpaces performs its own write and block authorization.
+		if err := api.reloadSpacesAPI.ReloadSpaces(); err != nil {
+			return result, errors.Trace(err)
+		}
+		result.Applied = true
+	}
+	return result, nil
+}
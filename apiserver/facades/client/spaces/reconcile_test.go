@@ -0,0 +1,134 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package spaces
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/juju/names/v4"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	facademocks "github.com/juju/juju/apiserver/facade/mocks"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/environs"
+	environscloudspec "github.com/juju/juju/environs/cloudspec"
+	"github.com/juju/juju/environs/context"
+	environmocks "github.com/juju/juju/environs/mocks"
+)
+
+// ReconcileSpacesSuite tests the reconciliation plan API using mocks.
+type ReconcileSpacesSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&ReconcileSpacesSuite{})
+
+func (s *ReconcileSpacesSuite) setup(
+	c *gc.C, ctrl *gomock.Controller, providerSpaces network.SpaceInfos, modelSpaces network.SpaceInfos,
+) (*API, *MockReloadSpaces, func()) {
+	authorizer := facademocks.NewMockAuthorizer(ctrl)
+	authorizer.EXPECT().HasPermission(gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
+	authorizer.EXPECT().AuthClient().Return(true)
+
+	backing := NewMockBacking(ctrl)
+	bExp := backing.EXPECT()
+	bExp.ModelTag().Return(names.NewModelTag("123")).AnyTimes()
+	bExp.ModelConfig().Return(nil, nil).AnyTimes()
+	bExp.CloudSpec().Return(environscloudspec.CloudSpec{Type: "mock-provider", Name: "cloud-name"}, nil).AnyTimes()
+	bExp.AllSpaceInfos().Return(modelSpaces, nil)
+
+	mockNetworkEnviron := environmocks.NewMockNetworkingEnviron(ctrl)
+	mockNetworkEnviron.EXPECT().SupportsSpaceDiscovery(gomock.Any()).Return(true, nil)
+	mockNetworkEnviron.EXPECT().Spaces(gomock.Any()).Return(providerSpaces, nil)
+
+	mockProvider := environmocks.NewMockCloudEnvironProvider(ctrl)
+	mockProvider.EXPECT().Open(gomock.Any(), gomock.Any()).Return(mockNetworkEnviron, nil).AnyTimes()
+	unReg := environs.RegisterProvider("mock-provider", mockProvider)
+
+	reload := NewMockReloadSpaces(ctrl)
+
+	api, err := newAPIWithBacking(apiConfig{
+		ReloadSpacesAPI: reload,
+		Backing:         backing,
+		Context:         context.NewEmptyCloudCallContext(),
+		Authorizer:      authorizer,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	return api, reload, unReg
+}
+
+func (s *ReconcileSpacesSuite) TestReconcileSpacesPlanOnly(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	providerSpaces := network.SpaceInfos{{
+		Name:       "db",
+		ProviderId: "1",
+		Subnets:    []network.SubnetInfo{{CIDR: "10.0.0.0/24"}},
+	}}
+	modelSpaces := network.SpaceInfos{{
+		Name:       "db",
+		ProviderId: "1",
+	}, {
+		Name:       "old-dmz",
+		ProviderId: "2",
+	}}
+
+	api, _, unReg := s.setup(c, ctrl, providerSpaces, modelSpaces)
+	defer unReg()
+
+	result, err := api.ReconcileSpaces(params.ReconcileSpacesArgs{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.ReconcileSpacesResult{
+		MissingSubnets: []params.ReconcileMissingSubnet{{CIDR: "10.0.0.0/24", Space: "db"}},
+		StaleSpaces:    []string{"old-dmz"},
+	})
+}
+
+func (s *ReconcileSpacesSuite) TestReconcileSpacesApply(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	providerSpaces := network.SpaceInfos{{
+		Name:       "db",
+		ProviderId: "1",
+		Subnets:    []network.SubnetInfo{{CIDR: "10.0.0.0/24"}},
+	}}
+	modelSpaces := network.SpaceInfos{{
+		Name:       "db",
+		ProviderId: "1",
+	}}
+
+	api, reload, unReg := s.setup(c, ctrl, providerSpaces, modelSpaces)
+	defer unReg()
+
+	reload.EXPECT().ReloadSpaces().Return(nil)
+
+	result, err := api.ReconcileSpaces(params.ReconcileSpacesArgs{Apply: true})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.ReconcileSpacesResult{
+		MissingSubnets: []params.ReconcileMissingSubnet{{CIDR: "10.0.0.0/24", Space: "db"}},
+		Applied:        true,
+	})
+}
+
+func (s *ReconcileSpacesSuite) TestReconcileSpacesInSyncDoesNotApply(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	spaceInfos := network.SpaceInfos{{
+		Name:       "db",
+		ProviderId: "1",
+		Subnets:    []network.SubnetInfo{{CIDR: "10.0.0.0/24"}},
+	}}
+
+	api, _, unReg := s.setup(c, ctrl, spaceInfos, spaceInfos)
+	defer unReg()
+
+	result, err := api.ReconcileSpaces(params.ReconcileSpacesArgs{Apply: true})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.ReconcileSpacesResult{})
+}
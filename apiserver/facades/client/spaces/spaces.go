@@ -44,10 +44,15 @@ type APIv4 struct {
 
 // APIv5 provides the spaces API facade for version 5.
 type APIv5 struct {
+	*APIv6
+}
+
+// APIv6 provides the spaces API facade for version 6.
+type APIv6 struct {
 	*API
 }
 
-// API provides the spaces API facade for version 6.
+// API provides the spaces API facade for version 7.
 type API struct {
 	reloadSpacesAPI ReloadSpaces
 
@@ -89,13 +94,22 @@ func NewAPIv4(st *state.State, res facade.Resources, auth facade.Authorizer) (*A
 
 // NewAPIv5 is a wrapper that creates a V5 spaces API.
 func NewAPIv5(st *state.State, res facade.Resources, auth facade.Authorizer) (*APIv5, error) {
-	api, err := NewAPI(st, res, auth)
+	api, err := NewAPIv6(st, res, auth)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 	return &APIv5{api}, nil
 }
 
+// NewAPIv6 is a wrapper that creates a V6 spaces API.
+func NewAPIv6(st *state.State, res facade.Resources, auth facade.Authorizer) (*APIv6, error) {
+	api, err := NewAPI(st, res, auth)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIv6{api}, nil
+}
+
 // NewAPI creates a new Space API server-side facade with a
 // state.State backing.
 func NewAPI(st *state.State, res facade.Resources, auth facade.Authorizer) (*API, error) {
@@ -1052,7 +1052,7 @@ func (s *LegacySuite) TestCreateSpacesBlocked(c *gc.C) {
 }
 
 func (s *LegacySuite) TestCreateSpacesAPIv4(c *gc.C) {
-	apiV4 := &spaces.APIv4{APIv5: &spaces.APIv5{API: s.facade}}
+	apiV4 := &spaces.APIv4{APIv5: &spaces.APIv5{APIv6: &spaces.APIv6{API: s.facade}}}
 	results, err := apiV4.CreateSpaces(params.CreateSpacesParamsV4{
 		Spaces: []params.CreateSpaceParamsV4{
 			{
@@ -1067,7 +1067,7 @@ func (s *LegacySuite) TestCreateSpacesAPIv4(c *gc.C) {
 }
 
 func (s *LegacySuite) TestCreateSpacesAPIv4FailCIDR(c *gc.C) {
-	apiV4 := &spaces.APIv4{APIv5: &spaces.APIv5{API: s.facade}}
+	apiV4 := &spaces.APIv4{APIv5: &spaces.APIv5{APIv6: &spaces.APIv6{API: s.facade}}}
 	results, err := apiV4.CreateSpaces(params.CreateSpacesParamsV4{
 		Spaces: []params.CreateSpaceParamsV4{
 			{
@@ -1082,7 +1082,7 @@ func (s *LegacySuite) TestCreateSpacesAPIv4FailCIDR(c *gc.C) {
 }
 
 func (s *LegacySuite) TestCreateSpacesAPIv4FailTag(c *gc.C) {
-	apiV4 := &spaces.APIv4{APIv5: &spaces.APIv5{API: s.facade}}
+	apiV4 := &spaces.APIv4{APIv5: &spaces.APIv5{APIv6: &spaces.APIv6{API: s.facade}}}
 	results, err := apiV4.CreateSpaces(params.CreateSpacesParamsV4{
 		Spaces: []params.CreateSpaceParamsV4{
 			{
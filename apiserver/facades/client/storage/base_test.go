@@ -76,7 +76,9 @@ func (s *baseStorageSuite) SetUpTest(c *gc.C) {
 	s.apiv3 = &storage.StorageAPIv3{
 		StorageAPIv4: storage.StorageAPIv4{
 			StorageAPIv5: storage.StorageAPIv5{
-				StorageAPI: *newAPI,
+				StorageAPIv6: storage.StorageAPIv6{
+					StorageAPI: *newAPI,
+				},
 			},
 		},
 	}
@@ -114,6 +116,7 @@ const (
 	volumeAttachmentPlansCall               = "volumeAttachmentPlans"
 	attachStorageCall                       = "attachStorage"
 	detachStorageCall                       = "detachStorage"
+	moveStorageCall                         = "moveStorage"
 	destroyStorageInstanceCall              = "destroyStorageInstance"
 	releaseStorageInstanceCall              = "releaseStorageInstance"
 	addExistingFilesystemCall               = "addExistingFilesystem"
@@ -313,6 +316,13 @@ func (s *baseStorageSuite) constructStorageAccessor() *mockStorageAccessor {
 				names.ReadableString(unit),
 			)
 		},
+		moveStorage: func(storage names.StorageTag, machineId string) error {
+			s.stub.AddCall(moveStorageCall, storage, machineId)
+			if storage == s.storageTag {
+				return nil
+			}
+			return errors.NotFoundf("%s", names.ReadableString(storage))
+		},
 		destroyStorageInstance: func(tag names.StorageTag, destroyAttached bool, force bool) error {
 			s.stub.AddCall(destroyStorageInstanceCall, tag, destroyAttached, force)
 			return errors.New("cannae do it")
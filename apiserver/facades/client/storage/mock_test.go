@@ -70,6 +70,7 @@ type mockStorageAccessor struct {
 	releaseStorageInstance              func(names.StorageTag, bool, bool) error
 	attachStorage                       func(names.StorageTag, names.UnitTag) error
 	detachStorage                       func(names.StorageTag, names.UnitTag, bool) error
+	moveStorage                         func(names.StorageTag, string) error
 	addExistingFilesystem               func(state.FilesystemInfo, *state.VolumeInfo, string) (names.StorageTag, error)
 }
 
@@ -173,6 +174,10 @@ func (st *mockStorageAccessor) DetachStorage(storage names.StorageTag, unit name
 	return st.detachStorage(storage, unit, force)
 }
 
+func (st *mockStorageAccessor) MoveStorage(storage names.StorageTag, machineId string) error {
+	return st.moveStorage(storage, machineId)
+}
+
 func (st *mockStorageAccessor) DestroyStorageInstance(tag names.StorageTag, destroyAttached bool, force bool, maxWait time.Duration) error {
 	return st.destroyStorageInstance(tag, destroyAttached, force)
 }
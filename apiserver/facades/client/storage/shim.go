@@ -56,6 +56,10 @@ type storageInterface interface {
 	// specified tag from the unit with the specified tag.
 	DetachStorage(names.StorageTag, names.UnitTag, bool, time.Duration) error
 
+	// MoveStorage moves the storage instance with the specified tag
+	// to a unit of the same application on the given machine.
+	MoveStorage(names.StorageTag, string) error
+
 	// DestroyStorageInstance destroys the storage instance with the specified tag.
 	DestroyStorageInstance(names.StorageTag, bool, bool, time.Duration) error
 
@@ -33,7 +33,7 @@ import (
 
 type storageMetadataFunc func() (poolmanager.PoolManager, storage.ProviderRegistry, error)
 
-// StorageAPI implements the latest version (v6) of the Storage API.
+// StorageAPI implements the latest version (v7) of the Storage API.
 type StorageAPI struct {
 	backend         backend
 	storageAccess   storageAccess
@@ -43,9 +43,14 @@ type StorageAPI struct {
 	modelType       state.ModelType
 }
 
+// StorageAPIv6 implements the storage v6 API.
+type StorageAPIv6 struct {
+	StorageAPI
+}
+
 // StorageAPIv5 implements the storage v5 API.
 type StorageAPIv5 struct {
-	StorageAPI
+	StorageAPIv6
 }
 
 // StorageAPIv4 implements the storage v4 API adding AddToUnit, Import and Remove (replacing Destroy)
@@ -110,14 +115,25 @@ func newStorageAPI(
 	}
 }
 
+// NewStorageAPIV6 returns a new storage v6 API facade.
+func NewStorageAPIV6(context facade.Context) (*StorageAPIv6, error) {
+	storageAPI, err := NewStorageAPI(context)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageAPIv6{
+		StorageAPI: *storageAPI,
+	}, nil
+}
+
 // NewStorageAPIV5 returns a new storage v5 API facade.
 func NewStorageAPIV5(context facade.Context) (*StorageAPIv5, error) {
-	storageAPI, err := NewStorageAPI(context)
+	storageAPI, err := NewStorageAPIV6(context)
 	if err != nil {
 		return nil, err
 	}
 	return &StorageAPIv5{
-		StorageAPI: *storageAPI,
+		StorageAPIv6: *storageAPI,
 	}, nil
 }
 
@@ -1071,6 +1087,42 @@ func (a *StorageAPI) attachStorage(storageTag names.StorageTag, unitTag names.Un
 	return a.storageAccess.AttachStorage(storageTag, unitTag)
 }
 
+// MoveStorage moves existing storage instances to units of the same
+// application on other machines, by detaching them and reattaching once
+// the machine agent has released them. The storage provider must
+// support detaching. A "CHANGE" block can block this operation.
+func (a *StorageAPI) MoveStorage(args params.MoveStorageParams) (params.ErrorResults, error) {
+	if err := a.checkCanWrite(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	blockChecker := common.NewBlockChecker(a.backend)
+	if err := blockChecker.ChangeAllowed(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	moveOne := func(arg params.MoveStorageParam) error {
+		storageTag, err := names.ParseStorageTag(arg.StorageTag)
+		if err != nil {
+			return err
+		}
+		machineTag, err := names.ParseMachineTag(arg.MachineTag)
+		if err != nil {
+			return err
+		}
+		return a.storageAccess.MoveStorage(storageTag, machineTag.Id())
+	}
+
+	result := make([]params.ErrorResult, len(args.Moves))
+	for i, arg := range args.Moves {
+		result[i].Error = apiservererrors.ServerError(moveOne(arg))
+	}
+	return params.ErrorResults{Results: result}, nil
+}
+
+// MoveStorage is not available in v6.
+func (a *StorageAPIv6) MoveStorage(_ struct{}) {}
+
 // Import imports existing storage into the model.
 // A "CHANGE" block can block this operation.
 func (a *StorageAPI) Import(args params.BulkImportStorageParams) (params.ImportStorageResults, error) {
@@ -0,0 +1,116 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package unitmover
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/state"
+)
+
+// API implements the unit mover facade, used to reassign units to other
+// machines in the same model.
+type API struct {
+	state      *state.State
+	authorizer facade.Authorizer
+}
+
+// NewFacade creates a new server-side unit mover API end point.
+func NewFacade(ctx facade.Context) (*API, error) {
+	authorizer := ctx.Auth()
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+	return &API{
+		state:      ctx.State(),
+		authorizer: authorizer,
+	}, nil
+}
+
+func (api *API) checkPermission(access permission.Access) error {
+	admin, err := api.authorizer.HasPermission(permission.SuperuserAccess, api.state.ControllerTag())
+	if err != nil && !errors.IsNotFound(err) {
+		return errors.Trace(err)
+	}
+	if !admin {
+		model, err := api.state.Model()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		admin, err = api.authorizer.HasPermission(access, model.ModelTag())
+		if err != nil && !errors.IsNotFound(err) {
+			return errors.Trace(err)
+		}
+	}
+	if !admin {
+		return apiservererrors.ErrPerm
+	}
+	return nil
+}
+
+// MoveUnits schedules each of the specified units to be replaced by a
+// new unit of the same application on the named target machine.
+func (api *API) MoveUnits(args params.MoveUnitsParams) (params.MoveUnitResults, error) {
+	if err := api.checkPermission(permission.WriteAccess); err != nil {
+		return params.MoveUnitResults{}, errors.Trace(err)
+	}
+	results := params.MoveUnitResults{
+		Results: make([]params.MoveUnitResult, len(args.Units)),
+	}
+	for i, arg := range args.Units {
+		move, err := api.moveUnit(arg)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].MoveId = move.Id()
+		results.Results[i].NewUnit = move.NewUnit()
+	}
+	return results, nil
+}
+
+func (api *API) moveUnit(arg params.MoveUnitParams) (*state.UnitMove, error) {
+	unitTag, err := names.ParseUnitTag(arg.UnitTag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	machineTag, err := names.ParseMachineTag(arg.MachineTag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return api.state.MoveUnit(unitTag.Id(), machineTag.Id())
+}
+
+// UnitMoves returns the progress of all unit move operations in the
+// model, oldest first.
+func (api *API) UnitMoves() (params.UnitMoveResults, error) {
+	if err := api.checkPermission(permission.ReadAccess); err != nil {
+		return params.UnitMoveResults{}, errors.Trace(err)
+	}
+	moves, err := api.state.UnitMoves()
+	if err != nil {
+		return params.UnitMoveResults{}, errors.Trace(err)
+	}
+	results := params.UnitMoveResults{
+		Results: make([]params.UnitMoveResult, len(moves)),
+	}
+	for i, move := range moves {
+		results.Results[i] = params.UnitMoveResult{
+			MoveId:        move.Id(),
+			OldUnit:       move.OldUnit(),
+			NewUnit:       move.NewUnit(),
+			TargetMachine: move.TargetMachine(),
+			Status:        string(move.Status()),
+			Message:       move.Message(),
+			Started:       move.Started(),
+			Completed:     move.Completed(),
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package unitmover_test
+
+import (
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/facade/facadetest"
+	"github.com/juju/juju/apiserver/facades/client/unitmover"
+	"github.com/juju/juju/apiserver/params"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/juju/testing"
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/testing/factory"
+)
+
+type moverSuite struct {
+	testing.JujuConnSuite
+
+	authorizer apiservertesting.FakeAuthorizer
+	api        *unitmover.API
+}
+
+var _ = gc.Suite(&moverSuite{})
+
+func (s *moverSuite) SetUpTest(c *gc.C) {
+	s.JujuConnSuite.SetUpTest(c)
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag:      s.AdminUserTag(c),
+		AdminTag: s.AdminUserTag(c),
+	}
+	api, err := unitmover.NewFacade(facadetest.Context{
+		State_: s.State,
+		Auth_:  s.authorizer,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	s.api = api
+}
+
+func (s *moverSuite) TestMoveUnits(c *gc.C) {
+	machine := s.Factory.MakeMachine(c, nil)
+	target := s.Factory.MakeMachine(c, nil)
+	unit := s.Factory.MakeUnit(c, &factory.UnitParams{Machine: machine})
+
+	results, err := s.api.MoveUnits(params.MoveUnitsParams{
+		Units: []params.MoveUnitParams{{
+			UnitTag:    unit.Tag().String(),
+			MachineTag: target.Tag().String(),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+
+	move, err := s.State.UnitMove(results.Results[0].MoveId)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(move.Status(), gc.Equals, state.UnitMoveWaiting)
+	c.Assert(move.OldUnit(), gc.Equals, unit.Name())
+	c.Assert(move.TargetMachine(), gc.Equals, target.Id())
+
+	newUnit, err := s.State.Unit(results.Results[0].NewUnit)
+	c.Assert(err, jc.ErrorIsNil)
+	assignedId, err := newUnit.AssignedMachineId()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(assignedId, gc.Equals, target.Id())
+
+	moves, err := s.api.UnitMoves()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(moves.Results, gc.HasLen, 1)
+	c.Assert(moves.Results[0].MoveId, gc.Equals, move.Id())
+	c.Assert(moves.Results[0].NewUnit, gc.Equals, newUnit.Name())
+}
+
+func (s *moverSuite) TestMoveUnitsInvalidTag(c *gc.C) {
+	results, err := s.api.MoveUnits(params.MoveUnitsParams{
+		Units: []params.MoveUnitParams{{
+			UnitTag:    "not-a-tag",
+			MachineTag: names.NewMachineTag("0").String(),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.ErrorMatches, `"not-a-tag" is not a valid tag`)
+}
+
+func (s *moverSuite) TestMoveUnitsPermission(c *gc.C) {
+	authorizer := apiservertesting.FakeAuthorizer{
+		Tag: names.NewUserTag("fred"),
+	}
+	api, err := unitmover.NewFacade(facadetest.Context{
+		State_: s.State,
+		Auth_:  authorizer,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = api.MoveUnits(params.MoveUnitsParams{})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
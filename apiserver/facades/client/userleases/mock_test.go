@@ -0,0 +1,52 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package userleases_test
+
+import (
+	"time"
+
+	jtesting "github.com/juju/testing"
+)
+
+type mockClaimer struct {
+	jtesting.Stub
+	claims map[string]string
+}
+
+func (m *mockClaimer) Claim(leaseName, holderName string, duration time.Duration) error {
+	m.MethodCall(m, "Claim", leaseName, holderName, duration)
+	if err := m.NextErr(); err != nil {
+		return err
+	}
+	if m.claims == nil {
+		m.claims = make(map[string]string)
+	}
+	m.claims[leaseName] = holderName
+	return nil
+}
+
+func (m *mockClaimer) WaitUntilExpired(leaseName string, cancel <-chan struct{}) error {
+	m.MethodCall(m, "WaitUntilExpired", leaseName, cancel)
+	return m.NextErr()
+}
+
+type mockRevoker struct {
+	jtesting.Stub
+}
+
+func (m *mockRevoker) Revoke(leaseName, holderName string) error {
+	m.MethodCall(m, "Revoke", leaseName, holderName)
+	return m.NextErr()
+}
+
+type mockReader struct {
+	jtesting.Stub
+	leases map[string]string
+}
+
+func (m *mockReader) Leases() map[string]string {
+	m.MethodCall(m, "Leases")
+	m.PopNoErr()
+	return m.leases
+}
@@ -0,0 +1,168 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package userleases
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/core/lease"
+	"github.com/juju/juju/core/permission"
+)
+
+// API provides the user leases facade APIs for v1. It lets operators
+// claim custom singleton leases in the "user" lease namespace, with the
+// same expiry guarantees as application leadership.
+type API struct {
+	authorizer facade.Authorizer
+	modelTag   names.ModelTag
+	claimer    lease.Claimer
+	revoker    lease.Revoker
+	reader     lease.Reader
+}
+
+// NewFacade provides the signature required for facade registration.
+func NewFacade(ctx facade.Context) (*API, error) {
+	m, err := ctx.State().Model()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	claimer, err := ctx.UserLeaseClaimer()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	revoker, err := ctx.UserLeaseRevoker()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	reader, err := ctx.UserLeaseReader()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return NewAPI(ctx.Auth(), m.ModelTag(), claimer, revoker, reader)
+}
+
+// NewAPI returns a new user leases API facade.
+func NewAPI(
+	authorizer facade.Authorizer,
+	modelTag names.ModelTag,
+	claimer lease.Claimer,
+	revoker lease.Revoker,
+	reader lease.Reader,
+) (*API, error) {
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+	return &API{
+		authorizer: authorizer,
+		modelTag:   modelTag,
+		claimer:    claimer,
+		revoker:    revoker,
+		reader:     reader,
+	}, nil
+}
+
+func (api *API) checkAccess(access permission.Access) error {
+	allowed, err := api.authorizer.HasPermission(access, api.modelTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !allowed {
+		return apiservererrors.ErrPerm
+	}
+	return nil
+}
+
+// holder returns the lease holder name used for the requesting entity.
+func (api *API) holder() string {
+	return api.authorizer.GetAuthTag().String()
+}
+
+// ClaimLeases acquires or extends the named user leases on behalf of
+// the requesting entity. A claim that succeeds guarantees the holder
+// the lease for at least the requested duration; a denied claim means
+// another holder has it.
+func (api *API) ClaimLeases(args params.UserLeaseClaims) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Claims)),
+	}
+	if err := api.checkAccess(permission.WriteAccess); err != nil {
+		return result, errors.Trace(err)
+	}
+	for i, claim := range args.Claims {
+		err := api.claim(claim)
+		result.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return result, nil
+}
+
+func (api *API) claim(claim params.UserLeaseClaim) error {
+	if claim.Name == "" {
+		return errors.NotValidf("empty lease name")
+	}
+	if !allowedDuration(claim.Duration) {
+		return errors.NotValidf("lease duration %v", claim.Duration)
+	}
+	return api.claimer.Claim(claim.Name, api.holder(), claim.Duration)
+}
+
+// ReleaseLeases releases the named user leases held by the requesting
+// entity.
+func (api *API) ReleaseLeases(args params.UserLeaseNames) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Names)),
+	}
+	if err := api.checkAccess(permission.WriteAccess); err != nil {
+		return result, errors.Trace(err)
+	}
+	for i, name := range args.Names {
+		err := api.revoker.Revoke(name, api.holder())
+		result.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return result, nil
+}
+
+// WaitUntilExpired returns for each named lease once it is no longer
+// held, allowing callers to watch for expiry before making their own
+// claim.
+func (api *API) WaitUntilExpired(ctx context.Context, args params.UserLeaseNames) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Names)),
+	}
+	if err := api.checkAccess(permission.ReadAccess); err != nil {
+		return result, errors.Trace(err)
+	}
+	for i, name := range args.Names {
+		err := api.claimer.WaitUntilExpired(name, ctx.Done())
+		result.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return result, nil
+}
+
+// Leases returns all user leases in the model with their holders.
+func (api *API) Leases() (params.UserLeasesResult, error) {
+	var result params.UserLeasesResult
+	if err := api.checkAccess(permission.ReadAccess); err != nil {
+		return result, errors.Trace(err)
+	}
+	result.Leases = api.reader.Leases()
+	return result, nil
+}
+
+// allowedDuration returns true if the supplied duration is at least one
+// second, and no more than five minutes. User workloads wanting
+// long-lived singletons are expected to extend their leases, just as
+// unit agents do for leadership.
+func allowedDuration(duration time.Duration) bool {
+	if duration < time.Second {
+		return false
+	}
+	return duration <= 5*time.Minute
+}
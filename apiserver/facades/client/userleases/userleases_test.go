@@ -0,0 +1,141 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package userleases_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/names/v4"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/facades/client/userleases"
+	"github.com/juju/juju/apiserver/params"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type UserLeasesSuite struct {
+	testing.IsolationSuite
+
+	claimer    mockClaimer
+	revoker    mockRevoker
+	reader     mockReader
+	authorizer apiservertesting.FakeAuthorizer
+	api        *userleases.API
+}
+
+var _ = gc.Suite(&UserLeasesSuite{})
+
+func (s *UserLeasesSuite) setAPIUser(c *gc.C, user names.UserTag) {
+	s.authorizer.Tag = user
+	api, err := userleases.NewAPI(
+		s.authorizer,
+		coretesting.ModelTag,
+		&s.claimer,
+		&s.revoker,
+		&s.reader,
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	s.api = api
+}
+
+func (s *UserLeasesSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.claimer = mockClaimer{}
+	s.revoker = mockRevoker{}
+	s.reader = mockReader{}
+	s.setAPIUser(c, names.NewUserTag("admin"))
+}
+
+func (s *UserLeasesSuite) TestClaimLeases(c *gc.C) {
+	result, err := s.api.ClaimLeases(params.UserLeaseClaims{
+		Claims: []params.UserLeaseClaim{{
+			Name:     "cron-leader",
+			Duration: time.Minute,
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{{}},
+	})
+	s.claimer.CheckCalls(c, []testing.StubCall{{
+		"Claim", []interface{}{"cron-leader", "user-admin", time.Minute},
+	}})
+}
+
+func (s *UserLeasesSuite) TestClaimLeasesBadDuration(c *gc.C) {
+	result, err := s.api.ClaimLeases(params.UserLeaseClaims{
+		Claims: []params.UserLeaseClaim{{
+			Name:     "cron-leader",
+			Duration: time.Hour,
+		}, {
+			Name: "cron-leader",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 2)
+	c.Assert(result.Results[0].Error, gc.ErrorMatches, `lease duration 1h0m0s not valid`)
+	c.Assert(result.Results[1].Error, gc.ErrorMatches, `lease duration 0s not valid`)
+	s.claimer.CheckCallNames(c)
+}
+
+func (s *UserLeasesSuite) TestClaimLeasesEmptyName(c *gc.C) {
+	result, err := s.api.ClaimLeases(params.UserLeaseClaims{
+		Claims: []params.UserLeaseClaim{{
+			Duration: time.Minute,
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 1)
+	c.Assert(result.Results[0].Error, gc.ErrorMatches, `empty lease name not valid`)
+	s.claimer.CheckCallNames(c)
+}
+
+func (s *UserLeasesSuite) TestClaimLeasesPermission(c *gc.C) {
+	s.setAPIUser(c, names.NewUserTag("mary"))
+	_, err := s.api.ClaimLeases(params.UserLeaseClaims{
+		Claims: []params.UserLeaseClaim{{
+			Name:     "cron-leader",
+			Duration: time.Minute,
+		}},
+	})
+	c.Assert(err, gc.ErrorMatches, ".*permission denied.*")
+	s.claimer.CheckCallNames(c)
+}
+
+func (s *UserLeasesSuite) TestReleaseLeases(c *gc.C) {
+	result, err := s.api.ReleaseLeases(params.UserLeaseNames{
+		Names: []string{"cron-leader"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{{}},
+	})
+	s.revoker.CheckCalls(c, []testing.StubCall{{
+		"Revoke", []interface{}{"cron-leader", "user-admin"},
+	}})
+}
+
+func (s *UserLeasesSuite) TestWaitUntilExpired(c *gc.C) {
+	result, err := s.api.WaitUntilExpired(context.Background(), params.UserLeaseNames{
+		Names: []string{"cron-leader"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{{}},
+	})
+	s.claimer.CheckCallNames(c, "WaitUntilExpired")
+}
+
+func (s *UserLeasesSuite) TestLeases(c *gc.C) {
+	s.reader.leases = map[string]string{"cron-leader": "user-admin"}
+	result, err := s.api.Leases()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Leases, jc.DeepEquals, map[string]string{
+		"cron-leader": "user-admin",
+	})
+}
@@ -392,6 +392,20 @@ func (mr *MockContextMockRecorder) LeadershipRevoker(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeadershipRevoker", reflect.TypeOf((*MockContext)(nil).LeadershipRevoker), arg0)
 }
 
+// LogNoise mocks base method.
+func (m *MockContext) LogNoise() facade.LogNoise {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogNoise")
+	ret0, _ := ret[0].(facade.LogNoise)
+	return ret0
+}
+
+// LogNoise indicates an expected call of LogNoise.
+func (mr *MockContextMockRecorder) LogNoise() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogNoise", reflect.TypeOf((*MockContext)(nil).LogNoise))
+}
+
 // MultiwatcherFactory mocks base method.
 func (m *MockContext) MultiwatcherFactory() multiwatcher.Factory {
 	m.ctrl.T.Helper()
@@ -505,6 +519,51 @@ func (mr *MockContextMockRecorder) StatePool() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatePool", reflect.TypeOf((*MockContext)(nil).StatePool))
 }
 
+// UserLeaseClaimer mocks base method.
+func (m *MockContext) UserLeaseClaimer() (lease.Claimer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserLeaseClaimer")
+	ret0, _ := ret[0].(lease.Claimer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserLeaseClaimer indicates an expected call of UserLeaseClaimer.
+func (mr *MockContextMockRecorder) UserLeaseClaimer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserLeaseClaimer", reflect.TypeOf((*MockContext)(nil).UserLeaseClaimer))
+}
+
+// UserLeaseReader mocks base method.
+func (m *MockContext) UserLeaseReader() (lease.Reader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserLeaseReader")
+	ret0, _ := ret[0].(lease.Reader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserLeaseReader indicates an expected call of UserLeaseReader.
+func (mr *MockContextMockRecorder) UserLeaseReader() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserLeaseReader", reflect.TypeOf((*MockContext)(nil).UserLeaseReader))
+}
+
+// UserLeaseRevoker mocks base method.
+func (m *MockContext) UserLeaseRevoker() (lease.Revoker, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserLeaseRevoker")
+	ret0, _ := ret[0].(lease.Revoker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserLeaseRevoker indicates an expected call of UserLeaseRevoker.
+func (mr *MockContextMockRecorder) UserLeaseRevoker() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserLeaseRevoker", reflect.TypeOf((*MockContext)(nil).UserLeaseRevoker))
+}
+
 // MockResources is a mock of Resources interface.
 type MockResources struct {
 	ctrl     *gomock.Controller
@@ -151,9 +151,11 @@ type mockApplication struct {
 	scale      int
 	units      []caasunitprovisioner.Unit
 	ops        *state.UpdateUnitsOperation
-	providerId string
-	addresses  []network.SpaceAddress
-	charm      *mockCharm
+	providerId      string
+	addresses       []network.SpaceAddress
+	externalNames   []string
+	portAnnotations map[string]map[string]string
+	charm           *mockCharm
 }
 
 func (a *mockApplication) Tag() names.Tag {
@@ -267,6 +269,17 @@ func (m *mockApplication) UpdateCloudService(providerId string, addresses []netw
 	return nil
 }
 
+func (m *mockApplication) UpdateCloudServiceInfo(
+	providerId string, addresses []network.SpaceAddress,
+	externalNames []string, portAnnotations map[string]map[string]string,
+) error {
+	m.providerId = providerId
+	m.addresses = addresses
+	m.externalNames = externalNames
+	m.portAnnotations = portAnnotations
+	return nil
+}
+
 var addOp = &state.AddUnitOperation{}
 
 func (m *mockApplication) AddOperation(props state.UnitUpdateProperties) *state.AddUnitOperation {
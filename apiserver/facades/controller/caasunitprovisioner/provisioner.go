@@ -1459,7 +1459,9 @@ func (f *Facade) UpdateApplicationsService(args params.UpdateApplicationServiceA
 			continue
 		}
 
-		if err := app.UpdateCloudService(appUpdate.ProviderId, sAddrs); err != nil {
+		if err := app.UpdateCloudServiceInfo(
+			appUpdate.ProviderId, sAddrs, appUpdate.ExternalNames, appUpdate.PortAnnotations,
+		); err != nil {
 			result.Results[i].Error = apiservererrors.ServerError(err)
 		}
 		if appUpdate.Scale != nil {
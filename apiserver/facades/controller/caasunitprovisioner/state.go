@@ -81,6 +81,10 @@ type Application interface {
 	AddOperation(state.UnitUpdateProperties) *state.AddUnitOperation
 	UpdateUnits(*state.UpdateUnitsOperation) error
 	UpdateCloudService(providerId string, addresses []network.SpaceAddress) error
+	UpdateCloudServiceInfo(
+		providerId string, addresses []network.SpaceAddress,
+		externalNames []string, portAnnotations map[string]map[string]string,
+	) error
 	StorageConstraints() (map[string]state.StorageConstraints, error)
 	DeviceConstraints() (map[string]state.DeviceConstraints, error)
 	Life() state.Life
@@ -17,6 +17,7 @@ import (
 	"github.com/juju/juju/apiserver/facades/client/charms/services"
 	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/charmhub"
+	corecharmdownloader "github.com/juju/juju/core/charm/downloader"
 	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/state/storage"
 	"github.com/juju/juju/state/watcher"
@@ -186,10 +187,16 @@ func (a *CharmDownloaderAPI) downloadApplicationCharm(appTag names.ApplicationTa
 		// to report the download error back. Also, we use a fairly
 		// generic error message instead of the actual error to avoid
 		// accidentally leaking any auth-related details that may be
-		// contained in the error.
+		// contained in the error. Attestation failures are called out
+		// explicitly so operators can tell a provenance problem from
+		// a transient download one.
+		message := "unable to download charm"
+		if corecharmdownloader.IsCharmVerificationFailedError(err) {
+			message = "charm verification failed"
+		}
 		statusErr := app.SetStatus(status.StatusInfo{
 			Status:  status.Blocked,
-			Message: "unable to download charm",
+			Message: message,
 			Since:   &now,
 		})
 		if statusErr != nil {
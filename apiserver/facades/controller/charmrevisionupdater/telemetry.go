@@ -0,0 +1,135 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package charmrevisionupdater
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/juju/charm/v9"
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/params"
+	charmmetrics "github.com/juju/juju/core/charm/metrics"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/state"
+)
+
+// TelemetryAPI is a read-only facade that previews the telemetry payload
+// the charm revision updater would send to charmhub. It lives alongside
+// the updater so the preview is built by the same code that builds the
+// real request.
+type TelemetryAPI struct {
+	state State
+}
+
+// NewTelemetryFacade creates a new server-side Telemetry facade.
+func NewTelemetryFacade(st *state.State, resources facade.Resources, authorizer facade.Authorizer) (*TelemetryAPI, error) {
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+	canRead, err := authorizer.HasPermission(permission.ReadAccess, names.NewModelTag(st.ModelUUID()))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !canRead {
+		return nil, apiservererrors.ErrPerm
+	}
+	return NewTelemetryAPIState(StateShim{State: st})
+}
+
+// NewTelemetryAPIState creates a new Telemetry API with a State interface
+// directly (mainly for use in tests).
+func NewTelemetryAPIState(state State) (*TelemetryAPI, error) {
+	return &TelemetryAPI{state: state}, nil
+}
+
+// PreviewCharmhubMetrics returns the metrics that the next periodic charm
+// revision update would report to charmhub under the model's current
+// telemetry configuration.
+func (api *TelemetryAPI) PreviewCharmhubMetrics() (params.TelemetryPreviewResult, error) {
+	model, err := api.state.Model()
+	if err != nil {
+		return params.TelemetryPreviewResult{}, errors.Trace(err)
+	}
+	cfg, err := model.Config()
+	if err != nil {
+		return params.TelemetryPreviewResult{}, errors.Trace(err)
+	}
+	if !cfg.Telemetry() {
+		return params.TelemetryPreviewResult{}, nil
+	}
+	result := params.TelemetryPreviewResult{Enabled: true}
+
+	metadata, err := charmhubRequestMetadata(api.state)
+	if err != nil {
+		return params.TelemetryPreviewResult{}, errors.Trace(err)
+	}
+	for _, group := range []charmmetrics.MetricKey{charmmetrics.Controller, charmmetrics.Model} {
+		groupMetrics, ok := metadata[group]
+		if !ok {
+			continue
+		}
+		metrics := make(map[string]string, len(groupMetrics))
+		for k, v := range groupMetrics {
+			metrics[k.String()] = v
+		}
+		result.Groups = append(result.Groups, params.TelemetryMetricGroup{
+			Group:   group.String(),
+			Metrics: metrics,
+		})
+	}
+
+	if cfg.TelemetryMetricGroupEnabled(charmmetrics.Charm.String()) {
+		result.Applications, err = api.applicationMetrics()
+		if err != nil {
+			return params.TelemetryPreviewResult{}, errors.Trace(err)
+		}
+	}
+	return result, nil
+}
+
+// applicationMetrics assembles the per-application metrics included in
+// each refresh request context, mirroring the applications the updater
+// would ask charmhub about.
+func (api *TelemetryAPI) applicationMetrics() ([]params.TelemetryApplicationMetrics, error) {
+	applications, err := api.state.AllApplications()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var appInfos []appInfo
+	var result []params.TelemetryApplicationMetrics
+	for _, application := range applications {
+		curl, _ := application.CharmURL()
+		if !charm.CharmHub.Matches(curl.Schema) {
+			continue
+		}
+		origin := application.CharmOrigin()
+		if origin == nil || origin.ID == "" {
+			// The updater skips these applications too.
+			continue
+		}
+		appInfos = append(appInfos, appInfo{
+			id:       application.ApplicationTag().Id(),
+			charmURL: curl,
+		})
+		result = append(result, params.TelemetryApplicationMetrics{
+			Application: application.ApplicationTag().Id(),
+			Metrics: map[string]string{
+				charmmetrics.NumUnits.String(): strconv.Itoa(application.UnitCount()),
+			},
+		})
+	}
+	relations := convertRelations(appInfos, api.state.AliveRelationKeys())
+	for i, app := range result {
+		if related, ok := relations[app.Application]; ok {
+			app.Metrics[charmmetrics.Relations.String()] = strings.Join(related, ",")
+			result[i] = app
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package charmrevisionupdater_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/facades/controller/charmrevisionupdater"
+	"github.com/juju/juju/apiserver/facades/controller/charmrevisionupdater/mocks"
+	"github.com/juju/juju/apiserver/params"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/testing"
+	jujuversion "github.com/juju/juju/version"
+)
+
+type telemetrySuite struct {
+	model *mocks.MockModel
+	state *mocks.MockState
+}
+
+var _ = gc.Suite(&telemetrySuite{})
+
+func (s *telemetrySuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.model = mocks.NewMockModel(ctrl)
+	s.state = mocks.NewMockState(ctrl)
+	s.state.EXPECT().Model().Return(s.model, nil).AnyTimes()
+	return ctrl
+}
+
+func (s *telemetrySuite) TestNewTelemetryFacadeAuthFailure(c *gc.C) {
+	authoriser := apiservertesting.FakeAuthorizer{Tag: names.NewMachineTag("0")}
+	api, err := charmrevisionupdater.NewTelemetryFacade(nil, nil, authoriser)
+	c.Assert(api, gc.IsNil)
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
+
+func (s *telemetrySuite) TestPreviewTelemetryDisabled(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+	cfg, err := config.New(config.UseDefaults, map[string]interface{}{
+		"name":                     "model",
+		"type":                     "type",
+		"uuid":                     testing.ModelTag.Id(),
+		config.DisableTelemetryKey: true,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	s.model.EXPECT().Config().Return(cfg, nil)
+
+	api, err := charmrevisionupdater.NewTelemetryAPIState(s.state)
+	c.Assert(err, jc.ErrorIsNil)
+
+	result, err := api.PreviewCharmhubMetrics()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.TelemetryPreviewResult{Enabled: false})
+}
+
+func (s *telemetrySuite) TestPreview(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+	uuid := testing.ModelTag.Id()
+	cfg, err := config.New(config.UseDefaults, map[string]interface{}{
+		"name": "model",
+		"type": "type",
+		"uuid": uuid,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	s.model.EXPECT().Config().Return(cfg, nil).Times(2)
+	s.model.EXPECT().Metrics().Return(state.ModelMetrics{
+		UUID:           uuid,
+		ControllerUUID: "controller-1",
+		CloudName:      "cloud",
+	}, nil)
+	s.state.EXPECT().AllApplications().Return([]charmrevisionupdater.Application{
+		makeApplication(ctrl, "ch", "mysql", "charm-1", "app-1", 22),
+		makeApplication(ctrl, "ch", "postgresql", "charm-2", "app-2", 41),
+	}, nil)
+	s.state.EXPECT().AliveRelationKeys().Return([]string{
+		"app-1:end app-2:point",
+	})
+
+	api, err := charmrevisionupdater.NewTelemetryAPIState(s.state)
+	c.Assert(err, jc.ErrorIsNil)
+
+	result, err := api.PreviewCharmhubMetrics()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.TelemetryPreviewResult{
+		Enabled: true,
+		Groups: []params.TelemetryMetricGroup{{
+			Group: "controller",
+			Metrics: map[string]string{
+				"juju-version": jujuversion.Current.String(),
+				"uuid":         "controller-1",
+			},
+		}, {
+			Group: "model",
+			Metrics: map[string]string{
+				"applications": "",
+				"cloud":        "cloud",
+				"machines":     "",
+				"provider":     "",
+				"region":       "",
+				"units":        "",
+				"uuid":         uuid,
+			},
+		}},
+		Applications: []params.TelemetryApplicationMetrics{{
+			Application: "app-1",
+			Metrics: map[string]string{
+				"units":     "2",
+				"relations": "postgresql",
+			},
+		}, {
+			Application: "app-2",
+			Metrics: map[string]string{
+				"units":     "2",
+				"relations": "mysql",
+			},
+		}},
+	})
+}
+
+func (s *telemetrySuite) TestPreviewCharmGroupDisabled(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+	uuid := testing.ModelTag.Id()
+	cfg, err := config.New(config.UseDefaults, map[string]interface{}{
+		"name":                          "model",
+		"type":                          "type",
+		"uuid":                          uuid,
+		config.TelemetryMetricGroupsKey: "controller",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	s.model.EXPECT().Config().Return(cfg, nil).Times(2)
+	s.model.EXPECT().Metrics().Return(state.ModelMetrics{
+		UUID:           uuid,
+		ControllerUUID: "controller-1",
+		CloudName:      "cloud",
+	}, nil)
+
+	api, err := charmrevisionupdater.NewTelemetryAPIState(s.state)
+	c.Assert(err, jc.ErrorIsNil)
+
+	result, err := api.PreviewCharmhubMetrics()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.TelemetryPreviewResult{
+		Enabled: true,
+		Groups: []params.TelemetryMetricGroup{{
+			Group: "controller",
+			Metrics: map[string]string{
+				"juju-version": jujuversion.Current.String(),
+				"uuid":         "controller-1",
+			},
+		}},
+	})
+}
@@ -5,6 +5,8 @@ package charmrevisionupdater
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"strconv"
 	"strings"
 	"time"
@@ -153,6 +155,9 @@ func (api *CharmRevisionUpdaterAPI) retrieveLatestCharmInfo() ([]latestCharmInfo
 		return nil, errors.Trace(err)
 	}
 	telemetry := cfg.Telemetry()
+	// Per-application metrics have their own group so operators can
+	// report controller and model metrics without describing workloads.
+	charmTelemetry := telemetry && cfg.TelemetryMetricGroupEnabled(charmmetrics.Charm.String())
 
 	// If there are no applications, exit now, check telemetry for additional work.
 	if len(applications) == 0 {
@@ -201,7 +206,7 @@ func (api *CharmRevisionUpdaterAPI) retrieveLatestCharmInfo() ([]latestCharmInfo
 				arch:        origin.Platform.Architecture,
 				instanceKey: charmhub.CreateInstanceKey(application.ApplicationTag(), model.ModelTag()),
 			}
-			if telemetry {
+			if charmTelemetry {
 				cid.metrics = map[charmmetrics.MetricKey]string{
 					charmmetrics.NumUnits: strconv.Itoa(application.UnitCount()),
 				}
@@ -223,7 +228,7 @@ func (api *CharmRevisionUpdaterAPI) retrieveLatestCharmInfo() ([]latestCharmInfo
 				URL:     curl,
 				Channel: application.Channel(),
 			}
-			if telemetry {
+			if charmTelemetry {
 				cid.Metadata = map[string]string{
 					"series": origin.Platform.Series,
 					"arch":   origin.Platform.Architecture,
@@ -249,7 +254,7 @@ func (api *CharmRevisionUpdaterAPI) retrieveLatestCharmInfo() ([]latestCharmInfo
 		latest = append(latest, storeLatest...)
 	}
 	if len(charmhubIDs) > 0 {
-		if telemetry {
+		if charmTelemetry {
 			charmhubIDs, err = api.addMetricsToCharmhubInfos(charmhubIDs, charmhubApps)
 			if err != nil {
 				return nil, errors.Trace(err)
@@ -293,6 +298,10 @@ func (api *CharmRevisionUpdaterAPI) sendEmptyModelMetrics() error {
 	if err != nil {
 		return errors.Trace(err)
 	}
+	if len(requestMetrics) == 0 {
+		// Every metric group has been disabled; there is nothing to send.
+		return nil
+	}
 	client, err := api.newCharmhubClient(api.state)
 	if err != nil {
 		return errors.Trace(err)
@@ -474,7 +483,9 @@ func charmstoreApiMetadata(st State) (map[string]string, error) {
 }
 
 // charmstoreApiMetadata returns a map containing metadata key/value pairs to
-// send to the charmhub for tracking metrics.
+// send to the charmhub for tracking metrics. The metadata honours the
+// model's telemetry settings: disabled metric groups are omitted and,
+// when configured, UUIDs are replaced with stable pseudonyms.
 func charmhubRequestMetadata(st State) (map[charmmetrics.MetricKey]map[charmmetrics.MetricKey]string, error) {
 	model, err := st.Model()
 	if err != nil {
@@ -485,15 +496,26 @@ func charmhubRequestMetadata(st State) (map[charmmetrics.MetricKey]map[charmmetr
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	cfg, err := model.Config()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	controllerUUID := metrics.ControllerUUID
+	modelUUID := metrics.UUID
+	if cfg.TelemetryPseudonymiseUUIDs() {
+		controllerUUID = pseudonymiseUUID(controllerUUID)
+		modelUUID = pseudonymiseUUID(modelUUID)
+	}
 
 	metadata := map[charmmetrics.MetricKey]map[charmmetrics.MetricKey]string{
 		charmmetrics.Controller: {
 			charmmetrics.JujuVersion: version.Current.String(),
-			charmmetrics.UUID:        metrics.ControllerUUID,
+			charmmetrics.UUID:        controllerUUID,
 		},
 		charmmetrics.Model: {
 			charmmetrics.Cloud:           metrics.CloudName,
-			charmmetrics.UUID:            metrics.UUID,
+			charmmetrics.UUID:            modelUUID,
 			charmmetrics.NumApplications: metrics.ApplicationCount,
 			charmmetrics.NumMachines:     metrics.MachineCount,
 			charmmetrics.NumUnits:        metrics.UnitCount,
@@ -501,6 +523,18 @@ func charmhubRequestMetadata(st State) (map[charmmetrics.MetricKey]map[charmmetr
 			charmmetrics.Region:          metrics.CloudRegion,
 		},
 	}
+	for _, group := range []charmmetrics.MetricKey{charmmetrics.Controller, charmmetrics.Model} {
+		if !cfg.TelemetryMetricGroupEnabled(group.String()) {
+			delete(metadata, group)
+		}
+	}
 
 	return metadata, nil
 }
+
+// pseudonymiseUUID derives a stable pseudonym for a UUID, so reports from
+// the same model can still be correlated without revealing the UUID.
+func pseudonymiseUUID(uuid string) string {
+	digest := sha256.Sum256([]byte(uuid))
+	return hex.EncodeToString(digest[:16])
+}
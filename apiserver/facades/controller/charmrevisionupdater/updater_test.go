@@ -4,6 +4,9 @@
 package charmrevisionupdater_test
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+
 	"github.com/golang/mock/gomock"
 	"github.com/juju/charm/v9"
 	"github.com/juju/charm/v9/resource"
@@ -185,7 +188,7 @@ func (s *updaterSuite) TestEmptyModelMetrics(c *gc.C) {
 		"uuid": uuid,
 	})
 	c.Assert(err, jc.ErrorIsNil)
-	s.model.EXPECT().Config().Return(cfg, nil)
+	s.model.EXPECT().Config().Return(cfg, nil).Times(2)
 	s.state.EXPECT().AllApplications().Return([]charmrevisionupdater.Application{}, nil)
 
 	client := mocks.NewMockCharmhubRefreshClient(ctrl)
@@ -213,6 +216,121 @@ func (s *updaterSuite) TestEmptyModelMetrics(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *updaterSuite) TestEmptyModelMetricsGroupsFiltered(c *gc.C) {
+	ctrl := s.setupMocksNoResources(c)
+	defer ctrl.Finish()
+	uuid := testing.ModelTag.Id()
+	s.model.EXPECT().Metrics().Return(state.ModelMetrics{
+		UUID:           uuid,
+		ControllerUUID: "controller-1",
+		CloudName:      "cloud",
+	}, nil)
+	cfg, err := config.New(config.UseDefaults, map[string]interface{}{
+		"name":                          "model",
+		"type":                          "type",
+		"uuid":                          uuid,
+		config.TelemetryMetricGroupsKey: "controller",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	s.model.EXPECT().Config().Return(cfg, nil).Times(2)
+	s.state.EXPECT().AllApplications().Return([]charmrevisionupdater.Application{}, nil)
+
+	client := mocks.NewMockCharmhubRefreshClient(ctrl)
+	send := map[charmmetrics.MetricKey]map[charmmetrics.MetricKey]string{
+		charmmetrics.Controller: {
+			charmmetrics.JujuVersion: jujuversion.Current.String(),
+			charmmetrics.UUID:        "controller-1",
+		},
+	}
+	client.EXPECT().RefreshWithMetricsOnly(gomock.Any(), gomock.Eq(send)).Return(nil)
+
+	updater, err := charmrevisionupdater.NewCharmRevisionUpdaterAPIState(s.state, nil, s.newCharmhubClient(client))
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = updater.UpdateLatestRevisions()
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *updaterSuite) TestEmptyModelMetricsAllGroupsDisabled(c *gc.C) {
+	ctrl := s.setupMocksNoResources(c)
+	defer ctrl.Finish()
+	uuid := testing.ModelTag.Id()
+	s.model.EXPECT().Metrics().Return(state.ModelMetrics{
+		UUID:           uuid,
+		ControllerUUID: "controller-1",
+		CloudName:      "cloud",
+	}, nil)
+	cfg, err := config.New(config.UseDefaults, map[string]interface{}{
+		"name":                          "model",
+		"type":                          "type",
+		"uuid":                          uuid,
+		config.TelemetryMetricGroupsKey: "charm",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	s.model.EXPECT().Config().Return(cfg, nil).Times(2)
+	s.state.EXPECT().AllApplications().Return([]charmrevisionupdater.Application{}, nil)
+
+	// No metric groups remain, so no client call is expected.
+	client := mocks.NewMockCharmhubRefreshClient(ctrl)
+
+	updater, err := charmrevisionupdater.NewCharmRevisionUpdaterAPIState(s.state, nil, s.newCharmhubClient(client))
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = updater.UpdateLatestRevisions()
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *updaterSuite) TestEmptyModelMetricsPseudonymised(c *gc.C) {
+	ctrl := s.setupMocksNoResources(c)
+	defer ctrl.Finish()
+	uuid := testing.ModelTag.Id()
+	s.model.EXPECT().Metrics().Return(state.ModelMetrics{
+		UUID:           uuid,
+		ControllerUUID: "controller-1",
+		CloudName:      "cloud",
+	}, nil)
+	cfg, err := config.New(config.UseDefaults, map[string]interface{}{
+		"name":                               "model",
+		"type":                               "type",
+		"uuid":                               uuid,
+		config.TelemetryPseudonymiseUUIDsKey: true,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	s.model.EXPECT().Config().Return(cfg, nil).Times(2)
+	s.state.EXPECT().AllApplications().Return([]charmrevisionupdater.Application{}, nil)
+
+	client := mocks.NewMockCharmhubRefreshClient(ctrl)
+	send := map[charmmetrics.MetricKey]map[charmmetrics.MetricKey]string{
+		charmmetrics.Controller: {
+			charmmetrics.JujuVersion: jujuversion.Current.String(),
+			charmmetrics.UUID:        pseudonym("controller-1"),
+		},
+		charmmetrics.Model: {
+			charmmetrics.NumApplications: "",
+			charmmetrics.Cloud:           "cloud",
+			charmmetrics.NumMachines:     "",
+			charmmetrics.Provider:        "",
+			charmmetrics.Region:          "",
+			charmmetrics.NumUnits:        "",
+			charmmetrics.UUID:            pseudonym(uuid),
+		},
+	}
+	client.EXPECT().RefreshWithMetricsOnly(gomock.Any(), gomock.Eq(send)).Return(nil)
+
+	updater, err := charmrevisionupdater.NewCharmRevisionUpdaterAPIState(s.state, nil, s.newCharmhubClient(client))
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = updater.UpdateLatestRevisions()
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+// pseudonym mirrors the pseudonymisation applied to UUIDs when
+// telemetry-pseudonymise-uuids is set.
+func pseudonym(uuid string) string {
+	digest := sha256.Sum256([]byte(uuid))
+	return hex.EncodeToString(digest[:16])
+}
+
 func (s *updaterSuite) TestEmptyModelNoMetrics(c *gc.C) {
 	ctrl := s.setupMocksNoResources(c)
 	defer ctrl.Finish()
@@ -70,6 +70,12 @@ type FirewallerAPIV7 struct {
 	*FirewallerAPIV6
 }
 
+// FirewallerAPIV8 provides access to the Firewaller v8 API facade.
+// It adds WatchFirewallRules.
+type FirewallerAPIV8 struct {
+	*FirewallerAPIV7
+}
+
 // NewStateFirewallerAPIV3 creates a new server-side FirewallerAPIV3 facade.
 func NewStateFirewallerAPIV3(context facade.Context) (*FirewallerAPIV3, error) {
 	st := context.State()
@@ -149,6 +155,17 @@ func NewStateFirewallerAPIV7(context facade.Context) (*FirewallerAPIV7, error) {
 	}, nil
 }
 
+// NewStateFirewallerAPIV8 creates a new server-side FirewallerAPIv8 facade.
+func NewStateFirewallerAPIV8(context facade.Context) (*FirewallerAPIV8, error) {
+	facadev7, err := NewStateFirewallerAPIV7(context)
+	if err != nil {
+		return nil, err
+	}
+	return &FirewallerAPIV8{
+		FirewallerAPIV7: facadev7,
+	}, nil
+}
+
 // NewFirewallerAPI creates a new server-side FirewallerAPIV3 facade.
 func NewFirewallerAPI(
 	st State,
@@ -459,6 +476,10 @@ func (f *FirewallerAPIV4) FirewallRules(args params.KnownServiceArgs) (params.Li
 		if err != nil {
 			continue
 		}
+		// Disabled rules are invisible to the firewaller.
+		if rule.Disabled() {
+			continue
+		}
 		result.Rules = append(result.Rules, params.FirewallRule{
 			KnownService:   knownService,
 			WhitelistCIDRS: rule.WhitelistCIDRs(),
@@ -467,6 +488,22 @@ func (f *FirewallerAPIV4) FirewallRules(args params.KnownServiceArgs) (params.Li
 	return result, nil
 }
 
+// WatchFirewallRules returns a StringsWatcher that notifies of changes
+// to the firewall rules, so rule changes take effect without waiting
+// for another machine or port event.
+func (f *FirewallerAPIV8) WatchFirewallRules() (params.StringsWatchResult, error) {
+	watch := f.st.WatchFirewallRules()
+	// Consume the initial event.
+	changes, ok := <-watch.Changes()
+	if !ok {
+		return params.StringsWatchResult{}, watcher.EnsureErr(watch)
+	}
+	return params.StringsWatchResult{
+		StringsWatcherId: f.resources.Register(watch),
+		Changes:          changes,
+	}, nil
+}
+
 // AreManuallyProvisioned returns whether each given entity is
 // manually provisioned or not. Only machine tags are accepted.
 func (f *FirewallerAPIV5) AreManuallyProvisioned(args params.Entities) (params.BoolResults, error) {
@@ -569,13 +606,39 @@ func (f *FirewallerAPIV6) openedPortRangesForOneMachine(machine firewall.Machine
 		appName, _ := names.UnitApplication(unitName)
 		appBindings := allAppBindings[appName]
 
+		// When a materialised subnet mapping has been recorded for
+		// the unit, restrict each space's CIDRs to the subnets the
+		// unit's machine can actually reach; the mapping is a single
+		// document read, maintained incrementally as units change.
+		cidrsBySpaceID := subnetCIDRsBySpaceID
+		if subnetIDs, err := f.st.UnitSubnetIDs(unitName); err == nil {
+			cidrsBySpaceID = restrictSubnetCIDRsBySpaceID(spaceInfos, subnetIDs)
+		} else if !errors.IsNotFound(err) {
+			return nil, errors.Trace(err)
+		}
+
 		unitTag := names.NewUnitTag(unitName).String()
-		res[unitTag] = mapUnitPortsAndResolveSubnetCIDRs(unitPortRanges.ByEndpoint(), appBindings, subnetCIDRsBySpaceID)
+		res[unitTag] = mapUnitPortsAndResolveSubnetCIDRs(unitPortRanges.ByEndpoint(), appBindings, cidrsBySpaceID)
 	}
 
 	return res, nil
 }
 
+// restrictSubnetCIDRsBySpaceID maps each space ID to the CIDRs of those
+// of its subnets present in the given subnet ID set.
+func restrictSubnetCIDRsBySpaceID(spaceInfos network.SpaceInfos, subnetIDs []string) map[string][]string {
+	include := set.NewStrings(subnetIDs...)
+	res := make(map[string][]string)
+	for _, space := range spaceInfos {
+		for _, subnet := range space.Subnets {
+			if include.Contains(subnet.ID.String()) {
+				res[space.ID] = append(res[space.ID], subnet.CIDR)
+			}
+		}
+	}
+	return res
+}
+
 // mapUnitPortsAndResolveSubnetCIDRs maps the provided list of opened port
 // ranges by endpoint to a params.OpenUnitPortRanges result list. Each entry in
 // the result list also contains the subnet CIDRs that correspond to each
@@ -248,6 +248,59 @@ func (s *FirewallerSuite) TestOpenedMachinePortRanges(c *gc.C) {
 	})
 }
 
+func (s *FirewallerSuite) TestOpenedMachinePortRangesWithUnitSubnetMapping(c *gc.C) {
+	// Set up our mocks
+	mockMachine := newMockMachine("0")
+	mockMachine.openedPortRanges = newMockMachinePortRanges(
+		newMockUnitPortRanges(
+			"mysql/0",
+			network.GroupedPortRanges{
+				"foo": []network.PortRange{
+					network.MustParsePortRange("3306/tcp"),
+				},
+			},
+		),
+	)
+	s.st.machines["0"] = mockMachine
+	s.st.spaceInfos = network.SpaceInfos{
+		{ID: "42", Name: "questions-about-the-universe", Subnets: []network.SubnetInfo{
+			{ID: "13", CIDR: "192.168.0.0/24"},
+			{ID: "14", CIDR: "192.168.1.0/24"},
+		}},
+	}
+	s.st.applicationEndpointBindings = map[string]map[string]string{
+		"mysql": {
+			"":    network.AlphaSpaceId,
+			"foo": "42",
+		},
+	}
+	// The unit's machine can only reach one of the two subnets in the
+	// bound space, and the materialised mapping records that.
+	s.st.unitSubnets["mysql/0"] = []string{"13"}
+
+	req := params.Entities{
+		Entities: []params.Entity{
+			{Tag: names.NewMachineTag("0").String()},
+		},
+	}
+	res, err := s.api.OpenedMachinePortRanges(req)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(res.Results, gc.HasLen, 1)
+
+	c.Assert(res.Results[0].Error, gc.IsNil)
+	c.Assert(res.Results[0].UnitPortRanges, gc.DeepEquals, map[string][]params.OpenUnitPortRanges{
+		"unit-mysql-0": {
+			{
+				Endpoint:    "foo",
+				SubnetCIDRs: []string{"192.168.0.0/24"},
+				PortRanges: []params.PortRange{
+					params.FromNetworkPortRange(network.MustParsePortRange("3306/tcp")),
+				},
+			},
+		},
+	})
+}
+
 func (s *FirewallerSuite) TestAllSpaceInfos(c *gc.C) {
 	// Set up our mocks
 	s.st.spaceInfos = network.SpaceInfos{
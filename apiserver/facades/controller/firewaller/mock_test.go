@@ -46,6 +46,7 @@ type mockState struct {
 
 	spaceInfos                  network.SpaceInfos
 	applicationEndpointBindings map[string]map[string]string
+	unitSubnets                 map[string][]string
 }
 
 func newMockState(modelUUID string) *mockState {
@@ -62,6 +63,7 @@ func newMockState(modelUUID string) *mockState {
 		configAttrs:    coretesting.FakeConfig(),
 
 		applicationEndpointBindings: make(map[string]map[string]string),
+		unitSubnets:                 make(map[string][]string),
 	}
 }
 
@@ -124,6 +126,12 @@ func (st *mockState) WatchOpenedPorts() state.StringsWatcher {
 	return nil
 }
 
+func (st *mockState) WatchFirewallRules() state.StringsWatcher {
+	st.MethodCall(st, "WatchFirewallRules")
+	// TODO - implement when remaining firewaller tests become unit tests
+	return nil
+}
+
 func (st *mockState) FindEntity(tag names.Tag) (state.Entity, error) {
 	st.MethodCall(st, "FindEntity")
 	// TODO - implement when remaining firewaller tests become unit tests
@@ -310,6 +318,18 @@ func (st *mockState) SpaceInfos() (network.SpaceInfos, error) {
 	return st.spaceInfos, nil
 }
 
+func (st *mockState) UnitSubnetIDs(unitName string) ([]string, error) {
+	st.MethodCall(st, "UnitSubnetIDs", unitName)
+	if err := st.NextErr(); err != nil {
+		return nil, err
+	}
+	subnetIDs, ok := st.unitSubnets[unitName]
+	if !ok {
+		return nil, errors.NotFoundf("subnet mapping for unit %q", unitName)
+	}
+	return subnetIDs, nil
+}
+
 type mockMachine struct {
 	testing.Stub
 	firewall.Machine
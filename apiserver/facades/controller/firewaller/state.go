@@ -24,8 +24,10 @@ type State interface {
 	WatchOpenedPorts() state.StringsWatcher
 	FindEntity(tag names.Tag) (state.Entity, error)
 	FirewallRule(service corefirewall.WellKnownServiceType) (*state.FirewallRule, error)
+	WatchFirewallRules() state.StringsWatcher
 	AllEndpointBindings() (map[string]map[string]string, error)
 	SpaceInfos() (network.SpaceInfos, error)
+	UnitSubnetIDs(unitName string) ([]string, error)
 }
 
 // TODO(wallyworld) - for tests, remove when remaining firewaller tests become unit tests.
@@ -60,6 +62,10 @@ func (st stateShim) FirewallRule(service corefirewall.WellKnownServiceType) (*st
 	return api.Rule(service)
 }
 
+func (st stateShim) WatchFirewallRules() state.StringsWatcher {
+	return st.st.WatchFirewallRules()
+}
+
 func (st stateShim) AllEndpointBindings() (map[string]map[string]string, error) {
 	model, err := st.st.Model()
 	if err != nil {
@@ -80,3 +86,11 @@ func (st stateShim) AllEndpointBindings() (map[string]map[string]string, error)
 func (st stateShim) SpaceInfos() (network.SpaceInfos, error) {
 	return st.st.AllSpaceInfos()
 }
+
+func (st stateShim) UnitSubnetIDs(unitName string) ([]string, error) {
+	unitSubnets, err := st.st.UnitSubnets(unitName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return unitSubnets.SubnetIDs(), nil
+}
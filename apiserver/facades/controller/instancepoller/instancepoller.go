@@ -13,6 +13,7 @@ import (
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/state"
@@ -381,6 +382,36 @@ func (a *InstancePollerAPI) SetInstanceStatus(args params.SetStatus) (params.Err
 	return result, nil
 }
 
+// SetHardwareCharacteristics replaces the recorded hardware
+// characteristics for each given machine with the values most recently
+// observed by the provider. Only machine tags are accepted.
+func (a *InstancePollerAPI) SetHardwareCharacteristics(args params.SetMachinesHardware) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.MachineHardware)),
+	}
+	canAccess, err := a.accessMachine()
+	if err != nil {
+		return result, err
+	}
+	for i, arg := range args.MachineHardware {
+		machine, err := a.getOneMachine(arg.Tag, canAccess)
+		if err == nil {
+			err = machine.SetHardwareCharacteristics(instance.HardwareCharacteristics{
+				Arch:             arg.Hardware.Arch,
+				Mem:              arg.Hardware.Mem,
+				RootDisk:         arg.Hardware.RootDisk,
+				RootDiskSource:   arg.Hardware.RootDiskSource,
+				CpuCores:         arg.Hardware.Cores,
+				CpuPower:         arg.Hardware.CpuPower,
+				Tags:             arg.Hardware.Tags,
+				AvailabilityZone: arg.Hardware.AvailabilityZone,
+			})
+		}
+		result.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return result, nil
+}
+
 // AreManuallyProvisioned returns whether each given entity is
 // manually provisioned or not. Only machine tags are accepted.
 func (a *InstancePollerAPI) AreManuallyProvisioned(args params.Entities) (params.BoolResults, error) {
@@ -21,6 +21,7 @@ import (
 	"github.com/juju/juju/apiserver/facades/controller/instancepoller"
 	"github.com/juju/juju/apiserver/params"
 	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/status"
@@ -699,6 +700,56 @@ func (s *InstancePollerSuite) TestSetInstanceStatusFailure(c *gc.C) {
 	s.st.CheckMachineCall(c, 3, "3")
 }
 
+func (s *InstancePollerSuite) TestSetHardwareCharacteristicsSuccess(c *gc.C) {
+	s.st.SetMachineInfo(c, machineInfo{id: "1"})
+
+	mem := uint64(8192)
+	cores := uint64(4)
+	result, err := s.api.SetHardwareCharacteristics(params.SetMachinesHardware{
+		MachineHardware: []params.MachineHardwareArg{{
+			Tag:      "machine-1",
+			Hardware: params.MachineHardware{Mem: &mem, Cores: &cores},
+		}, {
+			Tag: "machine-42",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{
+			{Error: nil},
+			{Error: apiservertesting.NotFoundError("machine 42")},
+		},
+	})
+
+	s.st.CheckMachineCall(c, 0, "1")
+	s.st.CheckCall(c, 1, "SetHardwareCharacteristics", instance.HardwareCharacteristics{
+		Mem:      &mem,
+		CpuCores: &cores,
+	})
+}
+
+func (s *InstancePollerSuite) TestSetHardwareCharacteristicsFailure(c *gc.C) {
+	s.st.SetErrors(
+		errors.New("pow!"),                   // m1 := Machine("1")
+		nil,                                  // m2 := Machine("2")
+		errors.New("FAIL"),                   // m2.SetHardwareCharacteristics()
+		errors.NotProvisionedf("machine 42"), // m3 := Machine("3")
+	)
+	s.st.SetMachineInfo(c, machineInfo{id: "1"})
+	s.st.SetMachineInfo(c, machineInfo{id: "2"})
+	s.st.SetMachineInfo(c, machineInfo{id: "3"})
+
+	result, err := s.api.SetHardwareCharacteristics(params.SetMachinesHardware{
+		MachineHardware: []params.MachineHardwareArg{
+			{Tag: "machine-1"},
+			{Tag: "machine-2"},
+			{Tag: "machine-3"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, s.machineErrorResults)
+}
+
 func (s *InstancePollerSuite) TestAreManuallyProvisionedSuccess(c *gc.C) {
 	s.st.SetMachineInfo(c, machineInfo{id: "1", isManual: true})
 	s.st.SetMachineInfo(c, machineInfo{id: "2", isManual: false})
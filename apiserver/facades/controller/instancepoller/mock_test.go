@@ -384,6 +384,15 @@ func (m *mockMachine) SetInstanceStatus(instanceStatus status.StatusInfo) error
 	return nil
 }
 
+// SetHardwareCharacteristics implements StateMachine.
+func (m *mockMachine) SetHardwareCharacteristics(hc instance.HardwareCharacteristics) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.MethodCall(m, "SetHardwareCharacteristics", hc)
+	return m.NextErr()
+}
+
 // Life implements StateMachine.
 func (m *mockMachine) Life() state.Life {
 	m.mu.Lock()
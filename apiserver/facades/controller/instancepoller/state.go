@@ -17,6 +17,7 @@ type StateMachine interface {
 	networkingcommon.LinkLayerMachine
 
 	InstanceId() (instance.Id, error)
+	SetHardwareCharacteristics(instance.HardwareCharacteristics) error
 	ProviderAddresses() network.SpaceAddresses
 	SetProviderAddresses(...network.SpaceAddress) error
 	InstanceStatus() (status.StatusInfo, error)
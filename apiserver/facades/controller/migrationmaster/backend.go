@@ -4,6 +4,7 @@
 package migrationmaster
 
 import (
+	"github.com/juju/description/v3"
 	"github.com/juju/names/v4"
 	"github.com/juju/version/v2"
 
@@ -17,6 +18,7 @@ import (
 type Backend interface {
 	migration.StateExporter
 
+	ExportPartial(state.ExportConfig) (description.Model, error)
 	WatchForMigration() state.NotifyWatcher
 	LatestMigration() (state.ModelMigration, error)
 	ModelUUID() string
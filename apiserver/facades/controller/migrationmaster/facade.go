@@ -20,6 +20,7 @@ import (
 	coremigration "github.com/juju/juju/core/migration"
 	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/migration"
+	"github.com/juju/juju/state"
 	"github.com/juju/juju/state/watcher"
 )
 
@@ -40,6 +41,11 @@ type APIV1 struct {
 
 // APIV2 implements version 2 of the migration master API.
 type APIV2 struct {
+	*APIV3
+}
+
+// APIV3 implements version 3 of the migration master API.
+type APIV3 struct {
 	*API
 }
 
@@ -56,13 +62,23 @@ func NewMigrationMasterFacadeV1(ctx facade.Context) (*APIV1, error) {
 // NewMigrationMasterFacadeV2 exists to provide the required signature for API
 // registration, converting st to backend.
 func NewMigrationMasterFacadeV2(ctx facade.Context) (*APIV2, error) {
-	v3, err := NewMigrationMasterFacade(ctx)
+	v3, err := NewMigrationMasterFacadeV3(ctx)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 	return &APIV2{v3}, nil
 }
 
+// NewMigrationMasterFacadeV3 exists to provide the required signature for API
+// registration, converting st to backend.
+func NewMigrationMasterFacadeV3(ctx facade.Context) (*APIV3, error) {
+	v4, err := NewMigrationMasterFacade(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIV3{v4}, nil
+}
+
 // NewMigrationMasterFacadeV2 exists to provide the required signature for API
 // registration, converting st to backend.
 func NewMigrationMasterFacade(ctx facade.Context) (*API, error) {
@@ -239,10 +255,25 @@ func (api *API) SetStatusMessage(args params.SetMigrationStatusMessageArgs) erro
 }
 
 // Export serializes the model associated with the API connection.
-func (api *API) Export() (params.SerializedModel, error) {
+func (api *APIV3) Export() (params.SerializedModel, error) {
+	return api.API.Export(params.ExportModelArgs{})
+}
+
+// Export serializes the model associated with the API connection,
+// optionally leaving out the named aspects of the model.
+func (api *API) Export(args params.ExportModelArgs) (params.SerializedModel, error) {
 	var serialized params.SerializedModel
 
-	model, err := api.backend.Export()
+	var cfg state.ExportConfig
+	for _, name := range args.Exclude {
+		kind, err := state.ParseExportKind(name)
+		if err != nil {
+			return serialized, errors.Trace(err)
+		}
+		cfg.Exclude(kind)
+	}
+
+	model, err := api.backend.ExportPartial(cfg)
 	if err != nil {
 		return serialized, err
 	}
@@ -278,6 +278,28 @@ func (s *Suite) TestExportCAAS(c *gc.C) {
 	s.assertExport(c, "caas")
 }
 
+func (s *Suite) TestExportExcludes(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var cfg state.ExportConfig
+	cfg.Exclude(state.ExportStatusHistory, state.ExportRelationData)
+	s.backend.EXPECT().ExportPartial(cfg).Return(s.model, nil)
+
+	_, err := s.mustMakeAPI(c).Export(params.ExportModelArgs{
+		Exclude: []string{"status-history", "relation-data"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *Suite) TestExportBadExcludeKind(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.mustMakeAPI(c).Export(params.ExportModelArgs{
+		Exclude: []string{"kittens"},
+	})
+	c.Assert(err, gc.ErrorMatches, `export kind "kittens" not valid`)
+}
+
 func (s *Suite) assertExport(c *gc.C, modelType string) {
 	defer s.setupMocks(c).Finish()
 
@@ -339,9 +361,9 @@ func (s *Suite) assertExport(c *gc.C, modelType string) {
 	})
 	unitRev := unitRes.Revision()
 
-	s.backend.EXPECT().Export().Return(s.model, nil)
+	s.backend.EXPECT().ExportPartial(state.ExportConfig{}).Return(s.model, nil)
 
-	serialized, err := s.mustMakeAPI(c).Export()
+	serialized, err := s.mustMakeAPI(c).Export(params.ExportModelArgs{})
 	c.Assert(err, jc.ErrorIsNil)
 
 	// We don't want to tie this test the serialisation output (that's
@@ -18,6 +18,7 @@ import (
 	"github.com/juju/juju/apiserver/common"
 	"github.com/juju/juju/apiserver/logsink"
 	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/core/lognoise"
 	"github.com/juju/juju/state"
 	"github.com/juju/juju/state/logdb"
 )
@@ -30,11 +31,13 @@ const (
 type agentLoggingStrategy struct {
 	dbloggers  *dbloggers
 	fileLogger io.Writer
+	noise      *lognoise.Recorder
 
 	dblogger   recordLogger
 	releaser   func()
 	version    version.Number
 	entity     string
+	modelUUID  string
 	filePrefix string
 }
 
@@ -110,11 +113,13 @@ func newAgentLogWriteCloserFunc(
 	ctxt httpContext,
 	fileLogger io.Writer,
 	dbloggers *dbloggers,
+	noise *lognoise.Recorder,
 ) logsink.NewLogWriteCloserFunc {
 	return func(req *http.Request) (logsink.LogWriteCloser, error) {
 		strategy := &agentLoggingStrategy{
 			dbloggers:  dbloggers,
 			fileLogger: fileLogger,
+			noise:      noise,
 		}
 		if err := strategy.init(ctxt, req); err != nil {
 			return nil, errors.Annotate(err, "initialising agent logsink session")
@@ -143,7 +148,8 @@ func (s *agentLoggingStrategy) init(ctxt httpContext, req *http.Request) error {
 	}
 	s.version = ver
 	s.entity = entity.Tag().String()
-	s.filePrefix = st.ModelUUID() + ":"
+	s.modelUUID = st.ModelUUID()
+	s.filePrefix = s.modelUUID + ":"
 	s.dblogger = s.dbloggers.get(st.State)
 	s.releaser = func() {
 		if removed := st.Release(); removed {
@@ -163,8 +169,14 @@ func (s *agentLoggingStrategy) Close() error {
 	return nil
 }
 
+// LoggingEntity is part of the logsink.EntityAware interface.
+func (s *agentLoggingStrategy) LoggingEntity() string {
+	return s.entity
+}
+
 // WriteLog is part of the logsink.LogWriteCloser interface.
 func (s *agentLoggingStrategy) WriteLog(m params.LogRecord) error {
+	s.noise.Record(s.modelUUID, s.entity)
 	level, _ := loggo.ParseLevel(m.Level)
 	dbErr := errors.Annotate(s.dblogger.Log([]state.LogRecord{{
 		Time:     m.Time,
@@ -12,7 +12,7 @@ import (
 func NewHTTPHandlerForTest(
 	newLogWriteCloser NewLogWriteCloserFunc,
 	abort <-chan struct{},
-	ratelimit *RateLimitConfig,
+	ratelimit *RateLimiter,
 	metrics MetricsCollector,
 	modelUUID string,
 	makeChannel func() (chan struct{}, func()),
@@ -61,6 +61,12 @@ type RateLimitConfig struct {
 
 	// Clock is the clock used to wait when rate-limiting log receives.
 	Clock clock.Clock
+
+	// PerEntity, when true, shares a connection's token bucket with
+	// all other connections made by the same authenticated entity, so
+	// that an agent cannot gain a fresh burst allowance by
+	// reconnecting or by opening extra connections.
+	PerEntity bool
 }
 
 // CounterVec is a Collector that bundles a set of Counters that all share the
@@ -113,12 +119,12 @@ type MetricsCollector interface {
 // websocket, using the given NewLogWriteCloserFunc to obtain a writer to which
 // the log messages will be written.
 //
-// ratelimit defines an optional rate-limit configuration. If nil, no rate-
-// limiting will be applied.
+// ratelimit defines an optional rate limiter. If nil, no rate-limiting
+// will be applied.
 func NewHTTPHandler(
 	newLogWriteCloser NewLogWriteCloserFunc,
 	abort <-chan struct{},
-	ratelimit *RateLimitConfig,
+	ratelimit *RateLimiter,
 	metrics MetricsCollector,
 	modelUUID string,
 ) http.Handler {
@@ -138,7 +144,7 @@ func NewHTTPHandler(
 type logSinkHandler struct {
 	newLogWriteCloser NewLogWriteCloserFunc
 	abort             <-chan struct{}
-	ratelimit         *RateLimitConfig
+	ratelimit         *RateLimiter
 	metrics           MetricsCollector
 	modelUUID         string
 	mu                sync.Mutex
@@ -199,6 +205,17 @@ func (h *logSinkHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		}
 		defer writer.Close()
 
+		var tokenBucket *ratelimit.Bucket
+		if h.ratelimit != nil {
+			var entity string
+			if e, ok := writer.(EntityAware); ok {
+				entity = e.LoggingEntity()
+			}
+			var release func()
+			tokenBucket, release = h.ratelimit.acquire(entity)
+			defer release()
+		}
+
 		// If we get to here, no more errors to report, so we report a nil
 		// error.  This way the first line of the socket is always a json
 		// formatted simple error.
@@ -224,7 +241,7 @@ func (h *logSinkHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 
 		stopReceiving, closer := h.newStopChannel()
 		defer closer()
-		logCh := h.receiveLogs(socket, endpointVersion, resolvedModelUUID, stopReceiving)
+		logCh := h.receiveLogs(socket, endpointVersion, resolvedModelUUID, tokenBucket, stopReceiving)
 		for {
 			select {
 			case <-h.abort:
@@ -284,19 +301,11 @@ func (h *logSinkHandler) getVersion(req *http.Request) (int, error) {
 func (h *logSinkHandler) receiveLogs(socket *websocket.Conn,
 	endpointVersion int,
 	resolvedModelUUID string,
+	tokenBucket *ratelimit.Bucket,
 	stop <-chan struct{},
 ) <-chan params.LogRecord {
 	logCh := make(chan params.LogRecord)
 
-	var tokenBucket *ratelimit.Bucket
-	if h.ratelimit != nil {
-		tokenBucket = ratelimit.NewBucketWithClock(
-			h.ratelimit.Refill,
-			h.ratelimit.Burst,
-			ratelimitClock{h.ratelimit.Clock},
-		)
-	}
-
 	go func() {
 		// Close the channel to signal ServeHTTP to finish. Otherwise
 		// we leak goroutines on client disconnect, because the server
@@ -333,13 +342,12 @@ func (h *logSinkHandler) receiveLogs(socket *websocket.Conn,
 				return
 			}
 
-			// Rate-limit receipt of log messages. We rate-limit
-			// each connection individually to prevent one noisy
-			// individual from drowning out the others.
+			// Rate-limit receipt of log messages to prevent one
+			// noisy individual from drowning out the others.
 			if tokenBucket != nil {
 				if d := tokenBucket.Take(1); d > 0 {
 					select {
-					case <-h.ratelimit.Clock.After(d):
+					case <-h.ratelimit.clock.After(d):
 					case <-h.abort:
 						return
 					}
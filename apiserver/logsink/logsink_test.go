@@ -270,11 +270,11 @@ func (s *logsinkSuite) TestRateLimit(c *gc.C) {
 			}, s.stub.NextErr()
 		},
 		s.abort,
-		&logsink.RateLimitConfig{
+		logsink.NewRateLimiter(logsink.RateLimitConfig{
 			Burst:  2,
 			Refill: time.Second,
 			Clock:  testClock,
-		},
+		}),
 		metricsCollector,
 		modelUUID.String(),
 	))
@@ -325,6 +325,84 @@ func (s *logsinkSuite) TestRateLimit(c *gc.C) {
 	expectNoRecord()
 }
 
+func (s *logsinkSuite) TestRateLimitPerEntity(c *gc.C) {
+	modelUUID, err := utils.NewUUID()
+	c.Assert(err, jc.ErrorIsNil)
+
+	metricsCollector, finish := createMockMetrics(c, modelUUID.String())
+	defer finish()
+
+	testClock := testclock.NewClock(time.Time{})
+	srv := httptest.NewServer(logsink.NewHTTPHandler(
+		func(req *http.Request) (logsink.LogWriteCloser, error) {
+			s.stub.AddCall("Open")
+			return &entityAwareLogWriteCloser{
+				mockLogWriteCloser{
+					s.stub,
+					s.written,
+					nil,
+				},
+				"machine-0",
+			}, s.stub.NextErr()
+		},
+		s.abort,
+		logsink.NewRateLimiter(logsink.RateLimitConfig{
+			Burst:     2,
+			Refill:    time.Second,
+			Clock:     testClock,
+			PerEntity: true,
+		}),
+		metricsCollector,
+		modelUUID.String(),
+	))
+	defer srv.Close()
+
+	record := params.LogRecord{
+		Time:     time.Date(2015, time.June, 1, 23, 2, 1, 0, time.UTC),
+		Module:   "some.where",
+		Location: "foo.go:42",
+		Level:    loggo.INFO.String(),
+		Message:  "all is well",
+	}
+	expectRecord := func() {
+		select {
+		case written, ok := <-s.written:
+			c.Assert(ok, jc.IsTrue)
+			c.Assert(written, jc.DeepEquals, record)
+		case <-time.After(coretesting.LongWait):
+			c.Fatal("timed out waiting for log record to be written")
+		}
+	}
+	expectNoRecord := func() {
+		select {
+		case <-s.written:
+			c.Fatal("unexpected log record")
+		case <-time.After(coretesting.ShortWait):
+		}
+	}
+
+	// The first connection uses up the entity's burst allowance...
+	conn := s.dialWebsocket(c, srv)
+	websockettest.AssertJSONInitialErrorNil(c, conn)
+	for i := 0; i < 2; i++ {
+		err := conn.WriteJSON(&record)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+	expectRecord()
+	expectRecord()
+
+	// ...so a second connection made by the same entity doesn't get
+	// a fresh burst; its first message is already rate-limited.
+	conn2 := s.dialWebsocket(c, srv)
+	websockettest.AssertJSONInitialErrorNil(c, conn2)
+	err = conn2.WriteJSON(&record)
+	c.Assert(err, jc.ErrorIsNil)
+	expectNoRecord()
+	testClock.WaitAdvance(time.Second, coretesting.LongWait, 1)
+	expectRecord()
+	expectNoRecord()
+}
+
 func (s *logsinkSuite) TestReceiverStopsWhenAsked(c *gc.C) {
 	myStopCh := make(chan struct{})
 
@@ -528,3 +606,12 @@ func createMockMetrics(c *gc.C, modelUUID string) (*mocks.MockMetricsCollector,
 
 	return metricsCollector, ctrl.Finish
 }
+
+type entityAwareLogWriteCloser struct {
+	mockLogWriteCloser
+	entity string
+}
+
+func (m *entityAwareLogWriteCloser) LoggingEntity() string {
+	return m.entity
+}
@@ -0,0 +1,104 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logsink
+
+import (
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/ratelimit"
+)
+
+// droppedBucketRetention is how long a disconnected entity's token
+// bucket is retained, so that a rapidly reconnecting agent does not
+// receive a fresh burst allowance on every connection.
+const droppedBucketRetention = 10 * time.Minute
+
+// EntityAware is implemented by LogWriteClosers that know which
+// authenticated entity's log messages they are receiving.
+type EntityAware interface {
+	// LoggingEntity returns the tag of the entity whose log messages
+	// are being received.
+	LoggingEntity() string
+}
+
+// RateLimiter hands out the token buckets used to rate-limit receipt
+// of log messages on logsink connections. When configured per-entity,
+// the bucket is shared between all connections made by the same
+// entity, so the limit applies to the agent rather than to each of
+// its connections.
+type RateLimiter struct {
+	clock     clock.Clock
+	perEntity bool
+
+	mu      sync.Mutex
+	burst   int64
+	refill  time.Duration
+	buckets map[string]*sharedBucket
+}
+
+type sharedBucket struct {
+	bucket   *ratelimit.Bucket
+	refs     int
+	released time.Time
+}
+
+// NewRateLimiter returns a RateLimiter with the given initial
+// configuration.
+func NewRateLimiter(cfg RateLimitConfig) *RateLimiter {
+	return &RateLimiter{
+		clock:     cfg.Clock,
+		perEntity: cfg.PerEntity,
+		burst:     cfg.Burst,
+		refill:    cfg.Refill,
+		buckets:   make(map[string]*sharedBucket),
+	}
+}
+
+// Update changes the burst and refill settings used for new token
+// buckets. Existing connections keep the settings that were in place
+// when they connected.
+func (l *RateLimiter) Update(burst int64, refill time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.burst = burst
+	l.refill = refill
+}
+
+// acquire returns a token bucket for a new connection made by the
+// given entity, along with a func to call when the connection is
+// finished with it.
+func (l *RateLimiter) acquire(entity string) (*ratelimit.Bucket, func()) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.perEntity || entity == "" {
+		return l.newBucket(), func() {}
+	}
+	now := l.clock.Now()
+	for name, shared := range l.buckets {
+		if shared.refs == 0 && now.Sub(shared.released) > droppedBucketRetention {
+			delete(l.buckets, name)
+		}
+	}
+	shared, ok := l.buckets[entity]
+	if !ok {
+		shared = &sharedBucket{bucket: l.newBucket()}
+		l.buckets[entity] = shared
+	}
+	shared.refs++
+	var once sync.Once
+	return shared.bucket, func() {
+		once.Do(func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			shared.refs--
+			shared.released = l.clock.Now()
+		})
+	}
+}
+
+func (l *RateLimiter) newBucket() *ratelimit.Bucket {
+	return ratelimit.NewBucketWithClock(l.refill, l.burst, ratelimitClock{l.clock})
+}
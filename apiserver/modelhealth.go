@@ -0,0 +1,153 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/state"
+)
+
+// modelHealth is the document returned by the model health endpoint.
+// The counts are deliberately coarse: the endpoint exists so that load
+// balancers and monitoring probes can tell whether a model needs
+// attention without a full status call or admin credentials.
+type modelHealth struct {
+	Status          string `json:"status"`
+	BlockedUnits    int    `json:"blocked-units"`
+	ErrorUnits      int    `json:"error-units"`
+	MachinesDown    int    `json:"machines-down"`
+	PendingUpgrades int    `json:"pending-upgrades"`
+}
+
+// healthy reports whether nothing in the model needs attention.
+func (h modelHealth) healthy() bool {
+	return h.BlockedUnits == 0 && h.ErrorUnits == 0 && h.MachinesDown == 0 && h.PendingUpgrades == 0
+}
+
+// modelHealthHandler serves GET <model>/health. The endpoint is
+// unauthenticated and therefore disabled unless the model-health-endpoint
+// controller config attribute has been set.
+type modelHealthHandler struct {
+	ctxt httpContext
+}
+
+func (h *modelHealthHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		if err := sendError(w, errors.MethodNotAllowedf("unsupported method: %q", r.Method)); err != nil {
+			logger.Errorf("%v", err)
+		}
+		return
+	}
+	st, err := h.ctxt.stateForRequestUnauthenticated(r)
+	if err != nil {
+		if err := sendError(w, err); err != nil {
+			logger.Errorf("%v", err)
+		}
+		return
+	}
+	defer st.Release()
+
+	cfg, err := st.ControllerConfig()
+	if err != nil {
+		if err := sendError(w, err); err != nil {
+			logger.Errorf("%v", err)
+		}
+		return
+	}
+	if !cfg.ModelHealthEndpointEnabled() {
+		if err := sendError(w, errors.NotFoundf("model health endpoint")); err != nil {
+			logger.Errorf("%v", err)
+		}
+		return
+	}
+
+	health, err := computeModelHealth(st.State)
+	if err != nil {
+		if err := sendError(w, err); err != nil {
+			logger.Errorf("%v", err)
+		}
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if !health.healthy() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if err := json.NewEncoder(w).Encode(health); err != nil {
+		logger.Errorf("sending model health response: %v", err)
+	}
+}
+
+// computeModelHealth gathers the coarse health counts for a model.
+func computeModelHealth(st *state.State) (modelHealth, error) {
+	var health modelHealth
+
+	model, err := st.Model()
+	if err != nil {
+		return health, errors.Trace(err)
+	}
+	modelCfg, err := model.Config()
+	if err != nil {
+		return health, errors.Trace(err)
+	}
+	targetVersion, haveTarget := modelCfg.AgentVersion()
+
+	applications, err := st.AllApplications()
+	if err != nil {
+		return health, errors.Trace(err)
+	}
+	for _, app := range applications {
+		units, err := app.AllUnits()
+		if err != nil {
+			return health, errors.Trace(err)
+		}
+		for _, unit := range units {
+			workload, err := unit.Status()
+			if err != nil {
+				return health, errors.Trace(err)
+			}
+			switch workload.Status {
+			case status.Blocked:
+				health.BlockedUnits++
+			case status.Error:
+				health.ErrorUnits++
+			}
+			if haveTarget {
+				if tools, err := unit.AgentTools(); err == nil && tools.Version.Number.Compare(targetVersion) < 0 {
+					health.PendingUpgrades++
+				}
+			}
+		}
+	}
+
+	machines, err := st.AllMachines()
+	if err != nil {
+		return health, errors.Trace(err)
+	}
+	for _, machine := range machines {
+		machineStatus, err := machine.Status()
+		if err != nil {
+			return health, errors.Trace(err)
+		}
+		if machineStatus.Status == status.Down || machineStatus.Status == status.Error {
+			health.MachinesDown++
+		}
+		if haveTarget {
+			if tools, err := machine.AgentTools(); err == nil && tools.Version.Number.Compare(targetVersion) < 0 {
+				health.PendingUpgrades++
+			}
+		}
+	}
+
+	if health.healthy() {
+		health.Status = "healthy"
+	} else {
+		health.Status = "degraded"
+	}
+	return health, nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	apitesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/testing/factory"
+)
+
+type modelHealthSuite struct {
+	apiserverBaseSuite
+}
+
+var _ = gc.Suite(&modelHealthSuite{})
+
+// modelHealthResponse mirrors the document served by the endpoint.
+type modelHealthResponse struct {
+	Status          string `json:"status"`
+	BlockedUnits    int    `json:"blocked-units"`
+	ErrorUnits      int    `json:"error-units"`
+	MachinesDown    int    `json:"machines-down"`
+	PendingUpgrades int    `json:"pending-upgrades"`
+}
+
+func (s *modelHealthSuite) healthURL() string {
+	return s.server.URL + fmt.Sprintf("/model/%s/health", s.State.ModelUUID())
+}
+
+func (s *modelHealthSuite) enableEndpoint(c *gc.C) {
+	err := s.State.UpdateControllerConfig(map[string]interface{}{
+		controller.ModelHealthEndpoint: true,
+	}, nil)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+// get issues an unauthenticated GET to the health endpoint; the whole
+// point of the endpoint is that probes need no credentials.
+func (s *modelHealthSuite) get(c *gc.C, expectStatus int) *http.Response {
+	return apitesting.SendHTTPRequest(c, apitesting.HTTPRequestParams{
+		Method:       "GET",
+		URL:          s.healthURL(),
+		ExpectStatus: expectStatus,
+	})
+}
+
+func (s *modelHealthSuite) readHealth(c *gc.C, resp *http.Response) modelHealthResponse {
+	defer resp.Body.Close()
+	c.Check(resp.Header.Get("Content-Type"), gc.Equals, "application/json")
+	body, err := ioutil.ReadAll(resp.Body)
+	c.Assert(err, jc.ErrorIsNil)
+	var health modelHealthResponse
+	err = json.Unmarshal(body, &health)
+	c.Assert(err, jc.ErrorIsNil, gc.Commentf("body: %s", body))
+	return health
+}
+
+func (s *modelHealthSuite) TestDisabledByDefault(c *gc.C) {
+	cfg, err := s.State.ControllerConfig()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.ModelHealthEndpointEnabled(), jc.IsFalse)
+
+	resp := s.get(c, http.StatusNotFound)
+	_ = resp.Body.Close()
+}
+
+func (s *modelHealthSuite) TestMethodNotAllowed(c *gc.C) {
+	s.enableEndpoint(c)
+	resp := apitesting.SendHTTPRequest(c, apitesting.HTTPRequestParams{
+		Method:       "POST",
+		URL:          s.healthURL(),
+		ExpectStatus: http.StatusMethodNotAllowed,
+	})
+	_ = resp.Body.Close()
+}
+
+func (s *modelHealthSuite) TestHealthy(c *gc.C) {
+	s.enableEndpoint(c)
+
+	resp := s.get(c, http.StatusOK)
+	health := s.readHealth(c, resp)
+	c.Check(health, jc.DeepEquals, modelHealthResponse{Status: "healthy"})
+}
+
+func (s *modelHealthSuite) TestDegraded(c *gc.C) {
+	s.enableEndpoint(c)
+
+	// A blocked unit is enough to degrade the model.
+	unit := s.Factory.MakeUnit(c, &factory.UnitParams{
+		Status: &status.StatusInfo{
+			Status:  status.Blocked,
+			Message: "needs configuration",
+		},
+	})
+	c.Assert(unit, gc.NotNil)
+
+	resp := s.get(c, http.StatusServiceUnavailable)
+	health := s.readHealth(c, resp)
+	c.Check(health, jc.DeepEquals, modelHealthResponse{
+		Status:       "degraded",
+		BlockedUnits: 1,
+	})
+}
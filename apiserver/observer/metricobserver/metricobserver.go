@@ -4,6 +4,7 @@
 package metricobserver
 
 import (
+	"encoding/json"
 	"net/http"
 	"strconv"
 	"time"
@@ -49,6 +50,14 @@ type MetricsCollector interface {
 	// APIRequestDuration returns a SummaryVec for updating the duration of
 	// api request duration.
 	APIRequestDuration() SummaryVec
+
+	// APIRequestSize returns a SummaryVec for updating the size of
+	// api request payloads.
+	APIRequestSize() SummaryVec
+
+	// APIReplySize returns a SummaryVec for updating the size of
+	// api reply payloads.
+	APIReplySize() SummaryVec
 }
 
 // Config contains the configuration for an Observer.
@@ -86,6 +95,8 @@ func NewObserverFactory(config Config) (observer.ObserverFactory, error) {
 		clock: config.Clock,
 		metrics: metrics{
 			apiRequestDuration: config.MetricsCollector.APIRequestDuration(),
+			apiRequestSize:     config.MetricsCollector.APIRequestSize(),
+			apiReplySize:       config.MetricsCollector.APIReplySize(),
 		},
 	}
 	return func() observer.Observer {
@@ -101,6 +112,8 @@ type Observer struct {
 
 type metrics struct {
 	apiRequestDuration SummaryVec
+	apiRequestSize     SummaryVec
+	apiReplySize       SummaryVec
 }
 
 // Login is part of the observer.Observer interface.
@@ -124,11 +137,13 @@ type rpcObserver struct {
 	clock        clock.Clock
 	metrics      metrics
 	requestStart time.Time
+	requestSize  int
 }
 
 // ServerRequest is part of the rpc.Observer interface.
 func (o *rpcObserver) ServerRequest(hdr *rpc.Header, body interface{}) {
 	o.requestStart = o.clock.Now()
+	o.requestSize = payloadSize(body)
 }
 
 // ServerReply is part of the rpc.Observer interface.
@@ -141,4 +156,19 @@ func (o *rpcObserver) ServerReply(req rpc.Request, hdr *rpc.Header, body interfa
 	}
 	duration := o.clock.Now().Sub(o.requestStart)
 	o.metrics.apiRequestDuration.With(labels).Observe(duration.Seconds())
+	o.metrics.apiRequestSize.With(labels).Observe(float64(o.requestSize))
+	o.metrics.apiReplySize.With(labels).Observe(float64(payloadSize(body)))
+}
+
+// payloadSize returns the size of the JSON encoding of the given
+// request or reply body, which is its uncompressed size on the wire.
+func payloadSize(body interface{}) int {
+	if body == nil {
+		return 0
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return 0
+	}
+	return len(data)
 }
@@ -27,6 +27,8 @@ func createMockMetrics(c *gc.C, labels interface{}) (*mocks.MockMetricsCollector
 
 	metricsCollector := mocks.NewMockMetricsCollector(ctrl)
 	metricsCollector.EXPECT().APIRequestDuration().Return(summaryVec).AnyTimes()
+	metricsCollector.EXPECT().APIRequestSize().Return(summaryVec).AnyTimes()
+	metricsCollector.EXPECT().APIReplySize().Return(summaryVec).AnyTimes()
 
 	return metricsCollector, ctrl.Finish
 }
@@ -235,6 +235,83 @@ type ApplicationConfigUnsetArgs struct {
 	Args []ApplicationUnset
 }
 
+// StagedConfigResults holds the results of an Application.StagedConfig
+// call.
+type StagedConfigResults struct {
+	Results []StagedConfigResult `json:"results"`
+}
+
+// StagedConfigResult describes an application's staged charm config
+// changeset and a preview of the effect of applying it.
+type StagedConfigResult struct {
+	// Staged holds the staged changes keyed by option name. A nil
+	// value marks the option for reset to its charm default.
+	Staged map[string]interface{} `json:"staged"`
+
+	// Effective holds the charm config as it would be after the
+	// staged changes are applied.
+	Effective map[string]interface{} `json:"effective"`
+
+	// Hooks lists the hooks that would run on the application's units
+	// when the staged changes are applied.
+	Hooks []string `json:"hooks,omitempty"`
+
+	Error *Error `json:"error,omitempty"`
+}
+
+// ConfigHistoryArgs holds the parameters for an
+// Application.ConfigHistory call.
+type ConfigHistoryArgs struct {
+	Args []ConfigHistoryArg `json:"args"`
+}
+
+// ConfigHistoryArg requests the config history of a single application.
+type ConfigHistoryArg struct {
+	ApplicationTag string `json:"application-tag"`
+
+	// Limit caps the number of entries returned, newest first. Zero
+	// means the full retained history.
+	Limit int `json:"limit,omitempty"`
+}
+
+// ConfigHistoryResults holds the results of an
+// Application.ConfigHistory call.
+type ConfigHistoryResults struct {
+	Results []ConfigHistoryResult `json:"results"`
+}
+
+// ConfigHistoryResult holds an application's config history.
+type ConfigHistoryResult struct {
+	Entries []ConfigHistoryEntry `json:"entries"`
+	Error   *Error               `json:"error,omitempty"`
+}
+
+// ConfigHistoryEntry describes one recorded application config change.
+type ConfigHistoryEntry struct {
+	Revision  int       `json:"revision"`
+	UpdatedBy string    `json:"updated-by,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+
+	// Changes holds the values the change set, keyed by option name.
+	// Previous holds the values those options had before the change; a
+	// nil value means the option was unset.
+	Changes  map[string]interface{} `json:"changes"`
+	Previous map[string]interface{} `json:"previous"`
+}
+
+// ConfigRollbackArgs holds the parameters for an
+// Application.RollbackConfig call.
+type ConfigRollbackArgs struct {
+	Args []ConfigRollback `json:"args"`
+}
+
+// ConfigRollback requests that the config change recorded as the given
+// revision be reverted.
+type ConfigRollback struct {
+	ApplicationTag string `json:"application-tag"`
+	Revision       int    `json:"revision"`
+}
+
 // ApplicationCharmRelations holds parameters for making the application CharmRelations call.
 type ApplicationCharmRelations struct {
 	ApplicationName string `json:"application"`
@@ -283,6 +360,14 @@ type UpdateApplicationServiceArg struct {
 	ProviderId     string    `json:"provider-id"`
 	Addresses      []Address `json:"addresses"`
 
+	// ExternalNames holds any DNS names by which the service
+	// is reachable from outside the cluster.
+	ExternalNames []string `json:"external-names,omitempty"`
+
+	// PortAnnotations holds provider annotations keyed on
+	// service port name.
+	PortAnnotations map[string]map[string]string `json:"port-annotations,omitempty"`
+
 	Scale      *int   `json:"scale,omitempty"`
 	Generation *int64 `json:"generation,omitempty"`
 }
@@ -513,3 +598,137 @@ type ExposeInfoResult struct {
 	// for the application will be exposed to 0.0.0.0/0.
 	ExposedEndpoints map[string]ExposedEndpoint `json:"exposed-endpoints,omitempty"`
 }
+
+// MoveApplicationsParams holds the parameters for moving applications
+// to another model on the same controller.
+type MoveApplicationsParams struct {
+	Applications []MoveApplicationParams `json:"applications"`
+}
+
+// MoveApplicationParams holds the parameters for moving one application
+// to another model on the same controller.
+type MoveApplicationParams struct {
+	ApplicationTag string `json:"application-tag"`
+	TargetModelTag string `json:"target-model-tag"`
+	SeverRelations bool   `json:"sever-relations,omitempty"`
+}
+
+// MoveUnitsParams holds the parameters for moving units to other
+// machines in the same model.
+type MoveUnitsParams struct {
+	Units []MoveUnitParams `json:"units"`
+}
+
+// MoveUnitParams holds the parameters for moving one unit to another
+// machine.
+type MoveUnitParams struct {
+	UnitTag    string `json:"unit-tag"`
+	MachineTag string `json:"machine-tag"`
+}
+
+// MoveUnitResults holds the results of scheduling unit moves.
+type MoveUnitResults struct {
+	Results []MoveUnitResult `json:"results"`
+}
+
+// MoveUnitResult holds the result of scheduling a single unit move.
+type MoveUnitResult struct {
+	// MoveId identifies the move operation so that its progress can be
+	// tracked.
+	MoveId string `json:"move-id,omitempty"`
+
+	// NewUnit is the name of the replacement unit added to the target
+	// machine.
+	NewUnit string `json:"new-unit,omitempty"`
+
+	Error *Error `json:"error,omitempty"`
+}
+
+// UnitMoveResults holds the progress of unit move operations.
+type UnitMoveResults struct {
+	Results []UnitMoveResult `json:"results"`
+}
+
+// UnitMoveResult describes the progress of a single unit move
+// operation.
+type UnitMoveResult struct {
+	MoveId        string    `json:"move-id"`
+	OldUnit       string    `json:"old-unit"`
+	NewUnit       string    `json:"new-unit"`
+	TargetMachine string    `json:"target-machine"`
+	Status        string    `json:"status"`
+	Message       string    `json:"message,omitempty"`
+	Started       time.Time `json:"started"`
+	Completed     time.Time `json:"completed,omitempty"`
+}
+
+// ApplicationCharmSync holds the files to live-sync onto an
+// application's units as part of `juju refresh --path --watch`.
+type ApplicationCharmSync struct {
+	// ApplicationName is the name of the application to sync files to.
+	ApplicationName string `json:"application"`
+
+	// Files are the changed charm files to push to the units.
+	Files []CharmSyncFile `json:"files"`
+}
+
+// CharmSyncFile describes one charm file pushed to an application's
+// units without a full charm upgrade.
+type CharmSyncFile struct {
+	// Path is the file path relative to the charm root.
+	Path string `json:"path"`
+
+	// Content is the file content; empty when Removed is true.
+	Content []byte `json:"content,omitempty"`
+
+	// Mode is the file mode bits to apply.
+	Mode uint32 `json:"mode"`
+
+	// Removed indicates the file should be deleted on the units.
+	Removed bool `json:"removed,omitempty"`
+}
+
+// CharmSyncResults holds the charm sync overlays requested by units.
+type CharmSyncResults struct {
+	Results []CharmSyncResult `json:"results"`
+}
+
+// CharmSyncResult holds one unit's view of its application's charm
+// sync overlay.
+type CharmSyncResult struct {
+	// Generation identifies the overlay revision so units can skip
+	// content they have already applied.
+	Generation int `json:"generation"`
+
+	// Files are the overlay files to apply on top of the charm
+	// directory.
+	Files []CharmSyncFile `json:"files"`
+
+	Error *Error `json:"error,omitempty"`
+}
+
+// EffectiveConstraintsArgs holds the constraint sets to preview
+// against the model constraints.
+type EffectiveConstraintsArgs struct {
+	Args []EffectiveConstraintsArg `json:"args"`
+}
+
+// EffectiveConstraintsArg holds one proposed constraint set. If an
+// application name is given and no constraints are supplied, the
+// application's current constraints are used as the proposal.
+type EffectiveConstraintsArg struct {
+	ApplicationName string            `json:"application-name,omitempty"`
+	Constraints     constraints.Value `json:"constraints"`
+}
+
+// EffectiveConstraintsResults holds the previewed constraints.
+type EffectiveConstraintsResults struct {
+	Results []EffectiveConstraintsResult `json:"results"`
+}
+
+// EffectiveConstraintsResult holds the constraints that would take
+// effect for one proposed constraint set.
+type EffectiveConstraintsResult struct {
+	Constraints constraints.Value `json:"constraints"`
+	Error       *Error            `json:"error,omitempty"`
+}
@@ -14,6 +14,10 @@ type BackupsCreateArgs struct {
 	Notes      string `json:"notes"`
 	KeepCopy   bool   `json:"keep-copy"`
 	NoDownload bool   `json:"no-download"`
+
+	// Incremental requests a backup holding only the database changes
+	// recorded since the most recent completed backup.
+	Incremental bool `json:"incremental,omitempty"`
 }
 
 // BackupsInfoArgs holds the args for the API Info method.
@@ -41,6 +45,34 @@ type BackupsRemoveArgs struct {
 	IDs []string `json:"ids"`
 }
 
+// BackupsListRemoteResult holds the names of the backup archives held
+// in the remote object store configured for the controller.
+type BackupsListRemoteResult struct {
+	Names []string `json:"names"`
+}
+
+// BackupsRemoveRemoteArgs holds the args for the API RemoveRemote
+// method.
+type BackupsRemoveRemoteArgs struct {
+	Names []string `json:"names"`
+}
+
+// BackupsRestoreModelArgs holds the args for the API RestoreModel
+// method.
+type BackupsRestoreModelArgs struct {
+	// BackupID identifies the stored backup to restore from.
+	BackupID string `json:"backup-id"`
+	// ModelUUID identifies the model to restore out of the backup.
+	ModelUUID string `json:"model-uuid"`
+}
+
+// BackupsRestoreModelResult reports the outcome of a selective model
+// restore.
+type BackupsRestoreModelResult struct {
+	// RestoredDocs is the number of model documents reconstructed.
+	RestoredDocs int `json:"restored-docs"`
+}
+
 // BackupsListResult holds the list of all stored backups.
 type BackupsListResult struct {
 	List []BackupsMetadataResult `json:"list"`
@@ -72,6 +104,14 @@ type BackupsMetadataResult struct {
 
 	Filename string `json:"filename"`
 
+	// Incremental is true if the backup holds only the oplog entries
+	// recorded since the backup identified by BasedOn.
+	Incremental bool `json:"incremental,omitempty"`
+
+	// BasedOn is the ID of the backup an incremental backup was taken
+	// against.
+	BasedOn string `json:"based-on,omitempty"`
+
 	// FormatVersion stores the version of the backup format.
 	// All unversioned backup files are considered 0,
 	// so the versioned formats start at 1.
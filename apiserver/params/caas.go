@@ -58,6 +58,7 @@ type CAASApplicationProvisioningInfo struct {
 	CharmURL             string                       `json:"charm-url,omitempty"`
 	Trust                bool                         `json:"trust,omitempty"`
 	Scale                int                          `json:"scale,omitempty"`
+	WorkloadPatch        string                       `json:"workload-patch,omitempty"`
 	Error                *Error                       `json:"error,omitempty"`
 }
 
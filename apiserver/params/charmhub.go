@@ -10,10 +10,13 @@ type Query struct {
 	Category         string `json:"category,omitempty"`
 	Channel          string `json:"channel,omitempty"`
 	CharmType        string `json:"type,omitempty"`
+	Base             string `json:"base,omitempty"`
 	Platforms        string `json:"platforms,omitempty"`
 	Publisher        string `json:"publisher,omitempty"`
 	RelationRequires string `json:"relation-requires,omitempty"`
 	RelationProvides string `json:"relation-provides,omitempty"`
+	Limit            int    `json:"limit,omitempty"`
+	Offset           int    `json:"offset,omitempty"`
 }
 
 // Info tag represents a info query for a given tag and channel.
@@ -288,3 +288,73 @@ type ApplicationCharmPlacement struct {
 	Application string `json:"application"`
 	CharmURL    string `json:"charm-url"`
 }
+
+// CharmDiffArgs requests a diff between the charm revision currently
+// deployed by an application and another revision of the same charm.
+type CharmDiffArgs struct {
+	ApplicationTag string `json:"application-tag"`
+	Revision       int    `json:"revision"`
+}
+
+// CharmDiffResult holds the outcome of a Charms.CharmDiff call.
+type CharmDiffResult struct {
+	Diff  CharmDiff `json:"diff"`
+	Error *Error    `json:"error,omitempty"`
+}
+
+// CharmDiff describes the metadata, config and action differences
+// between two revisions of a charm.
+type CharmDiff struct {
+	// Old and New are the charm URLs being compared.
+	Old string `json:"old"`
+	New string `json:"new"`
+
+	// AddedOptions and RemovedOptions name config options present in
+	// only one of the two revisions.
+	AddedOptions   []string `json:"added-options,omitempty"`
+	RemovedOptions []string `json:"removed-options,omitempty"`
+
+	// ChangedOptions records config options whose type or default
+	// changed between the two revisions.
+	ChangedOptions map[string]CharmOptionChange `json:"changed-options,omitempty"`
+
+	// AddedRelations and RemovedRelations describe endpoint changes,
+	// in "role name:interface" form.
+	AddedRelations   []string `json:"added-relations,omitempty"`
+	RemovedRelations []string `json:"removed-relations,omitempty"`
+
+	// AddedActions and RemovedActions name actions present in only
+	// one of the two revisions.
+	AddedActions   []string `json:"added-actions,omitempty"`
+	RemovedActions []string `json:"removed-actions,omitempty"`
+}
+
+// CharmOptionChange records how a single config option differs
+// between two charm revisions.
+type CharmOptionChange struct {
+	OldType    string      `json:"old-type,omitempty"`
+	NewType    string      `json:"new-type,omitempty"`
+	OldDefault interface{} `json:"old-default,omitempty"`
+	NewDefault interface{} `json:"new-default,omitempty"`
+}
+
+// CharmSupportMatrixResults holds the results of a Charms.SupportMatrix call.
+type CharmSupportMatrixResults struct {
+	Results []CharmSupportMatrixResult `json:"results"`
+}
+
+// CharmSupportMatrixResult holds the support matrix for a single charm, or an
+// error explaining why it could not be retrieved.
+type CharmSupportMatrixResult struct {
+	Releases []CharmSupportedRelease `json:"releases,omitempty"`
+	Error    *Error                  `json:"error,omitempty"`
+}
+
+// CharmSupportedRelease describes a single channel, base and architecture
+// combination that a charm has been released for.
+type CharmSupportedRelease struct {
+	Channel      string `json:"channel"`
+	OS           string `json:"os"`
+	Series       string `json:"series"`
+	Architecture string `json:"architecture"`
+}
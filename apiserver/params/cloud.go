@@ -39,6 +39,37 @@ type UpdateCloudArgs struct {
 	Clouds []AddCloudArgs `json:"clouds"`
 }
 
+// CloudAttributeChange describes how one attribute of a cloud definition
+// would change if a proposed cloud update was applied.
+type CloudAttributeChange struct {
+	// Attribute is the name of the cloud attribute that would change.
+	Attribute string `json:"attribute"`
+
+	// Old is the value currently held by the controller.
+	Old string `json:"old,omitempty"`
+
+	// New is the value the update would set.
+	New string `json:"new,omitempty"`
+}
+
+// CloudUpdatePreviewResult holds the preview for updating one cloud.
+type CloudUpdatePreviewResult struct {
+	Error *Error `json:"error,omitempty"`
+
+	// Changes describes the attribute level differences between the
+	// cloud definition held by the controller and the proposed one.
+	Changes []CloudAttributeChange `json:"changes,omitempty"`
+
+	// Models contains the results of revalidating the stored
+	// credentials for the cloud against the models that use them.
+	Models []UpdateCredentialModelResult `json:"models,omitempty"`
+}
+
+// CloudUpdatePreviewResults contains a set of CloudUpdatePreviewResult.
+type CloudUpdatePreviewResults struct {
+	Results []CloudUpdatePreviewResult `json:"results"`
+}
+
 // CloudResult contains a cloud definition or an error.
 type CloudResult struct {
 	Cloud *Cloud `json:"cloud,omitempty"`
@@ -323,6 +354,17 @@ type UpdateCredentialResults struct {
 	Results []UpdateCredentialResult `json:"results,omitempty"`
 }
 
+// CommitStagedCredentialArg identifies a credential with staged content
+// to commit, with an option to force the commit past model validation.
+type CommitStagedCredentialArg struct {
+	// CredentialTag holds the tag of the credential to commit.
+	CredentialTag string `json:"tag"`
+
+	// Force specifies whether the staged content is to be committed
+	// even if it fails validation against the models using it.
+	Force bool `json:"force,omitempty"`
+}
+
 // UpdateCredentialArgs contains a TaggedCredential set and is used in the call to update credentials.
 type UpdateCredentialArgs struct {
 	// Credentials holds credentials to update.
@@ -0,0 +1,34 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+// ConfigSchemaAttr describes a single configuration attribute: its
+// type, documentation, default value and whether it may be updated.
+type ConfigSchemaAttr struct {
+	// Name is the configuration key.
+	Name string `json:"name"`
+
+	// Type is the expected type of the value, e.g. "string" or "int".
+	Type string `json:"type"`
+
+	// Doc describes what the attribute controls.
+	Doc string `json:"doc,omitempty"`
+
+	// Default is the value used when the attribute is not set, if
+	// there is one.
+	Default interface{} `json:"default,omitempty"`
+
+	// Mutable is true if the attribute may be updated after the
+	// controller or model has been created.
+	Mutable bool `json:"mutable"`
+
+	// Secret is true if the attribute value should not be displayed.
+	Secret bool `json:"secret,omitempty"`
+}
+
+// ConfigSchemaResult holds the configuration schemas known to the
+// controller, keyed on schema name.
+type ConfigSchemaResult struct {
+	Schemas map[string][]ConfigSchemaAttr `json:"schemas"`
+}
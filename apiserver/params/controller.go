@@ -37,6 +37,60 @@ type DestroyControllerArgs struct {
 	ModelTimeout *time.Duration `json:"model-timeout,omitempty"`
 }
 
+// ControllerSettingsDocumentVersion is the current version of the
+// portable controller settings document produced by
+// ExportControllerSettings.
+const ControllerSettingsDocumentVersion = 1
+
+// ControllerSettingsDocument is a portable snapshot of the
+// controller-scoped settings namespaces: controller config, cloud
+// definitions and cloud credential metadata. Credential secret
+// attributes are never included.
+type ControllerSettingsDocument struct {
+	Version          int                    `json:"version"`
+	ControllerConfig map[string]interface{} `json:"controller-config,omitempty"`
+	Clouds           map[string]Cloud       `json:"clouds,omitempty"`
+	Credentials      []CredentialMetadata   `json:"credentials,omitempty"`
+}
+
+// CredentialMetadata identifies a stored cloud credential without its
+// secret attributes.
+type CredentialMetadata struct {
+	Cloud    string `json:"cloud"`
+	Owner    string `json:"owner"`
+	Name     string `json:"name"`
+	AuthType string `json:"auth-type"`
+}
+
+// ControllerSettingsExportResult holds an exported controller settings
+// document.
+type ControllerSettingsExportResult struct {
+	Document ControllerSettingsDocument `json:"document"`
+}
+
+// ControllerSettingsImportArgs holds a controller settings document to
+// verify and apply.
+type ControllerSettingsImportArgs struct {
+	Document ControllerSettingsDocument `json:"document"`
+
+	// DryRun verifies the document without applying any changes.
+	DryRun bool `json:"dry-run,omitempty"`
+}
+
+// ControllerSettingsNamespaceResult reports the outcome of importing a
+// single settings namespace.
+type ControllerSettingsNamespaceResult struct {
+	Namespace string `json:"namespace"`
+	Applied   bool   `json:"applied"`
+	Error     *Error `json:"error,omitempty"`
+}
+
+// ControllerSettingsImportResults holds the per-namespace results of a
+// controller settings import.
+type ControllerSettingsImportResults struct {
+	Results []ControllerSettingsNamespaceResult `json:"results"`
+}
+
 // ModelBlockInfo holds information about an model and its
 // current blocks.
 type ModelBlockInfo struct {
@@ -59,6 +113,22 @@ type RemoveBlocksArgs struct {
 	All bool `json:"all"`
 }
 
+// DrainStatusResults holds drain progress for a collection of
+// controller nodes.
+type DrainStatusResults struct {
+	Results []DrainStatusResult `json:"results"`
+}
+
+// DrainStatusResult holds drain progress for a single controller node.
+// The node is ready for maintenance once it is draining and no longer
+// has a replica set vote.
+type DrainStatusResult struct {
+	Draining bool   `json:"draining"`
+	HasVote  bool   `json:"has-vote"`
+	Ready    bool   `json:"ready"`
+	Error    *Error `json:"error,omitempty"`
+}
+
 // ModelStatus holds information about the status of a juju model.
 type ModelStatus struct {
 	ModelTag           string                `json:"model-tag"`
@@ -131,3 +201,29 @@ type ControllerVersionResults struct {
 	Version   string `json:"version"`
 	GitCommit string `json:"git-commit"`
 }
+
+// CleanupOrphanedRelationsArgs holds the parameters for a call to
+// Controller.CleanupOrphanedRelations.
+type CleanupOrphanedRelationsArgs struct {
+	// DryRun reports orphaned documents without removing them.
+	DryRun bool `json:"dry-run"`
+
+	// BatchSize bounds the number of documents removed in a single
+	// transaction. A zero value selects a server-side default.
+	BatchSize int `json:"batch-size,omitempty"`
+}
+
+// OrphanedRelationDocsResult reports the orphaned relation documents
+// found (or removed) in one model.
+type OrphanedRelationDocsResult struct {
+	ModelUUID    string   `json:"model-uuid"`
+	ScopeDocs    []string `json:"scope-docs,omitempty"`
+	SettingsDocs []string `json:"settings-docs,omitempty"`
+	Error        *Error   `json:"error,omitempty"`
+}
+
+// CleanupOrphanedRelationsResults holds the per-model results of a call
+// to Controller.CleanupOrphanedRelations.
+type CleanupOrphanedRelationsResults struct {
+	Results []OrphanedRelationDocsResult `json:"results"`
+}
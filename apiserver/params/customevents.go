@@ -0,0 +1,25 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+// TriggerCustomEventArgs holds the parameters for triggering one or
+// more charm-defined custom events.
+type TriggerCustomEventArgs struct {
+	// Events holds the events to trigger.
+	Events []TriggerCustomEventArg `json:"events"`
+}
+
+// TriggerCustomEventArg holds the parameters for triggering a single
+// custom event.
+type TriggerCustomEventArg struct {
+	// Receiver is the tag of the unit or application to queue the
+	// event for.
+	Receiver string `json:"receiver"`
+
+	// Name is the name of the custom event as defined by the charm.
+	Name string `json:"name"`
+
+	// Params holds any parameters to pass to the event hook.
+	Params map[string]interface{} `json:"params,omitempty"`
+}
@@ -3,7 +3,11 @@
 
 package params
 
-import "github.com/juju/errors"
+import (
+	"time"
+
+	"github.com/juju/errors"
+)
 
 // FirewallRuleArgs holds the parameters for updating
 // one or more firewall rules.
@@ -25,6 +29,66 @@ type FirewallRule struct {
 
 	// WhitelistCIDRS is the ist of subnets allowed access.
 	WhitelistCIDRS []string `json:"whitelist-cidrs,omitempty"`
+
+	// Disabled is true if the rule is disabled; disabled rules are
+	// retained but not applied.
+	Disabled bool `json:"disabled,omitempty"`
+
+	// UpdatedBy identifies who last changed the rule, if recorded.
+	UpdatedBy string `json:"updated-by,omitempty"`
+
+	// Updated is when the rule was last changed, if recorded.
+	Updated *time.Time `json:"updated,omitempty"`
+}
+
+// FirewallRulesEnabledArgs holds the parameters for enabling or
+// disabling one or more firewall rules.
+type FirewallRulesEnabledArgs struct {
+	// Args holds the parameters for enabling or disabling a rule.
+	Args []FirewallRuleEnabled `json:"args"`
+}
+
+// FirewallRuleEnabled identifies a firewall rule and the enabled
+// state to apply to it.
+type FirewallRuleEnabled struct {
+	// KnownService is the well known service for a firewall rule.
+	KnownService KnownServiceValue `json:"known-service"`
+
+	// Enabled is whether the rule is to be applied.
+	Enabled bool `json:"enabled"`
+}
+
+// ListFirewallRulesHistoryResults holds the results of listing the
+// change history of one or more firewall rules.
+type ListFirewallRulesHistoryResults struct {
+	// Rules holds the change history per rule.
+	Rules []FirewallRuleHistory `json:"rules"`
+}
+
+// FirewallRuleHistory holds the change history of a single firewall
+// rule.
+type FirewallRuleHistory struct {
+	// KnownService is the well known service for the firewall rule.
+	KnownService KnownServiceValue `json:"known-service"`
+
+	// Changes is the rule's recorded change history, oldest first.
+	Changes []FirewallRuleChange `json:"changes"`
+}
+
+// FirewallRuleChange describes one historical change to a firewall
+// rule.
+type FirewallRuleChange struct {
+	// WhitelistCIDRS is the rule's whitelist after the change.
+	WhitelistCIDRS []string `json:"whitelist-cidrs,omitempty"`
+
+	// Disabled is the rule's disabled flag after the change.
+	Disabled bool `json:"disabled,omitempty"`
+
+	// UpdatedBy identifies who made the change.
+	UpdatedBy string `json:"updated-by"`
+
+	// Updated is when the change was made.
+	Updated time.Time `json:"updated"`
 }
 
 // KnownServiceArgs holds the parameters for retrieving firewall rules.
@@ -144,6 +144,42 @@ type ModelCreateArgs struct {
 	// and the owner is the controller owner, the same credential
 	// used for the controller model will be used.
 	CloudCredentialTag string `json:"credential,omitempty"`
+
+	// Template is the name of a controller-level model template whose
+	// config and constraints are applied to the new model. Config
+	// values given explicitly in Config override template values.
+	Template string `json:"template,omitempty"`
+}
+
+// ModelTemplate holds a named set of model defaults stored at the
+// controller level that can be applied when creating a model.
+type ModelTemplate struct {
+	// Name is the name of the template.
+	Name string `json:"name"`
+
+	// Config holds model config values applied to models created
+	// from this template.
+	Config map[string]interface{} `json:"config,omitempty"`
+
+	// Constraints holds a constraints string applied as the model
+	// constraints of models created from this template.
+	Constraints string `json:"constraints,omitempty"`
+}
+
+// ModelTemplates holds a collection of model templates.
+type ModelTemplates struct {
+	Templates []ModelTemplate `json:"templates"`
+}
+
+// ModelTemplateNames identifies model templates by name.
+type ModelTemplateNames struct {
+	Names []string `json:"names"`
+}
+
+// ModelTemplatesResult holds the result of listing model templates.
+type ModelTemplatesResult struct {
+	Templates []ModelTemplate `json:"templates,omitempty"`
+	Error     *Error          `json:"error,omitempty"`
 }
 
 // Model holds the result of an API call returning a name and UUID
@@ -526,6 +562,40 @@ type AgentGetEntitiesResult struct {
 	Error         *Error                 `json:"error,omitempty"`
 }
 
+// WorkerHealth describes the state of a single worker in an agent's
+// dependency engine.
+type WorkerHealth struct {
+	Name       string `json:"name"`
+	State      string `json:"state"`
+	Error      string `json:"error,omitempty"`
+	StartCount int    `json:"start-count"`
+}
+
+// AgentHealthArg holds the worker health report for a single agent.
+type AgentHealthArg struct {
+	Tag     string         `json:"tag"`
+	Workers []WorkerHealth `json:"workers"`
+}
+
+// AgentHealthArgs holds the worker health reports of several agents.
+type AgentHealthArgs struct {
+	Args []AgentHealthArg `json:"args"`
+}
+
+// AgentHealthResult holds an agent's most recent worker health report,
+// or an error.
+type AgentHealthResult struct {
+	Updated time.Time      `json:"updated"`
+	Workers []WorkerHealth `json:"workers,omitempty"`
+	Error   *Error         `json:"error,omitempty"`
+}
+
+// AgentHealthResults holds the worker health reports for several
+// agents.
+type AgentHealthResults struct {
+	Results []AgentHealthResult `json:"results"`
+}
+
 // VersionResult holds the version and possibly error for a given
 // DesiredVersion() API call.
 type VersionResult struct {
@@ -742,6 +812,14 @@ type RunParams struct {
 	// WorkloadContext for CAAS is true when the Commands should be run on
 	// the workload not the operator.
 	WorkloadContext bool `json:"workload-context,omitempty"`
+
+	// OutputLimit is the maximum number of bytes of stdout and stderr
+	// to record for each target, 0 meaning no limit.
+	OutputLimit int `json:"output-limit,omitempty"`
+
+	// CaptureFiles lists files to capture from each target once the
+	// commands complete, recorded with the action results.
+	CaptureFiles []string `json:"capture-files,omitempty"`
 }
 
 // RunResult contains the result from an individual run call on a machine.
@@ -85,6 +85,26 @@ type PinApplicationResult struct {
 	Error *Error `json:"error,omitempty"`
 }
 
+// PinLeadershipBulkParams is a collection of parameters for pinning
+// application leadership on behalf of an operator.
+type PinLeadershipBulkParams struct {
+	// Params are the parameters for pinning application leadership.
+	Params []PinLeadershipParams `json:"params"`
+}
+
+// PinLeadershipParams are the parameters needed for pinning the
+// leadership of a single application.
+type PinLeadershipParams struct {
+	// ApplicationTag is the application whose leadership should be
+	// pinned.
+	ApplicationTag string `json:"application-tag"`
+
+	// DurationSeconds is the number of seconds after which the pin is
+	// automatically released. A value of zero means the pin does not
+	// expire.
+	DurationSeconds float64 `json:"duration,omitempty"`
+}
+
 // PinnedLeadershipResults holds data representing the current applications for
 // which leadership is pinned
 type PinnedLeadershipResult struct {
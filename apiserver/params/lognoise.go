@@ -0,0 +1,34 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+import (
+	"time"
+)
+
+// LogNoiseRequest holds the arguments for a log noise report.
+type LogNoiseRequest struct {
+	// Window is how far back to look when counting log messages. A
+	// zero window means the server's maximum reporting window.
+	Window time.Duration `json:"window,omitempty"`
+
+	// Limit is the maximum number of agents to report. A non-positive
+	// limit means no limit.
+	Limit int `json:"limit,omitempty"`
+}
+
+// LogNoiseResult holds the log noise report for a model.
+type LogNoiseResult struct {
+	Agents []NoisyAgent `json:"agents,omitempty"`
+}
+
+// NoisyAgent describes the log write volume of a single agent.
+type NoisyAgent struct {
+	// AgentTag is the tag of the agent that wrote the log messages.
+	AgentTag string `json:"agent-tag"`
+
+	// Count is the number of log messages the agent wrote over the
+	// requested window.
+	Count uint64 `json:"count"`
+}
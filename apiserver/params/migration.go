@@ -65,6 +65,14 @@ type SetMigrationStatusMessageArgs struct {
 	Message string `json:"message"`
 }
 
+// ExportModelArgs holds the options for a model export.
+type ExportModelArgs struct {
+	// Exclude names aspects of the model to leave out of the
+	// serialized model, for example "status-history" or
+	// "relation-data".
+	Exclude []string `json:"exclude,omitempty"`
+}
+
 // SerializedModel wraps a buffer contain a serialised Juju model. It
 // also contains lists of the charms and tools used in the model.
 type SerializedModel struct {
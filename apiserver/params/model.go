@@ -86,6 +86,41 @@ type ModelUnset struct {
 	Keys []string `json:"keys"`
 }
 
+// ModelConfigApplyArgs contains the arguments for the ApplyModelConfig
+// client API call. Config holds the complete set of model-managed
+// attributes to apply.
+type ModelConfigApplyArgs struct {
+	Config map[string]interface{} `json:"config"`
+}
+
+// ModelConfigDelta holds the before and after values of a single model
+// config attribute.
+type ModelConfigDelta struct {
+	Old interface{} `json:"old,omitempty"`
+	New interface{} `json:"new,omitempty"`
+}
+
+// ModelConfigDiffResult describes the changes made by an
+// ApplyModelConfig call: attributes newly set on the model, attributes
+// whose values changed, and attributes reset to their defaults.
+type ModelConfigDiffResult struct {
+	Added   map[string]interface{}      `json:"added,omitempty"`
+	Changed map[string]ModelConfigDelta `json:"changed,omitempty"`
+	Reset   []string                    `json:"reset,omitempty"`
+}
+
+// ModelFeatureFlag contains the arguments for the SetFeatureFlag
+// client API call.
+type ModelFeatureFlag struct {
+	Flag    string `json:"flag"`
+	Enabled bool   `json:"enabled"`
+}
+
+// ModelFeatureFlagsResult holds the feature flags enabled on a model.
+type ModelFeatureFlagsResult struct {
+	Flags []string `json:"flags,omitempty"`
+}
+
 // ModelSLA contains the arguments for the SetSLALevel client API
 // call.
 type ModelSLA struct {
@@ -121,6 +156,47 @@ type UnsetModelDefaults struct {
 	Keys []ModelUnsetKeys `json:"keys"`
 }
 
+// SetRegionStoragePoolDefaults contains the arguments for
+// SetRegionStoragePoolDefaults client API call.
+type SetRegionStoragePoolDefaults struct {
+	Defaults []RegionStoragePoolDefaults `json:"defaults"`
+}
+
+// RegionStoragePoolDefaults describes changes to the default storage
+// pools created in new models on a cloud/region.
+type RegionStoragePoolDefaults struct {
+	CloudTag    string                            `json:"cloud-tag"`
+	CloudRegion string                            `json:"cloud-region,omitempty"`
+	Update      map[string]map[string]interface{} `json:"update,omitempty"`
+	Remove      []string                          `json:"remove,omitempty"`
+}
+
+// RegionStoragePoolDefaultsSpecs contains the arguments for
+// RegionStoragePoolDefaults client API call.
+type RegionStoragePoolDefaultsSpecs struct {
+	Specs []RegionStoragePoolDefaultsSpec `json:"specs"`
+}
+
+// RegionStoragePoolDefaultsSpec identifies the cloud/region to fetch
+// default storage pools for.
+type RegionStoragePoolDefaultsSpec struct {
+	CloudTag    string `json:"cloud-tag"`
+	CloudRegion string `json:"cloud-region,omitempty"`
+}
+
+// RegionStoragePoolDefaultsResults contains the result of client API
+// calls to get the default storage pools for cloud regions.
+type RegionStoragePoolDefaultsResults struct {
+	Results []RegionStoragePoolDefaultsResult `json:"results"`
+}
+
+// RegionStoragePoolDefaultsResult contains the default storage pools
+// for one cloud region, keyed by pool name.
+type RegionStoragePoolDefaultsResult struct {
+	Pools map[string]map[string]interface{} `json:"pools,omitempty"`
+	Error *Error                            `json:"error,omitempty"`
+}
+
 // SetModelAgentVersion contains the arguments for
 // SetModelAgentVersion client API call.
 type SetModelAgentVersion struct {
@@ -128,6 +204,25 @@ type SetModelAgentVersion struct {
 	IgnoreAgentVersions bool           `json:"force,omitempty"`
 }
 
+// PrecheckModelUpgrade holds the target version for a model upgrade
+// precheck run.
+type PrecheckModelUpgrade struct {
+	TargetVersion version.Number `json:"target-version"`
+}
+
+// ModelUpgradePrecheckResult holds the outcome of a single model
+// upgrade precheck.
+type ModelUpgradePrecheckResult struct {
+	Name  string `json:"name"`
+	Error *Error `json:"error,omitempty"`
+}
+
+// PrecheckModelUpgradeResults holds the readiness report produced by
+// running the model upgrade prechecks.
+type PrecheckModelUpgradeResults struct {
+	Results []ModelUpgradePrecheckResult `json:"results"`
+}
+
 // ModelMigrationStatus holds information about the progress of a (possibly
 // failed) migration.
 type ModelMigrationStatus struct {
@@ -334,6 +429,20 @@ type MachineHardware struct {
 	CpuPower         *uint64   `json:"cpu-power,omitempty"`
 	Tags             *[]string `json:"tags,omitempty"`
 	AvailabilityZone *string   `json:"availability-zone,omitempty"`
+	RootDiskSource   *string   `json:"root-disk-source,omitempty"`
+}
+
+// SetMachinesHardware holds the parameters for updating the recorded
+// hardware characteristics of a set of machines.
+type SetMachinesHardware struct {
+	MachineHardware []MachineHardwareArg `json:"machine-hardware"`
+}
+
+// MachineHardwareArg associates hardware characteristics with the
+// machine they were observed on.
+type MachineHardwareArg struct {
+	Tag      string          `json:"tag"`
+	Hardware MachineHardware `json:"hardware"`
 }
 
 // ModelVolumeInfo holds information about a volume in a model.
@@ -0,0 +1,20 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+// ModelMetadataResult holds a compact metadata view of a model,
+// intended for integrations with strict message size budgets.
+type ModelMetadataResult struct {
+	Name           string `json:"name"`
+	UUID           string `json:"uuid"`
+	Type           string `json:"type"`
+	ControllerUUID string `json:"controller-uuid"`
+	Cloud          string `json:"cloud"`
+	CloudRegion    string `json:"cloud-region,omitempty"`
+	AgentVersion   string `json:"agent-version"`
+
+	ApplicationCount int `json:"application-count"`
+	UnitCount        int `json:"unit-count"`
+	MachineCount     int `json:"machine-count"`
+}
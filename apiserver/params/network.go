@@ -1103,6 +1103,54 @@ type MoveSubnetsResults struct {
 	Results []MoveSubnetsResult `json:"results"`
 }
 
+// ReconcileSpacesArgs holds the arguments of the ReconcileSpaces API call.
+type ReconcileSpacesArgs struct {
+	// Apply, when true, applies the reconciliation plan by reloading
+	// spaces and subnets from the provider.
+	Apply bool `json:"apply"`
+}
+
+// ReconcileMissingSubnet describes a provider subnet that is
+// not recorded in the model.
+type ReconcileMissingSubnet struct {
+	// CIDR is the subnet's CIDR as reported by the provider.
+	CIDR string `json:"cidr"`
+
+	// Space is the name of the space the subnet would be added to.
+	Space string `json:"space"`
+}
+
+// ReconcileMovedSubnet describes a subnet that the provider reports
+// in a different space than the model records.
+type ReconcileMovedSubnet struct {
+	// CIDR is the subnet's CIDR.
+	CIDR string `json:"cidr"`
+
+	// SpaceFrom is the space the subnet is currently recorded in.
+	SpaceFrom string `json:"space-from"`
+
+	// SpaceTo is the space the provider reports the subnet in.
+	SpaceTo string `json:"space-to"`
+}
+
+// ReconcileSpacesResult contains the reconciliation plan for the
+// differences between provider and model network topology.
+type ReconcileSpacesResult struct {
+	// MissingSubnets are provider subnets not present in the model.
+	MissingSubnets []ReconcileMissingSubnet `json:"missing-subnets,omitempty"`
+
+	// StaleSpaces are provider-sourced spaces in the model that the
+	// provider no longer reports.
+	StaleSpaces []string `json:"stale-spaces,omitempty"`
+
+	// MovedSubnets are subnets recorded in the model under a
+	// different space than the provider reports.
+	MovedSubnets []ReconcileMovedSubnet `json:"moved-subnets,omitempty"`
+
+	// Applied is true if the plan was applied.
+	Applied bool `json:"applied"`
+}
+
 // ShowSpaceResult holds the list of all available spaces.
 type ShowSpaceResult struct {
 	// Information about a given space.
@@ -1252,6 +1300,13 @@ type FanConfigResult struct {
 	Fans []FanConfigEntry `json:"fans"`
 }
 
+// DNSConfigResult holds the DNS configuration that a machine should
+// apply, after any machine-specific overrides.
+type DNSConfigResult struct {
+	Servers       []string `json:"servers,omitempty"`
+	SearchDomains []string `json:"search-domains,omitempty"`
+}
+
 // CIDRParams contains a slice of subnet CIDRs used for querying subnets.
 type CIDRParams struct {
 	CIDRS []string `json:"cidrs"`
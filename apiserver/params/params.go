@@ -32,6 +32,12 @@ type Entities struct {
 	Entities []Entity `json:"entities"`
 }
 
+// CancelRequestsArgs holds the ids of in-flight API requests on the
+// current connection that should be cancelled.
+type CancelRequestsArgs struct {
+	RequestIds []uint64 `json:"request-ids"`
+}
+
 // EntitiesResults contains multiple Entities results (where each
 // Entities is the result of a query).
 type EntitiesResults struct {
@@ -155,6 +161,19 @@ type RelationSuspendedArg struct {
 	Suspended  bool   `json:"suspended"`
 }
 
+// RelationFreezeArgs holds the parameters for setting
+// the frozen status of one or more relations.
+type RelationFreezeArgs struct {
+	Args []RelationFreezeArg `json:"args"`
+}
+
+// RelationFreezeArg holds the new frozen status value for a relation.
+type RelationFreezeArg struct {
+	RelationId int    `json:"relation-id"`
+	Message    string `json:"message"`
+	Frozen     bool   `json:"frozen"`
+}
+
 // ProcessRelations holds the information required to process series of
 // relations during a model migration.
 type ProcessRelations struct {
@@ -307,6 +326,21 @@ type RecordAgentStartInformationArg struct {
 	Hostname string `json:"hostname,omitempty"`
 }
 
+// SetMachineOSInfoArgs holds the parameters for updating the operating
+// system information reported by one or more machine agents.
+type SetMachineOSInfoArgs struct {
+	Args []MachineOSInfo `json:"args"`
+}
+
+// MachineOSInfo holds the operating system information reported by a
+// machine agent to the controller.
+type MachineOSInfo struct {
+	Tag            string `json:"tag"`
+	KernelVersion  string `json:"kernel-version,omitempty"`
+	OSPatchLevel   string `json:"os-patch-level,omitempty"`
+	RebootRequired bool   `json:"reboot-required,omitempty"`
+}
+
 // UpdateSeriesArg holds the parameters for updating the series for the
 // specified application or machine. For Application, only known by facade
 // version 5 and greater. For MachineManger, only known by facade version
@@ -427,6 +461,32 @@ type UnitsResolved struct {
 	All   bool     `json:"all,omitempty"`
 }
 
+// UnitErrorSelector matches units in an error state by attribute
+// rather than by name. An empty selector matches every errored unit.
+type UnitErrorSelector struct {
+	Application string `json:"application,omitempty"`
+}
+
+// UnitErrorSelectors holds parameters for the
+// ResolveUnitErrorsBySelector call.
+type UnitErrorSelectors struct {
+	Selectors []UnitErrorSelector `json:"selectors"`
+	Retry     bool                `json:"retry,omitempty"`
+}
+
+// BulkUnitResult reports the outcome of a bulk operation for a single
+// matched unit.
+type BulkUnitResult struct {
+	UnitTag string `json:"unit-tag"`
+	Error   *Error `json:"error,omitempty"`
+}
+
+// BulkUnitResults holds the outcome of a bulk unit operation, one
+// result per matched unit.
+type BulkUnitResults struct {
+	Results []BulkUnitResult `json:"results"`
+}
+
 // AddApplicationUnitsResults holds the names of the units added by the
 // AddUnits call.
 type AddApplicationUnitsResults struct {
@@ -921,6 +981,9 @@ type ControllersSpec struct {
 	Series string `json:"series,omitempty"`
 	// Placement defines specific machines to become new controller machines.
 	Placement []string `json:"placement,omitempty"`
+	// Replace holds the id of a dead controller machine to demote
+	// and replace with a new controller machine.
+	Replace string `json:"replace,omitempty"`
 }
 
 // ControllersServersSpecs contains all the arguments
@@ -1057,6 +1120,10 @@ type LeaseOperation struct {
 // ExportBundleParams holds parameters for exporting Bundles.
 type ExportBundleParams struct {
 	IncludeCharmDefaults bool `json:"include-charm-defaults,omitempty"`
+
+	// ExcludeMachines, if true, removes the machines section and any
+	// application placement directives from the exported bundle.
+	ExcludeMachines bool `json:"exclude-machines,omitempty"`
 }
 
 // BundleChangesParams holds parameters for making Bundle.GetChanges calls.
@@ -1115,6 +1182,25 @@ type BundleChangesMapArgs struct {
 	Requires []string `json:"requires"`
 }
 
+// BundleDiffParams holds parameters for making Bundle.GetBundleDiff calls.
+type BundleDiffParams struct {
+	// BundleDataYAML is the YAML-encoded charm bundle data
+	// (see "github.com/juju/charm.BundleData").
+	BundleDataYAML string `json:"yaml"`
+	// IncludeAnnotations controls whether annotation differences are
+	// included in the diff.
+	IncludeAnnotations bool `json:"include-annotations,omitempty"`
+}
+
+// BundleDiffResult holds the result of the Bundle.GetBundleDiff call.
+type BundleDiffResult struct {
+	// DiffYAML is the YAML-encoded diff between the supplied bundle and
+	// the current model (see "core/bundle/changes".BundleDiff).
+	DiffYAML string `json:"diff-yaml"`
+	// Empty reports whether the bundle and the model match.
+	Empty bool `json:"empty"`
+}
+
 type MongoVersion struct {
 	Major         int    `json:"major"`
 	Minor         int    `json:"minor"`
@@ -1350,6 +1436,15 @@ type BranchTrackArg struct {
 	NumUnits   int      `json:"num-units,omitempty"`
 }
 
+// BranchCharmRevisionArg identifies an in-flight branch and a charm
+// revision that an application should be upgraded to when the branch
+// is committed.
+type BranchCharmRevisionArg struct {
+	BranchName      string `json:"branch"`
+	ApplicationName string `json:"application"`
+	Revision        int    `json:"revision"`
+}
+
 // GenerationApplication represents changes to an application
 // made under a branch.
 type GenerationApplication struct {
@@ -1370,6 +1465,10 @@ type GenerationApplication struct {
 	// Config changes are the effective new configuration values resulting from
 	// changes made under this branch.
 	ConfigChanges map[string]interface{} `json:"config"`
+
+	// CharmRevision, if non-zero, is the charm revision that the application
+	// will be upgraded to when the branch is committed.
+	CharmRevision int `json:"charm-revision,omitempty"`
 }
 
 // Generation represents a model generation's details including config changes.
@@ -1454,3 +1553,93 @@ type CLICommandStatus struct {
 	Done   bool     `json:"done,omitempty"`
 	Error  *Error   `json:"error,omitempty"`
 }
+
+// ReenrollMachineResults contains the results of a
+// MachineManager.ReenrollMachine API request.
+type ReenrollMachineResults struct {
+	Results []ReenrollMachineResult `json:"results"`
+}
+
+// ReenrollMachineResult reports the fresh credentials issued for one
+// manually provisioned machine, or the error that prevented it.
+type ReenrollMachineResult struct {
+	Machine  string `json:"machine"`
+	Password string `json:"password,omitempty"`
+	Error    *Error `json:"error,omitempty"`
+}
+
+// MaintenanceWindow describes a period during which non-urgent
+// automatic operations against a machine (or every machine in the
+// model) are deferred.
+type MaintenanceWindow struct {
+	Start    time.Time     `json:"start"`
+	Duration time.Duration `json:"duration"`
+	Repeat   string        `json:"repeat,omitempty"`
+	Comment  string        `json:"comment,omitempty"`
+}
+
+// EntityMaintenanceWindows associates maintenance windows with a
+// machine or model tag.
+type EntityMaintenanceWindows struct {
+	Tag     string              `json:"tag"`
+	Windows []MaintenanceWindow `json:"windows"`
+}
+
+// SetMaintenanceWindowsArgs holds the maintenance windows to record for
+// a collection of machines or models.
+type SetMaintenanceWindowsArgs struct {
+	Args []EntityMaintenanceWindows `json:"args"`
+}
+
+// MaintenanceWindowsResults contains the results of a
+// MachineManager.MaintenanceWindows API request.
+type MaintenanceWindowsResults struct {
+	Results []MaintenanceWindowsResult `json:"results"`
+}
+
+// MaintenanceWindowsResult reports the maintenance windows defined for
+// one machine or model, or the error that prevented retrieving them.
+type MaintenanceWindowsResult struct {
+	Windows []MaintenanceWindow `json:"windows,omitempty"`
+	Error   *Error              `json:"error,omitempty"`
+}
+
+// EntityDisabledWorkers associates a set of disabled agent worker
+// names with a machine or model tag.
+type EntityDisabledWorkers struct {
+	Tag     string   `json:"tag"`
+	Workers []string `json:"workers"`
+}
+
+// SetDisabledWorkersArgs holds the disabled agent workers to record for
+// a collection of machines or models.
+type SetDisabledWorkersArgs struct {
+	Args []EntityDisabledWorkers `json:"args"`
+}
+
+// DisabledWorkersResults contains the results of a
+// MachineManager.DisabledWorkers API request.
+type DisabledWorkersResults struct {
+	Results []DisabledWorkersResult `json:"results"`
+}
+
+// DisabledWorkersResult reports the agent workers disabled for one
+// machine or model, or the error that prevented retrieving them.
+type DisabledWorkersResult struct {
+	Workers []string `json:"workers,omitempty"`
+	Error   *Error   `json:"error,omitempty"`
+}
+
+// SetContainerNetworkDevicesArgs holds the container network device
+// overrides to record for a collection of host machines.
+type SetContainerNetworkDevicesArgs struct {
+	Args []SetContainerNetworkDevices `json:"args"`
+}
+
+// SetContainerNetworkDevices names the host devices (or bridges) that
+// containers on the given machine should be attached to. An empty
+// device list clears any previously configured override.
+type SetContainerNetworkDevices struct {
+	MachineTag string   `json:"machine-tag"`
+	Devices    []string `json:"devices"`
+}
@@ -0,0 +1,41 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+import (
+	"time"
+)
+
+// AgentPresenceResult holds the agent connection details that the API
+// servers have recorded for a model.
+type AgentPresenceResult struct {
+	Connections []AgentPresenceConnection `json:"connections,omitempty"`
+}
+
+// AgentPresenceConnection holds the presence details for a single agent
+// connection to an API server.
+type AgentPresenceConnection struct {
+	// AgentTag is the stringified machine, unit, or application tag of
+	// the agent that made the connection.
+	AgentTag string `json:"agent-tag"`
+
+	// ControllerAgent is true if the agent is in the controller model.
+	ControllerAgent bool `json:"controller-agent,omitempty"`
+
+	// Server is the stringified machine tag of the API server holding
+	// the connection.
+	Server string `json:"server"`
+
+	// Status is the presence status of the connection, one of
+	// "alive", "missing" or "unknown".
+	Status string `json:"status"`
+
+	// LastSeen is when activity was last recorded for the connection.
+	LastSeen time.Time `json:"last-seen"`
+
+	// ConnectionCount is the number of connections observed for the
+	// agent to this server. A count that keeps climbing indicates a
+	// flapping agent.
+	ConnectionCount uint64 `json:"connection-count"`
+}
@@ -0,0 +1,105 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+import (
+	"time"
+)
+
+// AddServiceAccounts holds the parameters for adding service accounts.
+type AddServiceAccounts struct {
+	Accounts []AddServiceAccount `json:"accounts"`
+}
+
+// AddServiceAccount holds the parameters for adding one service
+// account.
+type AddServiceAccount struct {
+	Name string `json:"name"`
+}
+
+// AddServiceAccountResults holds the results of adding service
+// accounts.
+type AddServiceAccountResults struct {
+	Results []AddServiceAccountResult `json:"results"`
+}
+
+// AddServiceAccountResult holds the result of adding one service
+// account.
+type AddServiceAccountResult struct {
+	Tag   string `json:"tag,omitempty"`
+	Error *Error `json:"error,omitempty"`
+}
+
+// IssueServiceAccountTokens holds the parameters for issuing service
+// account tokens.
+type IssueServiceAccountTokens struct {
+	Tokens []IssueServiceAccountToken `json:"tokens"`
+}
+
+// IssueServiceAccountToken holds the parameters for issuing one token.
+// Empty Models or Facades leave the corresponding scope unrestricted.
+type IssueServiceAccountToken struct {
+	Name    string     `json:"name"`
+	Models  []string   `json:"models,omitempty"`
+	Facades []string   `json:"facades,omitempty"`
+	Expiry  *time.Time `json:"expiry,omitempty"`
+}
+
+// IssueServiceAccountTokenResults holds the results of issuing service
+// account tokens.
+type IssueServiceAccountTokenResults struct {
+	Results []IssueServiceAccountTokenResult `json:"results"`
+}
+
+// IssueServiceAccountTokenResult holds one issued token. The token
+// secret is only ever returned here, at mint time.
+type IssueServiceAccountTokenResult struct {
+	TokenId string `json:"token-id,omitempty"`
+	Token   string `json:"token,omitempty"`
+	Error   *Error `json:"error,omitempty"`
+}
+
+// RevokeServiceAccountTokens holds the parameters for revoking service
+// account tokens.
+type RevokeServiceAccountTokens struct {
+	Tokens []RevokeServiceAccountToken `json:"tokens"`
+}
+
+// RevokeServiceAccountToken identifies one token to revoke.
+type RevokeServiceAccountToken struct {
+	Name    string `json:"name"`
+	TokenId string `json:"token-id"`
+}
+
+// ServiceAccountInfoResults holds the results of listing service
+// accounts.
+type ServiceAccountInfoResults struct {
+	Results []ServiceAccountInfoResult `json:"results"`
+}
+
+// ServiceAccountInfoResult holds the result of listing one service
+// account.
+type ServiceAccountInfoResult struct {
+	Result *ServiceAccountInfo `json:"result,omitempty"`
+	Error  *Error              `json:"error,omitempty"`
+}
+
+// ServiceAccountInfo holds information on one service account.
+type ServiceAccountInfo struct {
+	Name        string                    `json:"name"`
+	Tag         string                    `json:"tag"`
+	CreatedBy   string                    `json:"created-by"`
+	DateCreated time.Time                 `json:"date-created"`
+	Tokens      []ServiceAccountTokenInfo `json:"tokens,omitempty"`
+}
+
+// ServiceAccountTokenInfo holds the metadata of one service account
+// token. The token secret is not recoverable.
+type ServiceAccountTokenInfo struct {
+	TokenId     string     `json:"token-id"`
+	Models      []string   `json:"models,omitempty"`
+	Facades     []string   `json:"facades,omitempty"`
+	DateCreated time.Time  `json:"date-created"`
+	Expiry      *time.Time `json:"expiry,omitempty"`
+}
@@ -18,6 +18,12 @@ import (
 // StatusParams holds parameters for the Status call.
 type StatusParams struct {
 	Patterns []string `json:"patterns"`
+
+	// Include names the sections of the status to compute and return:
+	// "machines", "applications", "remote-applications", "offers",
+	// "relations" or "branches". An empty list selects all sections.
+	// The model summary and controller timestamp are always included.
+	Include []string `json:"include,omitempty"`
 }
 
 // TODO(ericsnow) Add FullStatusResult.
@@ -107,6 +113,15 @@ type MachineStatus struct {
 	// NetworkInterfaces holds a map of NetworkInterface for this machine.
 	NetworkInterfaces map[string]NetworkInterface `json:"network-interfaces,omitempty"`
 
+	// MaintenanceWindows holds the scheduled maintenance windows that
+	// apply to this machine, including any defined model-wide.
+	MaintenanceWindows []MaintenanceWindow `json:"maintenance-windows,omitempty"`
+
+	// DisabledWorkers holds the names of the agent workers that have
+	// been administratively disabled on this machine, including any
+	// disabled model-wide.
+	DisabledWorkers []string `json:"disabled-workers,omitempty"`
+
 	// Containers holds the MachineStatus of any containers hosted on this
 	// machine.
 	Containers map[string]MachineStatus `json:"containers"`
@@ -119,6 +134,12 @@ type MachineStatus struct {
 	// hardware specification datum.
 	Hardware string `json:"hardware"`
 
+	// KernelVersion, OSPatchLevel and RebootRequired describe the
+	// operating system state last reported by the machine agent.
+	KernelVersion  string `json:"kernel-version,omitempty"`
+	OSPatchLevel   string `json:"os-patch-level,omitempty"`
+	RebootRequired bool   `json:"reboot-required,omitempty"`
+
 	Jobs      []model.MachineJob `json:"jobs"`
 	HasVote   bool               `json:"has-vote"`
 	WantsVote bool               `json:"wants-vote"`
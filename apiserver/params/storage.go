@@ -127,6 +127,19 @@ type StorageDetachmentParams struct {
 	MaxWait *time.Duration `json:"max-wait,omitempty"`
 }
 
+// MoveStorageParams holds the parameters for moving storage instances
+// to other machines.
+type MoveStorageParams struct {
+	Moves []MoveStorageParam `json:"moves"`
+}
+
+// MoveStorageParam holds the parameters for moving one storage instance
+// to a unit of the same application on another machine.
+type MoveStorageParam struct {
+	StorageTag string `json:"storage-tag"`
+	MachineTag string `json:"machine-tag"`
+}
+
 // StorageAttachmentIdsResult holds the result of an API call to retrieve the
 // IDs of a unit's attached storage instances.
 type StorageAttachmentIdsResult struct {
@@ -200,6 +213,9 @@ type VolumeInfo struct {
 	// Size is the size of the volume in MiB.
 	Size       uint64 `json:"size"`
 	Persistent bool   `json:"persistent"`
+	// AvailabilityZone is the availability zone the volume was
+	// provisioned into, if the provider reports it.
+	AvailabilityZone string `json:"availability-zone,omitempty"`
 }
 
 // Volumes describes a set of storage volumes in the model.
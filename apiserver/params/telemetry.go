@@ -0,0 +1,34 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+// TelemetryMetricGroup holds one group of metrics as they would be
+// reported to charmhub.
+type TelemetryMetricGroup struct {
+	Group   string            `json:"group"`
+	Metrics map[string]string `json:"metrics"`
+}
+
+// TelemetryApplicationMetrics holds the metrics reported to charmhub for
+// a single application.
+type TelemetryApplicationMetrics struct {
+	Application string            `json:"application"`
+	Metrics     map[string]string `json:"metrics"`
+}
+
+// TelemetryPreviewResult describes the telemetry payload that would be
+// sent to charmhub for a model with its current configuration.
+type TelemetryPreviewResult struct {
+	// Enabled is false when telemetry has been disabled entirely,
+	// in which case no other fields are populated.
+	Enabled bool `json:"enabled"`
+
+	// Groups holds the controller and model metric groups that
+	// would be included in the request.
+	Groups []TelemetryMetricGroup `json:"groups,omitempty"`
+
+	// Applications holds the per-application metrics that would be
+	// included in each request context.
+	Applications []TelemetryApplicationMetrics `json:"applications,omitempty"`
+}
@@ -0,0 +1,32 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+import (
+	"time"
+)
+
+// UserLeaseClaim represents a request to acquire or extend a
+// user-defined lease on behalf of the requesting entity.
+type UserLeaseClaim struct {
+	Name     string        `json:"name"`
+	Duration time.Duration `json:"duration"`
+}
+
+// UserLeaseClaims holds any number of UserLeaseClaim~s.
+type UserLeaseClaims struct {
+	Claims []UserLeaseClaim `json:"claims"`
+}
+
+// UserLeaseNames identifies user-defined leases by name.
+type UserLeaseNames struct {
+	Names []string `json:"names"`
+}
+
+// UserLeasesResult holds the user-defined leases in a model, keyed on
+// lease name with the holder as value.
+type UserLeasesResult struct {
+	Leases map[string]string `json:"leases,omitempty"`
+	Error  *Error            `json:"error,omitempty"`
+}
@@ -0,0 +1,59 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"sync"
+	"time"
+)
+
+// resourceDownloadRetryAfter is the delay hinted to unit agents whose
+// resource downloads are deferred because a download limit is reached.
+const resourceDownloadRetryAfter = 10 * time.Second
+
+// resourceDownloadLimiter tracks the unit resource downloads currently
+// being served so that caps configured on the controller can be applied
+// both across all applications and per application. Downloads over
+// either cap are deferred rather than queued; the handler tells the
+// requesting agent to retry later.
+type resourceDownloadLimiter struct {
+	mu sync.Mutex
+
+	global         int
+	perApplication map[string]int
+}
+
+func newResourceDownloadLimiter() *resourceDownloadLimiter {
+	return &resourceDownloadLimiter{
+		perApplication: make(map[string]int),
+	}
+}
+
+// Acquire attempts to reserve a download slot for the given application
+// subject to the supplied limits, where a limit of 0 means unlimited.
+// It returns false if either limit is currently reached.
+func (l *resourceDownloadLimiter) Acquire(appName string, globalLimit, applicationLimit int) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if globalLimit > 0 && l.global >= globalLimit {
+		return false
+	}
+	if applicationLimit > 0 && l.perApplication[appName] >= applicationLimit {
+		return false
+	}
+	l.global++
+	l.perApplication[appName]++
+	return true
+}
+
+// Release returns a slot previously reserved with Acquire.
+func (l *resourceDownloadLimiter) Release(appName string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.global--
+	l.perApplication[appName]--
+	if l.perApplication[appName] <= 0 {
+		delete(l.perApplication, appName)
+	}
+}
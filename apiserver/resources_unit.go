@@ -21,12 +21,26 @@ import (
 // resources.
 type UnitResourcesHandler struct {
 	NewOpener func(*http.Request, ...string) (resource.Opener, state.PoolHelper, error)
+
+	// AcquireSlot reserves a download slot for the request, applying
+	// any download limits configured on the controller. It returns a
+	// function releasing the slot, or an error carrying a retry hint
+	// if the request should be deferred. If nil, no limits apply.
+	AcquireSlot func(*http.Request) (func(), error)
 }
 
 // ServeHTTP implements http.Handler.
 func (h *UnitResourcesHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
 	switch req.Method {
 	case "GET":
+		if h.AcquireSlot != nil {
+			release, err := h.AcquireSlot(req)
+			if err != nil {
+				api.SendHTTPError(resp, err)
+				return
+			}
+			defer release()
+		}
 		opener, ph, err := h.NewOpener(req, names.UnitTagKind, names.ApplicationTagKind)
 		if err != nil {
 			api.SendHTTPError(resp, err)
@@ -0,0 +1,27 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"fmt"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+)
+
+// serviceAccountFacadesOnly returns a checker that restricts an API
+// connection to the facades a service account token is scoped to. The
+// Admin and Pinger facades are always allowed so that login and
+// connection keep-alives keep working regardless of scope.
+func serviceAccountFacadesOnly(allowed []string) func(string, string) error {
+	facadeNames := set.NewStrings(allowed...)
+	facadeNames.Add("Admin")
+	facadeNames.Add("Pinger")
+	return func(facadeName, _ string) error {
+		if !facadeNames.Contains(facadeName) {
+			return errors.NewNotSupported(nil, fmt.Sprintf("facade %q not supported for service account token scope", facadeName))
+		}
+		return nil
+	}
+}
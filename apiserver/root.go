@@ -110,6 +110,17 @@ func newAPIHandler(srv *Server, st *state.State, rpcConn *rpc.Conn, modelUUID st
 		return nil, errors.Trace(err)
 	}
 
+	// The RequestCanceller facade cancels in-flight requests on this
+	// connection via the rpc layer.
+	if rpcConn != nil {
+		if err := r.resources.RegisterNamed(
+			"requestCanceller",
+			common.ValueResource{Value: rpcConn},
+		); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+
 	// Facades involved with managing application offers need the auth context
 	// to mint and validate macaroons.
 	localOfferAccessEndpoint := url.URL{
@@ -483,6 +494,11 @@ func (ctx *facadeContext) ModelPresence(modelUUID string) facade.ModelPresence {
 	return ctx.r.shared.presence.Connections().ForModel(modelUUID)
 }
 
+// LogNoise implements facade.Context.
+func (ctx *facadeContext) LogNoise() facade.LogNoise {
+	return ctx.r.shared.logNoise
+}
+
 // Hub implements facade.Context.
 func (ctx *facadeContext) Hub() facade.Hub {
 	return ctx.r.shared.centralHub
@@ -594,6 +610,30 @@ func (ctx *facadeContext) SingularClaimer() (lease.Claimer, error) {
 	)
 }
 
+// UserLeaseClaimer is part of the facade.Context interface.
+func (ctx *facadeContext) UserLeaseClaimer() (lease.Claimer, error) {
+	return ctx.r.shared.leaseManager.Claimer(
+		lease.UserNamespace,
+		ctx.State().ModelUUID(),
+	)
+}
+
+// UserLeaseRevoker is part of the facade.Context interface.
+func (ctx *facadeContext) UserLeaseRevoker() (lease.Revoker, error) {
+	return ctx.r.shared.leaseManager.Revoker(
+		lease.UserNamespace,
+		ctx.State().ModelUUID(),
+	)
+}
+
+// UserLeaseReader is part of the facade.Context interface.
+func (ctx *facadeContext) UserLeaseReader() (lease.Reader, error) {
+	return ctx.r.shared.leaseManager.Reader(
+		lease.UserNamespace,
+		ctx.State().ModelUUID(),
+	)
+}
+
 func (ctx *facadeContext) Raft() facade.RaftContext {
 	return &raftMediator{
 		queue:  ctx.r.shared.raftOpQueue,
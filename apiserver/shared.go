@@ -14,6 +14,7 @@ import (
 	jujucontroller "github.com/juju/juju/controller"
 	"github.com/juju/juju/core/cache"
 	"github.com/juju/juju/core/lease"
+	"github.com/juju/juju/core/lognoise"
 	"github.com/juju/juju/core/multiwatcher"
 	"github.com/juju/juju/core/presence"
 	"github.com/juju/juju/pubsub/controller"
@@ -41,6 +42,7 @@ type sharedServerContext struct {
 	multiwatcherFactory multiwatcher.Factory
 	centralHub          SharedHub
 	presence            presence.Recorder
+	logNoise            *lognoise.Recorder
 	leaseManager        lease.Manager
 	raftOpQueue         Queue
 	logger              loggo.Logger
@@ -59,6 +61,7 @@ type sharedServerConfig struct {
 	multiwatcherFactory multiwatcher.Factory
 	centralHub          SharedHub
 	presence            presence.Recorder
+	logNoise            *lognoise.Recorder
 	leaseManager        lease.Manager
 	controllerConfig    jujucontroller.Config
 	raftOpQueue         Queue
@@ -81,6 +84,9 @@ func (c *sharedServerConfig) validate() error {
 	if c.presence == nil {
 		return errors.NotValidf("nil presence")
 	}
+	if c.logNoise == nil {
+		return errors.NotValidf("nil logNoise")
+	}
 	if c.leaseManager == nil {
 		return errors.NotValidf("nil leaseManager")
 	}
@@ -103,6 +109,7 @@ func newSharedServerContext(config sharedServerConfig) (*sharedServerContext, er
 		multiwatcherFactory: config.multiwatcherFactory,
 		centralHub:          config.centralHub,
 		presence:            config.presence,
+		logNoise:            config.logNoise,
 		leaseManager:        config.leaseManager,
 		logger:              config.logger,
 		controllerConfig:    config.controllerConfig,
@@ -17,6 +17,7 @@ import (
 
 	corecontroller "github.com/juju/juju/controller"
 	"github.com/juju/juju/core/cache"
+	"github.com/juju/juju/core/lognoise"
 	"github.com/juju/juju/core/presence"
 	"github.com/juju/juju/core/raft/queue"
 	"github.com/juju/juju/pubsub/controller"
@@ -77,6 +78,7 @@ func (s *sharedServerContextSuite) SetUpTest(c *gc.C) {
 		multiwatcherFactory: multiWatcherWorker,
 		centralHub:          s.hub,
 		presence:            presence.New(clock.WallClock),
+		logNoise:            lognoise.New(clock.WallClock),
 		leaseManager:        &lease.Manager{},
 		controllerConfig:    controllerConfig,
 		raftOpQueue:         queue.NewOpQueue(clock.WallClock),
@@ -177,6 +177,13 @@ func (a *Authenticator) checkCreds(
 	if err != nil {
 		return httpcontext.AuthInfo{}, errors.Trace(err)
 	}
+	if sa, ok := entity.(*authentication.ServiceAccountEntity); ok {
+		// The token's model scope is enforced here, at connection
+		// time; its facade scope is enforced on the API root.
+		if !sa.Token.AllowsModel(st.ModelUUID()) {
+			return httpcontext.AuthInfo{}, errors.Unauthorizedf("service account token not valid for model %q", st.ModelUUID())
+		}
+	}
 
 	authInfo := httpcontext.AuthInfo{Entity: entity}
 	type withIsManager interface {
@@ -212,6 +212,11 @@ func (a authenticator) authenticatorForTag(tag names.Tag) (authentication.Entity
 		}
 	}
 	if tag.Kind() == names.UserTagKind {
+		if userTag, ok := tag.(names.UserTag); ok && userTag.Domain() == state.ServiceAccountDomain {
+			// Service accounts authenticate with tokens held on the
+			// controller, never with passwords or macaroons.
+			return &authentication.ServiceAccountAuthenticator{ServiceAccounts: a.ctxt.st}, nil
+		}
 		return a.localUserAuth(), nil
 	}
 	return nil, errors.Annotatef(apiservererrors.ErrBadRequest, "unexpected login entity tag")
@@ -0,0 +1,184 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package testing
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/state"
+)
+
+// FakeState is an in-memory double of the state interfaces consumed by
+// the simpler client facades. It is deliberately not a full state
+// implementation: it covers entity lookup, annotations and command
+// blocks, which is enough for those facades' unit tests to run without
+// a mongo-backed JujuConnSuite.
+type FakeState struct {
+	modelTag    names.ModelTag
+	entities    map[string]state.Entity
+	annotations map[string]map[string]string
+	blocks      map[state.BlockType]*FakeBlock
+	nextBlockId int
+}
+
+// NewFakeState returns a FakeState for the model with the given tag.
+func NewFakeState(modelTag names.ModelTag) *FakeState {
+	return &FakeState{
+		modelTag:    modelTag,
+		entities:    make(map[string]state.Entity),
+		annotations: make(map[string]map[string]string),
+		blocks:      make(map[state.BlockType]*FakeBlock),
+	}
+}
+
+// ModelTag returns the tag of the model hosted by the fake.
+func (st *FakeState) ModelTag() names.ModelTag {
+	return st.modelTag
+}
+
+// AddEntity registers an entity double with the given tag so that it
+// can be looked up with FindEntity.
+func (st *FakeState) AddEntity(tag names.Tag) state.Entity {
+	entity := &fakeEntity{tag: tag}
+	st.entities[tag.String()] = entity
+	return entity
+}
+
+// RemoveEntity deregisters the entity with the given tag, discarding
+// any annotations held against it, mirroring the cleanup the real
+// state performs when an entity is removed.
+func (st *FakeState) RemoveEntity(tag names.Tag) {
+	delete(st.entities, tag.String())
+	delete(st.annotations, tag.String())
+}
+
+// FindEntity returns the registered entity with the given tag.
+func (st *FakeState) FindEntity(tag names.Tag) (state.Entity, error) {
+	entity, ok := st.entities[tag.String()]
+	if !ok {
+		return nil, errors.NotFoundf("entity %q", tag)
+	}
+	return entity, nil
+}
+
+// Annotations returns the annotations held against the given entity,
+// or an empty map if there are none.
+func (st *FakeState) Annotations(entity state.GlobalEntity) (map[string]string, error) {
+	result := make(map[string]string)
+	for key, value := range st.annotations[entity.Tag().String()] {
+		result[key] = value
+	}
+	return result, nil
+}
+
+// SetAnnotations merges the given pairs into the annotations held
+// against the given entity. As with the real state, an empty value
+// removes the annotation and keys must not contain periods.
+func (st *FakeState) SetAnnotations(entity state.GlobalEntity, pairs map[string]string) error {
+	for key := range pairs {
+		if strings.Contains(key, ".") {
+			return errors.Annotatef(
+				fmt.Errorf("invalid key %q", key), "cannot update annotations on %s", entity.Tag())
+		}
+	}
+	tag := entity.Tag().String()
+	current := st.annotations[tag]
+	if current == nil {
+		current = make(map[string]string)
+		st.annotations[tag] = current
+	}
+	for key, value := range pairs {
+		if value == "" {
+			delete(current, key)
+		} else {
+			current[key] = value
+		}
+	}
+	return nil
+}
+
+// AllBlocks returns all blocks currently switched on.
+func (st *FakeState) AllBlocks() ([]state.Block, error) {
+	var result []state.Block
+	for _, b := range st.blocks {
+		result = append(result, b)
+	}
+	return result, nil
+}
+
+// SwitchBlockOn switches on a block of the given type, updating the
+// message if the block is already on.
+func (st *FakeState) SwitchBlockOn(t state.BlockType, msg string) error {
+	if b, ok := st.blocks[t]; ok {
+		b.message = msg
+		return nil
+	}
+	st.blocks[t] = &FakeBlock{
+		id:        fmt.Sprint(st.nextBlockId),
+		modelUUID: st.modelTag.Id(),
+		t:         t,
+		message:   msg,
+	}
+	st.nextBlockId++
+	return nil
+}
+
+// SwitchBlockOff switches off the block of the given type, if any.
+func (st *FakeState) SwitchBlockOff(t state.BlockType) error {
+	delete(st.blocks, t)
+	return nil
+}
+
+// FakeBlock is an in-memory implementation of state.Block. The
+// embedded interface satisfies the unexported parts of the interface;
+// they must not be called.
+type FakeBlock struct {
+	state.Block
+	id        string
+	modelUUID string
+	t         state.BlockType
+	message   string
+}
+
+// Id is part of the state.Block interface.
+func (b *FakeBlock) Id() string {
+	return b.id
+}
+
+// ModelUUID is part of the state.Block interface.
+func (b *FakeBlock) ModelUUID() string {
+	return b.modelUUID
+}
+
+// Tag is part of the state.Block interface.
+func (b *FakeBlock) Tag() (names.Tag, error) {
+	return names.NewModelTag(b.modelUUID), nil
+}
+
+// Type is part of the state.Block interface.
+func (b *FakeBlock) Type() state.BlockType {
+	return b.t
+}
+
+// Message is part of the state.Block interface.
+func (b *FakeBlock) Message() string {
+	return b.message
+}
+
+// fakeEntity is a state entity double known only by its tag. The
+// embedded interface satisfies state.GlobalEntity's unexported
+// globalKey method; it must not be called.
+type fakeEntity struct {
+	state.GlobalEntity
+	tag names.Tag
+}
+
+// Tag is part of the state.Entity interface.
+func (e *fakeEntity) Tag() names.Tag {
+	return e.tag
+}
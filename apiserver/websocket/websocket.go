@@ -38,6 +38,11 @@ var websocketUpgrader = websocket.Upgrader{
 	CheckOrigin: func(r *http.Request) bool { return true },
 }
 
+var websocketCompressedUpgrader = websocket.Upgrader{
+	CheckOrigin:       func(r *http.Request) bool { return true },
+	EnableCompression: true,
+}
+
 // Conn wraps a gorilla/websocket.Conn, providing additional Juju-specific
 // functionality.
 type Conn struct {
@@ -47,7 +52,19 @@ type Conn struct {
 // Serve upgrades an HTTP connection to a websocket, and
 // serves the given handler.
 func Serve(w http.ResponseWriter, req *http.Request, handler func(ws *Conn)) {
-	conn, err := websocketUpgrader.Upgrade(w, req, nil)
+	serve(&websocketUpgrader, w, req, handler)
+}
+
+// ServeCompressed upgrades an HTTP connection to a websocket as Serve
+// does, but additionally offers permessage-deflate compression to the
+// client. Compression is only used on connections from clients that
+// negotiate it.
+func ServeCompressed(w http.ResponseWriter, req *http.Request, handler func(ws *Conn)) {
+	serve(&websocketCompressedUpgrader, w, req, handler)
+}
+
+func serve(upgrader *websocket.Upgrader, w http.ResponseWriter, req *http.Request, handler func(ws *Conn)) {
+	conn, err := upgrader.Upgrade(w, req, nil)
 	if err != nil {
 		logger.Errorf("problem initiating websocket: %v", err)
 		return
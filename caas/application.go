@@ -125,6 +125,11 @@ type ApplicationConfig struct {
 	// InitialScale is used to provide the initial desired scale of the application.
 	// After the application is created, InitialScale has no effect.
 	InitialScale int
+
+	// WorkloadPatch is an operator supplied strategic-merge patch,
+	// taken from application config, that is applied to the generated
+	// workload resource (StatefulSet, Deployment or DaemonSet).
+	WorkloadPatch string
 }
 
 // ContainerConfig describes a container that is deployed alonside the uniter/charm container.
@@ -342,6 +342,14 @@ type Service struct {
 	Scale      *int
 	Generation *int64
 	Status     status.StatusInfo
+
+	// ExternalNames holds any DNS names by which the service
+	// is reachable from outside the cluster.
+	ExternalNames []string
+
+	// PortAnnotations holds details of each named service port,
+	// eg the protocol, target port and any node port.
+	PortAnnotations map[string]map[string]string
 }
 
 // FilesystemInfo represents information about a filesystem
@@ -5,6 +5,7 @@ package application
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"sort"
@@ -29,6 +30,8 @@ import (
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
@@ -393,6 +396,10 @@ func (a *app) Ensure(config caas.ApplicationConfig) (err error) {
 			return errors.Trace(err)
 		}
 
+		if err := applyWorkloadPatch(config.WorkloadPatch, &statefulset.StatefulSet); err != nil {
+			return errors.Trace(err)
+		}
+
 		applier.Apply(&statefulset)
 	case caas.DeploymentStateless:
 		exists := true
@@ -441,6 +448,10 @@ func (a *app) Ensure(config caas.ApplicationConfig) (err error) {
 			},
 		}
 
+		if err := applyWorkloadPatch(config.WorkloadPatch, &deployment.Deployment); err != nil {
+			return errors.Trace(err)
+		}
+
 		applier.Apply(&deployment)
 	case caas.DeploymentDaemon:
 		storageUniqueID, err := a.getStorageUniqPrefix(func() (annotationGetter, error) {
@@ -476,6 +487,10 @@ func (a *app) Ensure(config caas.ApplicationConfig) (err error) {
 				},
 			},
 		}
+		if err := applyWorkloadPatch(config.WorkloadPatch, &daemonset.DaemonSet); err != nil {
+			return errors.Trace(err)
+		}
+
 		applier.Apply(&daemonset)
 	default:
 		return errors.NotSupportedf("unknown deployment type")
@@ -484,6 +499,27 @@ func (a *app) Ensure(config caas.ApplicationConfig) (err error) {
 	return applier.Run(context.Background(), a.client, false)
 }
 
+// applyWorkloadPatch applies the operator supplied strategic-merge patch
+// from application config to the generated workload resource in place.
+func applyWorkloadPatch(patch string, workload interface{}) error {
+	if patch == "" {
+		return nil
+	}
+	patchJSON, err := utilyaml.ToJSON([]byte(patch))
+	if err != nil {
+		return errors.Annotate(err, "parsing workload patch")
+	}
+	originalJSON, err := json.Marshal(workload)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	patchedJSON, err := strategicpatch.StrategicMergePatch(originalJSON, patchJSON, workload)
+	if err != nil {
+		return errors.Annotate(err, "applying workload patch")
+	}
+	return errors.Annotate(json.Unmarshal(patchedJSON, workload), "decoding patched workload")
+}
+
 // Upgrade upgrades the app to the specified version.
 func (a *app) Upgrade(ver version.Number) error {
 	// TODO(sidecar): Unify this with Ensure
@@ -1118,6 +1154,12 @@ func (a *app) Units() ([]caas.Unit, error) {
 			},
 		}
 
+		// Record any containers with failing health checks in the status
+		// data so that they end up in the unit's status history.
+		if unready := p.UnreadyContainers(); len(unready) > 0 {
+			unitInfo.Status.Data = map[string]interface{}{"unready-containers": unready}
+		}
+
 		volumesByName := make(map[string]corev1.Volume)
 		for _, pv := range p.Spec.Volumes {
 			volumesByName[pv.Name] = pv
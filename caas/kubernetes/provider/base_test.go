@@ -181,9 +181,10 @@ func (s *BaseSuite) SetUpTest(c *gc.C) {
 
 	// init config for each test for easier changing config inside test.
 	cfg, err := config.New(config.UseDefaults, coretesting.FakeConfig().Merge(coretesting.Attrs{
-		config.NameKey:                  "test",
-		k8sconstants.OperatorStorageKey: "",
-		k8sconstants.WorkloadStorageKey: "",
+		config.NameKey:                         "test",
+		k8sconstants.OperatorStorageKey:        "",
+		k8sconstants.WorkloadStorageKey:        "",
+		k8sconstants.AdoptExistingNamespaceKey: false,
 	}))
 	c.Assert(err, jc.ErrorIsNil)
 	s.cfg = cfg
@@ -512,9 +513,10 @@ func (s *fakeClientSuite) SetUpTest(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 
 	s.cfg, err = config.New(config.UseDefaults, coretesting.FakeConfig().Merge(coretesting.Attrs{
-		config.NameKey:                  "test",
-		k8sconstants.OperatorStorageKey: "",
-		k8sconstants.WorkloadStorageKey: "",
+		config.NameKey:                         "test",
+		k8sconstants.OperatorStorageKey:        "",
+		k8sconstants.WorkloadStorageKey:        "",
+		k8sconstants.AdoptExistingNamespaceKey: false,
 	}))
 	c.Assert(err, jc.ErrorIsNil)
 
@@ -4,9 +4,13 @@
 package provider
 
 import (
+	"encoding/json"
+
+	"github.com/juju/errors"
 	"github.com/juju/schema"
 	"gopkg.in/juju/environschema.v1"
 	core "k8s.io/api/core/v1"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
 )
 
 const (
@@ -24,6 +28,11 @@ const (
 	serviceExternalNameKey             = "kubernetes-service-externalname"
 	serviceAnnotationsKey              = "kubernetes-service-annotations"
 
+	// WorkloadPatchConfigKey is the application config key holding an
+	// operator supplied strategic-merge patch that is applied to the
+	// workload resource generated for the application.
+	WorkloadPatchConfigKey = "kubernetes-workload-patch"
+
 	ingressClassKey          = "kubernetes-ingress-class"
 	ingressSSLRedirectKey    = "kubernetes-ingress-ssl-redirect"
 	ingressSSLPassthroughKey = "kubernetes-ingress-ssl-passthrough"
@@ -66,6 +75,11 @@ var configFields = environschema.Fields{
 		Type:        environschema.Tstring,
 		Group:       environschema.ProviderGroup,
 	},
+	WorkloadPatchConfigKey: {
+		Description: "strategic-merge patch (YAML or JSON) applied to the generated StatefulSet, Deployment or DaemonSet",
+		Type:        environschema.Tstring,
+		Group:       environschema.ProviderGroup,
+	},
 	ingressClassKey: {
 		Description: "the class of the ingress controller to be used by the ingress resource",
 		Type:        environschema.Tstring,
@@ -91,6 +105,7 @@ var configFields = environschema.Fields{
 var schemaDefaults = schema.Defaults{
 	ServiceTypeConfigKey:     schema.Omit,
 	serviceAnnotationsKey:    schema.Omit,
+	WorkloadPatchConfigKey:   schema.Omit,
 	ingressClassKey:          defaultIngressClass,
 	ingressSSLRedirectKey:    defaultIngressSSLRedirect,
 	ingressSSLPassthroughKey: defaultIngressSSLPassthrough,
@@ -108,3 +123,20 @@ func ConfigSchema() environschema.Fields {
 func ConfigDefaults() schema.Defaults {
 	return schemaDefaults
 }
+
+// ValidateWorkloadPatch ensures that the input can be parsed as a
+// strategic-merge patch document.
+func ValidateWorkloadPatch(value string) error {
+	if value == "" {
+		return nil
+	}
+	patchJSON, err := utilyaml.ToJSON([]byte(value))
+	if err != nil {
+		return errors.Annotate(err, "parsing workload patch")
+	}
+	var patch map[string]interface{}
+	if err := json.Unmarshal(patchJSON, &patch); err != nil {
+		return errors.NotValidf("workload patch: expected a mapping document")
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/caas/kubernetes/provider"
+	"github.com/juju/juju/testing"
+)
+
+type configSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&configSuite{})
+
+func (s *configSuite) TestValidateWorkloadPatchEmpty(c *gc.C) {
+	c.Assert(provider.ValidateWorkloadPatch(""), jc.ErrorIsNil)
+}
+
+func (s *configSuite) TestValidateWorkloadPatchYAML(c *gc.C) {
+	patch := `
+spec:
+  template:
+    metadata:
+      labels:
+        extra: label
+`[1:]
+	c.Assert(provider.ValidateWorkloadPatch(patch), jc.ErrorIsNil)
+}
+
+func (s *configSuite) TestValidateWorkloadPatchJSON(c *gc.C) {
+	patch := `{"spec": {"template": {"spec": {"tolerations": []}}}}`
+	c.Assert(provider.ValidateWorkloadPatch(patch), jc.ErrorIsNil)
+}
+
+func (s *configSuite) TestValidateWorkloadPatchNotAMapping(c *gc.C) {
+	err := provider.ValidateWorkloadPatch("- just\n- a\n- list\n")
+	c.Assert(err, gc.ErrorMatches, `workload patch: expected a mapping document not valid`)
+}
+
+func (s *configSuite) TestValidateWorkloadPatchGarbage(c *gc.C) {
+	err := provider.ValidateWorkloadPatch(": not yaml {")
+	c.Assert(err, gc.NotNil)
+}
@@ -42,6 +42,11 @@ const (
 
 	// CAASImageRepoSecretName is the name of the secret for image pull.
 	CAASImageRepoSecretName = "juju-image-pull-secret"
+
+	// AdoptExistingNamespaceKey is the model config attribute used to
+	// allow the model to adopt a pre-created namespace rather than
+	// requiring Juju to create it.
+	AdoptExistingNamespaceKey = "adopt-existing-namespace"
 )
 
 // DefaultPropagationPolicy returns the default propagation policy.
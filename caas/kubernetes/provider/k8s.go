@@ -638,6 +638,8 @@ func (k *kubernetesClient) GetService(appName string, mode caas.DeploymentMode,
 		if svc != nil {
 			result.Id = string(svc.GetUID())
 			result.Addresses = utils.GetSvcAddresses(svc, includeClusterIP)
+			result.ExternalNames = utils.GetSvcExternalNames(svc)
+			result.PortAnnotations = utils.GetSvcPortAnnotations(svc)
 		}
 	}
 
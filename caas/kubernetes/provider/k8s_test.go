@@ -45,6 +45,7 @@ import (
 
 	"github.com/juju/juju/caas"
 	"github.com/juju/juju/caas/kubernetes/provider"
+	k8sconstants "github.com/juju/juju/caas/kubernetes/provider/constants"
 	k8sspecs "github.com/juju/juju/caas/kubernetes/provider/specs"
 	k8sutils "github.com/juju/juju/caas/kubernetes/provider/utils"
 	k8swatcher "github.com/juju/juju/caas/kubernetes/provider/watcher"
@@ -1906,6 +1907,100 @@ func (s *K8sBrokerSuite) TestCreateAlreadyExists(c *gc.C) {
 	c.Assert(err, jc.Satisfies, errors.IsAlreadyExists)
 }
 
+func (s *K8sBrokerSuite) TestCreateAdoptExistingNamespace(c *gc.C) {
+	var err error
+	s.cfg, err = s.cfg.Apply(map[string]interface{}{
+		k8sconstants.AdoptExistingNamespaceKey: true,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	ctrl := s.setupController(c)
+	defer ctrl.Finish()
+
+	ns := s.ensureJujuNamespaceAnnotations(false, &core.Namespace{
+		ObjectMeta: v1.ObjectMeta{
+			Labels: map[string]string{"app.kubernetes.io/managed-by": "juju", "model.juju.is/name": "test"},
+			Name:   "test",
+		},
+	})
+	existingNS := &core.Namespace{
+		ObjectMeta: v1.ObjectMeta{
+			Name:   "test",
+			Labels: map[string]string{"team": "alpha"},
+		},
+	}
+	adoptedNS := s.ensureJujuNamespaceAnnotations(false, &core.Namespace{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "test",
+			Labels: map[string]string{
+				"team":                         "alpha",
+				"app.kubernetes.io/managed-by": "juju",
+				"model.juju.is/name":           "test",
+			},
+		},
+	})
+	gomock.InOrder(
+		s.mockNamespaces.EXPECT().Create(gomock.Any(), ns, v1.CreateOptions{}).
+			Return(nil, s.k8sAlreadyExistsError()),
+		s.mockNamespaces.EXPECT().Get(gomock.Any(), "test", v1.GetOptions{}).
+			Return(existingNS, nil),
+		s.mockNamespaces.EXPECT().Update(gomock.Any(), adoptedNS, v1.UpdateOptions{}).
+			Return(adoptedNS, nil),
+		s.mockDeployments.EXPECT().List(gomock.Any(), v1.ListOptions{}).
+			Return(&appsv1.DeploymentList{}, nil),
+		s.mockStatefulSets.EXPECT().List(gomock.Any(), v1.ListOptions{}).
+			Return(&appsv1.StatefulSetList{}, nil),
+		s.mockDaemonSets.EXPECT().List(gomock.Any(), v1.ListOptions{}).
+			Return(&appsv1.DaemonSetList{}, nil),
+		s.mockServices.EXPECT().List(gomock.Any(), v1.ListOptions{}).
+			Return(&core.ServiceList{Items: []core.Service{
+				{ObjectMeta: v1.ObjectMeta{Name: "legacy-db"}},
+			}}, nil),
+	)
+
+	err = s.broker.Create(
+		&context.CloudCallContext{},
+		environs.CreateParams{},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *K8sBrokerSuite) TestCreateAdoptNamespaceOwnedByAnotherModel(c *gc.C) {
+	var err error
+	s.cfg, err = s.cfg.Apply(map[string]interface{}{
+		k8sconstants.AdoptExistingNamespaceKey: true,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	ctrl := s.setupController(c)
+	defer ctrl.Finish()
+
+	ns := s.ensureJujuNamespaceAnnotations(false, &core.Namespace{
+		ObjectMeta: v1.ObjectMeta{
+			Labels: map[string]string{"app.kubernetes.io/managed-by": "juju", "model.juju.is/name": "test"},
+			Name:   "test",
+		},
+	})
+	existingNS := &core.Namespace{
+		ObjectMeta: v1.ObjectMeta{
+			Name:        "test",
+			Annotations: map[string]string{"model.juju.is/id": "baadf00d-0bad-400d-8000-4b1d0d06f00d"},
+		},
+	}
+	gomock.InOrder(
+		s.mockNamespaces.EXPECT().Create(gomock.Any(), ns, v1.CreateOptions{}).
+			Return(nil, s.k8sAlreadyExistsError()),
+		s.mockNamespaces.EXPECT().Get(gomock.Any(), "test", v1.GetOptions{}).
+			Return(existingNS, nil),
+	)
+
+	err = s.broker.Create(
+		&context.CloudCallContext{},
+		environs.CreateParams{},
+	)
+	c.Assert(err, jc.Satisfies, errors.IsAlreadyExists)
+}
+
 func unitStatefulSetArg(numUnits int32, scName string, podSpec core.PodSpec) *appsv1.StatefulSet {
 	return &appsv1.StatefulSet{
 		ObjectMeta: v1.ObjectMeta{
@@ -3199,6 +3294,10 @@ func (s *K8sBrokerSuite) TestGetServiceSvcFoundNoWorkload(c *gc.C) {
 				network.NewProviderAddress("10.0.0.1", network.WithScope(network.ScopePublic)),
 				network.NewProviderAddress("host.com.au", network.WithScope(network.ScopePublic)),
 			},
+			ExternalNames: []string{"host.com.au"},
+			PortAnnotations: map[string]map[string]string{
+				"fred": {"protocol": "TCP", "port": "8080"},
+			},
 		},
 		s.mockStatefulSets.EXPECT().Get(gomock.Any(), "app-name", v1.GetOptions{}).
 			Return(nil, s.k8sNotFoundError()),
@@ -3290,6 +3389,10 @@ func (s *K8sBrokerSuite) assertGetServiceSvcFoundWithStatefulSet(c *gc.C, mode c
 				network.NewProviderAddress("10.0.0.1", network.WithScope(network.ScopePublic)),
 				network.NewProviderAddress("host.com.au", network.WithScope(network.ScopePublic)),
 			},
+			ExternalNames: []string{"host.com.au"},
+			PortAnnotations: map[string]map[string]string{
+				"fred": {"protocol": "TCP", "port": "8080"},
+			},
 			Scale:      k8sutils.IntPtr(2),
 			Generation: pointer.Int64Ptr(1),
 			Status: status.StatusInfo{
@@ -3382,6 +3485,10 @@ func (s *K8sBrokerSuite) assertGetServiceSvcFoundWithDeployment(c *gc.C, mode ca
 				network.NewProviderAddress("10.0.0.1", network.WithScope(network.ScopePublic)),
 				network.NewProviderAddress("host.com.au", network.WithScope(network.ScopePublic)),
 			},
+			ExternalNames: []string{"host.com.au"},
+			PortAnnotations: map[string]map[string]string{
+				"fred": {"protocol": "TCP", "port": "8080"},
+			},
 			Scale:      k8sutils.IntPtr(2),
 			Generation: pointer.Int64Ptr(1),
 			Status: status.StatusInfo{
@@ -3446,6 +3553,10 @@ func (s *K8sBrokerSuite) TestGetServiceSvcFoundWithDaemonSet(c *gc.C) {
 				network.NewProviderAddress("10.0.0.1", network.WithScope(network.ScopePublic)),
 				network.NewProviderAddress("host.com.au", network.WithScope(network.ScopePublic)),
 			},
+			ExternalNames: []string{"host.com.au"},
+			PortAnnotations: map[string]map[string]string{
+				"fred": {"protocol": "TCP", "port": "8080"},
+			},
 			Scale:      k8sutils.IntPtr(2),
 			Generation: pointer.Int64Ptr(1),
 			Status: status.StatusInfo{
@@ -6,6 +6,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/juju/errors"
 	core "k8s.io/api/core/v1"
@@ -132,11 +134,95 @@ func (k *kubernetesClient) createNamespace(name string) error {
 
 	_, err := k.client().CoreV1().Namespaces().Create(context.TODO(), ns, v1.CreateOptions{})
 	if k8serrors.IsAlreadyExists(err) {
+		if k.adoptExistingNamespace() {
+			return errors.Trace(k.adoptNamespace(name))
+		}
 		return errors.AlreadyExistsf("namespace %q", name)
 	}
 	return errors.Trace(err)
 }
 
+// adoptExistingNamespace reports whether the model is configured to
+// take ownership of a pre-created namespace.
+func (k *kubernetesClient) adoptExistingNamespace() bool {
+	adopt, _ := k.Config().AllAttrs()[constants.AdoptExistingNamespaceKey].(bool)
+	return adopt
+}
+
+// adoptNamespace takes ownership of an existing namespace by applying
+// the model's labels and annotations to it. The namespace must not be
+// annotated as belonging to another Juju model.
+func (k *kubernetesClient) adoptNamespace(name string) error {
+	ns, err := k.getNamespaceByName(name)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	modelUUIDKey := utils.AnnotationModelUUIDKey(k.IsLegacyLabels())
+	if existing, ok := ns.Annotations[modelUUIDKey]; ok && existing != k.modelUUID {
+		return errors.AlreadyExistsf("namespace %q owned by model %q", name, existing)
+	}
+	ns.SetLabels(utils.LabelsMerge(
+		ns.GetLabels(),
+		utils.LabelsForModel(k.CurrentModel(), false),
+		utils.LabelsJuju))
+	if err := k.ensureNamespaceAnnotations(ns); err != nil {
+		return errors.Trace(err)
+	}
+	if _, err := k.client().CoreV1().Namespaces().Update(context.TODO(), ns, v1.UpdateOptions{}); err != nil {
+		return errors.Annotatef(err, "adopting namespace %q", name)
+	}
+	logger.Infof("adopted existing namespace %q", name)
+	if foreign, err := k.foreignNamespaceObjects(name); err != nil {
+		logger.Warningf("unable to inspect namespace %q for foreign objects: %v", name, err)
+	} else if len(foreign) > 0 {
+		logger.Warningf("namespace %q contains objects not managed by Juju: %s", name, strings.Join(foreign, ", "))
+	}
+	return nil
+}
+
+// foreignNamespaceObjects returns descriptions of the workload objects
+// in the namespace that are not managed by Juju.
+func (k *kubernetesClient) foreignNamespaceObjects(namespace string) ([]string, error) {
+	var foreign []string
+	record := func(kind, name string, objLabels map[string]string) {
+		if utils.HasLabels(objLabels, utils.LabelsJuju) {
+			return
+		}
+		foreign = append(foreign, fmt.Sprintf("%s/%s", kind, name))
+	}
+
+	deployments, err := k.client().AppsV1().Deployments(namespace).List(context.TODO(), v1.ListOptions{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, item := range deployments.Items {
+		record("deployment", item.Name, item.Labels)
+	}
+	statefulSets, err := k.client().AppsV1().StatefulSets(namespace).List(context.TODO(), v1.ListOptions{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, item := range statefulSets.Items {
+		record("statefulset", item.Name, item.Labels)
+	}
+	daemonSets, err := k.client().AppsV1().DaemonSets(namespace).List(context.TODO(), v1.ListOptions{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, item := range daemonSets.Items {
+		record("daemonset", item.Name, item.Labels)
+	}
+	services, err := k.client().CoreV1().Services(namespace).List(context.TODO(), v1.ListOptions{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, item := range services.Items {
+		record("service", item.Name, item.Labels)
+	}
+	sort.Strings(foreign)
+	return foreign, nil
+}
+
 func (k *kubernetesClient) deleteNamespace() error {
 	// deleteNamespace is used as a means to implement Destroy().
 	// All model resources are provisioned in the namespace;
@@ -43,6 +43,12 @@ var configSchema = environschema.Fields{
 		Group:       environschema.AccountGroup,
 		Immutable:   true,
 	},
+	k8sconstants.AdoptExistingNamespaceKey: {
+		Description: "Whether the model may adopt a pre-created namespace instead of creating its own.",
+		Type:        environschema.Tbool,
+		Group:       environschema.AccountGroup,
+		Immutable:   true,
+	},
 }
 
 var providerConfigFields = func() schema.Fields {
@@ -54,8 +60,9 @@ var providerConfigFields = func() schema.Fields {
 }()
 
 var providerConfigDefaults = schema.Defaults{
-	k8sconstants.WorkloadStorageKey: "",
-	k8sconstants.OperatorStorageKey: "",
+	k8sconstants.WorkloadStorageKey:        "",
+	k8sconstants.OperatorStorageKey:        "",
+	k8sconstants.AdoptExistingNamespaceKey: false,
 }
 
 type brokerConfig struct {
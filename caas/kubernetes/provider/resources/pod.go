@@ -5,6 +5,9 @@ package resources
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/juju/errors"
@@ -139,6 +142,17 @@ func (p *Pod) ComputeStatus(ctx context.Context, client kubernetes.Interface, no
 	}
 	statusMessage := p.Status.Message
 	since := now
+	if jujuStatus == status.Running {
+		// For sidecar charm pods the container readiness probes are backed
+		// by Pebble health checks, so a running-but-unready container
+		// indicates that its checks are failing.
+		if unready := p.UnreadyContainers(); len(unready) > 0 {
+			jujuStatus = status.Blocked
+			if statusMessage == "" {
+				statusMessage = fmt.Sprintf("unready containers: %s", strings.Join(unready, ", "))
+			}
+		}
+	}
 	if statusMessage == "" {
 		for _, cond := range p.Status.Conditions {
 			statusMessage = cond.Message
@@ -163,3 +177,16 @@ func (p *Pod) ComputeStatus(ctx context.Context, client kubernetes.Interface, no
 	}
 	return statusMessage, jujuStatus, since, nil
 }
+
+// UnreadyContainers returns the names of containers in a running pod that
+// have started, but are failing their readiness checks.
+func (p *Pod) UnreadyContainers() []string {
+	var unready []string
+	for _, cs := range p.Status.ContainerStatuses {
+		if cs.State.Running != nil && !cs.Ready {
+			unready = append(unready, cs.Name)
+		}
+	}
+	sort.Strings(unready)
+	return unready
+}
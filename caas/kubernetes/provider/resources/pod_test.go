@@ -5,6 +5,7 @@ package resources_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
@@ -14,6 +15,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/juju/juju/caas/kubernetes/provider/resources"
+	"github.com/juju/juju/core/status"
 )
 
 type podSuite struct {
@@ -69,6 +71,40 @@ func (s *podSuite) TestGet(c *gc.C) {
 	c.Assert(dsResource.GetAnnotations(), gc.DeepEquals, map[string]string{"a": "b"})
 }
 
+func (s *podSuite) TestComputeStatusUnreadyContainers(c *gc.C) {
+	now := time.Now()
+	pod := resources.NewPod("p1", "test", &corev1.Pod{
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  "charm",
+				Ready: true,
+				State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+			}, {
+				Name:  "workload",
+				Ready: false,
+				State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+			}},
+		},
+	})
+
+	c.Assert(pod.UnreadyContainers(), gc.DeepEquals, []string{"workload"})
+
+	message, podStatus, _, err := pod.ComputeStatus(context.TODO(), s.client, now)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(podStatus, gc.Equals, status.Blocked)
+	c.Check(message, gc.Equals, "unready containers: workload")
+
+	// Once all containers pass their checks the pod reports as running.
+	pod.Status.ContainerStatuses[1].Ready = true
+	c.Assert(pod.UnreadyContainers(), gc.HasLen, 0)
+
+	message, podStatus, _, err = pod.ComputeStatus(context.TODO(), s.client, now)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(podStatus, gc.Equals, status.Running)
+	c.Check(message, gc.Equals, "")
+}
+
 func (s *podSuite) TestDelete(c *gc.C) {
 	ds := corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
@@ -4,6 +4,8 @@
 package utils
 
 import (
+	"fmt"
+
 	core "k8s.io/api/core/v1"
 
 	"github.com/juju/juju/core/network"
@@ -48,6 +50,50 @@ func GetSvcAddresses(svc *core.Service, includeClusterIP bool) []network.Provide
 	return netAddrs
 }
 
+// GetSvcExternalNames returns any DNS names by which the given service
+// is reachable from outside the cluster.
+func GetSvcExternalNames(svc *core.Service) []string {
+	var names []string
+	if svc.Spec.Type == core.ServiceTypeExternalName && svc.Spec.ExternalName != "" {
+		names = append(names, svc.Spec.ExternalName)
+	}
+	for _, ingressAddr := range svc.Status.LoadBalancer.Ingress {
+		if ingressAddr.Hostname != "" {
+			names = append(names, ingressAddr.Hostname)
+		}
+	}
+	return names
+}
+
+// GetSvcPortAnnotations returns details of each named port of the given
+// service, such as the protocol, target port and any node port.
+func GetSvcPortAnnotations(svc *core.Service) map[string]map[string]string {
+	if len(svc.Spec.Ports) == 0 {
+		return nil
+	}
+	portAnnotations := make(map[string]map[string]string)
+	for _, p := range svc.Spec.Ports {
+		if p.Name == "" {
+			continue
+		}
+		annotations := map[string]string{
+			"protocol": string(p.Protocol),
+			"port":     fmt.Sprint(p.Port),
+		}
+		if p.TargetPort.String() != "0" {
+			annotations["target-port"] = p.TargetPort.String()
+		}
+		if p.NodePort != 0 {
+			annotations["node-port"] = fmt.Sprint(p.NodePort)
+		}
+		portAnnotations[p.Name] = annotations
+	}
+	if len(portAnnotations) == 0 {
+		return nil
+	}
+	return portAnnotations
+}
+
 func getLoadBalancerAddresses(svc *core.Service) []string {
 	// different cloud providers have a different way to report back the Load Balancer address.
 	// This covers the cases we know about so far.
@@ -6,6 +6,7 @@ package charmhub
 import (
 	"context"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/juju/errors"
@@ -21,10 +22,13 @@ type findOptions struct {
 	category         *string
 	channel          *string
 	charmType        *string
+	base             *string
 	platforms        *string
 	publisher        *string
 	relationRequires *string
 	relationProvides *string
+	limit            *int
+	offset           *int
 }
 
 // WithFindCategory sets the category on the option.
@@ -48,6 +52,13 @@ func WithFindType(charmType string) FindOption {
 	}
 }
 
+// WithFindBase sets the base on the option.
+func WithFindBase(base string) FindOption {
+	return func(findOptions *findOptions) {
+		findOptions.base = &base
+	}
+}
+
 // WithFindPlatforms sets the charmPlatforms on the option.
 func WithFindPlatforms(platforms string) FindOption {
 	return func(findOptions *findOptions) {
@@ -76,6 +87,21 @@ func WithFindRelationProvides(relationProvides string) FindOption {
 	}
 }
 
+// WithFindLimit caps the number of results returned.
+func WithFindLimit(limit int) FindOption {
+	return func(findOptions *findOptions) {
+		findOptions.limit = &limit
+	}
+}
+
+// WithFindOffset skips the given number of results, for paginating through
+// a large result set.
+func WithFindOffset(offset int) FindOption {
+	return func(findOptions *findOptions) {
+		findOptions.offset = &offset
+	}
+}
+
 // Create a findOptions instance with default values.
 func newFindOptions() *findOptions {
 	return &findOptions{}
@@ -157,6 +183,11 @@ func walkFindOptions(opts *findOptions, fn func(string, string) error) error {
 			return errors.Trace(err)
 		}
 	}
+	if opts.base != nil {
+		if err := fn("base", *opts.base); err != nil {
+			return errors.Trace(err)
+		}
+	}
 	if opts.platforms != nil {
 		if err := fn("platforms", *opts.platforms); err != nil {
 			return errors.Trace(err)
@@ -177,6 +208,16 @@ func walkFindOptions(opts *findOptions, fn func(string, string) error) error {
 			return errors.Trace(err)
 		}
 	}
+	if opts.limit != nil {
+		if err := fn("limit", strconv.Itoa(*opts.limit)); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if opts.offset != nil {
+		if err := fn("offset", strconv.Itoa(*opts.offset)); err != nil {
+			return errors.Trace(err)
+		}
+	}
 	return nil
 }
 
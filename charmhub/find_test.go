@@ -67,6 +67,32 @@ func (s *FindSuite) TestFindWithOptions(c *gc.C) {
 	c.Assert(responses[0].Name, gc.Equals, name)
 }
 
+func (s *FindSuite) TestFindWithPagination(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	baseURL := MustParseURL(c, "http://api.foo.bar")
+
+	name := "meshuggah"
+	path := path.MakePath(baseURL)
+
+	expect, err := path.Query("base", "ubuntu@20.04")
+	c.Assert(err, jc.ErrorIsNil)
+	expect, err = expect.Query("limit", "25")
+	c.Assert(err, jc.ErrorIsNil)
+	expect, err = expect.Query("offset", "50")
+	c.Assert(err, jc.ErrorIsNil)
+
+	restClient := NewMockRESTClient(ctrl)
+	s.expectGet(c, restClient, expect, name)
+
+	client := NewFindClient(path, restClient, &FakeLogger{})
+	responses, err := client.Find(context.TODO(), name, WithFindBase("ubuntu@20.04"), WithFindLimit(25), WithFindOffset(50))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(len(responses), gc.Equals, 1)
+	c.Assert(responses[0].Name, gc.Equals, name)
+}
+
 func (s *FindSuite) TestFindFailure(c *gc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
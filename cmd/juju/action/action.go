@@ -5,7 +5,6 @@ package action
 
 import (
 	"io"
-	"time"
 
 	"github.com/juju/errors"
 
@@ -20,7 +19,7 @@ type APIClient interface {
 
 	// RunOnAllMachines runs the command on all the machines with the specified
 	// timeout.
-	RunOnAllMachines(commands string, timeout time.Duration) (action.EnqueuedActions, error)
+	RunOnAllMachines(action.RunParams) (action.EnqueuedActions, error)
 
 	// Run the Commands specified on the machines identified through the ids
 	// provided in the machines, applications and units slices.
@@ -0,0 +1,152 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package action
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/cmd/v3"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v4"
+
+	actionapi "github.com/juju/juju/api/action"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/actions"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/jujuclient"
+)
+
+// NewDiagnoseNetworkCommand returns a diagnose-network command.
+func NewDiagnoseNetworkCommand(store jujuclient.ClientStore) cmd.Command {
+	logMessageHandler := func(ctx *cmd.Context, msg string) {
+		ctx.Infof(msg)
+	}
+	return newDiagnoseNetworkCommand(store, logMessageHandler, clock.WallClock)
+}
+
+func newDiagnoseNetworkCommand(store jujuclient.ClientStore, logMessageHandler func(*cmd.Context, string), clock clock.Clock) cmd.Command {
+	cmd := modelcmd.Wrap(&diagnoseNetworkCommand{
+		runCommandBase: runCommandBase{
+			defaultWait:       5 * time.Minute,
+			logMessageHandler: logMessageHandler,
+			clock:             clock,
+		},
+	})
+	cmd.SetClientStore(store)
+	return cmd
+}
+
+// diagnoseNetworkCommand is responsible for running network connectivity
+// diagnostics on remote machines.
+type diagnoseNetworkCommand struct {
+	runCommandBase
+	machines  []string
+	endpoints []string
+}
+
+const diagnoseNetworkDoc = `
+Instruct the agent on each target machine to run connectivity checks
+against the controller API addresses, DNS, and the egress endpoints
+required for charm and agent binary downloads (Charmhub and the
+simplestreams mirrors), and report the results.
+
+Targets are specified using machine ids. A unit can be diagnosed by
+targeting the machine it is assigned to; see "juju show-unit".
+
+Additional host:port endpoints to check can be supplied with --endpoint.
+
+Examples:
+
+    juju diagnose-network 0
+    juju diagnose-network 0 1/lxd/2
+    juju diagnose-network 3 --endpoint archive.ubuntu.com:443
+
+See also:
+    exec
+    show-machine
+`
+
+// Info implements Command.Info.
+func (c *diagnoseNetworkCommand) Info() *cmd.Info {
+	info := jujucmd.Info(&cmd.Info{
+		Name:    "diagnose-network",
+		Args:    "<machine> ...",
+		Purpose: "Run network connectivity diagnostics on the target machines.",
+		Doc:     diagnoseNetworkDoc,
+	})
+	return info
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *diagnoseNetworkCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.runCommandBase.SetFlags(f)
+	f.Var(cmd.NewStringsValue(nil, &c.endpoints), "endpoint", "Additional host:port endpoints to check")
+}
+
+// Init implements Command.Init.
+func (c *diagnoseNetworkCommand) Init(args []string) error {
+	if err := c.runCommandBase.Init(args); err != nil {
+		return errors.Trace(err)
+	}
+	if len(args) == 0 {
+		return errors.Errorf("no machines specified")
+	}
+	var nameErrors []string
+	for _, machineId := range args {
+		if !names.IsValidMachine(machineId) {
+			nameErrors = append(nameErrors, fmt.Sprintf("  %q is not a valid machine id", machineId))
+		}
+	}
+	if len(nameErrors) > 0 {
+		return errors.Errorf("The following diagnose-network targets are not valid:\n%s",
+			strings.Join(nameErrors, "\n"))
+	}
+	c.machines = args
+	return nil
+}
+
+// Run implements Command.Run.
+func (c *diagnoseNetworkCommand) Run(ctx *cmd.Context) error {
+	if err := c.ensureAPI(); err != nil {
+		return errors.Trace(err)
+	}
+	defer c.api.Close()
+
+	modelType, err := c.ModelType()
+	if err != nil {
+		return errors.Annotatef(err, "unable to get model type")
+	}
+	if modelType == model.CAAS {
+		return errors.Errorf("unable to target machines with a k8s controller")
+	}
+
+	actionParams := map[string]interface{}{}
+	if len(c.endpoints) > 0 {
+		endpoints := make([]interface{}, len(c.endpoints))
+		for i, endpoint := range c.endpoints {
+			endpoints[i] = endpoint
+		}
+		actionParams["endpoints"] = endpoints
+	}
+
+	enqueue := make([]actionapi.Action, len(c.machines))
+	for i, machineId := range c.machines {
+		enqueue[i] = actionapi.Action{
+			Receiver:   names.NewMachineTag(machineId).String(),
+			Name:       actions.JujuDiagnoseNetworkActionName,
+			Parameters: actionParams,
+		}
+	}
+	results, err := c.api.EnqueueOperation(enqueue)
+	if err != nil {
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	return c.processOperationResults(ctx, &results)
+}
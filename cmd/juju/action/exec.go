@@ -54,6 +54,8 @@ type execCommand struct {
 	commands       string
 	parallel       bool
 	executionGroup string
+	outputLimit    int
+	captureFiles   []string
 }
 
 const execDoc = `
@@ -108,6 +110,14 @@ sequentially, but in parallel with other groups. This is done using
 in the model.  If you specify --all you cannot provide additional
 targets.
 
+--output-limit caps the amount of stdout and stderr recorded for each
+target; anything beyond the limit is dropped and the results are marked
+as truncated.
+
+--capture records the contents of the named files from each target with
+the task results once the commands complete, so they can be retrieved
+later with "juju show-task".
+
 Since juju exec creates tasks, you can query for the status of commands
 started with juju run by calling 
 "juju operations --machines <id>,... --actions juju-exec".
@@ -138,6 +148,8 @@ func (c *execCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.BoolVar(&c.operator, "operator", false, "Run the commands on the operator (k8s-only)")
 	f.BoolVar(&c.parallel, "parallel", true, "Run the commands in parallel without first acquiring a lock")
 	f.StringVar(&c.executionGroup, "execution-group", "", "Commands in the same execution group are run sequentially")
+	f.IntVar(&c.outputLimit, "output-limit", 0, "Maximum size in bytes of recorded stdout and stderr per target, 0 for no limit")
+	f.Var(cmd.NewStringsValue(nil, &c.captureFiles), "capture", "One or more files to capture from each target after the commands complete")
 	f.Var(cmd.NewStringsValue(nil, &c.machines), "machine", "One or more machine ids")
 	f.Var(cmd.NewStringsValue(nil, &c.applications), "a", "One or more application names")
 	f.Var(cmd.NewStringsValue(nil, &c.applications), "app", "")
@@ -206,6 +218,10 @@ func (c *execCommand) Init(args []string) error {
 			strings.Join(nameErrors, "\n"))
 	}
 
+	if c.outputLimit < 0 {
+		return errors.Errorf("invalid output limit %d: must not be negative", c.outputLimit)
+	}
+
 	return nil
 }
 
@@ -229,7 +245,12 @@ func (c *execCommand) Run(ctx *cmd.Context) error {
 
 	var runResults actionapi.EnqueuedActions
 	if c.all {
-		runResults, err = c.api.RunOnAllMachines(c.commands, c.wait)
+		runResults, err = c.api.RunOnAllMachines(actionapi.RunParams{
+			Commands:     c.commands,
+			Timeout:      c.wait,
+			OutputLimit:  c.outputLimit,
+			CaptureFiles: c.captureFiles,
+		})
 	} else {
 		runParams := actionapi.RunParams{
 			Commands:       c.commands,
@@ -239,6 +260,8 @@ func (c *execCommand) Run(ctx *cmd.Context) error {
 			Units:          c.units,
 			Parallel:       &c.parallel,
 			ExecutionGroup: &c.executionGroup,
+			OutputLimit:    c.outputLimit,
+			CaptureFiles:   c.captureFiles,
 		}
 		if c.operator {
 			if modelType != model.CAAS {
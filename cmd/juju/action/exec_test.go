@@ -292,6 +292,50 @@ mysql/0:
 	c.Assert(cmdtesting.Stdout(context), gc.Equals, expected)
 }
 
+func (s *ExecSuite) TestExecWithOutputLimitAndCapture(c *gc.C) {
+	fakeClient := &fakeAPIClient{}
+	restore := s.patchAPIClient(fakeClient)
+	defer restore()
+
+	fakeClient.actionResults = []actionapi.ActionResult{{
+		Action: &actionapi.Action{
+			ID:       validActionId,
+			Receiver: "machine-0",
+		},
+		Output: map[string]interface{}{
+			"stdout": "megatron",
+		},
+		Status:    "completed",
+		Enqueued:  time.Date(2015, time.February, 14, 8, 13, 0, 0, time.UTC),
+		Started:   time.Date(2015, time.February, 14, 8, 15, 0, 0, time.UTC),
+		Completed: time.Date(2015, time.February, 14, 8, 17, 0, 0, time.UTC),
+	}}
+
+	runCmd, _ := newTestExecCommand(testClock(), model.IAAS)
+	_, err := cmdtesting.RunCommand(c, runCmd,
+		"--machine=0", "--output-limit=1024", "--capture=/var/log/syslog,/tmp/out.tar", "hostname",
+	)
+	c.Assert(err, jc.ErrorIsNil)
+
+	parallel := true
+	group := ""
+	c.Assert(fakeClient.execParams, jc.DeepEquals, &actionapi.RunParams{
+		Commands:       "hostname",
+		Timeout:        300 * time.Second,
+		Machines:       []string{"0"},
+		Parallel:       &parallel,
+		ExecutionGroup: &group,
+		OutputLimit:    1024,
+		CaptureFiles:   []string{"/var/log/syslog", "/tmp/out.tar"},
+	})
+}
+
+func (s *ExecSuite) TestExecInvalidOutputLimit(c *gc.C) {
+	runCmd, _ := newTestExecCommand(testClock(), model.IAAS)
+	_, err := cmdtesting.RunCommand(c, runCmd, "--machine=0", "--output-limit=-1", "hostname")
+	c.Assert(err, gc.ErrorMatches, "invalid output limit -1: must not be negative")
+}
+
 func (s *ExecSuite) TestAllMachines(c *gc.C) {
 	fakeClient := &fakeAPIClient{}
 	restore := s.patchAPIClient(fakeClient)
@@ -309,7 +309,7 @@ func (c *fakeAPIClient) resultForUnit(unitName string) (actionapi.ActionResult,
 	return actionapi.ActionResult{}, false
 }
 
-func (c *fakeAPIClient) RunOnAllMachines(_ string, _ time.Duration) (actionapi.EnqueuedActions, error) {
+func (c *fakeAPIClient) RunOnAllMachines(_ actionapi.RunParams) (actionapi.EnqueuedActions, error) {
 	var result actionapi.EnqueuedActions
 
 	if c.block {
@@ -0,0 +1,127 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package agenthealth
+
+import (
+	"time"
+
+	"github.com/juju/cmd/v3"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/api/agenthealth"
+	"github.com/juju/juju/apiserver/params"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+const agentHealthDoc = `
+Show the most recent worker health report for a machine or unit agent.
+Agents periodically record the state of the workers in their dependency
+engine on the controller; this command displays that report, including
+any worker that is failing to start and its last error.
+
+Examples:
+    juju agent-health 0
+    juju agent-health mysql/0
+`
+
+// NewAgentHealthCommand returns a command that shows an agent's
+// self-reported worker health.
+func NewAgentHealthCommand() cmd.Command {
+	return modelcmd.Wrap(&agentHealthCommand{})
+}
+
+type agentHealthCommand struct {
+	modelcmd.ModelCommandBase
+	out cmd.Output
+	tag names.Tag
+}
+
+// Info implements Command.Info.
+func (c *agentHealthCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:    "agent-health",
+		Args:    "<machine or unit>",
+		Purpose: "Show an agent's self-reported worker health.",
+		Doc:     agentHealthDoc,
+	})
+}
+
+// Init implements Command.Init.
+func (c *agentHealthCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no machine or unit specified")
+	}
+	switch arg := args[0]; {
+	case names.IsValidMachine(arg):
+		c.tag = names.NewMachineTag(arg)
+	case names.IsValidUnit(arg):
+		c.tag = names.NewUnitTag(arg)
+	default:
+		return errors.Errorf("%q is not a valid machine or unit", arg)
+	}
+	return cmd.CheckEmpty(args[1:])
+}
+
+// SetFlags implements cmd.Command.SetFlags.
+func (c *agentHealthCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "yaml", map[string]cmd.Formatter{
+		"yaml": cmd.FormatYaml,
+		"json": cmd.FormatJson,
+	})
+}
+
+// AgentHealthClient defines the API methods used by the command.
+type AgentHealthClient interface {
+	AgentHealth(names.Tag) (params.AgentHealthResult, error)
+	Close() error
+}
+
+var newClient = func(base modelcmd.ModelCommandBase) (AgentHealthClient, error) {
+	root, err := base.NewAPIRoot()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return agenthealth.NewClient(root), nil
+}
+
+type workerHealth struct {
+	State      string `json:"state" yaml:"state"`
+	Error      string `json:"error,omitempty" yaml:"error,omitempty"`
+	StartCount int    `json:"start-count,omitempty" yaml:"start-count,omitempty"`
+}
+
+type agentHealth struct {
+	Updated time.Time               `json:"updated" yaml:"updated"`
+	Workers map[string]workerHealth `json:"workers" yaml:"workers"`
+}
+
+// Run implements Command.Run.
+func (c *agentHealthCommand) Run(ctx *cmd.Context) error {
+	client, err := newClient(c.ModelCommandBase)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	result, err := client.AgentHealth(c.tag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	output := agentHealth{
+		Updated: result.Updated,
+		Workers: make(map[string]workerHealth, len(result.Workers)),
+	}
+	for _, w := range result.Workers {
+		output.Workers[w.Name] = workerHealth{
+			State:      w.State,
+			Error:      w.Error,
+			StartCount: w.StartCount,
+		}
+	}
+	return c.out.Write(ctx, output)
+}
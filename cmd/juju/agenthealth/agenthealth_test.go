@@ -0,0 +1,103 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package agenthealth_test
+
+import (
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/cmd/v3/cmdtesting"
+	"github.com/juju/names/v4"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/agenthealth"
+	"github.com/juju/juju/cmd/modelcmd"
+	coretesting "github.com/juju/juju/testing"
+)
+
+func TestPackage(t *stdtesting.T) {
+	gc.TestingT(t)
+}
+
+type agentHealthSuite struct {
+	coretesting.FakeJujuXDGDataHomeSuite
+
+	client *mockClient
+}
+
+var _ = gc.Suite(&agentHealthSuite{})
+
+func (s *agentHealthSuite) SetUpTest(c *gc.C) {
+	s.FakeJujuXDGDataHomeSuite.SetUpTest(c)
+	s.client = &mockClient{}
+	s.PatchValue(agenthealth.NewClient, func(_ modelcmd.ModelCommandBase) (agenthealth.AgentHealthClient, error) {
+		return s.client, nil
+	})
+}
+
+func (s *agentHealthSuite) TestInitNoArgs(c *gc.C) {
+	cmd := agenthealth.NewAgentHealthCommandForTest()
+	_, err := cmdtesting.RunCommand(c, cmd)
+	c.Assert(err, gc.ErrorMatches, "no machine or unit specified")
+}
+
+func (s *agentHealthSuite) TestInitInvalidEntity(c *gc.C) {
+	cmd := agenthealth.NewAgentHealthCommandForTest()
+	_, err := cmdtesting.RunCommand(c, cmd, "not/valid/entity")
+	c.Assert(err, gc.ErrorMatches, `"not/valid/entity" is not a valid machine or unit`)
+}
+
+func (s *agentHealthSuite) TestMachine(c *gc.C) {
+	s.client.result = params.AgentHealthResult{
+		Updated: time.Date(2021, 11, 1, 12, 0, 0, 0, time.UTC),
+		Workers: []params.WorkerHealth{{
+			Name:       "machiner",
+			State:      "started",
+			StartCount: 1,
+		}, {
+			Name:  "upgrader",
+			State: "stopped",
+			Error: "boom",
+		}},
+	}
+	cmd := agenthealth.NewAgentHealthCommandForTest()
+	ctx, err := cmdtesting.RunCommand(c, cmd, "0")
+	c.Assert(err, jc.ErrorIsNil)
+	s.client.CheckCall(c, 0, "AgentHealth", names.NewMachineTag("0"))
+	c.Assert(cmdtesting.Stdout(ctx), gc.Equals, `
+updated: 2021-11-01T12:00:00Z
+workers:
+  machiner:
+    state: started
+    start-count: 1
+  upgrader:
+    state: stopped
+    error: boom
+`[1:])
+}
+
+func (s *agentHealthSuite) TestUnit(c *gc.C) {
+	cmd := agenthealth.NewAgentHealthCommandForTest()
+	_, err := cmdtesting.RunCommand(c, cmd, "mysql/0")
+	c.Assert(err, jc.ErrorIsNil)
+	s.client.CheckCall(c, 0, "AgentHealth", names.NewUnitTag("mysql/0"))
+}
+
+type mockClient struct {
+	testing.Stub
+	result params.AgentHealthResult
+}
+
+func (m *mockClient) AgentHealth(tag names.Tag) (params.AgentHealthResult, error) {
+	m.AddCall("AgentHealth", tag)
+	return m.result, m.NextErr()
+}
+
+func (m *mockClient) Close() error {
+	m.AddCall("Close")
+	return m.NextErr()
+}
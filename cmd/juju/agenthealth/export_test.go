@@ -0,0 +1,21 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package agenthealth
+
+import (
+	"github.com/juju/cmd/v3"
+
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/jujuclient/jujuclienttesting"
+)
+
+var NewClient = &newClient
+
+// NewAgentHealthCommandForTest returns an agent-health command with a
+// minimal client store for testing.
+func NewAgentHealthCommandForTest() cmd.Command {
+	cmd := &agentHealthCommand{}
+	cmd.SetClientStore(jujuclienttesting.MinimalStore())
+	return modelcmd.Wrap(cmd)
+}
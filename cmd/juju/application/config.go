@@ -89,6 +89,27 @@ settings back to their default value as defined in the charm metadata:
 juju config apache2 --reset servername
 juju config apache2 --reset servername,lb_balancer_timeout
 
+Configuration changes can be staged for review instead of being applied
+immediately. Staged changes are recorded against the application but do
+not take effect, and no hooks run, until they are applied:
+
+juju config apache2 --stage servername=example.com
+
+Running "juju config apache2 --stage" without settings displays the
+staged changes, the effective configuration that would result from
+applying them and the hooks that would run. The staged changes can then
+be applied or discarded:
+
+juju config apache2 --apply-staged
+juju config apache2 --discard-staged
+
+A bounded history of configuration changes is retained, recording who
+changed what and when. It can be displayed with --history, and a
+previous change can be reverted by rolling back its revision:
+
+juju config apache2 --history
+juju config apache2 --rollback 3
+
 See also:
     deploy
     status
@@ -125,6 +146,11 @@ type configCommand struct {
 	resetKeys       []string // Holds the keys to be reset once parsed.
 	useFile         bool
 	values          attributes
+	stage           bool
+	applyStaged     bool
+	discardStaged   bool
+	history         bool
+	rollback        int
 }
 
 // ApplicationAPI is an interface to allow passing in a fake implementation under test.
@@ -133,6 +159,12 @@ type ApplicationAPI interface {
 	Get(branchName string, application string) (*params.ApplicationGetResults, error)
 	SetConfig(branchName string, application, configYAML string, config map[string]string) error
 	UnsetApplicationConfig(branchName string, application string, options []string) error
+	StageConfig(application, configYAML string, config map[string]string) error
+	StagedConfig(application string) (*params.StagedConfigResult, error)
+	ApplyStagedConfig(application string) error
+	DiscardStagedConfig(application string) error
+	ConfigHistory(application string, limit int) ([]params.ConfigHistoryEntry, error)
+	RollbackConfig(application string, revision int) error
 }
 
 // Info is part of the cmd.Command interface.
@@ -151,6 +183,11 @@ func (c *configCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.out.AddFlags(f, "yaml", output.DefaultFormatters)
 	f.Var(&c.configFile, "file", "path to yaml-formatted application config")
 	f.Var(cmd.NewAppendStringsValue(&c.reset), "reset", "Reset the provided comma delimited keys")
+	f.BoolVar(&c.stage, "stage", false, "Stage the configuration changes for review instead of applying them")
+	f.BoolVar(&c.applyStaged, "apply-staged", false, "Apply the application's staged configuration changes")
+	f.BoolVar(&c.discardStaged, "discard-staged", false, "Discard the application's staged configuration changes")
+	f.BoolVar(&c.history, "history", false, "Show the application's configuration change history")
+	f.IntVar(&c.rollback, "rollback", 0, "Revert the configuration change recorded as the given revision")
 
 	if featureflag.Enabled(feature.Branches) || featureflag.Enabled(feature.Generations) {
 		f.StringVar(&c.branchName, "branch", "", "Specifically target config for the supplied branch")
@@ -192,6 +229,46 @@ func (c *configCommand) Init(args []string) error {
 	c.applicationName = args[0]
 	args = args[1:]
 
+	if c.history || c.rollback > 0 {
+		if c.history && c.rollback > 0 {
+			return errors.New("cannot show history and roll back simultaneously")
+		}
+		if c.stage || c.applyStaged || c.discardStaged || len(args) > 0 || len(c.resetKeys) > 0 || c.configFile.Path != "" {
+			return errors.New("--history and --rollback cannot be combined with other config changes")
+		}
+		if c.history {
+			c.action = c.getConfigHistory
+		} else {
+			c.action = c.rollbackConfig
+		}
+		return nil
+	}
+	if c.applyStaged || c.discardStaged {
+		if c.applyStaged && c.discardStaged {
+			return errors.New("cannot apply and discard staged changes simultaneously")
+		}
+		if c.stage || len(args) > 0 || len(c.resetKeys) > 0 || c.configFile.Path != "" {
+			return errors.New("--apply-staged and --discard-staged cannot be combined with other config changes")
+		}
+		if c.applyStaged {
+			c.action = c.applyStagedConfig
+		} else {
+			c.action = c.discardStagedConfig
+		}
+		return nil
+	}
+	if c.stage {
+		if len(c.resetKeys) > 0 {
+			return errors.New("cannot stage and reset values simultaneously")
+		}
+		if len(args) == 0 && c.configFile.Path == "" {
+			// Staging nothing displays the staged changes.
+			c.action = c.getStagedConfig
+			return nil
+		}
+		return c.parseSet(args)
+	}
+
 	switch len(args) {
 	case 0:
 		return c.handleZeroArgs()
@@ -358,10 +435,81 @@ func (c *configCommand) setConfig(client ApplicationAPI, ctx *cmd.Context) error
 		return errors.Trace(err)
 	}
 
-	err = client.SetConfig(c.branchName, c.applicationName, settingsYAML, settings)
+	if c.stage {
+		err = client.StageConfig(c.applicationName, settingsYAML, settings)
+	} else {
+		err = client.SetConfig(c.branchName, c.applicationName, settingsYAML, settings)
+	}
 	return errors.Trace(block.ProcessBlockedError(err, block.BlockChange))
 }
 
+// getStagedConfig is the run action to display the staged changes and a
+// preview of the effect of applying them.
+func (c *configCommand) getStagedConfig(client ApplicationAPI, ctx *cmd.Context) error {
+	result, err := client.StagedConfig(c.applicationName)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(result.Staged) == 0 {
+		ctx.Infof("no staged configuration changes for application %q", c.applicationName)
+		return nil
+	}
+	return errors.Trace(c.out.Write(ctx, map[string]interface{}{
+		"application": c.applicationName,
+		"staged":      result.Staged,
+		"effective":   result.Effective,
+		"hooks":       result.Hooks,
+	}))
+}
+
+// getConfigHistory is the run action to display the application's
+// configuration change history.
+func (c *configCommand) getConfigHistory(client ApplicationAPI, ctx *cmd.Context) error {
+	entries, err := client.ConfigHistory(c.applicationName, 0)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(entries) == 0 {
+		ctx.Infof("no configuration change history for application %q", c.applicationName)
+		return nil
+	}
+	history := make([]map[string]interface{}, len(entries))
+	for i, entry := range entries {
+		item := map[string]interface{}{
+			"revision":  entry.Revision,
+			"timestamp": entry.Timestamp,
+			"changes":   entry.Changes,
+			"previous":  entry.Previous,
+		}
+		if entry.UpdatedBy != "" {
+			item["updated-by"] = entry.UpdatedBy
+		}
+		history[i] = item
+	}
+	return errors.Trace(c.out.Write(ctx, history))
+}
+
+// rollbackConfig is the run action when we are reverting a previous
+// configuration change.
+func (c *configCommand) rollbackConfig(client ApplicationAPI, ctx *cmd.Context) error {
+	err := client.RollbackConfig(c.applicationName, c.rollback)
+	return block.ProcessBlockedError(err, block.BlockChange)
+}
+
+// applyStagedConfig is the run action when we are applying the staged
+// configuration changes.
+func (c *configCommand) applyStagedConfig(client ApplicationAPI, ctx *cmd.Context) error {
+	err := client.ApplyStagedConfig(c.applicationName)
+	return block.ProcessBlockedError(err, block.BlockChange)
+}
+
+// discardStagedConfig is the run action when we are discarding the staged
+// configuration changes.
+func (c *configCommand) discardStagedConfig(client ApplicationAPI, ctx *cmd.Context) error {
+	err := client.DiscardStagedConfig(c.applicationName)
+	return block.ProcessBlockedError(err, block.BlockChange)
+}
+
 func (c *configCommand) configMapFromKV(client ApplicationAPI, ctx *cmd.Context) (map[string]string, error) {
 	settings, err := c.validateValues(ctx)
 	if err != nil {
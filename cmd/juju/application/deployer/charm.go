@@ -432,6 +432,24 @@ func (c *repositoryCharm) String() string {
 	return fmt.Sprintf("%s%s%s", str, revision, channel)
 }
 
+// maybeReportSupportMatrix prints the full channel, base and architecture
+// release matrix for the charm when the resolver can supply one, helping the
+// user pick a combination that is actually supported.
+func (c *repositoryCharm) maybeReportSupportMatrix(ctx *cmd.Context, resolver Resolver, curl *charm.URL, origin commoncharm.Origin) {
+	matrixResolver, ok := resolver.(SupportMatrixResolver)
+	if !ok {
+		return
+	}
+	releases, err := matrixResolver.SupportMatrix(curl, origin)
+	if err != nil {
+		logger.Debugf("cannot fetch support matrix for %q: %v", curl.Name, err)
+		return
+	}
+	if matrix := utils.FormatCharmSupportMatrix(curl.Name, releases, origin.Architecture, origin.Series); matrix != "" {
+		ctx.Infof("%s", matrix)
+	}
+}
+
 // PrepareAndDeploy finishes preparing to deploy a charm store charm,
 // then deploys it.
 func (c *repositoryCharm) PrepareAndDeploy(ctx *cmd.Context, deployAPI DeployerAPI, resolver Resolver, macaroonGetter store.MacaroonGetter) error {
@@ -463,6 +481,7 @@ func (c *repositoryCharm) PrepareAndDeploy(ctx *cmd.Context, deployAPI DeployerA
 	origin := c.id.Origin
 	storeCharmOrBundleURL, origin, supportedSeries, err := resolver.ResolveCharm(userRequestedURL, origin, false) // no --switch possible.
 	if charm.IsUnsupportedSeriesError(err) {
+		c.maybeReportSupportMatrix(ctx, resolver, userRequestedURL, origin)
 		return errors.Errorf("%v. Use --force to deploy the charm anyway.", err)
 	} else if err != nil {
 		return errors.Trace(err)
@@ -494,6 +513,7 @@ func (c *repositoryCharm) PrepareAndDeploy(ctx *cmd.Context, deployAPI DeployerA
 	}
 
 	if charm.IsUnsupportedSeriesError(err) {
+		c.maybeReportSupportMatrix(ctx, resolver, storeCharmOrBundleURL, origin)
 		return errors.Errorf("%v. Use --force to deploy the charm anyway.", err)
 	}
 	if validationErr := charmValidationError(storeCharmOrBundleURL.Name, errors.Trace(err)); validationErr != nil {
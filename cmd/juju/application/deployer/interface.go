@@ -12,6 +12,7 @@ import (
 	"github.com/juju/juju/api"
 	"github.com/juju/juju/api/application"
 	"github.com/juju/juju/api/base"
+	apicharm "github.com/juju/juju/api/charms"
 	commoncharm "github.com/juju/juju/api/common/charm"
 	apicharms "github.com/juju/juju/api/common/charms"
 	apiparams "github.com/juju/juju/apiserver/params"
@@ -155,6 +156,12 @@ type Resolver interface {
 	ResolveCharm(url *charm.URL, preferredOrigin commoncharm.Origin, switchCharm bool) (*charm.URL, commoncharm.Origin, []string, error)
 }
 
+// SupportMatrixResolver is implemented by resolvers that can report the full
+// channel, base and architecture release matrix for a charm.
+type SupportMatrixResolver interface {
+	SupportMatrix(*charm.URL, commoncharm.Origin) ([]apicharm.CharmRelease, error)
+}
+
 type ModelConfigGetter interface {
 	ModelGet() (map[string]interface{}, error)
 }
@@ -0,0 +1,115 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"strconv"
+
+	"github.com/juju/cmd/v3"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v4"
+
+	apicharms "github.com/juju/juju/api/charms"
+	"github.com/juju/juju/apiserver/params"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+const diffCharmDoc = `
+Compares the charm revision currently deployed by an application against
+another revision of the same charm, reporting new and removed config
+options, changed defaults, endpoint changes and action changes. Both
+revisions must be present in the controller's charm store, so the diff
+reflects exactly what a refresh to that revision would change.
+
+Examples:
+    juju diff-charm mysql --revision 42
+    juju diff-charm wordpress --revision 7 --format json
+
+See also:
+    refresh
+    diff-bundle
+`
+
+// NewDiffCharmCommand returns a command that compares an application's
+// charm revision against another revision.
+func NewDiffCharmCommand() cmd.Command {
+	c := &diffCharmCommand{}
+	c.newAPIFunc = func() (CharmDiffAPI, error) {
+		return c.newCharmsAPI()
+	}
+	return modelcmd.Wrap(c)
+}
+
+// diffCharmCommand compares two revisions of an application's charm.
+type diffCharmCommand struct {
+	modelcmd.ModelCommandBase
+
+	out        cmd.Output
+	appName    string
+	revision   int
+	newAPIFunc func() (CharmDiffAPI, error)
+}
+
+// CharmDiffAPI defines the API methods that the diff-charm command uses.
+type CharmDiffAPI interface {
+	Close() error
+	CharmDiff(appName string, revision int) (params.CharmDiff, error)
+}
+
+// Info implements Command.Info.
+func (c *diffCharmCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:    "diff-charm",
+		Args:    "<application>",
+		Purpose: "Compare an application's charm against another revision.",
+		Doc:     diffCharmDoc,
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *diffCharmCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.IntVar(&c.revision, "revision", -1, "Charm revision to compare against")
+	c.out.AddFlags(f, "yaml", cmd.DefaultFormatters.Formatters())
+}
+
+// Init implements Command.Init.
+func (c *diffCharmCommand) Init(args []string) error {
+	if len(args) != 1 {
+		return errors.Errorf("an application name must be supplied")
+	}
+	if !names.IsValidApplication(args[0]) {
+		return errors.NotValidf("application name %q", args[0])
+	}
+	c.appName = args[0]
+	if c.revision < 0 {
+		return errors.Errorf("a target revision must be supplied with --revision")
+	}
+	return nil
+}
+
+func (c *diffCharmCommand) newCharmsAPI() (CharmDiffAPI, error) {
+	root, err := c.NewAPIRoot()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return apicharms.NewClient(root), nil
+}
+
+// Run implements Command.Run.
+func (c *diffCharmCommand) Run(ctx *cmd.Context) error {
+	client, err := c.newAPIFunc()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = client.Close() }()
+
+	diff, err := client.CharmDiff(c.appName, c.revision)
+	if err != nil {
+		return errors.Annotatef(err, "comparing %s against revision %s", c.appName, strconv.Itoa(c.revision))
+	}
+	return c.out.Write(ctx, diff)
+}
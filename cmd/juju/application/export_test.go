@@ -256,3 +256,21 @@ func (s *RepoSuiteBaseSuite) SetUpTest(c *gc.C) {
 		return defaultSupportedJujuSeries, nil
 	})
 }
+
+// NewMoveApplicationCommandForTest returns a moveApplicationCommand with the api provided as specified.
+func NewMoveApplicationCommandForTest(api MoveApplicationAPI, store jujuclient.ClientStore) modelcmd.ModelCommand {
+	cmd := &moveApplicationCommand{newAPIFunc: func() (MoveApplicationAPI, error) {
+		return api, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
+// NewMoveUnitCommandForTest returns a moveUnitCommand with the api provided as specified.
+func NewMoveUnitCommandForTest(api MoveUnitAPI, store jujuclient.ClientStore) modelcmd.ModelCommand {
+	cmd := &moveUnitCommand{newAPIFunc: func() (MoveUnitAPI, error) {
+		return api, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
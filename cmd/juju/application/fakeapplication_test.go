@@ -15,13 +15,14 @@ import (
 // fakeApplicationAPI is the fake application API for testing the application
 // update command.
 type fakeApplicationAPI struct {
-	branchName  string
-	name        string
-	charmName   string
-	charmValues map[string]interface{}
-	appValues   map[string]interface{}
-	config      string
-	err         error
+	branchName   string
+	name         string
+	charmName    string
+	charmValues  map[string]interface{}
+	appValues    map[string]interface{}
+	stagedValues map[string]interface{}
+	config       string
+	err          error
 }
 
 func (f *fakeApplicationAPI) Close() error {
@@ -98,6 +99,112 @@ func (f *fakeApplicationAPI) SetConfig(branchName, application, configYAML strin
 	return nil
 }
 
+func (f *fakeApplicationAPI) StageConfig(application, configYAML string, config map[string]string) error {
+	if f.err != nil {
+		return f.err
+	}
+
+	if application != f.name {
+		return errors.NotFoundf("application %q", application)
+	}
+
+	f.config = configYAML
+	if f.stagedValues == nil {
+		f.stagedValues = make(map[string]interface{})
+	}
+	for k, v := range config {
+		f.stagedValues[k] = v
+	}
+
+	return nil
+}
+
+func (f *fakeApplicationAPI) StagedConfig(application string) (*params.StagedConfigResult, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	if application != f.name {
+		return nil, errors.NotFoundf("application %q", application)
+	}
+
+	effective := make(map[string]interface{})
+	for k, v := range f.charmValues {
+		effective[k] = v
+	}
+	var hooks []string
+	if len(f.stagedValues) > 0 {
+		for k, v := range f.stagedValues {
+			effective[k] = v
+		}
+		hooks = []string{"config-changed"}
+	}
+
+	return &params.StagedConfigResult{
+		Staged:    f.stagedValues,
+		Effective: effective,
+		Hooks:     hooks,
+	}, nil
+}
+
+func (f *fakeApplicationAPI) ApplyStagedConfig(application string) error {
+	if f.err != nil {
+		return f.err
+	}
+
+	if application != f.name {
+		return errors.NotFoundf("application %q", application)
+	}
+
+	if f.charmValues == nil {
+		f.charmValues = make(map[string]interface{})
+	}
+	for k, v := range f.stagedValues {
+		f.charmValues[k] = v
+	}
+	f.stagedValues = nil
+
+	return nil
+}
+
+func (f *fakeApplicationAPI) DiscardStagedConfig(application string) error {
+	if f.err != nil {
+		return f.err
+	}
+
+	if application != f.name {
+		return errors.NotFoundf("application %q", application)
+	}
+
+	f.stagedValues = nil
+
+	return nil
+}
+
+func (f *fakeApplicationAPI) ConfigHistory(application string, limit int) ([]params.ConfigHistoryEntry, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	if application != f.name {
+		return nil, errors.NotFoundf("application %q", application)
+	}
+
+	return nil, nil
+}
+
+func (f *fakeApplicationAPI) RollbackConfig(application string, revision int) error {
+	if f.err != nil {
+		return f.err
+	}
+
+	if application != f.name {
+		return errors.NotFoundf("application %q", application)
+	}
+
+	return nil
+}
+
 func (f *fakeApplicationAPI) UnsetApplicationConfig(branchName, application string, options []string) error {
 	if branchName != f.branchName {
 		return errors.Errorf("expected branch %q, got %q", f.branchName, branchName)
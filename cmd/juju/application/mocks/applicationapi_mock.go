@@ -118,6 +118,92 @@ func (mr *MockApplicationAPIMockRecorder) SetConfig(arg0, arg1, arg2, arg3 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetConfig", reflect.TypeOf((*MockApplicationAPI)(nil).SetConfig), arg0, arg1, arg2, arg3)
 }
 
+// StageConfig mocks base method
+func (m *MockApplicationAPI) StageConfig(arg0, arg1 string, arg2 map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StageConfig", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StageConfig indicates an expected call of StageConfig
+func (mr *MockApplicationAPIMockRecorder) StageConfig(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StageConfig", reflect.TypeOf((*MockApplicationAPI)(nil).StageConfig), arg0, arg1, arg2)
+}
+
+// StagedConfig mocks base method
+func (m *MockApplicationAPI) StagedConfig(arg0 string) (*params.StagedConfigResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StagedConfig", arg0)
+	ret0, _ := ret[0].(*params.StagedConfigResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StagedConfig indicates an expected call of StagedConfig
+func (mr *MockApplicationAPIMockRecorder) StagedConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StagedConfig", reflect.TypeOf((*MockApplicationAPI)(nil).StagedConfig), arg0)
+}
+
+// ApplyStagedConfig mocks base method
+func (m *MockApplicationAPI) ApplyStagedConfig(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyStagedConfig", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyStagedConfig indicates an expected call of ApplyStagedConfig
+func (mr *MockApplicationAPIMockRecorder) ApplyStagedConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyStagedConfig", reflect.TypeOf((*MockApplicationAPI)(nil).ApplyStagedConfig), arg0)
+}
+
+// DiscardStagedConfig mocks base method
+func (m *MockApplicationAPI) DiscardStagedConfig(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiscardStagedConfig", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DiscardStagedConfig indicates an expected call of DiscardStagedConfig
+func (mr *MockApplicationAPIMockRecorder) DiscardStagedConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscardStagedConfig", reflect.TypeOf((*MockApplicationAPI)(nil).DiscardStagedConfig), arg0)
+}
+
+// ConfigHistory mocks base method
+func (m *MockApplicationAPI) ConfigHistory(arg0 string, arg1 int) ([]params.ConfigHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigHistory", arg0, arg1)
+	ret0, _ := ret[0].([]params.ConfigHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfigHistory indicates an expected call of ConfigHistory
+func (mr *MockApplicationAPIMockRecorder) ConfigHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigHistory", reflect.TypeOf((*MockApplicationAPI)(nil).ConfigHistory), arg0, arg1)
+}
+
+// RollbackConfig mocks base method
+func (m *MockApplicationAPI) RollbackConfig(arg0 string, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackConfig", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackConfig indicates an expected call of RollbackConfig
+func (mr *MockApplicationAPIMockRecorder) RollbackConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackConfig", reflect.TypeOf((*MockApplicationAPI)(nil).RollbackConfig), arg0, arg1)
+}
+
 // Unset mocks base method
 func (m *MockApplicationAPI) Unset(arg0 string, arg1 []string) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,111 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"github.com/juju/cmd/v3"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/api/applicationmover"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+var moveApplicationHelpSummary = `
+Moves an application to another model on the same controller.`[1:]
+
+var moveApplicationHelpDetails = `
+The application, along with its units' state, is exported from the
+current model and imported into the target model on the same
+controller. The application keeps its charm, configuration and unit
+names; its workloads are restarted in the target model.
+
+Relations with other applications must be severed before an application
+can move; pass --sever-relations to remove them as part of the move.
+Applications with units assigned to machines, storage, resources or
+offers cannot be moved.
+
+Examples:
+    juju move-application mediawiki staging
+    juju move-application mediawiki staging --sever-relations
+
+See also:
+    migrate`
+
+// NewMoveApplicationCommand returns a command to move an application to
+// another model on the same controller.
+func NewMoveApplicationCommand() cmd.Command {
+	cmd := &moveApplicationCommand{}
+	cmd.newAPIFunc = func() (MoveApplicationAPI, error) {
+		root, err := cmd.NewAPIRoot()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return applicationmover.NewClient(root), nil
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+// MoveApplicationAPI defines the API methods that the
+// move-application command uses.
+type MoveApplicationAPI interface {
+	Close() error
+	MoveApplication(appName, targetModelUUID string, severRelations bool) error
+}
+
+type moveApplicationCommand struct {
+	modelcmd.ModelCommandBase
+	applicationName string
+	targetModel     string
+	severRelations  bool
+	newAPIFunc      func() (MoveApplicationAPI, error)
+}
+
+func (c *moveApplicationCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:    "move-application",
+		Args:    "<application> <target model name>",
+		Purpose: moveApplicationHelpSummary,
+		Doc:     moveApplicationHelpDetails,
+	})
+}
+
+func (c *moveApplicationCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.BoolVar(&c.severRelations, "sever-relations", false, "Remove relations with other applications as part of the move")
+}
+
+func (c *moveApplicationCommand) Init(args []string) error {
+	if len(args) < 2 {
+		return errors.New("no application or target model specified")
+	}
+	if !names.IsValidApplication(args[0]) {
+		return errors.NotValidf("application name %q", args[0])
+	}
+	c.applicationName = args[0]
+	c.targetModel = args[1]
+	return cmd.CheckEmpty(args[2:])
+}
+
+func (c *moveApplicationCommand) Run(ctx *cmd.Context) error {
+	uuids, err := c.ModelUUIDs([]string{c.targetModel})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	client, err := c.newAPIFunc()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	err = client.MoveApplication(c.applicationName, uuids[0], c.severRelations)
+	if err != nil {
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	ctx.Infof("application %s moved to model %s", c.applicationName, c.targetModel)
+	return nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application_test
+
+import (
+	"github.com/juju/cmd/v3/cmdtesting"
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/cmd/juju/application"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/jujuclient"
+	"github.com/juju/juju/jujuclient/jujuclienttesting"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type MoveApplicationSuite struct {
+	testing.IsolationSuite
+	mockAPI *mockMoveApplicationAPI
+	store   *jujuclient.MemStore
+}
+
+var _ = gc.Suite(&MoveApplicationSuite{})
+
+func (s *MoveApplicationSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.mockAPI = &mockMoveApplicationAPI{Stub: &testing.Stub{}}
+	s.store = jujuclienttesting.MinimalStore()
+	s.store.Models["arthur"].Models["king/staging"] = jujuclient.ModelDetails{
+		ModelUUID: coretesting.ModelTag.Id(),
+		ModelType: model.IAAS,
+	}
+}
+
+func (s *MoveApplicationSuite) runMoveApplication(c *gc.C, args ...string) error {
+	_, err := cmdtesting.RunCommand(c, application.NewMoveApplicationCommandForTest(s.mockAPI, s.store), args...)
+	return err
+}
+
+func (s *MoveApplicationSuite) TestMoveApplicationInvalidArguments(c *gc.C) {
+	// No arguments.
+	err := s.runMoveApplication(c)
+	c.Assert(err, gc.ErrorMatches, "no application or target model specified")
+
+	// Invalid application name.
+	err = s.runMoveApplication(c, "7up", "king/staging")
+	c.Assert(err, gc.ErrorMatches, `application name "7up" not valid`)
+}
+
+func (s *MoveApplicationSuite) TestMoveApplicationSuccess(c *gc.C) {
+	err := s.runMoveApplication(c, "mediawiki", "king/staging")
+	c.Assert(err, jc.ErrorIsNil)
+	s.mockAPI.CheckCall(c, 0, "MoveApplication", "mediawiki", coretesting.ModelTag.Id(), false)
+	s.mockAPI.CheckCall(c, 1, "Close")
+}
+
+func (s *MoveApplicationSuite) TestMoveApplicationSeverRelations(c *gc.C) {
+	err := s.runMoveApplication(c, "mediawiki", "king/staging", "--sever-relations")
+	c.Assert(err, jc.ErrorIsNil)
+	s.mockAPI.CheckCall(c, 0, "MoveApplication", "mediawiki", coretesting.ModelTag.Id(), true)
+}
+
+func (s *MoveApplicationSuite) TestMoveApplicationUnknownModel(c *gc.C) {
+	err := s.runMoveApplication(c, "mediawiki", "king/missing")
+	c.Assert(err, gc.NotNil)
+	s.mockAPI.CheckNoCalls(c)
+}
+
+func (s *MoveApplicationSuite) TestMoveApplicationFail(c *gc.C) {
+	msg := "fail move-application at API"
+	s.mockAPI.SetErrors(errors.New(msg))
+	err := s.runMoveApplication(c, "mediawiki", "king/staging")
+	c.Assert(err, gc.ErrorMatches, msg)
+}
+
+func (s *MoveApplicationSuite) TestMoveApplicationBlocked(c *gc.C) {
+	s.mockAPI.SetErrors(apiservererrors.OperationBlockedError("TestMoveApplicationBlocked"))
+	err := s.runMoveApplication(c, "mediawiki", "king/staging")
+	coretesting.AssertOperationWasBlocked(c, err, ".*TestMoveApplicationBlocked.*")
+}
+
+type mockMoveApplicationAPI struct {
+	*testing.Stub
+}
+
+func (s *mockMoveApplicationAPI) Close() error {
+	s.MethodCall(s, "Close")
+	return s.NextErr()
+}
+
+func (s *mockMoveApplicationAPI) MoveApplication(appName, targetModelUUID string, severRelations bool) error {
+	s.MethodCall(s, "MoveApplication", appName, targetModelUUID, severRelations)
+	return s.NextErr()
+}
@@ -0,0 +1,140 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/juju/cmd/v3"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/api/unitmover"
+	"github.com/juju/juju/apiserver/params"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/cmd/output"
+)
+
+var moveUnitHelpSummary = `
+Moves a unit to another machine in the model.`[1:]
+
+var moveUnitHelpDetails = `
+A new unit of the same application is added to the target machine. Once
+its workload reports active, the original unit is removed and any
+storage it released is reattached to the replacement where the storage
+provider supports detaching.
+
+The move continues in the background after the command returns; its
+progress can be inspected with the --list flag.
+
+Examples:
+    juju move-unit mysql/0 3
+    juju move-unit --list
+
+See also:
+    add-unit
+    remove-unit`
+
+// NewMoveUnitCommand returns a command to move a unit to another
+// machine in the model.
+func NewMoveUnitCommand() cmd.Command {
+	cmd := &moveUnitCommand{}
+	cmd.newAPIFunc = func() (MoveUnitAPI, error) {
+		root, err := cmd.NewAPIRoot()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return unitmover.NewClient(root), nil
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+// MoveUnitAPI defines the API methods that the move-unit command uses.
+type MoveUnitAPI interface {
+	Close() error
+	MoveUnit(unitName, machineId string) (string, string, error)
+	UnitMoves() ([]params.UnitMoveResult, error)
+}
+
+type moveUnitCommand struct {
+	modelcmd.ModelCommandBase
+	unitName   string
+	machineId  string
+	list       bool
+	newAPIFunc func() (MoveUnitAPI, error)
+}
+
+func (c *moveUnitCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:    "move-unit",
+		Args:    "<unit> <machine>",
+		Purpose: moveUnitHelpSummary,
+		Doc:     moveUnitHelpDetails,
+	})
+}
+
+func (c *moveUnitCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.BoolVar(&c.list, "list", false, "List unit moves and their progress instead of starting one")
+}
+
+func (c *moveUnitCommand) Init(args []string) error {
+	if c.list {
+		return cmd.CheckEmpty(args)
+	}
+	if len(args) < 2 {
+		return errors.New("no unit or target machine specified")
+	}
+	if !names.IsValidUnit(args[0]) {
+		return errors.NotValidf("unit name %q", args[0])
+	}
+	if !names.IsValidMachine(args[1]) {
+		return errors.NotValidf("machine id %q", args[1])
+	}
+	c.unitName = args[0]
+	c.machineId = args[1]
+	return cmd.CheckEmpty(args[2:])
+}
+
+func (c *moveUnitCommand) Run(ctx *cmd.Context) error {
+	client, err := c.newAPIFunc()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	if c.list {
+		moves, err := client.UnitMoves()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		return c.formatMoves(ctx, moves)
+	}
+
+	moveId, newUnit, err := client.MoveUnit(c.unitName, c.machineId)
+	if err != nil {
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	ctx.Infof("scheduled move %s: unit %s will be replaced by %s on machine %s", moveId, c.unitName, newUnit, c.machineId)
+	return nil
+}
+
+func (c *moveUnitCommand) formatMoves(ctx *cmd.Context, moves []params.UnitMoveResult) error {
+	if len(moves) == 0 {
+		ctx.Infof("no unit moves")
+		return nil
+	}
+	tw := output.TabWriter(ctx.Stdout)
+	fmt.Fprintln(tw, "Id\tUnit\tReplacement\tMachine\tStatus\tStarted\tMessage")
+	for _, move := range moves {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			move.MoveId, move.OldUnit, move.NewUnit, move.TargetMachine,
+			move.Status, move.Started.Format(time.RFC3339), move.Message)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,108 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application_test
+
+import (
+	"github.com/juju/cmd/v3"
+	"github.com/juju/cmd/v3/cmdtesting"
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/application"
+	"github.com/juju/juju/jujuclient"
+	"github.com/juju/juju/jujuclient/jujuclienttesting"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type MoveUnitSuite struct {
+	testing.IsolationSuite
+	mockAPI *mockMoveUnitAPI
+	store   *jujuclient.MemStore
+}
+
+var _ = gc.Suite(&MoveUnitSuite{})
+
+func (s *MoveUnitSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.mockAPI = &mockMoveUnitAPI{Stub: &testing.Stub{}}
+	s.store = jujuclienttesting.MinimalStore()
+}
+
+func (s *MoveUnitSuite) runMoveUnit(c *gc.C, args ...string) (*cmd.Context, error) {
+	return cmdtesting.RunCommand(c, application.NewMoveUnitCommandForTest(s.mockAPI, s.store), args...)
+}
+
+func (s *MoveUnitSuite) TestMoveUnitInvalidArguments(c *gc.C) {
+	// No arguments.
+	_, err := s.runMoveUnit(c)
+	c.Assert(err, gc.ErrorMatches, "no unit or target machine specified")
+
+	// Invalid unit name.
+	_, err = s.runMoveUnit(c, "mysql", "1")
+	c.Assert(err, gc.ErrorMatches, `unit name "mysql" not valid`)
+
+	// Invalid machine id.
+	_, err = s.runMoveUnit(c, "mysql/0", "bad")
+	c.Assert(err, gc.ErrorMatches, `machine id "bad" not valid`)
+}
+
+func (s *MoveUnitSuite) TestMoveUnitSuccess(c *gc.C) {
+	_, err := s.runMoveUnit(c, "mysql/0", "1")
+	c.Assert(err, jc.ErrorIsNil)
+	s.mockAPI.CheckCall(c, 0, "MoveUnit", "mysql/0", "1")
+	s.mockAPI.CheckCall(c, 1, "Close")
+}
+
+func (s *MoveUnitSuite) TestMoveUnitList(c *gc.C) {
+	s.mockAPI.moves = []params.UnitMoveResult{{
+		MoveId:        "1",
+		OldUnit:       "mysql/0",
+		NewUnit:       "mysql/1",
+		TargetMachine: "3",
+		Status:        "waiting",
+		Message:       `waiting for unit "mysql/1" to become active`,
+	}}
+	ctx, err := s.runMoveUnit(c, "--list")
+	c.Assert(err, jc.ErrorIsNil)
+	s.mockAPI.CheckCall(c, 0, "UnitMoves")
+	c.Assert(cmdtesting.Stdout(ctx), jc.Contains, "mysql/1")
+	c.Assert(cmdtesting.Stdout(ctx), jc.Contains, "waiting")
+}
+
+func (s *MoveUnitSuite) TestMoveUnitFail(c *gc.C) {
+	msg := "fail move-unit at API"
+	s.mockAPI.SetErrors(errors.New(msg))
+	_, err := s.runMoveUnit(c, "mysql/0", "1")
+	c.Assert(err, gc.ErrorMatches, msg)
+}
+
+func (s *MoveUnitSuite) TestMoveUnitBlocked(c *gc.C) {
+	s.mockAPI.SetErrors(apiservererrors.OperationBlockedError("TestMoveUnitBlocked"))
+	_, err := s.runMoveUnit(c, "mysql/0", "1")
+	coretesting.AssertOperationWasBlocked(c, err, ".*TestMoveUnitBlocked.*")
+}
+
+type mockMoveUnitAPI struct {
+	*testing.Stub
+	moves []params.UnitMoveResult
+}
+
+func (s *mockMoveUnitAPI) Close() error {
+	s.MethodCall(s, "Close")
+	return s.NextErr()
+}
+
+func (s *mockMoveUnitAPI) MoveUnit(unitName, machineId string) (string, string, error) {
+	s.MethodCall(s, "MoveUnit", unitName, machineId)
+	return "1", "mysql/1", s.NextErr()
+}
+
+func (s *mockMoveUnitAPI) UnitMoves() ([]params.UnitMoveResult, error) {
+	s.MethodCall(s, "UnitMoves")
+	return s.moves, s.NextErr()
+}
@@ -5,6 +5,10 @@ package application
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/go-macaroon-bakery/macaroon-bakery/v3/httpbakery"
 	"github.com/juju/charm/v9"
@@ -112,6 +116,7 @@ type CharmRefreshClient interface {
 	GetCharmURLOrigin(string, string) (*charm.URL, commoncharm.Origin, error)
 	Get(string, string) (*params.ApplicationGetResults, error)
 	SetCharm(string, application.SetCharmConfig) error
+	SyncCharmFiles(string, []params.CharmSyncFile) error
 }
 
 // NewCharmAdderFunc is the type of a function used to construct
@@ -157,6 +162,11 @@ type refreshCommand struct {
 	CharmPath   string
 	Revision    int // defaults to -1 (latest)
 
+	// Watch, valid only with CharmPath, keeps the command running
+	// after the refresh, live-syncing further edits in the charm
+	// directory to the application's units.
+	Watch bool
+
 	BindToSpaces string
 	Bindings     map[string]string
 
@@ -196,6 +206,13 @@ which to load the updated charm. Note that the directory containing the charm mu
 match what was originally used to deploy the charm as a superficial check that the
 updated charm is compatible.
 
+With --path, the --watch option keeps the command running after the refresh and
+live-syncs subsequent edits in the charm directory straight to the deployed
+units, without uploading a new charm revision. This is intended for iterating
+on a charm during development; stop watching with Ctrl-C.
+
+  juju refresh foo --path ./foo --watch
+
 Resources may be uploaded at upgrade time by specifying the --resource option.
 Following the resource option should be name=filepath pair.  This option may be
 repeated more than once to upload more than one resource.
@@ -274,6 +291,7 @@ func (c *refreshCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.BoolVar(&c.ForceSeries, "force-series", false, "Refresh even if series of deployed applications are not supported by the new charm")
 	f.StringVar(&c.SwitchURL, "switch", "", "Crossgrade to a different charm")
 	f.StringVar(&c.CharmPath, "path", "", "Refresh to a charm located at path")
+	f.BoolVar(&c.Watch, "watch", false, "With --path, keep watching the charm directory and live-sync changes to the units")
 	f.IntVar(&c.Revision, "revision", -1, "Explicit revision of current charm")
 	f.Var(stringMap{&c.Resources}, "resource", "Resource to be uploaded to the controller")
 	f.Var(storageFlag{&c.Storage, nil}, "storage", "Charm storage constraints")
@@ -302,6 +320,9 @@ func (c *refreshCommand) Init(args []string) error {
 	if c.SwitchURL != "" && c.CharmPath != "" {
 		return errors.Errorf("--switch and --path are mutually exclusive")
 	}
+	if c.Watch && c.CharmPath == "" {
+		return errors.Errorf("--watch requires --path")
+	}
 	return nil
 }
 
@@ -468,9 +489,84 @@ func (c *refreshCommand) Run(ctx *cmd.Context) error {
 		ctx.Infof(change)
 	}
 
+	if c.Watch {
+		return errors.Trace(c.watchCharmDir(ctx, charmRefreshClient))
+	}
+
 	return nil
 }
 
+// charmWatchInterval is how often `refresh --path --watch` rescans the
+// charm directory for changes.
+const charmWatchInterval = 2 * time.Second
+
+// watchCharmDir polls the local charm directory and pushes changed
+// files to the application's units until interrupted.
+func (c *refreshCommand) watchCharmDir(ctx *cmd.Context, client CharmRefreshClient) error {
+	manifest, err := corecharm.ComputeSyncManifest(c.CharmPath)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	ctx.Infof("Watching %s for changes; press Ctrl-C to stop", c.CharmPath)
+
+	interrupted := make(chan os.Signal, 1)
+	ctx.InterruptNotify(interrupted)
+	defer ctx.StopInterruptNotify(interrupted)
+
+	timer := time.NewTimer(charmWatchInterval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-interrupted:
+			return nil
+		case <-timer.C:
+		}
+		timer.Reset(charmWatchInterval)
+
+		latest, err := corecharm.ComputeSyncManifest(c.CharmPath)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		delta := corecharm.ComputeSyncDelta(manifest, latest)
+		if delta.IsZero() {
+			continue
+		}
+		files, err := charmSyncFiles(c.CharmPath, latest, delta)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if err := client.SyncCharmFiles(c.ApplicationName, files); err != nil {
+			return errors.Trace(err)
+		}
+		manifest = latest
+		ctx.Infof("Synced %d change(s) to %s", len(files), c.ApplicationName)
+	}
+}
+
+// charmSyncFiles builds the sync payload for the given delta, reading
+// the content of added and modified files from the charm directory.
+func charmSyncFiles(charmPath string, latest corecharm.SyncManifest, delta corecharm.SyncDelta) ([]params.CharmSyncFile, error) {
+	var files []params.CharmSyncFile
+	for _, p := range append(delta.Added, delta.Modified...) {
+		content, err := ioutil.ReadFile(filepath.Join(charmPath, filepath.FromSlash(p)))
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		files = append(files, params.CharmSyncFile{
+			Path:    filepath.ToSlash(p),
+			Content: content,
+			Mode:    uint32(latest[p].Mode),
+		})
+	}
+	for _, p := range delta.Removed {
+		files = append(files, params.CharmSyncFile{
+			Path:    filepath.ToSlash(p),
+			Removed: true,
+		})
+	}
+	return files, nil
+}
+
 func (c *refreshCommand) validateEndpointNames(newCharmEndpoints set.Strings, oldEndpointsMap, userBindings map[string]string) error {
 	for epName := range userBindings {
 		if _, exists := oldEndpointsMap[epName]; exists || epName == "" {
@@ -455,6 +455,12 @@ func (s *RefreshErrorsStateSuite) TestSwitchAndPathFails(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, "--switch and --path are mutually exclusive")
 }
 
+func (s *RefreshErrorsStateSuite) TestWatchWithoutPathFails(c *gc.C) {
+	s.deployApplication(c)
+	_, err := s.runRefresh(c, s.cmd, "riak", "--watch")
+	c.Assert(err, gc.ErrorMatches, "--watch requires --path")
+}
+
 func (s *RefreshErrorsStateSuite) TestInvalidRevision(c *gc.C) {
 	s.deployApplication(c)
 	_, err := s.runRefresh(c, s.cmd, "riak", "--revision=blah")
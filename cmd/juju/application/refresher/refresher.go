@@ -12,6 +12,7 @@ import (
 	jujuclock "github.com/juju/clock"
 	"github.com/juju/errors"
 
+	apicharms "github.com/juju/juju/api/charms"
 	commoncharm "github.com/juju/juju/api/common/charm"
 	"github.com/juju/juju/cmd/juju/application/store"
 	"github.com/juju/juju/cmd/juju/application/utils"
@@ -271,6 +272,7 @@ func (r baseRefresher) ResolveCharm() (*charm.URL, commoncharm.Origin, error) {
 		if len(supportedSeries) > 0 {
 			series = supportedSeries
 		}
+		r.maybeReportSupportMatrix(newURL, origin)
 		return nil, commoncharm.Origin{}, errors.Errorf(
 			"cannot upgrade from single series %q charm to a charm supporting %q. Use --force-series to override.",
 			r.deployedSeries, series,
@@ -295,6 +297,25 @@ func (r baseRefresher) ResolveCharm() (*charm.URL, commoncharm.Origin, error) {
 	return newURL, origin, nil
 }
 
+// maybeReportSupportMatrix prints the full channel, base and architecture
+// release matrix for the charm when the resolver can supply one, helping the
+// user pick a combination that is actually supported.
+func (r baseRefresher) maybeReportSupportMatrix(curl *charm.URL, origin commoncharm.Origin) {
+	matrixResolver, ok := r.charmResolver.(interface {
+		SupportMatrix(*charm.URL, commoncharm.Origin) ([]apicharms.CharmRelease, error)
+	})
+	if !ok {
+		return
+	}
+	releases, err := matrixResolver.SupportMatrix(curl, origin)
+	if err != nil {
+		return
+	}
+	if matrix := utils.FormatCharmSupportMatrix(curl.Name, releases, origin.Architecture, r.deployedSeries); matrix != "" {
+		r.logger.Infof("%s", matrix)
+	}
+}
+
 // stdOriginResolver attempts to resolve the origin required to resolve a
 // charm. It works not only with charmstore charms but it also encapsulates the
 // required logic to deduce the appropriate origin for a charmstore to charmhub
@@ -10,6 +10,7 @@ import (
 	"github.com/juju/names/v4"
 
 	"github.com/juju/juju/api/application"
+	"github.com/juju/juju/apiserver/params"
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/cmd/juju/block"
 	"github.com/juju/juju/cmd/modelcmd"
@@ -25,9 +26,11 @@ type resolvedCommand struct {
 	applicationResolveAPI applicationResolveAPI
 	clientAPI             clientAPI
 
-	UnitNames []string
-	NoRetry   bool
-	All       bool
+	UnitNames  []string
+	NoRetry    bool
+	All        bool
+	AllErrored bool
+	AppName    string
 }
 
 func (c *resolvedCommand) Info() *cmd.Info {
@@ -43,9 +46,26 @@ func (c *resolvedCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.ModelCommandBase.SetFlags(f)
 	f.BoolVar(&c.NoRetry, "no-retry", false, "Do not re-execute failed hooks on the unit")
 	f.BoolVar(&c.All, "all", false, "Marks all units in error as resolved")
+	f.BoolVar(&c.AllErrored, "all-errored", false, "Marks all units in error as resolved, reporting each unit")
+	f.StringVar(&c.AppName, "app", "", "Only resolve errored units of the named application (requires --all-errored)")
 }
 
 func (c *resolvedCommand) Init(args []string) error {
+	if c.AppName != "" && !c.AllErrored {
+		return errors.New("--app requires --all-errored")
+	}
+	if c.AllErrored {
+		if c.All {
+			return errors.NotSupportedf("specifying --all with --all-errored")
+		}
+		if len(args) > 0 {
+			return errors.NotSupportedf("specifying unit names(s) with --all-errored")
+		}
+		if c.AppName != "" && !names.IsValidApplication(c.AppName) {
+			return errors.NotValidf("application name %q", c.AppName)
+		}
+		return nil
+	}
 	if c.All {
 		if len(args) > 0 {
 			return errors.NotSupportedf("specifying unit names(s) with --all")
@@ -68,6 +88,7 @@ func (c *resolvedCommand) Init(args []string) error {
 type applicationResolveAPI interface {
 	Close() error
 	ResolveUnitErrors(units []string, all, retry bool) error
+	ResolveUnitErrorsBySelector(appName string, retry bool) ([]params.BulkUnitResult, error)
 }
 
 type clientAPI interface {
@@ -101,5 +122,29 @@ func (c *resolvedCommand) Run(ctx *cmd.Context) error {
 	}
 	defer applicationResolveAPI.Close()
 
+	if c.AllErrored {
+		results, err := applicationResolveAPI.ResolveUnitErrorsBySelector(c.AppName, !c.NoRetry)
+		if err != nil {
+			return block.ProcessBlockedError(err, block.BlockChange)
+		}
+		var failed bool
+		for _, result := range results {
+			tag, err := names.ParseUnitTag(result.UnitTag)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			if result.Error != nil {
+				ctx.Infof("failed to resolve %s: %v", tag.Id(), result.Error)
+				failed = true
+				continue
+			}
+			ctx.Infof("resolved %s", tag.Id())
+		}
+		if failed {
+			return cmd.ErrSilent
+		}
+		return nil
+	}
+
 	return block.ProcessBlockedError(applicationResolveAPI.ResolveUnitErrors(c.UnitNames, c.All, !c.NoRetry), block.BlockChange)
 }
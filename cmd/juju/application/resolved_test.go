@@ -4,6 +4,7 @@
 package application_test
 
 import (
+	"github.com/juju/cmd/v3"
 	"github.com/juju/cmd/v3/cmdtesting"
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
@@ -78,9 +79,63 @@ func (s *ResolvedSuite) TestResolved(c *gc.C) {
 	}
 }
 
+var resolvedAllErroredTests = []struct {
+	args []string
+	err  string
+}{
+	{
+		args: []string{"--all-errored", "--all"},
+		err:  `specifying --all with --all-errored not supported`,
+	}, {
+		args: []string{"--all-errored", "jeremy-fisher/99"},
+		err:  `specifying unit names\(s\) with --all-errored not supported`,
+	}, {
+		args: []string{"--app", "foo"},
+		err:  `--app requires --all-errored`,
+	}, {
+		args: []string{"--all-errored", "--app", "foo=bar"},
+		err:  `application name "foo=bar" not valid`,
+	},
+}
+
+func (s *ResolvedSuite) TestResolveAllErroredInitErrors(c *gc.C) {
+	for i, t := range resolvedAllErroredTests {
+		c.Logf("test %d: %v", i, t.args)
+		err := s.runResolved(c, t.args)
+		c.Check(err, gc.ErrorMatches, t.err)
+	}
+}
+
+func (s *ResolvedSuite) TestResolveAllErrored(c *gc.C) {
+	s.mockAPI.bulkResults = []params.BulkUnitResult{
+		{UnitTag: "unit-foo-0"},
+		{UnitTag: "unit-foo-1"},
+	}
+	err := s.runResolved(c, []string{"--all-errored", "--app", "foo"})
+	c.Assert(err, jc.ErrorIsNil)
+	s.mockAPI.CheckCallNames(c, "ResolveUnitErrorsBySelector", "Close")
+	s.mockAPI.CheckCall(c, 0, "ResolveUnitErrorsBySelector", "foo", true)
+}
+
+func (s *ResolvedSuite) TestResolveAllErroredNoRetry(c *gc.C) {
+	err := s.runResolved(c, []string{"--all-errored", "--no-retry"})
+	c.Assert(err, jc.ErrorIsNil)
+	s.mockAPI.CheckCallNames(c, "ResolveUnitErrorsBySelector", "Close")
+	s.mockAPI.CheckCall(c, 0, "ResolveUnitErrorsBySelector", "", false)
+}
+
+func (s *ResolvedSuite) TestResolveAllErroredUnitFailure(c *gc.C) {
+	s.mockAPI.bulkResults = []params.BulkUnitResult{
+		{UnitTag: "unit-foo-0", Error: &params.Error{Message: "still in error"}},
+	}
+	err := s.runResolved(c, []string{"--all-errored"})
+	c.Assert(err, gc.Equals, cmd.ErrSilent)
+}
+
 type mockResolveAPI struct {
 	*testing.Stub
 	addRelationFunc func(endpoints, viaCIDRs []string) (*params.AddRelationResults, error)
+	bulkResults     []params.BulkUnitResult
 }
 
 func (s mockResolveAPI) Close() error {
@@ -93,6 +148,11 @@ func (s mockResolveAPI) ResolveUnitErrors(units []string, all, retry bool) error
 	return nil
 }
 
+func (s mockResolveAPI) ResolveUnitErrorsBySelector(appName string, retry bool) ([]params.BulkUnitResult, error) {
+	s.MethodCall(s, "ResolveUnitErrorsBySelector", appName, retry)
+	return s.bulkResults, s.NextErr()
+}
+
 func (s mockResolveAPI) Resolved(unit string, retry bool) error {
 	s.MethodCall(s, "Resolved", unit, retry)
 	return nil
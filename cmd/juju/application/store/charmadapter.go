@@ -119,6 +119,18 @@ func (c *CharmAdaptor) resolveCharmFallback(url *charm.URL, preferredOrigin comm
 	return resultURL, origin, supportedSeries, nil
 }
 
+// SupportMatrix returns the full channel, base and architecture release
+// matrix for a charmhub charm, when the charms API supports it.
+func (c *CharmAdaptor) SupportMatrix(url *charm.URL, origin commoncharm.Origin) ([]apicharm.CharmRelease, error) {
+	api, ok := c.charmsAPI.(interface {
+		SupportMatrix(*charm.URL, commoncharm.Origin) ([]apicharm.CharmRelease, error)
+	})
+	if !ok {
+		return nil, errors.NotSupportedf("support matrix")
+	}
+	return api.SupportMatrix(url, origin)
+}
+
 // ResolveBundleURL tries to interpret maybeBundle as a CharmStore
 // or CharmHub bundle. If it turns out to be a bundle, the resolved
 // URL and origin are returned. If it isn't but there wasn't a problem
@@ -0,0 +1,71 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	apicharms "github.com/juju/juju/api/charms"
+)
+
+// FormatCharmSupportMatrix renders the channel, base and architecture
+// combinations a charm has been released for, together with the closest
+// combination to the one that was requested.
+func FormatCharmSupportMatrix(name string, releases []apicharms.CharmRelease, arch, series string) string {
+	if len(releases) == 0 {
+		return ""
+	}
+
+	channelReleases := make(map[string][]string)
+	var channels []string
+	for _, release := range releases {
+		entry := release.Series
+		if release.Architecture != "" {
+			entry = fmt.Sprintf("%s (%s)", release.Series, release.Architecture)
+		}
+		if _, ok := channelReleases[release.Channel]; !ok {
+			channels = append(channels, release.Channel)
+		}
+		channelReleases[release.Channel] = append(channelReleases[release.Channel], entry)
+	}
+	sort.Strings(channels)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "charm %q supports the following combinations:\n", name)
+	for _, channel := range channels {
+		fmt.Fprintf(&b, "  %s: %s\n", channel, strings.Join(channelReleases[channel], ", "))
+	}
+	if closest, ok := ClosestCharmRelease(releases, arch, series); ok {
+		fmt.Fprintf(&b, "closest supported combination: channel %s with series %s on %s",
+			closest.Channel, closest.Series, closest.Architecture)
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// ClosestCharmRelease picks the release closest to the requested architecture
+// and series: an exact architecture and series match on any channel wins,
+// then any release for the requested architecture, then the first release.
+func ClosestCharmRelease(releases []apicharms.CharmRelease, arch, series string) (apicharms.CharmRelease, bool) {
+	if len(releases) == 0 {
+		return apicharms.CharmRelease{}, false
+	}
+	var archMatch *apicharms.CharmRelease
+	for i, release := range releases {
+		if arch != "" && release.Architecture != arch && release.Architecture != "all" {
+			continue
+		}
+		if release.Series == series {
+			return release, true
+		}
+		if archMatch == nil {
+			archMatch = &releases[i]
+		}
+	}
+	if archMatch != nil {
+		return *archMatch, true
+	}
+	return releases[0], true
+}
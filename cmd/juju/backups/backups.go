@@ -32,7 +32,8 @@ import (
 type APIClient interface {
 	io.Closer
 	// Create sends an RPC request to create a new backup.
-	Create(notes string, keepCopy, noDownload bool) (*params.BackupsMetadataResult, error)
+	Create(notes string, keepCopy, noDownload, incremental bool) (*params.BackupsMetadataResult, error)
+	RestoreModel(backupID, modelUUID string) (int, error)
 	// Info gets the backup's metadata.
 	Info(id string) (*params.BackupsMetadataResult, error)
 	// List gets all stored metadata.
@@ -35,6 +35,10 @@ at the end of the backup process.
 
 Use --keep-copy option to store a copy of backup remotely on the controller.
 
+Use --incremental to only back up the changes made since the most recent
+completed backup; restoring an incremental backup also requires the backup
+it is based on.
+
 Use --verbose to see extra information about backup.
 
 To access remote backups stored on the controller, see 'juju download-backup'.
@@ -67,6 +71,9 @@ type createCommand struct {
 	Notes string
 	// KeepCopy means the backup archive should be stored in the controller db.
 	KeepCopy bool
+	// Incremental means the backup should only capture the changes made
+	// since the most recent completed backup.
+	Incremental bool
 }
 
 // Info implements Command.Info.
@@ -84,6 +91,7 @@ func (c *createCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.CommandBase.SetFlags(f)
 	f.BoolVar(&c.NoDownload, "no-download", false, "Do not download the archive, implies keep-copy")
 	f.BoolVar(&c.KeepCopy, "keep-copy", false, "Keep a copy of the archive on the controller")
+	f.BoolVar(&c.Incremental, "incremental", false, "Only back up the changes since the last completed backup")
 	f.StringVar(&c.Filename, "filename", notset, "Download to this file")
 	c.fs = f
 }
@@ -204,7 +212,7 @@ func (c *createCommand) download(ctx *cmd.Context, client APIClient, copyFrom st
 }
 
 func (c *createCommand) create(client APIClient, apiVersion int) (*params.BackupsMetadataResult, string, error) {
-	result, err := client.Create(c.Notes, c.KeepCopy, c.NoDownload)
+	result, err := client.Create(c.Notes, c.KeepCopy, c.NoDownload, c.Incremental)
 	if err != nil {
 		return nil, "", errors.Trace(err)
 	}
@@ -183,7 +183,7 @@ func (s *createSuite) TestDefault(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 
 	client.CheckCalls(c, "Create", "Download")
-	client.CheckArgs(c, "", "false", "false", "filename")
+	client.CheckArgs(c, "", "false", "false", "false", "filename")
 	s.checkDownload(c, ctx)
 	c.Check(s.command.Filename, gc.Equals, backups.NotSet)
 }
@@ -195,7 +195,7 @@ func (s *createSuite) TestDefaultV1(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 
 	client.CheckCalls(c, "Create", "Download")
-	client.CheckArgs(c, "", "true", "false", "spam")
+	client.CheckArgs(c, "", "true", "false", "false", "spam")
 	c.Assert(s.command.KeepCopy, jc.IsTrue)
 	s.expectedErr = `
 Remote backup stored on the controller as spam.
@@ -211,7 +211,7 @@ func (s *createSuite) TestDefaultQuiet(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 
 	client.CheckCalls(c, "Create", "Download")
-	client.CheckArgs(c, "", "false", "false", "filename")
+	client.CheckArgs(c, "", "false", "false", "false", "filename")
 
 	c.Check(ctx.Stderr.(*bytes.Buffer).String(), gc.Equals, "")
 	c.Check(ctx.Stdout.(*bytes.Buffer).String(), gc.Equals, "")
@@ -223,7 +223,7 @@ func (s *createSuite) TestNotes(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 
 	client.CheckCalls(c, "Create", "Download")
-	client.CheckArgs(c, "test notes", "false", "false", "filename")
+	client.CheckArgs(c, "test notes", "false", "false", "false", "filename")
 	s.checkDownload(c, ctx)
 }
 
@@ -233,7 +233,7 @@ func (s *createSuite) TestFilename(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 
 	client.CheckCalls(c, "Create", "Download")
-	client.CheckArgs(c, "", "false", "false", "filename")
+	client.CheckArgs(c, "", "false", "false", "false", "filename")
 	s.expectedErr = `
 Remote backup was not created.
 Downloaded to backup.tgz.
@@ -248,7 +248,7 @@ func (s *createSuite) TestNoDownload(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 
 	client.CheckCalls(c, "Create")
-	client.CheckArgs(c, "", "true", "true")
+	client.CheckArgs(c, "", "true", "true", "false")
 	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "Remote backup stored on the controller as spam.\n")
 	c.Check(cmdtesting.Stdout(ctx), gc.Equals, s.expectedOut)
 	c.Check(s.command.Filename, gc.Equals, backups.NotSet)
@@ -260,7 +260,7 @@ func (s *createSuite) TestKeepCopy(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 
 	client.CheckCalls(c, "Create", "Download")
-	client.CheckArgs(c, "", "true", "false", "filename")
+	client.CheckArgs(c, "", "true", "false", "false", "filename")
 
 	s.expectedErr = `
 Remote backup stored on the controller as spam.
@@ -110,16 +110,29 @@ func (mr *MockAPIClientMockRecorder) Close() *gomock.Call {
 }
 
 // Create mocks base method
-func (m *MockAPIClient) Create(arg0 string, arg1, arg2 bool) (*params.BackupsMetadataResult, error) {
-	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+func (m *MockAPIClient) Create(arg0 string, arg1, arg2, arg3 bool) (*params.BackupsMetadataResult, error) {
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(*params.BackupsMetadataResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create
-func (mr *MockAPIClientMockRecorder) Create(arg0, arg1, arg2 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAPIClient)(nil).Create), arg0, arg1, arg2)
+func (mr *MockAPIClientMockRecorder) Create(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAPIClient)(nil).Create), arg0, arg1, arg2, arg3)
+}
+
+// RestoreModel mocks base method
+func (m *MockAPIClient) RestoreModel(arg0, arg1 string) (int, error) {
+	ret := m.ctrl.Call(m, "RestoreModel", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreModel indicates an expected call of RestoreModel
+func (mr *MockAPIClientMockRecorder) RestoreModel(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreModel", reflect.TypeOf((*MockAPIClient)(nil).RestoreModel), arg0, arg1)
 }
 
 // Download mocks base method
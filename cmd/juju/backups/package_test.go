@@ -191,9 +191,9 @@ func (f *fakeAPIClient) CheckArgs(c *gc.C, args ...string) {
 	c.Check(f.args, jc.DeepEquals, args)
 }
 
-func (c *fakeAPIClient) Create(notes string, keepCopy, noDownload bool) (*params.BackupsMetadataResult, error) {
+func (c *fakeAPIClient) Create(notes string, keepCopy, noDownload, incremental bool) (*params.BackupsMetadataResult, error) {
 	c.calls = append(c.calls, "Create")
-	c.args = append(c.args, notes, fmt.Sprintf("%t", keepCopy), fmt.Sprintf("%t", noDownload))
+	c.args = append(c.args, notes, fmt.Sprintf("%t", keepCopy), fmt.Sprintf("%t", noDownload), fmt.Sprintf("%t", incremental))
 	c.notes = notes
 	if c.err != nil {
 		return nil, c.err
@@ -203,6 +203,15 @@ func (c *fakeAPIClient) Create(notes string, keepCopy, noDownload bool) (*params
 	return createResult, nil
 }
 
+func (c *fakeAPIClient) RestoreModel(backupID, modelUUID string) (int, error) {
+	c.calls = append(c.calls, "RestoreModel")
+	c.args = append(c.args, backupID, modelUUID)
+	if c.err != nil {
+		return 0, c.err
+	}
+	return 1, nil
+}
+
 func (c *fakeAPIClient) Info(id string) (*params.BackupsMetadataResult, error) {
 	c.calls = append(c.calls, "Info")
 	c.args = append(c.args, id)
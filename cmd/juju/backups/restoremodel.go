@@ -0,0 +1,91 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"github.com/juju/cmd/v3"
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+const restoreModelDoc = `
+restore-model reconstructs a single model from a controller backup into
+the running controller, leaving every other model untouched. The model
+must not already exist on the controller.
+
+Examples:
+    juju restore-model 20140725-112005.f47ac10b 4a21ca5f-1b08-476a-84c9-4d4e17e7a1e9
+
+See also:
+    backups
+    create-backup
+`
+
+// NewRestoreModelCommand returns a command used to restore a single
+// model from a backup.
+func NewRestoreModelCommand() cmd.Command {
+	return modelcmd.Wrap(&restoreModelCommand{})
+}
+
+type restoreModelCommand struct {
+	CommandBase
+	// BackupID identifies the backup to restore from.
+	BackupID string
+	// ModelUUID identifies the model to restore.
+	ModelUUID string
+}
+
+// Info implements Command.Info.
+func (c *restoreModelCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:    "restore-model",
+		Args:    "<backup ID> <model UUID>",
+		Purpose: "Restore a single model from a backup.",
+		Doc:     restoreModelDoc,
+	})
+}
+
+// Init implements Command.Init.
+func (c *restoreModelCommand) Init(args []string) error {
+	if len(args) < 2 {
+		return errors.New("missing backup ID or model UUID")
+	}
+	backupID, modelUUID, args := args[0], args[1], args[2:]
+	if err := cmd.CheckEmpty(args); err != nil {
+		return errors.Trace(err)
+	}
+	if !names.IsValidModel(modelUUID) {
+		return errors.NotValidf("model UUID %q", modelUUID)
+	}
+	c.BackupID = backupID
+	c.ModelUUID = modelUUID
+	return nil
+}
+
+// Run implements Command.Run.
+func (c *restoreModelCommand) Run(ctx *cmd.Context) error {
+	if err := c.validateIaasController(c.Info().Name); err != nil {
+		return errors.Trace(err)
+	}
+
+	client, apiVersion, err := c.NewGetAPI()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	if apiVersion < 4 {
+		return errors.New("restore-model is not supported by this controller")
+	}
+
+	restored, err := client.RestoreModel(c.BackupID, c.ModelUUID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	ctx.Infof("restored model %v from %v (%d documents)", c.ModelUUID, c.BackupID, restored)
+	return nil
+}
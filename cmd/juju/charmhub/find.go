@@ -59,7 +59,10 @@ type findCommand struct {
 	category  string
 	channel   string
 	charmType string
+	base      string
 	publisher string
+	limit     int
+	offset    int
 
 	columns string
 }
@@ -90,7 +93,10 @@ func (c *findCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.StringVar(&c.category, "category", "", `filter by a category name`)
 	f.StringVar(&c.channel, "channel", "", `filter by channel. "latest" can be omitted, so "stable" also matches "latest/stable"`)
 	f.StringVar(&c.charmType, "type", "", `search by a given type <charm|bundle>`)
+	f.StringVar(&c.base, "base", "", `filter by a base, e.g. "ubuntu@20.04"`)
 	f.StringVar(&c.publisher, "publisher", "", `search by a given publisher`)
+	f.IntVar(&c.limit, "limit", 0, `limit the number of results returned`)
+	f.IntVar(&c.offset, "offset", 0, `skip the given number of results, for paginating through a large result set`)
 
 	f.StringVar(&c.columns, "columns", "nbvps", `display the columns associated with a find search.
 
@@ -121,6 +127,13 @@ func (c *findCommand) Init(args []string) error {
 		return errors.Errorf("expected type to be charm or bundle")
 	}
 
+	if c.limit < 0 {
+		return errors.Errorf("expected limit to be a positive number")
+	}
+	if c.offset < 0 {
+		return errors.Errorf("expected offset to be a positive number")
+	}
+
 	if c.columns == "" {
 		return errors.Errorf("expected at least one column")
 	}
@@ -230,9 +243,18 @@ func populateFindOptions(cmd *findCommand) []charmhub.FindOption {
 	if cmd.charmType != "" {
 		options = append(options, charmhub.WithFindType(cmd.charmType))
 	}
+	if cmd.base != "" {
+		options = append(options, charmhub.WithFindBase(cmd.base))
+	}
 	if cmd.publisher != "" {
 		options = append(options, charmhub.WithFindPublisher(cmd.publisher))
 	}
+	if cmd.limit > 0 {
+		options = append(options, charmhub.WithFindLimit(cmd.limit))
+	}
+	if cmd.offset > 0 {
+		options = append(options, charmhub.WithFindOffset(cmd.offset))
+	}
 
 	return options
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/juju/names/v4"
 
 	cloudapi "github.com/juju/juju/api/cloud"
+	"github.com/juju/juju/apiserver/params"
 	jujucloud "github.com/juju/juju/cloud"
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/cmd/modelcmd"
@@ -29,6 +30,10 @@ type updateCloudCommand struct {
 	// CloudFile is the name of the cloud YAML file
 	CloudFile string
 
+	// DryRun reports the changes an update on a controller would make
+	// without applying them.
+	DryRun bool
+
 	// Used when updating controllers' cloud details
 	updateCloudAPIFunc func() (updateCloudAPI, error)
 }
@@ -47,10 +52,15 @@ Use --controller option to update a cloud on a controller.
 
 Use --client to update cloud definition on this client.
 
+Use --dry-run to report the changes that updating a cloud on a controller
+would make, along with the validity of existing credentials for the models
+on that cloud, without applying the update.
+
 Examples:
 
     juju update-cloud mymaas -f path/to/maas.yaml
     juju update-cloud mymaas -f path/to/maas.yaml --controller mycontroller
+    juju update-cloud mymaas -f path/to/maas.yaml --controller mycontroller --dry-run
     juju update-cloud mymaas --controller mycontroller
     juju update-cloud mymaas --client --controller mycontroller
     juju update-cloud mymaas --client -f path/to/maas.yaml
@@ -63,6 +73,7 @@ See also:
 
 type updateCloudAPI interface {
 	UpdateCloud(jujucloud.Cloud) error
+	PreviewUpdateCloud(jujucloud.Cloud) (params.CloudUpdatePreviewResult, error)
 	Close() error
 }
 
@@ -123,6 +134,7 @@ func (c *updateCloudCommand) Info() *cmd.Info {
 func (c *updateCloudCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.OptionalControllerCommand.SetFlags(f)
 	f.StringVar(&c.CloudFile, "f", "", "The path to a cloud definition file")
+	f.BoolVar(&c.DryRun, "dry-run", false, "Report what updating the cloud on a controller would change without applying it")
 }
 
 func (c *updateCloudCommand) Run(ctxt *cmd.Context) error {
@@ -138,6 +150,18 @@ func (c *updateCloudCommand) Run(ctxt *cmd.Context) error {
 		}
 		c.Cloud = r.CloudName
 	}
+	if c.DryRun {
+		if c.ControllerName == "" {
+			return errors.New("--dry-run requires a controller, use --controller to specify one")
+		}
+		if c.CloudFile == "" {
+			var err error
+			if newCloud, err = cloudFromLocal(c.Store, c.Cloud); err != nil {
+				return errors.Trace(err)
+			}
+		}
+		return c.previewUpdateController(ctxt, newCloud)
+	}
 	if err := c.MaybePrompt(ctxt, fmt.Sprintf("update cloud %q on", c.Cloud)); err != nil {
 		return errors.Trace(err)
 	}
@@ -192,6 +216,36 @@ func (c *updateCloudCommand) updateControllerCacheFromLocalCache() error {
 	return c.updateController(newCloud)
 }
 
+func (c updateCloudCommand) previewUpdateController(ctxt *cmd.Context, cloud *jujucloud.Cloud) error {
+	api, err := c.updateCloudAPIFunc()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+	preview, err := api.PreviewUpdateCloud(*cloud)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(preview.Changes) == 0 {
+		ctxt.Infof("Updating cloud %q on controller %q would make no changes.", c.Cloud, c.ControllerName)
+	} else {
+		ctxt.Infof("Updating cloud %q on controller %q would change:", c.Cloud, c.ControllerName)
+		for _, change := range preview.Changes {
+			ctxt.Infof("  %s: %q -> %q", change.Attribute, change.Old, change.New)
+		}
+	}
+	for _, model := range preview.Models {
+		if len(model.Errors) == 0 {
+			ctxt.Infof("Credential for model %q remains valid.", model.ModelName)
+			continue
+		}
+		for _, result := range model.Errors {
+			ctxt.Infof("Credential for model %q would become invalid: %v", model.ModelName, result.Error)
+		}
+	}
+	return nil
+}
+
 func (c updateCloudCommand) updateController(cloud *jujucloud.Cloud) error {
 	api, err := c.updateCloudAPIFunc()
 	if err != nil {
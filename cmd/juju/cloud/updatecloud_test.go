@@ -13,6 +13,7 @@ import (
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/apiserver/params"
 	jujucloud "github.com/juju/juju/cloud"
 	"github.com/juju/juju/cmd/juju/cloud"
 	"github.com/juju/juju/juju/osenv"
@@ -153,8 +154,40 @@ Cloud "garage-maas" updated on controller "mycontroller" using client cloud defi
 `)
 }
 
+func (s *updateCloudSuite) TestUpdateControllerDryRun(c *gc.C) {
+	s.api.preview = params.CloudUpdatePreviewResult{
+		Changes: []params.CloudAttributeChange{
+			{Attribute: "endpoint", Old: "http://garagemaas", New: "http://newmaas"},
+		},
+		Models: []params.UpdateCredentialModelResult{
+			{ModelUUID: "deadbeef-0bad-400d-8000-4b1d0d06f00d", ModelName: "default"},
+		},
+	}
+	command, fileName := s.setupCloudFileScenario(c, func() (cloud.UpdateCloudAPI, error) {
+		return s.api, nil
+	})
+	ctx, err := cmdtesting.RunCommand(c, command, "garage-maas", "-f", fileName, "-c", "mycontroller", "--dry-run")
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckCallNames(c, "PreviewUpdateCloud", "Close")
+	c.Assert(cmdtesting.Stderr(ctx), gc.Equals, `
+Updating cloud "garage-maas" on controller "mycontroller" would change:
+  endpoint: "http://garagemaas" -> "http://newmaas"
+Credential for model "default" remains valid.
+`[1:])
+}
+
+func (s *updateCloudSuite) TestUpdateControllerDryRunNoController(c *gc.C) {
+	command, fileName := s.setupCloudFileScenario(c, func() (cloud.UpdateCloudAPI, error) {
+		return s.api, nil
+	})
+	_, err := cmdtesting.RunCommand(c, command, "garage-maas", "-f", fileName, "--dry-run")
+	c.Assert(err, gc.ErrorMatches, "--dry-run requires a controller, use --controller to specify one")
+	c.Assert(s.api.Calls(), gc.HasLen, 0)
+}
+
 type fakeUpdateCloudAPI struct {
 	jujutesting.Stub
+	preview params.CloudUpdatePreviewResult
 }
 
 func (api *fakeUpdateCloudAPI) Close() error {
@@ -166,3 +199,8 @@ func (api *fakeUpdateCloudAPI) UpdateCloud(cloud jujucloud.Cloud) error {
 	api.AddCall("UpdateCloud", cloud)
 	return api.NextErr()
 }
+
+func (api *fakeUpdateCloudAPI) PreviewUpdateCloud(cloud jujucloud.Cloud) (params.CloudUpdatePreviewResult, error) {
+	api.AddCall("PreviewUpdateCloud", cloud)
+	return api.preview, api.NextErr()
+}
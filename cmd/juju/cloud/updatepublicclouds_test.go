@@ -18,6 +18,7 @@ import (
 	"golang.org/x/crypto/openpgp/clearsign"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/apiserver/params"
 	jujucloud "github.com/juju/juju/cloud"
 	"github.com/juju/juju/cmd/juju/cloud"
 	sstesting "github.com/juju/juju/environs/simplestreams/testing"
@@ -297,6 +298,11 @@ func (f *fakeUpdatePublicCloudAPI) UpdateCloud(cloud jujucloud.Cloud) error {
 	return f.updateCloudF(cloud)
 }
 
+func (f *fakeUpdatePublicCloudAPI) PreviewUpdateCloud(cloud jujucloud.Cloud) (params.CloudUpdatePreviewResult, error) {
+	f.AddCall("PreviewUpdateCloud", cloud)
+	return params.CloudUpdatePreviewResult{}, nil
+}
+
 func (f *fakeUpdatePublicCloudAPI) Clouds() (map[names.CloudTag]jujucloud.Cloud, error) {
 	f.AddCall("Clouds")
 	return f.cloudsF()
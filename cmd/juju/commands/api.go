@@ -0,0 +1,158 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package commands
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+
+	"github.com/juju/cmd/v3"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/api"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+var usageAPICallSummary = `
+Makes a raw API call against the controller.`[1:]
+
+var usageAPICallDetails = `
+Performs a single facade call over the current model connection and
+prints the result. The facade version is negotiated with the server
+unless --facade-version is given.
+
+The call parameters are supplied as a JSON document via --params, or
+read from a file with --params @<file> (use @- for stdin). If no
+parameters are given, an empty request body is sent.
+
+This command is a developer aid for inspecting the controller API; it
+is only registered when developer mode is enabled, and the calls it
+makes are not checked by the client in any way.
+
+Examples:
+
+    juju api Client.FullStatus
+    juju api Application.Get --params '{"application": "mysql"}'
+    juju api ModelConfig.ModelGet --format json
+
+See also:
+    debug-log
+`
+
+func newAPICallCommand() cmd.Command {
+	return modelcmd.Wrap(&apiCallCommand{})
+}
+
+// apiCallCommand makes a raw facade call over the API connection and
+// renders the response.
+type apiCallCommand struct {
+	modelcmd.ModelCommandBase
+	out cmd.Output
+
+	facade        string
+	method        string
+	facadeVersion int
+	paramsSpec    string
+
+	apiOpen func() (api.Connection, error)
+}
+
+// Info implements cmd.Command.
+func (c *apiCallCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:    "api",
+		Args:    "<facade>.<method>",
+		Purpose: usageAPICallSummary,
+		Doc:     usageAPICallDetails,
+	})
+}
+
+// SetFlags implements cmd.Command.
+func (c *apiCallCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "smart", map[string]cmd.Formatter{
+		"smart": cmd.FormatSmart,
+		"yaml":  cmd.FormatYaml,
+		"json":  cmd.FormatJson,
+	})
+	f.StringVar(&c.paramsSpec, "params", "", "Call parameters as a JSON document, or @<file> (@- for stdin)")
+	f.IntVar(&c.facadeVersion, "facade-version", 0, "Facade version to call (defaults to the best negotiated version)")
+}
+
+// Init implements cmd.Command.
+func (c *apiCallCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no facade call specified")
+	}
+	parts := strings.SplitN(args[0], ".", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return errors.Errorf("invalid facade call %q, expected <facade>.<method>", args[0])
+	}
+	c.facade = parts[0]
+	c.method = parts[1]
+	if c.facadeVersion < 0 {
+		return errors.NotValidf("facade version %d", c.facadeVersion)
+	}
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Run implements cmd.Command.
+func (c *apiCallCommand) Run(ctx *cmd.Context) error {
+	callParams, err := c.readParams(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	apiOpen := c.apiOpen
+	if apiOpen == nil {
+		apiOpen = c.NewAPIRoot
+	}
+	conn, err := apiOpen()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer conn.Close()
+
+	vers := c.facadeVersion
+	if vers == 0 {
+		if vers = conn.BestFacadeVersion(c.facade); vers == 0 {
+			return errors.NotSupportedf("facade %q", c.facade)
+		}
+	}
+	var result map[string]interface{}
+	if err := conn.APICall(c.facade, vers, "", c.method, callParams, &result); err != nil {
+		return errors.Trace(err)
+	}
+	return c.out.Write(ctx, result)
+}
+
+// readParams parses the --params value, dereferencing @<file> and @-
+// references, into a document suitable for the request body.
+func (c *apiCallCommand) readParams(ctx *cmd.Context) (interface{}, error) {
+	spec := c.paramsSpec
+	if spec == "" {
+		return nil, nil
+	}
+	var data []byte
+	if strings.HasPrefix(spec, "@") {
+		var err error
+		if spec == "@-" {
+			data, err = ioutil.ReadAll(ctx.Stdin)
+		} else {
+			data, err = ioutil.ReadFile(ctx.AbsPath(spec[1:]))
+		}
+		if err != nil {
+			return nil, errors.Annotate(err, "reading call parameters")
+		}
+	} else {
+		data = []byte(spec)
+	}
+	var callParams map[string]interface{}
+	if err := json.Unmarshal(data, &callParams); err != nil {
+		return nil, errors.Annotate(err, "invalid call parameters")
+	}
+	return callParams, nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package commands
+
+import (
+	"github.com/juju/cmd/v3/cmdtesting"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/testing"
+)
+
+type APICallSuite struct {
+	testing.FakeJujuXDGDataHomeSuite
+}
+
+var _ = gc.Suite(&APICallSuite{})
+
+func (s *APICallSuite) TestInitArgParsing(c *gc.C) {
+	for i, test := range []struct {
+		args     []string
+		facade   string
+		method   string
+		errMatch string
+	}{
+		{
+			errMatch: "no facade call specified",
+		}, {
+			args:     []string{"Client"},
+			errMatch: `invalid facade call "Client", expected <facade>\.<method>`,
+		}, {
+			args:     []string{".FullStatus"},
+			errMatch: `invalid facade call "\.FullStatus", expected <facade>\.<method>`,
+		}, {
+			args:     []string{"Client.FullStatus", "extra"},
+			errMatch: `unrecognized args: \["extra"\]`,
+		}, {
+			args:     []string{"Client.FullStatus", "--facade-version", "-1"},
+			errMatch: "facade version -1 not valid",
+		}, {
+			args:   []string{"Client.FullStatus"},
+			facade: "Client",
+			method: "FullStatus",
+		}, {
+			args:   []string{"Application.Get", "--params", `{"application": "mysql"}`},
+			facade: "Application",
+			method: "Get",
+		},
+	} {
+		c.Logf("test %d: %v", i, test.args)
+		command := &apiCallCommand{}
+		err := cmdtesting.InitCommand(command, test.args)
+		if test.errMatch == "" {
+			c.Check(err, jc.ErrorIsNil)
+			c.Check(command.facade, gc.Equals, test.facade)
+			c.Check(command.method, gc.Equals, test.method)
+		} else {
+			c.Check(err, gc.ErrorMatches, test.errMatch)
+		}
+	}
+}
+
+func (s *APICallSuite) TestReadParams(c *gc.C) {
+	ctx := cmdtesting.Context(c)
+	command := &apiCallCommand{paramsSpec: `{"application": "mysql"}`}
+	result, err := command.readParams(ctx)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, map[string]interface{}{"application": "mysql"})
+}
+
+func (s *APICallSuite) TestReadParamsEmpty(c *gc.C) {
+	ctx := cmdtesting.Context(c)
+	command := &apiCallCommand{}
+	result, err := command.readParams(ctx)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, gc.IsNil)
+}
+
+func (s *APICallSuite) TestReadParamsInvalid(c *gc.C) {
+	ctx := cmdtesting.Context(c)
+	command := &apiCallCommand{paramsSpec: "not-json"}
+	_, err := command.readParams(ctx)
+	c.Assert(err, gc.ErrorMatches, "invalid call parameters: .*")
+}
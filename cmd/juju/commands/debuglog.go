@@ -52,18 +52,24 @@ The '--include-module' and '--exclude-module' options filter by (dotted)
 logging module name. The module name can be truncated such that all loggers
 with the prefix will match.
 
-The '--include-label' and '--exclude-label' options filter by logging label. 
+The '--include-module-regex' and '--exclude-module-regex' options filter by
+a regular expression matched against the full logging module name, for when
+a prefix match is not precise enough.
+
+The '--include-label' and '--exclude-label' options filter by logging label.
 
 The filtering options combine as follows:
 * All --include options are logically ORed together.
 * All --exclude options are logically ORed together.
 * All --include-module options are logically ORed together.
 * All --exclude-module options are logically ORed together.
+* All --include-module-regex options are logically ORed together.
+* All --exclude-module-regex options are logically ORed together.
 * All --include-label options are logically ORed together.
 * All --exclude-label options are logically ORed together.
 * The combined --include, --exclude, --include-module, --exclude-module,
-  --include-label and --exclude-label selections are logically ANDed to form
-  the complete filter.
+  --include-module-regex, --exclude-module-regex, --include-label and
+  --exclude-label selections are logically ANDed to form the complete filter.
 
 Examples:
 
@@ -106,6 +112,11 @@ new WARNING and ERROR messages as they are logged:
 
     juju debug-log --replay --level WARNING
 
+Show all messages logged within the last 30 minutes, rather than a fixed
+number of lines:
+
+    juju debug-log --since 30m
+
 See also:
     status
     ssh`
@@ -131,8 +142,10 @@ func newDebugLogCommandTZ(store jujuclient.ClientStore, tz *time.Location) cmd.C
 type debugLogCommand struct {
 	modelcmd.ModelCommandBase
 
-	level  string
-	params common.DebugLogParams
+	level    string
+	levelMax string
+	since    time.Duration
+	params   common.DebugLogParams
 
 	utc      bool
 	location bool
@@ -155,14 +168,18 @@ func (c *debugLogCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.Var(cmd.NewAppendStringsValue(&c.params.ExcludeEntity), "exclude", "Do not show log messages for these entities")
 	f.Var(cmd.NewAppendStringsValue(&c.params.IncludeModule), "include-module", "Only show log messages for these logging modules")
 	f.Var(cmd.NewAppendStringsValue(&c.params.ExcludeModule), "exclude-module", "Do not show log messages for these logging modules")
+	f.Var(cmd.NewAppendStringsValue(&c.params.IncludeModuleRegex), "include-module-regex", "Only show log messages for logging modules matching these regular expressions")
+	f.Var(cmd.NewAppendStringsValue(&c.params.ExcludeModuleRegex), "exclude-module-regex", "Do not show log messages for logging modules matching these regular expressions")
 	f.Var(cmd.NewAppendStringsValue(&c.params.IncludeLabel), "include-label", "Only show log messages for these logging labels")
 	f.Var(cmd.NewAppendStringsValue(&c.params.ExcludeLabel), "exclude-label", "Do not show log messages for these logging labels")
 
 	f.StringVar(&c.level, "l", "", "Log level to show, one of [TRACE, DEBUG, INFO, WARNING, ERROR]")
 	f.StringVar(&c.level, "level", "", "")
+	f.StringVar(&c.levelMax, "level-max", "", "Highest log level to show, one of [TRACE, DEBUG, INFO, WARNING, ERROR]")
 
 	f.UintVar(&c.params.Backlog, "n", defaultLineCount, "Show this many of the most recent (possibly filtered) lines, and continue to append")
 	f.UintVar(&c.params.Backlog, "lines", defaultLineCount, "")
+	f.DurationVar(&c.since, "since", 0, "Show the logs from this duration before now instead of a line count (overrides --lines)")
 	f.UintVar(&c.params.Limit, "limit", 0, "Exit once this many of the most recent (possibly filtered) lines are shown")
 	f.BoolVar(&c.params.Replay, "replay", false, "Show the entire (possibly filtered) log and continue to append")
 
@@ -185,6 +202,24 @@ func (c *debugLogCommand) Init(args []string) error {
 		}
 		c.params.Level = level
 	}
+	if c.levelMax != "" {
+		level, ok := loggo.ParseLevel(c.levelMax)
+		if !ok || level < loggo.TRACE || level > loggo.ERROR {
+			return errors.Errorf("level-max value %q is not one of %q, %q, %q, %q, %q",
+				c.levelMax, loggo.TRACE, loggo.DEBUG, loggo.INFO, loggo.WARNING, loggo.ERROR)
+		}
+		if c.params.Level != loggo.UNSPECIFIED && level < c.params.Level {
+			return errors.Errorf("level-max value %q is below level value %q", level, c.params.Level)
+		}
+		c.params.LevelMax = level
+	}
+	if c.since < 0 {
+		return errors.NotValidf("negative --since duration")
+	}
+	if c.since > 0 {
+		c.params.BacklogDuration = c.since
+		c.params.Backlog = 0
+	}
 	if c.tail && c.notail {
 		return errors.NotValidf("setting --tail and --no-tail")
 	}
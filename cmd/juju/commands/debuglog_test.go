@@ -49,6 +49,24 @@ func (s *DebugLogSuite) TestArgParsing(c *gc.C) {
 				Backlog: 10,
 				Level:   loggo.INFO,
 			},
+		}, {
+			args: []string{"--level=WARNING", "--level-max=ERROR"},
+			expected: common.DebugLogParams{
+				Backlog:  10,
+				Level:    loggo.WARNING,
+				LevelMax: loggo.ERROR,
+			},
+		}, {
+			args:     []string{"--level=WARNING", "--level-max=INFO"},
+			errMatch: `level-max value "INFO" is below level value "WARNING"`,
+		}, {
+			args: []string{"--since=30m"},
+			expected: common.DebugLogParams{
+				BacklogDuration: 30 * time.Minute,
+			},
+		}, {
+			args:     []string{"--since=-1h"},
+			errMatch: `negative --since duration not valid`,
 		}, {
 			args: []string{
 				"--include", "machine-1",
@@ -89,6 +107,18 @@ func (s *DebugLogSuite) TestArgParsing(c *gc.C) {
 				ExcludeModule: []string{"juju.foo", "unit"},
 				Backlog:       10,
 			},
+		}, {
+			args: []string{"--include-module-regex", "juju[.]foo.*", "--include-module-regex", "unit"},
+			expected: common.DebugLogParams{
+				IncludeModuleRegex: []string{"juju[.]foo.*", "unit"},
+				Backlog:            10,
+			},
+		}, {
+			args: []string{"--exclude-module-regex", "juju[.]foo.*", "--exclude-module-regex", "unit"},
+			expected: common.DebugLogParams{
+				ExcludeModuleRegex: []string{"juju[.]foo.*", "unit"},
+				Backlog:            10,
+			},
 		}, {
 			args: []string{"--include-label", "http", "--include-label", "apiserver"},
 			expected: common.DebugLogParams{
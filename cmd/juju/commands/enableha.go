@@ -63,6 +63,10 @@ type enableHACommand struct {
 
 	// PlacementSpec holds the unparsed placement directives argument (--to).
 	PlacementSpec string
+
+	// Replace holds the id of a dead controller machine to demote and
+	// replace with a newly created controller machine.
+	Replace string
 }
 
 const enableHADoc = `
@@ -91,6 +95,10 @@ Examples:
     # server2 used first, and if necessary, newly created controller
     # machines having at least 8GB RAM.
     juju enable-ha -n 7 --to server1,server2 --constraints mem=8G
+
+    # Replace controller machine 1, whose host has died, with a newly
+    # created controller machine.
+    juju enable-ha --replace 1
 `
 
 // formatSimple marshals value to a yaml-formatted []byte, unless value is nil.
@@ -146,6 +154,7 @@ func (c *enableHACommand) SetFlags(f *gnuflag.FlagSet) {
 	f.IntVar(&c.NumControllers, "n", 0, "Number of controllers to make available")
 	f.StringVar(&c.PlacementSpec, "to", "", "The machine(s) to become controllers, bypasses constraints")
 	f.StringVar(&c.ConstraintsStr, "constraints", "", "Additional machine constraints")
+	f.StringVar(&c.Replace, "replace", "", "The dead controller machine to demote and replace")
 	c.out.AddFlags(f, "simple", map[string]cmd.Formatter{
 		"yaml":   cmd.FormatYaml,
 		"json":   cmd.FormatJson,
@@ -158,6 +167,9 @@ func (c *enableHACommand) Init(args []string) error {
 	if c.NumControllers < 0 || (c.NumControllers%2 != 1 && c.NumControllers != 0) {
 		return errors.Errorf("must specify a number of controllers odd and non-negative")
 	}
+	if c.Replace != "" && !names.IsValidMachine(c.Replace) {
+		return errors.Errorf("invalid machine id %q", c.Replace)
+	}
 	if c.PlacementSpec != "" {
 		placementSpecs := strings.Split(c.PlacementSpec, ",")
 		c.Placement = make([]string, len(placementSpecs))
@@ -198,6 +210,9 @@ type MakeHAClient interface {
 	EnableHA(
 		numControllers int, cons constraints.Value,
 		placement []string) (params.ControllersChanges, error)
+	EnableHAReplacing(
+		numControllers int, cons constraints.Value,
+		placement []string, replaceMachine string) (params.ControllersChanges, error)
 }
 
 // Run connects to the environment specified on the command line
@@ -221,11 +236,21 @@ func (c *enableHACommand) Run(ctx *cmd.Context) error {
 	}
 
 	defer func() { _ = haClient.Close() }()
-	enableHAResult, err := haClient.EnableHA(
-		c.NumControllers,
-		c.Constraints,
-		c.Placement,
-	)
+	var enableHAResult params.ControllersChanges
+	if c.Replace != "" {
+		enableHAResult, err = haClient.EnableHAReplacing(
+			c.NumControllers,
+			c.Constraints,
+			c.Placement,
+			c.Replace,
+		)
+	} else {
+		enableHAResult, err = haClient.EnableHA(
+			c.NumControllers,
+			c.Constraints,
+			c.Placement,
+		)
+	}
 	if err != nil {
 		return block.ProcessBlockedError(err, block.BlockChange)
 	}
@@ -52,6 +52,7 @@ type fakeHAClient struct {
 	cons           constraints.Value
 	err            error
 	placement      []string
+	replace        string
 	result         params.ControllersChanges
 }
 
@@ -97,6 +98,20 @@ func (f *fakeHAClient) EnableHA(numControllers int, cons constraints.Value, plac
 	return f.result, nil
 }
 
+func (f *fakeHAClient) EnableHAReplacing(numControllers int, cons constraints.Value, placement []string, replaceMachine string) (
+	params.ControllersChanges, error,
+) {
+	f.replace = replaceMachine
+
+	if f.err != nil {
+		return f.result, f.err
+	}
+
+	f.result.Removed = append(f.result.Removed, "machine-"+replaceMachine)
+	f.result.Added = append(f.result.Added, "machine-3")
+	return f.result, nil
+}
+
 var _ = gc.Suite(&EnableHASuite{})
 
 func (s *EnableHASuite) runEnableHA(c *gc.C, args ...string) (*cmd.Context, error) {
@@ -171,6 +186,21 @@ func (s *EnableHASuite) TestEnableHAWithFive(c *gc.C) {
 	c.Assert(len(s.fake.placement), gc.Equals, 0)
 }
 
+func (s *EnableHASuite) TestEnableHAReplace(c *gc.C) {
+	ctx, err := s.runEnableHA(c, "--replace", "1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cmdtesting.Stdout(ctx), gc.Equals,
+		"adding machines: 3\n"+
+			"removing machines: 1\n\n")
+
+	c.Assert(s.fake.replace, gc.Equals, "1")
+}
+
+func (s *EnableHASuite) TestEnableHAInvalidReplace(c *gc.C) {
+	_, err := s.runEnableHA(c, "--replace", "machine-one")
+	c.Assert(err, gc.ErrorMatches, `invalid machine id "machine-one"`)
+}
+
 func (s *EnableHASuite) TestEnableHAWithConstraints(c *gc.C) {
 	ctx, err := s.runEnableHA(c, "--constraints", "mem=4G", "-n", "3")
 	c.Assert(err, jc.ErrorIsNil)
@@ -19,6 +19,7 @@ import (
 	cloudfile "github.com/juju/juju/cloud"
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/cmd/juju/action"
+	"github.com/juju/juju/cmd/juju/agenthealth"
 	"github.com/juju/juju/cmd/juju/application"
 	"github.com/juju/juju/cmd/juju/backups"
 	"github.com/juju/juju/cmd/juju/block"
@@ -28,6 +29,7 @@ import (
 	"github.com/juju/juju/cmd/juju/cloud"
 	"github.com/juju/juju/cmd/juju/controller"
 	"github.com/juju/juju/cmd/juju/crossmodel"
+	"github.com/juju/juju/cmd/juju/customevents"
 	"github.com/juju/juju/cmd/juju/dashboard"
 	"github.com/juju/juju/cmd/juju/firewall"
 	"github.com/juju/juju/cmd/juju/machine"
@@ -360,6 +362,9 @@ func registerCommands(r commandRegistry) {
 	r.Register(firewall.NewSetFirewallRuleCommand())
 	r.Register(firewall.NewListFirewallRulesCommand())
 
+	// Custom event commands.
+	r.Register(customevents.NewTriggerEventCommand())
+
 	// Destruction commands.
 	r.Register(application.NewRemoveRelationCommand())
 	r.Register(application.NewRemoveApplicationCommand())
@@ -373,12 +378,16 @@ func registerCommands(r commandRegistry) {
 
 	// Error resolution and debugging commands.
 	r.Register(action.NewExecCommand(nil))
+	r.Register(action.NewDiagnoseNetworkCommand(nil))
 	r.Register(newSCPCommand(nil))
 	r.Register(newSSHCommand(nil, nil))
 	r.Register(application.NewResolvedCommand())
 	r.Register(newDebugLogCommand(nil))
 	r.Register(newDebugHooksCommand(nil))
 	r.Register(newDebugCodeCommand(nil))
+	if featureflag.Enabled(feature.DeveloperMode) {
+		r.Register(newAPICallCommand())
+	}
 
 	// Configuration commands.
 	r.Register(model.NewModelGetConstraintsCommand())
@@ -400,6 +409,7 @@ func registerCommands(r commandRegistry) {
 	r.Register(backups.NewListCommand())
 	r.Register(backups.NewRemoveCommand())
 	r.Register(backups.NewUploadCommand())
+	r.Register(backups.NewRestoreModelCommand())
 
 	// Manage authorized ssh keys.
 	r.Register(NewAddKeysCommand())
@@ -426,9 +436,11 @@ func registerCommands(r commandRegistry) {
 	// Manage machines
 	r.Register(machine.NewAddCommand())
 	r.Register(machine.NewRemoveCommand())
+	r.Register(machine.NewReenrollCommand())
 	r.Register(machine.NewListMachinesCommand())
 	r.Register(machine.NewShowMachineCommand())
 	r.Register(machine.NewUpgradeSeriesCommand())
+	r.Register(agenthealth.NewAgentHealthCommand())
 
 	// Manage model
 	r.Register(model.NewConfigCommand())
@@ -479,6 +491,9 @@ func registerCommands(r commandRegistry) {
 	r.Register(application.NewApplicationGetConstraintsCommand())
 	r.Register(application.NewApplicationSetConstraintsCommand())
 	r.Register(application.NewDiffBundleCommand())
+	r.Register(application.NewDiffCharmCommand())
+	r.Register(application.NewMoveApplicationCommand())
+	r.Register(application.NewMoveUnitCommand())
 	r.Register(application.NewShowApplicationCommand())
 	r.Register(application.NewShowUnitCommand())
 
@@ -498,6 +513,7 @@ func registerCommands(r commandRegistry) {
 	r.Register(storage.NewRemoveStorageCommandWithAPI())
 	r.Register(storage.NewDetachStorageCommandWithAPI())
 	r.Register(storage.NewAttachStorageCommandWithAPI())
+	r.Register(storage.NewMoveStorageCommandWithAPI())
 	r.Register(storage.NewImportFilesystemCommand(storage.NewStorageImporter, nil))
 
 	// Manage spaces
@@ -522,6 +538,7 @@ func registerCommands(r commandRegistry) {
 	r.Register(controller.NewRegisterCommand())
 	r.Register(controller.NewUnregisterCommand(jujuclient.NewFileClientStore()))
 	r.Register(controller.NewEnableDestroyControllerCommand())
+	r.Register(controller.NewDrainControllerCommand())
 	r.Register(controller.NewShowControllerCommand())
 	r.Register(controller.NewConfigCommand())
 
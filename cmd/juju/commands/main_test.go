@@ -298,6 +298,7 @@ var commandNames = []string{
 	"add-subnet",
 	"add-unit",
 	"add-user",
+	"agent-health",
 	"agree",
 	"agreements",
 	"attach",
@@ -334,6 +335,7 @@ var commandNames = []string{
 	"destroy-model",
 	"detach-storage",
 	"diff-bundle",
+	"diff-charm",
 	"disable-command",
 	"disable-user",
 	"disabled-commands",
@@ -401,6 +403,7 @@ var commandNames = []string{
 	"operations",
 	"payloads",
 	"plans",
+	"reenroll-machine",
 	"refresh",
 	"regions",
 	"register",
@@ -427,6 +430,7 @@ var commandNames = []string{
 	"resolved",
 	"resolve",
 	"resources",
+	"restore-model",
 	"resume-relation",
 	"retry-provisioning",
 	"revoke",
@@ -475,6 +479,7 @@ var commandNames = []string{
 	"switch",
 	"sync-agent-binaries",
 	"sync-tools",
+	"trigger-event",
 	"trust",
 	"unexpose",
 	"unregister",
@@ -14,6 +14,8 @@ import (
 	"github.com/juju/featureflag"
 	"github.com/juju/gnuflag"
 	"github.com/juju/names/v4"
+	"github.com/juju/naturalsort"
+	"github.com/juju/version/v2"
 
 	"github.com/juju/juju/api/modelconfig"
 	"github.com/juju/juju/apiserver/params"
@@ -32,6 +34,7 @@ import (
 	"github.com/juju/juju/feature"
 	"github.com/juju/juju/jujuclient"
 	"github.com/juju/juju/tools"
+	"github.com/juju/juju/upgrades"
 	jujuversion "github.com/juju/juju/version"
 )
 
@@ -56,9 +59,10 @@ controllers in a high availability model failed to upgrade).
 
 Examples:
     juju upgrade-controller --dry-run
+    juju upgrade-controller --plan
     juju upgrade-controller --agent-version 2.0.1
-    
-See also: 
+
+See also:
     upgrade-model`
 
 func newUpgradeControllerCommand(options ...modelcmd.WrapControllerOption) cmd.Command {
@@ -72,6 +76,11 @@ type upgradeControllerCommand struct {
 	modelcmd.ControllerCommandBase
 	baseUpgradeCommand
 
+	// Plan requests that the upgrade be resolved and the exact steps,
+	// restart order and expected downtime be reported without making
+	// any change.
+	Plan bool
+
 	upgradeJujuAPI jujuClientAPI
 	rawArgs        []string
 }
@@ -87,6 +96,7 @@ func (c *upgradeControllerCommand) Info() *cmd.Info {
 func (c *upgradeControllerCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.ControllerCommandBase.SetFlags(f)
 	c.baseUpgradeCommand.SetFlags(f)
+	f.BoolVar(&c.Plan, "plan", false, "Resolve the upgrade and print the planned steps and expected downtime, without upgrading")
 }
 
 func (c *upgradeControllerCommand) getUpgradeJujuAPI() (jujuClientAPI, error) {
@@ -146,12 +156,216 @@ func (c *upgradeControllerCommand) Run(ctx *cmd.Context) (err error) {
 	if err != nil {
 		return errors.Trace(err)
 	}
+	if c.Plan {
+		return c.printUpgradePlan(ctx, controllerName, details.ModelType == model.CAAS)
+	}
 	if details.ModelType == model.CAAS {
 		return c.upgradeCAASController(ctx)
 	}
 	return c.upgradeIAASController(ctx, fullControllerModelName)
 }
 
+// Estimates used when reporting the expected downtime of a planned
+// controller upgrade. These are deliberately pessimistic; actual
+// restart and migration times are typically shorter.
+const (
+	controllerRestartEstimate = time.Minute
+	schemaStepEstimate        = 30 * time.Second
+)
+
+// printUpgradePlan resolves the upgrade exactly as a real run would,
+// then reports the target version, the database schema migrations and
+// controller upgrade steps that would run, the order in which the
+// controller agents would be restarted, and the expected downtime.
+// No change of any kind is made.
+func (c *upgradeControllerCommand) printUpgradePlan(ctx *cmd.Context, controllerName string, caasController bool) (err error) {
+	client, err := c.getUpgradeJujuAPI()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	modelConfigClient, err := c.getModelConfigAPI()
+	if err != nil {
+		return err
+	}
+	defer modelConfigClient.Close()
+	controllerAPI, err := c.getControllerAPI()
+	if err != nil {
+		return err
+	}
+	defer controllerAPI.Close()
+
+	defer func() {
+		if err == errUpToDate {
+			ctx.Infof(err.Error())
+			err = nil
+		}
+	}()
+
+	// Resolve the target version using the same machinery as a real
+	// upgrade run.
+	attrs, err := modelConfigClient.ModelGet()
+	if err != nil {
+		return err
+	}
+	cfg, err := config.New(config.NoDefaults, attrs)
+	if err != nil {
+		return err
+	}
+	currentAgentVersion, ok := cfg.AgentVersion()
+	if !ok {
+		// Can't happen. In theory.
+		return errors.New("incomplete model configuration")
+	}
+	warnCompat, err := c.precheckVersion(ctx, currentAgentVersion)
+	if err != nil {
+		return err
+	}
+	controllerCfg, err := controllerAPI.ControllerConfig()
+	if err != nil {
+		return err
+	}
+
+	availableAgents := func(controllerCfg controller.Config, majorVersion int, streamsVersions tools.List) (tools.Versions, error) {
+		agents := make(tools.Versions, len(streamsVersions))
+		for i, t := range streamsVersions {
+			agents[i] = t
+		}
+		return agents, nil
+	}
+	fetchTimeout := 10 * time.Minute
+	if caasController {
+		availableAgents = c.initCAASVersions
+		fetchTimeout = caasStreamsTimeout
+	}
+	context, err := c.initVersions(client, controllerCfg, cfg, currentAgentVersion, warnCompat, fetchTimeout, availableAgents)
+	if err != nil && !params.IsCodeNotFound(err) {
+		return err
+	}
+	if err := context.maybeChoosePackagedAgent(); err != nil {
+		return err
+	}
+	if err := context.validate(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(ctx.Stdout, "upgrade plan for controller %q:\n", controllerName)
+	fmt.Fprintf(ctx.Stdout, "    current version: %v\n", currentAgentVersion)
+	fmt.Fprintf(ctx.Stdout, "    target version:  %v\n", context.chosen)
+	if warnCompat {
+		fmt.Fprintf(ctx.Stderr, "version %s incompatible with this client (%s)\n", context.chosen, jujuversion.Current)
+	}
+
+	schemaSteps := upgrades.PlanStateUpgrade(currentAgentVersion, context.chosen, upgrades.DatabaseMaster, upgrades.Controller)
+	fmt.Fprintf(ctx.Stdout, "database schema migrations:\n")
+	if len(schemaSteps) == 0 {
+		fmt.Fprintf(ctx.Stdout, "    (none)\n")
+	}
+	for _, step := range schemaSteps {
+		fmt.Fprintf(ctx.Stdout, "    - %s\n", step)
+	}
+	controllerSteps := upgrades.PlanUpgrade(currentAgentVersion, context.chosen, upgrades.Controller)
+	fmt.Fprintf(ctx.Stdout, "controller upgrade steps:\n")
+	if len(controllerSteps) == 0 {
+		fmt.Fprintf(ctx.Stdout, "    (none)\n")
+	}
+	for _, step := range controllerSteps {
+		fmt.Fprintf(ctx.Stdout, "    - %s\n", step)
+	}
+	if currentAgentVersion.Major != context.chosen.Major || currentAgentVersion.Minor != context.chosen.Minor {
+		fmt.Fprintf(ctx.Stdout, "raft: a snapshot is taken before each controller agent restarts\n")
+	}
+
+	controllerNodes, err := c.planRestartOrder(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	// The API is only unavailable while the mongo primary restarts and
+	// the schema migrations run; the remaining restarts are masked by
+	// the other controllers.
+	apiDowntime := controllerRestartEstimate + time.Duration(len(schemaSteps))*schemaStepEstimate
+	totalDuration := time.Duration(controllerNodes)*controllerRestartEstimate + time.Duration(len(schemaSteps))*schemaStepEstimate
+	fmt.Fprintf(ctx.Stdout, "expected downtime: up to %v of API unavailability (upgrade completes in roughly %v)\n",
+		apiDowntime, totalDuration)
+
+	if err := c.planPrechecks(ctx, client, context.chosen); err != nil {
+		return err
+	}
+	fmt.Fprintf(ctx.Stdout, "no changes have been made; run the command without --plan to upgrade\n")
+	return nil
+}
+
+// planRestartOrder reports the order in which the controller agents
+// would restart and returns the number of controller nodes.
+func (c *upgradeControllerCommand) planRestartOrder(ctx *cmd.Context, client jujuClientAPI) (int, error) {
+	status, err := client.Status(nil)
+	if err != nil {
+		return 0, errors.Annotate(err, "cannot get controller status")
+	}
+	var voting, other []string
+	for id, m := range status.Machines {
+		if !m.WantsVote {
+			continue
+		}
+		if m.HasVote {
+			voting = append(voting, id)
+		} else {
+			other = append(other, id)
+		}
+	}
+	naturalsort.Sort(voting)
+	naturalsort.Sort(other)
+	nodes := append(other, voting...)
+	fmt.Fprintf(ctx.Stdout, "restart order:\n")
+	if len(nodes) == 0 {
+		// A CAAS controller has no machines in status; the operator
+		// restarts the controller pods in order itself.
+		fmt.Fprintf(ctx.Stdout, "    controller pods restart one at a time, mongo primary last\n")
+		return 1, nil
+	}
+	for i, id := range nodes {
+		note := "secondary"
+		if i == len(nodes)-1 {
+			note = "mongo primary restarts last"
+		}
+		if len(nodes) == 1 {
+			note = "sole controller"
+		}
+		fmt.Fprintf(ctx.Stdout, "    - machine %s (%s)\n", id, note)
+	}
+	return len(nodes), nil
+}
+
+// planPrechecks runs the registered upgrade prechecks against the
+// controller model and reports any that fail.
+func (c *upgradeControllerCommand) planPrechecks(ctx *cmd.Context, client jujuClientAPI, targetVersion version.Number) error {
+	results, err := client.PrecheckModelUpgrade(targetVersion)
+	if errors.IsNotImplemented(err) || params.IsCodeNotImplemented(err) {
+		fmt.Fprintf(ctx.Stdout, "prechecks: not supported by this controller\n")
+		return nil
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	fmt.Fprintf(ctx.Stdout, "prechecks:\n")
+	failed := 0
+	for _, result := range results.Results {
+		if result.Error != nil {
+			failed++
+			fmt.Fprintf(ctx.Stdout, "    FAIL %s: %v\n", result.Name, result.Error)
+		} else {
+			fmt.Fprintf(ctx.Stdout, "    OK   %s\n", result.Name)
+		}
+	}
+	if len(results.Results) == 0 {
+		fmt.Fprintf(ctx.Stdout, "    (none registered)\n")
+	}
+	if failed > 0 {
+		return errors.Errorf("%d precheck(s) failed; the controller is not ready to upgrade to %v", failed, targetVersion)
+	}
+	return nil
+}
+
 // fetchStreamsVersions returns simplestreams agent metadata
 // for the specified stream. timeout ensures we don't block forever.
 func fetchStreamsVersions(
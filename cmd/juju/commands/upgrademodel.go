@@ -74,6 +74,7 @@ Backups are recommended prior to upgrading.
 
 Examples:
     juju upgrade-model --dry-run
+    juju upgrade-model --precheck-only
     juju upgrade-model --agent-version 2.0.1
     juju upgrade-model --agent-stream proposed
     
@@ -211,6 +212,10 @@ type upgradeJujuCommand struct {
 	modelcmd.ModelCommandBase
 	baseUpgradeCommand
 
+	// PrecheckOnly requests that the registered upgrade prechecks are
+	// run and reported without starting the upgrade.
+	PrecheckOnly bool
+
 	jujuClientAPI jujuClientAPI
 }
 
@@ -226,6 +231,7 @@ func (c *upgradeJujuCommand) Info() *cmd.Info {
 func (c *upgradeJujuCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.ModelCommandBase.SetFlags(f)
 	c.baseUpgradeCommand.SetFlags(f)
+	f.BoolVar(&c.PrecheckOnly, "precheck-only", false, "Run the upgrade readiness checks and report, without upgrading")
 }
 
 var (
@@ -280,6 +286,7 @@ type toolsAPI interface {
 type upgradeJujuAPI interface {
 	AbortCurrentUpgrade() error
 	SetModelAgentVersion(version version.Number, ignoreAgentVersion bool) error
+	PrecheckModelUpgrade(targetVersion version.Number) (params.PrecheckModelUpgradeResults, error)
 	Close() error
 }
 
@@ -377,9 +384,45 @@ func (c *upgradeJujuCommand) Run(ctx *cmd.Context) (err error) {
 		fetchToolsTimeout = caasStreamsTimeout
 		availableAgents = c.initCAASVersions
 	}
+	if c.PrecheckOnly {
+		return c.reportUpgradePrechecks(ctx)
+	}
 	return c.upgradeModel(ctx, implicitAgentUploadAllowed, fetchToolsTimeout, availableAgents)
 }
 
+// reportUpgradePrechecks asks the controller to run the registered
+// upgrade prechecks against the model and prints the readiness report.
+func (c *upgradeJujuCommand) reportUpgradePrechecks(ctx *cmd.Context) error {
+	client, err := c.getJujuClientAPI()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	targetVersion := c.Version
+	if targetVersion == version.Zero {
+		targetVersion = jujuversion.Current
+	}
+	results, err := client.PrecheckModelUpgrade(targetVersion)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	failed := false
+	for _, result := range results.Results {
+		if result.Error != nil {
+			failed = true
+			ctx.Infof("FAIL %s: %v", result.Name, result.Error)
+		} else {
+			ctx.Infof("OK   %s", result.Name)
+		}
+	}
+	if failed {
+		return errors.Errorf("model is not ready to upgrade to %v", targetVersion)
+	}
+	ctx.Infof("model is ready to upgrade to %v", targetVersion)
+	return nil
+}
+
 func (c *upgradeJujuCommand) upgradeModel(ctx *cmd.Context, implicitUploadAllowed bool, fetchTimeout time.Duration, availableAgents availableAgentsFunc) (err error) {
 	// Validate a model can be upgraded, by running some pre-flight checks.
 	if err := c.validateModelUpgrade(); err != nil {
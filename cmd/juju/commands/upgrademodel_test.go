@@ -1141,6 +1141,10 @@ func (a *fakeUpgradeJujuAPI) SetModelAgentVersion(v version.Number, ignoreAgentV
 	return a.setVersionErr
 }
 
+func (a *fakeUpgradeJujuAPI) PrecheckModelUpgrade(targetVersion version.Number) (params.PrecheckModelUpgradeResults, error) {
+	return params.PrecheckModelUpgradeResults{}, nil
+}
+
 func (a *fakeUpgradeJujuAPI) ValidateModelUpgrade(tag names.ModelTag, force bool) error {
 	return a.setUpgradeErr
 }
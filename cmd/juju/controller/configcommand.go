@@ -15,6 +15,7 @@ import (
 	"github.com/juju/gnuflag"
 
 	apicontroller "github.com/juju/juju/api/controller"
+	"github.com/juju/juju/apiserver/params"
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/cmd/juju/common"
 	"github.com/juju/juju/cmd/modelcmd"
@@ -38,6 +39,7 @@ type configCommand struct {
 	action     func(controllerAPI, *cmd.Context) error // The action we want to perform, set in cmd.Init.
 	key        string                                  // One config key to read.
 	setOptions common.ConfigFlag                       // Config values to set.
+	schema     bool                                    // Print the config schema rather than values.
 }
 
 const (
@@ -65,6 +67,7 @@ Examples:
     juju controller-config auditing-enabled=true audit-log-max-backups=5
     juju controller-config auditing-enabled=true path/to/file.yaml
     juju controller-config path/to/file.yaml
+    juju controller-config --schema
 
 See also:
     controllers
@@ -107,11 +110,19 @@ func (c *configCommand) SetFlags(f *gnuflag.FlagSet) {
 		"tabular": formatConfigTabular,
 		"yaml":    cmd.FormatYaml,
 	})
+	f.BoolVar(&c.schema, "schema", false, "Display the controller config schema (types, defaults and mutability) instead of the current values")
 }
 
 // Init initialised the command from the arguments - it's part of
 // cmd.Command.
 func (c *configCommand) Init(args []string) error {
+	if c.schema {
+		if len(args) != 0 {
+			return errors.New("--schema cannot be combined with getting or setting values")
+		}
+		c.action = c.getSchema
+		return nil
+	}
 	switch len(args) {
 	case 0:
 		return c.handleZeroArgs()
@@ -168,6 +179,7 @@ type controllerAPI interface {
 	Close() error
 	ControllerConfig() (controller.Config, error)
 	ConfigSet(map[string]interface{}) error
+	ConfigSchema() (map[string][]params.ConfigSchemaAttr, error)
 }
 
 func (c *configCommand) getAPI() (controllerAPI, error) {
@@ -218,6 +230,36 @@ func (c *configCommand) getConfig(client controllerAPI, ctx *cmd.Context) error
 	return c.out.Write(ctx, attrs)
 }
 
+// printSchemaAttr is the structured output form of one schema
+// attribute as shown by --schema.
+type printSchemaAttr struct {
+	Type        string      `yaml:"type" json:"type"`
+	Description string      `yaml:"description,omitempty" json:"description,omitempty"`
+	Default     interface{} `yaml:"default,omitempty" json:"default,omitempty"`
+	Mutable     bool        `yaml:"mutable" json:"mutable"`
+}
+
+func (c *configCommand) getSchema(client controllerAPI, ctx *cmd.Context) error {
+	schemas, err := client.ConfigSchema()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	attrs := schemas["controller-config"]
+	if c.out.Name() == "tabular" {
+		return c.out.WriteFormatter(ctx, formatSchemaTabular, attrs)
+	}
+	details := make(map[string]printSchemaAttr)
+	for _, attr := range attrs {
+		details[attr.Name] = printSchemaAttr{
+			Type:        attr.Type,
+			Description: attr.Doc,
+			Default:     attr.Default,
+			Mutable:     attr.Mutable,
+		}
+	}
+	return c.out.Write(ctx, details)
+}
+
 func (c *configCommand) setConfig(client controllerAPI, ctx *cmd.Context) error {
 	attrs, err := c.setOptions.ReadAttrs(ctx)
 	if err != nil {
@@ -241,6 +283,26 @@ func ConfigDetails() (map[string]interface{}, error) {
 	return specifics, nil
 }
 
+func formatSchemaTabular(writer io.Writer, value interface{}) error {
+	attrs, ok := value.([]params.ConfigSchemaAttr)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", attrs, value)
+	}
+
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{tw}
+	w.Println("Attribute", "Type", "Default", "Mutable")
+	for _, attr := range attrs {
+		var defaultValue string
+		if attr.Default != nil {
+			defaultValue = fmt.Sprintf("%v", attr.Default)
+		}
+		w.Println(attr.Name, attr.Type, defaultValue, fmt.Sprintf("%v", attr.Mutable))
+	}
+	w.Flush()
+	return nil
+}
+
 func formatConfigTabular(writer io.Writer, value interface{}) error {
 	controllerConfig, ok := value.(controller.Config)
 	if !ok {
@@ -14,6 +14,7 @@ import (
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/cmd/juju/controller"
 	jujucontroller "github.com/juju/juju/controller"
 )
@@ -67,6 +68,17 @@ func (s *ConfigSuite) TestInit(c *gc.C) {
 	}, {
 		desc: "can mix setting with files",
 		args: []string{"key1=value", path},
+	}, {
+		desc: "show schema",
+		args: []string{"--schema"},
+	}, {
+		desc: "can't mix schema and getting",
+		args: []string{"--schema", "one"},
+		err:  "--schema cannot be combined with getting or setting values",
+	}, {
+		desc: "can't mix schema and setting",
+		args: []string{"--schema", "key=value"},
+		err:  "--schema cannot be combined with getting or setting values",
 	}}
 	for i, test := range tests {
 		c.Logf("%d - %s", i, test.desc)
@@ -137,6 +149,37 @@ func (s *ConfigSuite) TestAllValuesJSON(c *gc.C) {
 	c.Assert(output, gc.Equals, expected)
 }
 
+func (s *ConfigSuite) TestSchemaTabular(c *gc.C) {
+	context, err := s.run(c, "--schema")
+	c.Assert(err, jc.ErrorIsNil)
+
+	output := strings.TrimSpace(cmdtesting.Stdout(context))
+	expected := `
+Attribute              Type  Default  Mutable
+api-port               int   17070    false
+audit-log-max-backups  int   10       true`[1:]
+	c.Assert(output, gc.Equals, expected)
+}
+
+func (s *ConfigSuite) TestSchemaYAML(c *gc.C) {
+	context, err := s.run(c, "--schema", "--format=yaml")
+	c.Assert(err, jc.ErrorIsNil)
+
+	output := strings.TrimSpace(cmdtesting.Stdout(context))
+	expected := `
+api-port:
+  type: int
+  description: The port used for api connections
+  default: 17070
+  mutable: false
+audit-log-max-backups:
+  type: int
+  description: The number of old audit log files to keep (compressed)
+  default: 10
+  mutable: true`[1:]
+	c.Assert(output, gc.Equals, expected)
+}
+
 func (s *ConfigSuite) TestNonexistentValue(c *gc.C) {
 	context, err := s.run(c, "courtney-barnett")
 	c.Assert(err, gc.ErrorMatches, `key "courtney-barnett" not found in "mallards" controller`)
@@ -267,9 +310,10 @@ func writeFile(c *gc.C, name, content string) string {
 }
 
 type fakeControllerAPI struct {
-	err    error
-	config map[string]interface{}
-	values map[string]interface{}
+	err     error
+	config  map[string]interface{}
+	values  map[string]interface{}
+	schemas map[string][]params.ConfigSchemaAttr
 }
 
 func (f *fakeControllerAPI) Close() error {
@@ -298,3 +342,27 @@ func (f *fakeControllerAPI) ConfigSet(values map[string]interface{}) error {
 	f.values = values
 	return f.err
 }
+
+func (f *fakeControllerAPI) ConfigSchema() (map[string][]params.ConfigSchemaAttr, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if f.schemas != nil {
+		return f.schemas, nil
+	}
+	return map[string][]params.ConfigSchemaAttr{
+		"controller-config": {{
+			Name:    "api-port",
+			Type:    "int",
+			Doc:     "The port used for api connections",
+			Default: 17070,
+			Mutable: false,
+		}, {
+			Name:    "audit-log-max-backups",
+			Type:    "int",
+			Doc:     "The number of old audit log files to keep (compressed)",
+			Default: 10,
+			Mutable: true,
+		}},
+	}, nil
+}
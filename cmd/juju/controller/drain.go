@@ -0,0 +1,100 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller
+
+import (
+	"github.com/juju/cmd/v3"
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	apicontroller "github.com/juju/juju/api/controller"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+// NewDrainControllerCommand returns a command that drains a controller
+// node ahead of maintenance.
+func NewDrainControllerCommand() cmd.Command {
+	return modelcmd.WrapController(&drainControllerCommand{})
+}
+
+type drainControllerCommand struct {
+	modelcmd.ControllerCommandBase
+	api       drainControllerAPI
+	machineId string
+}
+
+type drainControllerAPI interface {
+	Close() error
+	DrainControllerNode(machineId string) error
+	ControllerNodeDrainStatus(machineId string) (apicontroller.DrainStatus, error)
+}
+
+var drainControllerDoc = `
+Drains the controller node running on the given machine so that it can
+be taken down for maintenance. The node stops wanting a replica set
+vote and the remaining controllers take over its vote and any singular
+workers. Draining the only voting controller is refused.
+
+The command reports the current drain progress; run it again to check
+whether the node has finished handing over its vote.
+
+Examples:
+    juju drain-controller 1
+
+See also:
+    enable-ha
+`
+
+// Info implements Command.Info.
+func (c *drainControllerCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:    "drain-controller",
+		Args:    "<machine number>",
+		Purpose: "Drain a controller node ahead of maintenance.",
+		Doc:     drainControllerDoc,
+	})
+}
+
+// Init implements Command.Init.
+func (c *drainControllerCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no controller machine specified")
+	}
+	if !names.IsValidMachine(args[0]) {
+		return errors.Errorf("invalid machine id %q", args[0])
+	}
+	c.machineId = args[0]
+	return cmd.CheckEmpty(args[1:])
+}
+
+func (c *drainControllerCommand) getAPI() (drainControllerAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	return c.NewControllerAPIClient()
+}
+
+// Run implements Command.Run.
+func (c *drainControllerCommand) Run(ctx *cmd.Context) error {
+	client, err := c.getAPI()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	if err := client.DrainControllerNode(c.machineId); err != nil {
+		return errors.Trace(err)
+	}
+	status, err := client.ControllerNodeDrainStatus(c.machineId)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if status.Ready {
+		ctx.Infof("controller node %s is drained and ready for maintenance", c.machineId)
+	} else {
+		ctx.Infof("controller node %s is draining; re-run this command to check whether its vote has been handed over", c.machineId)
+	}
+	return nil
+}
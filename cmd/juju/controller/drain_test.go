@@ -0,0 +1,86 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller_test
+
+import (
+	"github.com/juju/cmd/v3"
+	"github.com/juju/cmd/v3/cmdtesting"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	apicontroller "github.com/juju/juju/api/controller"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/cmd/juju/controller"
+	"github.com/juju/juju/jujuclient"
+)
+
+type drainControllerSuite struct {
+	baseControllerSuite
+	api   *fakeDrainControllerAPI
+	store *jujuclient.MemStore
+}
+
+var _ = gc.Suite(&drainControllerSuite{})
+
+func (s *drainControllerSuite) SetUpTest(c *gc.C) {
+	s.baseControllerSuite.SetUpTest(c)
+
+	s.api = &fakeDrainControllerAPI{}
+	s.store = jujuclient.NewMemStore()
+	s.store.CurrentControllerName = "fake"
+	s.store.Controllers["fake"] = jujuclient.ControllerDetails{}
+}
+
+func (s *drainControllerSuite) newCommand() cmd.Command {
+	return controller.NewDrainControllerCommandForTest(s.api, s.store)
+}
+
+func (s *drainControllerSuite) TestDrain(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, s.newCommand(), "1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.api.drained, gc.Equals, "1")
+	c.Assert(cmdtesting.Stderr(ctx), jc.Contains, "controller node 1 is draining")
+}
+
+func (s *drainControllerSuite) TestDrainReady(c *gc.C) {
+	s.api.status = apicontroller.DrainStatus{Draining: true, Ready: true}
+	ctx, err := cmdtesting.RunCommand(c, s.newCommand(), "1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cmdtesting.Stderr(ctx), jc.Contains, "controller node 1 is drained and ready for maintenance")
+}
+
+func (s *drainControllerSuite) TestNoMachine(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, s.newCommand())
+	c.Assert(err, gc.ErrorMatches, "no controller machine specified")
+}
+
+func (s *drainControllerSuite) TestInvalidMachine(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, s.newCommand(), "machine-one")
+	c.Assert(err, gc.ErrorMatches, `invalid machine id "machine-one"`)
+}
+
+func (s *drainControllerSuite) TestDrainError(c *gc.C) {
+	s.api.err = apiservererrors.ErrPerm
+	_, err := cmdtesting.RunCommand(c, s.newCommand(), "1")
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
+
+type fakeDrainControllerAPI struct {
+	err     error
+	drained string
+	status  apicontroller.DrainStatus
+}
+
+func (f *fakeDrainControllerAPI) Close() error {
+	return nil
+}
+
+func (f *fakeDrainControllerAPI) DrainControllerNode(machineId string) error {
+	f.drained = machineId
+	return f.err
+}
+
+func (f *fakeDrainControllerAPI) ControllerNodeDrainStatus(machineId string) (apicontroller.DrainStatus, error) {
+	return f.status, f.err
+}
@@ -93,6 +93,16 @@ func NewEnableDestroyControllerCommandForTest(api removeBlocksAPI, store jujucli
 	return modelcmd.WrapController(c)
 }
 
+// NewDrainControllerCommandForTest returns a drainControllerCommand
+// with the function used to open the API connection mocked out.
+func NewDrainControllerCommandForTest(api drainControllerAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &drainControllerCommand{
+		api: api,
+	}
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
+
 // NewDestroyCommandForTest returns a DestroyCommand with the controller and
 // client endpoints mocked out.
 func NewDestroyCommandForTest(
@@ -0,0 +1,23 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package customevents
+
+import (
+	"github.com/juju/cmd/v3"
+
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/jujuclient/jujuclienttesting"
+)
+
+func NewTriggerEventCommandForTest(
+	api TriggerEventAPI,
+) cmd.Command {
+	aCmd := &triggerEventCommand{
+		newAPIFunc: func() (TriggerEventAPI, error) {
+			return api, nil
+		},
+	}
+	aCmd.SetClientStore(jujuclienttesting.MinimalStore())
+	return modelcmd.Wrap(aCmd)
+}
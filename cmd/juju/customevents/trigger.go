@@ -0,0 +1,120 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package customevents
+
+import (
+	"github.com/juju/cmd/v3"
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	"github.com/juju/utils/v2/keyvalues"
+
+	"github.com/juju/juju/api/customevents"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+var triggerEventHelpSummary = `
+Triggers a charm-defined custom event on a unit or application.`[1:]
+
+var triggerEventHelpDetails = `
+Queues a custom event for the given unit or application. The uniter
+dispatches a corresponding charm hook for the event. Unlike actions,
+custom events carry no results; they are intended for operational
+runbooks such as certificate rotation.
+
+Event parameters may be passed as key=value pairs.
+
+Examples:
+    juju trigger-event mysql/0 rotate-certs
+    juju trigger-event mysql rotate-certs expiry=30d
+
+See also:
+    run`
+
+// NewTriggerEventCommand returns a command to trigger custom events.
+func NewTriggerEventCommand() cmd.Command {
+	cmd := &triggerEventCommand{}
+	cmd.newAPIFunc = func() (TriggerEventAPI, error) {
+		root, err := cmd.NewAPIRoot()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return customevents.NewClient(root), nil
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+type triggerEventCommand struct {
+	modelcmd.ModelCommandBase
+
+	receiver  names.Tag
+	eventName string
+	params    map[string]interface{}
+
+	newAPIFunc func() (TriggerEventAPI, error)
+}
+
+// Info implements cmd.Command.
+func (c *triggerEventCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:    "trigger-event",
+		Args:    "<unit|application> <event-name> [key=value ...]",
+		Purpose: triggerEventHelpSummary,
+		Doc:     triggerEventHelpDetails,
+	})
+}
+
+// Init implements cmd.Command.
+func (c *triggerEventCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no unit or application specified")
+	}
+	switch receiver := args[0]; {
+	case names.IsValidUnit(receiver):
+		c.receiver = names.NewUnitTag(receiver)
+	case names.IsValidApplication(receiver):
+		c.receiver = names.NewApplicationTag(receiver)
+	default:
+		return errors.NotValidf("unit or application name %q", receiver)
+	}
+	if len(args) < 2 {
+		return errors.New("no event name specified")
+	}
+	c.eventName = args[1]
+
+	eventParams, err := keyvalues.Parse(args[2:], false)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(eventParams) > 0 {
+		c.params = make(map[string]interface{}, len(eventParams))
+		for key, value := range eventParams {
+			c.params[key] = value
+		}
+	}
+	return nil
+}
+
+// TriggerEventAPI defines the API methods that the trigger-event
+// command uses.
+type TriggerEventAPI interface {
+	Close() error
+	TriggerCustomEvent(receiver names.Tag, name string, params map[string]interface{}) (string, error)
+}
+
+// Run implements cmd.Command.
+func (c *triggerEventCommand) Run(ctx *cmd.Context) error {
+	client, err := c.newAPIFunc()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	id, err := client.TriggerCustomEvent(c.receiver, c.eventName, c.params)
+	if err != nil {
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	ctx.Infof("Queued event %q for %s with id %s", c.eventName, c.receiver.Id(), id)
+	return nil
+}
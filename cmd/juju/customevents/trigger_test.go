@@ -0,0 +1,92 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package customevents_test
+
+import (
+	"github.com/juju/cmd/v3"
+	"github.com/juju/cmd/v3/cmdtesting"
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/cmd/juju/customevents"
+	"github.com/juju/juju/testing"
+)
+
+type TriggerEventSuite struct {
+	testing.BaseSuite
+
+	mockAPI *mockTriggerEventAPI
+}
+
+var _ = gc.Suite(&TriggerEventSuite{})
+
+func (s *TriggerEventSuite) SetUpTest(c *gc.C) {
+	s.mockAPI = &mockTriggerEventAPI{}
+}
+
+func (s *TriggerEventSuite) TestInitMissingReceiver(c *gc.C) {
+	_, err := s.runTriggerEvent(c)
+	c.Assert(err, gc.ErrorMatches, "no unit or application specified")
+}
+
+func (s *TriggerEventSuite) TestInitInvalidReceiver(c *gc.C) {
+	_, err := s.runTriggerEvent(c, "not/a/receiver", "rotate-certs")
+	c.Assert(err, gc.ErrorMatches, `unit or application name "not/a/receiver" not valid`)
+}
+
+func (s *TriggerEventSuite) TestInitMissingEventName(c *gc.C) {
+	_, err := s.runTriggerEvent(c, "mysql/0")
+	c.Assert(err, gc.ErrorMatches, "no event name specified")
+}
+
+func (s *TriggerEventSuite) TestTriggerEventUnit(c *gc.C) {
+	_, err := s.runTriggerEvent(c, "mysql/0", "rotate-certs")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.mockAPI.receiver, gc.Equals, names.NewUnitTag("mysql/0"))
+	c.Assert(s.mockAPI.name, gc.Equals, "rotate-certs")
+	c.Assert(s.mockAPI.params, gc.IsNil)
+}
+
+func (s *TriggerEventSuite) TestTriggerEventApplicationWithParams(c *gc.C) {
+	_, err := s.runTriggerEvent(c, "mysql", "rotate-certs", "expiry=30d")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.mockAPI.receiver, gc.Equals, names.NewApplicationTag("mysql"))
+	c.Assert(s.mockAPI.name, gc.Equals, "rotate-certs")
+	c.Assert(s.mockAPI.params, jc.DeepEquals, map[string]interface{}{
+		"expiry": "30d",
+	})
+}
+
+func (s *TriggerEventSuite) TestTriggerEventError(c *gc.C) {
+	s.mockAPI.err = errors.New("fail")
+	_, err := s.runTriggerEvent(c, "mysql/0", "rotate-certs")
+	c.Assert(err, gc.ErrorMatches, ".*fail.*")
+}
+
+func (s *TriggerEventSuite) runTriggerEvent(c *gc.C, args ...string) (*cmd.Context, error) {
+	return cmdtesting.RunCommand(c, customevents.NewTriggerEventCommandForTest(s.mockAPI), args...)
+}
+
+type mockTriggerEventAPI struct {
+	receiver names.Tag
+	name     string
+	params   map[string]interface{}
+	err      error
+}
+
+func (m *mockTriggerEventAPI) Close() error {
+	return nil
+}
+
+func (m *mockTriggerEventAPI) TriggerCustomEvent(receiver names.Tag, name string, params map[string]interface{}) (string, error) {
+	if m.err != nil {
+		return "", m.err
+	}
+	m.receiver = receiver
+	m.name = name
+	m.params = params
+	return "1", nil
+}
@@ -26,13 +26,37 @@ func (o firewallRules) Less(i, j int) bool {
 	return o[i].KnownService < o[j].KnownService
 }
 
+type firewallRuleChange struct {
+	WhitelistCIDRS []string `yaml:"whitelist-subnets,omitempty" json:"whitelist-subnets,omitempty"`
+	Disabled       bool     `yaml:"disabled,omitempty" json:"disabled,omitempty"`
+	UpdatedBy      string   `yaml:"updated-by,omitempty" json:"updated-by,omitempty"`
+	Updated        string   `yaml:"updated,omitempty" json:"updated,omitempty"`
+}
+
+type firewallRuleHistory struct {
+	KnownService string               `yaml:"known-service" json:"known-service"`
+	Changes      []firewallRuleChange `yaml:"changes" json:"changes"`
+}
+
+type firewallRuleHistories []firewallRuleHistory
+
+func (o firewallRuleHistories) Len() int      { return len(o) }
+func (o firewallRuleHistories) Swap(i, j int) { o[i], o[j] = o[j], o[i] }
+func (o firewallRuleHistories) Less(i, j int) bool {
+	return o[i].KnownService < o[j].KnownService
+}
+
 func formatListTabular(writer io.Writer, value interface{}) error {
-	rules, ok := value.([]firewallRule)
-	if !ok {
-		return errors.Errorf("expected value of type %T, got %T", rules, value)
+	switch rules := value.(type) {
+	case []firewallRule:
+		formatFirewallRulesTabular(writer, firewallRules(rules))
+		return nil
+	case []firewallRuleHistory:
+		formatFirewallRuleHistoriesTabular(writer, firewallRuleHistories(rules))
+		return nil
+	default:
+		return errors.Errorf("expected value of type %T, got %T", []firewallRule{}, value)
 	}
-	formatFirewallRulesTabular(writer, firewallRules(rules))
-	return nil
 }
 
 // formatFirewallRulesTabular returns a tabular summary of firewall rules.
@@ -48,3 +72,28 @@ func formatFirewallRulesTabular(writer io.Writer, rules firewallRules) {
 	}
 	tw.Flush()
 }
+
+// formatFirewallRuleHistoriesTabular returns a tabular summary of the
+// recorded changes for each firewall rule, oldest first.
+func formatFirewallRuleHistoriesTabular(writer io.Writer, histories firewallRuleHistories) {
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{tw}
+
+	sort.Sort(histories)
+
+	w.Println("Service", "Updated", "Updated by", "Disabled", "Whitelist subnets")
+	for _, history := range histories {
+		for i, change := range history.Changes {
+			service := history.KnownService
+			if i > 0 {
+				service = ""
+			}
+			disabled := ""
+			if change.Disabled {
+				disabled = "true"
+			}
+			w.Println(service, change.Updated, change.UpdatedBy, disabled, strings.Join(change.WhitelistCIDRS, ","))
+		}
+	}
+	tw.Flush()
+}
@@ -21,11 +21,15 @@ var listRulesHelpDetails = `
 Lists the firewall rules which control ingress to well known services
 within a Juju model.
 
+Use --history to show the recorded changes for each rule instead,
+including who made each change and when.
+
 Examples:
     juju list-firewall-rules
     juju firewall-rules
+    juju firewall-rules --history
 
-See also: 
+See also:
     set-firewall-rule`
 
 // NewListFirewallRulesCommand returns a command to list firewall rules.
@@ -45,7 +49,8 @@ func NewListFirewallRulesCommand() cmd.Command {
 type listFirewallRulesCommand struct {
 	modelcmd.ModelCommandBase
 	modelcmd.IAASOnlyCommand
-	out cmd.Output
+	out     cmd.Output
+	history bool
 
 	newAPIFunc func() (ListFirewallRulesAPI, error)
 }
@@ -62,6 +67,7 @@ func (c *listFirewallRulesCommand) Info() *cmd.Info {
 
 // SetFlags implements cmd.Command.
 func (c *listFirewallRulesCommand) SetFlags(f *gnuflag.FlagSet) {
+	f.BoolVar(&c.history, "history", false, "Show the recorded changes for each rule")
 	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
 		"yaml":    cmd.FormatYaml,
 		"json":    cmd.FormatJson,
@@ -78,6 +84,7 @@ func (c *listFirewallRulesCommand) Init(args []string) (err error) {
 type ListFirewallRulesAPI interface {
 	Close() error
 	ListFirewallRules() ([]params.FirewallRule, error)
+	ListFirewallRulesHistory() ([]params.FirewallRuleHistory, error)
 }
 
 // Run implements cmd.Command.
@@ -87,6 +94,9 @@ func (c *listFirewallRulesCommand) Run(ctx *cmd.Context) error {
 		return err
 	}
 	defer client.Close()
+	if c.history {
+		return c.runHistory(ctx, client)
+	}
 	rulesResult, err := client.ListFirewallRules()
 	if err != nil {
 		return err
@@ -101,3 +111,25 @@ func (c *listFirewallRulesCommand) Run(ctx *cmd.Context) error {
 	}
 	return c.out.Write(ctx, rules)
 }
+
+func (c *listFirewallRulesCommand) runHistory(ctx *cmd.Context, client ListFirewallRulesAPI) error {
+	historyResult, err := client.ListFirewallRulesHistory()
+	if err != nil {
+		return err
+	}
+
+	histories := make([]firewallRuleHistory, len(historyResult))
+	for i, h := range historyResult {
+		history := firewallRuleHistory{KnownService: string(h.KnownService)}
+		for _, change := range h.Changes {
+			history.Changes = append(history.Changes, firewallRuleChange{
+				WhitelistCIDRS: change.WhitelistCIDRS,
+				Disabled:       change.Disabled,
+				UpdatedBy:      change.UpdatedBy,
+				Updated:        change.Updated.Format("2006-01-02 15:04:05Z07:00"),
+			})
+		}
+		histories[i] = history
+	}
+	return c.out.Write(ctx, histories)
+}
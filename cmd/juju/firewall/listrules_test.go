@@ -5,6 +5,7 @@ package firewall_test
 
 import (
 	"strings"
+	"time"
 
 	"github.com/juju/cmd/v3"
 	"github.com/juju/cmd/v3/cmdtesting"
@@ -36,6 +37,23 @@ func (s *ListSuite) SetUpTest(c *gc.C) {
 				WhitelistCIDRS: []string{"10.2.0.0/16"},
 			},
 		},
+		history: []params.FirewallRuleHistory{
+			{
+				KnownService: "ssh",
+				Changes: []params.FirewallRuleChange{
+					{
+						WhitelistCIDRS: []string{"192.168.1.0/16"},
+						UpdatedBy:      "fred",
+						Updated:        time.Date(2026, 8, 1, 10, 0, 0, 0, time.UTC),
+					}, {
+						WhitelistCIDRS: []string{"192.168.1.0/16"},
+						Disabled:       true,
+						UpdatedBy:      "mary",
+						Updated:        time.Date(2026, 8, 2, 11, 30, 0, 0, time.UTC),
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -76,6 +94,41 @@ func (s *ListSuite) TestListYAML(c *gc.C) {
 	)
 }
 
+func (s *ListSuite) TestListHistoryTabular(c *gc.C) {
+	s.assertValidList(
+		c,
+		[]string{"--history", "--format", "tabular"},
+		`
+Service  Updated               Updated by  Disabled  Whitelist subnets
+ssh      2026-08-01 10:00:00Z  fred                  192.168.1.0/16
+         2026-08-02 11:30:00Z  mary        true      192.168.1.0/16
+
+`[1:],
+		"",
+	)
+}
+
+func (s *ListSuite) TestListHistoryYAML(c *gc.C) {
+	s.assertValidList(
+		c,
+		[]string{"--history", "--format", "yaml"},
+		`
+- known-service: ssh
+  changes:
+  - whitelist-subnets:
+    - 192.168.1.0/16
+    updated-by: fred
+    updated: 2026-08-01 10:00:00Z
+  - whitelist-subnets:
+    - 192.168.1.0/16
+    disabled: true
+    updated-by: mary
+    updated: 2026-08-02 11:30:00Z
+`[1:],
+		"",
+	)
+}
+
 func (s *ListSuite) runList(c *gc.C, args []string) (*cmd.Context, error) {
 	return cmdtesting.RunCommand(c, firewall.NewListRulesCommandForTest(s.mockAPI), args...)
 }
@@ -92,8 +145,9 @@ func (s *ListSuite) assertValidList(c *gc.C, args []string, expectedValid, expec
 }
 
 type mockListAPI struct {
-	rules []params.FirewallRule
-	err   error
+	rules   []params.FirewallRule
+	history []params.FirewallRuleHistory
+	err     error
 }
 
 func (s *mockListAPI) Close() error {
@@ -106,3 +160,10 @@ func (s *mockListAPI) ListFirewallRules() ([]params.FirewallRule, error) {
 	}
 	return s.rules, nil
 }
+
+func (s *mockListAPI) ListFirewallRulesHistory() ([]params.FirewallRuleHistory, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.history, nil
+}
@@ -46,6 +46,15 @@ func NewShowCommandForTest(api statusAPI) cmd.Command {
 	return modelcmd.Wrap(command)
 }
 
+// NewShowCommandForConsoleTest returns a showMachineCommand with the
+// specified console API.
+func NewShowCommandForConsoleTest(consoleAPI ConsoleLogAPI) cmd.Command {
+	command := newShowMachineCommand(nil)
+	command.consoleAPI = consoleAPI
+	command.SetClientStore(jujuclienttesting.MinimalStore())
+	return modelcmd.Wrap(command)
+}
+
 type RemoveCommand struct {
 	*removeCommand
 }
@@ -0,0 +1,106 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package machine
+
+import (
+	"github.com/juju/cmd/v3"
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/api/machinemanager"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+// NewReenrollCommand returns a command used to issue fresh agent
+// credentials for a manually provisioned machine.
+func NewReenrollCommand() cmd.Command {
+	return modelcmd.Wrap(&reenrollCommand{})
+}
+
+// reenrollCommand issues fresh agent credentials for an existing
+// manually provisioned machine.
+type reenrollCommand struct {
+	baseMachinesCommand
+	machineAPI ReenrollMachineAPI
+	machineID  string
+}
+
+const reenrollMachineDoc = `
+Issues fresh agent credentials for a manually provisioned machine whose
+agent has been wiped, for example after the host was re-imaged. The
+machine entity, its units and its history are kept; re-installing the
+agent with the printed password reattaches it to the existing machine
+rather than requiring remove-machine followed by add-machine ssh:host.
+
+Only machines added with ` + "`juju add-machine ssh:host`" + ` can be
+re-enrolled; provider-managed machines get their credentials through
+the provisioner.
+
+Examples:
+
+    juju reenroll-machine 5
+
+See also:
+    add-machine
+    remove-machine
+`
+
+// Info implements Command.Info.
+func (c *reenrollCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:    "reenroll-machine",
+		Args:    "<machine number>",
+		Purpose: "Issues fresh agent credentials for a manually provisioned machine.",
+		Doc:     reenrollMachineDoc,
+	})
+}
+
+// Init implements Command.Init.
+func (c *reenrollCommand) Init(args []string) error {
+	if len(args) != 1 {
+		return errors.Errorf("expected a single machine id")
+	}
+	if !names.IsValidMachine(args[0]) {
+		return errors.Errorf("invalid machine id %q", args[0])
+	}
+	c.machineID = args[0]
+	return nil
+}
+
+// ReenrollMachineAPI defines the client API methods used by the
+// reenroll-machine command.
+type ReenrollMachineAPI interface {
+	ReenrollMachine(machineName string) (string, error)
+	Close() error
+}
+
+func (c *reenrollCommand) getReenrollMachineAPI() (ReenrollMachineAPI, error) {
+	if c.machineAPI != nil {
+		return c.machineAPI, nil
+	}
+	root, err := c.NewAPIRoot()
+	if err != nil {
+		return nil, err
+	}
+	return machinemanager.NewClient(root), nil
+}
+
+// Run implements Command.Run.
+func (c *reenrollCommand) Run(ctx *cmd.Context) error {
+	client, err := c.getReenrollMachineAPI()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	password, err := client.ReenrollMachine(c.machineID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	ctx.Infof("Issued fresh credentials for machine %s.", c.machineID)
+	ctx.Infof("Re-install the agent on the host with this password to reattach it:")
+	ctx.Infof("  %s", password)
+	return nil
+}
@@ -4,8 +4,15 @@
 package machine
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/juju/cmd/v3"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v4"
 
+	"github.com/juju/juju/api/machinemanager"
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/cmd/modelcmd"
 )
@@ -15,12 +22,24 @@ Show a specified machine on a model.  Default format is in yaml,
 other formats can be specified with the "--format" option.
 Available formats are yaml, tabular, and json
 
+With --console-log, the provider's console output for the machine is
+shown instead of its status, so boot failures can be debugged without
+locating the instance in the cloud console.
+
 Examples:
     juju show-machine 0
     juju show-machine 1 2 3
+    juju show-machine 0 --console-log
 
 `
 
+// ConsoleLogAPI defines the API methods used to fetch the provider
+// console output for machines.
+type ConsoleLogAPI interface {
+	ConsoleOutput(machine names.MachineTag) (string, error)
+	Close() error
+}
+
 // NewShowMachineCommand returns a command that shows details on the specified machine[s].
 func NewShowMachineCommand() cmd.Command {
 	return modelcmd.Wrap(newShowMachineCommand(nil))
@@ -36,6 +55,9 @@ func newShowMachineCommand(api statusAPI) *showMachineCommand {
 // showMachineCommand struct holds details on the specified machine[s].
 type showMachineCommand struct {
 	baselistMachinesCommand
+
+	consoleLog bool
+	consoleAPI ConsoleLogAPI
 }
 
 // Info implements Command.Info.
@@ -48,8 +70,58 @@ func (c *showMachineCommand) Info() *cmd.Info {
 	})
 }
 
+// SetFlags implements Command.SetFlags.
+func (c *showMachineCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.baselistMachinesCommand.SetFlags(f)
+	f.BoolVar(&c.consoleLog, "console-log", false, "Show the provider console output for the machine")
+}
+
 // Init captures machineId's to show from CL args.
 func (c *showMachineCommand) Init(args []string) error {
+	if c.consoleLog && len(args) == 0 {
+		return errors.New("--console-log requires at least one machine ID")
+	}
 	c.machineIds = args
 	return nil
 }
+
+func (c *showMachineCommand) getConsoleLogAPI() (ConsoleLogAPI, error) {
+	if c.consoleAPI != nil {
+		return c.consoleAPI, nil
+	}
+	root, err := c.NewAPIRoot()
+	if err != nil {
+		return nil, err
+	}
+	return machinemanager.NewClient(root), nil
+}
+
+// Run implements Command.Run.
+func (c *showMachineCommand) Run(ctx *cmd.Context) error {
+	if !c.consoleLog {
+		return c.baselistMachinesCommand.Run(ctx)
+	}
+	client, err := c.getConsoleLogAPI()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = client.Close() }()
+
+	for _, id := range c.machineIds {
+		if !names.IsValidMachine(id) {
+			return errors.NotValidf("machine ID %q", id)
+		}
+		output, err := client.ConsoleOutput(names.NewMachineTag(id))
+		if err != nil {
+			return errors.Annotatef(err, "getting console output for machine %s", id)
+		}
+		if len(c.machineIds) > 1 {
+			fmt.Fprintf(ctx.Stdout, "=== machine %s ===\n", id)
+		}
+		fmt.Fprint(ctx.Stdout, output)
+		if output != "" && !strings.HasSuffix(output, "\n") {
+			fmt.Fprintln(ctx.Stdout)
+		}
+	}
+	return nil
+}
@@ -4,8 +4,11 @@
 package machine_test
 
 import (
+	"fmt"
+
 	"github.com/juju/cmd/v3"
 	"github.com/juju/cmd/v3/cmdtesting"
+	"github.com/juju/names/v4"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
@@ -27,6 +30,56 @@ func (s *MachineShowCommandSuite) SetUpTest(c *gc.C) {
 	s.FakeJujuXDGDataHomeSuite.SetUpTest(c)
 }
 
+type fakeConsoleAPI struct {
+	output map[string]string
+}
+
+func (api *fakeConsoleAPI) ConsoleOutput(tag names.MachineTag) (string, error) {
+	output, ok := api.output[tag.Id()]
+	if !ok {
+		return "", fmt.Errorf("machine %s not found", tag.Id())
+	}
+	return output, nil
+}
+
+func (api *fakeConsoleAPI) Close() error {
+	return nil
+}
+
+func (s *MachineShowCommandSuite) TestShowConsoleLog(c *gc.C) {
+	command := machine.NewShowCommandForConsoleTest(&fakeConsoleAPI{
+		output: map[string]string{"0": "booting...\nbooted\n"},
+	})
+	context, err := cmdtesting.RunCommand(c, command, "--console-log", "0")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cmdtesting.Stdout(context), gc.Equals, "booting...\nbooted\n")
+}
+
+func (s *MachineShowCommandSuite) TestShowConsoleLogMultipleMachines(c *gc.C) {
+	command := machine.NewShowCommandForConsoleTest(&fakeConsoleAPI{
+		output: map[string]string{"0": "machine zero log", "1": "machine one log"},
+	})
+	context, err := cmdtesting.RunCommand(c, command, "--console-log", "0", "1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cmdtesting.Stdout(context), gc.Equals, ""+
+		"=== machine 0 ===\n"+
+		"machine zero log\n"+
+		"=== machine 1 ===\n"+
+		"machine one log\n")
+}
+
+func (s *MachineShowCommandSuite) TestShowConsoleLogNoMachineID(c *gc.C) {
+	command := machine.NewShowCommandForConsoleTest(&fakeConsoleAPI{})
+	_, err := cmdtesting.RunCommand(c, command, "--console-log")
+	c.Assert(err, gc.ErrorMatches, "--console-log requires at least one machine ID")
+}
+
+func (s *MachineShowCommandSuite) TestShowConsoleLogError(c *gc.C) {
+	command := machine.NewShowCommandForConsoleTest(&fakeConsoleAPI{})
+	_, err := cmdtesting.RunCommand(c, command, "--console-log", "0")
+	c.Assert(err, gc.ErrorMatches, "getting console output for machine 0: machine 0 not found")
+}
+
 func (s *MachineShowCommandSuite) TestShowMachine(c *gc.C) {
 	context, err := cmdtesting.RunCommand(c, newMachineShowCommand())
 	c.Assert(err, jc.ErrorIsNil)
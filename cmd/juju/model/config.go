@@ -18,6 +18,7 @@ import (
 	"gopkg.in/juju/environschema.v1"
 
 	"github.com/juju/juju/api/modelconfig"
+	"github.com/juju/juju/apiserver/params"
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/cmd/juju/block"
 	"github.com/juju/juju/cmd/juju/common"
@@ -44,6 +45,12 @@ the fields when they're encountered.
 
 The reset flag will set the provided key(s) to the model default for those key(s).
 Any key not in the default model config, will be deleted.
+
+Passing --file replaces the model configuration as a whole: keys in the
+supplied yaml file are set, and model keys absent from the file are reset
+to their defaults. The whole set is validated and applied atomically by
+the controller, so a validation failure leaves the configuration
+untouched. Use --diff to display the resulting changes.
 `
 	modelConfigHelpDocKeys = `
 The following keys are available:
@@ -73,6 +80,10 @@ Set model config values of a specific model:
 Reset the values of the provided keys to model defaults:
     juju model-config --reset default-series,test-mode
 
+Replace the model config with the contents of a file, resetting keys not
+in the file and showing the resulting changes:
+    juju model-config --file path/to/file.yaml --diff
+
 See also:
     models
     model-defaults
@@ -175,6 +186,8 @@ type configCommand struct {
 	resetKeys            []string // Holds the keys to be reset once parsed.
 	setOptions           common.ConfigFlag
 	ignoreReadOnlyFields bool
+	configFile           string
+	showDiff             bool
 }
 
 // configCommandAPI defines an API interface to be used during testing.
@@ -184,6 +197,7 @@ type configCommandAPI interface {
 	ModelGetWithMetadata() (config.ConfigValues, error)
 	ModelSet(config map[string]interface{}) error
 	ModelUnset(keys ...string) error
+	ApplyModelConfig(config map[string]interface{}) (params.ModelConfigDiffResult, error)
 }
 
 // Info implements part of the cmd.Command interface.
@@ -220,6 +234,8 @@ func (c *configCommand) SetFlags(f *gnuflag.FlagSet) {
 	})
 	f.Var(cmd.NewAppendStringsValue(&c.reset), "reset", "Reset the provided comma delimited keys, deletes keys not in the model config")
 	f.BoolVar(&c.ignoreReadOnlyFields, "ignore-read-only-fields", false, "Ignore read only fields that might cause errors to be emitted while processing yaml documents")
+	f.StringVar(&c.configFile, "file", "", "Replace the model config with the contents of the yaml file, resetting keys not in the file")
+	f.BoolVar(&c.showDiff, "diff", false, "Show the changes made when applying a config file")
 }
 
 // Init implements part of the cmd.Command interface.
@@ -232,6 +248,23 @@ func (c *configCommand) Init(args []string) error {
 		return errors.Trace(err)
 	}
 
+	if c.configFile != "" {
+		if len(args) > 0 {
+			return errors.New("cannot use --file and key/value arguments simultaneously")
+		}
+		if len(c.reset) > 0 {
+			return errors.New("cannot use --file and --reset simultaneously")
+		}
+		if err := c.setOptions.Set(c.configFile); err != nil {
+			return errors.Trace(err)
+		}
+		c.action = c.applyConfig
+		return nil
+	}
+	if c.showDiff {
+		return errors.New("--diff requires --file")
+	}
+
 	switch len(args) {
 	case 0:
 		return c.handleZeroArgs()
@@ -442,6 +475,72 @@ func (c *configCommand) setConfig(client configCommandAPI, ctx *cmd.Context) err
 	return block.ProcessBlockedError(client.ModelSet(coerced), block.BlockChange)
 }
 
+// applyConfig replaces the model's settings with those read from the
+// supplied file. The controller validates and applies the whole set in
+// a single transaction, resetting any model keys absent from the file,
+// and returns a diff of the resulting changes.
+func (c *configCommand) applyConfig(client configCommandAPI, ctx *cmd.Context) error {
+	attrs, err := c.setOptions.ReadAttrs(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var keys []string
+	values := make(configAttrs)
+	for k, v := range attrs {
+		if k == config.AgentVersionKey {
+			if c.ignoreReadOnlyFields {
+				continue
+			}
+			return errors.Errorf(`"agent-version" must be set via "upgrade-model"`)
+		} else if k == config.CharmHubURLKey {
+			if c.ignoreReadOnlyFields {
+				continue
+			}
+			return errors.Errorf(`"charmhub-url" must be set via "add-model"`)
+		}
+
+		values[k] = v
+		keys = append(keys, k)
+	}
+
+	coerced, err := values.CoerceFormat()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	if err := c.verifyKnownKeys(client, keys); err != nil {
+		return errors.Trace(err)
+	}
+
+	result, err := client.ApplyModelConfig(coerced)
+	if err != nil {
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	if !c.showDiff {
+		return nil
+	}
+
+	diff := make(map[string]interface{})
+	if len(result.Added) > 0 {
+		diff["added"] = result.Added
+	}
+	if len(result.Changed) > 0 {
+		changed := make(map[string]interface{})
+		for k, delta := range result.Changed {
+			changed[k] = map[string]interface{}{"old": delta.Old, "new": delta.New}
+		}
+		diff["changed"] = changed
+	}
+	if len(result.Reset) > 0 {
+		diff["reset"] = result.Reset
+	}
+	if len(diff) == 0 {
+		ctx.Infof("no changes to apply")
+		return nil
+	}
+	return cmd.FormatYaml(ctx.Stdout, diff)
+}
+
 // get writes the value of a single key or the full output for the model to the cmd.Context.
 func (c *configCommand) getConfig(client configCommandAPI, ctx *cmd.Context) error {
 	if len(c.keys) == 1 && certBytes != nil {
@@ -51,6 +51,19 @@ func (s *ConfigCommandSuite) TestInit(c *gc.C) {
 			desc:       "reset cannot have k=v pairs",
 			args:       []string{"--reset", "a,b,c=d,e"},
 			errorMatch: `--reset accepts a comma delimited set of keys "a,b,c", received: "c=d"`,
+		}, {
+			// Test file
+			desc:       "file cannot be combined with key/value args",
+			args:       []string{"--file", "path/to/file.yaml", "special=extra"},
+			errorMatch: "cannot use --file and key/value arguments simultaneously",
+		}, {
+			desc:       "file cannot be combined with reset",
+			args:       []string{"--file", "path/to/file.yaml", "--reset", "special"},
+			errorMatch: "cannot use --file and --reset simultaneously",
+		}, {
+			desc:       "diff requires file",
+			args:       []string{"--diff"},
+			errorMatch: "--diff requires --file",
 		}, {
 			// Test get
 			desc:   "get all succeeds",
@@ -277,6 +290,64 @@ func (s *ConfigCommandSuite) TestSetFromFileCombined(c *gc.C) {
 	c.Assert(s.fake.values, jc.DeepEquals, expected)
 }
 
+func (s *ConfigCommandSuite) TestApplyFromFile(c *gc.C) {
+	tmpdir := c.MkDir()
+	configFile := filepath.Join(tmpdir, "config.yaml")
+	err := ioutil.WriteFile(configFile, []byte("special: extra\nnew-key: foo\n"), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	context, err := s.run(c, "--file", configFile)
+	c.Assert(err, jc.ErrorIsNil)
+	expected := map[string]interface{}{
+		"special": "extra",
+		"new-key": "foo",
+	}
+	c.Assert(s.fake.values, jc.DeepEquals, expected)
+	// Without --diff nothing is written to stdout.
+	c.Assert(cmdtesting.Stdout(context), gc.Equals, "")
+}
+
+func (s *ConfigCommandSuite) TestApplyFromFileDiff(c *gc.C) {
+	tmpdir := c.MkDir()
+	configFile := filepath.Join(tmpdir, "config.yaml")
+	err := ioutil.WriteFile(configFile, []byte("special: extra\nnew-key: foo\n"), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	context, err := s.run(c, "--file", configFile, "--diff")
+	c.Assert(err, jc.ErrorIsNil)
+	expected := "" +
+		"added:\n" +
+		"  new-key: foo\n" +
+		"changed:\n" +
+		"  special:\n" +
+		"    new: extra\n" +
+		"    old: special value\n" +
+		"reset:\n" +
+		"- running\n"
+	c.Assert(cmdtesting.Stdout(context), gc.Equals, expected)
+}
+
+func (s *ConfigCommandSuite) TestApplyFromFileAgentVersion(c *gc.C) {
+	tmpdir := c.MkDir()
+	configFile := filepath.Join(tmpdir, "config.yaml")
+	err := ioutil.WriteFile(configFile, []byte("agent-version: 2.0.0\n"), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.run(c, "--file", configFile)
+	c.Assert(err, gc.ErrorMatches, `"agent-version" must be set via "upgrade-model"`)
+}
+
+func (s *ConfigCommandSuite) TestApplyFromFileBlockedError(c *gc.C) {
+	s.fake.err = apiservererrors.OperationBlockedError("TestApplyFromFileBlockedError")
+	tmpdir := c.MkDir()
+	configFile := filepath.Join(tmpdir, "config.yaml")
+	err := ioutil.WriteFile(configFile, []byte("special: extra\n"), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.run(c, "--file", configFile)
+	testing.AssertOperationWasBlocked(c, err, ".*TestApplyFromFileBlockedError.*")
+}
+
 func (s *ConfigCommandSuite) TestPassesValues(c *gc.C) {
 	_, err := s.run(c, "special=extra", "unknown=foo")
 	c.Assert(err, jc.ErrorIsNil)
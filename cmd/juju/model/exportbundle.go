@@ -36,6 +36,7 @@ type exportBundleCommand struct {
 	newAPIFunc           func() (ExportBundleAPI, ConfigAPI, error)
 	Filename             string
 	includeCharmDefaults bool
+	excludeMachines      bool
 }
 
 const exportBundleHelpDoc = `
@@ -49,6 +50,7 @@ Examples:
     juju export-bundle
     juju export-bundle --filename mymodel.yaml
     juju export-bundle --include-charm-defaults
+    juju export-bundle --exclude-machines
 
 `
 
@@ -66,6 +68,7 @@ func (c *exportBundleCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.ModelCommandBase.SetFlags(f)
 	f.StringVar(&c.Filename, "filename", "", "Bundle file")
 	f.BoolVar(&c.includeCharmDefaults, "include-charm-defaults", false, "Whether to include charm config default values in the exported bundle")
+	f.BoolVar(&c.excludeMachines, "exclude-machines", false, "Do not include machines or placement directives in the exported bundle")
 }
 
 // Init implements Command.
@@ -77,7 +80,7 @@ func (c *exportBundleCommand) Init(args []string) error {
 type ExportBundleAPI interface {
 	BestAPIVersion() int
 	Close() error
-	ExportBundle(bool) (string, error)
+	ExportBundle(includeDefaults, excludeMachines bool) (string, error)
 }
 
 // ConfigAPI specifies the used function calls of the ApplicationFacade.
@@ -106,7 +109,7 @@ func (c *exportBundleCommand) Run(ctx *cmd.Context) error {
 		_ = cfgClient.Close()
 	}()
 
-	result, err := bundleClient.ExportBundle(c.includeCharmDefaults)
+	result, err := bundleClient.ExportBundle(c.includeCharmDefaults, c.excludeMachines)
 	if err != nil {
 		return err
 	}
@@ -323,7 +323,7 @@ func (f *fakeExportBundleClient) BestAPIVersion() int { return f.bestAPIVersion
 
 func (f *fakeExportBundleClient) Close() error { return nil }
 
-func (f *fakeExportBundleClient) ExportBundle(includeDefaults bool) (string, error) {
+func (f *fakeExportBundleClient) ExportBundle(includeDefaults, excludeMachines bool) (string, error) {
 	f.MethodCall(f, "ExportBundle", includeDefaults)
 	if err := f.NextErr(); err != nil {
 		return "", err
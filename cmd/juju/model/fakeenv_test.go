@@ -4,11 +4,14 @@
 package model_test
 
 import (
+	"sort"
+
 	"github.com/juju/errors"
 	"github.com/juju/names/v4"
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/api"
+	"github.com/juju/juju/apiserver/params"
 	jujucloud "github.com/juju/juju/cloud"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/testing"
@@ -72,6 +75,39 @@ func (f *fakeEnvAPI) ModelUnset(keys ...string) error {
 	return f.err
 }
 
+func (f *fakeEnvAPI) ApplyModelConfig(attrs map[string]interface{}) (params.ModelConfigDiffResult, error) {
+	if f.err != nil {
+		return params.ModelConfigDiffResult{}, f.err
+	}
+	var result params.ModelConfigDiffResult
+	for k, v := range attrs {
+		old, found := f.values[k]
+		switch {
+		case !found:
+			if result.Added == nil {
+				result.Added = make(map[string]interface{})
+			}
+			result.Added[k] = v
+		case old != v:
+			if result.Changed == nil {
+				result.Changed = make(map[string]params.ModelConfigDelta)
+			}
+			result.Changed[k] = params.ModelConfigDelta{Old: old, New: v}
+		}
+	}
+	for k := range f.values {
+		if k == "name" {
+			continue
+		}
+		if _, found := attrs[k]; !found {
+			result.Reset = append(result.Reset, k)
+		}
+	}
+	sort.Strings(result.Reset)
+	f.values = attrs
+	return result, nil
+}
+
 // ModelDefaults related fake environment for testing.
 
 type fakeModelDefaultEnvSuite struct {
@@ -77,9 +77,24 @@ type machineStatus struct {
 	Containers         map[string]machineStatus      `json:"containers,omitempty" yaml:"containers,omitempty"`
 	Constraints        string                        `json:"constraints,omitempty" yaml:"constraints,omitempty"`
 	Hardware           string                        `json:"hardware,omitempty" yaml:"hardware,omitempty"`
+	KernelVersion      string                        `json:"kernel-version,omitempty" yaml:"kernel-version,omitempty"`
+	OSPatchLevel       string                        `json:"os-patch-level,omitempty" yaml:"os-patch-level,omitempty"`
+	RebootRequired     bool                          `json:"reboot-required,omitempty" yaml:"reboot-required,omitempty"`
 	HAStatus           string                        `json:"controller-member-status,omitempty" yaml:"controller-member-status,omitempty"`
 	HAPrimary          bool                          `json:"ha-primary,omitempty" yaml:"ha-primary,omitempty"`
 	LXDProfiles        map[string]lxdProfileContents `json:"lxd-profiles,omitempty" yaml:"lxd-profiles,omitempty"`
+	MaintenanceWindows []maintenanceWindow           `json:"maintenance-windows,omitempty" yaml:"maintenance-windows,omitempty"`
+	DisabledWorkers    []string                      `json:"disabled-workers,omitempty" yaml:"disabled-workers,omitempty"`
+}
+
+// maintenanceWindow describes a scheduled maintenance window that
+// applies to a machine, during which non-urgent automatic operations
+// are deferred.
+type maintenanceWindow struct {
+	Start    string `json:"start" yaml:"start"`
+	Duration string `json:"duration" yaml:"duration"`
+	Repeat   string `json:"repeat,omitempty" yaml:"repeat,omitempty"`
+	Comment  string `json:"comment,omitempty" yaml:"comment,omitempty"`
 }
 
 // A goyaml bug means we can't declare these types
@@ -190,6 +190,9 @@ func (sf *statusFormatter) formatMachine(machine params.MachineStatus) machineSt
 		Containers:         make(map[string]machineStatus),
 		Constraints:        machine.Constraints,
 		Hardware:           machine.Hardware,
+		KernelVersion:      machine.KernelVersion,
+		OSPatchLevel:       machine.OSPatchLevel,
+		RebootRequired:     machine.RebootRequired,
 		LXDProfiles:        make(map[string]lxdProfileContents),
 	}
 
@@ -204,6 +207,16 @@ func (sf *statusFormatter) formatMachine(machine params.MachineStatus) machineSt
 		}
 	}
 
+	for _, w := range machine.MaintenanceWindows {
+		out.MaintenanceWindows = append(out.MaintenanceWindows, maintenanceWindow{
+			Start:    w.Start.Format(time.RFC3339),
+			Duration: w.Duration.String(),
+			Repeat:   w.Repeat,
+			Comment:  w.Comment,
+		})
+	}
+	out.DisabledWorkers = machine.DisabledWorkers
+
 	for k, m := range machine.Containers {
 		out.Containers[k] = sf.formatMachine(m)
 	}
@@ -4,6 +4,7 @@
 package status
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -31,6 +32,7 @@ var logger = loggo.GetLogger("juju.cmd.juju.status")
 
 type statusAPI interface {
 	Status(patterns []string) (*params.FullStatus, error)
+	StatusFiltered(patterns, include []string) (*params.FullStatus, error)
 	Close() error
 }
 
@@ -70,6 +72,11 @@ type statusCommand struct {
 	// storage indicates if 'storage' section is displayed
 	storage bool
 
+	// only holds the comma-separated status sections requested with
+	// --only; the parsed section names are kept in include.
+	only    string
+	include []string
+
 	// watch indicates the time to wait between consecutive status queries
 	watch time.Duration
 }
@@ -135,6 +142,9 @@ Examples:
     # Include information about storage and relations in output
     juju status --storage --relations
 
+    # Only compute and report the applications section
+    juju status --only applications --format=json
+
     # Provide output as valid JSON
     juju status --format=json
 
@@ -142,6 +152,10 @@ Examples:
 	# Only available for unix-based systems.
     juju status --watch 5s mysql
 
+    # Stream incremental model changes as newline-delimited JSON
+	# Only available for unix-based systems.
+    juju status --watch 1s --format=json
+
 See also:
 
     machines
@@ -168,13 +182,14 @@ func (c *statusCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.BoolVar(&c.color, "color", false, "Use ANSI color codes in tabular output")
 	f.BoolVar(&c.relations, "relations", false, "Show 'relations' section in tabular output")
 	f.BoolVar(&c.storage, "storage", false, "Show 'storage' section in tabular output")
+	f.StringVar(&c.only, "only", "", "Comma-separated status sections to compute and report (machines,applications,remote-applications,offers,relations,branches)")
 
 	f.IntVar(&c.retryCount, "retry-count", 3, "Number of times to retry API failures")
 	f.DurationVar(&c.retryDelay, "retry-delay", 100*time.Millisecond, "Time to wait between retry attempts")
 
 	if runtime.GOOS != "windows" {
 		// The watch flag is only available for unix-based systems.
-		f.DurationVar(&c.watch, "watch", 0, "Watch the status every period of time")
+		f.DurationVar(&c.watch, "watch", 0, "Watch the status every period of time; with --format json, stream model deltas as NDJSON instead")
 	}
 
 	c.checkProvidedIgnoredFlagF = func() set.Strings {
@@ -221,6 +236,16 @@ func (c *statusCommand) Init(args []string) error {
 	if c.clock == nil {
 		c.clock = clock.WallClock
 	}
+	c.include = nil
+	if c.only != "" {
+		for _, section := range strings.Split(c.only, ",") {
+			section = strings.TrimSpace(section)
+			if section == "" {
+				return errors.Errorf("empty status section in --only %q", c.only)
+			}
+			c.include = append(c.include, section)
+		}
+	}
 	return nil
 }
 
@@ -263,6 +288,9 @@ func (c *statusCommand) getStatus() (*params.FullStatus, error) {
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	if len(c.include) > 0 {
+		return apiclient.StatusFiltered(c.patterns, c.include)
+	}
 	return apiclient.Status(c.patterns)
 }
 
@@ -394,9 +422,45 @@ func clearScreen() {
 	fmt.Printf("\u001Bc")
 }
 
+// watchDeltas streams incremental model changes from the AllWatcher to
+// stdout as newline-delimited JSON, one delta per line. It blocks until
+// the watcher is stopped or fails, typically when the user interrupts
+// the command or the connection drops.
+func (c *statusCommand) watchDeltas(ctx *cmd.Context) error {
+	apiclient, err := c.NewAPIClient()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer apiclient.Close()
+	watcher, err := apiclient.WatchAll()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer watcher.Stop()
+
+	encoder := json.NewEncoder(ctx.Stdout)
+	for {
+		deltas, err := watcher.Next()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		for _, delta := range deltas {
+			if err := encoder.Encode(delta); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	}
+}
+
 func (c *statusCommand) Run(ctx *cmd.Context) error {
 	defer c.close()
 
+	if c.watch != 0 && c.out.Name() == "json" {
+		// The JSON format watches by streaming deltas rather than
+		// re-rendering the whole status every period.
+		return c.watchDeltas(ctx)
+	}
+
 	if c.watch != 0 {
 		clearScreen()
 	}
@@ -4901,6 +4901,7 @@ func (s *StatusSuite) setupMigrationTest(c *gc.C) *state.State {
 type fakeAPIClient struct {
 	statusReturn *params.FullStatus
 	patternsUsed []string
+	includeUsed  []string
 	closeCalled  bool
 }
 
@@ -4909,6 +4910,11 @@ func (a *fakeAPIClient) Status(patterns []string) (*params.FullStatus, error) {
 	return a.statusReturn, nil
 }
 
+func (a *fakeAPIClient) StatusFiltered(patterns, include []string) (*params.FullStatus, error) {
+	a.includeUsed = include
+	return a.Status(patterns)
+}
+
 func (a *fakeAPIClient) Close() error {
 	a.closeCalled = true
 	return nil
@@ -178,6 +178,10 @@ func (f *fakeStatusAPI) Status(_ []string) (*params.FullStatus, error) {
 	return f.result, nil
 }
 
+func (f *fakeStatusAPI) StatusFiltered(patterns, _ []string) (*params.FullStatus, error) {
+	return f.Status(patterns)
+}
+
 func (*fakeStatusAPI) Close() error {
 	return nil
 }
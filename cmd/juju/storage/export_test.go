@@ -91,3 +91,10 @@ func NewDetachStorageCommandForTest(new NewEntityDetacherCloserFunc, store jujuc
 	cmd.newEntityDetacherCloser = new
 	return modelcmd.Wrap(cmd)
 }
+
+func NewMoveStorageCommandForTest(new NewEntityMoverCloserFunc, store jujuclient.ClientStore) cmd.Command {
+	cmd := &moveStorageCommand{}
+	cmd.SetClientStore(store)
+	cmd.newEntityMoverCloser = new
+	return modelcmd.Wrap(cmd)
+}
@@ -0,0 +1,129 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"github.com/juju/cmd/v3"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/apiserver/params"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+// NewMoveStorageCommandWithAPI returns a command
+// used to move storage to units on another machine.
+func NewMoveStorageCommandWithAPI() cmd.Command {
+	cmd := &moveStorageCommand{}
+	cmd.newEntityMoverCloser = func() (EntityMoverCloser, error) {
+		return cmd.NewStorageAPI()
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+// NewMoveStorageCommand returns a command used to
+// move storage to units on another machine.
+func NewMoveStorageCommand(new NewEntityMoverCloserFunc) cmd.Command {
+	cmd := &moveStorageCommand{}
+	cmd.newEntityMoverCloser = new
+	return modelcmd.Wrap(cmd)
+}
+
+const (
+	moveStorageCommandDoc = `
+Move existing storage to a unit of the same application on another
+machine. Specify one or more storage IDs and the destination machine.
+
+The storage is detached from its current unit and, once the machine
+agent has released it, attached to the application's unit on the
+destination machine. Only storage whose provider supports detaching
+can be moved; the move fails for any other storage.
+
+Examples:
+    juju move-storage pgdata/0 3
+
+See also:
+    attach-storage
+    detach-storage
+`
+
+	moveStorageCommandArgs = `<storage> [<storage> ...] <machine>`
+)
+
+// moveStorageCommand moves unit storage instances to another machine.
+type moveStorageCommand struct {
+	StorageCommandBase
+	modelcmd.IAASOnlyCommand
+	newEntityMoverCloser NewEntityMoverCloserFunc
+	storageIds           []string
+	machineId            string
+}
+
+// Init implements Command.Init.
+func (c *moveStorageCommand) Init(args []string) error {
+	if len(args) < 2 {
+		return errors.New("move-storage requires at least one storage ID and a machine ID")
+	}
+	c.storageIds = args[:len(args)-1]
+	c.machineId = args[len(args)-1]
+	return nil
+}
+
+// Info implements Command.Info.
+func (c *moveStorageCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:    "move-storage",
+		Purpose: "Moves existing storage to another machine.",
+		Doc:     moveStorageCommandDoc,
+		Args:    moveStorageCommandArgs,
+	})
+}
+
+// Run implements Command.Run.
+func (c *moveStorageCommand) Run(ctx *cmd.Context) error {
+	mover, err := c.newEntityMoverCloser()
+	if err != nil {
+		return err
+	}
+	defer mover.Close()
+
+	results, err := mover.Move(c.storageIds, c.machineId)
+	if err != nil {
+		if params.IsCodeUnauthorized(err) {
+			common.PermissionsMessage(ctx.Stderr, "move storage")
+		}
+		return block.ProcessBlockedError(errors.Annotatef(err, "could not move storage %v", c.storageIds), block.BlockChange)
+	}
+	var anyFailed bool
+	for i, result := range results {
+		if result.Error != nil {
+			ctx.Infof("failed to move %s to machine %s: %s", c.storageIds[i], c.machineId, result.Error)
+			anyFailed = true
+			continue
+		}
+		ctx.Infof("moving %s to machine %s", c.storageIds[i], c.machineId)
+	}
+	if anyFailed {
+		return cmd.ErrSilent
+	}
+	return nil
+}
+
+// NewEntityMoverCloserFunc is the type of a function that returns an
+// EntityMoverCloser.
+type NewEntityMoverCloserFunc func() (EntityMoverCloser, error)
+
+// EntityMoverCloser extends EntityMover with a Closer method.
+type EntityMoverCloser interface {
+	EntityMover
+	Close() error
+}
+
+// EntityMover defines an interface for moving storage with the
+// specified IDs to a machine.
+type EntityMover interface {
+	Move([]string, string) ([]params.ErrorResult, error)
+}
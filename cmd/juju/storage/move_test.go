@@ -0,0 +1,107 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"regexp"
+
+	"github.com/juju/cmd/v3"
+	"github.com/juju/cmd/v3/cmdtesting"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/storage"
+	"github.com/juju/juju/jujuclient/jujuclienttesting"
+)
+
+type MoveStorageSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&MoveStorageSuite{})
+
+func (s *MoveStorageSuite) TestMove(c *gc.C) {
+	fake := fakeEntityMover{results: []params.ErrorResult{
+		{},
+		{},
+	}}
+	cmd := storage.NewMoveStorageCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	ctx, err := cmdtesting.RunCommand(c, cmd, "bar/1", "baz/2", "3")
+	c.Assert(err, jc.ErrorIsNil)
+	fake.CheckCallNames(c, "NewEntityMoverCloser", "Move", "Close")
+	fake.CheckCall(c, 1, "Move", []string{"bar/1", "baz/2"}, "3")
+	c.Assert(cmdtesting.Stderr(ctx), gc.Equals, `
+moving bar/1 to machine 3
+moving baz/2 to machine 3
+`[1:])
+}
+
+func (s *MoveStorageSuite) TestMoveError(c *gc.C) {
+	fake := fakeEntityMover{results: []params.ErrorResult{
+		{Error: &params.Error{Message: "foo"}},
+		{Error: &params.Error{Message: "bar"}},
+	}}
+	moveCmd := storage.NewMoveStorageCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	ctx, err := cmdtesting.RunCommand(c, moveCmd, "qux/1", "quux/2", "3")
+	stderr := cmdtesting.Stderr(ctx)
+	c.Assert(stderr, gc.Equals, `failed to move qux/1 to machine 3: foo
+failed to move quux/2 to machine 3: bar
+`)
+	c.Assert(err, gc.Equals, cmd.ErrSilent)
+}
+
+func (s *MoveStorageSuite) TestMoveUnauthorizedError(c *gc.C) {
+	var fake fakeEntityMover
+	fake.SetErrors(nil, &params.Error{Code: params.CodeUnauthorized, Message: "nope"})
+	cmd := storage.NewMoveStorageCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	ctx, err := cmdtesting.RunCommand(c, cmd, "bar/1", "3")
+	c.Assert(err, gc.ErrorMatches, regexp.QuoteMeta("could not move storage [bar/1]: nope"))
+	c.Assert(cmdtesting.Stderr(ctx), gc.Equals, `
+You do not have permission to move storage.
+You may ask an administrator to grant you access with "juju grant".
+
+`)
+}
+
+func (s *MoveStorageSuite) TestMoveBlocked(c *gc.C) {
+	var fake fakeEntityMover
+	fake.SetErrors(nil, &params.Error{Code: params.CodeOperationBlocked, Message: "nope"})
+	cmd := storage.NewMoveStorageCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	_, err := cmdtesting.RunCommand(c, cmd, "bar/1", "3")
+	c.Assert(err.Error(), jc.Contains, `could not move storage [bar/1]: nope`)
+	c.Assert(err.Error(), jc.Contains, `All operations that change model have been disabled for the current model.`)
+}
+
+func (s *MoveStorageSuite) TestMoveInitErrors(c *gc.C) {
+	s.testMoveInitError(c, []string{}, "move-storage requires at least one storage ID and a machine ID")
+	s.testMoveInitError(c, []string{"bar/1"}, "move-storage requires at least one storage ID and a machine ID")
+}
+
+func (s *MoveStorageSuite) testMoveInitError(c *gc.C, args []string, expect string) {
+	cmd := storage.NewMoveStorageCommandForTest(nil, jujuclienttesting.MinimalStore())
+	_, err := cmdtesting.RunCommand(c, cmd, args...)
+	c.Assert(err, gc.ErrorMatches, expect)
+}
+
+type fakeEntityMover struct {
+	testing.Stub
+	results []params.ErrorResult
+}
+
+func (f *fakeEntityMover) new() (storage.EntityMoverCloser, error) {
+	f.MethodCall(f, "NewEntityMoverCloser")
+	return f, f.NextErr()
+}
+
+func (f *fakeEntityMover) Close() error {
+	f.MethodCall(f, "Close")
+	return f.NextErr()
+}
+
+func (f *fakeEntityMover) Move(storageIds []string, machineId string) ([]params.ErrorResult, error) {
+	f.MethodCall(f, "Move", storageIds, machineId)
+	return f.results, f.NextErr()
+}
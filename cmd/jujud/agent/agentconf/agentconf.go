@@ -4,6 +4,7 @@
 package agentconf
 
 import (
+	"bytes"
 	"sync"
 
 	"github.com/juju/cmd/v3"
@@ -19,6 +20,8 @@ import (
 	"github.com/juju/juju/state/mgo"
 )
 
+var logger = loggo.GetLogger("juju.cmd.jujud.agent.agentconf")
+
 // AgentConf is a terribly confused interface.
 //
 // Parts of it are a mixin for cmd.Command implementations; others are a mixin
@@ -96,12 +99,35 @@ func (c *agentConf) ReadConfig(tag string) error {
 }
 
 // ChangeConfig modifies this configuration using the given mutator.
+// If the mutator left the rendered configuration unchanged nothing is
+// written; address updaters firing with the same addresses on
+// thousands of agents would otherwise all rewrite identical files.
+// Otherwise only the changed keys are persisted, as a versioned delta
+// against the config file, with a full write as the fallback whenever
+// a delta cannot be recorded safely.
 func (c *agentConf) ChangeConfig(change agent.ConfigMutator) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
+	before, beforeErr := c._config.Render()
 	if err := change(c._config); err != nil {
 		return errors.Trace(err)
 	}
+	if beforeErr == nil {
+		// The rendered config includes the format version, so a
+		// format upgrade always results in a write. If either render
+		// fails we fall back to a full write.
+		after, err := c._config.Render()
+		if err == nil {
+			if bytes.Equal(before, after) {
+				logger.Debugf("agent configuration unchanged, not writing %q", agent.AgentConfigFilename)
+				return nil
+			}
+			if err := c._config.WriteUpdate(before); err != nil {
+				return errors.Annotate(err, "cannot write agent configuration")
+			}
+			return nil
+		}
+	}
 	if err := c._config.Write(); err != nil {
 		return errors.Annotate(err, "cannot write agent configuration")
 	}
@@ -20,12 +20,39 @@ type agentConfSuite struct {
 }
 
 func (s *agentConfSuite) TestChangeConfigSuccess(c *gc.C) {
+	mcsw := &mockConfigSetterWriter{}
+	conf := agentconf.NewAgentConfForTest(c.MkDir(), mcsw)
+	err := conf.ChangeConfig(func(setter agent.ConfigSetter) error {
+		setter.(*mockConfigSetterWriter).RenderOutput = []byte("changed")
+		return nil
+	})
+
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(mcsw.WriteUpdateCalled, jc.IsTrue)
+	c.Assert(mcsw.WriteCalled, jc.IsFalse)
+}
+
+func (s *agentConfSuite) TestChangeConfigUnchangedSkipsWrite(c *gc.C) {
 	mcsw := &mockConfigSetterWriter{}
 	conf := agentconf.NewAgentConfForTest(c.MkDir(), mcsw)
 	err := conf.ChangeConfig(func(agent.ConfigSetter) error {
 		return nil
 	})
 
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(mcsw.WriteCalled, jc.IsFalse)
+	c.Assert(mcsw.WriteUpdateCalled, jc.IsFalse)
+}
+
+func (s *agentConfSuite) TestChangeConfigRenderFailureStillWrites(c *gc.C) {
+	mcsw := &mockConfigSetterWriter{
+		RenderError: errors.New("splat"),
+	}
+	conf := agentconf.NewAgentConfForTest(c.MkDir(), mcsw)
+	err := conf.ChangeConfig(func(agent.ConfigSetter) error {
+		return nil
+	})
+
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(mcsw.WriteCalled, jc.IsTrue)
 }
@@ -47,21 +74,34 @@ func (s *agentConfSuite) TestChangeConfigWriteFailure(c *gc.C) {
 		WriteError: errors.New("boom"),
 	}
 	conf := agentconf.NewAgentConfForTest(c.MkDir(), mcsw)
-	err := conf.ChangeConfig(func(agent.ConfigSetter) error {
+	err := conf.ChangeConfig(func(setter agent.ConfigSetter) error {
+		setter.(*mockConfigSetterWriter).RenderOutput = []byte("changed")
 		return nil
 	})
 
 	c.Assert(err, gc.ErrorMatches, "cannot write agent configuration: boom")
-	c.Assert(mcsw.WriteCalled, jc.IsTrue)
+	c.Assert(mcsw.WriteUpdateCalled, jc.IsTrue)
 }
 
 type mockConfigSetterWriter struct {
 	agent.ConfigSetterWriter
-	WriteError  error
-	WriteCalled bool
+	RenderOutput      []byte
+	RenderError       error
+	WriteError        error
+	WriteCalled       bool
+	WriteUpdateCalled bool
+}
+
+func (c *mockConfigSetterWriter) Render() ([]byte, error) {
+	return c.RenderOutput, c.RenderError
 }
 
 func (c *mockConfigSetterWriter) Write() error {
 	c.WriteCalled = true
 	return c.WriteError
 }
+
+func (c *mockConfigSetterWriter) WriteUpdate(previous []byte) error {
+	c.WriteUpdateCalled = true
+	return c.WriteError
+}
@@ -31,7 +31,6 @@ var (
 		"valid-credential-flag",
 	}
 	requireValidCredentialModelWorkers = []string{
-		"action-pruner",          // tertiary dependency: will be inactive because migration workers will be inactive
 		"application-scaler",     // tertiary dependency: will be inactive because migration workers will be inactive
 		"charm-downloader",       // tertiary dependency: will be inactive because migration workers will be inactive
 		"charm-revision-updater", // tertiary dependency: will be inactive because migration workers will be inactive
@@ -47,15 +46,14 @@ var (
 		"migration-inactive-flag", // secondary dependency: will be inactive because depends on model-upgrader
 		"migration-master",        // secondary dependency: will be inactive because depends on model-upgrader
 		"model-upgrader",
-		"remote-relations",      // tertiary dependency: will be inactive because migration workers will be inactive
-		"state-cleaner",         // tertiary dependency: will be inactive because migration workers will be inactive
-		"status-history-pruner", // tertiary dependency: will be inactive because migration workers will be inactive
-		"storage-provisioner",   // tertiary dependency: will be inactive because migration workers will be inactive
+		"pruner",              // tertiary dependency: will be inactive because migration workers will be inactive
+		"remote-relations",    // tertiary dependency: will be inactive because migration workers will be inactive
+		"state-cleaner",       // tertiary dependency: will be inactive because migration workers will be inactive
+		"storage-provisioner", // tertiary dependency: will be inactive because migration workers will be inactive
 		"undertaker",
 		"unit-assigner", // tertiary dependency: will be inactive because migration workers will be inactive
 	}
 	aliveModelWorkers = []string{
-		"action-pruner",
 		"application-scaler",
 		"charm-downloader",
 		"charm-revision-updater",
@@ -71,9 +69,9 @@ var (
 		"migration-fortress",
 		"migration-inactive-flag",
 		"migration-master",
+		"pruner",
 		"remote-relations",
 		"state-cleaner",
-		"status-history-pruner",
 		"storage-provisioner",
 		"unit-assigner",
 	}
@@ -111,11 +109,14 @@ var (
 		"upgrade-steps-gate",
 		"upgrader",
 		"valid-credential-flag",
+		"worker-control",
 	}
 	notMigratingMachineWorkers = []string{
+		"agent-health-reporter",
 		"api-address-updater",
 		"deployer",
 		"disk-manager",
+		"dns-configurer",
 		"fan-configurer",
 		// "host-key-reporter", not stable, exits when done
 		"log-sender",
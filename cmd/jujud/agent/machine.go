@@ -343,6 +343,13 @@ func (a *MachineAgent) registerPrometheusCollectors() error {
 	); err != nil {
 		return errors.Annotate(err, "registering logsender collector")
 	}
+	if v := agentConfig.Value(agent.MgoTxnSlowLogThreshold); v != "" {
+		threshold, err := time.ParseDuration(v)
+		if err != nil {
+			return errors.Annotatef(err, "parsing %s", agent.MgoTxnSlowLogThreshold)
+		}
+		a.mongoTxnCollector.SetSlowTransactionThreshold(threshold)
+	}
 	if err := a.prometheusRegistry.Register(a.mongoTxnCollector); err != nil {
 		return errors.Annotate(err, "registering mgo/txn collector")
 	}
@@ -600,6 +607,7 @@ func (a *MachineAgent) makeEngineCreator(
 			CentralHub:              a.centralHub,
 			LocalHub:                localHub,
 			PubSubReporter:          pubsubReporter,
+			DepEngineReporter:       engine,
 			PresenceRecorder:        presenceRecorder,
 			UpdateLoggerConfig:      updateAgentConfLogging,
 			UpdateControllerAPIPort: updateControllerAPIPort,
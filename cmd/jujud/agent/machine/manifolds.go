@@ -39,6 +39,7 @@ import (
 	jworker "github.com/juju/juju/worker"
 	"github.com/juju/juju/worker/agent"
 	"github.com/juju/juju/worker/agentconfigupdater"
+	"github.com/juju/juju/worker/agenthealthreporter"
 	"github.com/juju/juju/worker/apiaddressupdater"
 	"github.com/juju/juju/worker/apicaller"
 	"github.com/juju/juju/worker/apiconfigwatcher"
@@ -55,6 +56,8 @@ import (
 	"github.com/juju/juju/worker/credentialvalidator"
 	"github.com/juju/juju/worker/deployer"
 	"github.com/juju/juju/worker/diskmanager"
+	"github.com/juju/juju/worker/dnsconfigurer"
+	"github.com/juju/juju/worker/docstoremigrator"
 	"github.com/juju/juju/worker/externalcontrollerupdater"
 	"github.com/juju/juju/worker/fanconfigurer"
 	"github.com/juju/juju/worker/fortress"
@@ -98,6 +101,7 @@ import (
 	"github.com/juju/juju/worker/upgrader"
 	"github.com/juju/juju/worker/upgradeseries"
 	"github.com/juju/juju/worker/upgradesteps"
+	"github.com/juju/juju/worker/workercontrol"
 )
 
 const (
@@ -201,6 +205,10 @@ type ManifoldsConfig struct {
 	// worker.
 	PubSubReporter psworker.Reporter
 
+	// DepEngineReporter gives access to the report of the dependency
+	// engine running these manifolds, for agent health reporting.
+	DepEngineReporter agenthealthreporter.Reporter
+
 	// PresenceRecorder
 	PresenceRecorder presence.Recorder
 
@@ -659,6 +667,15 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			},
 		))),
 
+		docstoreMigratorName: ifNotMigrating(ifPrimaryController(docstoremigrator.Manifold(
+			docstoremigrator.ManifoldConfig{
+				StateName:      stateName,
+				Logger:         loggo.GetLogger("juju.worker.docstoremigrator"),
+				NewWorker:      docstoremigrator.NewWorker,
+				NewTargetStore: docstoremigrator.NewTargetStore,
+			},
+		))),
+
 		httpServerArgsName: httpserverargs.Manifold(httpserverargs.ManifoldConfig{
 			ClockName:             clockName,
 			ControllerPortName:    controllerPortName,
@@ -853,11 +870,57 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			NewWorker:     credentialvalidator.NewWorker,
 			Logger:        loggo.GetLogger("juju.worker.credentialvalidator"),
 		}),
+
+		// The agent health reporter periodically summarises the
+		// dependency engine report and records it on the controller,
+		// so that wedged workers can be inspected without reading
+		// machine logs.
+		agentHealthReporterName: ifNotMigrating(agenthealthreporter.Manifold(agenthealthreporter.ManifoldConfig{
+			AgentName:     agentName,
+			APICallerName: apiCallerName,
+			Reporter:      config.DepEngineReporter,
+			Interval:      agenthealthreporter.ReportInterval,
+			Logger:        loggo.GetLogger("juju.worker.agenthealthreporter"),
+		})),
+
+		// The worker control manifold tracks the set of workers that
+		// have been administratively disabled for this machine, and
+		// bounces whenever it changes. Manifolds wrapped with
+		// disableable depend on it and refuse to start while their
+		// name is in the set.
+		workerControlName: workercontrol.Manifold(workercontrol.ManifoldConfig{
+			AgentName:     agentName,
+			APICallerName: apiCallerName,
+			NewFacade:     workercontrol.NewFacade,
+			NewWorker:     workercontrol.NewWorker,
+			Logger:        loggo.GetLogger("juju.worker.workercontrol"),
+		}),
 	}
 
 	return manifolds
 }
 
+// disableable wraps a manifold so that it will not start while its
+// name is in the set of administratively disabled workers published
+// by the worker control manifold. Only apply it to leaf workers whose
+// absence cannot stop the agent from talking to the controller, or a
+// disabled worker could not be re-enabled.
+func disableable(name string, base dependency.Manifold) dependency.Manifold {
+	manifold := base
+	manifold.Inputs = append([]string{workerControlName}, base.Inputs...)
+	manifold.Start = func(context dependency.Context) (worker.Worker, error) {
+		var disabled workercontrol.Check
+		if err := context.Get(workerControlName, &disabled); err != nil {
+			return nil, errors.Trace(err)
+		}
+		if disabled(name) {
+			return nil, dependency.ErrMissing
+		}
+		return base.Start(context)
+	}
+	return manifold
+}
+
 // IAASManifolds returns a set of co-configured manifolds covering the
 // various responsibilities of a IAAS machine agent.
 func IAASManifolds(config ManifoldsConfig) dependency.Manifolds {
@@ -880,10 +943,15 @@ func IAASManifolds(config ManifoldsConfig) dependency.Manifolds {
 			NewWorker:     hostkeyreporter.NewWorker,
 		})),
 
-		fanConfigurerName: ifNotMigrating(fanconfigurer.Manifold(fanconfigurer.ManifoldConfig{
+		fanConfigurerName: disableable(fanConfigurerName, ifNotMigrating(fanconfigurer.Manifold(fanconfigurer.ManifoldConfig{
 			APICallerName: apiCallerName,
 			Clock:         config.Clock,
-		})),
+		}))),
+
+		dnsConfigurerName: disableable(dnsConfigurerName, ifNotMigrating(dnsconfigurer.Manifold(dnsconfigurer.ManifoldConfig{
+			APICallerName: apiCallerName,
+			Clock:         config.Clock,
+		}))),
 
 		certificateUpdaterName: ifFullyUpgraded(certupdater.Manifold(certupdater.ManifoldConfig{
 			AgentName:                agentName,
@@ -906,10 +974,10 @@ func IAASManifolds(config ManifoldsConfig) dependency.Manifolds {
 		// The diskmanager worker periodically lists block devices on the
 		// machine it runs on. This worker will be run on all Juju-managed
 		// machines (one per machine agent).
-		diskManagerName: ifNotMigrating(diskmanager.Manifold(diskmanager.ManifoldConfig{
+		diskManagerName: disableable(diskManagerName, ifNotMigrating(diskmanager.Manifold(diskmanager.ManifoldConfig{
 			AgentName:     agentName,
 			APICallerName: apiCallerName,
-		})),
+		}))),
 
 		// The api address updater is a leaf worker that rewrites agent config
 		// as the state server addresses change. We should only need one of
@@ -996,14 +1064,14 @@ func IAASManifolds(config ManifoldsConfig) dependency.Manifolds {
 			NewBrokerFunc: config.NewBrokerFunc,
 			NewTracker:    lxdbroker.NewWorkerTracker,
 		})),
-		instanceMutaterName: ifNotMigrating(instancemutater.MachineManifold(instancemutater.MachineManifoldConfig{
+		instanceMutaterName: disableable(instanceMutaterName, ifNotMigrating(instancemutater.MachineManifold(instancemutater.MachineManifoldConfig{
 			AgentName:     agentName,
 			APICallerName: apiCallerName,
 			BrokerName:    brokerTrackerName,
 			Logger:        loggo.GetLogger("juju.worker.instancemutater"),
 			NewClient:     instancemutater.NewClient,
 			NewWorker:     instancemutater.NewContainerWorker,
-		})),
+		}))),
 	}
 
 	return mergeManifolds(config, manifolds)
@@ -1136,13 +1204,17 @@ const (
 	toolsVersionCheckerName       = "tools-version-checker"
 	machineActionName             = "machine-action-runner"
 	hostKeyReporterName           = "host-key-reporter"
+	agentHealthReporterName       = "agent-health-reporter"
+	workerControlName             = "worker-control"
 	fanConfigurerName             = "fan-configurer"
+	dnsConfigurerName             = "dns-configurer"
 	externalControllerUpdaterName = "external-controller-updater"
 	leaseClockUpdaterName         = "lease-clock-updater"
 	isPrimaryControllerFlagName   = "is-primary-controller-flag"
 	isControllerFlagName          = "is-controller-flag"
 	instanceMutaterName           = "instance-mutater"
 	txnPrunerName                 = "transaction-pruner"
+	docstoreMigratorName          = "docstore-migrator"
 	certificateWatcherName        = "certificate-watcher"
 	modelCacheName                = "model-cache"
 	modelCacheInitializedFlagName = "model-cache-initialized-flag"
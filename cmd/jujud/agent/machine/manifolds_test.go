@@ -60,6 +60,7 @@ func (ms *ManifoldsSuite) TestManifoldNamesIAAS(c *gc.C) {
 		[]string{
 			"agent",
 			"agent-config-updater",
+			"agent-health-reporter",
 			"api-address-updater",
 			"api-caller",
 			"api-config-watcher",
@@ -73,6 +74,8 @@ func (ms *ManifoldsSuite) TestManifoldNamesIAAS(c *gc.C) {
 			"controller-port",
 			"deployer",
 			"disk-manager",
+			"dns-configurer",
+			"docstore-migrator",
 			"external-controller-updater",
 			"fan-configurer",
 			"host-key-reporter",
@@ -127,6 +130,7 @@ func (ms *ManifoldsSuite) TestManifoldNamesIAAS(c *gc.C) {
 			"upgrade-steps-runner",
 			"upgrader",
 			"valid-credential-flag",
+			"worker-control",
 		},
 	)
 }
@@ -139,6 +143,7 @@ func (ms *ManifoldsSuite) TestManifoldNamesCAAS(c *gc.C) {
 		[]string{
 			"agent",
 			"agent-config-updater",
+			"agent-health-reporter",
 			"api-caller",
 			"api-config-watcher",
 			"api-server",
@@ -147,6 +152,7 @@ func (ms *ManifoldsSuite) TestManifoldNamesCAAS(c *gc.C) {
 			"certificate-watcher",
 			"clock",
 			"controller-port",
+			"docstore-migrator",
 			"external-controller-updater",
 			"http-server",
 			"http-server-args",
@@ -191,6 +197,7 @@ func (ms *ManifoldsSuite) TestManifoldNamesCAAS(c *gc.C) {
 			"upgrade-steps-runner",
 			"upgrader",
 			"valid-credential-flag",
+			"worker-control",
 		},
 	)
 }
@@ -269,6 +276,7 @@ func (ms *ManifoldsSuite) TestMigrationGuardsUsed(c *gc.C) {
 		"raft-leader-flag",
 		"raft-transport",
 		"valid-credential-flag",
+		"worker-control",
 	)
 	manifolds := machine.IAASManifolds(machine.ManifoldsConfig{
 		Agent: &mockAgent{},
@@ -302,6 +310,7 @@ func (*ManifoldsSuite) TestSingularGuardsUsed(c *gc.C) {
 		"upgrade-database-runner",
 	)
 	primaryControllerWorkers := set.NewStrings(
+		"docstore-migrator",
 		"external-controller-updater",
 		"transaction-pruner",
 	)
@@ -526,6 +535,7 @@ var expectedMachineManifoldsWithDependencies = map[string][]string{
 		"upgrade-check-gate",
 		"upgrade-steps-flag",
 		"upgrade-steps-gate",
+		"worker-control",
 	},
 
 	"external-controller-updater": {
@@ -544,6 +554,35 @@ var expectedMachineManifoldsWithDependencies = map[string][]string{
 		"upgrade-steps-gate",
 	},
 
+	"dns-configurer": {
+		"agent",
+		"api-caller",
+		"api-config-watcher",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"upgrade-check-flag",
+		"upgrade-check-gate",
+		"upgrade-steps-flag",
+		"upgrade-steps-gate",
+		"worker-control",
+	},
+
+	"docstore-migrator": {
+		"agent",
+		"api-caller",
+		"api-config-watcher",
+		"is-controller-flag",
+		"is-primary-controller-flag",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"state",
+		"state-config-watcher",
+		"upgrade-check-flag",
+		"upgrade-check-gate",
+		"upgrade-steps-flag",
+		"upgrade-steps-gate",
+	},
+
 	"fan-configurer": {
 		"agent",
 		"api-caller",
@@ -554,6 +593,19 @@ var expectedMachineManifoldsWithDependencies = map[string][]string{
 		"upgrade-check-gate",
 		"upgrade-steps-flag",
 		"upgrade-steps-gate",
+		"worker-control",
+	},
+
+	"agent-health-reporter": {
+		"agent",
+		"api-caller",
+		"api-config-watcher",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"upgrade-check-flag",
+		"upgrade-check-gate",
+		"upgrade-steps-flag",
+		"upgrade-steps-gate",
 	},
 
 	"host-key-reporter": {
@@ -611,6 +663,7 @@ var expectedMachineManifoldsWithDependencies = map[string][]string{
 		"upgrade-check-gate",
 		"upgrade-steps-flag",
 		"upgrade-steps-gate",
+		"worker-control",
 	},
 
 	"is-controller-flag": {"agent", "state", "state-config-watcher"},
@@ -698,6 +751,7 @@ var expectedMachineManifoldsWithDependencies = map[string][]string{
 		"upgrade-check-gate",
 		"upgrade-steps-flag",
 		"upgrade-steps-gate",
+		"worker-control",
 	},
 
 	"mgo-txn-resumer": {
@@ -1077,6 +1131,12 @@ var expectedMachineManifoldsWithDependencies = map[string][]string{
 		"api-caller",
 		"api-config-watcher",
 	},
+
+	"worker-control": {
+		"agent",
+		"api-caller",
+		"api-config-watcher",
+	},
 }
 
 type mockAgent struct {
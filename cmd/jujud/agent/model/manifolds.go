@@ -24,7 +24,6 @@ import (
 	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/pki"
-	"github.com/juju/juju/worker/actionpruner"
 	"github.com/juju/juju/worker/agent"
 	"github.com/juju/juju/worker/apicaller"
 	"github.com/juju/juju/worker/apiconfigwatcher"
@@ -62,7 +61,6 @@ import (
 	"github.com/juju/juju/worker/pruner"
 	"github.com/juju/juju/worker/remoterelations"
 	"github.com/juju/juju/worker/singular"
-	"github.com/juju/juju/worker/statushistorypruner"
 	"github.com/juju/juju/worker/storageprovisioner"
 	"github.com/juju/juju/worker/undertaker"
 	"github.com/juju/juju/worker/unitassigner"
@@ -285,27 +283,26 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			Clock:         config.Clock,
 			Logger:        config.LoggingContext.GetLogger("juju.worker.cleaner"),
 		})),
-		statusHistoryPrunerName: ifNotMigrating(pruner.Manifold(pruner.ManifoldConfig{
+		prunerName: ifNotMigrating(pruner.Manifold(pruner.ManifoldConfig{
 			APICallerName: apiCallerName,
 			Clock:         config.Clock,
-			NewWorker:     statushistorypruner.New,
-			NewClient:     statushistorypruner.NewClient,
-			PruneInterval: config.StatusHistoryPrunerInterval,
-			Logger:        config.LoggingContext.GetLogger("juju.worker.pruner.statushistory"),
-		})),
-		actionPrunerName: ifNotMigrating(pruner.Manifold(pruner.ManifoldConfig{
-			APICallerName: apiCallerName,
-			Clock:         config.Clock,
-			NewWorker:     actionpruner.New,
-			NewClient:     actionpruner.NewClient,
-			PruneInterval: config.ActionPrunerInterval,
-			Logger:        config.LoggingContext.GetLogger("juju.worker.pruner.action"),
+			Policies: []pruner.Policy{
+				pruner.StatusHistoryPolicy(config.StatusHistoryPrunerInterval),
+				pruner.ActionPolicy(config.ActionPrunerInterval),
+			},
+			NewWorker: pruner.New,
+			Logger:    config.LoggingContext.GetLogger("juju.worker.pruner"),
 		})),
 		logForwarderName: ifNotDead(logforwarder.Manifold(logforwarder.ManifoldConfig{
 			APICallerName: apiCallerName,
 			Sinks: []logforwarder.LogSinkSpec{{
-				Name:   "juju-log-forward",
-				OpenFn: sinks.OpenSyslog,
+				Name:     "juju-log-forward",
+				ConfigFn: sinks.SyslogConfig,
+				OpenFn:   sinks.OpenSyslog,
+			}, {
+				Name:     "juju-loki-forward",
+				ConfigFn: sinks.LokiConfig,
+				OpenFn:   sinks.OpenLoki,
 			}},
 			Logger: config.LoggingContext.GetLogger("juju.worker.logforwarder"),
 		})),
@@ -701,8 +698,7 @@ const (
 	charmRevisionUpdaterName = "charm-revision-updater"
 	metricWorkerName         = "metric-worker"
 	stateCleanerName         = "state-cleaner"
-	statusHistoryPrunerName  = "status-history-pruner"
-	actionPrunerName         = "action-pruner"
+	prunerName               = "pruner"
 	machineUndertakerName    = "machine-undertaker"
 	remoteRelationsName      = "remote-relations"
 	logForwarderName         = "log-forwarder"
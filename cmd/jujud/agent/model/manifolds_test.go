@@ -34,7 +34,6 @@ func (s *ManifoldsSuite) TestIAASNames(c *gc.C) {
 	// NOTE: if this test failed, the cmd/jujud/agent tests will
 	// also fail. Search for 'ModelWorkers' to find affected vars.
 	c.Check(actual.SortedValues(), jc.DeepEquals, []string{
-		"action-pruner",
 		"agent",
 		"api-caller",
 		"api-config-watcher",
@@ -60,9 +59,9 @@ func (s *ManifoldsSuite) TestIAASNames(c *gc.C) {
 		"model-upgrader",
 		"not-alive-flag",
 		"not-dead-flag",
+		"pruner",
 		"remote-relations",
 		"state-cleaner",
-		"status-history-pruner",
 		"storage-provisioner",
 		"undertaker",
 		"unit-assigner",
@@ -82,7 +81,6 @@ func (s *ManifoldsSuite) TestCAASNames(c *gc.C) {
 	// NOTE: if this test failed, the cmd/jujud/agent tests will
 	// also fail. Search for 'ModelWorkers' to find affected vars.
 	c.Check(actual.SortedValues(), jc.DeepEquals, []string{
-		"action-pruner",
 		"agent",
 		"api-caller",
 		"api-config-watcher",
@@ -109,9 +107,9 @@ func (s *ManifoldsSuite) TestCAASNames(c *gc.C) {
 		"model-upgrader",
 		"not-alive-flag",
 		"not-dead-flag",
+		"pruner",
 		"remote-relations",
 		"state-cleaner",
-		"status-history-pruner",
 		"undertaker",
 		"valid-credential-flag",
 	})
@@ -205,16 +203,6 @@ func (s *ManifoldsSuite) TestCAASManifold(c *gc.C) {
 }
 
 var expectedCAASModelManifoldsWithDependencies = map[string][]string{
-	"action-pruner": {
-		"agent",
-		"api-caller",
-		"is-responsible-flag",
-		"migration-fortress",
-		"migration-inactive-flag",
-		"model-upgrade-gate",
-		"model-upgraded-flag",
-		"not-dead-flag"},
-
 	"agent": {},
 
 	"api-caller": {"agent"},
@@ -398,7 +386,7 @@ var expectedCAASModelManifoldsWithDependencies = map[string][]string{
 		"model-upgraded-flag",
 		"not-dead-flag"},
 
-	"status-history-pruner": {
+	"pruner": {
 		"agent",
 		"api-caller",
 		"is-responsible-flag",
@@ -423,17 +411,6 @@ var expectedCAASModelManifoldsWithDependencies = map[string][]string{
 
 var expectedIAASModelManifoldsWithDependencies = map[string][]string{
 
-	"action-pruner": {
-		"agent",
-		"api-caller",
-		"is-responsible-flag",
-		"migration-fortress",
-		"migration-inactive-flag",
-		"model-upgrade-gate",
-		"model-upgraded-flag",
-		"not-dead-flag",
-	},
-
 	"agent": {},
 
 	"api-caller": {"agent"},
@@ -639,7 +616,7 @@ var expectedIAASModelManifoldsWithDependencies = map[string][]string{
 		"model-upgraded-flag",
 		"not-dead-flag"},
 
-	"status-history-pruner": {
+	"pruner": {
 		"agent",
 		"api-caller",
 		"is-responsible-flag",
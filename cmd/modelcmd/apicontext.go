@@ -4,6 +4,7 @@
 package modelcmd
 
 import (
+	"fmt"
 	"net/http"
 	"net/url"
 	"os"
@@ -26,6 +27,10 @@ type apiContext struct {
 	// methods that clients should not use, such as Save.
 	jar        *domainCookieJar
 	interactor httpbakery.Interactor
+
+	// deviceInteractor, if non-nil, handles OIDC device-code
+	// discharges for identity providers that offer them.
+	deviceInteractor httpbakery.Interactor
 }
 
 // AuthOpts holds flags relating to authentication.
@@ -64,7 +69,7 @@ func newAPIContext(ctxt *cmd.Context, opts *AuthOpts, store jujuclient.CookieSto
 		CookieJar: jar0,
 		domain:    os.Getenv("JUJU_USER_DOMAIN"),
 	}
-	var interactor httpbakery.Interactor
+	var interactor, deviceInteractor httpbakery.Interactor
 	embedded := ctxt != nil && opts != nil && opts.Embedded
 	if embedded {
 		// Embedded commands don't yet support macaroon discharge workflow.
@@ -84,13 +89,22 @@ func newAPIContext(ctxt *cmd.Context, opts *AuthOpts, store jujuclient.CookieSto
 					Name:   "juju",
 				},
 			})
+			// Without a browser, identity providers supporting the
+			// OIDC device authorization grant can authorize the
+			// login from another device.
+			stderr := ctxt.Stderr
+			deviceInteractor = authentication.NewDeviceCodeInteractor(func(userCode, verificationURI string) error {
+				_, err := fmt.Fprintf(stderr, "To log in, visit %s and enter code %s\n", verificationURI, userCode)
+				return err
+			})
 		} else {
 			interactor = httpbakery.WebBrowserInteractor{}
 		}
 	}
 	return &apiContext{
-		jar:        jar,
-		interactor: interactor,
+		jar:              jar,
+		interactor:       interactor,
+		deviceInteractor: deviceInteractor,
 	}, nil
 }
 
@@ -108,6 +122,9 @@ func (ctx *apiContext) NewBakeryClient() *httpbakery.Client {
 	if ctx.interactor != nil {
 		client.AddInteractor(ctx.interactor)
 	}
+	if ctx.deviceInteractor != nil {
+		client.AddInteractor(ctx.deviceInteractor)
+	}
 	return client
 }
 
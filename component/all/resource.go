@@ -11,6 +11,7 @@ import (
 	"github.com/juju/juju/api/base"
 	internalclient "github.com/juju/juju/api/resources/private/client"
 	"github.com/juju/juju/resource"
+	resourcecache "github.com/juju/juju/resource/cache"
 	"github.com/juju/juju/resource/context"
 	contextcmd "github.com/juju/juju/resource/context/cmd"
 	unitercontext "github.com/juju/juju/worker/uniter/runner/context"
@@ -70,8 +71,15 @@ func (r resources) registerHookContext() {
 			if err != nil {
 				return nil, errors.Trace(err)
 			}
+			// Serve resource content from the host-local cache where
+			// possible, so units on the same machine share downloads.
+			var apiClient context.APIClient = hctxClient
+			if cacheDir := resourcecache.HostCacheDir(config.DataDir); cacheDir != "" {
+				cache := resourcecache.HostCache(cacheDir, resourcecache.DefaultMaxSize)
+				apiClient = resourcecache.NewCachingClient(hctxClient, cache)
+			}
 			// TODO(ericsnow) Pass the unit's tag through to the component?
-			return context.NewContextAPI(hctxClient, config.DataDir), nil
+			return context.NewContextAPI(apiClient, config.DataDir), nil
 		},
 	)
 
@@ -99,7 +107,7 @@ func (r resources) registerHookContextCommands() {
 	)
 }
 
-func (r resources) newUnitFacadeClient(unitName string, caller base.APICaller) (context.APIClient, error) {
+func (r resources) newUnitFacadeClient(unitName string, caller base.APICaller) (*internalclient.UnitFacadeClient, error) {
 
 	facadeCaller := base.NewFacadeCallerForVersion(caller, context.HookContextFacade, 1)
 	httpClient, err := caller.HTTPClient()
@@ -6,6 +6,7 @@ package lxd
 import (
 	"fmt"
 	"path"
+	"strconv"
 
 	"github.com/juju/errors"
 	jujuarch "github.com/juju/utils/v2/arch"
@@ -18,6 +19,16 @@ import (
 	"github.com/juju/juju/environs"
 )
 
+// imageCacheExpiryConfigKey is the LXD server configuration key holding
+// the remote image cache expiry, measured in days. Server configuration
+// is global across a cluster, so the policy applies to images cached on
+// every cluster member.
+const imageCacheExpiryConfigKey = "images.remote_cache_expiry"
+
+// defaultImageCacheExpiryDays mirrors the LXD default for
+// images.remote_cache_expiry, used when the key is not set.
+const defaultImageCacheExpiryDays = 10
+
 // SourcedImage is the result of a successful image acquisition.
 // It includes the relevant data that located the image.
 type SourcedImage struct {
@@ -167,9 +178,47 @@ func (s *Server) CopyRemoteImage(
 	if opInfo.StatusCode != api.Success {
 		return fmt.Errorf("image copy failed: %s", opInfo.Err)
 	}
+
+	// Apply the cache expiry policy to the newly cached image, so that
+	// images for series no longer in use are eventually removed.
+	if err := s.applyImageCacheExpiry(sourced.Image.Fingerprint); err != nil {
+		// The image is cached and usable; failing to stamp an expiry
+		// date is not worth failing provisioning over.
+		logger.Warningf("failed to set expiry on cached image %q: %s", sourced.Image.Fingerprint, err)
+	}
 	return nil
 }
 
+// applyImageCacheExpiry stamps the cached image with the given
+// fingerprint with an expiry date based on the server's image cache
+// expiry configuration. A non-positive expiry leaves the image without
+// an expiry date.
+func (s *Server) applyImageCacheExpiry(fingerprint string) error {
+	svr, _, err := s.GetServer()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	days := defaultImageCacheExpiryDays
+	if value, ok := svr.Config[imageCacheExpiryConfigKey]; ok {
+		parsed, err := strconv.Atoi(fmt.Sprintf("%v", value))
+		if err != nil {
+			return errors.Annotatef(err, "parsing %s", imageCacheExpiryConfigKey)
+		}
+		days = parsed
+	}
+	if days <= 0 {
+		return nil
+	}
+
+	image, eTag, err := s.GetImage(fingerprint)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	writable := image.Writable()
+	writable.ExpiresAt = s.clock.Now().AddDate(0, 0, days)
+	return errors.Trace(s.UpdateImage(fingerprint, writable, eTag))
+}
+
 // seriesLocalAlias returns the alias to assign to images for the
 // specified series. The alias is juju-specific, to support the
 // user supplying a customised image (e.g. CentOS with cloud-init).
@@ -5,6 +5,7 @@ package lxd_test
 
 import (
 	"errors"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	jc "github.com/juju/testing/checkers"
@@ -13,6 +14,7 @@ import (
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/container/lxd"
+	"github.com/juju/juju/container/lxd/mocks"
 	lxdtesting "github.com/juju/juju/container/lxd/testing"
 )
 
@@ -36,10 +38,13 @@ func (s *imageSuite) TestCopyImageUsesPassedCallback(c *gc.C) {
 	copyOp.EXPECT().GetTarget().Return(&lxdapi.Operation{StatusCode: lxdapi.Success}, nil)
 	copyOp.EXPECT().AddHandler(gomock.Any()).Return(nil, nil)
 
-	image := lxdapi.Image{Filename: "this-is-our-image"}
+	image := lxdapi.Image{Filename: "this-is-our-image", Fingerprint: "fingerprint"}
 	aliases := []lxdapi.ImageAlias{{Name: "local/image/alias"}}
 	req := &lxdclient.ImageCopyArgs{Aliases: aliases}
 	iSvr.EXPECT().CopyImage(iSvr, image, req).Return(copyOp, nil)
+	iSvr.EXPECT().GetServer().Return(&lxdapi.Server{}, lxdtesting.ETag, nil)
+	iSvr.EXPECT().GetImage("fingerprint").Return(&image, lxdtesting.ETag, nil)
+	iSvr.EXPECT().UpdateImage("fingerprint", gomock.Any(), lxdtesting.ETag).Return(nil)
 
 	jujuSvr, err := lxd.NewServer(iSvr)
 	c.Assert(err, jc.ErrorIsNil)
@@ -52,6 +57,44 @@ func (s *imageSuite) TestCopyImageUsesPassedCallback(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *imageSuite) TestCopyImageUsesServerCacheExpiry(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	iSvr := s.NewMockServer(ctrl)
+
+	copyOp := lxdtesting.NewMockRemoteOperation(ctrl)
+	copyOp.EXPECT().Wait().Return(nil).AnyTimes()
+	copyOp.EXPECT().GetTarget().Return(&lxdapi.Operation{StatusCode: lxdapi.Success}, nil)
+
+	now := time.Now()
+	clock := mocks.NewMockClock(ctrl)
+	clock.EXPECT().Now().Return(now).AnyTimes()
+
+	image := lxdapi.Image{Filename: "this-is-our-image", Fingerprint: "fingerprint"}
+	expected := image.Writable()
+	expected.ExpiresAt = now.AddDate(0, 0, 30)
+
+	req := &lxdclient.ImageCopyArgs{Aliases: []lxdapi.ImageAlias{{Name: "local/image/alias"}}}
+	iSvr.EXPECT().CopyImage(iSvr, image, req).Return(copyOp, nil)
+	iSvr.EXPECT().GetServer().Return(&lxdapi.Server{
+		ServerPut: lxdapi.ServerPut{Config: map[string]interface{}{
+			"images.remote_cache_expiry": "30",
+		}},
+	}, lxdtesting.ETag, nil)
+	iSvr.EXPECT().GetImage("fingerprint").Return(&image, lxdtesting.ETag, nil)
+	iSvr.EXPECT().UpdateImage("fingerprint", expected, lxdtesting.ETag).Return(nil)
+
+	jujuSvr, err := lxd.NewTestingServer(iSvr, clock)
+	c.Assert(err, jc.ErrorIsNil)
+
+	sourced := lxd.SourcedImage{
+		Image:     &image,
+		LXDServer: iSvr,
+	}
+	err = jujuSvr.CopyRemoteImage(sourced, []string{"local/image/alias"}, nil)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func (s *imageSuite) TestFindImageLocalServer(c *gc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -137,7 +180,7 @@ func (s *imageSuite) TestFindImageRemoteServersCopyLocalNoCallback(c *gc.C) {
 	copyOp.EXPECT().GetTarget().Return(&lxdapi.Operation{StatusCode: lxdapi.Success}, nil)
 
 	localAlias := "juju/xenial/" + s.Arch()
-	image := lxdapi.Image{Filename: "this-is-our-image"}
+	image := lxdapi.Image{Filename: "this-is-our-image", Fingerprint: "fingerprint"}
 	alias := lxdapi.ImageAliasesEntry{ImageAliasesEntryPut: lxdapi.ImageAliasesEntryPut{Target: "foo-remote-target"}}
 	copyReq := &lxdclient.ImageCopyArgs{Aliases: []lxdapi.ImageAlias{{Name: localAlias}}}
 	gomock.InOrder(
@@ -145,6 +188,9 @@ func (s *imageSuite) TestFindImageRemoteServersCopyLocalNoCallback(c *gc.C) {
 		rSvr.EXPECT().GetImageAliasType("container", "xenial/"+s.Arch()).Return(&alias, lxdtesting.ETag, nil),
 		rSvr.EXPECT().GetImage("foo-remote-target").Return(&image, lxdtesting.ETag, nil),
 		iSvr.EXPECT().CopyImage(rSvr, image, copyReq).Return(copyOp, nil),
+		iSvr.EXPECT().GetServer().Return(&lxdapi.Server{}, lxdtesting.ETag, nil),
+		iSvr.EXPECT().GetImage("fingerprint").Return(&image, lxdtesting.ETag, nil),
+		iSvr.EXPECT().UpdateImage("fingerprint", gomock.Any(), lxdtesting.ETag).Return(nil),
 	)
 
 	jujuSvr, err := lxd.NewServer(iSvr)
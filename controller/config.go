@@ -58,6 +58,21 @@ const (
 	// This effectively says that we can have a new agent connect per duration specified.
 	AgentRateLimitRate = "agent-ratelimit-rate"
 
+	// AgentWebsocketCompression sets whether the API server offers
+	// permessage-deflate compression on API connections. This is most
+	// useful for controllers managing models across a WAN, where the
+	// CPU cost of compressing the large status and watcher payloads is
+	// outweighed by the bandwidth saved.
+	AgentWebsocketCompression = "agent-websocket-compression"
+
+	// LogSinkRateLimitBurst is the number of log messages an agent may
+	// send to the logsink endpoint before rate limiting kicks in.
+	LogSinkRateLimitBurst = "logsink-ratelimit-burst"
+
+	// LogSinkRateLimitRefill is the time taken to replace each log
+	// message allowance consumed from the burst.
+	LogSinkRateLimitRefill = "logsink-ratelimit-refill"
+
 	// APIPortOpenDelay is a duration that the controller will wait
 	// between when the controller has been deemed to be ready to open
 	// the api-port and when the api-port is actually opened. This value
@@ -138,10 +153,26 @@ const (
 	// detault
 	MongoMemoryProfile = "mongo-memory-profile"
 
+	// StateStorageBackend selects the document store used by the newest
+	// state subsystems (secrets, firewall rules, external controllers):
+	// either mongo or dqlite. This is a stepping stone for the database
+	// transition; the bulk of state remains in mongo regardless.
+	StateStorageBackend = "state-storage-backend"
+
 	// JujuDBSnapChannel selects the channel to use when installing mongo
 	// snaps for focal or later. The value is ignored for older releases.
 	JujuDBSnapChannel = "juju-db-snap-channel"
 
+	// ModelHealthEndpoint determines whether the apiserver exposes the
+	// unauthenticated per-model health endpoint used by load balancers
+	// and monitoring probes.
+	ModelHealthEndpoint = "model-health-endpoint"
+
+	// CharmAttestationPolicy sets the enforcement level applied to
+	// store-provided hash attestations when charm blobs are downloaded:
+	// ignore, verify or strict.
+	CharmAttestationPolicy = "charm-attestation-policy"
+
 	// MaxDebugLogDuration is used to provide a backstop to the execution of a
 	// debug-log command. If someone starts a debug-log session in a remote
 	// screen for example, it is very easy to disconnect from the screen while
@@ -192,6 +223,15 @@ const (
 	// indicates to not sleep at all.
 	PruneTxnSleepTime = "prune-txn-sleep-time"
 
+	// WatcherCoalesceWindow is the amount of time the state watcher
+	// keeps draining further incoming document changes before
+	// notifying watchers. Changes to the same document within the
+	// window are folded into a single notification, which cuts the
+	// event storms seen during large deploys. A value of 0 disables
+	// coalescing. Changing the value only affects watchers started
+	// after the change.
+	WatcherCoalesceWindow = "watcher-coalesce-window"
+
 	// MaxCharmStateSize is the maximum allowed size of charm-specific
 	// per-unit state data that charms can store to the controller in
 	// bytes. A value of 0 disables the quota checks although in
@@ -204,6 +244,30 @@ const (
 	// hard (but configurable) limit of 16M.
 	MaxAgentStateSize = "max-agent-state-size"
 
+	// ControllerResourceDownloadLimit is the maximum number of
+	// concurrent resource downloads that the controller will serve to
+	// unit agents across all applications. A value of 0 disables the
+	// limit; downloads over the limit are deferred with a retry hint.
+	ControllerResourceDownloadLimit = "controller-resource-download-limit"
+
+	// ApplicationResourceDownloadLimit is the maximum number of
+	// concurrent resource downloads that the controller will serve to
+	// the unit agents of any one application. A value of 0 disables
+	// the limit.
+	ApplicationResourceDownloadLimit = "application-resource-download-limit"
+
+	// MaxRelationSettingsSize is the maximum allowed size of unit or
+	// application settings data that charms can store into a single
+	// relation in bytes. A value of 0 disables the quota checks although
+	// in principle, mongo imposes a hard (but configurable) limit of 16M.
+	MaxRelationSettingsSize = "max-relation-settings-size"
+
+	// BackupStorageURL identifies an object store (eg s3://bucket/path,
+	// swift://container, azure://container) to which backup archives
+	// are streamed, and from which they can be listed and pruned. When
+	// empty, backups are only kept on the controller disk.
+	BackupStorageURL = "backup-storage-url"
+
 	// NonSyncedWritesToRaftLog allows the operator to disable fsync calls
 	// when writing to the raft log by setting this value to true.
 	NonSyncedWritesToRaftLog = "non-synced-writes-to-raft-log"
@@ -249,6 +313,14 @@ const (
 	// second. A token is added to the ratelimit token bucket every 250ms.
 	DefaultAgentRateLimitRate = 250 * time.Millisecond
 
+	// DefaultLogSinkRateLimitBurst allows agents to send 1000 log
+	// messages before the logsink rate limiting is applied.
+	DefaultLogSinkRateLimitBurst = 1000
+
+	// DefaultLogSinkRateLimitRefill allows one log message per
+	// millisecond once the burst allowance has been used up.
+	DefaultLogSinkRateLimitRefill = time.Millisecond
+
 	// DefaultAuditingEnabled contains the default value for the
 	// AuditingEnabled config value.
 	DefaultAuditingEnabled = true
@@ -286,6 +358,26 @@ const (
 	// mongo in focal or later.
 	DefaultJujuDBSnapChannel = "4.4/stable"
 
+	// StateStorageBackendMongo keeps the newest state subsystems in
+	// mongo alongside the rest of state.
+	StateStorageBackendMongo = "mongo"
+
+	// StateStorageBackendDqlite stores the newest state subsystems in
+	// a dqlite database on the controller.
+	StateStorageBackendDqlite = "dqlite"
+
+	// DefaultStateStorageBackend is the document store used for the
+	// newest state subsystems when none is configured.
+	DefaultStateStorageBackend = StateStorageBackendMongo
+
+	// DefaultModelHealthEndpoint is the default for whether the
+	// unauthenticated per-model health endpoint is exposed.
+	DefaultModelHealthEndpoint = false
+
+	// DefaultCharmAttestationPolicy verifies charm hash attestations
+	// when the store provides them, without requiring their presence.
+	DefaultCharmAttestationPolicy = "verify"
+
 	// DefaultMaxDebugLogDuration is the default duration that debug-log
 	// commands can run before being terminated by the API server.
 	DefaultMaxDebugLogDuration = 24 * time.Hour
@@ -326,6 +418,11 @@ const (
 	// operate concurrently.
 	DefaultPruneTxnSleepTime = "10ms"
 
+	// DefaultWatcherCoalesceWindow is the default amount of time the
+	// state watcher keeps draining incoming document changes before
+	// notifying watchers. Coalescing is disabled by default.
+	DefaultWatcherCoalesceWindow = "0s"
+
 	// DefaultMaxCharmStateSize is the maximum size (in bytes) of charm
 	// state data that each unit can store to the controller.
 	DefaultMaxCharmStateSize = 2 * 1024 * 1024
@@ -334,6 +431,19 @@ const (
 	// state data that agents can store to the controller.
 	DefaultMaxAgentStateSize = 512 * 1024
 
+	// DefaultControllerResourceDownloadLimit is the default resource
+	// download limit across the controller: no limit.
+	DefaultControllerResourceDownloadLimit = 0
+
+	// DefaultApplicationResourceDownloadLimit is the default resource
+	// download limit for a single application: no limit.
+	DefaultApplicationResourceDownloadLimit = 0
+
+	// DefaultMaxRelationSettingsSize is the maximum size (in bytes) of
+	// unit or application settings data that charms can store into a
+	// single relation.
+	DefaultMaxRelationSettingsSize = 1024 * 1024
+
 	// DefaultNonSyncedWritesToRaftLog is the default value for the
 	// non-synced-writes-to-raft-log value. It is set to false by default.
 	DefaultNonSyncedWritesToRaftLog = false
@@ -349,6 +459,9 @@ var (
 		AllowModelAccessKey,
 		AgentRateLimitMax,
 		AgentRateLimitRate,
+		AgentWebsocketCompression,
+		LogSinkRateLimitBurst,
+		LogSinkRateLimitRefill,
 		APIPort,
 		APIPortOpenDelay,
 		AutocertDNSNameKey,
@@ -363,8 +476,11 @@ var (
 		SetNUMAControlPolicyKey,
 		StatePort,
 		MongoMemoryProfile,
+		StateStorageBackend,
 		JujuDBSnapChannel,
 		MaxDebugLogDuration,
+		ModelHealthEndpoint,
+		CharmAttestationPolicy,
 		MaxTxnLogSize,
 		MaxPruneTxnBatchSize,
 		MaxPruneTxnPasses,
@@ -373,6 +489,7 @@ var (
 		ModelLogsSize,
 		PruneTxnQueryCount,
 		PruneTxnSleepTime,
+		WatcherCoalesceWindow,
 		PublicDNSAddress,
 		JujuHASpace,
 		JujuManagementSpace,
@@ -387,6 +504,10 @@ var (
 		MeteringURL,
 		MaxCharmStateSize,
 		MaxAgentStateSize,
+		MaxRelationSettingsSize,
+		ControllerResourceDownloadLimit,
+		ApplicationResourceDownloadLimit,
+		BackupStorageURL,
 		NonSyncedWritesToRaftLog,
 		MigrationMinionWaitMax,
 	}
@@ -409,6 +530,9 @@ var (
 	AllowedUpdateConfigAttributes = set.NewStrings(
 		AgentRateLimitMax,
 		AgentRateLimitRate,
+		AgentWebsocketCompression,
+		LogSinkRateLimitBurst,
+		LogSinkRateLimitRefill,
 		APIPortOpenDelay,
 		AuditingEnabled,
 		AuditLogCaptureArgs,
@@ -417,21 +541,29 @@ var (
 		// more like api-port.
 		ControllerAPIPort,
 		ControllerName,
+		CharmAttestationPolicy,
 		MaxDebugLogDuration,
 		MaxPruneTxnBatchSize,
 		MaxPruneTxnPasses,
+		ModelHealthEndpoint,
 		ModelLogfileMaxBackups,
 		ModelLogfileMaxSize,
 		ModelLogsSize,
 		MongoMemoryProfile,
+		StateStorageBackend,
 		PruneTxnQueryCount,
 		PruneTxnSleepTime,
+		WatcherCoalesceWindow,
 		PublicDNSAddress,
 		JujuHASpace,
 		JujuManagementSpace,
 		Features,
 		MaxCharmStateSize,
 		MaxAgentStateSize,
+		MaxRelationSettingsSize,
+		ControllerResourceDownloadLimit,
+		ApplicationResourceDownloadLimit,
+		BackupStorageURL,
 		NonSyncedWritesToRaftLog,
 		MigrationMinionWaitMax,
 	)
@@ -604,6 +736,33 @@ func (c Config) AgentRateLimitRate() time.Duration {
 	return c.durationOrDefault(AgentRateLimitRate, DefaultAgentRateLimitRate)
 }
 
+// AgentWebsocketCompression returns whether the API server offers
+// permessage-deflate compression on API connections.
+func (c Config) AgentWebsocketCompression() bool {
+	value, _ := c[AgentWebsocketCompression].(bool)
+	return value
+}
+
+// LogSinkRateLimit returns the burst and refill settings used to rate
+// limit each agent's writes to the logsink endpoint, and reports
+// whether either has been set in the config. When neither is set the
+// API server falls back to the values in its agent configuration.
+func (c Config) LogSinkRateLimit() (burst int64, refill time.Duration, ok bool) {
+	burst = DefaultLogSinkRateLimitBurst
+	refill = DefaultLogSinkRateLimitRefill
+	switch v := c[LogSinkRateLimitBurst].(type) {
+	case float64:
+		burst, ok = int64(v), true
+	case int:
+		burst, ok = int64(v), true
+	}
+	if _, found := c[LogSinkRateLimitRefill]; found {
+		refill = c.durationOrDefault(LogSinkRateLimitRefill, DefaultLogSinkRateLimitRefill)
+		ok = true
+	}
+	return burst, refill, ok
+}
+
 // AuditingEnabled returns whether or not auditing has been enabled
 // for the environment. The default is false.
 func (c Config) AuditingEnabled() bool {
@@ -734,11 +893,38 @@ func (c Config) MongoMemoryProfile() string {
 	return DefaultMongoMemoryProfile
 }
 
+// StateStorageBackend returns the document store used for the newest
+// state subsystems.
+func (c Config) StateStorageBackend() string {
+	if backend, ok := c[StateStorageBackend]; ok {
+		return backend.(string)
+	}
+	return DefaultStateStorageBackend
+}
+
 // JujuDBSnapChannel returns the channel for installing mongo snaps.
 func (c Config) JujuDBSnapChannel() string {
 	return c.asString(JujuDBSnapChannel)
 }
 
+// ModelHealthEndpointEnabled returns whether the apiserver exposes the
+// unauthenticated per-model health endpoint.
+func (c Config) ModelHealthEndpointEnabled() bool {
+	if v, ok := c[ModelHealthEndpoint]; ok {
+		return v.(bool)
+	}
+	return DefaultModelHealthEndpoint
+}
+
+// CharmAttestationPolicy returns the enforcement level applied to
+// store-provided hash attestations on downloaded charm blobs.
+func (c Config) CharmAttestationPolicy() string {
+	if v, ok := c[CharmAttestationPolicy]; ok {
+		return v.(string)
+	}
+	return DefaultCharmAttestationPolicy
+}
+
 // NUMACtlPreference returns if numactl is preferred.
 func (c Config) NUMACtlPreference() bool {
 	if numa, ok := c[SetNUMAControlPolicyKey]; ok {
@@ -816,6 +1002,22 @@ func (c Config) PruneTxnSleepTime() time.Duration {
 	return val
 }
 
+// WatcherCoalesceWindow is the amount of time the state watcher keeps
+// draining incoming document changes before notifying watchers. A zero
+// value disables coalescing.
+func (c Config) WatcherCoalesceWindow() time.Duration {
+	asInterface, ok := c[WatcherCoalesceWindow]
+	if !ok {
+		asInterface = DefaultWatcherCoalesceWindow
+	}
+	asStr, ok := asInterface.(string)
+	if !ok {
+		asStr = DefaultWatcherCoalesceWindow
+	}
+	val, _ := time.ParseDuration(asStr)
+	return val
+}
+
 // PublicDNSAddress returns the DNS name of the controller.
 func (c Config) PublicDNSAddress() string {
 	return c.asString(PublicDNSAddress)
@@ -907,6 +1109,34 @@ func (c Config) MaxAgentStateSize() int {
 	return c.intOrDefault(MaxAgentStateSize, DefaultMaxAgentStateSize)
 }
 
+// ControllerResourceDownloadLimit returns the max number of concurrent
+// resource downloads served to unit agents across the controller. A
+// value of zero indicates no limit.
+func (c Config) ControllerResourceDownloadLimit() int {
+	return c.intOrDefault(ControllerResourceDownloadLimit, DefaultControllerResourceDownloadLimit)
+}
+
+// ApplicationResourceDownloadLimit returns the max number of concurrent
+// resource downloads served to the unit agents of one application. A
+// value of zero indicates no limit.
+func (c Config) ApplicationResourceDownloadLimit() int {
+	return c.intOrDefault(ApplicationResourceDownloadLimit, DefaultApplicationResourceDownloadLimit)
+}
+
+// BackupStorageURL returns the URL of the object store to which
+// backup archives are streamed, or empty if backups are only kept on
+// the controller disk.
+func (c Config) BackupStorageURL() string {
+	return c.asString(BackupStorageURL)
+}
+
+// MaxRelationSettingsSize returns the max size (in bytes) of unit or
+// application settings data that charms can store into a single relation.
+// A value of zero indicates no limit.
+func (c Config) MaxRelationSettingsSize() int {
+	return c.intOrDefault(MaxRelationSettingsSize, DefaultMaxRelationSettingsSize)
+}
+
 // NonSyncedWritesToRaftLog returns true if fsync calls should be skipped
 // after each write to the raft log.
 func (c Config) NonSyncedWritesToRaftLog() bool {
@@ -985,6 +1215,16 @@ func Validate(c Config) error {
 			return errors.Errorf("%s must be between 0..1m", AgentRateLimitRate)
 		}
 	}
+	if v, ok := c[LogSinkRateLimitBurst].(int); ok {
+		if v <= 0 {
+			return errors.NotValidf("non-positive %s (%d)", LogSinkRateLimitBurst, v)
+		}
+	}
+	if v, ok := c[LogSinkRateLimitRefill].(time.Duration); ok {
+		if v <= 0 {
+			return errors.Errorf("%s must be a positive duration", LogSinkRateLimitRefill)
+		}
+	}
 
 	if mgoMemProfile, ok := c[MongoMemoryProfile].(string); ok {
 		if mgoMemProfile != MongoProfLow && mgoMemProfile != MongoProfDefault {
@@ -992,6 +1232,20 @@ func Validate(c Config) error {
 		}
 	}
 
+	if backend, ok := c[StateStorageBackend].(string); ok {
+		if backend != StateStorageBackendMongo && backend != StateStorageBackendDqlite {
+			return errors.Errorf("%s: expected one of %q or %q got string(%q)", StateStorageBackend, StateStorageBackendMongo, StateStorageBackendDqlite, backend)
+		}
+	}
+
+	if policy, ok := c[CharmAttestationPolicy].(string); ok {
+		switch policy {
+		case "ignore", "verify", "strict":
+		default:
+			return errors.Errorf("%s: expected one of %q, %q or %q got string(%q)", CharmAttestationPolicy, "ignore", "verify", "strict", policy)
+		}
+	}
+
 	if v, ok := c[MaxDebugLogDuration].(time.Duration); ok {
 		if v == 0 {
 			return errors.Errorf("%s cannot be zero", MaxDebugLogDuration)
@@ -1035,6 +1289,16 @@ func Validate(c Config) error {
 		}
 	}
 
+	if v, ok := c[WatcherCoalesceWindow].(string); ok {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return errors.Annotatef(err, `%s must be a valid duration (eg "10ms")`, WatcherCoalesceWindow)
+		}
+		if d < 0 {
+			return errors.Errorf("%s must not be negative", WatcherCoalesceWindow)
+		}
+	}
+
 	if err := c.validateSpaceConfig(JujuHASpace, "juju HA"); err != nil {
 		return errors.Trace(err)
 	}
@@ -1132,6 +1396,27 @@ func Validate(c Config) error {
 		maxUnitStateSize += DefaultMaxAgentStateSize
 	}
 
+	if v, ok := c[MaxRelationSettingsSize].(int); ok && v < 0 {
+		return errors.Errorf("invalid max relation settings size: should be a number of bytes (or 0 to disable limit), got %d", v)
+	}
+
+	if v, ok := c[BackupStorageURL].(string); ok && v != "" {
+		u, err := url.Parse(v)
+		if err != nil {
+			return errors.Annotate(err, "invalid backup storage URL")
+		}
+		if u.Scheme == "" {
+			return errors.Errorf("invalid backup storage URL %q: missing scheme", v)
+		}
+	}
+
+	if v, ok := c[ControllerResourceDownloadLimit].(int); ok && v < 0 {
+		return errors.Errorf("invalid controller resource download limit: should be a number of downloads (or 0 to disable limit), got %d", v)
+	}
+	if v, ok := c[ApplicationResourceDownloadLimit].(int); ok && v < 0 {
+		return errors.Errorf("invalid application resource download limit: should be a number of downloads (or 0 to disable limit), got %d", v)
+	}
+
 	if mongoMax := 16 * 1024 * 1024; maxUnitStateSize > mongoMax {
 		return errors.Errorf("invalid max charm/agent state sizes: combined value should not exceed mongo's 16M per-document limit, got %d", maxUnitStateSize)
 	}
@@ -1196,90 +1481,130 @@ func (c Config) AsSpaceConstraints(spaces *[]string) *[]string {
 }
 
 var configChecker = schema.FieldMap(schema.Fields{
-	AgentRateLimitMax:        schema.ForceInt(),
-	AgentRateLimitRate:       schema.TimeDuration(),
-	AuditingEnabled:          schema.Bool(),
-	AuditLogCaptureArgs:      schema.Bool(),
-	AuditLogMaxSize:          schema.String(),
-	AuditLogMaxBackups:       schema.ForceInt(),
-	AuditLogExcludeMethods:   schema.List(schema.String()),
-	APIPort:                  schema.ForceInt(),
-	APIPortOpenDelay:         schema.String(),
-	ControllerAPIPort:        schema.ForceInt(),
-	ControllerName:           schema.String(),
-	StatePort:                schema.ForceInt(),
-	IdentityURL:              schema.String(),
-	IdentityPublicKey:        schema.String(),
-	SetNUMAControlPolicyKey:  schema.Bool(),
-	AutocertURLKey:           schema.String(),
-	AutocertDNSNameKey:       schema.String(),
-	AllowModelAccessKey:      schema.Bool(),
-	MongoMemoryProfile:       schema.String(),
-	JujuDBSnapChannel:        schema.String(),
-	MaxDebugLogDuration:      schema.TimeDuration(),
-	MaxTxnLogSize:            schema.String(),
-	MaxPruneTxnBatchSize:     schema.ForceInt(),
-	MaxPruneTxnPasses:        schema.ForceInt(),
-	ModelLogfileMaxBackups:   schema.ForceInt(),
-	ModelLogfileMaxSize:      schema.String(),
-	ModelLogsSize:            schema.String(),
-	PruneTxnQueryCount:       schema.ForceInt(),
-	PruneTxnSleepTime:        schema.String(),
-	PublicDNSAddress:         schema.String(),
-	JujuHASpace:              schema.String(),
-	JujuManagementSpace:      schema.String(),
-	CAASOperatorImagePath:    schema.String(),
-	CAASImageRepo:            schema.String(),
-	Features:                 schema.List(schema.String()),
-	CharmStoreURL:            schema.String(),
-	MeteringURL:              schema.String(),
-	MaxCharmStateSize:        schema.ForceInt(),
-	MaxAgentStateSize:        schema.ForceInt(),
-	NonSyncedWritesToRaftLog: schema.Bool(),
-	MigrationMinionWaitMax:   schema.String(),
-}, schema.Defaults{
-	AgentRateLimitMax:        schema.Omit,
-	AgentRateLimitRate:       schema.Omit,
-	APIPort:                  DefaultAPIPort,
-	APIPortOpenDelay:         DefaultAPIPortOpenDelay,
-	ControllerAPIPort:        schema.Omit,
-	ControllerName:           schema.Omit,
-	AuditingEnabled:          DefaultAuditingEnabled,
-	AuditLogCaptureArgs:      DefaultAuditLogCaptureArgs,
-	AuditLogMaxSize:          fmt.Sprintf("%vM", DefaultAuditLogMaxSizeMB),
-	AuditLogMaxBackups:       DefaultAuditLogMaxBackups,
-	AuditLogExcludeMethods:   DefaultAuditLogExcludeMethods,
-	StatePort:                DefaultStatePort,
-	IdentityURL:              schema.Omit,
-	IdentityPublicKey:        schema.Omit,
-	SetNUMAControlPolicyKey:  DefaultNUMAControlPolicy,
-	AutocertURLKey:           schema.Omit,
-	AutocertDNSNameKey:       schema.Omit,
-	AllowModelAccessKey:      schema.Omit,
-	MongoMemoryProfile:       DefaultMongoMemoryProfile,
-	JujuDBSnapChannel:        DefaultJujuDBSnapChannel,
-	MaxDebugLogDuration:      DefaultMaxDebugLogDuration,
-	MaxTxnLogSize:            fmt.Sprintf("%vM", DefaultMaxTxnLogCollectionMB),
-	MaxPruneTxnBatchSize:     DefaultMaxPruneTxnBatchSize,
-	MaxPruneTxnPasses:        DefaultMaxPruneTxnPasses,
-	ModelLogfileMaxBackups:   DefaultModelLogfileMaxBackups,
-	ModelLogfileMaxSize:      fmt.Sprintf("%vM", DefaultModelLogfileMaxSize),
-	ModelLogsSize:            fmt.Sprintf("%vM", DefaultModelLogsSizeMB),
-	PruneTxnQueryCount:       DefaultPruneTxnQueryCount,
-	PruneTxnSleepTime:        DefaultPruneTxnSleepTime,
-	PublicDNSAddress:         schema.Omit,
-	JujuHASpace:              schema.Omit,
-	JujuManagementSpace:      schema.Omit,
-	CAASOperatorImagePath:    schema.Omit,
-	CAASImageRepo:            schema.Omit,
-	Features:                 schema.Omit,
-	CharmStoreURL:            csclient.ServerURL,
-	MeteringURL:              romulus.DefaultAPIRoot,
-	MaxCharmStateSize:        DefaultMaxCharmStateSize,
-	MaxAgentStateSize:        DefaultMaxAgentStateSize,
-	NonSyncedWritesToRaftLog: DefaultNonSyncedWritesToRaftLog,
-	MigrationMinionWaitMax:   DefaultMigrationMinionWaitMax,
-})
+	AgentRateLimitMax:                schema.ForceInt(),
+	AgentWebsocketCompression:        schema.Bool(),
+	AgentRateLimitRate:               schema.TimeDuration(),
+	LogSinkRateLimitBurst:            schema.ForceInt(),
+	LogSinkRateLimitRefill:           schema.TimeDuration(),
+	AuditingEnabled:                  schema.Bool(),
+	AuditLogCaptureArgs:              schema.Bool(),
+	AuditLogMaxSize:                  schema.String(),
+	AuditLogMaxBackups:               schema.ForceInt(),
+	AuditLogExcludeMethods:           schema.List(schema.String()),
+	APIPort:                          schema.ForceInt(),
+	APIPortOpenDelay:                 schema.String(),
+	ControllerAPIPort:                schema.ForceInt(),
+	ControllerName:                   schema.String(),
+	StatePort:                        schema.ForceInt(),
+	IdentityURL:                      schema.String(),
+	IdentityPublicKey:                schema.String(),
+	SetNUMAControlPolicyKey:          schema.Bool(),
+	AutocertURLKey:                   schema.String(),
+	AutocertDNSNameKey:               schema.String(),
+	AllowModelAccessKey:              schema.Bool(),
+	MongoMemoryProfile:               schema.String(),
+	StateStorageBackend:              schema.String(),
+	JujuDBSnapChannel:                schema.String(),
+	ModelHealthEndpoint:              schema.Bool(),
+	CharmAttestationPolicy:           schema.String(),
+	MaxDebugLogDuration:              schema.TimeDuration(),
+	MaxTxnLogSize:                    schema.String(),
+	MaxPruneTxnBatchSize:             schema.ForceInt(),
+	MaxPruneTxnPasses:                schema.ForceInt(),
+	ModelLogfileMaxBackups:           schema.ForceInt(),
+	ModelLogfileMaxSize:              schema.String(),
+	ModelLogsSize:                    schema.String(),
+	PruneTxnQueryCount:               schema.ForceInt(),
+	PruneTxnSleepTime:                schema.String(),
+	WatcherCoalesceWindow:            schema.String(),
+	PublicDNSAddress:                 schema.String(),
+	JujuHASpace:                      schema.String(),
+	JujuManagementSpace:              schema.String(),
+	CAASOperatorImagePath:            schema.String(),
+	CAASImageRepo:                    schema.String(),
+	Features:                         schema.List(schema.String()),
+	CharmStoreURL:                    schema.String(),
+	MeteringURL:                      schema.String(),
+	MaxCharmStateSize:                schema.ForceInt(),
+	MaxAgentStateSize:                schema.ForceInt(),
+	MaxRelationSettingsSize:          schema.ForceInt(),
+	ControllerResourceDownloadLimit:  schema.ForceInt(),
+	BackupStorageURL:                 schema.String(),
+	ApplicationResourceDownloadLimit: schema.ForceInt(),
+	NonSyncedWritesToRaftLog:         schema.Bool(),
+	MigrationMinionWaitMax:           schema.String(),
+}, configDefaults)
+
+// configDefaults holds the default values used when coercing
+// controller config. Attributes that have no usable default
+// are omitted.
+var configDefaults = schema.Defaults{
+	AgentRateLimitMax:                schema.Omit,
+	AgentWebsocketCompression:        schema.Omit,
+	AgentRateLimitRate:               schema.Omit,
+	LogSinkRateLimitBurst:            schema.Omit,
+	LogSinkRateLimitRefill:           schema.Omit,
+	APIPort:                          DefaultAPIPort,
+	APIPortOpenDelay:                 DefaultAPIPortOpenDelay,
+	ControllerAPIPort:                schema.Omit,
+	ControllerName:                   schema.Omit,
+	AuditingEnabled:                  DefaultAuditingEnabled,
+	AuditLogCaptureArgs:              DefaultAuditLogCaptureArgs,
+	AuditLogMaxSize:                  fmt.Sprintf("%vM", DefaultAuditLogMaxSizeMB),
+	AuditLogMaxBackups:               DefaultAuditLogMaxBackups,
+	AuditLogExcludeMethods:           DefaultAuditLogExcludeMethods,
+	StatePort:                        DefaultStatePort,
+	IdentityURL:                      schema.Omit,
+	IdentityPublicKey:                schema.Omit,
+	SetNUMAControlPolicyKey:          DefaultNUMAControlPolicy,
+	AutocertURLKey:                   schema.Omit,
+	AutocertDNSNameKey:               schema.Omit,
+	AllowModelAccessKey:              schema.Omit,
+	MongoMemoryProfile:               DefaultMongoMemoryProfile,
+	StateStorageBackend:              DefaultStateStorageBackend,
+	JujuDBSnapChannel:                DefaultJujuDBSnapChannel,
+	ModelHealthEndpoint:              DefaultModelHealthEndpoint,
+	CharmAttestationPolicy:           DefaultCharmAttestationPolicy,
+	MaxDebugLogDuration:              DefaultMaxDebugLogDuration,
+	MaxTxnLogSize:                    fmt.Sprintf("%vM", DefaultMaxTxnLogCollectionMB),
+	MaxPruneTxnBatchSize:             DefaultMaxPruneTxnBatchSize,
+	MaxPruneTxnPasses:                DefaultMaxPruneTxnPasses,
+	ModelLogfileMaxBackups:           DefaultModelLogfileMaxBackups,
+	ModelLogfileMaxSize:              fmt.Sprintf("%vM", DefaultModelLogfileMaxSize),
+	ModelLogsSize:                    fmt.Sprintf("%vM", DefaultModelLogsSizeMB),
+	PruneTxnQueryCount:               DefaultPruneTxnQueryCount,
+	PruneTxnSleepTime:                DefaultPruneTxnSleepTime,
+	WatcherCoalesceWindow:            DefaultWatcherCoalesceWindow,
+	PublicDNSAddress:                 schema.Omit,
+	JujuHASpace:                      schema.Omit,
+	JujuManagementSpace:              schema.Omit,
+	CAASOperatorImagePath:            schema.Omit,
+	CAASImageRepo:                    schema.Omit,
+	Features:                         schema.Omit,
+	CharmStoreURL:                    csclient.ServerURL,
+	MeteringURL:                      romulus.DefaultAPIRoot,
+	MaxCharmStateSize:                DefaultMaxCharmStateSize,
+	MaxAgentStateSize:                DefaultMaxAgentStateSize,
+	MaxRelationSettingsSize:          DefaultMaxRelationSettingsSize,
+	ControllerResourceDownloadLimit:  DefaultControllerResourceDownloadLimit,
+	BackupStorageURL:                 schema.Omit,
+	ApplicationResourceDownloadLimit: DefaultApplicationResourceDownloadLimit,
+	NonSyncedWritesToRaftLog:         DefaultNonSyncedWritesToRaftLog,
+	MigrationMinionWaitMax:           DefaultMigrationMinionWaitMax,
+}
+
+// ConfigDefaults returns the default values for controller config
+// attributes that have one.
+func ConfigDefaults() map[string]interface{} {
+	defaults := make(map[string]interface{})
+	for name, value := range configDefaults {
+		if value == schema.Omit {
+			continue
+		}
+		defaults[name] = value
+	}
+	return defaults
+}
 
 // ConfigSchema holds information on all the fields defined by
 // the config package.
@@ -1292,6 +1617,18 @@ var ConfigSchema = environschema.Fields{
 		Description: "The time taken to add a new token to the ratelimit bucket",
 		Type:        environschema.Tstring,
 	},
+	AgentWebsocketCompression: {
+		Description: "Whether the API server offers permessage-deflate compression on API connections",
+		Type:        environschema.Tbool,
+	},
+	LogSinkRateLimitBurst: {
+		Description: "The number of log messages an agent can send before being rate limited",
+		Type:        environschema.Tint,
+	},
+	LogSinkRateLimitRefill: {
+		Description: "The time taken to let another log message through once an agent's burst is used up",
+		Type:        environschema.Tstring,
+	},
 	AuditingEnabled: {
 		Description: "Determines if the controller records auditing information",
 		Type:        environschema.Tbool,
@@ -1365,6 +1702,18 @@ they don't have any access rights to the controller itself`,
 		Type:        environschema.Tstring,
 		Description: `Sets mongo memory profile`,
 	},
+	StateStorageBackend: {
+		Type:        environschema.Tstring,
+		Description: `The storage backend used for state documents that have been migrated off mongo`,
+	},
+	ModelHealthEndpoint: {
+		Type:        environschema.Tbool,
+		Description: `Whether the unauthenticated per-model health endpoint is exposed`,
+	},
+	CharmAttestationPolicy: {
+		Type:        environschema.Tstring,
+		Description: `The enforcement level for store-provided hash attestations on downloaded charms (ignore, verify or strict)`,
+	},
 	JujuDBSnapChannel: {
 		Type:        environschema.Tstring,
 		Description: `Sets channel for installing mongo snaps when bootstrapping on focal or later`,
@@ -1405,6 +1754,10 @@ they don't have any access rights to the controller itself`,
 		Type:        environschema.Tstring,
 		Description: `The amount of time to sleep between processing each batch query`,
 	},
+	WatcherCoalesceWindow: {
+		Type:        environschema.Tstring,
+		Description: `The amount of time the state watcher folds further document changes into a single notification; 0 disables coalescing`,
+	},
 	PublicDNSAddress: {
 		Type:        environschema.Tstring,
 		Description: `Public DNS address (with port) of the controller.`,
@@ -1446,6 +1799,22 @@ Use "caas-image-repo" instead.`,
 		Type:        environschema.Tint,
 		Description: `The maximum size (in bytes) of internal state data that agents can store to the controller`,
 	},
+	MaxRelationSettingsSize: {
+		Type:        environschema.Tint,
+		Description: `The maximum size (in bytes) of unit or application settings data that charms can store into a single relation`,
+	},
+	ControllerResourceDownloadLimit: {
+		Type:        environschema.Tint,
+		Description: `The maximum number of concurrent resource downloads served to unit agents across the controller`,
+	},
+	BackupStorageURL: {
+		Type:        environschema.Tstring,
+		Description: `The URL of an object store to which controller backup archives are streamed`,
+	},
+	ApplicationResourceDownloadLimit: {
+		Type:        environschema.Tint,
+		Description: `The maximum number of concurrent resource downloads served to the unit agents of one application`,
+	},
 	NonSyncedWritesToRaftLog: {
 		Type:        environschema.Tbool,
 		Description: `Do not perform fsync calls after appending entries to the raft log. Disabling sync improves performance at the cost of reliability`,
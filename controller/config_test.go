@@ -249,6 +249,18 @@ var newConfigTests = []struct {
 			controller.PruneTxnSleepTime: "15",
 		},
 		expectError: `prune-txn-sleep-time must be a valid duration \(eg "10ms"\): time: missing unit in duration "?15"?`,
+	}, {
+		about: "watcher-coalesce-window not a duration",
+		config: controller.Config{
+			controller.WatcherCoalesceWindow: "15",
+		},
+		expectError: `watcher-coalesce-window must be a valid duration \(eg "10ms"\): time: missing unit in duration "?15"?`,
+	}, {
+		about: "watcher-coalesce-window negative",
+		config: controller.Config{
+			controller.WatcherCoalesceWindow: "-10ms",
+		},
+		expectError: `watcher-coalesce-window must not be negative`,
 	}, {
 		about: "mongo-memory-profile not valid",
 		config: controller.Config{
@@ -315,6 +327,18 @@ var newConfigTests = []struct {
 			controller.AgentRateLimitRate: "4h",
 		},
 		expectError: `agent-ratelimit-rate must be between 0..1m`,
+	}, {
+		about: "logsink-ratelimit-burst zero",
+		config: controller.Config{
+			controller.LogSinkRateLimitBurst: "0",
+		},
+		expectError: `non-positive logsink-ratelimit-burst \(0\) not valid`,
+	}, {
+		about: "logsink-ratelimit-refill negative",
+		config: controller.Config{
+			controller.LogSinkRateLimitRefill: "-5s",
+		},
+		expectError: `logsink-ratelimit-refill must be a positive duration`,
 	}, {
 		about: "max-charm-state-size non-int",
 		config: controller.Config{
@@ -459,6 +483,26 @@ func (s *ConfigSuite) TestPruneTxnQueryCount(c *gc.C) {
 	c.Check(cfg.PruneTxnSleepTime(), gc.Equals, 5*time.Millisecond)
 }
 
+func (s *ConfigSuite) TestWatcherCoalesceWindow(c *gc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]interface{}{},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cfg.WatcherCoalesceWindow(), gc.Equals, time.Duration(0))
+
+	cfg, err = controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]interface{}{
+			"watcher-coalesce-window": "10ms",
+		},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cfg.WatcherCoalesceWindow(), gc.Equals, 10*time.Millisecond)
+}
+
 func (s *ConfigSuite) TestPublicDNSAddressConfigValue(c *gc.C) {
 	cfg, err := controller.NewConfig(
 		testing.ControllerTag.Id(),
@@ -831,6 +875,60 @@ func (s *ConfigSuite) TestAgentRateLimitRate(c *gc.C) {
 	c.Assert(cfg.AgentRateLimitRate(), gc.Equals, 500*time.Millisecond)
 }
 
+func (s *ConfigSuite) TestAgentWebsocketCompression(c *gc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.AgentWebsocketCompression(), jc.IsFalse)
+
+	cfg, err = controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]interface{}{
+			"agent-websocket-compression": true,
+		},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.AgentWebsocketCompression(), jc.IsTrue)
+}
+
+func (s *ConfigSuite) TestLogSinkRateLimit(c *gc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	burst, refill, ok := cfg.LogSinkRateLimit()
+	c.Assert(ok, jc.IsFalse)
+	c.Assert(burst, gc.Equals, int64(controller.DefaultLogSinkRateLimitBurst))
+	c.Assert(refill, gc.Equals, controller.DefaultLogSinkRateLimitRefill)
+
+	cfg[controller.LogSinkRateLimitBurst] = 500
+	burst, refill, ok = cfg.LogSinkRateLimit()
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(burst, gc.Equals, int64(500))
+	c.Assert(refill, gc.Equals, controller.DefaultLogSinkRateLimitRefill)
+
+	cfg[controller.LogSinkRateLimitRefill] = "5ms"
+	burst, refill, ok = cfg.LogSinkRateLimit()
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(burst, gc.Equals, int64(500))
+	c.Assert(refill, gc.Equals, 5*time.Millisecond)
+}
+
+func (s *ConfigSuite) TestConfigDefaults(c *gc.C) {
+	defaults := controller.ConfigDefaults()
+	c.Assert(defaults[controller.APIPort], gc.Equals, controller.DefaultAPIPort)
+	c.Assert(defaults[controller.AuditLogMaxBackups], gc.Equals, controller.DefaultAuditLogMaxBackups)
+	c.Assert(defaults[controller.AuditingEnabled], gc.Equals, controller.DefaultAuditingEnabled)
+
+	// Attributes without a usable default are not reported.
+	_, ok := defaults[controller.AgentRateLimitMax]
+	c.Assert(ok, jc.IsFalse)
+	_, ok = defaults[controller.IdentityURL]
+	c.Assert(ok, jc.IsFalse)
+}
+
 func (s *ConfigSuite) TestJujuDBSnapChannel(c *gc.C) {
 	cfg, err := controller.NewConfig(
 		testing.ControllerTag.Id(),
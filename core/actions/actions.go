@@ -29,6 +29,16 @@ func HasJujuExecAction(commands string) bool {
 	return strings.Contains(commands, JujuExecActionName) || strings.Contains(commands, legacyJujuRunActionName)
 }
 
+// JujuDiagnoseNetworkActionName defines the name of the predefined
+// network diagnostics action run by machine agents.
+const JujuDiagnoseNetworkActionName = "juju-diagnose-network"
+
+// IsJujuDiagnoseNetworkAction returns true if name is the
+// "juju-diagnose-network" action.
+func IsJujuDiagnoseNetworkAction(name string) bool {
+	return name == JujuDiagnoseNetworkActionName
+}
+
 // PredefinedActionsSpec defines a spec for each predefined action.
 var PredefinedActionsSpec = map[string]charm.ActionSpec{
 	JujuExecActionName: {
@@ -52,6 +62,35 @@ var PredefinedActionsSpec = map[string]charm.ActionSpec{
 					"type":        "boolean",
 					"description": "run the command in k8s workload context",
 				},
+				"output-limit": map[string]interface{}{
+					"type":        "number",
+					"description": "maximum size in bytes of captured stdout and stderr, 0 for no limit",
+				},
+				"capture-files": map[string]interface{}{
+					"type":        "array",
+					"items":       map[string]interface{}{"type": "string"},
+					"description": "files to capture from the target after the command completes",
+				},
+			},
+		},
+	},
+	JujuDiagnoseNetworkActionName: {
+		Description: "predefined juju-diagnose-network action",
+		Parallel:    true,
+		Params: map[string]interface{}{
+			"type":        "object",
+			"title":       JujuDiagnoseNetworkActionName,
+			"description": "predefined juju-diagnose-network action params",
+			"properties": map[string]interface{}{
+				"endpoints": map[string]interface{}{
+					"type":        "array",
+					"items":       map[string]interface{}{"type": "string"},
+					"description": "extra host:port endpoints to check in addition to the defaults",
+				},
+				"timeout": map[string]interface{}{
+					"type":        "number",
+					"description": "timeout for each connectivity check",
+				},
 			},
 		},
 	},
@@ -4,6 +4,9 @@
 package downloader
 
 import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
 	"io"
 	"io/ioutil"
 	"net/url"
@@ -13,7 +16,6 @@ import (
 	"github.com/juju/charm/v9"
 	"github.com/juju/errors"
 	"github.com/juju/os/v2/series"
-	"github.com/juju/utils/v2"
 	"gopkg.in/macaroon.v2"
 
 	"github.com/juju/juju/core/arch"
@@ -22,6 +24,24 @@ import (
 	"github.com/juju/juju/version"
 )
 
+// AttestationPolicy controls how store-provided hash attestations are
+// treated when verifying downloaded charm blobs.
+type AttestationPolicy string
+
+const (
+	// AttestationIgnore skips attestation checks entirely.
+	AttestationIgnore AttestationPolicy = "ignore"
+
+	// AttestationVerify checks downloaded blobs against the hash
+	// attestation when the store provides one, and accepts blobs for
+	// which no attestation is available.
+	AttestationVerify AttestationPolicy = "verify"
+
+	// AttestationStrict requires the store to provide a hash
+	// attestation and rejects downloads without one.
+	AttestationStrict AttestationPolicy = "strict"
+)
+
 // Logger defines the logging methods that the package uses.
 type Logger interface {
 	Tracef(string, ...interface{})
@@ -69,6 +89,11 @@ type DownloadedCharm struct {
 	// SHA256 is the hash of the bytes in Data.
 	SHA256 string
 
+	// SHA384 is the hash the blobstore uses to content-address blobs.
+	// When set, storage implementations may use it to reference an
+	// already-stored copy of the blob instead of writing a new one.
+	SHA384 string
+
 	// The LXD profile or nil if no profile specified by the charm.
 	LXDProfile *charm.LXDProfile
 
@@ -76,9 +101,10 @@ type DownloadedCharm struct {
 	Macaroons macaroon.Slice
 }
 
-// verify checks that the charm is compatible with the specified Juju version
-// and ensure that the LXDProfile (if one is specified) is valid.
-func (dc DownloadedCharm) verify(downloadOrigin corecharm.Origin, force bool) error {
+// verify checks that the charm is compatible with the specified Juju version,
+// ensures that the LXDProfile (if one is specified) is valid and applies the
+// given attestation policy to the store-provided hash attestation.
+func (dc DownloadedCharm) verify(downloadOrigin corecharm.Origin, force bool, policy AttestationPolicy) error {
 	if err := version.CheckJujuMinVersion(dc.Charm.Meta().MinJujuVersion, version.Current); err != nil {
 		return errors.Trace(err)
 	}
@@ -89,8 +115,17 @@ func (dc DownloadedCharm) verify(downloadOrigin corecharm.Origin, force bool) er
 		}
 	}
 
-	if downloadOrigin.Hash != "" && downloadOrigin.Hash != dc.SHA256 {
-		return errors.NewNotValid(nil, "detected SHA256 hash mismatch")
+	switch policy {
+	case AttestationIgnore:
+	case AttestationStrict:
+		if downloadOrigin.Hash == "" {
+			return NewCharmVerificationFailedError("no hash attestation provided by the store")
+		}
+		fallthrough
+	default:
+		if downloadOrigin.Hash != "" && downloadOrigin.Hash != dc.SHA256 {
+			return NewCharmVerificationFailedError("detected SHA256 hash mismatch")
+		}
 	}
 
 	return nil
@@ -98,17 +133,20 @@ func (dc DownloadedCharm) verify(downloadOrigin corecharm.Origin, force bool) er
 
 // Downloader implements store-agnostic download and pesistence of charm blobs.
 type Downloader struct {
-	logger     Logger
-	repoGetter RepositoryGetter
-	storage    Storage
+	logger            Logger
+	repoGetter        RepositoryGetter
+	storage           Storage
+	attestationPolicy AttestationPolicy
 }
 
-// NewDownloader returns a new charm downloader instance.
-func NewDownloader(logger Logger, storage Storage, repoGetter RepositoryGetter) *Downloader {
+// NewDownloader returns a new charm downloader instance that verifies
+// downloaded blobs according to the given attestation policy.
+func NewDownloader(logger Logger, storage Storage, repoGetter RepositoryGetter, policy AttestationPolicy) *Downloader {
 	return &Downloader{
-		repoGetter: repoGetter,
-		storage:    storage,
-		logger:     logger,
+		repoGetter:        repoGetter,
+		storage:           storage,
+		logger:            logger,
+		attestationPolicy: policy,
 	}
 }
 
@@ -169,7 +207,7 @@ func (d *Downloader) DownloadAndStore(charmURL *charm.URL, requestedOrigin corec
 	}
 
 	// Validate charm
-	if err := downloadedCharm.verify(actualOrigin, force); err != nil {
+	if err := downloadedCharm.verify(actualOrigin, force, d.attestationPolicy); err != nil {
 		return corecharm.Origin{}, errors.Annotatef(err, "verifying downloaded charm %q from origin %v", charmURL, requestedOrigin)
 	}
 
@@ -196,10 +234,13 @@ func (d *Downloader) downloadAndHash(charmURL *charm.URL, requestedOrigin corech
 	}
 	defer func() { _ = f.Close() }()
 
-	sha, size, err := utils.ReadSHA256(f)
+	sha256hash := sha256.New()
+	sha384hash := sha512.New384()
+	size, err := io.Copy(io.MultiWriter(sha256hash, sha384hash), f)
 	if err != nil {
-		return DownloadedCharm{}, corecharm.Origin{}, errors.Annotate(err, "cannot calculate SHA256 hash of charm")
+		return DownloadedCharm{}, corecharm.Origin{}, errors.Annotate(err, "cannot calculate hash of charm")
 	}
+	sha := hex.EncodeToString(sha256hash.Sum(nil))
 
 	d.logger.Tracef("downloadResult(%q) sha: %q, size: %d", f.Name(), sha, size)
 	return DownloadedCharm{
@@ -208,6 +249,7 @@ func (d *Downloader) downloadAndHash(charmURL *charm.URL, requestedOrigin corech
 		Size:         size,
 		LXDProfile:   chArchive.LXDProfile(),
 		SHA256:       sha,
+		SHA384:       hex.EncodeToString(sha384hash.Sum(nil)),
 		Macaroons:    macaroons,
 	}, actualOrigin, nil
 }
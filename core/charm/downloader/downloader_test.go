@@ -42,7 +42,7 @@ func (s *downloadedCharmVerificationSuite) TestVersionMismatch(c *gc.C) {
 		Charm: charmArchive,
 	}
 
-	err := dc.verify(corecharm.Origin{}, false)
+	err := dc.verify(corecharm.Origin{}, false, AttestationVerify)
 	c.Assert(err, gc.ErrorMatches, ".*min version.*is higher.*")
 }
 
@@ -63,7 +63,7 @@ func (s *downloadedCharmVerificationSuite) TestSHA256CheckSkipping(c *gc.C) {
 		SHA256: "this-is-not-the-hash-that-you-are-looking-for",
 	}
 
-	err := dc.verify(corecharm.Origin{}, false)
+	err := dc.verify(corecharm.Origin{}, false, AttestationVerify)
 	c.Assert(err, jc.ErrorIsNil)
 }
 
@@ -81,8 +81,49 @@ func (s *downloadedCharmVerificationSuite) TestSHA256Mismatch(c *gc.C) {
 		SHA256: "this-is-not-the-hash-that-you-are-looking-for",
 	}
 
-	err := dc.verify(corecharm.Origin{Hash: "the-real-hash"}, false)
+	err := dc.verify(corecharm.Origin{Hash: "the-real-hash"}, false, AttestationVerify)
 	c.Assert(err, gc.ErrorMatches, "detected SHA256 hash mismatch")
+	c.Assert(IsCharmVerificationFailedError(err), jc.IsTrue)
+}
+
+func (s *downloadedCharmVerificationSuite) TestSHA256MismatchIgnored(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	charmArchive := NewMockCharmArchive(ctrl)
+	charmArchive.EXPECT().Meta().Return(&charm.Meta{
+		MinJujuVersion: version.MustParse("0.0.42"),
+	})
+
+	dc := DownloadedCharm{
+		Charm:  charmArchive,
+		SHA256: "this-is-not-the-hash-that-you-are-looking-for",
+	}
+
+	err := dc.verify(corecharm.Origin{Hash: "the-real-hash"}, false, AttestationIgnore)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *downloadedCharmVerificationSuite) TestStrictPolicyRequiresAttestation(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	charmArchive := NewMockCharmArchive(ctrl)
+	charmArchive.EXPECT().Meta().Return(&charm.Meta{
+		MinJujuVersion: version.MustParse("0.0.42"),
+	})
+
+	dc := DownloadedCharm{
+		Charm:  charmArchive,
+		SHA256: "sha256",
+	}
+
+	err := dc.verify(corecharm.Origin{}, false, AttestationStrict)
+	c.Assert(err, gc.ErrorMatches, "no hash attestation provided by the store")
+	c.Assert(IsCharmVerificationFailedError(err), jc.IsTrue)
+
+	err = dc.verify(corecharm.Origin{Hash: "sha256"}, false, AttestationStrict)
+	c.Assert(err, jc.ErrorIsNil)
 }
 
 func (s *downloadedCharmVerificationSuite) TestLXDProfileValidationError(c *gc.C) {
@@ -104,7 +145,7 @@ func (s *downloadedCharmVerificationSuite) TestLXDProfileValidationError(c *gc.C
 		},
 	}
 
-	err := dc.verify(corecharm.Origin{Hash: "sha256"}, false)
+	err := dc.verify(corecharm.Origin{Hash: "sha256"}, false, AttestationVerify)
 	c.Assert(err, gc.ErrorMatches, ".*cannot verify charm-provided LXD profile.*")
 }
 
@@ -275,7 +316,7 @@ func (s *downloaderSuite) setupMocks(c *gc.C) *gomock.Controller {
 }
 
 func (s *downloaderSuite) newDownloader() *Downloader {
-	return NewDownloader(s.logger, s.storage, s.repoGetter)
+	return NewDownloader(s.logger, s.storage, s.repoGetter, AttestationVerify)
 }
 
 func mustParseChannel(c *gc.C, channel string) *charm.Channel {
@@ -5,6 +5,8 @@ package downloader
 
 import (
 	"fmt"
+
+	"github.com/juju/errors"
 )
 
 type errCharmAlreadyStored struct {
@@ -23,3 +25,27 @@ func NewCharmAlreadyStoredError(charmURL string) error {
 		charmURL: charmURL,
 	}
 }
+
+type errCharmVerificationFailed struct {
+	msg string
+}
+
+// Error implements error.
+func (e errCharmVerificationFailed) Error() string {
+	return e.msg
+}
+
+// NewCharmVerificationFailedError creates an error that indicates that a
+// downloaded charm blob failed its attestation checks.
+func NewCharmVerificationFailedError(msg string) error {
+	return errCharmVerificationFailed{
+		msg: msg,
+	}
+}
+
+// IsCharmVerificationFailedError reports whether err was caused by a
+// failed charm attestation check.
+func IsCharmVerificationFailedError(err error) bool {
+	_, ok := errors.Cause(err).(errCharmVerificationFailed)
+	return ok
+}
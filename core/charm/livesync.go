@@ -0,0 +1,144 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package charm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/juju/errors"
+)
+
+// SyncFile describes a single file in a local charm directory for the
+// purposes of live syncing changes to deployed units.
+type SyncFile struct {
+	// Path is the file path relative to the charm root.
+	Path string
+	// SHA256 is the hex-encoded sha256 digest of the file contents.
+	SHA256 string
+	// Size is the file size in bytes.
+	Size int64
+	// Mode is the file mode bits.
+	Mode os.FileMode
+}
+
+// SyncManifest maps charm-relative file paths to their content digests.
+// It is the unit of comparison used by `juju refresh --path --watch` to
+// decide which files need to be pushed to deployed units without a full
+// re-deploy.
+type SyncManifest map[string]SyncFile
+
+// ComputeSyncManifest walks the charm directory at path and returns a
+// manifest of its files. Directories and VCS metadata are excluded; the
+// resulting manifest describes exactly the content a unit would receive.
+func ComputeSyncManifest(path string) (SyncManifest, error) {
+	if path == "" {
+		return nil, errors.New("empty charm path")
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !info.IsDir() {
+		return nil, errors.Errorf("charm path %q is not a directory", path)
+	}
+	manifest := make(SyncManifest)
+	err = filepath.Walk(path, func(file string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		name := fi.Name()
+		if fi.IsDir() {
+			// Skip VCS metadata and build artifacts that never
+			// form part of the deployed charm.
+			if name == ".git" || name == ".bzr" || (name == "build" && file == filepath.Join(path, "build")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(path, file)
+		if err != nil {
+			return err
+		}
+		digest, err := fileSHA256(file)
+		if err != nil {
+			return err
+		}
+		manifest[rel] = SyncFile{
+			Path:   rel,
+			SHA256: digest,
+			Size:   fi.Size(),
+			Mode:   fi.Mode(),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return manifest, nil
+}
+
+// SyncDelta describes the difference between two sync manifests as the
+// sets of files that must be pushed to or removed from a unit to bring
+// it in line with the local charm directory.
+type SyncDelta struct {
+	// Added holds paths present in the new manifest only.
+	Added []string
+	// Modified holds paths present in both manifests whose content
+	// or mode differs.
+	Modified []string
+	// Removed holds paths present in the old manifest only.
+	Removed []string
+}
+
+// IsZero reports whether the delta contains no changes.
+func (d SyncDelta) IsZero() bool {
+	return len(d.Added) == 0 && len(d.Modified) == 0 && len(d.Removed) == 0
+}
+
+// ComputeSyncDelta returns the changes needed to transform the charm
+// content described by old into that described by latest. The returned
+// path slices are sorted for deterministic hook dispatch and logging.
+func ComputeSyncDelta(old, latest SyncManifest) SyncDelta {
+	var delta SyncDelta
+	for path, file := range latest {
+		prev, ok := old[path]
+		if !ok {
+			delta.Added = append(delta.Added, path)
+			continue
+		}
+		if prev.SHA256 != file.SHA256 || prev.Mode != file.Mode {
+			delta.Modified = append(delta.Modified, path)
+		}
+	}
+	for path := range old {
+		if _, ok := latest[path]; !ok {
+			delta.Removed = append(delta.Removed, path)
+		}
+	}
+	sort.Strings(delta.Added)
+	sort.Strings(delta.Modified)
+	sort.Strings(delta.Removed)
+	return delta
+}
+
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	defer func() { _ = f.Close() }()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", errors.Trace(err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
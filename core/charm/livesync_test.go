@@ -0,0 +1,89 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package charm
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+type liveSyncSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&liveSyncSuite{})
+
+func (s *liveSyncSuite) writeFile(c *gc.C, dir, name, content string) {
+	path := filepath.Join(dir, name)
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	c.Assert(err, jc.ErrorIsNil)
+	err = ioutil.WriteFile(path, []byte(content), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *liveSyncSuite) TestComputeSyncManifest(c *gc.C) {
+	dir := c.MkDir()
+	s.writeFile(c, dir, "metadata.yaml", "name: dummy")
+	s.writeFile(c, dir, "hooks/install", "#!/bin/sh")
+	s.writeFile(c, dir, ".git/HEAD", "ref: refs/heads/master")
+
+	manifest, err := ComputeSyncManifest(dir)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(manifest, gc.HasLen, 2)
+	c.Assert(manifest["metadata.yaml"].SHA256, gc.Not(gc.Equals), "")
+	c.Assert(manifest["metadata.yaml"].Size, gc.Equals, int64(len("name: dummy")))
+	_, ok := manifest[filepath.Join(".git", "HEAD")]
+	c.Assert(ok, jc.IsFalse)
+}
+
+func (s *liveSyncSuite) TestComputeSyncManifestEmptyPath(c *gc.C) {
+	_, err := ComputeSyncManifest("")
+	c.Assert(err, gc.ErrorMatches, "empty charm path")
+}
+
+func (s *liveSyncSuite) TestComputeSyncManifestNotADirectory(c *gc.C) {
+	dir := c.MkDir()
+	s.writeFile(c, dir, "metadata.yaml", "name: dummy")
+	_, err := ComputeSyncManifest(filepath.Join(dir, "metadata.yaml"))
+	c.Assert(err, gc.ErrorMatches, `charm path ".*" is not a directory`)
+}
+
+func (s *liveSyncSuite) TestComputeSyncDelta(c *gc.C) {
+	oldDir := c.MkDir()
+	s.writeFile(c, oldDir, "metadata.yaml", "name: dummy")
+	s.writeFile(c, oldDir, "hooks/install", "#!/bin/sh")
+	s.writeFile(c, oldDir, "hooks/stop", "#!/bin/sh")
+
+	newDir := c.MkDir()
+	s.writeFile(c, newDir, "metadata.yaml", "name: dummy")
+	s.writeFile(c, newDir, "hooks/install", "#!/bin/sh\necho changed")
+	s.writeFile(c, newDir, "hooks/start", "#!/bin/sh")
+
+	oldManifest, err := ComputeSyncManifest(oldDir)
+	c.Assert(err, jc.ErrorIsNil)
+	newManifest, err := ComputeSyncManifest(newDir)
+	c.Assert(err, jc.ErrorIsNil)
+
+	delta := ComputeSyncDelta(oldManifest, newManifest)
+	c.Assert(delta.IsZero(), jc.IsFalse)
+	c.Assert(delta.Added, jc.DeepEquals, []string{filepath.Join("hooks", "start")})
+	c.Assert(delta.Modified, jc.DeepEquals, []string{filepath.Join("hooks", "install")})
+	c.Assert(delta.Removed, jc.DeepEquals, []string{filepath.Join("hooks", "stop")})
+}
+
+func (s *liveSyncSuite) TestComputeSyncDeltaNoChanges(c *gc.C) {
+	dir := c.MkDir()
+	s.writeFile(c, dir, "metadata.yaml", "name: dummy")
+
+	manifest, err := ComputeSyncManifest(dir)
+	c.Assert(err, jc.ErrorIsNil)
+
+	delta := ComputeSyncDelta(manifest, manifest)
+	c.Assert(delta.IsZero(), jc.IsTrue)
+}
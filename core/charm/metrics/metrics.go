@@ -17,6 +17,11 @@ const (
 	// Model is used in RequestMetrics
 	Model MetricKey = "model"
 
+	// Charm groups the per-application metrics included in each
+	// RefreshRequestContext. It is only used to name the group in
+	// configuration; it never appears in the request itself.
+	Charm MetricKey = "charm"
+
 	//
 	// Controller and Model, included in the RefreshRequest Metrics.
 	//
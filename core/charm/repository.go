@@ -37,6 +37,10 @@ type Repository interface {
 
 	// ListResources returns a list of resources associated with a given charm.
 	ListResources(*charm.URL, Origin, macaroon.Slice) ([]charmresource.Resource, error)
+
+	// SupportMatrix returns every channel, base and architecture combination
+	// the given charm has been released for.
+	SupportMatrix(*charm.URL, Origin, macaroon.Slice) ([]SupportedRelease, error)
 }
 
 // RepositoryFactory is a factory for charm Repositories.
@@ -60,6 +64,13 @@ type MetadataRequest struct {
 	Macaroons macaroon.Slice
 }
 
+// SupportedRelease describes a single channel, base and architecture
+// combination that a charm has been released for.
+type SupportedRelease struct {
+	Channel  string
+	Platform Platform
+}
+
 // EssentialMetadata encapsulates the essential metadata required for deploying
 // a particular charm.
 type EssentialMetadata struct {
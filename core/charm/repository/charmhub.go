@@ -10,6 +10,7 @@ import (
 	"io/ioutil"
 	"net/url"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/juju/charm/v9"
@@ -341,6 +342,73 @@ func (c *CharmHubRepository) ListResources(charmURL *charm.URL, origin corecharm
 	return results, nil
 }
 
+// SupportMatrix returns every channel, base and architecture combination the
+// charm has been released for. The data comes from the refresh API: an
+// install request made without a base cannot be resolved, and the resulting
+// error metadata carries the full release list for the charm.
+func (c *CharmHubRepository) SupportMatrix(charmURL *charm.URL, origin corecharm.Origin, macaroons macaroon.Slice) ([]corecharm.SupportedRelease, error) {
+	c.logger.Tracef("SupportMatrix %q %v", charmURL, origin)
+
+	// Strip the base and any revision pinning from the origin so that the
+	// refresh API reports the releases it could have matched rather than
+	// resolving the charm.
+	probe := origin
+	probe.Platform.OS = ""
+	probe.Platform.Series = ""
+	probe.Revision = nil
+	probe.ID = ""
+
+	res, err := c.refreshOne(charmURL, probe, macaroons)
+	if err != nil {
+		return nil, errors.Annotatef(err, "listing releases")
+	}
+	if res.Error == nil {
+		return nil, errors.Errorf("charmhub returned no release metadata for charm %q", charmURL.Name)
+	}
+	switch res.Error.Code {
+	case transport.ErrorCodeRevisionNotFound, transport.ErrorCodeInvalidCharmBase, transport.ErrorCodeInvalidCharmPlatform:
+	default:
+		return nil, errors.Errorf("listing releases: %s", res.Error.Message)
+	}
+	releases := res.Error.Extra.Releases
+	if len(releases) == 0 {
+		return nil, errors.NotFoundf("releases for charm %q", charmURL.Name)
+	}
+
+	results := make([]corecharm.SupportedRelease, 0, len(releases))
+	for _, release := range releases {
+		base := release.Base
+		track, err := channelTrack(base.Channel)
+		if err != nil {
+			c.logger.Errorf("invalid base channel %v: %s", base.Channel, err)
+			continue
+		}
+		series, err := coreseries.VersionSeries(track)
+		if err != nil {
+			c.logger.Errorf("converting version to series: %s", err)
+			continue
+		}
+		results = append(results, corecharm.SupportedRelease{
+			Channel: release.Channel,
+			Platform: corecharm.Platform{
+				Architecture: base.Architecture,
+				OS:           base.Name,
+				Series:       series,
+			},
+		})
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Channel != results[j].Channel {
+			return results[i].Channel < results[j].Channel
+		}
+		if results[i].Platform.Series != results[j].Platform.Series {
+			return results[i].Platform.Series < results[j].Platform.Series
+		}
+		return results[i].Platform.Architecture < results[j].Platform.Architecture
+	})
+	return results, nil
+}
+
 // GetEssentialMetadata resolves each provided MetadataRequest and returns back
 // a slice with the results. The results include the minimum set of metadata
 // that is required for deploying each charm.
@@ -284,6 +284,99 @@ func (s *charmHubRepositorySuite) TestResolveRevisionNotFoundError(c *gc.C) {
 	c.Assert(obtainedSeries, jc.SameContents, []string{"focal"})
 }
 
+func (s *charmHubRepositorySuite) TestSupportMatrix(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+	s.client.EXPECT().Refresh(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, cfg charmhub.RefreshConfig) ([]transport.RefreshResponse, error) {
+		id := charmhub.ExtractConfigInstanceKey(cfg)
+
+		return []transport.RefreshResponse{{
+			ID:          "charmCHARMcharmCHARMcharmCHARM01",
+			InstanceKey: id,
+			Error: &transport.APIError{
+				Code:    transport.ErrorCodeRevisionNotFound,
+				Message: "revision not found",
+				Extra: transport.APIErrorExtra{
+					Releases: []transport.Release{{
+						Base: transport.Base{
+							Architecture: "amd64",
+							Name:         "ubuntu",
+							Channel:      "20.04",
+						},
+						Channel: "stable",
+					}, {
+						Base: transport.Base{
+							Architecture: "arm64",
+							Name:         "ubuntu",
+							Channel:      "18.04",
+						},
+						Channel: "stable",
+					}, {
+						Base: transport.Base{
+							Architecture: "amd64",
+							Name:         "ubuntu",
+							Channel:      "20.04",
+						},
+						Channel: "edge",
+					}},
+				},
+			},
+		}}, nil
+	})
+
+	curl := charm.MustParseURL("ch:wordpress")
+	origin := corecharm.Origin{
+		Source: "charm-hub",
+		Platform: corecharm.Platform{
+			Architecture: arch.DefaultArchitecture,
+		},
+	}
+
+	repo := NewCharmHubRepository(s.logger, s.client)
+	releases, err := repo.SupportMatrix(curl, origin, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(releases, jc.DeepEquals, []corecharm.SupportedRelease{{
+		Channel: "edge",
+		Platform: corecharm.Platform{
+			Architecture: "amd64",
+			OS:           "ubuntu",
+			Series:       "focal",
+		},
+	}, {
+		Channel: "stable",
+		Platform: corecharm.Platform{
+			Architecture: "arm64",
+			OS:           "ubuntu",
+			Series:       "bionic",
+		},
+	}, {
+		Channel: "stable",
+		Platform: corecharm.Platform{
+			Architecture: "amd64",
+			OS:           "ubuntu",
+			Series:       "focal",
+		},
+	}})
+}
+
+func (s *charmHubRepositorySuite) TestSupportMatrixResolvedCharm(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+	// A refresh response without an error carries no release metadata,
+	// so the matrix cannot be derived from it.
+	s.expectCharmRefreshInstallOneFromChannel(c)
+
+	curl := charm.MustParseURL("ch:wordpress")
+	origin := corecharm.Origin{
+		Source: "charm-hub",
+		Platform: corecharm.Platform{
+			Architecture: arch.DefaultArchitecture,
+		},
+	}
+
+	repo := NewCharmHubRepository(s.logger, s.client)
+	_, err := repo.SupportMatrix(curl, origin, nil)
+	c.Assert(err, gc.ErrorMatches, `charmhub returned no release metadata for charm "wordpress"`)
+}
+
 func (s *charmHubRepositorySuite) TestDownloadCharm(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
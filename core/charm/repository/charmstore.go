@@ -147,6 +147,12 @@ func (c *CharmStoreRepository) ListResources(charmURL *charm.URL, _ corecharm.Or
 	return nil, nil
 }
 
+// SupportMatrix is not supported by the charmstore; release information is
+// only available from charmhub.
+func (c *CharmStoreRepository) SupportMatrix(charmURL *charm.URL, _ corecharm.Origin, _ macaroon.Slice) ([]corecharm.SupportedRelease, error) {
+	return nil, errors.NotSupportedf("support matrix for charmstore charm %q", charmURL.Name)
+}
+
 // GetEssentialMetadata resolves each provided MetadataRequest and returns back
 // a slice with the results. The results include the minimum set of metadata
 // that is required for deploying each charm.
@@ -30,8 +30,10 @@ const (
 	RootDisk         = "root-disk"
 	RootDiskSource   = "root-disk-source"
 	Tags             = "tags"
+	ImageID          = "image-id"
 	InstanceRole     = "instance-role"
 	InstanceType     = "instance-type"
+	PlacementGroup   = "placement-group"
 	Spaces           = "spaces"
 	VirtType         = "virt-type"
 	Zones            = "zones"
@@ -82,6 +84,12 @@ type Value struct {
 	// empty list will override any default tags, where a nil list will not.
 	Tags *[]string `json:"tags,omitempty" yaml:"tags,omitempty"`
 
+	// ImageID, if not nil, indicates that a machine must use the specified
+	// image id when provisioning. Only valid for providers that support
+	// explicit image selection, such as MAAS custom images or clouds that
+	// identify images by id.
+	ImageID *string `json:"image-id,omitempty" yaml:"image-id,omitempty"`
+
 	// InstanceRole, if not nil, indicates that the specificed role/profile for
 	// the given cloud should be used. Only valid for clouds which support
 	// instance roles. Currently only for AWS with instance-profiles
@@ -91,6 +99,12 @@ type Value struct {
 	// be used. Only valid for clouds which support instance types.
 	InstanceType *string `json:"instance-type,omitempty" yaml:"instance-type,omitempty"`
 
+	// PlacementGroup, if not nil, names a placement group that machines
+	// should be started in, to co-locate them for low latency. Only valid
+	// for clouds which support placement groups; currently only Azure,
+	// where it names a proximity placement group.
+	PlacementGroup *string `json:"placement-group,omitempty" yaml:"placement-group,omitempty"`
+
 	// Spaces, if not nil, holds a list of juju network spaces that
 	// should be available (or not) on the machine. Positive and
 	// negative values are accepted, and the difference is the latter
@@ -164,6 +178,11 @@ func (v *Value) HasRootDiskSource() bool {
 	return v.RootDiskSource != nil && *v.RootDiskSource != ""
 }
 
+// HasImageID returns true if the constraints.Value specifies an image id.
+func (v *Value) HasImageID() bool {
+	return v.ImageID != nil && *v.ImageID != ""
+}
+
 // HasInstanceRole returns true if the constraints.Value specifies an instance
 // role.
 func (v *Value) HasInstanceRole() bool {
@@ -175,6 +194,12 @@ func (v *Value) HasInstanceType() bool {
 	return v.InstanceType != nil && *v.InstanceType != ""
 }
 
+// HasPlacementGroup returns true if the constraints.Value specifies a
+// placement group.
+func (v *Value) HasPlacementGroup() bool {
+	return v.PlacementGroup != nil && *v.PlacementGroup != ""
+}
+
 // extractItems returns the list of entries in the given field which
 // are either positive (included) or negative (!included; with prefix
 // "^").
@@ -247,12 +272,18 @@ func (v Value) String() string {
 	if v.CpuPower != nil {
 		strs = append(strs, "cpu-power="+uintStr(*v.CpuPower))
 	}
+	if v.ImageID != nil {
+		strs = append(strs, "image-id="+(*v.ImageID))
+	}
 	if v.InstanceRole != nil {
 		strs = append(strs, "instance-role="+(*v.InstanceRole))
 	}
 	if v.InstanceType != nil {
 		strs = append(strs, "instance-type="+(*v.InstanceType))
 	}
+	if v.PlacementGroup != nil {
+		strs = append(strs, "placement-group="+(*v.PlacementGroup))
+	}
 	if v.Mem != nil {
 		s := uintStr(*v.Mem)
 		if s != "" {
@@ -317,12 +348,18 @@ func (v Value) GoString() string {
 	if v.RootDisk != nil {
 		values = append(values, fmt.Sprintf("RootDisk: %v", *v.RootDisk))
 	}
+	if v.ImageID != nil {
+		values = append(values, fmt.Sprintf("ImageID: %q", *v.ImageID))
+	}
 	if v.InstanceRole != nil {
 		values = append(values, fmt.Sprintf("InstanceRole: %q", *v.InstanceRole))
 	}
 	if v.InstanceType != nil {
 		values = append(values, fmt.Sprintf("InstanceType: %q", *v.InstanceType))
 	}
+	if v.PlacementGroup != nil {
+		values = append(values, fmt.Sprintf("PlacementGroup: %q", *v.PlacementGroup))
+	}
 	if v.Container != nil {
 		values = append(values, fmt.Sprintf("Container: %q", *v.Container))
 	}
@@ -508,10 +545,14 @@ func (v *Value) setRaw(name, str string) error {
 		err = v.setRootDiskSource(str)
 	case Tags:
 		err = v.setTags(str)
+	case ImageID:
+		err = v.setImageID(str)
 	case InstanceRole:
 		err = v.setInstanceRole(str)
 	case InstanceType:
 		err = v.setInstanceType(str)
+	case PlacementGroup:
+		err = v.setPlacementGroup(str)
 	case Spaces:
 		err = v.setSpaces(str)
 	case VirtType:
@@ -559,10 +600,14 @@ func (v *Value) UnmarshalYAML(unmarshal func(interface{}) error) error {
 		case Container:
 			ctype := instance.ContainerType(vstr)
 			v.Container = &ctype
+		case ImageID:
+			v.ImageID = &vstr
 		case InstanceRole:
 			v.InstanceRole = &vstr
 		case InstanceType:
 			v.InstanceType = &vstr
+		case PlacementGroup:
+			v.PlacementGroup = &vstr
 		case Cores:
 			v.CpuCores, err = parseUint64(vstr)
 		case CpuPower:
@@ -650,6 +695,14 @@ func (v *Value) setCpuPower(str string) (err error) {
 	return
 }
 
+func (v *Value) setImageID(str string) error {
+	if v.ImageID != nil {
+		return errors.Errorf("already set")
+	}
+	v.ImageID = &str
+	return nil
+}
+
 func (v *Value) setInstanceRole(str string) error {
 	if v.InstanceRole != nil {
 		return errors.Errorf("already set")
@@ -666,6 +719,14 @@ func (v *Value) setInstanceType(str string) error {
 	return nil
 }
 
+func (v *Value) setPlacementGroup(str string) error {
+	if v.PlacementGroup != nil {
+		return errors.Errorf("already set")
+	}
+	v.PlacementGroup = &str
+	return nil
+}
+
 func (v *Value) setMem(str string) (err error) {
 	if v.Mem != nil {
 		return errors.Errorf("already set")
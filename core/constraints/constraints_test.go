@@ -314,6 +314,15 @@ var parseConstraintsTests = []struct {
 		args:    []string{"instance-role=auto"},
 	},
 
+	// image id
+	{
+		summary: "set image id",
+		args:    []string{"image-id=ubuntu-bf2"},
+	}, {
+		summary: "image id empty",
+		args:    []string{"image-id="},
+	},
+
 	// instance type
 	{
 		summary: "set instance type",
@@ -326,6 +335,15 @@ var parseConstraintsTests = []struct {
 		args:    []string{`instance-type=something\ with\ spaces`},
 	},
 
+	// placement group
+	{
+		summary: "set placement group",
+		args:    []string{"placement-group=my-ppg"},
+	}, {
+		summary: "placement group empty",
+		args:    []string{"placement-group="},
+	},
+
 	// "virt-type" in detail.
 	{
 		summary: "set virt-type empty",
@@ -37,9 +37,15 @@ type Validator interface {
 	Validate(cons Value) ([]string, error)
 
 	// Merge merges cons into consFallback, with any conflicting attributes from cons
-	// overriding those from consFallback.
+	// overriding those from consFallback. Attributes registered as additive are
+	// combined rather than overridden.
 	Merge(consFallback, cons Value) (Value, error)
 
+	// RegisterAdditive records list-valued attributes (eg spaces, tags, zones)
+	// for which Merge combines the values from both constraints instead of
+	// having the overriding constraints replace the fallback values.
+	RegisterAdditive(attributes []string)
+
 	// UpdateVocabulary merges new attribute values with existing values.
 	// This method does not overwrite or delete values, i.e.
 	//     if existing values are {a, b}
@@ -60,6 +66,7 @@ type validator struct {
 	unsupported set.Strings
 	conflicts   map[string]set.Strings
 	vocab       map[string][]interface{}
+	additive    set.Strings
 }
 
 // RegisterConflicts is defined on Validator.
@@ -77,6 +84,11 @@ func (v *validator) RegisterUnsupported(unsupported []string) {
 	v.unsupported = set.NewStrings(unsupported...)
 }
 
+// RegisterAdditive is defined on Validator.
+func (v *validator) RegisterAdditive(attributes []string) {
+	v.additive = set.NewStrings(attributes...)
+}
+
 // RegisterVocabulary is defined on Validator.
 func (v *validator) RegisterVocabulary(attributeName string, allowedValues interface{}) {
 	v.vocab[resolveAlias(attributeName)] = convertToSlice(allowedValues)
@@ -284,5 +296,39 @@ func (v *validator) Merge(consFallback, cons Value) (Value, error) {
 	consFallbackMinusConflicts := consFallback.without(fallbackConflicts...)
 	// The result is cons with fallbacks coming from any
 	// non conflicting consFallback attributes.
-	return withFallbacks(cons, consFallbackMinusConflicts), nil
+	merged := withFallbacks(cons, consFallbackMinusConflicts)
+	// Any additive list-valued attributes present in both constraints
+	// are combined rather than overridden.
+	if len(v.additive) > 0 {
+		merged = withAdditiveValues(merged, consFallbackMinusConflicts, cons, v.additive)
+	}
+	return merged, nil
+}
+
+// withAdditiveValues returns a copy of merged in which each additive
+// attribute present in both fallback and override holds the union of
+// their values, fallback values first, order preserved.
+func withAdditiveValues(merged, fallback, override Value, additive set.Strings) Value {
+	mergedAttr := merged.attributesWithValues()
+	fbAttr := fallback.attributesWithValues()
+	ovAttr := override.attributesWithValues()
+	for _, attrTag := range additive.SortedValues() {
+		attrTag = resolveAlias(attrTag)
+		fbValue, fbOK := fbAttr[attrTag].([]interface{})
+		ovValue, ovOK := ovAttr[attrTag].([]interface{})
+		if !fbOK || !ovOK {
+			continue
+		}
+		seen := make(map[interface{}]bool)
+		var union []interface{}
+		for _, one := range append(fbValue[:len(fbValue):len(fbValue)], ovValue...) {
+			if seen[one] {
+				continue
+			}
+			seen[one] = true
+			union = append(union, one)
+		}
+		mergedAttr[attrTag] = union
+	}
+	return fromAttributes(mergedAttr)
 }
@@ -353,6 +353,58 @@ func (s *validationSuite) TestMerge(c *gc.C) {
 	}
 }
 
+var mergeAdditiveTests = []struct {
+	desc         string
+	additive     []string
+	consFallback string
+	cons         string
+	expected     string
+}{
+	{
+		desc:         "additive spaces are combined",
+		additive:     []string{"spaces"},
+		consFallback: "spaces=db",
+		cons:         "spaces=public",
+		expected:     "spaces=db,public",
+	}, {
+		desc:         "duplicates removed preserving fallback order",
+		additive:     []string{"tags"},
+		consFallback: "tags=foo,bar",
+		cons:         "tags=bar,baz",
+		expected:     "tags=foo,bar,baz",
+	}, {
+		desc:         "additive attribute only in override",
+		additive:     []string{"zones"},
+		cons:         "zones=az1",
+		expected:     "zones=az1",
+	}, {
+		desc:         "additive attribute only in fallback",
+		additive:     []string{"zones"},
+		consFallback: "zones=az1",
+		expected:     "zones=az1",
+	}, {
+		desc:         "non-additive attributes still override",
+		additive:     []string{"spaces"},
+		consFallback: "spaces=db mem=4G",
+		cons:         "spaces=public mem=8G",
+		expected:     "spaces=db,public mem=8G",
+	},
+}
+
+func (s *validationSuite) TestMergeAdditive(c *gc.C) {
+	for i, t := range mergeAdditiveTests {
+		c.Logf("test %d: %s", i, t.desc)
+		validator := constraints.NewValidator()
+		validator.RegisterAdditive(t.additive)
+		consFallback := constraints.MustParse(t.consFallback)
+		cons := constraints.MustParse(t.cons)
+		merged, err := validator.Merge(consFallback, cons)
+		c.Assert(err, jc.ErrorIsNil)
+		expected := constraints.MustParse(t.expected)
+		c.Check(merged, gc.DeepEquals, expected)
+	}
+}
+
 func (s *validationSuite) TestMergeError(c *gc.C) {
 	validator := constraints.NewValidator()
 	validator.RegisterConflicts([]string{"instance-type"}, []string{"mem"})
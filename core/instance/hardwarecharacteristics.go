@@ -41,6 +41,14 @@ type HardwareCharacteristics struct {
 
 	// AvailabilityZone defines the zone in which the machine resides.
 	AvailabilityZone *string `json:"availability-zone,omitempty" yaml:"availabilityzone,omitempty"`
+
+	// ImageID is the id of the image the machine was provisioned with,
+	// where the provider records one.
+	ImageID *string `json:"image-id,omitempty" yaml:"imageid,omitempty"`
+
+	// VMHost is the name of the VM host (e.g. a MAAS pod or LXD
+	// cluster member) the machine was composed on, if any.
+	VMHost *string `json:"vm-host,omitempty" yaml:"vmhost,omitempty"`
 }
 
 // quoteIfNeeded quotes s (according to Go string quoting rules) if it
@@ -87,6 +95,12 @@ func (hc HardwareCharacteristics) String() string {
 	if hc.AvailabilityZone != nil && *hc.AvailabilityZone != "" {
 		strs = append(strs, fmt.Sprintf("availability-zone=%s", quoteIfNeeded(*hc.AvailabilityZone)))
 	}
+	if hc.ImageID != nil && *hc.ImageID != "" {
+		strs = append(strs, fmt.Sprintf("image-id=%s", quoteIfNeeded(*hc.ImageID)))
+	}
+	if hc.VMHost != nil && *hc.VMHost != "" {
+		strs = append(strs, fmt.Sprintf("vm-host=%s", quoteIfNeeded(*hc.VMHost)))
+	}
 	return strings.Join(strs, " ")
 }
 
@@ -173,6 +187,10 @@ func (hc *HardwareCharacteristics) parseField(s string) (rest string, err error)
 			err = hc.setRootDiskSource(value)
 		case "availability-zone":
 			err = hc.setAvailabilityZone(value)
+		case "image-id":
+			err = hc.setImageID(value)
+		case "vm-host":
+			err = hc.setVMHost(value)
 		default:
 			return rest, errors.Errorf("unknown characteristic %q", name)
 		}
@@ -334,6 +352,26 @@ func (hc *HardwareCharacteristics) setAvailabilityZone(str string) error {
 	return nil
 }
 
+func (hc *HardwareCharacteristics) setImageID(str string) error {
+	if hc.ImageID != nil {
+		return errors.Errorf("already set")
+	}
+	if str != "" {
+		hc.ImageID = &str
+	}
+	return nil
+}
+
+func (hc *HardwareCharacteristics) setVMHost(str string) error {
+	if hc.VMHost != nil {
+		return errors.Errorf("already set")
+	}
+	if str != "" {
+		hc.VMHost = &str
+	}
+	return nil
+}
+
 func parseUint64(str string) (*uint64, error) {
 	var value uint64
 	if str != "" {
@@ -404,6 +404,36 @@ var parseHardwareTests = []parseHardwareTestSpec{
 		err:     `bad "availability-zone" characteristic: already set`,
 	},
 
+	// "image-id" in detail.
+	{
+		summary: "set image-id empty",
+		args:    []string{"image-id="},
+		hc:      &HC{ImageID: nil},
+	}, {
+		summary: "set image-id non-empty",
+		args:    []string{"image-id=ubuntu-bf2"},
+		hc:      &HC{ImageID: stringPtr("ubuntu-bf2")},
+	}, {
+		summary: "double set image-id together",
+		args:    []string{"image-id=ubuntu-bf2 image-id=ubuntu-bf2"},
+		err:     `bad "image-id" characteristic: already set`,
+	},
+
+	// "vm-host" in detail.
+	{
+		summary: "set vm-host empty",
+		args:    []string{"vm-host="},
+		hc:      &HC{VMHost: nil},
+	}, {
+		summary: "set vm-host non-empty",
+		args:    []string{"vm-host=pod-01"},
+		hc:      &HC{VMHost: stringPtr("pod-01")},
+	}, {
+		summary: "double set vm-host together",
+		args:    []string{"vm-host=pod-01 vm-host=pod-01"},
+		err:     `bad "vm-host" characteristic: already set`,
+	},
+
 	// Everything at once.
 	{
 		summary: "kitchen sink together",
@@ -15,6 +15,10 @@ const (
 	// SingularControllerNamespace is the namespace used to manage
 	// controller leases.
 	SingularControllerNamespace = "singular-controller"
+
+	// UserNamespace is the namespace used to manage user-defined
+	// leases, claimed by operators for their own singleton workloads.
+	UserNamespace = "user"
 )
 
 // Claimer exposes lease acquisition and expiry notification capabilities.
@@ -0,0 +1,140 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// The lognoise package records how many log messages each agent has
+// written through the API servers recently, so that an agent flooding
+// the logs can be identified before it fills the controller's disks.
+package lognoise
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+)
+
+const (
+	// MaxWindow is the longest period for which per-agent log write
+	// counts are retained. Reports over a longer window are clamped
+	// to this value.
+	MaxWindow = 15 * time.Minute
+
+	// bucketSize is the granularity at which log write counts are
+	// recorded.
+	bucketSize = time.Minute
+)
+
+// Entry reports the number of log messages written by a single agent.
+type Entry struct {
+	// Entity is the tag of the agent that wrote the log messages.
+	Entity string
+
+	// Count is the number of log messages the agent wrote over the
+	// requested window.
+	Count uint64
+}
+
+// Recorder tracks the volume of log messages written by each agent.
+// It is safe to use from multiple goroutines.
+type Recorder struct {
+	clock clock.Clock
+
+	mu     sync.Mutex
+	counts map[key][]bucket
+}
+
+type key struct {
+	model  string
+	entity string
+}
+
+type bucket struct {
+	start time.Time
+	count uint64
+}
+
+// New returns a Recorder that uses the given clock to age out old
+// log write counts.
+func New(clock clock.Clock) *Recorder {
+	return &Recorder{
+		clock:  clock,
+		counts: make(map[key][]bucket),
+	}
+}
+
+// Record notes that the given agent in the given model wrote one log
+// message.
+func (r *Recorder) Record(modelUUID, entity string) {
+	now := r.clock.Now()
+	start := now.Truncate(bucketSize)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	k := key{model: modelUUID, entity: entity}
+	buckets := r.counts[k]
+	if n := len(buckets); n > 0 && buckets[n-1].start.Equal(start) {
+		buckets[n-1].count++
+	} else {
+		buckets = append(prune(buckets, now), bucket{start: start, count: 1})
+	}
+	r.counts[k] = buckets
+}
+
+// NoisiestAgents returns up to limit agents in the given model ordered
+// by the number of log messages each has written over the given window,
+// noisiest first. Agents with equal counts are ordered by entity tag. A
+// non-positive limit means no limit, and the window is clamped to
+// MaxWindow. Counts are recorded at a one minute granularity, so the
+// window is effectively rounded up to a whole number of minutes.
+func (r *Recorder) NoisiestAgents(modelUUID string, window time.Duration, limit int) []Entry {
+	if window <= 0 || window > MaxWindow {
+		window = MaxWindow
+	}
+	now := r.clock.Now()
+	cutoff := now.Add(-window).Truncate(bucketSize)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var entries []Entry
+	for k, buckets := range r.counts {
+		buckets = prune(buckets, now)
+		if len(buckets) == 0 {
+			delete(r.counts, k)
+			continue
+		}
+		r.counts[k] = buckets
+		if k.model != modelUUID {
+			continue
+		}
+		var total uint64
+		for _, b := range buckets {
+			if !b.start.Before(cutoff) {
+				total += b.count
+			}
+		}
+		if total > 0 {
+			entries = append(entries, Entry{Entity: k.entity, Count: total})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		left, right := entries[i], entries[j]
+		if left.Count != right.Count {
+			return left.Count > right.Count
+		}
+		return left.Entity < right.Entity
+	})
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries
+}
+
+// prune drops buckets that have aged out of the maximum window.
+func prune(buckets []bucket, now time.Time) []bucket {
+	cutoff := now.Add(-MaxWindow).Truncate(bucketSize)
+	for len(buckets) > 0 && buckets[0].start.Before(cutoff) {
+		buckets = buckets[1:]
+	}
+	return buckets
+}
@@ -0,0 +1,105 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package lognoise_test
+
+import (
+	"time"
+
+	"github.com/juju/clock/testclock"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/lognoise"
+)
+
+type RecorderSuite struct {
+	clock    *testclock.Clock
+	recorder *lognoise.Recorder
+}
+
+var _ = gc.Suite(&RecorderSuite{})
+
+const (
+	model      = "deadbeef-2f18-4fd2-967d-db9663db7bea"
+	otherModel = "deadbeef-0bad-400d-8000-4b1d0d06f00d"
+)
+
+func (s *RecorderSuite) SetUpTest(c *gc.C) {
+	// Starting from the epoch means bucket boundaries line up with
+	// the clock advances below.
+	s.clock = testclock.NewClock(time.Time{})
+	s.recorder = lognoise.New(s.clock)
+}
+
+func (s *RecorderSuite) record(modelUUID, entity string, count int) {
+	for i := 0; i < count; i++ {
+		s.recorder.Record(modelUUID, entity)
+	}
+}
+
+func (s *RecorderSuite) TestEmpty(c *gc.C) {
+	c.Assert(s.recorder.NoisiestAgents(model, 0, 0), gc.HasLen, 0)
+}
+
+func (s *RecorderSuite) TestOrdersByCount(c *gc.C) {
+	s.record(model, "machine-0", 2)
+	s.record(model, "unit-mysql-0", 5)
+	s.record(model, "unit-wordpress-0", 3)
+	c.Assert(s.recorder.NoisiestAgents(model, 0, 0), jc.DeepEquals, []lognoise.Entry{
+		{Entity: "unit-mysql-0", Count: 5},
+		{Entity: "unit-wordpress-0", Count: 3},
+		{Entity: "machine-0", Count: 2},
+	})
+}
+
+func (s *RecorderSuite) TestEqualCountsOrderedByEntity(c *gc.C) {
+	s.record(model, "unit-wordpress-0", 2)
+	s.record(model, "unit-mysql-0", 2)
+	c.Assert(s.recorder.NoisiestAgents(model, 0, 0), jc.DeepEquals, []lognoise.Entry{
+		{Entity: "unit-mysql-0", Count: 2},
+		{Entity: "unit-wordpress-0", Count: 2},
+	})
+}
+
+func (s *RecorderSuite) TestLimit(c *gc.C) {
+	s.record(model, "machine-0", 2)
+	s.record(model, "unit-mysql-0", 5)
+	s.record(model, "unit-wordpress-0", 3)
+	c.Assert(s.recorder.NoisiestAgents(model, 0, 2), jc.DeepEquals, []lognoise.Entry{
+		{Entity: "unit-mysql-0", Count: 5},
+		{Entity: "unit-wordpress-0", Count: 3},
+	})
+}
+
+func (s *RecorderSuite) TestOtherModelExcluded(c *gc.C) {
+	s.record(model, "machine-0", 1)
+	s.record(otherModel, "machine-0", 4)
+	c.Assert(s.recorder.NoisiestAgents(model, 0, 0), jc.DeepEquals, []lognoise.Entry{
+		{Entity: "machine-0", Count: 1},
+	})
+}
+
+func (s *RecorderSuite) TestWindow(c *gc.C) {
+	s.record(model, "machine-0", 4)
+	s.clock.Advance(5 * time.Minute)
+	s.record(model, "machine-0", 1)
+	// Only the writes in the last two minutes are reported.
+	c.Assert(s.recorder.NoisiestAgents(model, 2*time.Minute, 0), jc.DeepEquals, []lognoise.Entry{
+		{Entity: "machine-0", Count: 1},
+	})
+	// The older writes are still inside the maximum window.
+	c.Assert(s.recorder.NoisiestAgents(model, 0, 0), jc.DeepEquals, []lognoise.Entry{
+		{Entity: "machine-0", Count: 5},
+	})
+}
+
+func (s *RecorderSuite) TestOldWritesAgeOut(c *gc.C) {
+	s.record(model, "machine-0", 4)
+	s.clock.Advance(lognoise.MaxWindow + time.Minute)
+	c.Assert(s.recorder.NoisiestAgents(model, 0, 0), gc.HasLen, 0)
+	s.record(model, "machine-0", 1)
+	c.Assert(s.recorder.NoisiestAgents(model, 0, 0), jc.DeepEquals, []lognoise.Entry{
+		{Entity: "machine-0", Count: 1},
+	})
+}
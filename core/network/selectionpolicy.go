@@ -0,0 +1,127 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package network
+
+import (
+	"net"
+	"sort"
+)
+
+// AddressSelectionPolicy captures model-level preferences applied when
+// sorting and selecting addresses for agent-to-controller connections
+// and unit ingress. The zero value expresses no preferences and leaves
+// the default scope-based ordering untouched.
+type AddressSelectionPolicy struct {
+	// PreferIPv6 orders IPv6 addresses ahead of other addresses.
+	PreferIPv6 bool
+
+	// PreferSpaceName orders addresses in the named space ahead of
+	// addresses in any other space.
+	PreferSpaceName SpaceName
+
+	// ExcludeCIDRs drops addresses falling within any of the given
+	// subnets, unless doing so would leave nothing to select from.
+	ExcludeCIDRs []string
+}
+
+// IsZero reports whether the policy expresses no preferences.
+func (p AddressSelectionPolicy) IsZero() bool {
+	return !p.PreferIPv6 && p.PreferSpaceName == "" && len(p.ExcludeCIDRs) == 0
+}
+
+// Excludes reports whether the input address falls within any of the
+// policy's excluded subnets. Host names never match.
+func (p AddressSelectionPolicy) Excludes(addr Address) bool {
+	ip := net.ParseIP(addr.Host())
+	if ip == nil {
+		return false
+	}
+	for _, cidr := range p.ExcludeCIDRs {
+		if _, ipNet, err := net.ParseCIDR(cidr); err == nil && ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyToSpaceAddresses returns the input addresses with the policy
+// applied. Excluded addresses are dropped, unless that would leave no
+// addresses at all, and the remainder are stably re-ordered so that
+// preferred addresses come first. The input spaces are used to resolve
+// the preferred space name.
+func (p AddressSelectionPolicy) ApplyToSpaceAddresses(sas SpaceAddresses, spaces SpaceInfos) SpaceAddresses {
+	if p.IsZero() {
+		return sas
+	}
+
+	preferredSpaceID := ""
+	if p.PreferSpaceName != "" {
+		if space := spaces.GetByName(string(p.PreferSpaceName)); space != nil {
+			preferredSpaceID = space.ID
+		}
+	}
+
+	filtered := make(SpaceAddresses, 0, len(sas))
+	for _, addr := range sas {
+		if !p.Excludes(addr) {
+			filtered = append(filtered, addr)
+		}
+	}
+	if len(filtered) == 0 {
+		filtered = append(filtered, sas...)
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		iPref := p.preference(preferredSpaceID != "" && filtered[i].SpaceID == preferredSpaceID, filtered[i].AddressType())
+		jPref := p.preference(preferredSpaceID != "" && filtered[j].SpaceID == preferredSpaceID, filtered[j].AddressType())
+		return iPref < jPref
+	})
+	return filtered
+}
+
+// ApplyToHostPorts returns the input host/port collections with the
+// policy applied: for each server, excluded addresses are dropped,
+// unless that would leave no addresses at all, and the remainder are
+// stably re-ordered so that preferred addresses come first.
+func (p AddressSelectionPolicy) ApplyToHostPorts(servers []ProviderHostPorts) []ProviderHostPorts {
+	if p.IsZero() {
+		return servers
+	}
+
+	out := make([]ProviderHostPorts, len(servers))
+	for i, server := range servers {
+		filtered := make(ProviderHostPorts, 0, len(server))
+		for _, hp := range server {
+			if !p.Excludes(hp) {
+				filtered = append(filtered, hp)
+			}
+		}
+		if len(filtered) == 0 {
+			filtered = append(filtered, server...)
+		}
+
+		sort.SliceStable(filtered, func(i, j int) bool {
+			iPref := p.preference(p.PreferSpaceName != "" && filtered[i].SpaceName == p.PreferSpaceName, filtered[i].AddressType())
+			jPref := p.preference(p.PreferSpaceName != "" && filtered[j].SpaceName == p.PreferSpaceName, filtered[j].AddressType())
+			return iPref < jPref
+		})
+		out[i] = filtered
+	}
+	return out
+}
+
+// preference weighs an address against the policy; lower is better.
+// Space preference trumps IP version preference so that a dual-homed
+// machine picks the intended network even when it has addresses of both
+// versions outside the preferred space.
+func (p AddressSelectionPolicy) preference(inPreferredSpace bool, addrType AddressType) int {
+	weight := 0
+	if p.PreferSpaceName != "" && !inPreferredSpace {
+		weight += 2
+	}
+	if p.PreferIPv6 && addrType != IPv6Address {
+		weight++
+	}
+	return weight
+}
@@ -0,0 +1,128 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package network_test
+
+import (
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/network"
+)
+
+type selectionPolicySuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&selectionPolicySuite{})
+
+func (s *selectionPolicySuite) TestIsZero(c *gc.C) {
+	c.Check(network.AddressSelectionPolicy{}.IsZero(), jc.IsTrue)
+	c.Check(network.AddressSelectionPolicy{PreferIPv6: true}.IsZero(), jc.IsFalse)
+	c.Check(network.AddressSelectionPolicy{PreferSpaceName: "internal"}.IsZero(), jc.IsFalse)
+	c.Check(network.AddressSelectionPolicy{ExcludeCIDRs: []string{"10.0.0.0/8"}}.IsZero(), jc.IsFalse)
+}
+
+func (s *selectionPolicySuite) TestExcludes(c *gc.C) {
+	policy := network.AddressSelectionPolicy{ExcludeCIDRs: []string{"10.0.0.0/24", "fd00::/8"}}
+
+	c.Check(policy.Excludes(network.NewSpaceAddress("10.0.0.5")), jc.IsTrue)
+	c.Check(policy.Excludes(network.NewSpaceAddress("fd00::1")), jc.IsTrue)
+	c.Check(policy.Excludes(network.NewSpaceAddress("10.0.1.5")), jc.IsFalse)
+	// Host names never match an excluded CIDR.
+	c.Check(policy.Excludes(network.NewSpaceAddress("host.example.com")), jc.IsFalse)
+}
+
+func (s *selectionPolicySuite) TestApplyToSpaceAddressesExcludeCIDRs(c *gc.C) {
+	policy := network.AddressSelectionPolicy{ExcludeCIDRs: []string{"252.80.0.0/12"}}
+
+	addrs := network.NewSpaceAddresses("252.80.0.100", "10.0.0.5")
+	filtered := policy.ApplyToSpaceAddresses(addrs, nil)
+	c.Assert(filtered, gc.HasLen, 1)
+	c.Check(filtered[0].Value, gc.Equals, "10.0.0.5")
+}
+
+func (s *selectionPolicySuite) TestApplyToSpaceAddressesExcludeAllRetainsInput(c *gc.C) {
+	policy := network.AddressSelectionPolicy{ExcludeCIDRs: []string{"10.0.0.0/8"}}
+
+	// If exclusion would leave nothing to select from, the input is
+	// returned unfiltered.
+	addrs := network.NewSpaceAddresses("10.0.0.5", "10.0.1.5")
+	filtered := policy.ApplyToSpaceAddresses(addrs, nil)
+	c.Assert(filtered, gc.HasLen, 2)
+}
+
+func (s *selectionPolicySuite) TestApplyToSpaceAddressesPreferIPv6(c *gc.C) {
+	policy := network.AddressSelectionPolicy{PreferIPv6: true}
+
+	addrs := network.NewSpaceAddresses("10.0.0.5", "2001:db8::1", "10.0.1.5")
+	sorted := policy.ApplyToSpaceAddresses(addrs, nil)
+	c.Assert(sorted, gc.HasLen, 3)
+	c.Check(sorted[0].Value, gc.Equals, "2001:db8::1")
+}
+
+func (s *selectionPolicySuite) TestApplyToSpaceAddressesPreferSpace(c *gc.C) {
+	policy := network.AddressSelectionPolicy{PreferSpaceName: "internal"}
+
+	spaces := network.SpaceInfos{
+		{ID: "1", Name: "public"},
+		{ID: "2", Name: "internal"},
+	}
+
+	addrs := network.NewSpaceAddresses("10.0.0.5", "10.0.1.5")
+	addrs[0].SpaceID = "1"
+	addrs[1].SpaceID = "2"
+
+	sorted := policy.ApplyToSpaceAddresses(addrs, spaces)
+	c.Assert(sorted, gc.HasLen, 2)
+	c.Check(sorted[0].Value, gc.Equals, "10.0.1.5")
+}
+
+func (s *selectionPolicySuite) TestApplyToSpaceAddressesSpaceTrumpsIPVersion(c *gc.C) {
+	policy := network.AddressSelectionPolicy{
+		PreferIPv6:      true,
+		PreferSpaceName: "internal",
+	}
+
+	spaces := network.SpaceInfos{
+		{ID: "1", Name: "public"},
+		{ID: "2", Name: "internal"},
+	}
+
+	addrs := network.NewSpaceAddresses("2001:db8::1", "10.0.1.5")
+	addrs[0].SpaceID = "1"
+	addrs[1].SpaceID = "2"
+
+	sorted := policy.ApplyToSpaceAddresses(addrs, spaces)
+	c.Assert(sorted, gc.HasLen, 2)
+	c.Check(sorted[0].Value, gc.Equals, "10.0.1.5")
+}
+
+func (s *selectionPolicySuite) TestApplyToHostPorts(c *gc.C) {
+	policy := network.AddressSelectionPolicy{
+		PreferSpaceName: "internal",
+		ExcludeCIDRs:    []string{"252.80.0.0/12"},
+	}
+
+	hps := []network.ProviderHostPorts{{
+		{ProviderAddress: network.NewProviderAddress("252.80.0.100"), NetPort: 17070},
+		{ProviderAddress: network.NewProviderAddressInSpace("public", "10.0.0.5"), NetPort: 17070},
+		{ProviderAddress: network.NewProviderAddressInSpace("internal", "10.0.1.5"), NetPort: 17070},
+	}}
+
+	out := policy.ApplyToHostPorts(hps)
+	c.Assert(out, gc.HasLen, 1)
+	c.Assert(out[0], gc.HasLen, 2)
+	c.Check(out[0][0].Host(), gc.Equals, "10.0.1.5")
+	c.Check(out[0][1].Host(), gc.Equals, "10.0.0.5")
+}
+
+func (s *selectionPolicySuite) TestApplyToHostPortsZeroPolicy(c *gc.C) {
+	hps := []network.ProviderHostPorts{{
+		{ProviderAddress: network.NewProviderAddress("10.0.0.5"), NetPort: 17070},
+	}}
+
+	out := network.AddressSelectionPolicy{}.ApplyToHostPorts(hps)
+	c.Check(out, gc.DeepEquals, hps)
+}
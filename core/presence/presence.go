@@ -144,6 +144,11 @@ type Value struct {
 	// LastSeen is the timestamp when the connection was added using
 	// Connect, or the last time Activity was called.
 	LastSeen time.Time
+
+	// ConnectionCount is the number of connections this recorder has
+	// observed for the agent to this server since the recorder was
+	// enabled. A count that keeps climbing indicates a flapping agent.
+	ConnectionCount uint64
 }
 
 type connections struct {
@@ -167,6 +172,15 @@ type recorder struct {
 	enabled bool
 	clock   Clock
 	entries []Value
+	counts  map[connectionKey]uint64
+}
+
+// connectionKey identifies the agent connection counts that the recorder
+// keeps in order to identify flapping agents.
+type connectionKey struct {
+	server string
+	model  string
+	agent  string
 }
 
 // Disable implements Recorder.
@@ -175,6 +189,7 @@ func (r *recorder) Disable() {
 	defer r.mu.Unlock()
 	r.enabled = false
 	r.entries = nil
+	r.counts = nil
 }
 
 // Enable implements Recorder.
@@ -182,7 +197,9 @@ func (r *recorder) Enable() {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	r.enabled = true
-
+	if r.counts == nil {
+		r.counts = make(map[connectionKey]uint64)
+	}
 }
 
 // IsEnabled implements Recorder.
@@ -211,6 +228,8 @@ func (r *recorder) Connect(server, model, agent string, id uint64, controllerAge
 
 	pos := r.findIndex(server, id)
 	if pos == -1 {
+		key := connectionKey{server: server, model: model, agent: agent}
+		r.counts[key]++
 		r.entries = append(r.entries, Value{
 			Model:           model,
 			Server:          server,
@@ -220,6 +239,7 @@ func (r *recorder) Connect(server, model, agent string, id uint64, controllerAge
 			Status:          Alive,
 			UserData:        userData,
 			LastSeen:        r.clock.Now(),
+			ConnectionCount: r.counts[key],
 		})
 	} else {
 		// Need to access the value in the array, not a copy of it.
@@ -304,8 +324,11 @@ func (r *recorder) UpdateServer(server string, connections []Value) error {
 		if i, found := alive[value.ConnectionID]; found {
 			entries[i].LastSeen = r.clock.Now()
 		} else {
+			key := connectionKey{server: value.Server, model: value.Model, agent: value.Agent}
+			r.counts[key]++
 			value.Status = Alive
 			value.LastSeen = r.clock.Now()
+			value.ConnectionCount = r.counts[key]
 			entries = append(entries, value)
 		}
 	}
@@ -233,14 +233,16 @@ func (s *suite) TestUpdateServer(c *gc.C) {
 	err := r.UpdateServer("machine-0", values(ha0))
 	c.Assert(err, jc.ErrorIsNil)
 
+	// The update re-added the connection for ha0, so the recorder has
+	// now observed two connections for that agent.
 	connections := r.Connections()
 	s.assertConnections(c, connections.ForModel(bootstrapUUID),
-		values(alive(ha0), alive(ha1), alive(ha2)))
+		values(connectionCount(alive(ha0), 2), alive(ha1), alive(ha2)))
 	s.assertConnections(c, connections.ForModel(modelUUID),
 		values(alive(modelMachine0), alive(modelMachine1)))
 
 	s.assertConnections(c, connections.ForServer(ha0.Server),
-		values(alive(ha0)))
+		values(connectionCount(alive(ha0), 2)))
 	s.assertConnections(c, connections.ForServer(ha1.Server),
 		values(alive(ha1), alive(modelMachine0)))
 	s.assertConnections(c, connections.ForServer(ha2.Server),
@@ -256,6 +258,24 @@ func (s *suite) TestUpdateServerError(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, `connection server mismatch, got "machine-1" expected "machine-0"`)
 }
 
+func (s *suite) TestFlappingAgentConnectionCount(c *gc.C) {
+	r, _ := bootstrap()
+	enableHA(r)
+	deployModel(r)
+
+	// An agent that bounces and comes back through a new connection has
+	// its connection count incremented.
+	r.Disconnect(modelUnit1.Server, modelUnit1.ConnectionID)
+	bounced := modelUnit1
+	bounced.ConnectionID = 2400
+	connect(r, bounced)
+
+	connections := r.Connections()
+	s.assertConnections(c, connections.ForModel(modelUUID),
+		values(alive(modelMachine0), alive(modelMachine1),
+			connectionCount(alive(bounced), 2), alive(modelUnit2)))
+}
+
 func (s *suite) TestConnections(c *gc.C) {
 	r, _ := bootstrap()
 	enableHA(r)
@@ -331,6 +351,11 @@ func lastSeen(v presence.Value, when time.Time) presence.Value {
 	return v
 }
 
+func connectionCount(v presence.Value, count uint64) presence.Value {
+	v.ConnectionCount = count
+	return v
+}
+
 func connect(r presence.Recorder, info presence.Value) {
 	r.Connect(info.Server, info.Model, info.Agent, info.ConnectionID, info.ControllerAgent, info.UserData)
 }
@@ -339,45 +364,52 @@ const bootstrapUUID = "bootstrap-uuid"
 const modelUUID = "model-uuid"
 
 var ha0 = presence.Value{
-	Model:        bootstrapUUID,
-	Server:       "machine-0",
-	Agent:        "machine-0",
-	ConnectionID: 1234,
+	Model:           bootstrapUUID,
+	Server:          "machine-0",
+	Agent:           "machine-0",
+	ConnectionID:    1234,
+	ConnectionCount: 1,
 }
 var ha1 = presence.Value{
-	Model:        bootstrapUUID,
-	Server:       "machine-1",
-	Agent:        "machine-1",
-	ConnectionID: 1235,
+	Model:           bootstrapUUID,
+	Server:          "machine-1",
+	Agent:           "machine-1",
+	ConnectionID:    1235,
+	ConnectionCount: 1,
 }
 var ha2 = presence.Value{
-	Model:        bootstrapUUID,
-	Server:       "machine-2",
-	Agent:        "machine-2",
-	ConnectionID: 1236,
+	Model:           bootstrapUUID,
+	Server:          "machine-2",
+	Agent:           "machine-2",
+	ConnectionID:    1236,
+	ConnectionCount: 1,
 }
 
 var modelMachine0 = presence.Value{
-	Model:        modelUUID,
-	Server:       "machine-1",
-	Agent:        "machine-0",
-	ConnectionID: 1237,
+	Model:           modelUUID,
+	Server:          "machine-1",
+	Agent:           "machine-0",
+	ConnectionID:    1237,
+	ConnectionCount: 1,
 }
 var modelMachine1 = presence.Value{
-	Model:        modelUUID,
-	Server:       "machine-2",
-	Agent:        "machine-1",
-	ConnectionID: 1238,
+	Model:           modelUUID,
+	Server:          "machine-2",
+	Agent:           "machine-1",
+	ConnectionID:    1238,
+	ConnectionCount: 1,
 }
 var modelUnit1 = presence.Value{
-	Model:        modelUUID,
-	Server:       "machine-0",
-	Agent:        "unit-wordpress-0",
-	ConnectionID: 1239,
+	Model:           modelUUID,
+	Server:          "machine-0",
+	Agent:           "unit-wordpress-0",
+	ConnectionID:    1239,
+	ConnectionCount: 1,
 }
 var modelUnit2 = presence.Value{
-	Model:        modelUUID,
-	Server:       "machine-0",
-	Agent:        "unit-mysql-0",
-	ConnectionID: 12409,
+	Model:           modelUUID,
+	Server:          "machine-0",
+	Agent:           "unit-mysql-0",
+	ConnectionID:    12409,
+	ConnectionCount: 1,
 }
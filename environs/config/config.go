@@ -8,6 +8,8 @@ import (
 	"net"
 	"net/url"
 	"os"
+	"path"
+	"regexp"
 	"strings"
 	"time"
 
@@ -24,9 +26,11 @@ import (
 
 	"github.com/juju/juju/charmhub"
 	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/environs/tags"
 	"github.com/juju/juju/juju/osenv"
+	"github.com/juju/juju/logfwd"
 	"github.com/juju/juju/logfwd/syslog"
 	jujuversion "github.com/juju/juju/version"
 )
@@ -194,6 +198,14 @@ const (
 	// forwarding.
 	LogFwdSyslogClientKey = "syslog-client-key"
 
+	// LogFwdLokiEndpoint sets the URL of the Loki push API endpoint
+	// for log forwarding.
+	LogFwdLokiEndpoint = "loki-endpoint"
+
+	// LogFwdLokiLabels sets additional static labels attached to log
+	// streams pushed to Loki.
+	LogFwdLokiLabels = "loki-labels"
+
 	// AutomaticallyRetryHooks determines whether the uniter will
 	// automatically retry a hook that has failed
 	AutomaticallyRetryHooks = "automatically-retry-hooks"
@@ -232,6 +244,29 @@ const (
 	// FanConfig defines the configuration for FAN network running in the model.
 	FanConfig = "fan-config"
 
+	// DNSServersKey is a key for the DNS servers that machines in the
+	// model should use, overriding the provider's DNS configuration.
+	DNSServersKey = "dns-servers"
+
+	// DNSSearchDomainsKey is a key for the DNS search domains that
+	// machines in the model should use.
+	DNSSearchDomainsKey = "dns-search-domains"
+
+	// AddressSelectionPreferIPv6Key when true, orders IPv6 addresses
+	// ahead of IPv4 addresses when selecting addresses for
+	// agent-to-controller connections and unit ingress.
+	AddressSelectionPreferIPv6Key = "address-selection-prefer-ipv6"
+
+	// AddressSelectionPreferSpaceKey names a space whose addresses are
+	// ordered ahead of addresses in any other space when selecting
+	// addresses for agent-to-controller connections and unit ingress.
+	AddressSelectionPreferSpaceKey = "address-selection-prefer-space"
+
+	// AddressSelectionExcludeCIDRsKey is a comma-separated list of
+	// subnets whose addresses are never selected for
+	// agent-to-controller connections or unit ingress.
+	AddressSelectionExcludeCIDRsKey = "address-selection-exclude-cidrs"
+
 	// CloudInitUserDataKey is the key to specify cloud-init yaml the user
 	// wants to add into the cloud-config data produced by Juju when
 	// provisioning machines.
@@ -249,6 +284,29 @@ const (
 	// endpoint bindings.
 	DefaultSpace = "default-space"
 
+	// DefaultBindings specifies default endpoint bindings for new
+	// applications as a comma-separated list of pattern=space rules,
+	// e.g. "db*=internal,*=dmz". Rules are matched in order against
+	// endpoint names when an application is deployed without explicit
+	// bindings.
+	DefaultBindings = "default-bindings"
+
+	// AdditiveConstraintsKey names the list-valued constraint
+	// attributes (spaces, tags, zones) whose values are combined,
+	// rather than overridden, when application constraints are merged
+	// with model constraints.
+	AdditiveConstraintsKey = "additive-constraints"
+
+	// InstanceNamePrefixKey specifies a prefix to apply to provider
+	// instance display names (MAAS owner-data, other providers' name
+	// tags), so cloud bills and dashboards can attribute machines to
+	// a team or cost centre.
+	InstanceNamePrefixKey = "instance-name-prefix"
+
+	// InstanceNameSuffixKey specifies a suffix to apply to provider
+	// instance display names; see InstanceNamePrefixKey.
+	InstanceNameSuffixKey = "instance-name-suffix"
+
 	// LXDSnapChannel selects the channel to use when installing LXD from a snap.
 	LXDSnapChannel = "lxd-snap-channel"
 
@@ -280,6 +338,16 @@ const (
 	// DisableTelemetryKey is a key for determining whether telemetry on juju
 	// models will be done.
 	DisableTelemetryKey = "disable-telemetry"
+
+	// TelemetryMetricGroupsKey is a key for selecting which groups of
+	// metrics (controller, model, charm) are reported when telemetry
+	// is enabled. An empty value reports all groups.
+	TelemetryMetricGroupsKey = "telemetry-metric-groups"
+
+	// TelemetryPseudonymiseUUIDsKey is a key for determining whether
+	// controller and model UUIDs are replaced with stable pseudonyms
+	// in telemetry reports.
+	TelemetryPseudonymiseUUIDsKey = "telemetry-pseudonymise-uuids"
 )
 
 // ParseHarvestMode parses description of harvesting method and
@@ -399,8 +467,8 @@ const (
 // "ca-cert" and "ca-private-key" values.  If not specified, CA details
 // will be read from:
 //
-//     ~/.local/share/juju/<name>-cert.pem
-//     ~/.local/share/juju/<name>-private-key.pem
+//	~/.local/share/juju/<name>-cert.pem
+//	~/.local/share/juju/<name>-private-key.pem
 //
 // if $XDG_DATA_HOME is defined it will be used instead of ~/.local/share
 func New(withDefaults Defaulting, attrs map[string]interface{}) (*Config, error) {
@@ -461,6 +529,10 @@ var defaultConfigValues = map[string]interface{}{
 	"ssl-hostname-verification":  true,
 	"proxy-ssh":                  false,
 	DefaultSpace:                 "",
+	DefaultBindings:              "",
+	AdditiveConstraintsKey:       "",
+	InstanceNamePrefixKey:        "",
+	InstanceNameSuffixKey:        "",
 	// Why is net-bond-reconfigure-delay set to 17 seconds?
 	//
 	// The value represents the amount of time in seconds to sleep
@@ -481,24 +553,31 @@ var defaultConfigValues = map[string]interface{}{
 	NetBondReconfigureDelayKey: 17,
 	ContainerNetworkingMethod:  "",
 
-	"default-series":              jujuversion.DefaultSupportedLTS(),
-	ProvisionerHarvestModeKey:     HarvestDestroyed.String(),
-	ResourceTagsKey:               "",
-	"logging-config":              "",
-	AutomaticallyRetryHooks:       true,
-	"enable-os-refresh-update":    true,
-	"enable-os-upgrade":           true,
-	"development":                 false,
-	TestModeKey:                   false,
-	DisableTelemetryKey:           false,
-	TransmitVendorMetricsKey:      true,
-	UpdateStatusHookInterval:      DefaultUpdateStatusHookInterval,
-	EgressSubnets:                 "",
-	FanConfig:                     "",
-	CloudInitUserDataKey:          "",
-	ContainerInheritPropertiesKey: "",
-	BackupDirKey:                  "",
-	LXDSnapChannel:                "latest/stable",
+	"default-series":                jujuversion.DefaultSupportedLTS(),
+	ProvisionerHarvestModeKey:       HarvestDestroyed.String(),
+	ResourceTagsKey:                 "",
+	"logging-config":                "",
+	AutomaticallyRetryHooks:         true,
+	"enable-os-refresh-update":      true,
+	"enable-os-upgrade":             true,
+	"development":                   false,
+	TestModeKey:                     false,
+	DisableTelemetryKey:             false,
+	TelemetryMetricGroupsKey:        "",
+	TelemetryPseudonymiseUUIDsKey:   false,
+	TransmitVendorMetricsKey:        true,
+	UpdateStatusHookInterval:        DefaultUpdateStatusHookInterval,
+	EgressSubnets:                   "",
+	FanConfig:                       "",
+	DNSServersKey:                   "",
+	DNSSearchDomainsKey:             "",
+	AddressSelectionPreferIPv6Key:   false,
+	AddressSelectionPreferSpaceKey:  "",
+	AddressSelectionExcludeCIDRsKey: "",
+	CloudInitUserDataKey:            "",
+	ContainerInheritPropertiesKey:   "",
+	BackupDirKey:                    "",
+	LXDSnapChannel:                  "latest/stable",
 
 	CharmHubURLKey: charmhub.CharmHubServerURL,
 
@@ -646,6 +725,12 @@ func Validate(cfg, old *Config) error {
 		}
 	}
 
+	if lokiCfg, ok := cfg.LogFwdLoki(); ok {
+		if err := lokiCfg.Validate(); err != nil {
+			return errors.Annotate(err, "invalid loki forwarding config")
+		}
+	}
+
 	if uuid := cfg.UUID(); !utils.IsValidUUIDString(uuid) {
 		return errors.Errorf("uuid: expected UUID, got string(%q)", uuid)
 	}
@@ -711,6 +796,24 @@ func Validate(cfg, old *Config) error {
 		}
 	}
 
+	if raw, ok := cfg.defined[DNSServersKey].(string); ok && raw != "" {
+		for _, server := range strings.Split(raw, ",") {
+			server = strings.TrimSpace(server)
+			if net.ParseIP(server) == nil {
+				return errors.Errorf("dns-servers: %q is not a valid IP address", server)
+			}
+		}
+	}
+
+	if v, ok := cfg.defined[AddressSelectionExcludeCIDRsKey].(string); ok && v != "" {
+		cidrs := strings.Split(v, ",")
+		for _, cidr := range cidrs {
+			if _, _, err := net.ParseCIDR(strings.TrimSpace(cidr)); err != nil {
+				return errors.Annotatef(err, "invalid address selection excluded subnet: %v", cidr)
+			}
+		}
+	}
+
 	if v, ok := cfg.defined[ContainerNetworkingMethod].(string); ok {
 		switch v {
 		case "fan":
@@ -771,14 +874,39 @@ func Validate(cfg, old *Config) error {
 		}
 	}
 
+	if raw, ok := cfg.defined[TelemetryMetricGroupsKey].(string); ok && raw != "" {
+		groupSet := set.NewStrings()
+		for _, group := range strings.Split(raw, ",") {
+			groupSet.Add(strings.TrimSpace(group))
+		}
+		knownGroups := set.NewStrings("controller", "model", "charm")
+		diffSet := groupSet.Difference(knownGroups)
+
+		if !diffSet.IsEmpty() {
+			return errors.Errorf("telemetry-metric-groups: %s not allowed", strings.Join(diffSet.SortedValues(), ", "))
+		}
+	}
+
 	if err := cfg.validateCharmHubURL(); err != nil {
 		return errors.Trace(err)
 	}
 
+	if err := cfg.validateDefaultBindings(); err != nil {
+		return errors.Trace(err)
+	}
+
+	if err := cfg.validateAdditiveConstraints(); err != nil {
+		return errors.Trace(err)
+	}
+
 	if err := cfg.validateDefaultSpace(); err != nil {
 		return errors.Trace(err)
 	}
 
+	if err := cfg.validateInstanceNaming(); err != nil {
+		return errors.Trace(err)
+	}
+
 	if err := cfg.validateMode(); err != nil {
 		return errors.Trace(err)
 	}
@@ -911,6 +1039,124 @@ func (c *Config) DefaultSpace() string {
 	return c.asString(DefaultSpace)
 }
 
+// DefaultBindingRule associates an endpoint-name pattern with the space
+// endpoints matching it should be bound to by default.
+type DefaultBindingRule struct {
+	// Pattern is a shell-style pattern matched against endpoint names.
+	Pattern string
+
+	// Space is the name of the space to bind matching endpoints to.
+	Space string
+}
+
+// ParseDefaultBindings parses a comma-separated list of pattern=space
+// rules, as held in the default-bindings model config attribute.
+func ParseDefaultBindings(s string) ([]DefaultBindingRule, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var rules []DefaultBindingRule
+	for _, raw := range strings.Split(s, ",") {
+		raw = strings.TrimSpace(raw)
+		parts := strings.SplitN(raw, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, errors.NotValidf("default binding %q, expected pattern=space", raw)
+		}
+		pattern, space := parts[0], parts[1]
+		if _, err := path.Match(pattern, "endpoint"); err != nil {
+			return nil, errors.NotValidf("default binding pattern %q", pattern)
+		}
+		if !names.IsValidSpace(space) {
+			return nil, errors.NotValidf("default binding space name %q", space)
+		}
+		rules = append(rules, DefaultBindingRule{Pattern: pattern, Space: space})
+	}
+	return rules, nil
+}
+
+func (c *Config) validateDefaultBindings() error {
+	_, err := ParseDefaultBindings(c.asString(DefaultBindings))
+	return errors.Trace(err)
+}
+
+// DefaultBindings returns the model's default endpoint binding rules,
+// applied in order to endpoints of applications deployed without
+// explicit bindings.
+func (c *Config) DefaultBindings() []DefaultBindingRule {
+	rules, _ := ParseDefaultBindings(c.asString(DefaultBindings))
+	return rules
+}
+
+// additiveConstraintAttributes are the constraint attributes that may
+// be named in the additive-constraints model config attribute; only
+// list-valued constraints have meaningful additive merge semantics.
+var additiveConstraintAttributes = set.NewStrings(
+	constraints.Spaces,
+	constraints.Tags,
+	constraints.Zones,
+)
+
+func (c *Config) validateAdditiveConstraints() error {
+	for _, attr := range c.AdditiveConstraints() {
+		if !additiveConstraintAttributes.Contains(attr) {
+			return errors.NotValidf("additive constraint attribute %q", attr)
+		}
+	}
+	return nil
+}
+
+// AdditiveConstraints returns the list-valued constraint attributes
+// whose values are combined when application constraints are merged
+// with model constraints.
+func (c *Config) AdditiveConstraints() []string {
+	raw := c.asString(AdditiveConstraintsKey)
+	if raw == "" {
+		return nil
+	}
+	var attrs []string
+	for _, attr := range strings.Split(raw, ",") {
+		attrs = append(attrs, strings.TrimSpace(attr))
+	}
+	return attrs
+}
+
+// validInstanceNamePattern constrains instance name prefixes and
+// suffixes to characters that are safe for hostnames and cloud name
+// tags across providers.
+var validInstanceNamePattern = regexp.MustCompile(`^[a-z0-9-]+$`)
+
+// maxInstanceNameAffixLength bounds instance name prefixes and
+// suffixes so decorated names stay within provider name limits.
+const maxInstanceNameAffixLength = 16
+
+func (c *Config) validateInstanceNaming() error {
+	for _, key := range []string{InstanceNamePrefixKey, InstanceNameSuffixKey} {
+		value := c.asString(key)
+		if value == "" {
+			continue
+		}
+		if len(value) > maxInstanceNameAffixLength {
+			return errors.Errorf("%s %q longer than %d characters", key, value, maxInstanceNameAffixLength)
+		}
+		if !validInstanceNamePattern.MatchString(value) {
+			return errors.NotValidf("%s %q", key, value)
+		}
+	}
+	return nil
+}
+
+// InstanceNamePrefix returns the prefix to apply to provider instance
+// display names, if one is configured for the model.
+func (c *Config) InstanceNamePrefix() string {
+	return c.asString(InstanceNamePrefixKey)
+}
+
+// InstanceNameSuffix returns the suffix to apply to provider instance
+// display names, if one is configured for the model.
+func (c *Config) InstanceNameSuffix() string {
+	return c.asString(InstanceNameSuffixKey)
+}
+
 // DefaultSeries returns the configured default Ubuntu series for the model,
 // and whether the default series was explicitly configured on the environment.
 func (c *Config) DefaultSeries() (string, bool) {
@@ -1169,6 +1415,24 @@ func (c *Config) LogFwdSyslog() (*syslog.RawConfig, bool) {
 	return &lfCfg, true
 }
 
+// LogFwdLoki returns the Loki forwarding config.
+func (c *Config) LogFwdLoki() (*logfwd.LokiConfig, bool) {
+	endpoint, ok := c.defined[LogFwdLokiEndpoint].(string)
+	if !ok || endpoint == "" {
+		return nil, false
+	}
+	lfCfg := logfwd.LokiConfig{Endpoint: endpoint}
+
+	if s, ok := c.defined[LogForwardEnabled]; ok {
+		lfCfg.Enabled = s.(bool)
+	}
+
+	if labels, ok := c.defined[LogFwdLokiLabels].(map[string]string); ok {
+		lfCfg.Labels = labels
+	}
+	return &lfCfg, true
+}
+
 // FirewallMode returns whether the firewall should
 // manage ports per machine, globally, or not at all.
 // (FwInstance, FwGlobal, or FwNone).
@@ -1503,6 +1767,49 @@ func (c *Config) FanConfig() (network.FanConfig, error) {
 	return network.ParseFanConfig(c.asString(FanConfig))
 }
 
+// DNSServers returns the DNS servers that machines in the model should
+// use. An empty result means the provider's DNS configuration is left
+// alone.
+func (c *Config) DNSServers() []string {
+	return splitCommaList(c.asString(DNSServersKey))
+}
+
+// DNSSearchDomains returns the DNS search domains that machines in the
+// model should use.
+func (c *Config) DNSSearchDomains() []string {
+	return splitCommaList(c.asString(DNSSearchDomainsKey))
+}
+
+func splitCommaList(raw string) []string {
+	if raw == "" {
+		return nil
+	}
+	values := strings.Split(raw, ",")
+	for i, value := range values {
+		values[i] = strings.TrimSpace(value)
+	}
+	return values
+}
+
+// AddressSelectionPolicy returns the model's preferences for sorting and
+// selecting addresses for agent-to-controller connections and unit ingress.
+func (c *Config) AddressSelectionPolicy() network.AddressSelectionPolicy {
+	policy := network.AddressSelectionPolicy{
+		PreferSpaceName: network.SpaceName(c.asString(AddressSelectionPreferSpaceKey)),
+	}
+	policy.PreferIPv6, _ = c.defined[AddressSelectionPreferIPv6Key].(bool)
+
+	// The value has already been validated.
+	if raw := c.asString(AddressSelectionExcludeCIDRsKey); raw != "" {
+		cidrs := strings.Split(raw, ",")
+		policy.ExcludeCIDRs = make([]string, len(cidrs))
+		for i, cidr := range cidrs {
+			policy.ExcludeCIDRs[i] = strings.TrimSpace(cidr)
+		}
+	}
+	return policy
+}
+
 // CloudInitUserData returns a copy of the raw user data attributes
 // that were specified by the user.
 func (c *Config) CloudInitUserData() map[string]interface{} {
@@ -1532,6 +1839,42 @@ func (c *Config) Telemetry() bool {
 	return !value
 }
 
+// TelemetryMetricGroups returns the groups of metrics reported when
+// telemetry is enabled. An empty result means all groups are reported.
+func (c *Config) TelemetryMetricGroups() []string {
+	raw := c.asString(TelemetryMetricGroupsKey)
+	if raw == "" {
+		return nil
+	}
+	groups := strings.Split(raw, ",")
+	for i, group := range groups {
+		groups[i] = strings.TrimSpace(group)
+	}
+	return groups
+}
+
+// TelemetryMetricGroupEnabled returns whether the named group of metrics
+// is reported when telemetry is enabled.
+func (c *Config) TelemetryMetricGroupEnabled(group string) bool {
+	groups := c.TelemetryMetricGroups()
+	if len(groups) == 0 {
+		return true
+	}
+	for _, g := range groups {
+		if g == group {
+			return true
+		}
+	}
+	return false
+}
+
+// TelemetryPseudonymiseUUIDs returns whether controller and model UUIDs
+// are replaced with stable pseudonyms in telemetry reports.
+func (c *Config) TelemetryPseudonymiseUUIDs() bool {
+	value, _ := c.defined[TelemetryPseudonymiseUUIDsKey].(bool)
+	return value
+}
+
 // UnknownAttrs returns a copy of the raw configuration attributes
 // that are supposedly specific to the environment type. They could
 // also be wrong attributes, though. Only the specific environment
@@ -1602,69 +1945,82 @@ var alwaysOptional = schema.Defaults{
 	LogFwdSyslogCACert:     schema.Omit,
 	LogFwdSyslogClientCert: schema.Omit,
 	LogFwdSyslogClientKey:  schema.Omit,
+	LogFwdLokiEndpoint:     schema.Omit,
+	LogFwdLokiLabels:       schema.Omit,
 
 	// Storage related config.
 	// Environ providers will specify their own defaults.
 	StorageDefaultBlockSourceKey:      schema.Omit,
 	StorageDefaultFilesystemSourceKey: schema.Omit,
 
-	"firewall-mode":               schema.Omit,
-	"logging-config":              schema.Omit,
-	ProvisionerHarvestModeKey:     schema.Omit,
-	HTTPProxyKey:                  schema.Omit,
-	HTTPSProxyKey:                 schema.Omit,
-	FTPProxyKey:                   schema.Omit,
-	NoProxyKey:                    schema.Omit,
-	JujuHTTPProxyKey:              schema.Omit,
-	JujuHTTPSProxyKey:             schema.Omit,
-	JujuFTPProxyKey:               schema.Omit,
-	JujuNoProxyKey:                schema.Omit,
-	AptHTTPProxyKey:               schema.Omit,
-	AptHTTPSProxyKey:              schema.Omit,
-	AptFTPProxyKey:                schema.Omit,
-	AptNoProxyKey:                 schema.Omit,
-	SnapHTTPProxyKey:              schema.Omit,
-	SnapHTTPSProxyKey:             schema.Omit,
-	SnapStoreProxyKey:             schema.Omit,
-	SnapStoreAssertionsKey:        schema.Omit,
-	SnapStoreProxyURLKey:          schema.Omit,
-	AptMirrorKey:                  schema.Omit,
-	AgentStreamKey:                schema.Omit,
-	ResourceTagsKey:               schema.Omit,
-	"cloudimg-base-url":           schema.Omit,
-	"enable-os-refresh-update":    schema.Omit,
-	"enable-os-upgrade":           schema.Omit,
-	"image-stream":                schema.Omit,
-	"image-metadata-url":          schema.Omit,
-	AgentMetadataURLKey:           schema.Omit,
-	ContainerImageStreamKey:       schema.Omit,
-	ContainerImageMetadataURLKey:  schema.Omit,
-	"default-series":              schema.Omit,
-	"development":                 schema.Omit,
-	"ssl-hostname-verification":   schema.Omit,
-	"proxy-ssh":                   schema.Omit,
-	"disable-network-management":  schema.Omit,
-	IgnoreMachineAddresses:        schema.Omit,
-	AutomaticallyRetryHooks:       schema.Omit,
-	TestModeKey:                   schema.Omit,
-	DisableTelemetryKey:           schema.Omit,
-	ModeKey:                       schema.Omit,
-	TransmitVendorMetricsKey:      schema.Omit,
-	NetBondReconfigureDelayKey:    schema.Omit,
-	ContainerNetworkingMethod:     schema.Omit,
-	MaxStatusHistoryAge:           schema.Omit,
-	MaxStatusHistorySize:          schema.Omit,
-	MaxActionResultsAge:           schema.Omit,
-	MaxActionResultsSize:          schema.Omit,
-	UpdateStatusHookInterval:      schema.Omit,
-	EgressSubnets:                 schema.Omit,
-	FanConfig:                     schema.Omit,
-	CloudInitUserDataKey:          schema.Omit,
-	ContainerInheritPropertiesKey: schema.Omit,
-	BackupDirKey:                  schema.Omit,
-	DefaultSpace:                  schema.Omit,
-	LXDSnapChannel:                schema.Omit,
-	CharmHubURLKey:                schema.Omit,
+	"firewall-mode":                 schema.Omit,
+	"logging-config":                schema.Omit,
+	ProvisionerHarvestModeKey:       schema.Omit,
+	HTTPProxyKey:                    schema.Omit,
+	HTTPSProxyKey:                   schema.Omit,
+	FTPProxyKey:                     schema.Omit,
+	NoProxyKey:                      schema.Omit,
+	JujuHTTPProxyKey:                schema.Omit,
+	JujuHTTPSProxyKey:               schema.Omit,
+	JujuFTPProxyKey:                 schema.Omit,
+	JujuNoProxyKey:                  schema.Omit,
+	AptHTTPProxyKey:                 schema.Omit,
+	AptHTTPSProxyKey:                schema.Omit,
+	AptFTPProxyKey:                  schema.Omit,
+	AptNoProxyKey:                   schema.Omit,
+	SnapHTTPProxyKey:                schema.Omit,
+	SnapHTTPSProxyKey:               schema.Omit,
+	SnapStoreProxyKey:               schema.Omit,
+	SnapStoreAssertionsKey:          schema.Omit,
+	SnapStoreProxyURLKey:            schema.Omit,
+	AptMirrorKey:                    schema.Omit,
+	AgentStreamKey:                  schema.Omit,
+	ResourceTagsKey:                 schema.Omit,
+	"cloudimg-base-url":             schema.Omit,
+	"enable-os-refresh-update":      schema.Omit,
+	"enable-os-upgrade":             schema.Omit,
+	"image-stream":                  schema.Omit,
+	"image-metadata-url":            schema.Omit,
+	AgentMetadataURLKey:             schema.Omit,
+	ContainerImageStreamKey:         schema.Omit,
+	ContainerImageMetadataURLKey:    schema.Omit,
+	"default-series":                schema.Omit,
+	"development":                   schema.Omit,
+	"ssl-hostname-verification":     schema.Omit,
+	"proxy-ssh":                     schema.Omit,
+	"disable-network-management":    schema.Omit,
+	IgnoreMachineAddresses:          schema.Omit,
+	AutomaticallyRetryHooks:         schema.Omit,
+	TestModeKey:                     schema.Omit,
+	DisableTelemetryKey:             schema.Omit,
+	TelemetryMetricGroupsKey:        schema.Omit,
+	TelemetryPseudonymiseUUIDsKey:   schema.Omit,
+	DNSServersKey:                   schema.Omit,
+	DNSSearchDomainsKey:             schema.Omit,
+	ModeKey:                         schema.Omit,
+	TransmitVendorMetricsKey:        schema.Omit,
+	NetBondReconfigureDelayKey:      schema.Omit,
+	ContainerNetworkingMethod:       schema.Omit,
+	MaxStatusHistoryAge:             schema.Omit,
+	MaxStatusHistorySize:            schema.Omit,
+	MaxActionResultsAge:             schema.Omit,
+	MaxActionResultsSize:            schema.Omit,
+	UpdateStatusHookInterval:        schema.Omit,
+	EgressSubnets:                   schema.Omit,
+	FanConfig:                       schema.Omit,
+	AddressSelectionPreferIPv6Key:   schema.Omit,
+	AddressSelectionPreferSpaceKey:  schema.Omit,
+	AddressSelectionExcludeCIDRsKey: schema.Omit,
+	CloudInitUserDataKey:            schema.Omit,
+	ContainerInheritPropertiesKey:   schema.Omit,
+	BackupDirKey:                    schema.Omit,
+	DefaultSpace:                    schema.Omit,
+	DefaultBindings:                 schema.Omit,
+	AdditiveConstraintsKey:          schema.Omit,
+	InstanceNamePrefixKey:           schema.Omit,
+	InstanceNameSuffixKey:           schema.Omit,
+	LXDSnapChannel:                  schema.Omit,
+	CharmHubURLKey:                  schema.Omit,
 }
 
 func allowEmpty(attr string) bool {
@@ -2063,6 +2419,16 @@ global or per instance security groups.`,
 		Type:        environschema.Tstring,
 		Group:       environschema.EnvironGroup,
 	},
+	LogFwdLokiEndpoint: {
+		Description: `The URL of the Loki push API endpoint for log forwarding.`,
+		Type:        environschema.Tstring,
+		Group:       environschema.EnvironGroup,
+	},
+	LogFwdLokiLabels: {
+		Description: `Additional static labels attached to log streams pushed to Loki.`,
+		Type:        environschema.Tattrs,
+		Group:       environschema.EnvironGroup,
+	},
 	"ssl-hostname-verification": {
 		Description: "Whether SSL hostname verification is enabled (default true)",
 		Type:        environschema.Tbool,
@@ -2090,6 +2456,17 @@ data of the store. (default false)`,
 		Type:        environschema.Tbool,
 		Group:       environschema.EnvironGroup,
 	},
+	TelemetryMetricGroupsKey: {
+		Description: `Comma separated list of metric groups (controller, model, charm)
+reported when telemetry is enabled. An empty value reports all groups. (default "")`,
+		Type:  environschema.Tstring,
+		Group: environschema.EnvironGroup,
+	},
+	TelemetryPseudonymiseUUIDsKey: {
+		Description: `Replace controller and model UUIDs with stable pseudonyms in telemetry reports`,
+		Type:        environschema.Tbool,
+		Group:       environschema.EnvironGroup,
+	},
 	ModeKey: {
 		Description: `Mode sets the type of mode the model should run in.
 If the mode is set to "strict" then errors will be used instead of
@@ -2166,6 +2543,33 @@ where possible. (default "")`,
 		Type:        environschema.Tstring,
 		Group:       environschema.EnvironGroup,
 	},
+	DNSServersKey: {
+		Description: `Comma separated list of DNS servers that machines in the
+model should use, overriding the provider's DNS configuration`,
+		Type:  environschema.Tstring,
+		Group: environschema.EnvironGroup,
+	},
+	DNSSearchDomainsKey: {
+		Description: `Comma separated list of DNS search domains that machines
+in the model should use`,
+		Type:  environschema.Tstring,
+		Group: environschema.EnvironGroup,
+	},
+	AddressSelectionPreferIPv6Key: {
+		Description: "Whether IPv6 addresses are preferred over IPv4 addresses when selecting addresses for agents and unit ingress",
+		Type:        environschema.Tbool,
+		Group:       environschema.EnvironGroup,
+	},
+	AddressSelectionPreferSpaceKey: {
+		Description: "The name of a space whose addresses are preferred when selecting addresses for agents and unit ingress",
+		Type:        environschema.Tstring,
+		Group:       environschema.EnvironGroup,
+	},
+	AddressSelectionExcludeCIDRsKey: {
+		Description: "Subnets (comma-separated) whose addresses are never selected for agents or unit ingress",
+		Type:        environschema.Tstring,
+		Group:       environschema.EnvironGroup,
+	},
 	CloudInitUserDataKey: {
 		Description: "Cloud-init user-data (in yaml format) to be added to userdata for new machines created in this model",
 		Type:        environschema.Tstring,
@@ -2186,6 +2590,26 @@ where possible. (default "")`,
 		Type:        environschema.Tstring,
 		Group:       environschema.EnvironGroup,
 	},
+	DefaultBindings: {
+		Description: "Default endpoint bindings for new applications as comma-separated pattern=space rules",
+		Type:        environschema.Tstring,
+		Group:       environschema.EnvironGroup,
+	},
+	AdditiveConstraintsKey: {
+		Description: "Comma-separated list-valued constraint attributes (spaces, tags, zones) merged additively between model and application constraints",
+		Type:        environschema.Tstring,
+		Group:       environschema.EnvironGroup,
+	},
+	InstanceNamePrefixKey: {
+		Description: "Prefix applied to provider instance display names for billing attribution",
+		Type:        environschema.Tstring,
+		Group:       environschema.EnvironGroup,
+	},
+	InstanceNameSuffixKey: {
+		Description: "Suffix applied to provider instance display names for billing attribution",
+		Type:        environschema.Tstring,
+		Group:       environschema.EnvironGroup,
+	},
 	LXDSnapChannel: {
 		Description: "The channel to use when installing LXD from a snap (cosmic and later)",
 		Type:        environschema.Tstring,
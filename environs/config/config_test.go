@@ -582,6 +582,27 @@ var configTests = []configTest{
 		attrs: minimalConfigAttrs.Merge(testing.Attrs{
 			"default-space": "bar",
 		}),
+	}, {
+		about:       "Valid instance naming policy",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"instance-name-prefix": "teamx-",
+			"instance-name-suffix": "-cc42",
+		}),
+	}, {
+		about:       "Invalid instance-name-prefix",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"instance-name-prefix": "Team X",
+		}),
+		err: `instance-name-prefix "Team X" not valid`,
+	}, {
+		about:       "Overlong instance-name-suffix",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"instance-name-suffix": "-an-extremely-verbose-cost-centre",
+		}),
+		err: `instance-name-suffix "-an-extremely-verbose-cost-centre" longer than 16 characters`,
 	}, {
 		about:       "Valid charm-hub api url",
 		useDefaults: config.UseDefaults,
@@ -1188,6 +1209,81 @@ func (s *ConfigSuite) TestCharmHubURL(c *gc.C) {
 	c.Assert(chURL, gc.Equals, charmhub.CharmHubServerURL)
 }
 
+func (s *ConfigSuite) TestDefaultBindings(c *gc.C) {
+	cfg := newTestConfig(c, testing.Attrs{})
+	c.Assert(cfg.DefaultBindings(), gc.HasLen, 0)
+
+	cfg = newTestConfig(c, testing.Attrs{
+		"default-bindings": "db*=internal, *=public",
+	})
+	c.Assert(cfg.DefaultBindings(), gc.DeepEquals, []config.DefaultBindingRule{
+		{Pattern: "db*", Space: "internal"},
+		{Pattern: "*", Space: "public"},
+	})
+}
+
+func (s *ConfigSuite) TestParseDefaultBindings(c *gc.C) {
+	rules, err := config.ParseDefaultBindings("")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rules, gc.IsNil)
+
+	rules, err = config.ParseDefaultBindings("db*=internal")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rules, gc.DeepEquals, []config.DefaultBindingRule{
+		{Pattern: "db*", Space: "internal"},
+	})
+
+	for _, t := range []struct {
+		value string
+		err   string
+	}{{
+		value: "db",
+		err:   `default binding "db", expected pattern=space not valid`,
+	}, {
+		value: "=internal",
+		err:   `default binding "=internal", expected pattern=space not valid`,
+	}, {
+		value: "[=internal",
+		err:   `default binding pattern "\[" not valid`,
+	}, {
+		value: "db*=Not A Space",
+		err:   `default binding space name "Not A Space" not valid`,
+	}} {
+		_, err = config.ParseDefaultBindings(t.value)
+		c.Assert(err, gc.ErrorMatches, t.err)
+	}
+}
+
+func (s *ConfigSuite) TestDefaultBindingsInvalid(c *gc.C) {
+	final := testing.Attrs{
+		"type": "my-type", "name": "my-name",
+		"uuid":             testing.ModelTag.Id(),
+		"default-bindings": "db",
+	}
+	_, err := config.New(config.UseDefaults, final)
+	c.Assert(err, gc.ErrorMatches, `default binding "db", expected pattern=space not valid`)
+}
+
+func (s *ConfigSuite) TestAdditiveConstraints(c *gc.C) {
+	config := newTestConfig(c, testing.Attrs{})
+	c.Assert(config.AdditiveConstraints(), gc.HasLen, 0)
+
+	config = newTestConfig(c, testing.Attrs{
+		"additive-constraints": "spaces, tags",
+	})
+	c.Assert(config.AdditiveConstraints(), gc.DeepEquals, []string{"spaces", "tags"})
+}
+
+func (s *ConfigSuite) TestAdditiveConstraintsInvalidAttribute(c *gc.C) {
+	final := testing.Attrs{
+		"type": "my-type", "name": "my-name",
+		"uuid":                 testing.ModelTag.Id(),
+		"additive-constraints": "mem",
+	}
+	_, err := config.New(config.UseDefaults, final)
+	c.Assert(err, gc.ErrorMatches, `additive constraint attribute "mem" not valid`)
+}
+
 func (s *ConfigSuite) TestMode(c *gc.C) {
 	config := newTestConfig(c, testing.Attrs{})
 	mode, ok := config.Mode()
@@ -21,3 +21,14 @@ func CallContext(ctx ModelCredentialInvalidator) *CloudCallContext {
 	callCtx.InvalidateCredentialFunc = ctx.InvalidateModelCredential
 	return callCtx
 }
+
+// WithCancellation returns a copy of the given call context that is
+// tied to the given standard context, so that provider calls made
+// with it are abandoned if the standard context is cancelled. It is
+// intended for scoping a long-lived call context to a single request.
+func WithCancellation(stdCtx stdcontext.Context, callCtx ProviderCallContext) *CloudCallContext {
+	return &CloudCallContext{
+		Context:                  stdCtx,
+		InvalidateCredentialFunc: callCtx.InvalidateCredential,
+	}
+}
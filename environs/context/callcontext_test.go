@@ -4,6 +4,8 @@
 package context
 
 import (
+	stdcontext "context"
+
 	"github.com/golang/mock/gomock"
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
@@ -29,3 +31,22 @@ func (s *CallContextSuite) TestCallContext(c *gc.C) {
 	err := ctx.InvalidateCredential("call")
 	c.Assert(err, jc.ErrorIsNil)
 }
+
+func (s *CallContextSuite) TestWithCancellation(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	invalidator := NewMockModelCredentialInvalidator(ctrl)
+	invalidator.EXPECT().InvalidateModelCredential("call").Return(nil)
+
+	stdCtx, cancel := stdcontext.WithCancel(stdcontext.Background())
+	ctx := WithCancellation(stdCtx, CallContext(invalidator))
+	c.Assert(ctx.Err(), jc.ErrorIsNil)
+
+	// The credential callback of the original context is preserved.
+	err := ctx.InvalidateCredential("call")
+	c.Assert(err, jc.ErrorIsNil)
+
+	cancel()
+	c.Assert(ctx.Err(), gc.Equals, stdcontext.Canceled)
+}
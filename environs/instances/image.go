@@ -68,6 +68,23 @@ func FindInstanceSpec(possibleImages []Image, ic *InstanceConstraint, allInstanc
 			ic.Series, ic.Region, ic.Arches)
 	}
 
+	// An image-id constraint selects exactly one image; restrict the
+	// possibilities to it, validating the id against the image metadata
+	// we were given.
+	if ic.Constraints.HasImageID() {
+		imageID := *ic.Constraints.ImageID
+		var matched []Image
+		for _, image := range possibleImages {
+			if image.Id == imageID {
+				matched = append(matched, image)
+			}
+		}
+		if len(matched) == 0 {
+			return nil, errors.Errorf("no image metadata for image-id %q in %s", imageID, ic.Region)
+		}
+		possibleImages = matched
+	}
+
 	logger.Debugf("matching constraints %v against possible image metadata %+v", ic, possibleImages)
 	matchingTypes, err := MatchingInstanceTypes(allInstanceTypes, ic.Region, ic.Constraints)
 	if err != nil {
@@ -23,6 +23,15 @@ type Instance interface {
 	Addresses(context.ProviderCallContext) (corenetwork.ProviderAddresses, error)
 }
 
+// InstanceHardware is an optional interface implemented by instances
+// that can report their current hardware characteristics, allowing the
+// values recorded at provisioning time to be refreshed.
+type InstanceHardware interface {
+	// HardwareCharacteristics returns the hardware characteristics
+	// currently reported by the provider for the instance.
+	HardwareCharacteristics(context.ProviderCallContext) (*instance.HardwareCharacteristics, error)
+}
+
 // InstanceFirewaller provides instance-level firewall functionality
 type InstanceFirewaller interface {
 	// OpenPorts opens the given port ranges on the instance, which
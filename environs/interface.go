@@ -16,6 +16,7 @@ import (
 	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/network/firewall"
+	"github.com/juju/juju/core/watcher"
 	environscloudspec "github.com/juju/juju/environs/cloudspec"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/environs/context"
@@ -526,12 +527,43 @@ type InstanceTagger interface {
 	TagInstance(ctx context.ProviderCallContext, id instance.Id, tags map[string]string) error
 }
 
+// InstanceConsole is an interface that can be used for retrieving the
+// console output of an instance, so boot failures can be debugged from
+// the client without locating the instance in the cloud's own console.
+type InstanceConsole interface {
+	// ConsoleOutput returns the console output most recently captured
+	// by the provider for the given instance.
+	ConsoleOutput(ctx context.ProviderCallContext, id instance.Id) (string, error)
+}
+
+// InstanceNotifier is an optional interface implemented by environs
+// whose substrate can push notifications when instances change (e.g.
+// MAAS websockets). Pollers use these notifications to react to
+// instance changes instead of discovering them on fixed poll
+// intervals, reducing the provider API load for large models.
+type InstanceNotifier interface {
+	// WatchInstances returns a watcher that reports the IDs of
+	// instances whose state has changed in the provider.
+	WatchInstances(ctx context.ProviderCallContext) (watcher.StringsWatcher, error)
+}
+
 // InstanceTypesFetcher is an interface that allows for instance information from
 // a provider to be obtained.
 type InstanceTypesFetcher interface {
 	InstanceTypes(context.ProviderCallContext, constraints.Value) (instances.InstanceTypesWithCostMetadata, error)
 }
 
+// VMHostEnviron is an optional interface implemented by environs whose
+// substrate can compose machines on registered VM hosts (e.g. MAAS pods
+// or LXD cluster members). Such hosts are targeted with the "vmhost="
+// placement directive, and the chosen host is reported in the hardware
+// characteristics of the started instance.
+type VMHostEnviron interface {
+	// VMHosts returns the names of the VM hosts on which machines
+	// can be composed.
+	VMHosts(ctx context.ProviderCallContext) ([]string, error)
+}
+
 // Upgrader is an interface that can be used for upgrading Environs. If an
 // Environ implements this interface, its UpgradeOperations method will be
 // invoked to identify operations that should be run on upgrade.
@@ -0,0 +1,126 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package space
+
+import (
+	"sort"
+
+	"github.com/juju/juju/core/network"
+)
+
+// MissingSubnet identifies a subnet reported by the provider
+// that is not recorded against any space in the model.
+type MissingSubnet struct {
+	// CIDR is the subnet's CIDR as reported by the provider.
+	CIDR string
+
+	// SpaceName is the name of the space the subnet would be
+	// recorded against if the plan were applied.
+	SpaceName string
+}
+
+// MovedSubnet identifies a subnet that the provider reports in a
+// different space than the one it is recorded against in the model.
+type MovedSubnet struct {
+	// CIDR is the subnet's CIDR.
+	CIDR string
+
+	// SpaceFrom is the name of the space the subnet is currently
+	// recorded against in the model.
+	SpaceFrom string
+
+	// SpaceTo is the name of the space the provider reports the
+	// subnet in.
+	SpaceTo string
+}
+
+// ReconciliationPlan describes the differences between the network
+// topology reported by the provider and the one recorded in the model.
+type ReconciliationPlan struct {
+	// MissingSubnets are provider subnets not present in the model.
+	MissingSubnets []MissingSubnet
+
+	// StaleSpaces are provider-sourced spaces recorded in the model
+	// that the provider no longer reports.
+	StaleSpaces []string
+
+	// MovedSubnets are subnets recorded in the model under a
+	// different space than the provider reports.
+	MovedSubnets []MovedSubnet
+}
+
+// IsEmpty returns true if provider and model agree,
+// leaving nothing to reconcile.
+func (p ReconciliationPlan) IsEmpty() bool {
+	return len(p.MissingSubnets) == 0 && len(p.StaleSpaces) == 0 && len(p.MovedSubnets) == 0
+}
+
+// Reconcile compares the spaces and subnets reported by the provider
+// with those recorded in the model and returns a plan describing the
+// drift between the two. It makes no changes; applying the plan is the
+// equivalent of calling ReloadSpaces.
+func Reconcile(providerSpaces []network.SpaceInfo, modelSpaces network.SpaceInfos) ReconciliationPlan {
+	modelSpaceByProviderID := make(map[network.Id]network.SpaceInfo)
+	modelSpaceBySubnetCIDR := make(map[string]string)
+	for _, space := range modelSpaces {
+		if space.ProviderId != "" {
+			modelSpaceByProviderID[space.ProviderId] = space
+		}
+		for _, subnet := range space.Subnets {
+			modelSpaceBySubnetCIDR[subnet.CIDR] = string(space.Name)
+		}
+	}
+
+	var plan ReconciliationPlan
+	seen := network.MakeIDSet()
+	for _, providerSpace := range providerSpaces {
+		seen.Add(providerSpace.ProviderId)
+
+		// If the provider space is already recorded, subnets belong
+		// under the name it has in the model, which may differ from
+		// the provider's name after a rename or de-duplication.
+		spaceName := string(providerSpace.Name)
+		if modelSpace, ok := modelSpaceByProviderID[providerSpace.ProviderId]; ok {
+			spaceName = string(modelSpace.Name)
+		}
+
+		for _, subnet := range providerSpace.Subnets {
+			recordedIn, ok := modelSpaceBySubnetCIDR[subnet.CIDR]
+			if !ok {
+				plan.MissingSubnets = append(plan.MissingSubnets, MissingSubnet{
+					CIDR:      subnet.CIDR,
+					SpaceName: spaceName,
+				})
+			} else if recordedIn != spaceName {
+				plan.MovedSubnets = append(plan.MovedSubnets, MovedSubnet{
+					CIDR:      subnet.CIDR,
+					SpaceFrom: recordedIn,
+					SpaceTo:   spaceName,
+				})
+			}
+		}
+	}
+
+	for _, space := range modelSpaces {
+		if space.ProviderId == "" || seen.Contains(space.ProviderId) {
+			continue
+		}
+		if string(space.Name) == network.AlphaSpaceName {
+			continue
+		}
+		plan.StaleSpaces = append(plan.StaleSpaces, string(space.Name))
+	}
+
+	// Sort for stable output; provider and state ordering are not
+	// guaranteed.
+	sort.Slice(plan.MissingSubnets, func(i, j int) bool {
+		return plan.MissingSubnets[i].CIDR < plan.MissingSubnets[j].CIDR
+	})
+	sort.Slice(plan.MovedSubnets, func(i, j int) bool {
+		return plan.MovedSubnets[i].CIDR < plan.MovedSubnets[j].CIDR
+	})
+	sort.Strings(plan.StaleSpaces)
+
+	return plan
+}
@@ -0,0 +1,137 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package space
+
+import (
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/network"
+)
+
+type reconcileSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&reconcileSuite{})
+
+func (s *reconcileSuite) TestReconcileInSync(c *gc.C) {
+	provider := []network.SpaceInfo{{
+		Name:       "db",
+		ProviderId: "1",
+		Subnets:    []network.SubnetInfo{{CIDR: "10.0.0.0/24"}},
+	}}
+	model := network.SpaceInfos{{
+		Name:       "db",
+		ProviderId: "1",
+		Subnets:    []network.SubnetInfo{{CIDR: "10.0.0.0/24"}},
+	}}
+
+	plan := Reconcile(provider, model)
+	c.Assert(plan.IsEmpty(), jc.IsTrue)
+}
+
+func (s *reconcileSuite) TestReconcileMissingSubnets(c *gc.C) {
+	provider := []network.SpaceInfo{{
+		Name:       "db",
+		ProviderId: "1",
+		Subnets: []network.SubnetInfo{
+			{CIDR: "10.0.0.0/24"},
+			{CIDR: "10.0.1.0/24"},
+		},
+	}, {
+		Name:       "dmz",
+		ProviderId: "2",
+		Subnets:    []network.SubnetInfo{{CIDR: "10.0.2.0/24"}},
+	}}
+	model := network.SpaceInfos{{
+		Name:       "db",
+		ProviderId: "1",
+		Subnets:    []network.SubnetInfo{{CIDR: "10.0.0.0/24"}},
+	}}
+
+	plan := Reconcile(provider, model)
+	c.Assert(plan.IsEmpty(), jc.IsFalse)
+	c.Assert(plan.MissingSubnets, jc.DeepEquals, []MissingSubnet{
+		{CIDR: "10.0.1.0/24", SpaceName: "db"},
+		{CIDR: "10.0.2.0/24", SpaceName: "dmz"},
+	})
+	c.Assert(plan.StaleSpaces, gc.HasLen, 0)
+	c.Assert(plan.MovedSubnets, gc.HasLen, 0)
+}
+
+func (s *reconcileSuite) TestReconcileMissingSubnetUsesModelSpaceName(c *gc.C) {
+	// The model knows the provider space under a converted name;
+	// new subnets should be reported against that name.
+	provider := []network.SpaceInfo{{
+		Name:       "Admin DB",
+		ProviderId: "1",
+		Subnets:    []network.SubnetInfo{{CIDR: "10.0.1.0/24"}},
+	}}
+	model := network.SpaceInfos{{
+		Name:       "admin-db",
+		ProviderId: "1",
+	}}
+
+	plan := Reconcile(provider, model)
+	c.Assert(plan.MissingSubnets, jc.DeepEquals, []MissingSubnet{
+		{CIDR: "10.0.1.0/24", SpaceName: "admin-db"},
+	})
+}
+
+func (s *reconcileSuite) TestReconcileStaleSpaces(c *gc.C) {
+	provider := []network.SpaceInfo{{
+		Name:       "db",
+		ProviderId: "1",
+	}}
+	model := network.SpaceInfos{{
+		Name:       network.AlphaSpaceName,
+		ProviderId: "",
+	}, {
+		Name:       "db",
+		ProviderId: "1",
+	}, {
+		Name:       "old-dmz",
+		ProviderId: "2",
+	}, {
+		Name:       "operator-made",
+		ProviderId: "",
+	}}
+
+	plan := Reconcile(provider, model)
+	c.Assert(plan.StaleSpaces, jc.DeepEquals, []string{"old-dmz"})
+	c.Assert(plan.MissingSubnets, gc.HasLen, 0)
+	c.Assert(plan.MovedSubnets, gc.HasLen, 0)
+}
+
+func (s *reconcileSuite) TestReconcileMovedSubnets(c *gc.C) {
+	provider := []network.SpaceInfo{{
+		Name:       "db",
+		ProviderId: "1",
+		Subnets:    []network.SubnetInfo{{CIDR: "10.0.0.0/24"}},
+	}, {
+		Name:       "dmz",
+		ProviderId: "2",
+		Subnets:    []network.SubnetInfo{{CIDR: "10.0.1.0/24"}},
+	}}
+	model := network.SpaceInfos{{
+		Name:       "db",
+		ProviderId: "1",
+		Subnets: []network.SubnetInfo{
+			{CIDR: "10.0.0.0/24"},
+			{CIDR: "10.0.1.0/24"},
+		},
+	}, {
+		Name:       "dmz",
+		ProviderId: "2",
+	}}
+
+	plan := Reconcile(provider, model)
+	c.Assert(plan.MovedSubnets, jc.DeepEquals, []MovedSubnet{
+		{CIDR: "10.0.1.0/24", SpaceFrom: "db", SpaceTo: "dmz"},
+	})
+	c.Assert(plan.MissingSubnets, gc.HasLen, 0)
+	c.Assert(plan.StaleSpaces, gc.HasLen, 0)
+}
@@ -40,6 +40,11 @@ const (
 	// the model and machine id corresponding to the
 	// provisioned machine instance.
 	JujuMachine = JujuTagPrefix + "machine-id"
+
+	// JujuDisplayName is the tag name used for recording the
+	// decorated display name of a machine instance when the model
+	// configures an instance naming policy.
+	JujuDisplayName = JujuTagPrefix + "display-name"
 )
 
 // ResourceTagger is an interface that can provide resource tags.
@@ -61,3 +61,17 @@ const RaftAPILeases = "raft-api-leases"
 // metadata when attempting to deploy charms. In addition, it enables reporting
 // of supported features as part of the 'juju show-model' output.
 const CharmAssumes = "charm-assumes"
+
+// ModelScoped returns the names of the feature flags that may be enabled
+// on individual models, as opposed to controller-wide via the environment.
+// When a feature graduates to being default behaviour its flag is removed
+// from this list, and any record of it in model state is cleaned up
+// automatically.
+func ModelScoped() []string {
+	return []string{
+		StrictMigration,
+		ActionsV2,
+		Secrets,
+		CharmAssumes,
+	}
+}
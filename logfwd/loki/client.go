@@ -0,0 +1,113 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package loki
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/logfwd"
+)
+
+// openTimeout is how long a push to the Loki endpoint may take before
+// it is abandoned.
+const openTimeout = 30 * time.Second
+
+// Client is the wrapper around a connection to a Loki push API endpoint.
+type Client struct {
+	cfg    RawConfig
+	client *http.Client
+}
+
+// Open returns a client that pushes log records to the Loki endpoint
+// in the config.
+func Open(cfg RawConfig) (*Client, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &Client{
+		cfg:    cfg,
+		client: &http.Client{Timeout: openTimeout},
+	}, nil
+}
+
+// Close closes the client's idle connections.
+func (c *Client) Close() error {
+	c.client.CloseIdleConnections()
+	return nil
+}
+
+// pushRequest is the payload for the Loki push API.
+type pushRequest struct {
+	Streams []stream `json:"streams"`
+}
+
+// stream is a single labelled log stream within a push request. Each
+// value holds the record timestamp in nanoseconds and the log line.
+type stream struct {
+	Labels map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+// Send pushes the records to the Loki endpoint. The records are
+// batched into a single request, grouped into streams labelled with
+// the originating model and source (eg the unit or machine), plus any
+// static labels from the config.
+func (c *Client) Send(records []logfwd.Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	var request pushRequest
+	index := make(map[string]int)
+	for _, rec := range records {
+		key := rec.Origin.ModelUUID + "\x00" + rec.Origin.Name
+		pos, found := index[key]
+		if !found {
+			labels := map[string]string{
+				"model":  rec.Origin.ModelUUID,
+				"source": rec.Origin.Name,
+			}
+			for name, value := range c.cfg.Labels {
+				labels[name] = value
+			}
+			pos = len(request.Streams)
+			request.Streams = append(request.Streams, stream{Labels: labels})
+			index[key] = pos
+		}
+		request.Streams[pos].Values = append(request.Streams[pos].Values, [2]string{
+			strconv.FormatInt(rec.Timestamp.UnixNano(), 10),
+			lineFromRecord(rec),
+		})
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	resp, err := c.client.Post(c.cfg.Endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return errors.Annotate(err, "pushing records to loki")
+	}
+	defer func() {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("pushing records to loki: %s", resp.Status)
+	}
+	return nil
+}
+
+func lineFromRecord(rec logfwd.Record) string {
+	return fmt.Sprintf("%s %s %s", rec.Level, rec.Location, rec.Message)
+}
@@ -0,0 +1,122 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package loki_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/juju/loggo"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/logfwd"
+	"github.com/juju/juju/logfwd/loki"
+)
+
+type ClientSuite struct{}
+
+var _ = gc.Suite(&ClientSuite{})
+
+func record(model, source, message string, when time.Time) logfwd.Record {
+	return logfwd.Record{
+		Origin: logfwd.Origin{
+			ModelUUID: model,
+			Name:      source,
+		},
+		Timestamp: when,
+		Level:     loggo.INFO,
+		Message:   message,
+	}
+}
+
+func (s *ClientSuite) TestSendGroupsRecordsIntoStreams(c *gc.C) {
+	var received struct {
+		Streams []struct {
+			Labels map[string]string `json:"stream"`
+			Values [][2]string       `json:"values"`
+		} `json:"streams"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		c.Check(req.Method, gc.Equals, "POST")
+		c.Check(req.Header.Get("Content-Type"), gc.Equals, "application/json")
+		body, err := ioutil.ReadAll(req.Body)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Assert(json.Unmarshal(body, &received), jc.ErrorIsNil)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := loki.Open(loki.RawConfig{
+		Enabled:  true,
+		Endpoint: server.URL,
+		Labels:   map[string]string{"environment": "production"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	defer client.Close()
+
+	t0 := time.Unix(1600000000, 0)
+	err = client.Send([]logfwd.Record{
+		record("model-uuid", "machine-0", "first", t0),
+		record("model-uuid", "unit-mysql-0", "second", t0.Add(time.Second)),
+		record("model-uuid", "machine-0", "third", t0.Add(2*time.Second)),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(received.Streams, gc.HasLen, 2)
+	c.Check(received.Streams[0].Labels, jc.DeepEquals, map[string]string{
+		"model":       "model-uuid",
+		"source":      "machine-0",
+		"environment": "production",
+	})
+	c.Check(received.Streams[0].Values, jc.DeepEquals, [][2]string{
+		{"1600000000000000000", "INFO  first"},
+		{"1600000002000000000", "INFO  third"},
+	})
+	c.Check(received.Streams[1].Labels, jc.DeepEquals, map[string]string{
+		"model":       "model-uuid",
+		"source":      "unit-mysql-0",
+		"environment": "production",
+	})
+	c.Check(received.Streams[1].Values, jc.DeepEquals, [][2]string{
+		{"1600000001000000000", "INFO  second"},
+	})
+}
+
+func (s *ClientSuite) TestSendEmpty(c *gc.C) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	client, err := loki.Open(loki.RawConfig{Enabled: true, Endpoint: server.URL})
+	c.Assert(err, jc.ErrorIsNil)
+	defer client.Close()
+
+	c.Assert(client.Send(nil), jc.ErrorIsNil)
+	c.Check(called, jc.IsFalse)
+}
+
+func (s *ClientSuite) TestSendErrorStatus(c *gc.C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		http.Error(w, "out of order entry", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	client, err := loki.Open(loki.RawConfig{Enabled: true, Endpoint: server.URL})
+	c.Assert(err, jc.ErrorIsNil)
+	defer client.Close()
+
+	err = client.Send([]logfwd.Record{record("model-uuid", "machine-0", "first", time.Now())})
+	c.Assert(err, gc.ErrorMatches, "pushing records to loki: 400 Bad Request")
+}
+
+func (s *ClientSuite) TestOpenInvalidConfig(c *gc.C) {
+	_, err := loki.Open(loki.RawConfig{Enabled: true})
+	c.Assert(err, gc.ErrorMatches, "empty Endpoint not valid")
+}
@@ -0,0 +1,15 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package loki
+
+import (
+	"github.com/juju/juju/logfwd"
+)
+
+// RawConfig holds the raw configuration data for a connection to a
+// Loki push API endpoint. It is an alias for logfwd.LokiConfig, which
+// holds the type and its validation so config consumers can handle
+// the settings without importing this package, and with it the
+// client.
+type RawConfig = logfwd.LokiConfig
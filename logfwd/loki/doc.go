@@ -0,0 +1,6 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// The loki package holds the tools needed to perform log forwarding
+// from Juju to a Loki server using its push API.
+package loki
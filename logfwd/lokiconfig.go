@@ -0,0 +1,67 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logfwd
+
+import (
+	"net/url"
+	"regexp"
+
+	"github.com/juju/errors"
+)
+
+// lokiLabelNameRegexp matches the label names accepted by Loki.
+var lokiLabelNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// LokiConfig holds the raw configuration data for a connection to a
+// Loki push API endpoint. It lives here rather than alongside the
+// client in logfwd/loki so config consumers such as environs/config
+// can validate the settings without dragging the client into every
+// agent's import tree.
+type LokiConfig struct {
+	// Enabled is true if the log forwarding feature is enabled.
+	Enabled bool
+
+	// Endpoint is the URL of the Loki push API endpoint. The format is:
+	//
+	//   http[s]://[domain-or-ip-addr][:port]/loki/api/v1/push
+	Endpoint string
+
+	// Labels holds static labels that are attached to every stream
+	// pushed to Loki, in addition to the per-record model and source
+	// labels.
+	Labels map[string]string
+}
+
+// Validate ensures that the config is currently valid.
+func (cfg LokiConfig) Validate() error {
+	if err := cfg.validateEndpoint(); err != nil {
+		return errors.Trace(err)
+	}
+	for name := range cfg.Labels {
+		if !lokiLabelNameRegexp.MatchString(name) {
+			return errors.NotValidf("label name %q", name)
+		}
+	}
+	return nil
+}
+
+func (cfg LokiConfig) validateEndpoint() error {
+	if cfg.Endpoint == "" {
+		if cfg.Enabled {
+			return errors.NotValidf("empty Endpoint")
+		}
+		return nil
+	}
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return errors.Annotate(err, "parsing endpoint URL")
+	}
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return errors.NotValidf("endpoint scheme %q", endpoint.Scheme)
+	}
+	if endpoint.Host == "" {
+		return errors.NotValidf("endpoint %q", cfg.Endpoint)
+	}
+	return nil
+}
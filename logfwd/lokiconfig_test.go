@@ -0,0 +1,77 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logfwd_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/logfwd"
+)
+
+type LokiConfigSuite struct{}
+
+var _ = gc.Suite(&LokiConfigSuite{})
+
+func validLokiConfig() logfwd.LokiConfig {
+	return logfwd.LokiConfig{
+		Enabled:  true,
+		Endpoint: "https://loki.example.com:3100/loki/api/v1/push",
+		Labels: map[string]string{
+			"environment": "production",
+		},
+	}
+}
+
+func (s *LokiConfigSuite) TestValidateValid(c *gc.C) {
+	cfg := validLokiConfig()
+
+	err := cfg.Validate()
+
+	c.Check(err, jc.ErrorIsNil)
+}
+
+func (s *LokiConfigSuite) TestValidateEmptyNotEnabled(c *gc.C) {
+	cfg := logfwd.LokiConfig{}
+
+	err := cfg.Validate()
+
+	c.Check(err, jc.ErrorIsNil)
+}
+
+func (s *LokiConfigSuite) TestValidateEmptyEndpointEnabled(c *gc.C) {
+	cfg := validLokiConfig()
+	cfg.Endpoint = ""
+
+	err := cfg.Validate()
+
+	c.Check(err, gc.ErrorMatches, `empty Endpoint not valid`)
+}
+
+func (s *LokiConfigSuite) TestValidateBadEndpointScheme(c *gc.C) {
+	cfg := validLokiConfig()
+	cfg.Endpoint = "ftp://loki.example.com/loki/api/v1/push"
+
+	err := cfg.Validate()
+
+	c.Check(err, gc.ErrorMatches, `endpoint scheme "ftp" not valid`)
+}
+
+func (s *LokiConfigSuite) TestValidateBadEndpointHost(c *gc.C) {
+	cfg := validLokiConfig()
+	cfg.Endpoint = "https:///loki/api/v1/push"
+
+	err := cfg.Validate()
+
+	c.Check(err, gc.ErrorMatches, `endpoint "https:///loki/api/v1/push" not valid`)
+}
+
+func (s *LokiConfigSuite) TestValidateBadLabelName(c *gc.C) {
+	cfg := validLokiConfig()
+	cfg.Labels = map[string]string{"bad-label": "value"}
+
+	err := cfg.Validate()
+
+	c.Check(err, gc.ErrorMatches, `label name "bad-label" not valid`)
+}
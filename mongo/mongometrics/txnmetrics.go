@@ -4,12 +4,17 @@
 package mongometrics
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/juju/loggo"
 	"github.com/juju/mgo/v2/txn"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
+var logger = loggo.GetLogger("juju.mongo.mongometrics")
+
 const (
 	databaseLabel   = "database"
 	collectionLabel = "collection"
@@ -29,9 +34,15 @@ var (
 // TxnCollector is a prometheus.Collector that collects metrics about
 // mgo/txn operations.
 type TxnCollector struct {
-	txnOpsTotalCounter *prometheus.CounterVec
-	txnRetries         prometheus.Histogram
-	txnDurations       prometheus.Histogram
+	txnOpsTotalCounter     *prometheus.CounterVec
+	txnRetries             prometheus.Histogram
+	txnDurations           prometheus.Histogram
+	txnCollectionDurations *prometheus.HistogramVec
+
+	// slowThreshold, if non-zero, is the duration at or above which
+	// a transaction is logged as slow. It must be set before the
+	// collector starts observing transactions.
+	slowThreshold time.Duration
 }
 
 // NewTxnCollector returns a new TxnCollector.
@@ -61,32 +72,67 @@ func NewTxnCollector() *TxnCollector {
 				Buckets:   prometheus.LinearBuckets(0, 2, 50),
 			},
 		),
+		txnCollectionDurations: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: "juju",
+				Name:      "mgo_txn_collection_durations",
+				Help:      "Time (ms) taken to complete transactions touching a collection",
+				Buckets:   prometheus.ExponentialBuckets(1, 2, 12),
+			},
+			[]string{databaseLabel, collectionLabel},
+		),
 	}
 }
 
+// SetSlowTransactionThreshold sets the duration at or above which a
+// transaction is logged as slow, along with a sanitised summary of its
+// ops. A zero threshold disables slow-transaction logging.
+func (c *TxnCollector) SetSlowTransactionThreshold(threshold time.Duration) {
+	c.slowThreshold = threshold
+}
+
 // AfterRunTransaction is called when a mgo/txn transaction has run.
 func (c *TxnCollector) AfterRunTransaction(dbName, modelUUID string, attempt int, duration time.Duration, ops []txn.Op, err error) {
 	for _, op := range ops {
 		c.updateMetrics(dbName, attempt, duration, op, err)
 	}
+	if c.slowThreshold > 0 && duration >= c.slowThreshold {
+		logger.Warningf(
+			"slow transaction: database %s, model %s, attempt %d, duration %.3fs, err %v, ops:\n%s",
+			dbName, modelUUID, attempt, duration.Seconds(), err, sanitisedOps(ops),
+		)
+	}
 }
 
-func (c *TxnCollector) updateMetrics(dbName string, attempt int, duration time.Duration, op txn.Op, err error) {
-	var failed string
-	if err != nil {
-		failed = "failed"
+// sanitisedOps summarises transaction ops for the slow-transaction log.
+// Only collection names, document keys and op types are included;
+// document contents and asserts are never logged.
+func sanitisedOps(ops []txn.Op) string {
+	lines := make([]string, len(ops))
+	for i, op := range ops {
+		lines[i] = fmt.Sprintf(" - %s:%v [%s]", op.C, op.Id, opType(op))
 	}
-	var optype string
+	return strings.Join(lines, "\n")
+}
+
+func opType(op txn.Op) string {
 	switch {
 	case op.Insert != nil:
-		optype = "insert"
+		return "insert"
 	case op.Update != nil:
-		optype = "update"
+		return "update"
 	case op.Remove:
-		optype = "remove"
-	default:
-		optype = "assert"
+		return "remove"
+	}
+	return "assert"
+}
+
+func (c *TxnCollector) updateMetrics(dbName string, attempt int, duration time.Duration, op txn.Op, err error) {
+	var failed string
+	if err != nil {
+		failed = "failed"
 	}
+	optype := opType(op)
 	c.txnOpsTotalCounter.With(prometheus.Labels{
 		databaseLabel:   dbName,
 		collectionLabel: op.C,
@@ -95,6 +141,10 @@ func (c *TxnCollector) updateMetrics(dbName string, attempt int, duration time.D
 	}).Inc()
 	c.txnRetries.Observe(float64(attempt))
 	c.txnDurations.Observe(float64(duration / time.Millisecond))
+	c.txnCollectionDurations.With(prometheus.Labels{
+		databaseLabel:   dbName,
+		collectionLabel: op.C,
+	}).Observe(float64(duration / time.Millisecond))
 }
 
 // Describe is part of the prometheus.Collector interface.
@@ -102,6 +152,7 @@ func (c *TxnCollector) Describe(ch chan<- *prometheus.Desc) {
 	c.txnOpsTotalCounter.Describe(ch)
 	c.txnRetries.Describe(ch)
 	c.txnDurations.Describe(ch)
+	c.txnCollectionDurations.Describe(ch)
 }
 
 // Collect is part of the prometheus.Collector interface.
@@ -109,4 +160,5 @@ func (c *TxnCollector) Collect(ch chan<- prometheus.Metric) {
 	c.txnOpsTotalCounter.Collect(ch)
 	c.txnRetries.Collect(ch)
 	c.txnDurations.Collect(ch)
+	c.txnCollectionDurations.Collect(ch)
 }
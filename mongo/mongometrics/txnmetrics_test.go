@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/juju/loggo"
 	"github.com/juju/mgo/v2/bson"
 	"github.com/juju/mgo/v2/txn"
 	"github.com/juju/testing"
@@ -41,10 +42,11 @@ func (s *TxnCollectorSuite) TestDescribe(c *gc.C) {
 	for desc := range ch {
 		descs = append(descs, desc)
 	}
-	c.Assert(descs, gc.HasLen, 3)
+	c.Assert(descs, gc.HasLen, 4)
 	c.Assert(descs[0].String(), gc.Matches, `.*fqName: "juju_mgo_txn_ops_total".*`)
 	c.Assert(descs[1].String(), gc.Matches, `.*fqName: "juju_mgo_txn_retries".*`)
 	c.Assert(descs[2].String(), gc.Matches, `.*fqName: "juju_mgo_txn_durations".*`)
+	c.Assert(descs[3].String(), gc.Matches, `.*fqName: "juju_mgo_txn_collection_durations".*`)
 }
 
 func (s *TxnCollectorSuite) TestCollect(c *gc.C) {
@@ -76,9 +78,9 @@ func (s *TxnCollectorSuite) TestCollect(c *gc.C) {
 	for metric := range ch {
 		metrics = append(metrics, metric)
 	}
-	c.Assert(metrics, gc.HasLen, 7)
+	c.Assert(metrics, gc.HasLen, 11)
 
-	var dtoMetrics [7]dto.Metric
+	var dtoMetrics [11]dto.Metric
 	for i, metric := range metrics {
 		err := metric.Write(&dtoMetrics[i])
 		c.Assert(err, jc.ErrorIsNil)
@@ -104,6 +106,16 @@ func (s *TxnCollectorSuite) TestCollect(c *gc.C) {
 			UpperBound:      float64ptr(float64(i)),
 		})
 	}
+	collectionDurationBuckets := func(count uint64) []*dto.Bucket {
+		var buckets []*dto.Bucket
+		for i := 0; i < 12; i++ {
+			buckets = append(buckets, &dto.Bucket{
+				CumulativeCount: uint64ptr(count),
+				UpperBound:      float64ptr(float64(uint64(1) << i)),
+			})
+		}
+		return buckets
+	}
 	var durationBuckets []*dto.Bucket
 	for i := 0; i < 50; i++ {
 		count := uint64(0)
@@ -175,6 +187,50 @@ func (s *TxnCollectorSuite) TestCollect(c *gc.C) {
 				Bucket:      durationBuckets,
 			},
 		},
+		{
+			Histogram: &dto.Histogram{
+				SampleCount: uint64ptr(2),
+				SampleSum:   float64ptr(2),
+				Bucket:      collectionDurationBuckets(2),
+			},
+			Label: []*dto.LabelPair{
+				labelpair("collection", "update-coll"),
+				labelpair("database", "dbname"),
+			},
+		},
+		{
+			Histogram: &dto.Histogram{
+				SampleCount: uint64ptr(1),
+				SampleSum:   float64ptr(1),
+				Bucket:      collectionDurationBuckets(1),
+			},
+			Label: []*dto.LabelPair{
+				labelpair("collection", "insert-coll"),
+				labelpair("database", "dbname"),
+			},
+		},
+		{
+			Histogram: &dto.Histogram{
+				SampleCount: uint64ptr(1),
+				SampleSum:   float64ptr(1),
+				Bucket:      collectionDurationBuckets(1),
+			},
+			Label: []*dto.LabelPair{
+				labelpair("collection", "remove-coll"),
+				labelpair("database", "dbname"),
+			},
+		},
+		{
+			Histogram: &dto.Histogram{
+				SampleCount: uint64ptr(1),
+				SampleSum:   float64ptr(1),
+				Bucket:      collectionDurationBuckets(1),
+			},
+			Label: []*dto.LabelPair{
+				labelpair("collection", "assert-coll"),
+				labelpair("database", "dbname"),
+			},
+		},
 	}
 	for _, dm := range dtoMetrics {
 		var found bool
@@ -191,3 +247,29 @@ func (s *TxnCollectorSuite) TestCollect(c *gc.C) {
 		}
 	}
 }
+
+func (s *TxnCollectorSuite) TestSlowTransactionLog(c *gc.C) {
+	var tw loggo.TestWriter
+	err := loggo.RegisterWriter("test", &tw)
+	c.Assert(err, jc.ErrorIsNil)
+	defer func() { _, _ = loggo.RemoveWriter("test") }()
+
+	ops := []txn.Op{{
+		C:      "update-coll",
+		Id:     "doc-key",
+		Update: bson.D{{"$set", bson.D{{"secret", "hush"}}}},
+	}}
+
+	s.collector.SetSlowTransactionThreshold(time.Second)
+	s.collector.AfterRunTransaction("dbname", "modeluuid", 2, 500*time.Millisecond, ops, nil)
+	c.Assert(tw.Log(), gc.HasLen, 0)
+
+	s.collector.AfterRunTransaction("dbname", "modeluuid", 2, 2*time.Second, ops, nil)
+	log := tw.Log()
+	c.Assert(log, gc.HasLen, 1)
+	c.Assert(log[0].Level, gc.Equals, loggo.WARNING)
+	c.Assert(log[0].Message, gc.Matches,
+		`(?s)slow transaction: database dbname, model modeluuid, attempt 2, duration 2\.000s.*- update-coll:doc-key \[update\].*`)
+	// Document contents must never make it into the log.
+	c.Assert(log[0].Message, gc.Not(jc.Contains), "hush")
+}
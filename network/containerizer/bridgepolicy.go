@@ -117,6 +117,10 @@ func (p *BridgePolicy) FindMissingBridgesForContainer(
 		return nil, 0, nil
 	}
 
+	// Devices explicitly configured for container use on this host
+	// trump the automatic selection below.
+	override := set.NewStrings(host.ContainerNetworkDevices()...)
+
 	hostDeviceNamesToBridge := make([]string, 0)
 	reconfigureDelay := 0
 	hostDeviceByName := make(map[string]LinkLayerDevice, 0)
@@ -134,6 +138,17 @@ func (p *BridgePolicy) FindMissingBridgesForContainer(
 			hostDeviceByName[hostDevice.Name()] = hostDevice
 			spacesFound = append(spacesFound, spaceInfo)
 		}
+		if !override.IsEmpty() {
+			preferred := make([]string, 0, len(hostDeviceNames))
+			for _, name := range hostDeviceNames {
+				if override.Contains(name) {
+					preferred = append(preferred, name)
+				}
+			}
+			if len(preferred) > 0 {
+				hostDeviceNames = preferred
+			}
+		}
 		if len(hostDeviceNames) > 0 {
 			if spaceInfo.ID == corenetwork.AlphaSpaceId {
 				// When we are bridging unknown space devices, we bridge all
@@ -511,7 +526,20 @@ func (p *BridgePolicy) PopulateContainerLinkLayerDevices(
 	devicesByName := make(map[string]LinkLayerDevice)
 	bridgeDeviceNames := make([]string, 0)
 
+	// Bridges explicitly configured for container use on this host
+	// trump the automatic selection.
+	override := set.NewStrings(host.ContainerNetworkDevices()...)
+
 	for spaceID, hostDevices := range devicesPerSpace {
+		overridden := make([]LinkLayerDevice, 0, len(hostDevices))
+		for _, hostDevice := range hostDevices {
+			if override.Contains(hostDevice.Name()) {
+				overridden = append(overridden, hostDevice)
+			}
+		}
+		if !override.IsEmpty() && len(overridden) > 0 {
+			hostDevices = overridden
+		}
 		for _, hostDevice := range hostDevices {
 			isFan := strings.HasPrefix(hostDevice.Name(), "fan-")
 			wantThisDevice := isFan == (p.containerNetworkingMethod == "fan")
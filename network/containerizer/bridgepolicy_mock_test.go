@@ -83,6 +83,20 @@ func (mr *MockContainerMockRecorder) AllSpaces() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllSpaces", reflect.TypeOf((*MockContainer)(nil).AllSpaces))
 }
 
+// ContainerNetworkDevices mocks base method.
+func (m *MockContainer) ContainerNetworkDevices() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainerNetworkDevices")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// ContainerNetworkDevices indicates an expected call of ContainerNetworkDevices.
+func (mr *MockContainerMockRecorder) ContainerNetworkDevices() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainerNetworkDevices", reflect.TypeOf((*MockContainer)(nil).ContainerNetworkDevices))
+}
+
 // Constraints mocks base method.
 func (m *MockContainer) Constraints() (constraints.Value, error) {
 	m.ctrl.T.Helper()
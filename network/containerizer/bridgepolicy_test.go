@@ -71,6 +71,7 @@ func (s *bridgePolicySuite) setupMocks(c *gc.C) *gomock.Controller {
 	s.guest = NewMockContainer(ctrl)
 
 	s.guest.EXPECT().Id().Return("guest-id").AnyTimes()
+	s.host.EXPECT().ContainerNetworkDevices().Return(nil).AnyTimes()
 
 	s.spaces = make(network.SpaceInfos, 4)
 	for i, space := range []string{network.AlphaSpaceName, "foo", "bar", "fizz"} {
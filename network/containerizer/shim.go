@@ -70,6 +70,7 @@ type Machine interface {
 	Id() string
 	AllDeviceAddresses() ([]Address, error)
 	AllSpaces() (set.Strings, error)
+	ContainerNetworkDevices() []string
 	SetLinkLayerDevices(devicesArgs ...state.LinkLayerDeviceArgs) (err error)
 	AllLinkLayerDevices() ([]LinkLayerDevice, error)
 
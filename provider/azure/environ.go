@@ -418,6 +418,7 @@ func (env *azureEnviron) ConstraintsValidator(ctx context.ProviderCallContext) (
 		constraints.CpuPower,
 		constraints.Tags,
 		constraints.VirtType,
+		constraints.ImageID,
 	})
 	validator.RegisterVocabulary(
 		constraints.Arch,
@@ -564,9 +565,17 @@ func (env *azureEnviron) StartInstance(ctx context.ProviderCallContext, args env
 	if args.Constraints.HasAllocatePublicIP() {
 		usePublicIP = *args.Constraints.AllocatePublicIP
 	}
+
+	// Determine the availability zone to deploy into, if any. A
+	// placement directive takes precedence over a zones constraint.
+	availabilityZone, err := env.instanceZone(args)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
 	stdCtx := stdcontext.Background()
 	if err := env.createVirtualMachine(
-		stdCtx, ctx, vmName, vmTags, envTags,
+		stdCtx, ctx, vmName, availabilityZone, vmTags, envTags,
 		instanceSpec, args, usePublicIP,
 	); err != nil {
 		logger.Errorf("creating instance failed, destroying: %v", err)
@@ -587,12 +596,34 @@ func (env *azureEnviron) StartInstance(ctx context.ProviderCallContext, args env
 		RootDisk: &instanceSpec.InstanceType.RootDisk,
 		CpuCores: &instanceSpec.InstanceType.CpuCores,
 	}
+	if availabilityZone != "" {
+		hc.AvailabilityZone = &availabilityZone
+	}
 	return &environs.StartInstanceResult{
 		Instance: inst,
 		Hardware: hc,
 	}, nil
 }
 
+// instanceZone returns the availability zone that the given instance
+// parameters request, or an empty string if no zone was requested. A
+// "zone=" placement directive takes precedence over a zones constraint.
+func (env *azureEnviron) instanceZone(args environs.StartInstanceParams) (string, error) {
+	if args.Placement != "" {
+		_, zone, err := env.parsePlacement(args.Placement)
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		if zone != "" {
+			return zone, nil
+		}
+	}
+	if args.Constraints.HasZones() {
+		return (*args.Constraints.Zones)[0], nil
+	}
+	return "", nil
+}
+
 // referenceInfo splits a reference to an Azure entity into an
 // optional resource group and name, or just name if no
 // resource group is specified.
@@ -611,7 +642,7 @@ func referenceInfo(entityRef string) (entityRG, entityName string) {
 func (env *azureEnviron) createVirtualMachine(
 	stdCtx stdcontext.Context,
 	ctx context.ProviderCallContext,
-	vmName string,
+	vmName, availabilityZone string,
 	vmTags, envTags map[string]string,
 	instanceSpec *instances.InstanceSpec,
 	args environs.StartInstanceParams,
@@ -690,6 +721,12 @@ func (env *azureEnviron) createVirtualMachine(
 	if err != nil {
 		return errors.Annotate(err, "getting availability set name")
 	}
+	if availabilityZone != "" {
+		// Azure does not allow a VM to be in both an availability
+		// set and an availability zone; a zonal machine relies on
+		// the zone for fault isolation.
+		availabilitySetName = ""
+	}
 	if availabilitySetName != "" {
 		availabilitySetId := fmt.Sprintf(
 			`[resourceId('Microsoft.Compute/availabilitySets','%s')]`,
@@ -802,6 +839,27 @@ func (env *azureEnviron) createVirtualMachine(
 		})
 	}
 
+	var proximityPlacementGroup *compute.SubResource
+	if args.Constraints.HasPlacementGroup() {
+		ppg := *args.Constraints.PlacementGroup
+		ppgId := ppg
+		if !strings.Contains(ppg, "/") {
+			// A bare name refers to a proximity placement group
+			// in the model's resource group.
+			ppgId = fmt.Sprintf(
+				`[resourceId('Microsoft.Compute/proximityPlacementGroups','%s')]`,
+				ppg,
+			)
+		}
+		proximityPlacementGroup = &compute.SubResource{
+			ID: to.StringPtr(ppgId),
+		}
+	}
+
+	var zones []string
+	if availabilityZone != "" {
+		zones = []string{availabilityZone}
+	}
 	res = append(res, armtemplates.Resource{
 		APIVersion: computeAPIVersion,
 		Type:       "Microsoft.Compute/virtualMachines",
@@ -814,12 +872,14 @@ func (env *azureEnviron) createVirtualMachine(
 					instanceSpec.InstanceType.Name,
 				),
 			},
-			StorageProfile:  storageProfile,
-			OsProfile:       osProfile,
-			NetworkProfile:  &compute.NetworkProfile{NetworkInterfaces: &nics},
-			AvailabilitySet: availabilitySetSubResource,
+			StorageProfile:          storageProfile,
+			OsProfile:               osProfile,
+			NetworkProfile:          &compute.NetworkProfile{NetworkInterfaces: &nics},
+			AvailabilitySet:         availabilitySetSubResource,
+			ProximityPlacementGroup: proximityPlacementGroup,
 		},
 		DependsOn: vmDependsOn,
+		Zones:     zones,
 	})
 
 	// On Windows and CentOS, we must add the CustomScript VM
@@ -301,26 +301,31 @@ func (env *azureEnviron) subnetsForZone(subnetsToZones []map[network.Id][]string
 	return subnetIDsForZone, nil
 }
 
-func (env *azureEnviron) parsePlacement(placement string) (string, error) {
+func (env *azureEnviron) parsePlacement(placement string) (subnet, zone string, err error) {
 	pos := strings.IndexRune(placement, '=')
 	if pos == -1 {
-		return "", fmt.Errorf("unknown placement directive: %v", placement)
+		return "", "", fmt.Errorf("unknown placement directive: %v", placement)
 	}
 	switch key, value := placement[:pos], placement[pos+1:]; key {
 	case "subnet":
-		return value, nil
+		return value, "", nil
+	case "zone":
+		return "", value, nil
 	}
-	return "", fmt.Errorf("unknown placement directive: %v", placement)
+	return "", "", fmt.Errorf("unknown placement directive: %v", placement)
 }
 
 func (env *azureEnviron) findPlacementSubnet(ctx context.ProviderCallContext, placement string) (network.Id, error) {
 	if placement == "" {
 		return "", nil
 	}
-	subnetName, err := env.parsePlacement(placement)
+	subnetName, _, err := env.parsePlacement(placement)
 	if err != nil {
 		return "", errors.Trace(err)
 	}
+	if subnetName == "" {
+		return "", nil
+	}
 
 	logger.Debugf("searching for subnet matching placement directive %q", subnetName)
 	return env.findSubnetID(ctx, subnetName)
@@ -887,6 +887,74 @@ func (s *environSuite) TestStartInstanceServiceAvailabilitySet(c *gc.C) {
 	})
 }
 
+func (s *environSuite) TestStartInstanceWithZonesConstraint(c *gc.C) {
+	env := s.openEnviron(c)
+	unitsDeployed := "mysql/0"
+	s.vmTags[tags.JujuUnitsDeployed] = &unitsDeployed
+	s.sender = s.startInstanceSenders(startInstanceSenderParams{bootstrap: false})
+	s.requests = nil
+	params := makeStartInstanceParams(c, s.controllerUUID, "bionic")
+	params.InstanceConfig.Tags[tags.JujuUnitsDeployed] = unitsDeployed
+	params.Constraints.Zones = &[]string{"1", "2"}
+
+	result, err := env.StartInstance(s.callCtx, params)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Hardware.AvailabilityZone, gc.NotNil)
+	c.Assert(*result.Hardware.AvailabilityZone, gc.Equals, "1")
+	// A zonal machine must not also be placed in an availability set.
+	s.assertStartInstanceRequests(c, s.requests, assertStartInstanceRequestsParams{
+		imageReference:   &xenialImageReference,
+		diskSizeGB:       32,
+		osProfile:        &s.linuxOsProfile,
+		instanceType:     "Standard_A1",
+		publicIP:         true,
+		availabilityZone: "1",
+	})
+}
+
+func (s *environSuite) TestStartInstanceWithZonePlacement(c *gc.C) {
+	env := s.openEnviron(c)
+	s.sender = s.startInstanceSenders(startInstanceSenderParams{bootstrap: false})
+	s.requests = nil
+	params := makeStartInstanceParams(c, s.controllerUUID, "bionic")
+	params.Placement = "zone=3"
+	params.Constraints.Zones = &[]string{"1"}
+
+	result, err := env.StartInstance(s.callCtx, params)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Hardware.AvailabilityZone, gc.NotNil)
+	// The placement directive takes precedence over the constraint.
+	c.Assert(*result.Hardware.AvailabilityZone, gc.Equals, "3")
+	s.assertStartInstanceRequests(c, s.requests, assertStartInstanceRequestsParams{
+		imageReference:   &xenialImageReference,
+		diskSizeGB:       32,
+		osProfile:        &s.linuxOsProfile,
+		instanceType:     "Standard_A1",
+		publicIP:         true,
+		availabilityZone: "3",
+	})
+}
+
+func (s *environSuite) TestStartInstanceWithPlacementGroupConstraint(c *gc.C) {
+	env := s.openEnviron(c)
+	s.sender = s.startInstanceSenders(startInstanceSenderParams{bootstrap: false})
+	s.requests = nil
+	params := makeStartInstanceParams(c, s.controllerUUID, "bionic")
+	placementGroup := "my-ppg"
+	params.Constraints.PlacementGroup = &placementGroup
+
+	_, err := env.StartInstance(s.callCtx, params)
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertStartInstanceRequests(c, s.requests, assertStartInstanceRequestsParams{
+		imageReference:   &xenialImageReference,
+		diskSizeGB:       32,
+		osProfile:        &s.linuxOsProfile,
+		instanceType:     "Standard_A1",
+		publicIP:         true,
+		placementGroupId: `[resourceId('Microsoft.Compute/proximityPlacementGroups','my-ppg')]`,
+	})
+}
+
 func (s *environSuite) TestStartInstanceWithSpaceConstraints(c *gc.C) {
 	env := s.openEnviron(c)
 	s.sender = s.startInstanceSenders(startInstanceSenderParams{bootstrap: false})
@@ -918,7 +986,7 @@ func (s *environSuite) TestStartInstanceWithInvalidPlacement(c *gc.C) {
 	params.Placement = "foo"
 
 	_, err := env.StartInstance(s.callCtx, params)
-	c.Assert(err, gc.ErrorMatches, `creating virtual machine "machine-0": unknown placement directive: foo`)
+	c.Assert(err, gc.ErrorMatches, `unknown placement directive: foo`)
 }
 
 func (s *environSuite) TestStartInstanceWithInvalidSubnet(c *gc.C) {
@@ -1033,6 +1101,8 @@ type assertStartInstanceRequestsParams struct {
 	existingNetwork     string
 	subnets             []string
 	placementSubnet     string
+	availabilityZone    string
+	placementGroupId    string
 }
 
 func (s *environSuite) assertStartInstanceRequests(
@@ -1306,6 +1376,16 @@ func (s *environSuite) assertStartInstanceRequests(
 		})
 	}
 	templateResources = append(templateResources, nicResources...)
+	var proximityPlacementGroup *compute.SubResource
+	if args.placementGroupId != "" {
+		proximityPlacementGroup = &compute.SubResource{
+			ID: to.StringPtr(args.placementGroupId),
+		}
+	}
+	var zones []string
+	if args.availabilityZone != "" {
+		zones = []string{args.availabilityZone}
+	}
 	templateResources = append(templateResources, []armtemplates.Resource{{
 		APIVersion: computeAPIVersion,
 		Type:       "Microsoft.Compute/virtualMachines",
@@ -1320,11 +1400,13 @@ func (s *environSuite) assertStartInstanceRequests(
 				ImageReference: args.imageReference,
 				OsDisk:         osDisk,
 			},
-			OsProfile:       args.osProfile,
-			NetworkProfile:  &compute.NetworkProfile{&nics},
-			AvailabilitySet: availabilitySetSubResource,
+			OsProfile:               args.osProfile,
+			NetworkProfile:          &compute.NetworkProfile{&nics},
+			AvailabilitySet:         availabilitySetSubResource,
+			ProximityPlacementGroup: proximityPlacementGroup,
 		},
 		DependsOn: vmDependsOn,
+		Zones:     zones,
 	}}...)
 	if args.vmExtension != nil {
 		templateResources = append(templateResources, armtemplates.Resource{
@@ -0,0 +1,25 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package common
+
+// InstanceNamingConfig exposes the model configuration attributes that
+// make up the instance naming policy.
+type InstanceNamingConfig interface {
+	// InstanceNamePrefix returns the prefix to apply to provider
+	// instance display names, or "" if none is configured.
+	InstanceNamePrefix() string
+
+	// InstanceNameSuffix returns the suffix to apply to provider
+	// instance display names, or "" if none is configured.
+	InstanceNameSuffix() string
+}
+
+// ApplyInstanceNaming decorates a provider instance display name with
+// the prefix and suffix configured for the model, so cloud bills and
+// dashboards can attribute machines to a team or cost centre without
+// manual tagging. The name is returned unchanged when no naming policy
+// is configured.
+func ApplyInstanceNaming(cfg InstanceNamingConfig, name string) string {
+	return cfg.InstanceNamePrefix() + name + cfg.InstanceNameSuffix()
+}
@@ -0,0 +1,43 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package common_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/provider/common"
+)
+
+type InstanceNameSuite struct{}
+
+var _ = gc.Suite(&InstanceNameSuite{})
+
+type fakeNamingConfig struct {
+	prefix, suffix string
+}
+
+func (c fakeNamingConfig) InstanceNamePrefix() string {
+	return c.prefix
+}
+
+func (c fakeNamingConfig) InstanceNameSuffix() string {
+	return c.suffix
+}
+
+func (s *InstanceNameSuite) TestApplyInstanceNaming(c *gc.C) {
+	var nameTests = []struct {
+		prefix   string
+		suffix   string
+		expected string
+	}{
+		{"", "", "juju-deadbe-0"},
+		{"teamx-", "", "teamx-juju-deadbe-0"},
+		{"", "-cc42", "juju-deadbe-0-cc42"},
+		{"teamx-", "-cc42", "teamx-juju-deadbe-0-cc42"},
+	}
+	for _, t := range nameTests {
+		cfg := fakeNamingConfig{prefix: t.prefix, suffix: t.suffix}
+		c.Check(common.ApplyInstanceNaming(cfg, "juju-deadbe-0"), gc.Equals, t.expected)
+	}
+}
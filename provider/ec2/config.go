@@ -33,6 +33,10 @@ var configSchema = environschema.Fields{
 		Group:       environschema.AccountGroup,
 		Immutable:   true,
 	},
+	"require-imdsv2": {
+		Description: "Launch instances with IMDSv2 required, so that the instance metadata service rejects unauthenticated (IMDSv1) requests. Only affects instances started after the value is changed.",
+		Type:        environschema.Tbool,
+	},
 }
 
 var configFields = func() schema.Fields {
@@ -44,8 +48,9 @@ var configFields = func() schema.Fields {
 }()
 
 var configDefaults = schema.Defaults{
-	"vpc-id":       "",
-	"vpc-id-force": false,
+	"vpc-id":         "",
+	"vpc-id-force":   false,
+	"require-imdsv2": true,
 }
 
 type environConfig struct {
@@ -61,6 +66,10 @@ func (c *environConfig) forceVPCID() bool {
 	return c.attrs["vpc-id-force"].(bool)
 }
 
+func (c *environConfig) requireIMDSv2() bool {
+	return c.attrs["require-imdsv2"].(bool)
+}
+
 func (p environProvider) newConfig(cfg *config.Config) (*environConfig, error) {
 	valid, err := p.Validate(cfg, nil)
 	if err != nil {
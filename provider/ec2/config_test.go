@@ -235,6 +235,28 @@ var configTests = []configTest{
 		change:     attrs{},
 		vpcID:      "vpc-foo",
 		forceVPCID: true,
+	}, {
+		config: attrs{
+			"require-imdsv2": "nonsense",
+		},
+		err: `.*expected bool, got string\("nonsense"\)`,
+	}, {
+		config: attrs{
+			"require-imdsv2": false,
+		},
+		expect: attrs{
+			"require-imdsv2": false,
+		},
+	}, {
+		config: attrs{
+			"require-imdsv2": true,
+		},
+		change: attrs{
+			"require-imdsv2": false,
+		},
+		expect: attrs{
+			"require-imdsv2": false,
+		},
 	}, {
 		config:       attrs{},
 		firewallMode: config.FwInstance,
@@ -644,6 +644,31 @@ func (e *environ) StartInstance(
 	}
 	rootDiskSize := uint64(aws.ToInt32(blockDeviceMappings[0].Ebs.VolumeSize)) * 1024
 
+	// Tag the instance and its root disk at creation time, via tag
+	// specifications on the RunInstances request. Tagging in a
+	// follow-up call risks leaving untagged resources behind if
+	// StartInstance fails part way through.
+	cfg := e.Config()
+	instanceName := common.ApplyInstanceNaming(cfg, resourceName(
+		names.NewMachineTag(args.InstanceConfig.MachineId), cfg.Name(),
+	))
+	args.InstanceConfig.Tags[tagName] = instanceName
+	rootDiskTags := tags.ResourceTags(
+		names.NewModelTag(cfg.UUID()),
+		names.NewControllerTag(args.ControllerUUID),
+		cfg,
+	)
+	rootDiskTags[tagName] = instanceName + "-root"
+
+	// IMDSv1 is disabled unless the model explicitly asks for it.
+	metadataOptions := &types.InstanceMetadataOptionsRequest{
+		HttpEndpoint: types.InstanceMetadataEndpointStateEnabled,
+		HttpTokens:   types.HttpTokensStateOptional,
+	}
+	if e.ecfg().requireIMDSv2() {
+		metadataOptions.HttpTokens = types.HttpTokensStateRequired
+	}
+
 	var instResp *ec2.RunInstancesOutput
 	commonRunArgs := &ec2.RunInstancesInput{
 		MinCount:            aws.Int32(1),
@@ -653,6 +678,11 @@ func (e *environ) StartInstance(
 		SecurityGroupIds:    groupIDs,
 		BlockDeviceMappings: blockDeviceMappings,
 		ImageId:             aws.String(spec.Image.Id),
+		MetadataOptions:     metadataOptions,
+		TagSpecifications: []types.TagSpecification{
+			makeTagSpecification(types.ResourceTypeInstance, args.InstanceConfig.Tags),
+			makeTagSpecification(types.ResourceTypeVolume, rootDiskTags),
+		},
 	}
 
 	runArgs := commonRunArgs
@@ -700,29 +730,6 @@ func (e *environ) StartInstance(
 		logger.Infof("started instance %q in AZ %q", inst.Id(), instAZ)
 	}
 
-	// Tag instance, for accounting and identification.
-	instanceName := resourceName(
-		names.NewMachineTag(args.InstanceConfig.MachineId), e.Config().Name(),
-	)
-	args.InstanceConfig.Tags[tagName] = instanceName
-	if err := tagResources(e.ec2Client, ctx, args.InstanceConfig.Tags, string(inst.Id())); err != nil {
-		return nil, annotateWrapError(err, "tagging instance")
-	}
-
-	// Tag the machine's root EBS volume, if it has one.
-	if inst.i.RootDeviceType == "ebs" {
-		cfg := e.Config()
-		tags := tags.ResourceTags(
-			names.NewModelTag(cfg.UUID()),
-			names.NewControllerTag(args.ControllerUUID),
-			cfg,
-		)
-		tags[tagName] = instanceName + "-root"
-		if err := tagRootDisk(e.ec2Client, ctx, tags, &inst.i); err != nil {
-			return nil, annotateWrapError(err, "tagging root disk")
-		}
-	}
-
 	hc := instance.HardwareCharacteristics{
 		Arch:     &spec.Image.Arch,
 		Mem:      &spec.InstanceType.Mem,
@@ -731,6 +738,7 @@ func (e *environ) StartInstance(
 		RootDisk: &rootDiskSize,
 		// Tags currently not supported by EC2
 		AvailabilityZone: &instAZ,
+		ImageID:          &spec.Image.Id,
 	}
 
 	if err := e.maybeAttachInstanceProfile(ctx, callback, inst, args.Constraints); err != nil {
@@ -1134,59 +1142,14 @@ func tagResources(e Client, ctx context.ProviderCallContext, tags map[string]str
 	return maybeConvertCredentialError(err, ctx)
 }
 
-func tagRootDisk(e Client, ctx context.ProviderCallContext, tags map[string]string, inst *types.Instance) error {
-	if len(tags) == 0 {
-		return nil
-	}
-	findVolumeID := func(inst *types.Instance) string {
-		for _, m := range inst.BlockDeviceMappings {
-			if aws.ToString(m.DeviceName) != aws.ToString(inst.RootDeviceName) {
-				continue
-			}
-			if m.Ebs == nil {
-				continue
-			}
-			return aws.ToString(m.Ebs.VolumeId)
-		}
-		return ""
-	}
-	// Wait until the instance has an associated EBS volume in the
-	// block-device-mapping.
-	volumeID := findVolumeID(inst)
-	// TODO(katco): 2016-08-09: lp:1611427
-	waitRootDiskAttempt := utils.AttemptStrategy{
-		Total: 5 * time.Minute,
-		Delay: 5 * time.Second,
-	}
-	for a := waitRootDiskAttempt.Start(); volumeID == "" && a.Next(); {
-		resp, err := e.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
-			InstanceIds: []string{aws.ToString(inst.InstanceId)},
-		})
-		if err != nil {
-			// EC2 calls are eventually consistent; if we get a
-			// NotFound error when looking up the instance we
-			// should retry until it appears or we run out of
-			// attempts.
-			if strings.HasSuffix(ec2ErrCode(err), ".NotFound") {
-				logger.Debugf("instance %v is not available yet; retrying fetch of instance information", inst.InstanceId)
-				continue
-			}
-
-			// No need to retry for other error types.
-			return errors.Annotate(
-				maybeConvertCredentialError(err, ctx),
-				"cannot fetch instance information",
-			)
-		}
-		if len(resp.Reservations) > 0 && len(resp.Reservations[0].Instances) > 0 {
-			inst = &resp.Reservations[0].Instances[0]
-			volumeID = findVolumeID(inst)
-		}
-	}
-	if volumeID == "" {
-		return errors.New("timed out waiting for EBS volume to be associated")
+// makeTagSpecification converts the given tags to a TagSpecification,
+// used to tag resources of the given type when they are created.
+func makeTagSpecification(resourceType types.ResourceType, tags map[string]string) types.TagSpecification {
+	spec := types.TagSpecification{ResourceType: resourceType}
+	for k, v := range tags {
+		spec.Tags = append(spec.Tags, types.Tag{Key: aws.String(k), Value: aws.String(v)})
 	}
-	return tagResources(e, ctx, tags, volumeID)
+	return spec
 }
 
 var runInstances = _runInstances
@@ -1668,6 +1631,24 @@ func (e *environ) subnetsForVPC(ctx context.ProviderCallContext) ([]types.Subnet
 	return resp.Subnets, vpcId, maybeConvertCredentialError(err, ctx)
 }
 
+// ConsoleOutput is part of the environs.InstanceConsole interface.
+func (e *environ) ConsoleOutput(ctx context.ProviderCallContext, id instance.Id) (string, error) {
+	output, err := e.ec2Client.GetConsoleOutput(ctx, &ec2.GetConsoleOutputInput{
+		InstanceId: aws.String(string(id)),
+	})
+	if err != nil {
+		return "", errors.Annotatef(maybeConvertCredentialError(err, ctx), "getting console output for instance %q", id)
+	}
+	if output.Output == nil {
+		return "", nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(aws.ToString(output.Output))
+	if err != nil {
+		return "", errors.Annotatef(err, "decoding console output for instance %q", id)
+	}
+	return string(decoded), nil
+}
+
 // AdoptResources is part of the Environ interface.
 func (e *environ) AdoptResources(ctx context.ProviderCallContext, controllerUUID string, fromVersion version.Number) error {
 	// Gather resource ids for instances, volumes and security groups tagged with this model.
@@ -5,6 +5,7 @@ package testing
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"strconv"
 	"strings"
@@ -49,6 +50,7 @@ type Instance struct {
 	rootDeviceName      string
 
 	iamInstanceProfile *types.IamInstanceProfileSpecification
+	metadataOptions    *types.InstanceMetadataOptionsResponse
 }
 
 // TerminateInstances implements ec2.Client.
@@ -79,6 +81,23 @@ func (srv *Server) TerminateInstances(ctx context.Context, in *ec2.TerminateInst
 	return resp, nil
 }
 
+// GetConsoleOutput implements the GetConsoleOutput entrypoint, returning
+// a canned base64 encoded output for the given instance.
+func (srv *Server) GetConsoleOutput(ctx context.Context, in *ec2.GetConsoleOutputInput, opts ...func(*ec2.Options)) (*ec2.GetConsoleOutputOutput, error) {
+	id := aws.ToString(in.InstanceId)
+	if _, err := srv.instance(id); err != nil {
+		return nil, err
+	}
+	output := base64.StdEncoding.EncodeToString(
+		[]byte(fmt.Sprintf("console output for %s", id)),
+	)
+	return &ec2.GetConsoleOutputOutput{
+		InstanceId: in.InstanceId,
+		Output:     aws.String(output),
+		Timestamp:  aws.Time(time.Now()),
+	}, nil
+}
+
 func (srv *Server) instance(id string) (*Instance, error) {
 	srv.mu.Lock()
 	defer srv.mu.Unlock()
@@ -158,6 +177,29 @@ func (srv *Server) RunInstances(ctx context.Context, in *ec2.RunInstancesInput,
 		ifacesToCreate = srv.addDefaultNIC(instSubnet)
 	}
 
+	// Tag specifications are applied to the resources created by
+	// the request, as EC2 does.
+	var instanceTags, volumeTags []types.Tag
+	for _, spec := range in.TagSpecifications {
+		switch spec.ResourceType {
+		case types.ResourceTypeInstance:
+			instanceTags = append(instanceTags, spec.Tags...)
+		case types.ResourceTypeVolume:
+			volumeTags = append(volumeTags, spec.Tags...)
+		default:
+			return nil, apiError("InvalidParameterValue", "unsupported tag specification resource type %q", spec.ResourceType)
+		}
+	}
+
+	var metadataOptions *types.InstanceMetadataOptionsResponse
+	if in.MetadataOptions != nil {
+		metadataOptions = &types.InstanceMetadataOptionsResponse{
+			HttpEndpoint: in.MetadataOptions.HttpEndpoint,
+			HttpTokens:   in.MetadataOptions.HttpTokens,
+			State:        types.InstanceMetadataOptionsStateApplied,
+		}
+	}
+
 	resp := &ec2.RunInstancesOutput{}
 	resp.ReservationId = aws.String(r.id)
 	resp.OwnerId = aws.String(ownerId)
@@ -175,6 +217,16 @@ func (srv *Server) RunInstances(ctx context.Context, in *ec2.RunInstancesInput,
 		inst.blockDeviceMappings = append(inst.blockDeviceMappings,
 			srv.createBlockDeviceMappingsOnRun(in.BlockDeviceMappings)...,
 		)
+		inst.tags = append(inst.tags, instanceTags...)
+		inst.metadataOptions = metadataOptions
+		for _, mapping := range inst.blockDeviceMappings {
+			if mapping.Ebs == nil {
+				continue
+			}
+			if vol := srv.volumes[aws.ToString(mapping.Ebs.VolumeId)]; vol != nil {
+				vol.Tags = append(vol.Tags, volumeTags...)
+			}
+		}
 		resp.Instances = append(resp.Instances, inst.ec2instance())
 	}
 	return resp, nil
@@ -399,6 +451,7 @@ func (inst *Instance) ec2instance() types.Instance {
 		Tags:                inst.tags,
 		RootDeviceType:      inst.rootDeviceType,
 		RootDeviceName:      aws.String(inst.rootDeviceName),
+		MetadataOptions:     inst.metadataOptions,
 	}
 }
 
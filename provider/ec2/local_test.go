@@ -1847,6 +1847,20 @@ func (t *localServerSuite) TestRootDiskTags(c *gc.C) {
 	})
 }
 
+func (t *localServerSuite) TestInstanceMetadataOptions(c *gc.C) {
+	env := t.prepareAndBootstrap(c)
+
+	instances, err := env.AllRunningInstances(t.callCtx)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(instances, gc.HasLen, 1)
+
+	// IMDSv2 is required unless the model opts out.
+	ec2Inst := ec2.InstanceSDKEC2(instances[0])
+	c.Assert(ec2Inst.MetadataOptions, gc.NotNil)
+	c.Assert(ec2Inst.MetadataOptions.HttpEndpoint, gc.Equals, types.InstanceMetadataEndpointStateEnabled)
+	c.Assert(ec2Inst.MetadataOptions.HttpTokens, gc.Equals, types.HttpTokensStateRequired)
+}
+
 func (s *localServerSuite) TestBootstrapInstanceConstraints(c *gc.C) {
 	env := s.prepareAndBootstrap(c)
 	inst, err := env.AllRunningInstances(s.callCtx)
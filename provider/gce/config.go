@@ -12,7 +12,11 @@ import (
 )
 
 const (
-	cfgBaseImagePath = "base-image-path"
+	cfgBaseImagePath               = "base-image-path"
+	cfgShieldedSecureBoot          = "shielded-secure-boot"
+	cfgShieldedVTPM                = "shielded-vtpm"
+	cfgShieldedIntegrityMonitoring = "shielded-integrity-monitoring"
+	cfgConfidentialCompute         = "confidential-compute"
 )
 
 var configSchema = environschema.Fields{
@@ -20,6 +24,22 @@ var configSchema = environschema.Fields{
 		Description: "Base path to look for machine disk images.",
 		Type:        environschema.Tstring,
 	},
+	cfgShieldedSecureBoot: {
+		Description: "Start instances with shielded VM secure boot enabled. Only affects instances started after the value is changed.",
+		Type:        environschema.Tbool,
+	},
+	cfgShieldedVTPM: {
+		Description: "Start instances with the shielded VM virtual trusted platform module enabled. Only affects instances started after the value is changed.",
+		Type:        environschema.Tbool,
+	},
+	cfgShieldedIntegrityMonitoring: {
+		Description: "Start instances with shielded VM boot integrity monitoring enabled. Only affects instances started after the value is changed.",
+		Type:        environschema.Tbool,
+	},
+	cfgConfidentialCompute: {
+		Description: "Start instances on confidential computing hardware, encrypting memory in use. Requires an N2D machine type. Only affects instances started after the value is changed.",
+		Type:        environschema.Tbool,
+	},
 }
 
 // configFields is the spec for each GCE config value's type.
@@ -35,6 +55,12 @@ var configImmutableFields = []string{}
 
 var configDefaults = schema.Defaults{
 	cfgBaseImagePath: schema.Omit,
+	// The shielded VM defaults mirror those GCE applies to
+	// shielded-capable images.
+	cfgShieldedSecureBoot:          false,
+	cfgShieldedVTPM:                true,
+	cfgShieldedIntegrityMonitoring: true,
+	cfgConfidentialCompute:         false,
 }
 
 type environConfig struct {
@@ -85,3 +111,23 @@ func (c *environConfig) baseImagePath() (string, bool) {
 	path, ok := c.attrs[cfgBaseImagePath].(string)
 	return path, ok
 }
+
+func (c *environConfig) shieldedSecureBoot() bool {
+	enabled, _ := c.attrs[cfgShieldedSecureBoot].(bool)
+	return enabled
+}
+
+func (c *environConfig) shieldedVTPM() bool {
+	enabled, _ := c.attrs[cfgShieldedVTPM].(bool)
+	return enabled
+}
+
+func (c *environConfig) shieldedIntegrityMonitoring() bool {
+	enabled, _ := c.attrs[cfgShieldedIntegrityMonitoring].(bool)
+	return enabled
+}
+
+func (c *environConfig) confidentialCompute() bool {
+	enabled, _ := c.attrs[cfgConfidentialCompute].(bool)
+	return enabled
+}
@@ -94,6 +94,18 @@ var newConfigTests = []configTestSpec{{
 	info:   "unknown field is not touched",
 	insert: testing.Attrs{"unknown-field": 12345},
 	expect: testing.Attrs{"unknown-field": 12345},
+}, {
+	info:   "shielded-secure-boot must be a bool",
+	insert: testing.Attrs{"shielded-secure-boot": "nonsense"},
+	err:    `shielded-secure-boot: expected bool, got string\("nonsense"\)`,
+}, {
+	info:   "shielded-vtpm may be disabled",
+	insert: testing.Attrs{"shielded-vtpm": false},
+	expect: testing.Attrs{"shielded-vtpm": false},
+}, {
+	info:   "confidential-compute may be enabled",
+	insert: testing.Attrs{"confidential-compute": true},
+	expect: testing.Attrs{"confidential-compute": true},
 }}
 
 func (s *ConfigSuite) TestNewModelConfig(c *gc.C) {
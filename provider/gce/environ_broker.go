@@ -5,6 +5,7 @@ package gce
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/juju/errors"
 	"github.com/juju/utils/v2"
@@ -200,15 +201,36 @@ func (env *environ) newRawInstance(
 		allocatePublicIP = *args.Constraints.AllocatePublicIP
 	}
 
+	confidentialCompute := env.ecfg.confidentialCompute()
+	if confidentialCompute && !strings.HasPrefix(spec.InstanceType.Name, "n2d-") {
+		return nil, common.ZoneIndependentError(errors.Errorf(
+			"confidential computing is only supported on n2d machine types, not %q",
+			spec.InstanceType.Name,
+		))
+	}
+
+	// Shielded VM options are only sent when they differ from the GCE
+	// defaults, so that images without UEFI support remain usable.
+	var shieldedVM *google.ShieldedInstanceOptions
+	if env.ecfg.shieldedSecureBoot() || !env.ecfg.shieldedVTPM() || !env.ecfg.shieldedIntegrityMonitoring() {
+		shieldedVM = &google.ShieldedInstanceOptions{
+			SecureBoot:          env.ecfg.shieldedSecureBoot(),
+			VTPM:                env.ecfg.shieldedVTPM(),
+			IntegrityMonitoring: env.ecfg.shieldedIntegrityMonitoring(),
+		}
+	}
+
 	inst, err := env.gce.AddInstance(google.InstanceSpec{
-		ID:                hostname,
-		Type:              spec.InstanceType.Name,
-		Disks:             disks,
-		NetworkInterfaces: []string{"ExternalNAT"},
-		Metadata:          metadata,
-		Tags:              tags,
-		AvailabilityZone:  args.AvailabilityZone,
-		AllocatePublicIP:  allocatePublicIP,
+		ID:                  hostname,
+		Type:                spec.InstanceType.Name,
+		Disks:               disks,
+		NetworkInterfaces:   []string{"ExternalNAT"},
+		Metadata:            metadata,
+		Tags:                tags,
+		AvailabilityZone:    args.AvailabilityZone,
+		AllocatePublicIP:    allocatePublicIP,
+		ShieldedVM:          shieldedVM,
+		ConfidentialCompute: confidentialCompute,
 	})
 	if err != nil {
 		// We currently treat all AddInstance failures
@@ -298,7 +320,18 @@ func (env *environ) getHardwareCharacteristics(spec *instances.InstanceSpec, ins
 		CpuPower:         spec.InstanceType.CpuPower,
 		RootDisk:         &rootDiskMB,
 		AvailabilityZone: &inst.base.ZoneName,
-		// Tags: not supported in GCE.
+		// Tags: not supported in GCE; instead they surface the
+		// non-default security options applied to the instance.
+	}
+	var secTags []string
+	if env.ecfg.shieldedSecureBoot() {
+		secTags = append(secTags, cfgShieldedSecureBoot)
+	}
+	if env.ecfg.confidentialCompute() {
+		secTags = append(secTags, cfgConfidentialCompute)
+	}
+	if len(secTags) > 0 {
+		hwc.Tags = &secTags
 	}
 	return &hwc
 }
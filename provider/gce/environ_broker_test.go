@@ -183,6 +183,66 @@ func (s *environBrokerSuite) TestNewRawInstanceNoPublicIP(c *gc.C) {
 	c.Assert(nics[0].AccessConfigs, gc.HasLen, 0)
 }
 
+func (s *environBrokerSuite) TestNewRawInstanceShieldedVM(c *gc.C) {
+	s.UpdateConfig(c, map[string]interface{}{"shielded-secure-boot": true})
+	s.FakeConn.Inst = s.BaseInstance
+	s.FakeCommon.AZInstances = []common.AvailabilityZoneInstances{{
+		ZoneName:  "home-zone",
+		Instances: []instance.Id{s.Instance.Id()},
+	}}
+
+	_, err := gce.NewRawInstance(s.Env, s.CallCtx, s.StartInstArgs, s.spec)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(s.FakeConn.Calls, gc.HasLen, 1)
+	c.Check(s.FakeConn.Calls[0].InstanceSpec.ShieldedVM, jc.DeepEquals, &google.ShieldedInstanceOptions{
+		SecureBoot:          true,
+		VTPM:                true,
+		IntegrityMonitoring: true,
+	})
+}
+
+func (s *environBrokerSuite) TestNewRawInstanceShieldedVMDefaultsOmitted(c *gc.C) {
+	s.FakeConn.Inst = s.BaseInstance
+	s.FakeCommon.AZInstances = []common.AvailabilityZoneInstances{{
+		ZoneName:  "home-zone",
+		Instances: []instance.Id{s.Instance.Id()},
+	}}
+
+	_, err := gce.NewRawInstance(s.Env, s.CallCtx, s.StartInstArgs, s.spec)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(s.FakeConn.Calls, gc.HasLen, 1)
+	c.Check(s.FakeConn.Calls[0].InstanceSpec.ShieldedVM, gc.IsNil)
+	c.Check(s.FakeConn.Calls[0].InstanceSpec.ConfidentialCompute, jc.IsFalse)
+}
+
+func (s *environBrokerSuite) TestNewRawInstanceConfidentialCompute(c *gc.C) {
+	s.UpdateConfig(c, map[string]interface{}{"confidential-compute": true})
+	s.FakeConn.Inst = s.BaseInstance
+	s.FakeCommon.AZInstances = []common.AvailabilityZoneInstances{{
+		ZoneName:  "home-zone",
+		Instances: []instance.Id{s.Instance.Id()},
+	}}
+
+	spec := *s.spec
+	spec.InstanceType.Name = "n2d-standard-2"
+	_, err := gce.NewRawInstance(s.Env, s.CallCtx, s.StartInstArgs, &spec)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(s.FakeConn.Calls, gc.HasLen, 1)
+	c.Check(s.FakeConn.Calls[0].InstanceSpec.ConfidentialCompute, jc.IsTrue)
+}
+
+func (s *environBrokerSuite) TestNewRawInstanceConfidentialComputeMachineType(c *gc.C) {
+	s.UpdateConfig(c, map[string]interface{}{"confidential-compute": true})
+
+	_, err := gce.NewRawInstance(s.Env, s.CallCtx, s.StartInstArgs, s.spec)
+	c.Assert(err, gc.ErrorMatches, `confidential computing is only supported on n2d machine types, not "n1-standard-1"`)
+	c.Assert(err, jc.Satisfies, environs.IsAvailabilityZoneIndependent)
+	c.Check(s.FakeConn.Calls, gc.HasLen, 0)
+}
+
 func (s *environBrokerSuite) TestNewRawInstanceZoneInvalidCredentialError(c *gc.C) {
 	s.FakeConn.Err = gce.InvalidCredentialError
 	c.Assert(s.InvalidatedCredentials, jc.IsFalse)
@@ -306,6 +366,19 @@ func (s *environBrokerSuite) TestGetHardwareCharacteristics(c *gc.C) {
 	c.Check(*hwc.CpuPower, gc.Equals, uint64(275))
 	c.Check(*hwc.Mem, gc.Equals, uint64(3750))
 	c.Check(*hwc.RootDisk, gc.Equals, uint64(15360))
+	c.Check(hwc.Tags, gc.IsNil)
+}
+
+func (s *environBrokerSuite) TestGetHardwareCharacteristicsSecurityTags(c *gc.C) {
+	s.UpdateConfig(c, map[string]interface{}{
+		"shielded-secure-boot": true,
+		"confidential-compute": true,
+	})
+
+	hwc := gce.GetHardwareCharacteristics(s.Env, s.spec, s.Instance)
+
+	c.Assert(hwc.Tags, gc.NotNil)
+	c.Check(*hwc.Tags, jc.DeepEquals, []string{"shielded-secure-boot", "confidential-compute"})
 }
 
 func (s *environBrokerSuite) TestAllRunningInstances(c *gc.C) {
@@ -54,6 +54,10 @@ func NewNetInterface(spec NetworkSpec, name string, allocatePublicIP bool) *comp
 	return spec.newInterface(name, allocatePublicIP)
 }
 
+func RawInstance(spec InstanceSpec) *compute.Instance {
+	return spec.raw()
+}
+
 func ConnAddInstance(conn *Connection, inst *compute.Instance, mtype string, zone string) error {
 	return conn.addInstance(inst, mtype, zone)
 }
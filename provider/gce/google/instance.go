@@ -56,10 +56,33 @@ type InstanceSpec struct {
 	// AllocatePublicIP is true if the instance should be assigned a public IP
 	// address, exposing it to access from outside the internal network.
 	AllocatePublicIP bool
+
+	// ShieldedVM, if set, holds the shielded VM options to apply to the
+	// new instance. If nil the GCE defaults are used.
+	ShieldedVM *ShieldedInstanceOptions
+
+	// ConfidentialCompute is true if the instance should run on
+	// confidential computing hardware, with memory encrypted in use.
+	ConfidentialCompute bool
+}
+
+// ShieldedInstanceOptions holds the shielded VM options to apply to a
+// new instance.
+type ShieldedInstanceOptions struct {
+	// SecureBoot ensures the instance only boots software signed by
+	// an authorized key.
+	SecureBoot bool
+
+	// VTPM enables the instance's virtual trusted platform module.
+	VTPM bool
+
+	// IntegrityMonitoring compares the instance's boot measurements
+	// against a trusted baseline.
+	IntegrityMonitoring bool
 }
 
 func (is InstanceSpec) raw() *compute.Instance {
-	return &compute.Instance{
+	raw := &compute.Instance{
 		Name:              is.ID,
 		Disks:             is.disks(),
 		NetworkInterfaces: is.networkInterfaces(),
@@ -67,6 +90,22 @@ func (is InstanceSpec) raw() *compute.Instance {
 		Tags:              &compute.Tags{Items: is.Tags},
 		// MachineType is set in the addInstance call.
 	}
+	if is.ShieldedVM != nil {
+		raw.ShieldedInstanceConfig = &compute.ShieldedInstanceConfig{
+			EnableSecureBoot:          is.ShieldedVM.SecureBoot,
+			EnableVtpm:                is.ShieldedVM.VTPM,
+			EnableIntegrityMonitoring: is.ShieldedVM.IntegrityMonitoring,
+			// The GCE defaults for vTPM and integrity monitoring are
+			// enabled, so false values must be sent explicitly.
+			ForceSendFields: []string{"EnableSecureBoot", "EnableVtpm", "EnableIntegrityMonitoring"},
+		}
+	}
+	if is.ConfidentialCompute {
+		// Confidential instances cannot be live-migrated, so host
+		// maintenance must terminate them.
+		raw.Scheduling = &compute.Scheduling{OnHostMaintenance: "TERMINATE"}
+	}
+	return raw
 }
 
 // Summary builds an InstanceSummary based on the spec and returns it.
@@ -29,6 +29,35 @@ func (s *instanceSuite) TestNewInstance(c *gc.C) {
 	c.Check(spec, jc.DeepEquals, &s.InstanceSpec)
 }
 
+func (s *instanceSuite) TestRawInstanceShieldedVM(c *gc.C) {
+	spec := s.InstanceSpec
+	spec.ShieldedVM = &google.ShieldedInstanceOptions{
+		SecureBoot:          true,
+		VTPM:                true,
+		IntegrityMonitoring: false,
+	}
+
+	raw := google.RawInstance(spec)
+
+	c.Check(raw.ShieldedInstanceConfig, jc.DeepEquals, &compute.ShieldedInstanceConfig{
+		EnableSecureBoot:          true,
+		EnableVtpm:                true,
+		EnableIntegrityMonitoring: false,
+		ForceSendFields:           []string{"EnableSecureBoot", "EnableVtpm", "EnableIntegrityMonitoring"},
+	})
+	c.Check(raw.Scheduling, gc.IsNil)
+}
+
+func (s *instanceSuite) TestRawInstanceConfidentialCompute(c *gc.C) {
+	spec := s.InstanceSpec
+	spec.ConfidentialCompute = true
+
+	raw := google.RawInstance(spec)
+
+	c.Check(raw.Scheduling, jc.DeepEquals, &compute.Scheduling{OnHostMaintenance: "TERMINATE"})
+	c.Check(raw.ShieldedInstanceConfig, gc.IsNil)
+}
+
 func (s *instanceSuite) TestNewInstanceNoSpec(c *gc.C) {
 	inst := google.NewInstanceRaw(&s.RawInstanceFull, nil)
 
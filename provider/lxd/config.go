@@ -4,6 +4,8 @@
 package lxd
 
 import (
+	"strings"
+
 	"github.com/juju/errors"
 	"github.com/juju/schema"
 	"gopkg.in/juju/environschema.v1"
@@ -16,10 +18,15 @@ var configSchema = environschema.Fields{
 		Description: "The LXD project name to use for Juju's resources.",
 		Type:        environschema.Tstring,
 	},
+	"image-remotes": {
+		Description: "A comma-separated list of additional simplestreams image server URLs to trust for container images (optional). Each URL must use https.",
+		Type:        environschema.Tstring,
+	},
 }
 
 var configDefaults = schema.Defaults{
-	"project": "default",
+	"project":       "default",
+	"image-remotes": "",
 }
 
 var configFields = func() schema.Fields {
@@ -70,7 +77,12 @@ func (c *environConfig) validate() error {
 	if err != nil {
 		return errors.Trace(err)
 	}
-	// There are currently no known extra fields for LXD
+	// LXD only trusts image servers that are reachable over https.
+	for _, remote := range c.imageRemotes() {
+		if !strings.HasPrefix(remote, "https://") {
+			return errors.Errorf("image-remotes: %q is not an https URL", remote)
+		}
+	}
 	return nil
 }
 
@@ -81,3 +93,19 @@ func (c *environConfig) project() string {
 	}
 	return project.(string)
 }
+
+// imageRemotes returns the additional trusted image server URLs
+// configured for the model, if any.
+func (c *environConfig) imageRemotes() []string {
+	raw, _ := c.attrs["image-remotes"].(string)
+	if raw == "" {
+		return nil
+	}
+	var remotes []string
+	for _, remote := range strings.Split(raw, ",") {
+		if remote = strings.TrimSpace(remote); remote != "" {
+			remotes = append(remotes, remote)
+		}
+	}
+	return remotes
+}
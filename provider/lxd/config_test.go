@@ -133,6 +133,14 @@ var newConfigTests = []configTestSpec{{
 	info:   "unknown field is not touched",
 	insert: testing.Attrs{"unknown-field": 12345},
 	expect: testing.Attrs{"unknown-field": 12345},
+}, {
+	info:   "image-remotes may hold a list of https URLs",
+	insert: testing.Attrs{"image-remotes": "https://a.example.com, https://b.example.com"},
+	expect: testing.Attrs{"image-remotes": "https://a.example.com, https://b.example.com"},
+}, {
+	info:   "image-remotes rejects non-https URLs",
+	insert: testing.Attrs{"image-remotes": "http://a.example.com"},
+	err:    `image-remotes: "http://a.example.com" is not an https URL`,
 }}
 
 func (s *configSuite) TestNewModelConfig(c *gc.C) {
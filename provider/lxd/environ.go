@@ -5,10 +5,12 @@ package lxd
 
 import (
 	stdcontext "context"
+	"io/ioutil"
 	"strings"
 	"sync"
 
 	"github.com/juju/errors"
+	lxdclient "github.com/lxc/lxd/client"
 	"github.com/lxc/lxd/shared/api"
 
 	"github.com/juju/juju/core/instance"
@@ -176,6 +178,13 @@ func (env *environ) server() Server {
 	return env.serverUnlocked
 }
 
+func (env *environ) ecfg() *environConfig {
+	env.lock.Lock()
+	defer env.lock.Unlock()
+
+	return env.ecfgUnlocked
+}
+
 // Config returns the configuration data with which the env was created.
 func (env *environ) Config() *config.Config {
 	env.lock.Lock()
@@ -259,6 +268,21 @@ func (env *environ) destroyHostedModelResources(controllerUUID string) error {
 	return errors.Trace(env.server().RemoveContainers(names))
 }
 
+// ConsoleOutput is part of the environs.InstanceConsole interface.
+func (env *environ) ConsoleOutput(ctx context.ProviderCallContext, id instance.Id) (string, error) {
+	rc, err := env.server().GetContainerConsoleLog(string(id), &lxdclient.ContainerConsoleLogArgs{})
+	if err != nil {
+		common.HandleCredentialError(IsAuthorisationFailure, err, ctx)
+		return "", errors.Annotatef(err, "getting console log for instance %q", id)
+	}
+	defer func() { _ = rc.Close() }()
+	content, err := ioutil.ReadAll(rc)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return string(content), nil
+}
+
 // lxdAvailabilityZone wraps a LXD cluster member as an availability zone.
 type lxdAvailabilityZone struct {
 	api.ClusterMember
@@ -304,6 +328,21 @@ func (env *environ) AvailabilityZones(ctx context.ProviderCallContext) (network.
 	return aZones, nil
 }
 
+// VMHosts (VMHostEnviron) returns the names of the cluster members that
+// containers can be composed on. For LXD these are the same names as
+// the availability zones.
+func (env *environ) VMHosts(ctx context.ProviderCallContext) ([]string, error) {
+	zones, err := env.AvailabilityZones(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	names := make([]string, len(zones))
+	for i, zone := range zones {
+		names[i] = zone.Name()
+	}
+	return names, nil
+}
+
 // InstanceAvailabilityZoneNames (ZonedEnviron) returns the names of the
 // availability zones for the specified instances.
 // For containers, this means the LXD server node names where they reside.
@@ -163,6 +163,11 @@ func (env *environ) getImageSources() ([]lxd.ServerSpec, error) {
 		// https://github.com/lxc/lxd/issues/1763
 		remotes = append(remotes, lxd.MakeSimpleStreamsServerSpec(source.Description(), url))
 	}
+	// Any additional remotes trusted via the model configuration are
+	// consulted after the standard metadata sources.
+	for _, url := range env.ecfg().imageRemotes() {
+		remotes = append(remotes, lxd.MakeSimpleStreamsServerSpec(url, url))
+	}
 	return remotes, nil
 }
 
@@ -360,7 +365,9 @@ func (env *environ) parsePlacement(ctx context.ProviderCallContext, placement st
 	if pos == -1 {
 		node = placement
 	} else {
-		if placement[:pos] != "zone" {
+		// "vmhost" targets a cluster member by name, which for LXD
+		// is the same namespace as the availability zones.
+		if placement[:pos] != "zone" && placement[:pos] != "vmhost" {
 			return nil, fmt.Errorf("unknown placement directive: %v", placement)
 		}
 		node = placement[pos+1:]
@@ -394,11 +401,15 @@ func (env *environ) getHardwareCharacteristics(
 	}
 	cores := uint64(container.CPUs())
 	mem := uint64(container.Mem())
-	return &instance.HardwareCharacteristics{
+	hc := &instance.HardwareCharacteristics{
 		Arch:     &archStr,
 		CpuCores: &cores,
 		Mem:      &mem,
 	}
+	if container.Location != "" {
+		hc.VMHost = &container.Location
+	}
+	return hc
 }
 
 // AllInstances implements environs.InstanceBroker.
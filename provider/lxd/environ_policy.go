@@ -24,6 +24,7 @@ var unsupportedConstraints = []string{
 	constraints.VirtType,
 	constraints.Container,
 	constraints.AllocatePublicIP,
+	constraints.ImageID,
 }
 
 // ConstraintsValidator returns a Validator value which is used to
@@ -5,6 +5,7 @@
 package lxd
 
 import (
+	io "io"
 	net "net"
 	reflect "reflect"
 
@@ -336,6 +337,21 @@ func (mr *MockServerMockRecorder) GetContainer(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContainer", reflect.TypeOf((*MockServer)(nil).GetContainer), arg0)
 }
 
+// GetContainerConsoleLog mocks base method.
+func (m *MockServer) GetContainerConsoleLog(arg0 string, arg1 *client.ContainerConsoleLogArgs) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContainerConsoleLog", arg0, arg1)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContainerConsoleLog indicates an expected call of GetContainerConsoleLog.
+func (mr *MockServerMockRecorder) GetContainerConsoleLog(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContainerConsoleLog", reflect.TypeOf((*MockServer)(nil).GetContainerConsoleLog), arg0, arg1)
+}
+
 // GetContainerProfiles mocks base method.
 func (m *MockServer) GetContainerProfiles(arg0 string) ([]string, error) {
 	m.ctrl.T.Helper()
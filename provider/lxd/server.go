@@ -5,6 +5,7 @@ package lxd
 
 import (
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"net/url"
@@ -84,6 +85,7 @@ type Server interface {
 	GetNetworkState(name string) (*lxdapi.NetworkState, error)
 	GetContainer(name string) (*lxdapi.Container, string, error)
 	GetContainerState(name string) (*lxdapi.ContainerState, string, error)
+	GetContainerConsoleLog(name string, args *lxdclient.ContainerConsoleLogArgs) (io.ReadCloser, error)
 
 	// UseProject ensures that this server will use the input project.
 	// See: https://linuxcontainers.org/lxd/docs/master/projects.
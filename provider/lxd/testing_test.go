@@ -4,9 +4,12 @@
 package lxd
 
 import (
+	"io"
+	"io/ioutil"
 	"net"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/juju/clock"
@@ -486,6 +489,14 @@ func (conn *StubClient) GetCertificate(fingerprint string) (*api.Certificate, st
 	return &api.Certificate{}, "", conn.NextErr()
 }
 
+func (conn *StubClient) GetContainerConsoleLog(name string, args *lxdclient.ContainerConsoleLogArgs) (io.ReadCloser, error) {
+	conn.AddCall("GetContainerConsoleLog", name, args)
+	if err := conn.NextErr(); err != nil {
+		return nil, err
+	}
+	return ioutil.NopCloser(strings.NewReader("console log")), nil
+}
+
 func (conn *StubClient) GetServer() (*api.Server, string, error) {
 	conn.AddCall("ServerStatus")
 	if err := conn.NextErr(); err != nil {
@@ -5,8 +5,10 @@ package maas
 
 import (
 	stdcontext "context"
+	"encoding/base64"
 	"fmt"
 	"net/http"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
@@ -263,6 +265,16 @@ func (env *maasEnviron) SetCloudSpec(_ stdcontext.Context, spec environscloudspe
 	env.apiVersion = apiVersion
 	env.storageUnlocked = NewStorage(env)
 
+	// Some endpoints, such as the node installation results used for
+	// console output, are not covered by the controller API wrapper,
+	// so keep a raw client for them too.
+	client, err := gomaasapi.NewAuthenticatedClient(
+		gomaasapi.AddAPIVersionToURL(maasServer, apiVersion2), maasOAuth)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	env.maasClientUnlocked = gomaasapi.NewMAAS(*client)
+
 	return nil
 }
 
@@ -282,6 +294,33 @@ func (env *maasEnviron) getSupportedArchitectures(ctx context.ProviderCallContex
 	return architectures.SortedValues(), nil
 }
 
+// validateImageID checks that the given image id names a boot resource
+// known to MAAS, and that its architecture matches one the requested
+// tools can run on. The validated id is returned, and is passed to MAAS
+// in place of the distro series when the node is started.
+func (env *maasEnviron) validateImageID(ctx context.ProviderCallContext, imageID string, arches []string) (string, error) {
+	resources, err := env.maasController.BootResources()
+	if err != nil {
+		common.HandleCredentialError(IsAuthorisationFailure, err, ctx)
+		return "", errors.Trace(err)
+	}
+	requested := set.NewStrings(arches...)
+	for _, resource := range resources {
+		if resource.Name() != imageID {
+			continue
+		}
+		resourceArch := strings.Split(resource.Architecture(), "/")[0]
+		if !requested.IsEmpty() && !requested.Contains(resourceArch) {
+			return "", errors.Errorf(
+				"image %q is for architecture %q, which does not match %s",
+				imageID, resourceArch, requested.SortedValues(),
+			)
+		}
+		return imageID, nil
+	}
+	return "", errors.NotFoundf("boot resource %q", imageID)
+}
+
 // SupportsSpaces is specified on environs.Networking.
 func (env *maasEnviron) SupportsSpaces(ctx context.ProviderCallContext) (bool, error) {
 	return true, nil
@@ -383,6 +422,7 @@ type maasPlacement struct {
 	nodeName string
 	zoneName string
 	systemId string
+	vmHost   string
 }
 
 func (env *maasEnviron) parsePlacement(ctx context.ProviderCallContext, placement string) (*maasPlacement, error) {
@@ -404,6 +444,17 @@ func (env *maasEnviron) parsePlacement(ctx context.ProviderCallContext, placemen
 		return &maasPlacement{zoneName: value}, nil
 	case "system-id":
 		return &maasPlacement{systemId: value}, nil
+	case "vmhost":
+		hosts, err := env.VMHosts(ctx)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		for _, host := range hosts {
+			if host == value {
+				return &maasPlacement{vmHost: value}, nil
+			}
+		}
+		return nil, errors.NotFoundf("VM host %q", value)
 	}
 
 	return nil, errors.Errorf("unknown placement directive: %v", placement)
@@ -480,6 +531,51 @@ func (env *maasEnviron) getMAASClient() *gomaasapi.MAASObject {
 	return env.maasClientUnlocked
 }
 
+// ConsoleOutput is part of the environs.InstanceConsole interface.
+// MAAS does not expose a live serial console, so the installation
+// output recorded for the node is returned instead; that is the log
+// MAAS itself surfaces when a deployment fails.
+func (env *maasEnviron) ConsoleOutput(ctx context.ProviderCallContext, id instance.Id) (string, error) {
+	client := env.getMAASClient()
+	if client == nil {
+		return "", errors.NotSupportedf("console output on this MAAS endpoint")
+	}
+	result, err := client.GetSubObject("installation-results").CallGet("", url.Values{
+		"system_id": {string(id)},
+	})
+	if err != nil {
+		common.HandleCredentialError(IsAuthorisationFailure, err, ctx)
+		return "", errors.Annotatef(err, "getting installation results for instance %q", id)
+	}
+	entries, err := result.GetArray()
+	if err != nil {
+		return "", errors.Annotatef(err, "parsing installation results for instance %q", id)
+	}
+	var output []string
+	for _, entry := range entries {
+		attrs, err := entry.GetMap()
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		data, ok := attrs["data"]
+		if !ok {
+			continue
+		}
+		encoded, err := data.GetString()
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			// Older MAAS releases return the output verbatim.
+			output = append(output, encoded)
+			continue
+		}
+		output = append(output, string(decoded))
+	}
+	return strings.Join(output, "\n"), nil
+}
+
 var dashSuffix = regexp.MustCompile("^(.*)-\\d+$")
 
 func spaceNamesToSpaceInfo(
@@ -713,7 +809,7 @@ func (env *maasEnviron) StartInstance(
 ) (_ *environs.StartInstanceResult, err error) {
 
 	availabilityZone := args.AvailabilityZone
-	var nodeName, systemId string
+	var nodeName, systemId, vmHost string
 	if args.Placement != "" {
 		placement, err := env.parsePlacement(ctx, args.Placement)
 		if err != nil {
@@ -729,6 +825,18 @@ func (env *maasEnviron) StartInstance(
 		case placement.nodeName != "":
 			availabilityZone = ""
 			nodeName = placement.nodeName
+		case placement.vmHost != "":
+			// Compose a machine on the pod first; once it is
+			// ready it is acquired by system ID like any other
+			// node.
+			availabilityZone = ""
+			vmHost = placement.vmHost
+			systemId, err = env.composeMachine(ctx, vmHost, args.Constraints)
+			if err != nil {
+				return nil, common.ZoneIndependentError(errors.Annotatef(
+					err, "composing machine on VM host %q", vmHost,
+				))
+			}
 		}
 	}
 	if availabilityZone != "" {
@@ -754,6 +862,17 @@ func (env *maasEnviron) StartInstance(
 		return nil, errors.Trace(err)
 	}
 
+	// An image-id constraint overrides the distro series with an
+	// explicit (possibly custom) MAAS boot resource. Check it before
+	// acquiring a node.
+	var distroSeries string
+	if args.Constraints.HasImageID() {
+		distroSeries, err = env.validateImageID(ctx, *args.Constraints.ImageID, args.Tools.Arches())
+		if err != nil {
+			return nil, common.ZoneIndependentError(err)
+		}
+	}
+
 	inst, selectNodeErr := env.selectNode(ctx,
 		selectNodeArgs{
 			Constraints:      args.Constraints,
@@ -787,6 +906,9 @@ func (env *maasEnviron) StartInstance(
 	if err != nil {
 		return nil, common.ZoneIndependentError(err)
 	}
+	if vmHost != "" {
+		hc.VMHost = &vmHost
+	}
 
 	selectedTools, err := args.Tools.Match(tools.Filter{
 		Arch: *hc.Arch,
@@ -826,10 +948,16 @@ func (env *maasEnviron) StartInstance(
 	}
 	logger.Debugf("maas user data; %d bytes", len(userdata))
 
+	if distroSeries == "" {
+		distroSeries = series
+	} else {
+		hc.ImageID = args.Constraints.ImageID
+	}
+
 	var displayName string
 	var interfaces corenetwork.InterfaceInfos
 	inst2 := inst.(*maas2Instance)
-	startedInst, err := env.startNode2(*inst2, series, userdata)
+	startedInst, err := env.startNode2(*inst2, distroSeries, userdata)
 	if err != nil {
 		return nil, common.ZoneIndependentError(err)
 	}
@@ -841,12 +969,18 @@ func (env *maasEnviron) StartInstance(
 	if err != nil {
 		return nil, common.ZoneIndependentError(err)
 	}
-	env.tagInstance2(inst2, args.InstanceConfig)
-
 	displayName, err = inst2.displayName()
 	if err != nil {
 		return nil, common.ZoneIndependentError(err)
 	}
+	// Record the decorated display name in the node's owner data so
+	// MAAS dashboards can attribute the machine without manual tagging.
+	if decorated := common.ApplyInstanceNaming(env.Config(), displayName); decorated != displayName {
+		displayName = decorated
+		args.InstanceConfig.Tags[tags.JujuDisplayName] = displayName
+	}
+	env.tagInstance2(inst2, args.InstanceConfig)
+
 	logger.Debugf("started instance %q", inst.Id())
 
 	requestedVolumes := make([]names.VolumeTag, len(args.Volumes))
@@ -422,6 +422,40 @@ func (suite *maas2EnvironSuite) TestStartInstanceAppliesResourceTags(c *gc.C) {
 	})
 }
 
+func (suite *maas2EnvironSuite) TestStartInstanceImageIDConstraint(c *gc.C) {
+	env, controller := suite.injectControllerWithSpacesAndCheck(c, nil, gomaasapi.AllocateMachineArgs{})
+	controller.bootResources = []gomaasapi.BootResource{
+		&fakeBootResource{name: "custom/golden", architecture: arch.HostArch() + "/generic"},
+	}
+	params := environs.StartInstanceParams{
+		ControllerUUID: suite.controllerUUID,
+		Constraints:    constraints.MustParse("image-id=custom/golden"),
+	}
+	result, err := jujutesting.StartInstanceWithParams(env, suite.callCtx, "1", params)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Hardware.ImageID, gc.NotNil)
+	c.Assert(*result.Hardware.ImageID, gc.Equals, "custom/golden")
+
+	// The image is deployed by passing its name in place of the distro
+	// series.
+	machine := controller.allocateMachine.(*fakeMachine)
+	startArgs := machine.Calls()[0].Args[0].(gomaasapi.StartArgs)
+	c.Assert(startArgs.DistroSeries, gc.Equals, "custom/golden")
+}
+
+func (suite *maas2EnvironSuite) TestStartInstanceImageIDConstraintUnknownImage(c *gc.C) {
+	env, controller := suite.injectControllerWithSpacesAndCheck(c, nil, gomaasapi.AllocateMachineArgs{})
+	controller.bootResources = []gomaasapi.BootResource{
+		&fakeBootResource{name: "trusty", architecture: "amd64"},
+	}
+	params := environs.StartInstanceParams{
+		ControllerUUID: suite.controllerUUID,
+		Constraints:    constraints.MustParse("image-id=custom/golden"),
+	}
+	_, err := jujutesting.StartInstanceWithParams(env, suite.callCtx, "1", params)
+	c.Assert(err, gc.ErrorMatches, `.*boot resource "custom/golden" not found`)
+}
+
 func (suite *maas2EnvironSuite) TestStartInstanceParams(c *gc.C) {
 	var env *maasEnviron
 	suite.injectController(&fakeController{
@@ -556,18 +590,18 @@ func (suite *maas2EnvironSuite) TestAcquireNodeStorage(c *gc.C) {
 		volumes:  nil,
 		expected: []gomaasapi.StorageSpec{},
 	}, {
-		volumes:  []volumeInfo{{"volume-1", 1234, nil}},
+		volumes:  []volumeInfo{{"volume-1", 1234, nil, false}},
 		expected: []gomaasapi.StorageSpec{{"volume-1", 1234, nil}},
 	}, {
-		volumes:  []volumeInfo{{"", 1234, []string{"tag1", "tag2"}}},
+		volumes:  []volumeInfo{{"", 1234, []string{"tag1", "tag2"}, false}},
 		expected: []gomaasapi.StorageSpec{{"", 1234, []string{"tag1", "tag2"}}},
 	}, {
-		volumes:  []volumeInfo{{"volume-1", 1234, []string{"tag1", "tag2"}}},
+		volumes:  []volumeInfo{{"volume-1", 1234, []string{"tag1", "tag2"}, false}},
 		expected: []gomaasapi.StorageSpec{{"volume-1", 1234, []string{"tag1", "tag2"}}},
 	}, {
 		volumes: []volumeInfo{
-			{"volume-1", 1234, []string{"tag1", "tag2"}},
-			{"volume-2", 4567, []string{"tag1", "tag3"}},
+			{"volume-1", 1234, []string{"tag1", "tag2"}, false},
+			{"volume-2", 4567, []string{"tag1", "tag3"}, false},
 		},
 		expected: []gomaasapi.StorageSpec{
 			{"volume-1", 1234, []string{"tag1", "tag2"}},
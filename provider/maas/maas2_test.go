@@ -611,6 +611,18 @@ func (bd fakeBlockDevice) Size() uint64 {
 	return bd.size
 }
 
+func (bd fakeBlockDevice) Type() string {
+	return "blockdev"
+}
+
+func (bd fakeBlockDevice) Path() string {
+	return "/dev/" + bd.name
+}
+
+func (bd fakeBlockDevice) Tags() []string {
+	return nil
+}
+
 type fakePartition struct {
 	gomaasapi.Partition
 
@@ -631,6 +643,14 @@ func (part fakePartition) Size() uint64 {
 	return part.size
 }
 
+func (part fakePartition) Type() string {
+	return "partition"
+}
+
+func (part fakePartition) Tags() []string {
+	return nil
+}
+
 type fakeDevice struct {
 	*testing.Stub
 
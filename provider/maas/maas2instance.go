@@ -63,6 +63,13 @@ func (mi *maas2Instance) hardwareCharacteristics() (*instance.HardwareCharacteri
 	return hc, nil
 }
 
+// HardwareCharacteristics implements instances.InstanceHardware,
+// allowing the recorded hardware data to be refreshed after a machine
+// has been recommissioned.
+func (mi *maas2Instance) HardwareCharacteristics(_ context.ProviderCallContext) (*instance.HardwareCharacteristics, error) {
+	return mi.hardwareCharacteristics()
+}
+
 func (mi *maas2Instance) displayName() (string, error) {
 	hostname := mi.machine.Hostname()
 	if hostname != "" {
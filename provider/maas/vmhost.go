@@ -0,0 +1,153 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package maas
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gomaasapi/v2"
+	"github.com/juju/utils/v2"
+
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/environs/context"
+	"github.com/juju/juju/provider/common"
+)
+
+// composeWaitTimeout bounds how long we wait for a machine composed on
+// a pod to finish commissioning and become allocatable.
+var composeWaitTimeout = 10 * time.Minute
+
+// VMHosts is part of the environs.VMHostEnviron interface. It returns
+// the names of the pods registered with the MAAS controller.
+func (env *maasEnviron) VMHosts(ctx context.ProviderCallContext) ([]string, error) {
+	pods, err := env.listPods(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	names := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		attrs, err := pod.GetMap()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		name, err := attrs["name"].GetString()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// listPods fetches the pods registered with the MAAS controller. The
+// controller wrapper has no pod support, so the raw API is used.
+func (env *maasEnviron) listPods(ctx context.ProviderCallContext) ([]gomaasapi.JSONObject, error) {
+	client := env.getMAASClient()
+	if client == nil {
+		return nil, errors.NotSupportedf("VM hosts on this MAAS endpoint")
+	}
+	result, err := client.GetSubObject("pods").CallGet("", nil)
+	if err != nil {
+		common.HandleCredentialError(IsAuthorisationFailure, err, ctx)
+		return nil, errors.Trace(err)
+	}
+	return result.GetArray()
+}
+
+// composeMachine asks the pod registered under the supplied name to
+// compose a machine satisfying the constraints, waits for it to finish
+// commissioning, and returns its system ID so it can be acquired the
+// same way as any other node.
+func (env *maasEnviron) composeMachine(ctx context.ProviderCallContext, vmHost string, cons constraints.Value) (string, error) {
+	pods, err := env.listPods(ctx)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	podID := ""
+	for _, pod := range pods {
+		attrs, err := pod.GetMap()
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		name, err := attrs["name"].GetString()
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		if name != vmHost {
+			continue
+		}
+		id, err := attrs["id"].GetFloat64()
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		podID = fmt.Sprintf("%d", int(id))
+		break
+	}
+	if podID == "" {
+		return "", errors.NotFoundf("VM host %q", vmHost)
+	}
+
+	params := url.Values{}
+	if cons.CpuCores != nil {
+		params.Add("cores", fmt.Sprintf("%d", *cons.CpuCores))
+	}
+	if cons.Mem != nil {
+		params.Add("memory", fmt.Sprintf("%d", *cons.Mem))
+	}
+	if cons.Arch != nil {
+		params.Add("architecture", *cons.Arch)
+	}
+	if cons.RootDisk != nil {
+		// The compose API takes storage sizes in GB.
+		params.Add("storage", fmt.Sprintf("root:%d", *cons.RootDisk/1024))
+	}
+	result, err := env.getMAASClient().GetSubObject("pods").GetSubObject(podID).CallPost("compose", params)
+	if err != nil {
+		common.HandleCredentialError(IsAuthorisationFailure, err, ctx)
+		return "", errors.Trace(err)
+	}
+	composed, err := result.GetMap()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	systemID, err := composed["system_id"].GetString()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if err := env.waitForComposedMachine(ctx, systemID); err != nil {
+		return "", errors.Trace(err)
+	}
+	return systemID, nil
+}
+
+// waitForComposedMachine waits for a freshly composed machine to come
+// out of commissioning so that acquiring it by system ID will succeed.
+func (env *maasEnviron) waitForComposedMachine(ctx context.ProviderCallContext, systemID string) error {
+	attempt := utils.AttemptStrategy{
+		Delay: 10 * time.Second,
+		Total: composeWaitTimeout,
+	}
+	for a := attempt.Start(); a.Next(); {
+		machines, err := env.maasController.Machines(gomaasapi.MachinesArgs{
+			SystemIDs: []string{systemID},
+		})
+		if err != nil {
+			common.HandleCredentialError(IsAuthorisationFailure, err, ctx)
+			return errors.Trace(err)
+		}
+		if len(machines) != 1 {
+			return errors.NotFoundf("composed machine %q", systemID)
+		}
+		switch machines[0].StatusName() {
+		case "Ready":
+			return nil
+		case "Failed commissioning":
+			return errors.Errorf("composed machine %q failed commissioning", systemID)
+		}
+	}
+	return errors.Errorf("composed machine %q did not become ready", systemID)
+}
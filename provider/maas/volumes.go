@@ -31,6 +31,11 @@ const (
 	// tagsAttribute is the name of the pool attribute used
 	// to specify tag values for requested volumes.
 	tagsAttribute = "tags"
+
+	// partitionAttribute is the name of the pool attribute used
+	// to indicate that a volume may be satisfied by a partition
+	// rather than requiring a whole physical disk.
+	partitionAttribute = "partition"
 )
 
 // StorageProviderTypes implements storage.ProviderRegistry.
@@ -54,17 +59,20 @@ var storageConfigFields = schema.Fields{
 		schema.List(schema.String()),
 		schema.String(),
 	),
+	partitionAttribute: schema.Bool(),
 }
 
 var storageConfigChecker = schema.FieldMap(
 	storageConfigFields,
 	schema.Defaults{
-		tagsAttribute: schema.Omit,
+		tagsAttribute:      schema.Omit,
+		partitionAttribute: schema.Omit,
 	},
 )
 
 type storageConfig struct {
-	tags []string
+	tags      []string
+	partition bool
 }
 
 func newStorageConfig(attrs map[string]interface{}) (*storageConfig, error) {
@@ -90,7 +98,11 @@ func newStorageConfig(attrs map[string]interface{}) (*storageConfig, error) {
 			tags = append(tags, f)
 		}
 	}
-	return &storageConfig{tags: tags}, nil
+	var partition bool
+	if v, ok := coerced[partitionAttribute].(bool); ok {
+		partition = v
+	}
+	return &storageConfig{tags: tags, partition: partition}, nil
 }
 
 // ValidateConfig is defined on the Provider interface.
@@ -139,6 +151,9 @@ type volumeInfo struct {
 	name     string
 	sizeInGB uint64
 	tags     []string
+	// partition records that the volume may be satisfied by a
+	// partition of a larger device rather than a whole disk.
+	partition bool
 }
 
 // mibToGB converts the value in MiB to GB.
@@ -165,9 +180,10 @@ func buildMAASVolumeParameters(args []storage.VolumeParams, cons constraints.Val
 			return nil, errors.Trace(err)
 		}
 		info := volumeInfo{
-			name:     v.Tag.Id(),
-			sizeInGB: mibToGb(v.Size),
-			tags:     cfg.tags,
+			name:      v.Tag.Id(),
+			sizeInGB:  mibToGb(v.Size),
+			tags:      cfg.tags,
+			partition: cfg.partition,
 		}
 		volumes[i+1] = info
 	}
@@ -218,6 +234,13 @@ func (mi *maas2Instance) volumes(
 		}
 
 		device := devices[0]
+		// Report the matched device so operators can see exactly
+		// which physical disk or partition backs each Juju volume.
+		logger.Infof(
+			"volume %q matched %s %q (size %dMiB, tags %v)",
+			label, device.Type(), device.Path(),
+			device.Size()/humanize.MiByte, device.Tags(),
+		)
 		volumeTag := names.NewVolumeTag(label)
 		vol := storage.Volume{
 			volumeTag,
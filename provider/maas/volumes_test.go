@@ -33,7 +33,7 @@ func (s *volumeSuite) TestBuildMAASVolumeParametersJustRootDisk(c *gc.C) {
 	vInfo, err := buildMAASVolumeParameters(nil, cons)
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(vInfo, jc.DeepEquals, []volumeInfo{
-		{"root", 20, nil},
+		{"root", 20, nil, false},
 	})
 }
 
@@ -43,8 +43,8 @@ func (s *volumeSuite) TestBuildMAASVolumeParametersNoTags(c *gc.C) {
 	}, constraints.Value{})
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(vInfo, jc.DeepEquals, []volumeInfo{
-		{"root", 0, nil}, //root disk
-		{"1", 1954, nil},
+		{"root", 0, nil, false}, //root disk
+		{"1", 1954, nil, false},
 	})
 }
 
@@ -57,8 +57,8 @@ func (s *volumeSuite) TestBuildMAASVolumeParametersWithRootDisk(c *gc.C) {
 	}, cons)
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(vInfo, jc.DeepEquals, []volumeInfo{
-		{"root", 20, nil}, //root disk
-		{"1", 1954, nil},
+		{"root", 20, nil, false}, //root disk
+		{"1", 1954, nil, false},
 	})
 }
 
@@ -68,11 +68,34 @@ func (s *volumeSuite) TestBuildMAASVolumeParametersWithTags(c *gc.C) {
 	}, constraints.Value{})
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(vInfo, jc.DeepEquals, []volumeInfo{
-		{"root", 0, nil}, //root disk
-		{"1", 1954, []string{"tag1", "tag2"}},
+		{"root", 0, nil, false}, //root disk
+		{"1", 1954, []string{"tag1", "tag2"}, false},
 	})
 }
 
+func (s *volumeSuite) TestBuildMAASVolumeParametersWithPartition(c *gc.C) {
+	vInfo, err := buildMAASVolumeParameters([]storage.VolumeParams{
+		{Tag: names.NewVolumeTag("1"), Size: 2000000, Attributes: map[string]interface{}{
+			"tags":      "ssd",
+			"partition": "true",
+		}},
+	}, constraints.Value{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(vInfo, jc.DeepEquals, []volumeInfo{
+		{"root", 0, nil, false}, //root disk
+		{"1", 1954, []string{"ssd"}, true},
+	})
+}
+
+func (s *volumeSuite) TestBuildMAASVolumeParametersInvalidPartition(c *gc.C) {
+	_, err := buildMAASVolumeParameters([]storage.VolumeParams{
+		{Tag: names.NewVolumeTag("1"), Size: 2000000, Attributes: map[string]interface{}{
+			"partition": "not-a-bool",
+		}},
+	}, constraints.Value{})
+	c.Assert(err, gc.ErrorMatches, `validating MAAS storage config: partition: expected bool, got string\("not-a-bool"\)`)
+}
+
 func (s *volumeSuite) TestInstanceVolumesMAAS2(c *gc.C) {
 	instance := maas2Instance{
 		machine: &fakeMachine{},
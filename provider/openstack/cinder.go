@@ -31,6 +31,11 @@ const (
 
 	cinderVolumeType = "volume-type"
 
+	// cinderAvailabilityZone is a storage pool attribute that pins
+	// volumes created from the pool to a specific availability zone,
+	// instead of following the zone of the attached instance.
+	cinderAvailabilityZone = "availability-zone"
+
 	// autoAssignedMountPoint specifies the value to pass in when
 	// you'd like Cinder to automatically assign a mount point.
 	autoAssignedMountPoint = ""
@@ -42,18 +47,21 @@ const (
 )
 
 var cinderConfigFields = schema.Fields{
-	cinderVolumeType: schema.String(),
+	cinderVolumeType:       schema.String(),
+	cinderAvailabilityZone: schema.String(),
 }
 
 var cinderConfigChecker = schema.FieldMap(
 	cinderConfigFields,
 	schema.Defaults{
-		cinderVolumeType: schema.Omit,
+		cinderVolumeType:       schema.Omit,
+		cinderAvailabilityZone: schema.Omit,
 	},
 )
 
 type cinderConfig struct {
-	volumeType string
+	volumeType       string
+	availabilityZone string
 }
 
 func newCinderConfig(attrs map[string]interface{}) (*cinderConfig, error) {
@@ -63,8 +71,10 @@ func newCinderConfig(attrs map[string]interface{}) (*cinderConfig, error) {
 	}
 	coerced := out.(map[string]interface{})
 	volumeType, _ := coerced[cinderVolumeType].(string)
+	availabilityZone, _ := coerced[cinderAvailabilityZone].(string)
 	cinderConfig := &cinderConfig{
-		volumeType: volumeType,
+		volumeType:       volumeType,
+		availabilityZone: availabilityZone,
 	}
 	return cinderConfig, nil
 }
@@ -253,9 +263,14 @@ func (s *cinderVolumeSource) createVolume(
 		metadata = arg.ResourceTags
 	}
 
-	az, err := s.availabilityZoneForVolume(ctx, arg.Tag.Id(), arg.Attachment)
-	if err != nil {
-		return nil, errors.Trace(err)
+	// A zone configured on the storage pool pins the volume; otherwise
+	// follow the availability zone of the attached instance.
+	az := cinderConfig.availabilityZone
+	if az == "" {
+		az, err = s.availabilityZoneForVolume(ctx, arg.Tag.Id(), arg.Attachment)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
 	}
 	cinderVolume, err := s.storageAdapter.CreateVolume(cinder.CreateVolumeVolumeParams{
 		// The Cinder documentation incorrectly states the
@@ -658,9 +673,10 @@ func cinderToJujuVolumeInfos(volumes []cinder.Volume) []storage.VolumeInfo {
 
 func cinderToJujuVolumeInfo(volume *cinder.Volume) storage.VolumeInfo {
 	return storage.VolumeInfo{
-		VolumeId:   volume.ID,
-		Size:       uint64(volume.Size * 1024),
-		Persistent: true,
+		VolumeId:         volume.ID,
+		Size:             uint64(volume.Size * 1024),
+		Persistent:       true,
+		AvailabilityZone: volume.AvailabilityZone,
 	}
 }
 
@@ -355,6 +355,48 @@ func (s *cinderVolumeSourceSuite) TestCreateVolumeVolumeType(c *gc.C) {
 	c.Assert(created, jc.IsTrue)
 }
 
+func (s *cinderVolumeSourceSuite) TestCreateVolumePinnedAvailabilityZone(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var created bool
+	mockAdapter := &mockAdapter{
+		// listAvailabilityZones not implemented; the pinned zone
+		// must be used without consulting the zone list.
+		createVolume: func(args cinder.CreateVolumeVolumeParams) (*cinder.Volume, error) {
+			created = true
+			c.Assert(args, jc.DeepEquals, cinder.CreateVolumeVolumeParams{
+				Size:             1,
+				Name:             "juju-testmodel-volume-123",
+				AvailabilityZone: "az2",
+			})
+			return &cinder.Volume{ID: mockVolId}, nil
+		},
+		getVolume: func(volumeId string) (*cinder.Volume, error) {
+			return &cinder.Volume{
+				ID:               volumeId,
+				Size:             1,
+				Status:           "available",
+				AvailabilityZone: "az2",
+			}, nil
+		},
+	}
+
+	volSource := openstack.NewCinderVolumeSource(mockAdapter, s.env)
+	results, err := volSource.CreateVolumes(s.callCtx, []storage.VolumeParams{{
+		Provider: openstack.CinderProviderType,
+		Tag:      mockVolumeTag,
+		Size:     1024,
+		Attributes: map[string]interface{}{
+			"availability-zone": "az2",
+		},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(created, jc.IsTrue)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, jc.ErrorIsNil)
+	c.Check(results[0].Volume.AvailabilityZone, gc.Equals, "az2")
+}
+
 func (s *cinderVolumeSourceSuite) TestCreateVolumeInvalidCredential(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
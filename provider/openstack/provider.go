@@ -460,11 +460,18 @@ func (inst *openstackInstance) hardwareCharacteristics() *instance.HardwareChara
 	// If the instance was started with a volume block device mapping, select the first
 	// boot disk as the reported RootDisk size.
 	if inst.runOpts != nil {
+		if inst.runOpts.ImageId != "" {
+			hc.ImageID = &inst.runOpts.ImageId
+		}
 		for _, blockDevice := range inst.runOpts.BlockDeviceMappings {
 			if blockDevice.BootIndex == 0 &&
 				blockDevice.DestinationType == rootDiskSourceVolume {
 				rootDiskSize := uint64(blockDevice.VolumeSize * 1024)
 				hc.RootDisk = &rootDiskSize
+				if blockDevice.SourceType == "image" {
+					imageID := blockDevice.UUID
+					hc.ImageID = &imageID
+				}
 				break
 			}
 		}
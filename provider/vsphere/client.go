@@ -24,6 +24,8 @@ type Client interface {
 	ComputeResources(context.Context) ([]vsphereclient.ComputeResource, error)
 	CreateVirtualMachine(context.Context, vsphereclient.CreateVirtualMachineParams) (*mo.VirtualMachine, error)
 	CreateTemplateVM(ctx context.Context, ovaArgs vsphereclient.ImportOVAParameters) (vm *object.VirtualMachine, err error)
+	DatastoreClusterDatastore(ctx context.Context, clusterName string) (*object.Datastore, error)
+	DeployFromContentLibrary(ctx context.Context, args vsphereclient.DeployFromContentLibraryParams) (*object.VirtualMachine, error)
 	Folders(ctx context.Context) (*object.DatacenterFolders, error)
 	Datastores(context.Context) ([]mo.Datastore, error)
 	DeleteDatastoreFile(context.Context, string) error
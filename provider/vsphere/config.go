@@ -17,9 +17,13 @@ const (
 	cfgPrimaryNetwork         = "primary-network"
 	cfgExternalNetwork        = "external-network"
 	cfgDatastore              = "datastore"
+	cfgDatastoreCluster       = "datastore-cluster"
 	cfgForceVMHardwareVersion = "force-vm-hardware-version"
 	cfgEnableDiskUUID         = "enable-disk-uuid"
 	cfgDiskProvisioningType   = "disk-provisioning-type"
+	cfgVMFolder               = "vm-folder"
+	cfgResourcePool           = "resource-pool"
+	cfgContentLibrary         = "content-library"
 )
 
 // configFields is the spec for each vmware config value's type.
@@ -49,6 +53,22 @@ var (
 			Description: "Specify how the disk should be provisioned when cloning the VM template. Allowed values are: thickEagerZero (default), thick and thin.",
 			Type:        environschema.Tstring,
 		},
+		cfgDatastoreCluster: {
+			Description: "The datastore cluster (storage pod) in which to create VMs when neither a datastore nor a root disk source is specified. The member datastore with the most free space is used.",
+			Type:        environschema.Tstring,
+		},
+		cfgVMFolder: {
+			Description: "The folder, relative to the root VM folder of the credential, in which the model's VM folders are created. This allows a shared vCenter to isolate the VMs of each model.",
+			Type:        environschema.Tstring,
+		},
+		cfgResourcePool: {
+			Description: "The resource pool to which the model's machines are restricted. Only the named pool and pools nested under it are presented as availability zones.",
+			Type:        environschema.Tstring,
+		},
+		cfgContentLibrary: {
+			Description: "The name of a vSphere content library to search for an OVF item named after the requested series, before falling back to downloading images from simplestreams.",
+			Type:        environschema.Tstring,
+		},
 	}
 
 	configDefaults = schema.Defaults{
@@ -58,10 +78,16 @@ var (
 		cfgForceVMHardwareVersion: int(0),
 		cfgEnableDiskUUID:         true,
 		cfgDiskProvisioningType:   string(vsphereclient.DiskTypeThick),
+		cfgDatastoreCluster:       "",
+		cfgVMFolder:               "",
+		cfgResourcePool:           "",
+		cfgContentLibrary:         "",
 	}
 
-	configRequiredFields  = []string{}
-	configImmutableFields = []string{}
+	configRequiredFields = []string{}
+	// The VM folder cannot change once the model's VMs have been
+	// created under it.
+	configImmutableFields = []string{cfgVMFolder}
 )
 
 type environConfig struct {
@@ -124,11 +150,31 @@ func (c *environConfig) datastore() string {
 	return ds
 }
 
+func (c *environConfig) datastoreCluster() string {
+	cluster, _ := c.attrs[cfgDatastoreCluster].(string)
+	return cluster
+}
+
 func (c *environConfig) primaryNetwork() string {
 	network, _ := c.attrs[cfgPrimaryNetwork].(string)
 	return network
 }
 
+func (c *environConfig) vmFolder() string {
+	folder, _ := c.attrs[cfgVMFolder].(string)
+	return folder
+}
+
+func (c *environConfig) resourcePool() string {
+	pool, _ := c.attrs[cfgResourcePool].(string)
+	return pool
+}
+
+func (c *environConfig) contentLibrary() string {
+	lib, _ := c.attrs[cfgContentLibrary].(string)
+	return lib
+}
+
 func (c *environConfig) enableDiskUUID() bool {
 	return c.attrs[cfgEnableDiskUUID].(bool)
 }
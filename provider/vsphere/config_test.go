@@ -31,6 +31,10 @@ func fakeConfigAttrs(attrs ...testing.Attrs) testing.Attrs {
 		"enable-disk-uuid":          true,
 		"force-vm-hardware-version": 0,
 		"disk-provisioning-type":    "",
+		"datastore-cluster":         "",
+		"vm-folder":                 "",
+		"resource-pool":             "",
+		"content-library":           "",
 	})
 	for _, attrs := range attrs {
 		merged = merged.Merge(attrs)
@@ -140,6 +144,19 @@ var newConfigTests = []configTestSpec{
 		insert: testing.Attrs{"disk-provisioning-type": "eroneous"},
 		err:    "\"disk-provisioning-type\" must be one of.*",
 	},
+	{
+		info: "can set placement and content library attributes",
+		insert: testing.Attrs{
+			"resource-pool":     "z1/child",
+			"datastore-cluster": "ds-cluster",
+			"content-library":   "juju-images",
+		},
+		expect: testing.Attrs{
+			"resource-pool":     "z1/child",
+			"datastore-cluster": "ds-cluster",
+			"content-library":   "juju-images",
+		},
+	},
 }
 
 func (*ConfigSuite) TestNewModelConfig(c *gc.C) {
@@ -216,6 +233,10 @@ var changeConfigTests = []configTestSpec{{
 	info:   "can insert unknown field",
 	insert: testing.Attrs{"unknown": "ignoti"},
 	expect: testing.Attrs{"unknown": "ignoti"},
+}, {
+	info:   "cannot change vm-folder",
+	insert: testing.Attrs{"vm-folder": "teams/blue"},
+	err:    "vm-folder: cannot change from  to teams/blue",
 }}
 
 func (s *ConfigSuite) TestValidateChange(c *gc.C) {
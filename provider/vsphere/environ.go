@@ -123,9 +123,31 @@ func (env *environ) Create(ctx callcontext.ProviderCallContext, args environs.Cr
 
 // Create implements environs.Environ.
 func (env *sessionEnviron) Create(ctx callcontext.ProviderCallContext, args environs.CreateParams) error {
+	if err := env.validatePlacementConfig(ctx); err != nil {
+		return errors.Trace(err)
+	}
 	return env.ensureVMFolder(args.ControllerUUID, ctx)
 }
 
+// validatePlacementConfig checks that the placement-related model config
+// attributes name objects that actually exist in the vCenter, so that a
+// misconfigured model fails at creation time rather than at the first
+// deploy.
+func (env *sessionEnviron) validatePlacementConfig(ctx callcontext.ProviderCallContext) error {
+	if pool := env.ecfg.resourcePool(); pool != "" {
+		if _, err := env.availZone(ctx, pool); err != nil {
+			return errors.Annotatef(err, "validating %s", cfgResourcePool)
+		}
+	}
+	if cluster := env.ecfg.datastoreCluster(); cluster != "" {
+		if _, err := env.client.DatastoreClusterDatastore(env.ctx, cluster); err != nil {
+			HandleCredentialError(err, env, ctx)
+			return errors.Annotatef(err, "validating %s", cfgDatastoreCluster)
+		}
+	}
+	return nil
+}
+
 // Bootstrap is exported, because it has to be rewritten in external unit tests
 var Bootstrap = common.Bootstrap
 
@@ -234,7 +256,11 @@ func (env *sessionEnviron) DestroyController(ctx callcontext.ProviderCallContext
 }
 
 func (env *sessionEnviron) getVMFolder() string {
-	return env.environ.cloud.Credential.Attributes()[credAttrVMFolder]
+	credFolder := env.environ.cloud.Credential.Attributes()[credAttrVMFolder]
+	// The model's vm-folder config, if set, is nested under the
+	// credential's folder so that a restricted credential still bounds
+	// where the model may create VMs.
+	return path.Join(credFolder, env.ecfg.vmFolder())
 }
 
 func (env *sessionEnviron) accessibleDatastores(ctx callcontext.ProviderCallContext) ([]mo.Datastore, error) {
@@ -89,6 +89,18 @@ func (env *sessionEnviron) AvailabilityZones(ctx context.ProviderCallContext) (n
 		}
 	}
 
+	// If the model is restricted to a resource pool, only that pool and
+	// pools nested under it are usable zones.
+	if rp := env.ecfg.resourcePool(); rp != "" {
+		var filtered network.AvailabilityZones
+		for _, zone := range zones {
+			if zone.Name() == rp || strings.HasPrefix(zone.Name(), rp+"/") {
+				filtered = append(filtered, zone)
+			}
+		}
+		zones = filtered
+	}
+
 	if logger.IsDebugEnabled() {
 		zoneNames := make([]string, len(zones))
 		for i, zone := range zones {
@@ -69,6 +69,41 @@ func (s *environAvailzonesSuite) TestAvailabilityZones(c *gc.C) {
 	c.Assert(zones[5].Name(), gc.Equals, "z2/Other/thing")
 }
 
+func (s *environAvailzonesSuite) TestAvailabilityZonesResourcePoolConfig(c *gc.C) {
+	s.client.folders = makeFolders("/DC/host")
+	s.client.computeResources = []vsphereclient.ComputeResource{
+		{Resource: newComputeResource("z1"), Path: "/DC/host/z1"},
+		{Resource: newComputeResource("z2"), Path: "/DC/host/z2"},
+	}
+	s.client.resourcePools = map[string][]*object.ResourcePool{
+		"/DC/host/z1/...": {
+			makeResourcePool("pool-1", "/DC/host/z1/Resources"),
+		},
+		"/DC/host/z2/...": {
+			makeResourcePool("pool-2", "/DC/host/z2/Resources"),
+			makeResourcePool("pool-3", "/DC/host/z2/Resources/child"),
+			makeResourcePool("pool-4", "/DC/host/z2/Resources/child/nested"),
+			makeResourcePool("pool-5", "/DC/host/z2/Resources/childish"),
+		},
+	}
+
+	cfg, err := s.env.Config().Apply(map[string]interface{}{
+		"resource-pool": "z2/child",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.env.SetConfig(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+
+	zonedEnviron := s.env.(common.ZonedEnviron)
+	zones, err := zonedEnviron.AvailabilityZones(s.callCtx)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(len(zones), gc.Equals, 2)
+	// Only the configured pool and pools nested under it; "z2/childish"
+	// is not included by the prefix match.
+	c.Assert(zones[0].Name(), gc.Equals, "z2/child")
+	c.Assert(zones[1].Name(), gc.Equals, "z2/child/nested")
+}
+
 func (s *environAvailzonesSuite) TestAvailabilityZonesInFolder(c *gc.C) {
 	s.client.folders = makeFolders("/DC/host")
 	s.client.computeResources = []vsphereclient.ComputeResource{
@@ -130,6 +130,20 @@ func (env *sessionEnviron) newRawInstance(
 	if cons.RootDiskSource == nil || *cons.RootDiskSource == "" {
 		cons.RootDiskSource = &defaultDatastore
 	}
+	if *cons.RootDiskSource == "" {
+		// With no datastore specified anywhere else, fall back to the
+		// configured datastore cluster, using the member datastore with
+		// the most free space.
+		if cluster := env.ecfg.datastoreCluster(); cluster != "" {
+			ds, err := env.client.DatastoreClusterDatastore(env.ctx, cluster)
+			if err != nil {
+				HandleCredentialError(err, env, ctx)
+				return nil, nil, common.ZoneIndependentError(err)
+			}
+			dsName := ds.Name()
+			cons.RootDiskSource = &dsName
+		}
+	}
 
 	// Attempt to create a VM in each of the AZs in turn.
 	logger.Debugf("attempting to create VM in availability zone %q", args.AvailabilityZone)
@@ -165,6 +179,7 @@ func (env *sessionEnviron) newRawInstance(
 		azPoolRef:        availZone.pool.Reference(),
 		datastore:        datastore,
 		controllerUUID:   args.ControllerUUID,
+		contentLibrary:   env.ecfg.contentLibrary(),
 		statusUpdateArgs: statusUpdateArgs,
 	}
 
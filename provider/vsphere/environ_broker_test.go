@@ -483,6 +483,37 @@ func (s *legacyEnvironBrokerSuite) TestStartInstanceDatastoreDefault(c *gc.C) {
 	c.Assert(createVMArgs, gc.Equals, "datastore0")
 }
 
+func (s *legacyEnvironBrokerSuite) TestStartInstanceDatastoreCluster(c *gc.C) {
+	cfg := s.env.Config()
+	cfg, err := cfg.Apply(map[string]interface{}{
+		"datastore-cluster": "swimming",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.env.SetConfig(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+
+	ds := object.NewDatastore(nil, types.ManagedObjectReference{
+		Type:  "Datastore",
+		Value: "datastore-1",
+	})
+	ds.InventoryPath = "/DC/datastore/datastore1"
+	s.client.datastoreClusterDatastore = ds
+
+	result, err := s.env.StartInstance(s.callCtx, s.createStartInstanceArgs(c))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(*result.Hardware.RootDiskSource, gc.Equals, "datastore1")
+
+	// The datastore cluster is resolved before the zones are queried.
+	clusterCall := s.client.Calls()[0]
+	c.Assert(clusterCall.FuncName, gc.Equals, "DatastoreClusterDatastore")
+	c.Assert(clusterCall.Args[1], gc.Equals, "swimming")
+
+	call := s.client.Calls()[5]
+	c.Assert(call.FuncName, gc.Equals, "GetTargetDatastore")
+	requestedDatastore := call.Args[2].(string)
+	c.Assert(requestedDatastore, gc.Equals, "datastore1")
+}
+
 func (s *legacyEnvironBrokerSuite) TestStartInstanceRootDiskSource(c *gc.C) {
 	cfg := s.env.Config()
 	cfg, err := cfg.Apply(map[string]interface{}{
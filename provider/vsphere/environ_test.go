@@ -52,6 +52,37 @@ func (s *environSuite) TestBootstrap(c *gc.C) {
 	)
 }
 
+func (s *environSuite) TestCreateValidatesResourcePool(c *gc.C) {
+	s.client.folders = makeFolders("/DC/host")
+	cfg, err := s.env.Config().Apply(map[string]interface{}{
+		"resource-pool": "z1/no-such-pool",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.env.SetConfig(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.env.Create(s.callCtx, environs.CreateParams{
+		ControllerUUID: testing.FakeControllerConfig().ControllerUUID(),
+	})
+	c.Assert(err, gc.ErrorMatches, `validating resource-pool: availability zone "z1/no-such-pool" not found`)
+}
+
+func (s *environSuite) TestCreateValidatesDatastoreCluster(c *gc.C) {
+	s.client.folders = makeFolders("/DC/host")
+	cfg, err := s.env.Config().Apply(map[string]interface{}{
+		"datastore-cluster": "swimming",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.env.SetConfig(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.client.SetErrors(errors.NotFoundf(`datastore cluster "swimming"`))
+	err = s.env.Create(s.callCtx, environs.CreateParams{
+		ControllerUUID: testing.FakeControllerConfig().ControllerUUID(),
+	})
+	c.Assert(err, gc.ErrorMatches, `validating datastore-cluster: datastore cluster "swimming" not found`)
+}
+
 func (s *environSuite) TestDestroy(c *gc.C) {
 	var destroyCalled bool
 	s.PatchValue(&vsphere.DestroyEnv, func(env environs.Environ, callCtx environscontext.ProviderCallContext) error {
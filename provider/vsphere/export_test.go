@@ -20,7 +20,7 @@ func NewVMTemplateManager(
 	azRef types.ManagedObjectReference,
 	datastore *object.Datastore,
 	statusUpdateArgs vsphereclient.StatusUpdateParams,
-	vmFolder, controllerUUID string) vmTemplateManager {
+	vmFolder, controllerUUID, contentLibrary string) vmTemplateManager {
 
 	return vmTemplateManager{
 		imageMetadata:    imgMeta,
@@ -32,5 +32,6 @@ func NewVMTemplateManager(
 
 		vmFolder:       vmFolder,
 		controllerUUID: controllerUUID,
+		contentLibrary: contentLibrary,
 	}
 }
@@ -105,6 +105,11 @@ type Client struct {
 	logger       loggo.Logger
 	clock        clock.Clock
 	acquireMutex func(mutex.Spec) (func(), error)
+
+	// userInfo holds the credentials used to dial the client, so
+	// that sessions with the vSphere REST API can be established
+	// on demand.
+	userInfo *url.Userinfo
 }
 
 // Dial dials a new vSphere client connection using the given URL,
@@ -127,6 +132,7 @@ func Dial(
 		logger:       logger,
 		clock:        clock.WallClock,
 		acquireMutex: acquireMutex,
+		userInfo:     u.User,
 	}, nil
 }
 
@@ -435,6 +441,59 @@ func (c *Client) Datastores(ctx context.Context) ([]mo.Datastore, error) {
 	return datastores, nil
 }
 
+// DatastoreClusterDatastore returns the accessible datastore with the
+// most free space in the named datastore cluster. A NotFound error is
+// returned if no datastore cluster with the given name exists.
+func (c *Client) DatastoreClusterDatastore(ctx context.Context, clusterName string) (*object.Datastore, error) {
+	c.logger.Tracef("DatastoreClusterDatastore() cluster=%q", clusterName)
+	finder, _, err := c.finder(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	pod, err := finder.DatastoreCluster(ctx, clusterName)
+	if err != nil {
+		if _, ok := err.(*find.NotFoundError); ok {
+			return nil, errors.NotFoundf("datastore cluster %q", clusterName)
+		}
+		return nil, errors.Trace(err)
+	}
+
+	items, err := finder.DatastoreList(ctx, path.Join(pod.InventoryPath, "*"))
+	if err != nil {
+		if _, ok := err.(*find.NotFoundError); ok {
+			return nil, errors.Errorf("datastore cluster %q has no datastores", clusterName)
+		}
+		return nil, errors.Trace(err)
+	}
+
+	refs := make([]types.ManagedObjectReference, len(items))
+	byRef := make(map[types.ManagedObjectReference]*object.Datastore, len(items))
+	for i, item := range items {
+		refs[i] = item.Reference()
+		byRef[item.Reference()] = item
+	}
+	var datastores []mo.Datastore
+	if err := c.client.Retrieve(ctx, refs, nil, &datastores); err != nil {
+		return nil, errors.Annotate(err, "retrieving datastore details")
+	}
+
+	var best *mo.Datastore
+	for i, ds := range datastores {
+		if !ds.Summary.Accessible {
+			c.logger.Debugf("datastore %s is inaccessible", ds.Name)
+			continue
+		}
+		if best == nil || ds.Summary.FreeSpace > best.Summary.FreeSpace {
+			best = &datastores[i]
+		}
+	}
+	if best == nil {
+		return nil, errors.Errorf("no accessible datastores in datastore cluster %q", clusterName)
+	}
+	c.logger.Infof("selecting datastore %s from datastore cluster %s", best.Name, clusterName)
+	return byRef[best.Reference()], nil
+}
+
 // ResourcePools returns a list of all of the resource pools (possibly
 // nested) under the given path.
 func (c *Client) ResourcePools(ctx context.Context, path string) ([]*object.ResourcePool, error) {
@@ -0,0 +1,143 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package vsphereclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/juju/errors"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vapi/library"
+	"github.com/vmware/govmomi/vapi/rest"
+	"github.com/vmware/govmomi/vapi/vcenter"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// DeployFromContentLibraryParams holds the information needed to deploy
+// an OVF item from a content library as a VM template.
+type DeployFromContentLibraryParams struct {
+	// LibraryName is the name of the content library holding the item.
+	LibraryName string
+
+	// ItemName is the name of the OVF library item to deploy.
+	ItemName string
+
+	// TemplateName is the name to give the deployed template VM.
+	TemplateName string
+
+	// ResourcePool is the resource pool to deploy into.
+	ResourcePool types.ManagedObjectReference
+
+	// DestinationFolder is the VM folder to deploy into.
+	DestinationFolder *object.Folder
+
+	// Datastore is the datastore to store the template on.
+	Datastore *object.Datastore
+
+	// Arch, if not empty, is the CPU architecture to tag the template
+	// with.
+	Arch string
+
+	// StatusUpdateParams contains parameters associated with the status
+	// update function.
+	StatusUpdateParams StatusUpdateParams
+}
+
+// withRestClient logs in to the vSphere REST API using the credentials
+// the client was dialed with, runs the given function against the
+// resulting session, and logs out again.
+func (c *Client) withRestClient(ctx context.Context, f func(*rest.Client) error) error {
+	rc := rest.NewClient(c.client.Client)
+	if err := rc.Login(ctx, c.userInfo); err != nil {
+		return errors.Annotate(err, "logging in to vSphere REST API")
+	}
+	defer func() { _ = rc.Logout(ctx) }()
+	return f(rc)
+}
+
+// FindContentLibraryItem returns the ID of the named OVF item in the
+// named content library. A NotFound error is returned if the library
+// holds no such item.
+func (c *Client) FindContentLibraryItem(ctx context.Context, libraryName, itemName string) (string, error) {
+	c.logger.Tracef("FindContentLibraryItem() library=%q, item=%q", libraryName, itemName)
+	var itemID string
+	err := c.withRestClient(ctx, func(rc *rest.Client) error {
+		manager := library.NewManager(rc)
+		lib, err := manager.GetLibraryByName(ctx, libraryName)
+		if err != nil {
+			return errors.Annotatef(err, "finding content library %q", libraryName)
+		}
+		ids, err := manager.FindLibraryItems(ctx, library.FindItem{
+			LibraryID: lib.ID,
+			Name:      itemName,
+			Type:      "ovf",
+		})
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if len(ids) == 0 {
+			return errors.NotFoundf("OVF item %q in content library %q", itemName, libraryName)
+		}
+		itemID = ids[0]
+		return nil
+	})
+	return itemID, errors.Trace(err)
+}
+
+// DeployFromContentLibrary deploys the named content library OVF item
+// into the destination folder, marks the resulting VM as a template and
+// returns it.
+func (c *Client) DeployFromContentLibrary(
+	ctx context.Context,
+	args DeployFromContentLibraryParams,
+) (*object.VirtualMachine, error) {
+	itemID, err := c.FindContentLibraryItem(ctx, args.LibraryName, args.ItemName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	args.StatusUpdateParams.UpdateProgress(fmt.Sprintf(
+		"deploying template %q from content library %q", args.ItemName, args.LibraryName))
+	c.logger.Debugf("deploying content library item %q to folder %s", itemID, args.DestinationFolder)
+
+	var ref *types.ManagedObjectReference
+	if err := c.withRestClient(ctx, func(rc *rest.Client) error {
+		deploy := vcenter.Deploy{
+			DeploymentSpec: vcenter.DeploymentSpec{
+				Name:               args.TemplateName,
+				AcceptAllEULA:      true,
+				DefaultDatastoreID: args.Datastore.Reference().Value,
+			},
+			Target: vcenter.Target{
+				ResourcePoolID: args.ResourcePool.Value,
+				FolderID:       args.DestinationFolder.Reference().Value,
+			},
+		}
+		ref, err = vcenter.NewManager(rc).DeployLibraryItem(ctx, itemID, deploy)
+		return errors.Annotatef(err, "deploying content library item %q", args.ItemName)
+	}); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	vm := object.NewVirtualMachine(c.client.Client, *ref)
+	if args.Arch != "" {
+		spec := types.VirtualMachineConfigSpec{
+			ExtraConfig: []types.BaseOptionValue{
+				&types.OptionValue{Key: ArchTag, Value: args.Arch},
+			},
+		}
+		task, err := vm.Reconfigure(ctx, spec)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if _, err := task.WaitForResult(ctx, nil); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	if err := vm.MarkAsTemplate(ctx); err != nil {
+		return nil, errors.Annotate(err, "marking as template")
+	}
+	return vm, nil
+}
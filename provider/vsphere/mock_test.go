@@ -34,15 +34,16 @@ type mockClient struct {
 	mu sync.Mutex
 	testing.Stub
 
-	computeResources        []vsphereclient.ComputeResource
-	resourcePools           map[string][]*object.ResourcePool
-	createdVirtualMachine   *mo.VirtualMachine
-	virtualMachines         []*mo.VirtualMachine
-	virtualMachineTemplates []mockTemplateVM
-	folders                 *object.DatacenterFolders
-	datastores              []mo.Datastore
-	vmFolder                *object.Folder
-	hasPrivilege            bool
+	computeResources          []vsphereclient.ComputeResource
+	resourcePools             map[string][]*object.ResourcePool
+	createdVirtualMachine     *mo.VirtualMachine
+	virtualMachines           []*mo.VirtualMachine
+	virtualMachineTemplates   []mockTemplateVM
+	folders                   *object.DatacenterFolders
+	datastores                []mo.Datastore
+	datastoreClusterDatastore *object.Datastore
+	vmFolder                  *object.Folder
+	hasPrivilege              bool
 }
 
 type mockTemplateVM struct {
@@ -72,6 +73,24 @@ func (c *mockClient) CreateTemplateVM(ctx context.Context, ovaArgs vsphereclient
 	return tpl.vm, c.NextErr()
 }
 
+func (c *mockClient) DatastoreClusterDatastore(ctx context.Context, clusterName string) (*object.Datastore, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.MethodCall(c, "DatastoreClusterDatastore", ctx, clusterName)
+	return c.datastoreClusterDatastore, c.NextErr()
+}
+
+func (c *mockClient) DeployFromContentLibrary(ctx context.Context, args vsphereclient.DeployFromContentLibraryParams) (*object.VirtualMachine, error) {
+	vm := object.NewVirtualMachine(nil, types.ManagedObjectReference{
+		Type:  "VirtualMachine",
+		Value: args.TemplateName,
+	})
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.MethodCall(c, "DeployFromContentLibrary", ctx, args)
+	return vm, c.NextErr()
+}
+
 func (c *mockClient) GetTargetDatastore(ctx context.Context, computeResource *mo.ComputeResource, rootDiskSource string) (*object.Datastore, error) {
 	if rootDiskSource == "" {
 		for _, ds := range c.datastores {
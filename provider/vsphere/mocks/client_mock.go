@@ -97,6 +97,21 @@ func (mr *MockClientMockRecorder) CreateVirtualMachine(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVirtualMachine", reflect.TypeOf((*MockClient)(nil).CreateVirtualMachine), arg0, arg1)
 }
 
+// DatastoreClusterDatastore mocks base method.
+func (m *MockClient) DatastoreClusterDatastore(arg0 context.Context, arg1 string) (*object.Datastore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DatastoreClusterDatastore", arg0, arg1)
+	ret0, _ := ret[0].(*object.Datastore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DatastoreClusterDatastore indicates an expected call of DatastoreClusterDatastore.
+func (mr *MockClientMockRecorder) DatastoreClusterDatastore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DatastoreClusterDatastore", reflect.TypeOf((*MockClient)(nil).DatastoreClusterDatastore), arg0, arg1)
+}
+
 // Datastores mocks base method.
 func (m *MockClient) Datastores(arg0 context.Context) ([]mo.Datastore, error) {
 	m.ctrl.T.Helper()
@@ -126,6 +141,21 @@ func (mr *MockClientMockRecorder) DeleteDatastoreFile(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDatastoreFile", reflect.TypeOf((*MockClient)(nil).DeleteDatastoreFile), arg0, arg1)
 }
 
+// DeployFromContentLibrary mocks base method.
+func (m *MockClient) DeployFromContentLibrary(arg0 context.Context, arg1 vsphereclient.DeployFromContentLibraryParams) (*object.VirtualMachine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployFromContentLibrary", arg0, arg1)
+	ret0, _ := ret[0].(*object.VirtualMachine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployFromContentLibrary indicates an expected call of DeployFromContentLibrary.
+func (mr *MockClientMockRecorder) DeployFromContentLibrary(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployFromContentLibrary", reflect.TypeOf((*MockClient)(nil).DeployFromContentLibrary), arg0, arg1)
+}
+
 // DestroyVMFolder mocks base method.
 func (m *MockClient) DestroyVMFolder(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
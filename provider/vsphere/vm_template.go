@@ -34,6 +34,11 @@ type vmTemplateManager struct {
 
 	vmFolder       string
 	controllerUUID string
+
+	// contentLibrary, if not empty, is the name of a content library to
+	// search for an OVF item named after the series before falling back
+	// to simplestreams.
+	contentLibrary string
 }
 
 // EnsureTemplate will return a virtual machine template for the requested series.
@@ -67,6 +72,18 @@ func (v *vmTemplateManager) EnsureTemplate(ctx context.Context, series string, a
 	if !errors.IsNotFound(err) {
 		return nil, "", errors.Trace(err)
 	}
+	if v.contentLibrary != "" {
+		logger.Debugf("looking for series %q in content library %q", series, v.contentLibrary)
+		tpl, arch, err := v.deployFromContentLibrary(ctx, series, arches)
+		if err == nil {
+			logger.Debugf("using content library template for series %s", series)
+			return tpl, arch, nil
+		}
+		if !errors.IsNotFound(err) {
+			return nil, "", errors.Trace(err)
+		}
+		logger.Debugf("content library has no item for series %q: %s", series, err)
+	}
 	logger.Debugf("downloading and importing template from simplestreams")
 	// Last resort, download and import a template.
 	return v.downloadAndImportTemplate(ctx, series, arches)
@@ -169,6 +186,49 @@ func (v *vmTemplateManager) getImportedTemplate(ctx context.Context, series stri
 	return vmTpl, arch, nil
 }
 
+// deployFromContentLibrary deploys the OVF item named after the series
+// from the configured content library into the series template folder,
+// so that subsequent machines reuse the resulting template.
+func (v *vmTemplateManager) deployFromContentLibrary(
+	ctx context.Context,
+	series string, arches []string,
+) (*object.VirtualMachine, string, error) {
+
+	seriesTemplateFolder := v.seriesTemplateFolder(series)
+	if len(v.vmFolder) > 0 && strings.HasPrefix(seriesTemplateFolder, v.vmFolder) {
+		seriesTemplateFolder = seriesTemplateFolder[len(v.vmFolder)+1:]
+	}
+
+	vmFolder, err := v.client.EnsureVMFolder(
+		ctx, v.vmFolder, seriesTemplateFolder)
+	if err != nil {
+		return nil, "", errors.Trace(err)
+	}
+
+	var arch string
+	if len(arches) > 0 {
+		// Content library items carry no architecture metadata; trust
+		// that the library item was built for the requested
+		// architecture and tag the template accordingly.
+		arch = arches[0]
+	}
+
+	vmTpl, err := v.client.DeployFromContentLibrary(ctx, vsphereclient.DeployFromContentLibraryParams{
+		LibraryName:        v.contentLibrary,
+		ItemName:           series,
+		TemplateName:       "juju-template-" + series,
+		ResourcePool:       v.azPoolRef,
+		DestinationFolder:  vmFolder,
+		Datastore:          v.datastore,
+		Arch:               arch,
+		StatusUpdateParams: v.statusUpdateArgs,
+	})
+	if err != nil {
+		return nil, "", errors.Trace(err)
+	}
+	return vmTpl, arch, nil
+}
+
 func (v *vmTemplateManager) downloadAndImportTemplate(
 	ctx context.Context,
 	series string, arches []string,
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/juju/errors"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/types"
@@ -139,7 +140,7 @@ func (v *vmTemplateSuite) TestEnsureTemplateNoImageMetadataSuppliedButImageExist
 	tplMgr := vsphere.NewVMTemplateManager(
 		nil, v.env, v.client, resPool.Reference(),
 		v.datastore, v.statusUpdateParams, "",
-		coretesting.FakeControllerConfig().ControllerUUID(),
+		coretesting.FakeControllerConfig().ControllerUUID(), "",
 	)
 
 	tpl, arch, err := tplMgr.EnsureTemplate(context.Background(), "trusty", arches)
@@ -157,7 +158,7 @@ func (v *vmTemplateSuite) TestEnsureTemplateNoImageMetadataSuppliedAndImageDoesN
 	tplMgr := vsphere.NewVMTemplateManager(
 		nil, v.env, v.client, resPool.Reference(),
 		v.datastore, v.statusUpdateParams, "",
-		coretesting.FakeControllerConfig().ControllerUUID(),
+		coretesting.FakeControllerConfig().ControllerUUID(), "",
 	)
 
 	_, _, err := tplMgr.EnsureTemplate(context.Background(), "xenial", arches)
@@ -183,7 +184,7 @@ func (v *vmTemplateSuite) TestEnsureTemplateWithImageMetadataSupplied(c *gc.C) {
 	tplMgr := vsphere.NewVMTemplateManager(
 		imgMeta, v.env, v.client, resPool.Reference(),
 		v.datastore, v.statusUpdateParams, "",
-		coretesting.FakeControllerConfig().ControllerUUID(),
+		coretesting.FakeControllerConfig().ControllerUUID(), "",
 	)
 	tpl, arch, err := tplMgr.EnsureTemplate(context.Background(), "trusty", arches)
 	c.Assert(err, jc.ErrorIsNil)
@@ -211,7 +212,7 @@ func (v *vmTemplateSuite) TestEnsureTemplateImageNotFoundLocally(c *gc.C) {
 	tplMgr := vsphere.NewVMTemplateManager(
 		imgMeta, v.env, v.client, resPool.Reference(),
 		v.datastore, v.statusUpdateParams, "",
-		coretesting.FakeControllerConfig().ControllerUUID(),
+		coretesting.FakeControllerConfig().ControllerUUID(), "",
 	)
 	// trusty exists in the image-download simplestreams
 	tpl, arch, err := tplMgr.EnsureTemplate(context.Background(), "trusty", arches)
@@ -232,7 +233,7 @@ func (v *vmTemplateSuite) TestEnsureTemplateImageCachedImage(c *gc.C) {
 	tplMgr := vsphere.NewVMTemplateManager(
 		nil, v.env, v.client, resPool.Reference(),
 		v.datastore, v.statusUpdateParams, "",
-		coretesting.FakeControllerConfig().ControllerUUID(),
+		coretesting.FakeControllerConfig().ControllerUUID(), "",
 	)
 
 	tpl, arch, err := tplMgr.EnsureTemplate(context.Background(), "trusty", arches)
@@ -252,7 +253,7 @@ func (v *vmTemplateSuite) TestEnsureTemplateImageCachedImageNoArch(c *gc.C) {
 	tplMgr := vsphere.NewVMTemplateManager(
 		nil, v.env, v.client, resPool.Reference(),
 		v.datastore, v.statusUpdateParams, "",
-		coretesting.FakeControllerConfig().ControllerUUID(),
+		coretesting.FakeControllerConfig().ControllerUUID(), "",
 	)
 
 	tpl, arch, err := tplMgr.EnsureTemplate(context.Background(), "trusty", arches)
@@ -261,3 +262,52 @@ func (v *vmTemplateSuite) TestEnsureTemplateImageCachedImageNoArch(c *gc.C) {
 	c.Assert(arch, gc.Equals, "")
 	v.client.CheckCallNames(c, "ListVMTemplates", "VirtualMachineObjectToManagedObject")
 }
+
+func (v *vmTemplateSuite) TestEnsureTemplateFromContentLibrary(c *gc.C) {
+	arches := []string{
+		"amd64",
+	}
+	resPool := v.client.resourcePools["/DC/host/z1/..."][0]
+	tplMgr := vsphere.NewVMTemplateManager(
+		nil, v.env, v.client, resPool.Reference(),
+		v.datastore, v.statusUpdateParams, "",
+		coretesting.FakeControllerConfig().ControllerUUID(), "juju-images",
+	)
+
+	tpl, arch, err := tplMgr.EnsureTemplate(context.Background(), "trusty", arches)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(tpl, gc.NotNil)
+	c.Assert(arch, gc.Equals, "amd64")
+	v.client.CheckCallNames(c, "ListVMTemplates", "EnsureVMFolder", "DeployFromContentLibrary")
+
+	call := v.client.Calls()[2]
+	deployArgs := call.Args[1].(vsphereclient.DeployFromContentLibraryParams)
+	c.Assert(deployArgs.LibraryName, gc.Equals, "juju-images")
+	c.Assert(deployArgs.ItemName, gc.Equals, "trusty")
+	c.Assert(deployArgs.TemplateName, gc.Equals, "juju-template-trusty")
+	c.Assert(deployArgs.Arch, gc.Equals, "amd64")
+}
+
+func (v *vmTemplateSuite) TestEnsureTemplateContentLibraryFallsBack(c *gc.C) {
+	arches := []string{
+		"amd64",
+	}
+	resPool := v.client.resourcePools["/DC/host/z1/..."][0]
+	tplMgr := vsphere.NewVMTemplateManager(
+		nil, v.env, v.client, resPool.Reference(),
+		v.datastore, v.statusUpdateParams, "",
+		coretesting.FakeControllerConfig().ControllerUUID(), "juju-images",
+	)
+
+	// The library does not hold an item for the series, so we fall
+	// back to importing from simplestreams.
+	v.client.SetErrors(nil, nil, errors.NotFoundf(`OVF item "trusty"`))
+	tpl, arch, err := tplMgr.EnsureTemplate(context.Background(), "trusty", arches)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(tpl, gc.NotNil)
+	c.Assert(arch, gc.Equals, "amd64")
+	v.client.CheckCallNames(c,
+		"ListVMTemplates", "EnsureVMFolder", "DeployFromContentLibrary",
+		"EnsureVMFolder", "CreateTemplateVM",
+	)
+}
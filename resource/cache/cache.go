@@ -0,0 +1,220 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package cache provides a host-local, fingerprint-addressed cache of
+// resource content. It is shared by all units deployed to the same
+// machine, so that a resource revision fetched by one unit does not
+// have to be downloaded from the controller again by its neighbours.
+package cache
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	charmresource "github.com/juju/charm/v9/resource"
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+)
+
+var logger = loggo.GetLogger("juju.resource.cache")
+
+// DefaultMaxSize is the cache size limit applied when none is
+// specified. Once the cache grows beyond it, the least recently used
+// entries are evicted.
+const DefaultMaxSize int64 = 1024 * 1024 * 1024 // 1GiB
+
+// incomingPrefix is used for temporary files holding content that has
+// not yet been verified; such files are never served from the cache.
+const incomingPrefix = "incoming-"
+
+// Cache is a size-limited cache of resource content on local disk,
+// keyed by the content's fingerprint. Entries are only ever added
+// whole and verified, so a hit is always complete, correct content.
+type Cache struct {
+	dir     string
+	maxSize int64
+
+	fetchMu  sync.Mutex
+	fetching map[string]*sync.Mutex
+}
+
+// New returns a cache rooted at the given directory, evicting least
+// recently used entries once the total content size exceeds maxSize.
+// The directory is created lazily on first write.
+func New(dir string, maxSize int64) *Cache {
+	if maxSize <= 0 {
+		maxSize = DefaultMaxSize
+	}
+	return &Cache{
+		dir:      dir,
+		maxSize:  maxSize,
+		fetching: make(map[string]*sync.Mutex),
+	}
+}
+
+// lockContent takes a lock specific to the given fingerprint and
+// returns the corresponding unlock function. It is used to ensure
+// that concurrent fetches of the same content share one download.
+func (c *Cache) lockContent(fp charmresource.Fingerprint) func() {
+	c.fetchMu.Lock()
+	mutex, ok := c.fetching[fp.String()]
+	if !ok {
+		mutex = &sync.Mutex{}
+		c.fetching[fp.String()] = mutex
+	}
+	c.fetchMu.Unlock()
+	mutex.Lock()
+	return mutex.Unlock
+}
+
+func (c *Cache) path(fp charmresource.Fingerprint) string {
+	return filepath.Join(c.dir, fp.String())
+}
+
+// Open returns a reader for the cached content with the given
+// fingerprint. It returns errors.NotFound if the content is not in
+// the cache. Opening an entry marks it as recently used.
+func (c *Cache) Open(fp charmresource.Fingerprint) (io.ReadCloser, error) {
+	file, err := os.Open(c.path(fp))
+	if os.IsNotExist(err) {
+		return nil, errors.NotFoundf("cached resource with fingerprint %q", fp)
+	}
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	// Freshen the entry's timestamp so eviction is least-recently-used
+	// rather than least-recently-added. Failure here only skews the
+	// eviction order, so it is not fatal.
+	if err := touch(c.path(fp)); err != nil {
+		logger.Debugf("cannot freshen cache entry %q: %v", fp, err)
+	}
+	return file, nil
+}
+
+// Put reads the resource content from the given reader into the
+// cache. The content is verified against the fingerprint before it
+// becomes visible; content that does not match is discarded and an
+// error returned. Adding an entry may evict older ones to keep the
+// cache within its size limit.
+func (c *Cache) Put(fp charmresource.Fingerprint, content io.Reader) error {
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return errors.Trace(err)
+	}
+	tempFile, err := ioutil.TempFile(c.dir, incomingPrefix)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		tempFile.Close()
+		os.Remove(tempFile.Name())
+	}()
+
+	hash := charmresource.NewFingerprintHash()
+	if _, err := io.Copy(tempFile, io.TeeReader(content, hash)); err != nil {
+		return errors.Annotate(err, "could not write resource content to cache")
+	}
+	if err := tempFile.Close(); err != nil {
+		return errors.Trace(err)
+	}
+	if actual := hash.Fingerprint(); actual.String() != fp.String() {
+		return errors.Errorf("resource fingerprint does not match expected (%q != %q)", actual, fp)
+	}
+
+	if err := os.Rename(tempFile.Name(), c.path(fp)); err != nil {
+		return errors.Trace(err)
+	}
+	if err := c.evict(fp); err != nil {
+		// A failed eviction leaves the cache oversized but otherwise
+		// healthy; the next successful Put will try again.
+		logger.Warningf("cannot evict resource cache entries: %v", err)
+	}
+	return nil
+}
+
+// evict removes least recently used entries until the cache fits
+// within its size limit. The entry with the given fingerprint is the
+// one just added and is never evicted, even if it is by itself larger
+// than the limit.
+func (c *Cache) evict(keep charmresource.Fingerprint) error {
+	entries, err := ioutil.ReadDir(c.dir)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var candidates []os.FileInfo
+	var total int64
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), incomingPrefix) {
+			continue
+		}
+		total += entry.Size()
+		if entry.Name() != keep.String() {
+			candidates = append(candidates, entry)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].ModTime().Before(candidates[j].ModTime())
+	})
+	for _, entry := range candidates {
+		if total <= c.maxSize {
+			break
+		}
+		if err := os.Remove(filepath.Join(c.dir, entry.Name())); err != nil {
+			return errors.Trace(err)
+		}
+		logger.Debugf("evicted resource cache entry %q (%d bytes)", entry.Name(), entry.Size())
+		total -= entry.Size()
+	}
+	return nil
+}
+
+// hostCaches holds one Cache per directory so that all units managed
+// by this agent share the same eviction bookkeeping and download
+// deduplication.
+var (
+	hostCachesMu sync.Mutex
+	hostCaches   = make(map[string]*Cache)
+)
+
+// HostCache returns the shared cache for the given directory,
+// creating it on first use. Every caller passing the same directory
+// gets the same instance.
+func HostCache(dir string, maxSize int64) *Cache {
+	hostCachesMu.Lock()
+	defer hostCachesMu.Unlock()
+	if cache, ok := hostCaches[dir]; ok {
+		return cache
+	}
+	cache := New(dir, maxSize)
+	hostCaches[dir] = cache
+	return cache
+}
+
+// HostCacheDir derives the machine-wide resource cache directory from
+// a unit's component data directory (which looks something like
+// /var/lib/juju/agents/unit-spam-1/resource). It returns the empty
+// string if the directory does not have the expected layout, in which
+// case callers should skip caching rather than fail.
+func HostCacheDir(componentDataDir string) string {
+	dir := filepath.Clean(componentDataDir)
+	for {
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		if filepath.Base(parent) == "agents" {
+			return filepath.Join(filepath.Dir(parent), "resources-cache")
+		}
+		dir = parent
+	}
+}
+
+func touch(path string) error {
+	now := time.Now()
+	return os.Chtimes(path, now, now)
+}
@@ -0,0 +1,162 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package cache_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	charmresource "github.com/juju/charm/v9/resource"
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/resource/cache"
+)
+
+type CacheSuite struct {
+	testing.IsolationSuite
+
+	dir string
+}
+
+var _ = gc.Suite(&CacheSuite{})
+
+func (s *CacheSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.dir = c.MkDir()
+}
+
+func fingerprint(c *gc.C, content string) charmresource.Fingerprint {
+	fp, err := charmresource.GenerateFingerprint(strings.NewReader(content))
+	c.Assert(err, jc.ErrorIsNil)
+	return fp
+}
+
+func (s *CacheSuite) TestOpenMissing(c *gc.C) {
+	ch := cache.New(s.dir, 0)
+	_, err := ch.Open(fingerprint(c, "some data"))
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *CacheSuite) TestPutThenOpen(c *gc.C) {
+	ch := cache.New(s.dir, 0)
+	fp := fingerprint(c, "some data")
+
+	err := ch.Put(fp, strings.NewReader("some data"))
+	c.Assert(err, jc.ErrorIsNil)
+
+	reader, err := ch.Open(fp)
+	c.Assert(err, jc.ErrorIsNil)
+	defer reader.Close()
+	data, err := ioutil.ReadAll(reader)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(string(data), gc.Equals, "some data")
+}
+
+func (s *CacheSuite) TestPutFingerprintMismatch(c *gc.C) {
+	ch := cache.New(s.dir, 0)
+	fp := fingerprint(c, "some data")
+
+	err := ch.Put(fp, strings.NewReader("corrupted data"))
+	c.Assert(err, gc.ErrorMatches, `resource fingerprint does not match expected .*`)
+
+	// The bad content must not be visible.
+	_, err = ch.Open(fp)
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+	entries, err := ioutil.ReadDir(s.dir)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(entries, gc.HasLen, 0)
+}
+
+func (s *CacheSuite) TestEvictsLeastRecentlyUsed(c *gc.C) {
+	// Room for two 9-byte entries but not three.
+	ch := cache.New(s.dir, 20)
+
+	contents := []string{"content-1", "content-2", "content-3"}
+	for i, content := range contents[:2] {
+		fp := fingerprint(c, content)
+		err := ch.Put(fp, strings.NewReader(content))
+		c.Assert(err, jc.ErrorIsNil)
+		// Space the timestamps out so the eviction order is
+		// deterministic regardless of filesystem resolution.
+		when := time.Now().Add(time.Duration(i-10) * time.Minute)
+		err = os.Chtimes(filepath.Join(s.dir, fp.String()), when, when)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	err := ch.Put(fingerprint(c, contents[2]), strings.NewReader(contents[2]))
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The oldest entry is gone; the newer two remain.
+	_, err = ch.Open(fingerprint(c, contents[0]))
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+	for _, content := range contents[1:] {
+		reader, err := ch.Open(fingerprint(c, content))
+		c.Assert(err, jc.ErrorIsNil)
+		reader.Close()
+	}
+}
+
+func (s *CacheSuite) TestOpenFreshensEntry(c *gc.C) {
+	ch := cache.New(s.dir, 20)
+
+	contents := []string{"content-1", "content-2"}
+	for i, content := range contents {
+		fp := fingerprint(c, content)
+		err := ch.Put(fp, strings.NewReader(content))
+		c.Assert(err, jc.ErrorIsNil)
+		when := time.Now().Add(time.Duration(i-10) * time.Minute)
+		err = os.Chtimes(filepath.Join(s.dir, fp.String()), when, when)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	// Using the older entry makes it the most recently used, so the
+	// other one is evicted instead.
+	reader, err := ch.Open(fingerprint(c, contents[0]))
+	c.Assert(err, jc.ErrorIsNil)
+	reader.Close()
+
+	err = ch.Put(fingerprint(c, "content-3"), strings.NewReader("content-3"))
+	c.Assert(err, jc.ErrorIsNil)
+
+	reader, err = ch.Open(fingerprint(c, contents[0]))
+	c.Assert(err, jc.ErrorIsNil)
+	reader.Close()
+	_, err = ch.Open(fingerprint(c, contents[1]))
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *CacheSuite) TestNewEntryNeverEvicted(c *gc.C) {
+	ch := cache.New(s.dir, 4)
+	fp := fingerprint(c, "oversized")
+
+	err := ch.Put(fp, strings.NewReader("oversized"))
+	c.Assert(err, jc.ErrorIsNil)
+
+	reader, err := ch.Open(fp)
+	c.Assert(err, jc.ErrorIsNil)
+	reader.Close()
+}
+
+func (s *CacheSuite) TestHostCacheShared(c *gc.C) {
+	first := cache.HostCache(s.dir, 0)
+	second := cache.HostCache(s.dir, 0)
+	c.Assert(first, gc.Equals, second)
+	c.Assert(cache.HostCache(c.MkDir(), 0), gc.Not(gc.Equals), first)
+}
+
+func (s *CacheSuite) TestHostCacheDir(c *gc.C) {
+	dir := cache.HostCacheDir("/var/lib/juju/agents/unit-spam-1/resource")
+	c.Assert(dir, gc.Equals, "/var/lib/juju/resources-cache")
+}
+
+func (s *CacheSuite) TestHostCacheDirUnexpectedLayout(c *gc.C) {
+	c.Assert(cache.HostCacheDir("/tmp/no-such-layout"), gc.Equals, "")
+	c.Assert(cache.HostCacheDir(""), gc.Equals, "")
+}
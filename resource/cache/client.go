@@ -0,0 +1,91 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package cache
+
+import (
+	"io"
+
+	charmresource "github.com/juju/charm/v9/resource"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/resource"
+)
+
+// Client exposes the resource API functionality needed by the caching
+// client. It is satisfied by the uniter's unit facade client.
+type Client interface {
+	// GetResource returns the resource info and content for the given
+	// name (and unit-implied application).
+	GetResource(resourceName string) (resource.Resource, io.ReadCloser, error)
+
+	// GetResourceInfo returns just the resource info for the given
+	// name, without opening the content.
+	GetResourceInfo(resourceName string) (resource.Resource, error)
+}
+
+// CachingClient wraps a resource API client with a host-local cache.
+// Content already in the cache is served from disk without touching
+// the controller; concurrent fetches of the same content share a
+// single download.
+type CachingClient struct {
+	client Client
+	cache  *Cache
+}
+
+// NewCachingClient returns a client that serves resource content from
+// the given cache where possible, falling back to (and populating the
+// cache from) the underlying client.
+func NewCachingClient(client Client, cache *Cache) *CachingClient {
+	return &CachingClient{
+		client: client,
+		cache:  cache,
+	}
+}
+
+// GetResource returns the resource info and content for the given
+// name. It implements the hook context's APIClient interface.
+func (c *CachingClient) GetResource(resourceName string) (resource.Resource, io.ReadCloser, error) {
+	info, err := c.client.GetResourceInfo(resourceName)
+	if err != nil {
+		return resource.Resource{}, nil, errors.Trace(err)
+	}
+	// Container image "content" is registry credentials rather than a
+	// stable blob, and older uploads may lack a fingerprint entirely;
+	// neither can be cached safely.
+	if info.Type == charmresource.TypeContainerImage || info.Fingerprint.IsZero() {
+		return c.client.GetResource(resourceName)
+	}
+
+	// Hold a per-fingerprint lock on the shared cache so that when
+	// several units want the same content at once, only the first
+	// downloads it; the rest wait and then read the cached copy.
+	unlock := c.cache.lockContent(info.Fingerprint)
+	defer unlock()
+
+	reader, err := c.cache.Open(info.Fingerprint)
+	if err == nil {
+		logger.Debugf("resource %q served from host cache", resourceName)
+		return info, reader, nil
+	}
+	if !errors.IsNotFound(err) {
+		logger.Warningf("cannot read resource %q from host cache: %v", resourceName, err)
+	}
+
+	info, remote, err := c.client.GetResource(resourceName)
+	if err != nil {
+		return resource.Resource{}, nil, errors.Trace(err)
+	}
+	defer remote.Close()
+	if err := c.cache.Put(info.Fingerprint, remote); err != nil {
+		// The cache must never make a unit worse off than no cache at
+		// all, so fall back to a direct fetch.
+		logger.Warningf("cannot cache resource %q: %v", resourceName, err)
+		return c.client.GetResource(resourceName)
+	}
+	reader, err = c.cache.Open(info.Fingerprint)
+	if err != nil {
+		return resource.Resource{}, nil, errors.Trace(err)
+	}
+	return info, reader, nil
+}
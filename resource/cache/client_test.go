@@ -0,0 +1,175 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package cache_test
+
+import (
+	"io"
+	"io/ioutil"
+	"strings"
+
+	charmresource "github.com/juju/charm/v9/resource"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/resource"
+	"github.com/juju/juju/resource/cache"
+)
+
+type ClientSuite struct {
+	testing.IsolationSuite
+
+	stub   *testing.Stub
+	client *stubClient
+	cache  *cache.Cache
+}
+
+var _ = gc.Suite(&ClientSuite{})
+
+func (s *ClientSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.stub = &testing.Stub{}
+	s.client = &stubClient{stub: s.stub}
+	s.cache = cache.New(c.MkDir(), 0)
+}
+
+func (s *ClientSuite) newResource(c *gc.C, name, content string) resource.Resource {
+	res := resource.Resource{
+		Resource: charmresource.Resource{
+			Meta: charmresource.Meta{
+				Name: name,
+				Type: charmresource.TypeFile,
+				Path: name + ".tgz",
+			},
+			Origin:      charmresource.OriginStore,
+			Revision:    1,
+			Fingerprint: fingerprint(c, content),
+			Size:        int64(len(content)),
+		},
+		ID:            "a-application/" + name,
+		ApplicationID: "a-application",
+	}
+	return res
+}
+
+func (s *ClientSuite) TestGetResourcePopulatesCache(c *gc.C) {
+	s.client.info = s.newResource(c, "spam", "some data")
+	s.client.content = "some data"
+	client := cache.NewCachingClient(s.client, s.cache)
+
+	info, reader, err := client.GetResource("spam")
+	c.Assert(err, jc.ErrorIsNil)
+	defer reader.Close()
+
+	c.Check(info, jc.DeepEquals, s.client.info)
+	data, err := ioutil.ReadAll(reader)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(data), gc.Equals, "some data")
+	s.stub.CheckCallNames(c, "GetResourceInfo", "GetResource")
+
+	// The content is now cached for the next fetch.
+	cached, err := s.cache.Open(s.client.info.Fingerprint)
+	c.Assert(err, jc.ErrorIsNil)
+	cached.Close()
+}
+
+func (s *ClientSuite) TestGetResourceServedFromCache(c *gc.C) {
+	s.client.info = s.newResource(c, "spam", "some data")
+	s.client.content = "some data"
+	client := cache.NewCachingClient(s.client, s.cache)
+
+	reader, err := s.openAndRead(client, "spam")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(reader, gc.Equals, "some data")
+	s.stub.ResetCalls()
+
+	// The second fetch never opens the content on the controller.
+	reader, err = s.openAndRead(client, "spam")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(reader, gc.Equals, "some data")
+	s.stub.CheckCallNames(c, "GetResourceInfo")
+}
+
+func (s *ClientSuite) TestCacheSharedBetweenClients(c *gc.C) {
+	s.client.info = s.newResource(c, "spam", "some data")
+	s.client.content = "some data"
+
+	// Two clients (think: two units on one machine) sharing one cache
+	// result in a single content download.
+	first := cache.NewCachingClient(s.client, s.cache)
+	second := cache.NewCachingClient(s.client, s.cache)
+
+	_, err := s.openAndRead(first, "spam")
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.openAndRead(second, "spam")
+	c.Assert(err, jc.ErrorIsNil)
+	s.stub.CheckCallNames(c, "GetResourceInfo", "GetResource", "GetResourceInfo")
+}
+
+func (s *ClientSuite) TestContainerImageBypassesCache(c *gc.C) {
+	info := s.newResource(c, "image", "registry credentials")
+	info.Type = charmresource.TypeContainerImage
+	s.client.info = info
+	s.client.content = "registry credentials"
+	client := cache.NewCachingClient(s.client, s.cache)
+
+	_, err := s.openAndRead(client, "image")
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.openAndRead(client, "image")
+	c.Assert(err, jc.ErrorIsNil)
+	s.stub.CheckCallNames(c,
+		"GetResourceInfo", "GetResource",
+		"GetResourceInfo", "GetResource",
+	)
+}
+
+func (s *ClientSuite) TestCorruptDownloadNotCached(c *gc.C) {
+	s.client.info = s.newResource(c, "spam", "some data")
+	s.client.content = "corrupted data"
+	client := cache.NewCachingClient(s.client, s.cache)
+
+	// The direct fallback still returns the (corrupt) content; it is
+	// the unit-side verification that ultimately rejects it. The cache
+	// must not keep it.
+	_, err := s.openAndRead(client, "spam")
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.cache.Open(s.client.info.Fingerprint)
+	c.Assert(err, gc.NotNil)
+}
+
+func (s *ClientSuite) openAndRead(client *cache.CachingClient, name string) (string, error) {
+	_, reader, err := client.GetResource(name)
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// stubClient implements cache.Client for testing.
+type stubClient struct {
+	stub    *testing.Stub
+	info    resource.Resource
+	content string
+}
+
+func (s *stubClient) GetResource(resourceName string) (resource.Resource, io.ReadCloser, error) {
+	s.stub.AddCall("GetResource", resourceName)
+	if err := s.stub.NextErr(); err != nil {
+		return resource.Resource{}, nil, err
+	}
+	return s.info, ioutil.NopCloser(strings.NewReader(s.content)), nil
+}
+
+func (s *stubClient) GetResourceInfo(resourceName string) (resource.Resource, error) {
+	s.stub.AddCall("GetResourceInfo", resourceName)
+	if err := s.stub.NextErr(); err != nil {
+		return resource.Resource{}, err
+	}
+	return s.info, nil
+}
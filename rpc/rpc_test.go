@@ -1219,6 +1219,42 @@ func (*rpcSuite) TestConnectionContextCloseServer(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, "context canceled")
 }
 
+func (*rpcSuite) TestCancelRequest(c *gc.C) {
+	root := &Root{}
+	root.contextInst = &ContextMethods{
+		root:    root,
+		waiting: make(chan struct{}),
+	}
+
+	client, server, srvDone, serverNotifier := newRPCClientServer(c, root, nil, false)
+	defer closeClient(c, client, srvDone)
+
+	errch := make(chan error, 1)
+	go func() {
+		errch <- client.Call(rpc.Request{"ContextMethods", 0, "", "Wait"}, nil, nil)
+	}()
+
+	<-root.contextInst.waiting
+	serverNotifier.mu.Lock()
+	c.Assert(serverNotifier.serverRequests, gc.HasLen, 1)
+	requestId := serverNotifier.serverRequests[0].hdr.RequestId
+	serverNotifier.mu.Unlock()
+
+	// Cancelling the in-flight request interrupts its method without
+	// affecting the connection.
+	c.Assert(server.CancelRequest(requestId), jc.IsTrue)
+	err := <-errch
+	c.Assert(err, gc.ErrorMatches, "context canceled")
+
+	// Once the request has finished running it can no longer be found.
+	for a := testing.LongAttempt.Start(); a.Next(); {
+		if !server.CancelRequest(requestId) {
+			break
+		}
+	}
+	c.Assert(server.CancelRequest(requestId), jc.IsFalse)
+}
+
 func (s *rpcSuite) TestRecorderErrorPreventsRequest(c *gc.C) {
 	root := &Root{
 		simple: make(map[string]*SimpleMethods),
@@ -141,6 +141,10 @@ type Conn struct {
 	// clientPending holds all pending client requests.
 	clientPending map[uint64]*Call
 
+	// srvInFlight holds the cancellation functions for the server
+	// requests currently being run, keyed by client request id.
+	srvInFlight map[uint64]context.CancelFunc
+
 	// closing is set when the connection is shutting down via
 	// Close.  When this is set, no more client or server requests
 	// will be initiated.
@@ -173,6 +177,7 @@ func NewConn(codec Codec, factory RecorderFactory) *Conn {
 	return &Conn{
 		codec:           codec,
 		clientPending:   make(map[uint64]*Call),
+		srvInFlight:     make(map[uint64]context.CancelFunc),
 		recorderFactory: ensureFactory(factory),
 	}
 }
@@ -561,12 +566,13 @@ func (conn *Conn) runRequest(
 	}()
 	defer conn.srvPending.Done()
 
-	// Create a request-specific context, cancelled when the
-	// request returns.
-	//
-	// TODO(axw) provide a means for clients to cancel a request.
+	// Create a request-specific context, cancelled when the request
+	// returns, when the connection is closed, or when CancelRequest
+	// is called for the request.
 	ctx, cancel := context.WithCancel(conn.context)
 	defer cancel()
+	conn.startRequest(req.hdr.RequestId, cancel)
+	defer conn.endRequest(req.hdr.RequestId)
 
 	rv, err := req.Call(ctx, req.hdr.Request.Id, arg)
 	if err != nil {
@@ -602,6 +608,36 @@ func (conn *Conn) runRequest(
 	}
 }
 
+// startRequest records the cancellation function for a server
+// request that has begun running.
+func (conn *Conn) startRequest(requestId uint64, cancel context.CancelFunc) {
+	conn.mutex.Lock()
+	defer conn.mutex.Unlock()
+	conn.srvInFlight[requestId] = cancel
+}
+
+// endRequest discards the cancellation function for a server request
+// that has finished running.
+func (conn *Conn) endRequest(requestId uint64) {
+	conn.mutex.Lock()
+	defer conn.mutex.Unlock()
+	delete(conn.srvInFlight, requestId)
+}
+
+// CancelRequest cancels the context of the identified server request
+// if it is still being run, reporting whether the request was found.
+// The request's method will observe the cancellation if it honours
+// its context; the connection itself is unaffected.
+func (conn *Conn) CancelRequest(requestId uint64) bool {
+	conn.mutex.Lock()
+	cancel, ok := conn.srvInFlight[requestId]
+	conn.mutex.Unlock()
+	if ok {
+		cancel()
+	}
+	return ok
+}
+
 type serverError struct {
 	error
 }
@@ -0,0 +1,139 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2"
+	"github.com/juju/mgo/v2/bson"
+	"github.com/juju/mgo/v2/txn"
+	"github.com/juju/names/v4"
+)
+
+// agentHealthDoc records the most recent self-reported worker health
+// for an agent. There is at most one document per agent, keyed on the
+// agent entity's global key.
+type agentHealthDoc struct {
+	DocID     string            `bson:"_id"`
+	ModelUUID string            `bson:"model-uuid"`
+	Updated   int64             `bson:"updated"`
+	Workers   []workerHealthDoc `bson:"workers"`
+}
+
+type workerHealthDoc struct {
+	Name       string `bson:"name"`
+	State      string `bson:"state"`
+	Error      string `bson:"error,omitempty"`
+	StartCount int    `bson:"start-count"`
+}
+
+// WorkerHealth describes the state of a single worker in an agent's
+// dependency engine, as reported by the agent itself.
+type WorkerHealth struct {
+	Name       string
+	State      string
+	Error      string
+	StartCount int
+}
+
+// AgentHealth holds an agent's self-reported worker health along with
+// the time the agent reported it.
+type AgentHealth struct {
+	Updated time.Time
+	Workers []WorkerHealth
+}
+
+func agentHealthGlobalKey(tag names.Tag) (string, error) {
+	switch tag := tag.(type) {
+	case names.MachineTag:
+		return machineGlobalKey(tag.Id()), nil
+	case names.UnitTag:
+		return unitGlobalKey(tag.Id()), nil
+	}
+	return "", errors.NotSupportedf("agent health for %q", tag)
+}
+
+// SetAgentHealth replaces the recorded worker health report for the
+// given agent with the one supplied.
+func (st *State) SetAgentHealth(tag names.Tag, workers []WorkerHealth) error {
+	key, err := agentHealthGlobalKey(tag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	workerDocs := make([]workerHealthDoc, len(workers))
+	for i, w := range workers {
+		workerDocs[i] = workerHealthDoc(w)
+	}
+	updated := st.clock().Now().UnixNano()
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		_, found, err := st.agentHealth(key)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if !found {
+			return []txn.Op{{
+				C:      agentHealthC,
+				Id:     st.docID(key),
+				Assert: txn.DocMissing,
+				Insert: &agentHealthDoc{
+					ModelUUID: st.ModelUUID(),
+					Updated:   updated,
+					Workers:   workerDocs,
+				},
+			}}, nil
+		}
+		return []txn.Op{{
+			C:      agentHealthC,
+			Id:     st.docID(key),
+			Assert: txn.DocExists,
+			Update: bson.D{{"$set", bson.D{
+				{"updated", updated},
+				{"workers", workerDocs},
+			}}},
+		}}, nil
+	}
+	return errors.Annotatef(st.db().Run(buildTxn), "cannot set agent health for %q", tag)
+}
+
+// AgentHealth returns the most recent worker health report for the
+// given agent. A not found error is returned if the agent has never
+// reported.
+func (st *State) AgentHealth(tag names.Tag) (AgentHealth, error) {
+	key, err := agentHealthGlobalKey(tag)
+	if err != nil {
+		return AgentHealth{}, errors.Trace(err)
+	}
+	doc, found, err := st.agentHealth(key)
+	if err != nil {
+		return AgentHealth{}, errors.Trace(err)
+	}
+	if !found {
+		return AgentHealth{}, errors.NotFoundf("agent health for %q", tag)
+	}
+	workers := make([]WorkerHealth, len(doc.Workers))
+	for i, w := range doc.Workers {
+		workers[i] = WorkerHealth(w)
+	}
+	return AgentHealth{
+		Updated: time.Unix(0, doc.Updated).UTC(),
+		Workers: workers,
+	}, nil
+}
+
+func (st *State) agentHealth(key string) (agentHealthDoc, bool, error) {
+	coll, closer := st.db().GetCollection(agentHealthC)
+	defer closer()
+
+	var doc agentHealthDoc
+	err := coll.FindId(key).One(&doc)
+	if err == mgo.ErrNotFound {
+		return agentHealthDoc{}, false, nil
+	}
+	if err != nil {
+		return agentHealthDoc{}, false, errors.Trace(err)
+	}
+	return doc, true, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state_test
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/state"
+)
+
+type AgentHealthSuite struct {
+	ConnSuite
+}
+
+var _ = gc.Suite(&AgentHealthSuite{})
+
+func (s *AgentHealthSuite) TestAgentHealthNotReported(c *gc.C) {
+	machine := s.Factory.MakeMachine(c, nil)
+	_, err := s.State.AgentHealth(machine.Tag())
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *AgentHealthSuite) TestSetAgentHealth(c *gc.C) {
+	machine := s.Factory.MakeMachine(c, nil)
+	workers := []state.WorkerHealth{{
+		Name:       "machiner",
+		State:      "started",
+		StartCount: 1,
+	}, {
+		Name:       "upgrader",
+		State:      "stopped",
+		Error:      "boom",
+		StartCount: 3,
+	}}
+	err := s.State.SetAgentHealth(machine.Tag(), workers)
+	c.Assert(err, jc.ErrorIsNil)
+
+	health, err := s.State.AgentHealth(machine.Tag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(health.Workers, jc.DeepEquals, workers)
+	c.Assert(health.Updated.IsZero(), jc.IsFalse)
+}
+
+func (s *AgentHealthSuite) TestSetAgentHealthReplaces(c *gc.C) {
+	machine := s.Factory.MakeMachine(c, nil)
+	err := s.State.SetAgentHealth(machine.Tag(), []state.WorkerHealth{{
+		Name:  "machiner",
+		State: "starting",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.State.SetAgentHealth(machine.Tag(), []state.WorkerHealth{{
+		Name:       "machiner",
+		State:      "started",
+		StartCount: 1,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+
+	health, err := s.State.AgentHealth(machine.Tag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(health.Workers, jc.DeepEquals, []state.WorkerHealth{{
+		Name:       "machiner",
+		State:      "started",
+		StartCount: 1,
+	}})
+}
+
+func (s *AgentHealthSuite) TestSetAgentHealthUnit(c *gc.C) {
+	unit := s.Factory.MakeUnit(c, nil)
+	err := s.State.SetAgentHealth(unit.Tag(), []state.WorkerHealth{{
+		Name:  "uniter",
+		State: "started",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+
+	health, err := s.State.AgentHealth(unit.Tag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(health.Workers, gc.HasLen, 1)
+}
+
+func (s *AgentHealthSuite) TestAgentHealthUnsupportedEntity(c *gc.C) {
+	err := s.State.SetAgentHealth(names.NewApplicationTag("mysql"), nil)
+	c.Assert(err, gc.ErrorMatches, `agent health for "application-mysql" not supported`)
+}
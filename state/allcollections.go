@@ -8,30 +8,31 @@ import (
 
 	"github.com/juju/juju/state/bakerystorage"
 	"github.com/juju/juju/state/cloudimagemetadata"
+	"github.com/juju/juju/state/docstore"
 )
 
 // allCollections should be the single source of truth for information about
 // any collection we use. It's broken up into 4 main sections:
 //
-//  * infrastructure: we really don't have any business touching these once
-//    we've created them. They should have the rawAccess attribute set, so that
-//    multiModelRunner will consider them forbidden.
+//   - infrastructure: we really don't have any business touching these once
+//     we've created them. They should have the rawAccess attribute set, so that
+//     multiModelRunner will consider them forbidden.
 //
-//  * global: these hold information external to models. They may include
-//    model metadata, or references; but they're generally not relevant
-//    from the perspective of a given model.
+//   - global: these hold information external to models. They may include
+//     model metadata, or references; but they're generally not relevant
+//     from the perspective of a given model.
 //
-//  * local (in opposition to global; and for want of a better term): these
-//    hold information relevant *within* specific models (machines,
-//    applications, relations, settings, bookkeeping, etc) and should generally be
-//    read via an modelStateCollection, and written via a multiModelRunner. This is
-//    the most common form of collection, and the above access should usually
-//    be automatic via Database.Collection and Database.Runner.
+//   - local (in opposition to global; and for want of a better term): these
+//     hold information relevant *within* specific models (machines,
+//     applications, relations, settings, bookkeeping, etc) and should generally be
+//     read via an modelStateCollection, and written via a multiModelRunner. This is
+//     the most common form of collection, and the above access should usually
+//     be automatic via Database.Collection and Database.Runner.
 //
-//  * raw-access: there's certainly data that's a poor fit for mgo/txn. Most
-//    forms of logs, for example, will benefit both from the speedy insert and
-//    worry-free bulk deletion; so raw-access collections are fine. Just don't
-//    try to run transactions that reference them.
+//   - raw-access: there's certainly data that's a poor fit for mgo/txn. Most
+//     forms of logs, for example, will benefit both from the speedy insert and
+//     worry-free bulk deletion; so raw-access collections are fine. Just don't
+//     try to run transactions that reference them.
 //
 // Please do not use collections not referenced here; and when adding new
 // collections, please document them, and make an effort to put them in an
@@ -127,6 +128,11 @@ func allCollections() CollectionSchema {
 			global: true,
 		},
 
+		// This collection holds service accounts and their tokens.
+		serviceAccountsC: {
+			global: true,
+		},
+
 		// This collection holds users that are relative to controllers.
 		controllerUsersC: {
 			global: true,
@@ -159,6 +165,10 @@ func allCollections() CollectionSchema {
 		// are inherited and then forked by new models.
 		globalSettingsC: {global: true},
 
+		// This collection holds named model templates that capture
+		// model config and constraints applied when creating models.
+		modelTemplatesC: {global: true},
+
 		// This collection holds workload metrics reported by certain charms
 		// for passing onward to other tools.
 		metricsC: {
@@ -208,6 +218,15 @@ func allCollections() CollectionSchema {
 			}},
 		},
 
+		// This collection mirrors the lease holder records as opaque
+		// blobs written through the state/docstore package, keeping
+		// the configured storage backend current. The raw collection
+		// above stays authoritative for transaction assertions.
+		leaseHolderBlobsC: {
+			global:    true,
+			rawAccess: true,
+		},
+
 		// This collection holds the last time the model user connected
 		// to the model.
 		modelUserLastConnectionC: {
@@ -273,6 +292,14 @@ func allCollections() CollectionSchema {
 		// meterStatusC is the collection used to store meter status information.
 		meterStatusC: {},
 
+		// modelFeatureFlagsC records the feature flags enabled on
+		// each model.
+		modelFeatureFlagsC: {},
+
+		// agentHealthC records the most recent self-reported worker
+		// health for each agent.
+		agentHealthC: {},
+
 		// These collections hold reference counts which are used
 		// by the nsRefcounts struct.
 		refcountsC: {}, // Per model.
@@ -327,6 +354,23 @@ func allCollections() CollectionSchema {
 			}},
 		},
 
+		// This collection holds plans for upgrading the machines of an
+		// application in batches.
+		upgradeSeriesPlansC: {},
+
+		// This collection holds scheduled maintenance windows for
+		// machines and models, during which non-urgent automatic
+		// operations are deferred.
+		maintenanceWindowsC: {},
+
+		// This collection holds the names of agent workers that have
+		// been administratively disabled for machines and models.
+		disabledWorkersC: {},
+
+		// This collection tracks in-progress and finished unit move
+		// operations, which reassign a unit to another machine.
+		unitMovesC: {},
+
 		// -----
 
 		// These collections hold information associated with storage.
@@ -387,6 +431,11 @@ func allCollections() CollectionSchema {
 		},
 		endpointBindingsC: {},
 		openedPortsC:      {},
+		unitSubnetsC: {
+			indexes: []mgo.Index{{
+				Key: []string{"model-uuid", "machine-id"},
+			}},
+		},
 
 		// -----
 
@@ -399,6 +448,11 @@ func allCollections() CollectionSchema {
 			}},
 		},
 		actionNotificationsC: {},
+		customEventsC: {
+			indexes: []mgo.Index{{
+				Key: []string{"model-uuid", "receiver"},
+			}},
+		},
 		operationsC: {
 			indexes: []mgo.Index{{
 				Key: []string{"model-uuid", "_id"},
@@ -458,6 +512,12 @@ func allCollections() CollectionSchema {
 				Key: []string{"model-uuid", "_id"},
 			}},
 		},
+		configHistoryC: {
+			rawAccess: true,
+			indexes: []mgo.Index{{
+				Key: []string{"model-uuid", "application", "-revision"},
+			}},
+		},
 		statusesHistoryC: {
 			rawAccess: true,
 			indexes: []mgo.Index{{
@@ -508,6 +568,10 @@ func allCollections() CollectionSchema {
 		// firewallRulesC holds firewall rules for defined service types.
 		firewallRulesC: {},
 
+		// charmSyncC holds the overlay of charm files live-synced onto
+		// applications deployed from a local path.
+		charmSyncC: {},
+
 		// podSpecsC holds the CAAS pod specifications,
 		// for applications.
 		podSpecsC: {},
@@ -527,8 +591,12 @@ func allCollections() CollectionSchema {
 			}},
 		},
 
+		// Secret values are opaque blobs written through the
+		// state/docstore package rather than under txn control,
+		// so the collection needs raw access.
 		secretValuesC: {
-			global: true,
+			global:    true,
+			rawAccess: true,
 		},
 
 		secretRotateC: {
@@ -555,6 +623,7 @@ func allCollections() CollectionSchema {
 // inspection.
 const (
 	actionNotificationsC       = "actionnotifications"
+	customEventsC              = "customEvents"
 	actionresultsC             = "actionresults"
 	actionsC                   = "actions"
 	annotationsC               = "annotations"
@@ -575,16 +644,20 @@ const (
 	controllersC               = "controllers"
 	controllerNodesC           = "controllerNodes"
 	controllerUsersC           = "controllerusers"
+	disabledWorkersC           = "disabledWorkers"
 	dockerResourcesC           = "dockerResources"
 	filesystemAttachmentsC     = "filesystemAttachments"
 	filesystemsC               = "filesystems"
 	globalClockC               = "globalclock"
 	globalRefcountsC           = "globalRefcounts"
 	globalSettingsC            = "globalSettings"
+	modelTemplatesC            = "modelTemplates"
 	instanceDataC              = "instanceData"
 	leaseHoldersC              = "leaseholders"
+	leaseHolderBlobsC          = docstore.LeaseHolders
 	machinesC                  = "machines"
 	machineRemovalsC           = "machineremovals"
+	maintenanceWindowsC        = "maintenanceWindows"
 	machineUpgradeSeriesLocksC = "machineUpgradeSeriesLocks"
 	meterStatusC               = "meterStatus"
 	metricsC                   = "metrics"
@@ -598,6 +671,8 @@ const (
 	modelUsersC                = "modelusers"
 	modelsC                    = "models"
 	modelEntityRefsC           = "modelEntityRefs"
+	modelFeatureFlagsC         = "modelFeatureFlags"
+	agentHealthC               = "agentHealth"
 	openedPortsC               = "openedPorts"
 	operationsC                = "operations"
 	payloadsC                  = "payloads"
@@ -618,6 +693,7 @@ const (
 	spacesC                    = "spaces"
 	statusesC                  = "statuses"
 	statusesHistoryC           = "statuseshistory"
+	configHistoryC             = "configHistory"
 	storageAttachmentsC        = "storageattachments"
 	storageConstraintsC        = "storageconstraints"
 	deviceConstraintsC         = "deviceConstraints"
@@ -628,11 +704,15 @@ const (
 	toolsmetadataC             = "toolsmetadata"
 	txnLogC                    = "txns.log"
 	txnsC                      = "txns"
+	unitMovesC                 = "unitMoves"
 	unitsC                     = "units"
 	unitStatesC                = "unitstates"
+	unitSubnetsC               = "unitSubnets"
 	upgradeInfoC               = "upgradeInfo"
+	upgradeSeriesPlansC        = "upgradeSeriesPlans"
 	userLastLoginC             = "userLastLogin"
 	usermodelnameC             = "usermodelname"
+	serviceAccountsC           = "serviceAccounts"
 	usersC                     = "users"
 	volumeAttachmentsC         = "volumeattachments"
 	volumeAttachmentPlanC      = "volumeattachmentplan"
@@ -648,9 +728,10 @@ const (
 	externalControllersC = "externalControllers"
 	relationNetworksC    = "relationNetworks"
 	firewallRulesC       = "firewallRules"
+	charmSyncC           = "charmSync"
 
 	// Secrets
 	secretMetadataC = "secretMetadata"
-	secretValuesC   = "secretValues"
+	secretValuesC   = docstore.SecretValues
 	secretRotateC   = "secretRotate"
 )
@@ -84,18 +84,22 @@ type applicationDoc struct {
 	// be relatively straight forward, but very time consuming.
 	// When moving to CharmHub or removing CharmStore from Juju it should be
 	// tackled then.
-	CharmURL             *charm.URL   `bson:"charmurl"`
-	Channel              string       `bson:"cs-channel"`
-	CharmOrigin          *CharmOrigin `bson:"charm-origin"`
-	CharmModifiedVersion int          `bson:"charmmodifiedversion"`
-	ForceCharm           bool         `bson:"forcecharm"`
-	Life                 Life         `bson:"life"`
-	UnitCount            int          `bson:"unitcount"`
-	RelationCount        int          `bson:"relationcount"`
-	MinUnits             int          `bson:"minunits"`
-	Tools                *tools.Tools `bson:",omitempty"`
-	TxnRevno             int64        `bson:"txn-revno"`
-	MetricCredentials    []byte       `bson:"metric-credentials"`
+	CharmURL    *charm.URL   `bson:"charmurl"`
+	Channel     string       `bson:"cs-channel"`
+	CharmOrigin *CharmOrigin `bson:"charm-origin"`
+	// CharmSupportedBases mirrors the bases from the charm's manifest
+	// so upgrade-series prechecks can check compatibility without
+	// re-reading the charm archive. It is refreshed on SetCharm.
+	CharmSupportedBases  []SupportedBase `bson:"charm-supported-bases,omitempty"`
+	CharmModifiedVersion int             `bson:"charmmodifiedversion"`
+	ForceCharm           bool            `bson:"forcecharm"`
+	Life                 Life            `bson:"life"`
+	UnitCount            int             `bson:"unitcount"`
+	RelationCount        int             `bson:"relationcount"`
+	MinUnits             int             `bson:"minunits"`
+	Tools                *tools.Tools    `bson:",omitempty"`
+	TxnRevno             int64           `bson:"txn-revno"`
+	MetricCredentials    []byte          `bson:"metric-credentials"`
 
 	// Exposed is set to true when the application is exposed.
 	Exposed bool `bson:"exposed"`
@@ -172,6 +176,16 @@ func (a *Application) charmConfigKey() string {
 	return applicationCharmConfigKey(a.doc.Name, a.doc.CharmURL)
 }
 
+func applicationStagedConfigKey(appName string) string {
+	return fmt.Sprintf("a#%s#staged", appName)
+}
+
+// stagedConfigKey returns the settings collection key for the
+// application's staged charm config changeset.
+func (a *Application) stagedConfigKey() string {
+	return applicationStagedConfigKey(a.doc.Name)
+}
+
 func applicationConfigKey(appName string) string {
 	return fmt.Sprintf("a#%s#application", appName)
 }
@@ -688,6 +702,7 @@ func (a *Application) removeOps(asserts bson.D, op *ForcedOperation) ([]txn.Op,
 		removeStatusOp(a.st, globalKey),
 		removeStatusOp(a.st, applicationGlobalOperatorKey(name)),
 		removeSettingsOp(settingsC, a.applicationConfigKey()),
+		removeSettingsOp(settingsC, a.stagedConfigKey()),
 		removeModelApplicationRefOp(a.st, name),
 		removePodSpecOp(a.ApplicationTag()),
 	)
@@ -858,6 +873,13 @@ func (a *Application) CharmOrigin() *CharmOrigin {
 	return a.doc.CharmOrigin
 }
 
+// CharmSupportedBases returns the bases the application's current charm
+// declares support for, as recorded when the charm was deployed or
+// refreshed. An empty result means the charm manifest declared none.
+func (a *Application) CharmSupportedBases() []SupportedBase {
+	return a.doc.CharmSupportedBases
+}
+
 // IsPrincipal returns whether units of the application can
 // have subordinate units.
 func (a *Application) IsPrincipal() bool {
@@ -1518,6 +1540,21 @@ func (a *Application) SetCharm(cfg SetCharmConfig) (err error) {
 		if err != nil {
 			return errors.Trace(err)
 		}
+		// Units may be running a different series from the
+		// application default; the new charm must support every
+		// series in use.
+		unitSeries, err := a.UnitSeries()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		for _, oneSeries := range unitSeries {
+			if oneSeries == a.doc.Series {
+				continue
+			}
+			if err := checkSeriesForSetCharm(oneSeries, cfg.Charm, cfg.ForceSeries); err != nil {
+				return errors.Annotatef(err, "units running series %q", oneSeries)
+			}
+		}
 	}
 
 	updatedSettings, err := cfg.Charm.Config().ValidateSettings(cfg.ConfigSettings)
@@ -1637,6 +1674,25 @@ func (a *Application) SetCharm(cfg SetCharmConfig) (err error) {
 			})
 		}
 
+		// Keep the denormalised manifest bases in step with the
+		// charm now in use.
+		ops = append(ops, txn.Op{
+			C:      applicationsC,
+			Id:     a.doc.DocID,
+			Assert: txn.DocExists,
+			Update: bson.D{{"$set", bson.D{
+				{"charm-supported-bases", supportedBases(cfg.Charm.Manifest())},
+			}}},
+		})
+
+		// A real charm upgrade supersedes any live-synced file
+		// overlay accumulated by `juju refresh --path --watch`.
+		ops = append(ops, txn.Op{
+			C:      charmSyncC,
+			Id:     a.st.docID(a.doc.Name),
+			Remove: true,
+		})
+
 		// Always update bindings regardless of whether we upgrade to a
 		// new version or stay at the previous version.
 		currentMap, txnRevno, err := readEndpointBindings(a.st, a.globalKey())
@@ -2175,6 +2231,7 @@ func (a *Application) addUnitOps(
 		address:            args.Address,
 		ports:              args.Ports,
 		unitName:           args.UnitName,
+		series:             args.Series,
 	})
 	if err != nil {
 		return uNames, ops, errors.Trace(err)
@@ -2193,6 +2250,9 @@ type applicationAddUnitOpsArgs struct {
 	storageCons   map[string]StorageConstraints
 	attachStorage []names.StorageTag
 
+	// series overrides the application's series for the new unit.
+	series string
+
 	// These optional attributes are relevant to CAAS models.
 	providerId *string
 	address    *string
@@ -2230,6 +2290,14 @@ func (a *Application) addUnitOpsWithCons(args applicationAddUnitOpsArgs) (string
 		return "", nil, errors.Trace(err)
 	}
 
+	unitSeries := a.doc.Series
+	if args.series != "" && args.series != a.doc.Series {
+		if err := a.VerifySupportedSeries(args.series, false); err != nil {
+			return "", nil, errors.Trace(err)
+		}
+		unitSeries = args.series
+	}
+
 	docID := a.st.docID(name)
 	globalKey := unitGlobalKey(name)
 	agentGlobalKey := unitAgentGlobalKey(name)
@@ -2237,7 +2305,7 @@ func (a *Application) addUnitOpsWithCons(args applicationAddUnitOpsArgs) (string
 		DocID:                  docID,
 		Name:                   name,
 		Application:            a.doc.Name,
-		Series:                 a.doc.Series,
+		Series:                 unitSeries,
 		Life:                   Alive,
 		Principal:              args.principalName,
 		MachineId:              args.principalMachineID,
@@ -2477,6 +2545,10 @@ type AddUnitParams struct {
 	// AttachStorage identifies storage instances to attach to the unit.
 	AttachStorage []names.StorageTag
 
+	// Series, if set, overrides the application's series for this
+	// unit. The charm must support the requested series.
+	Series string
+
 	// These attributes are relevant to CAAS models.
 
 	// ProviderId identifies the unit for a given provider.
@@ -2557,6 +2629,7 @@ func (a *Application) removeUnitOps(u *Unit, asserts bson.D, op *ForcedOperation
 		annotationRemoveOp(a.st, u.globalKey()),
 		newCleanupOp(cleanupRemovedUnit, u.doc.Name, op.Force),
 	}
+	ops = append(ops, removeUnitSubnetsOps(u.doc.Name)...)
 	ops = append(ops, portsOps...)
 	ops = append(ops, resOps...)
 	ops = append(ops, hostOps...)
@@ -2655,6 +2728,21 @@ func (a *Application) AllUnits() (units []*Unit, err error) {
 	return allUnits(a.st, a.doc.Name)
 }
 
+// UnitSeries returns the distinct series in use by the application's
+// units, sorted. Units normally run the application's series, but may
+// differ while the application straddles an OS upgrade.
+func (a *Application) UnitSeries() ([]string, error) {
+	units, err := a.AllUnits()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	allSeries := set.NewStrings()
+	for _, unit := range units {
+		allSeries.Add(unit.Series())
+	}
+	return allSeries.SortedValues(), nil
+}
+
 func allUnits(st *State, application string) (units []*Unit, err error) {
 	unitsCollection, closer := st.db().GetCollection(unitsC)
 	defer closer()
@@ -2744,6 +2832,13 @@ func branchCharmSettings(st *State, cURL *charm.URL, appName, branchName string)
 // UpdateCharmConfig changes a application's charm config settings. Values set
 // to nil will be deleted; unknown and invalid values will return an error.
 func (a *Application) UpdateCharmConfig(branchName string, changes charm.Settings) error {
+	return a.UpdateCharmConfigAs(branchName, "", changes)
+}
+
+// UpdateCharmConfigAs is UpdateCharmConfig with provenance: the given
+// user is recorded against the change in the application's config
+// history.
+func (a *Application) UpdateCharmConfigAs(branchName, updatedBy string, changes charm.Settings) error {
 	ch, _, err := a.Charm()
 	if err != nil {
 		return errors.Trace(err)
@@ -2763,14 +2858,19 @@ func (a *Application) UpdateCharmConfig(branchName string, changes charm.Setting
 	}
 
 	if branchName == model.GenerationMaster {
-		return errors.Trace(a.updateMasterConfig(current, changes))
+		return errors.Trace(a.updateMasterConfig(current, changes, updatedBy))
 	}
 	return errors.Trace(a.updateBranchConfig(branchName, current, changes))
 }
 
 // TODO (manadart 2019-04-03): Implement master config changes as
 // instantly committed branches.
-func (a *Application) updateMasterConfig(current *Settings, validChanges charm.Settings) error {
+func (a *Application) updateMasterConfig(current *Settings, validChanges charm.Settings, updatedBy string) error {
+	previous := make(charm.Settings)
+	currentMap := current.Map()
+	for name := range validChanges {
+		previous[name] = currentMap[name]
+	}
 	for name, value := range validChanges {
 		if value == nil {
 			current.Delete(name)
@@ -2778,8 +2878,15 @@ func (a *Application) updateMasterConfig(current *Settings, validChanges charm.S
 			current.Set(name, value)
 		}
 	}
-	_, err := current.Write()
-	return errors.Trace(err)
+	if _, err := current.Write(); err != nil {
+		return errors.Trace(err)
+	}
+	if err := a.recordConfigHistory(updatedBy, validChanges, previous); err != nil {
+		// History is advisory; do not fail a change that has already
+		// been written.
+		logger.Errorf("recording config history for application %q: %v", a.doc.Name, err)
+	}
+	return nil
 }
 
 // updateBranchConfig compares the incoming charm settings to the current
@@ -2794,6 +2901,73 @@ func (a *Application) updateBranchConfig(branchName string, current *Settings, v
 	return errors.Trace(branch.UpdateCharmConfig(a.Name(), current, validChanges))
 }
 
+// StageCharmConfig validates the given charm config changes and records
+// them as the application's staged changeset without applying them.
+// Values set to nil mark the option for reset when the changeset is
+// applied. Staging is cumulative: repeated calls merge into the same
+// changeset.
+func (a *Application) StageCharmConfig(changes charm.Settings) error {
+	ch, _, err := a.Charm()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	changes, err = ch.Config().ValidateSettings(changes)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	staged, err := readSettings(a.st.db(), settingsC, a.stagedConfigKey())
+	if errors.IsNotFound(err) {
+		_, err := createSettings(a.st.db(), settingsC, a.stagedConfigKey(), changes)
+		return errors.Annotatef(err, "staged config for application %q", a.doc.Name)
+	} else if err != nil {
+		return errors.Annotatef(err, "staged config for application %q", a.doc.Name)
+	}
+	for name, value := range changes {
+		staged.Set(name, value)
+	}
+	_, err = staged.Write()
+	return errors.Trace(err)
+}
+
+// StagedCharmConfig returns the application's staged charm config
+// changeset. An empty set of changes is returned when nothing is staged.
+func (a *Application) StagedCharmConfig() (charm.Settings, error) {
+	staged, err := readSettings(a.st.db(), settingsC, a.stagedConfigKey())
+	if errors.IsNotFound(err) {
+		return charm.Settings{}, nil
+	} else if err != nil {
+		return nil, errors.Annotatef(err, "staged config for application %q", a.doc.Name)
+	}
+	return charm.Settings(staged.Map()), nil
+}
+
+// ApplyStagedCharmConfig applies the staged changeset to the
+// application's charm config on the master generation and discards the
+// changeset. It returns a NotFound error when nothing is staged.
+func (a *Application) ApplyStagedCharmConfig() error {
+	staged, err := readSettings(a.st.db(), settingsC, a.stagedConfigKey())
+	if errors.IsNotFound(err) {
+		return errors.NotFoundf("staged config for application %q", a.doc.Name)
+	} else if err != nil {
+		return errors.Annotatef(err, "staged config for application %q", a.doc.Name)
+	}
+	if err := a.UpdateCharmConfig(model.GenerationMaster, charm.Settings(staged.Map())); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(removeSettings(a.st.db(), settingsC, a.stagedConfigKey()))
+}
+
+// DiscardStagedCharmConfig removes the application's staged changeset
+// without applying it. Discarding when nothing is staged is a no-op.
+func (a *Application) DiscardStagedCharmConfig() error {
+	err := removeSettings(a.st.db(), settingsC, a.stagedConfigKey())
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return errors.Trace(err)
+}
+
 // ApplicationConfig returns the configuration for the application itself.
 func (a *Application) ApplicationConfig() (application.ConfigAttributes, error) {
 	config, err := readSettings(a.st.db(), settingsC, a.applicationConfigKey())
@@ -3535,6 +3709,23 @@ func (a *Application) UpdateCloudService(providerId string, addresses []network.
 	return errors.Trace(err)
 }
 
+// UpdateCloudServiceInfo updates the cloud service details for the
+// application, including the external DNS names and per-port annotations
+// reported by the provider.
+func (a *Application) UpdateCloudServiceInfo(
+	providerId string, addresses []network.SpaceAddress,
+	externalNames []string, portAnnotations map[string]map[string]string,
+) error {
+	_, err := a.st.SaveCloudService(SaveCloudServiceArgs{
+		Id:              a.Name(),
+		ProviderId:      providerId,
+		Addresses:       addresses,
+		ExternalNames:   externalNames,
+		PortAnnotations: portAnnotations,
+	})
+	return errors.Trace(err)
+}
+
 // ServiceInfo returns information about this application's cloud service.
 // This is only used for CAAS models.
 func (a *Application) ServiceInfo() (CloudServicer, error) {
@@ -176,6 +176,30 @@ func (s *ApplicationSuite) TestSetCharmCharmOriginNoChange(c *gc.C) {
 	c.Assert(obtainedOrigin, gc.DeepEquals, origin)
 }
 
+func (s *ApplicationSuite) TestSetCharmUpdatesSupportedBases(c *gc.C) {
+	manifestYaml := `
+bases:
+  - name: ubuntu
+    channel: "18.04"
+  - name: ubuntu
+    channel: "20.04"
+`
+	sch := s.AddManifestCharm(c, "mysql", manifestYaml, 2)
+	cfg := state.SetCharmConfig{
+		Charm: sch,
+	}
+	err := s.mysql.SetCharm(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.mysql.Refresh()
+	c.Assert(err, jc.ErrorIsNil)
+	bases := s.mysql.CharmSupportedBases()
+	c.Assert(bases, gc.HasLen, 2)
+	c.Assert(bases[0].Name, gc.Equals, "ubuntu")
+	c.Assert(bases[0].Channel, gc.Matches, "18.04.*")
+	c.Assert(bases[1].Name, gc.Equals, "ubuntu")
+	c.Assert(bases[1].Channel, gc.Matches, "20.04.*")
+}
+
 func (s *ApplicationSuite) TestLXDProfileSetCharm(c *gc.C) {
 	charm := s.AddTestingCharm(c, "lxd-profile")
 	app := s.AddTestingApplication(c, "lxd-profile", charm)
@@ -686,6 +710,39 @@ func (s *ApplicationSuite) TestClientApplicationSetCharmUnsupportedSeriesForce(c
 	c.Assert(ch.URL().String(), gc.Equals, "cs:multi-series2-8")
 }
 
+func (s *ApplicationSuite) TestClientApplicationSetCharmMixedUnitSeries(c *gc.C) {
+	ch := state.AddTestingCharmMultiSeries(c, s.State, "multi-series")
+	app := state.AddTestingApplicationForSeries(c, s.State, "trusty", "application", ch)
+	_, err := app.AddUnit(state.AddUnitParams{})
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = app.AddUnit(state.AddUnitParams{Series: "xenial"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// multi-series2 supports the application's series but not that of
+	// the xenial unit.
+	chDifferentSeries := state.AddTestingCharmMultiSeries(c, s.State, "multi-series2")
+	cfg := state.SetCharmConfig{
+		Charm: chDifferentSeries,
+	}
+	err = app.SetCharm(cfg)
+	c.Assert(err, gc.ErrorMatches, `cannot upgrade application "application" to charm "cs:multi-series2-8": units running series "xenial": only these series are supported: trusty, wily`)
+}
+
+func (s *ApplicationSuite) TestClientApplicationSetCharmMixedUnitSeriesForce(c *gc.C) {
+	ch := state.AddTestingCharmMultiSeries(c, s.State, "multi-series")
+	app := state.AddTestingApplicationForSeries(c, s.State, "trusty", "application", ch)
+	_, err := app.AddUnit(state.AddUnitParams{Series: "xenial"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	chDifferentSeries := state.AddTestingCharmMultiSeries(c, s.State, "multi-series2")
+	cfg := state.SetCharmConfig{
+		Charm:       chDifferentSeries,
+		ForceSeries: true,
+	}
+	err = app.SetCharm(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func (s *ApplicationSuite) TestClientApplicationSetCharmWrongOS(c *gc.C) {
 	ch := state.AddTestingCharmMultiSeries(c, s.State, "multi-series")
 	app := state.AddTestingApplicationForSeries(c, s.State, "precise", "application", ch)
@@ -2717,6 +2774,42 @@ func (s *ApplicationSuite) TestAddUnit(c *gc.C) {
 	c.Assert(id, gc.Equals, m.Id())
 }
 
+func (s *ApplicationSuite) TestAddUnitWithSeries(c *gc.C) {
+	ch := state.AddTestingCharmMultiSeries(c, s.State, "multi-series")
+	app := state.AddTestingApplicationForSeries(c, s.State, "precise", "application", ch)
+
+	unit, err := app.AddUnit(state.AddUnitParams{Series: "xenial"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(unit.Series(), gc.Equals, "xenial")
+
+	// The application's own series is unchanged.
+	c.Assert(app.Series(), gc.Equals, "precise")
+	unitSeries, err := app.UnitSeries()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(unitSeries, jc.DeepEquals, []string{"xenial"})
+}
+
+func (s *ApplicationSuite) TestAddUnitWithUnsupportedSeries(c *gc.C) {
+	ch := state.AddTestingCharmMultiSeries(c, s.State, "multi-series")
+	app := state.AddTestingApplicationForSeries(c, s.State, "precise", "application", ch)
+
+	_, err := app.AddUnit(state.AddUnitParams{Series: "bionic"})
+	c.Assert(err, gc.ErrorMatches, `cannot add unit to application "application": series "bionic" not supported by charm .*, supported series are: precise, trusty, xenial, yakkety`)
+}
+
+func (s *ApplicationSuite) TestUnitSeries(c *gc.C) {
+	ch := state.AddTestingCharmMultiSeries(c, s.State, "multi-series")
+	app := state.AddTestingApplicationForSeries(c, s.State, "trusty", "application", ch)
+	_, err := app.AddUnit(state.AddUnitParams{})
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = app.AddUnit(state.AddUnitParams{Series: "xenial"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	unitSeries, err := app.UnitSeries()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(unitSeries, jc.DeepEquals, []string{"trusty", "xenial"})
+}
+
 func (s *ApplicationSuite) TestAddUnitWhenNotAlive(c *gc.C) {
 	u, err := s.mysql.AddUnit(state.AddUnitParams{})
 	c.Assert(err, jc.ErrorIsNil)
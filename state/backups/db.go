@@ -4,10 +4,12 @@
 package backups
 
 import (
+	"fmt"
 	"io/ioutil"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"time"
 
 	"github.com/juju/collections/set"
 	"github.com/juju/errors"
@@ -39,6 +41,10 @@ type DBInfo struct {
 	Password string
 	// Targets is a list of databases to dump.
 	Targets set.Strings
+	// IncrementalSince, if set, requests an incremental dump holding
+	// only the oplog entries recorded at or after the given time,
+	// rather than a full dump of every target database.
+	IncrementalSince *time.Time
 }
 
 // ignoredDatabases is the list of databases that should not be
@@ -160,7 +166,23 @@ func (md *mongoDumper) options(dumpDir string) []string {
 		"--username", md.Username,
 		"--password", md.Password,
 		"--out", dumpDir,
-		"--oplog",
+	}
+	if md.IncrementalSince != nil {
+		// An incremental dump captures only the oplog entries recorded
+		// since the base backup, instead of a full dump of every
+		// database. Replaying the oplog over a restored base backup
+		// brings the database up to date.
+		query := fmt.Sprintf(
+			`{"ts":{"$gte":{"$timestamp":{"t":%d,"i":0}}}}`,
+			md.IncrementalSince.Unix(),
+		)
+		options = append(options,
+			"--db", "local",
+			"--collection", "oplog.rs",
+			"--query", query,
+		)
+	} else {
+		options = append(options, "--oplog")
 	}
 	return options
 }
@@ -201,6 +223,12 @@ func (md *mongoDumper) Dump(baseDumpDir string) error {
 		return errors.Trace(err)
 	}
 
+	// An incremental dump only contains the oplog collection, so there
+	// are no ignored databases to strip.
+	if md.IncrementalSince != nil {
+		return nil
+	}
+
 	found, err := listDatabases(baseDumpDir)
 	if err != nil {
 		return errors.Trace(err)
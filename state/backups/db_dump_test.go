@@ -6,6 +6,7 @@ package backups_test
 import (
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/juju/collections/set"
 	jc "github.com/juju/testing/checkers"
@@ -30,7 +31,7 @@ func (s *dumpSuite) SetUpTest(c *gc.C) {
 	s.BaseSuite.SetUpTest(c)
 
 	targets := set.NewStrings("juju", "admin")
-	s.dbInfo = &backups.DBInfo{"a", "b", "c", targets}
+	s.dbInfo = &backups.DBInfo{Address: "a", Username: "b", Password: "c", Targets: targets}
 	s.targets = targets
 	s.dumpDir = c.MkDir()
 }
@@ -131,6 +132,21 @@ func (s *dumpSuite) TestDumpStrippedMultiple(c *gc.C) {
 	s.checkStripped(c, "backups")
 }
 
+func (s *dumpSuite) TestDumpIncrementalLeavesDumpIntact(c *gc.C) {
+	since := time.Unix(1420000000, 0)
+	s.dbInfo.IncrementalSince = &since
+	s.patch(c)
+	dumper := s.prep(c, "juju", "admin")
+	s.prepDB(c, "backups") // not stripped for incremental dumps
+
+	err := dumper.Dump(s.dumpDir)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(s.ranCommand, jc.IsTrue)
+	// An incremental dump holds only the oplog, so nothing is stripped.
+	s.checkDBs(c, "juju", "admin", "backups")
+}
+
 func (s *dumpSuite) TestDumpNothingIgnored(c *gc.C) {
 	s.patch(c)
 	dumper := s.prep(c, "juju", "admin")
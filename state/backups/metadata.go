@@ -83,6 +83,14 @@ type Metadata struct {
 	// Notes is an optional user-supplied annotation.
 	Notes string
 
+	// Incremental is true if this backup holds only the oplog entries
+	// recorded since the backup identified by BasedOn.
+	Incremental bool
+
+	// BasedOn is the ID of the backup an incremental backup was taken
+	// against. It is empty for full backups.
+	BasedOn string
+
 	// FormatVersion stores format version of these metadata.
 	FormatVersion int64
 
@@ -266,6 +274,8 @@ type flatMetadata struct {
 	Started                     time.Time
 	Finished                    time.Time
 	Notes                       string
+	Incremental                 bool   `json:",omitempty"`
+	BasedOn                     string `json:",omitempty"`
 	ModelUUID                   string
 	Machine                     string
 	Hostname                    string
@@ -287,6 +297,8 @@ func (m *Metadata) flat() flatMetadata {
 		Size:                        m.Size(),
 		Started:                     m.Started,
 		Notes:                       m.Notes,
+		Incremental:                 m.Incremental,
+		BasedOn:                     m.BasedOn,
 		ModelUUID:                   m.Origin.Model,
 		Machine:                     m.Origin.Machine,
 		Hostname:                    m.Origin.Hostname,
@@ -330,6 +342,8 @@ func (flat *flatMetadata) inflate() (*Metadata, error) {
 		meta.Finished = &flat.Finished
 	}
 	meta.Notes = flat.Notes
+	meta.Incremental = flat.Incremental
+	meta.BasedOn = flat.BasedOn
 	meta.Origin = Origin{
 		Model:    flat.ModelUUID,
 		Machine:  flat.Machine,
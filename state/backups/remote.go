@@ -0,0 +1,71 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"io"
+	"net/url"
+	"sync"
+
+	"github.com/juju/errors"
+)
+
+// RemoteStorage is implemented by object stores to which backup
+// archives can be streamed directly, instead of (or as well as) being
+// kept on the controller disk.
+type RemoteStorage interface {
+	// Put streams the archive of the given size to the store under
+	// the given name.
+	Put(name string, archive io.Reader, size int64) error
+
+	// List returns the names of the archives held in the store.
+	List() ([]string, error)
+
+	// Remove deletes the named archive from the store.
+	Remove(name string) error
+}
+
+// RemoteStorageProvider returns a RemoteStorage for the given target
+// URL. The URL's scheme identifies the provider; the rest of the URL
+// is provider-specific (bucket, container, credentials reference...).
+type RemoteStorageProvider func(target *url.URL) (RemoteStorage, error)
+
+var (
+	remoteStorageMu        sync.RWMutex
+	remoteStorageProviders = make(map[string]RemoteStorageProvider)
+)
+
+// RegisterRemoteStorageProvider registers a remote storage provider
+// for the given URL scheme (eg "s3", "swift", "azure"). It is intended
+// to be called from provider init functions; registering the same
+// scheme twice panics.
+func RegisterRemoteStorageProvider(scheme string, provider RemoteStorageProvider) {
+	remoteStorageMu.Lock()
+	defer remoteStorageMu.Unlock()
+	if _, ok := remoteStorageProviders[scheme]; ok {
+		panic(errors.Errorf("duplicate remote backup storage provider for scheme %q", scheme))
+	}
+	remoteStorageProviders[scheme] = provider
+}
+
+// OpenRemoteStorage returns the remote storage identified by the
+// given target URL, as configured via the backup-storage-url
+// controller config attribute.
+func OpenRemoteStorage(target string) (RemoteStorage, error) {
+	parsed, err := url.Parse(target)
+	if err != nil {
+		return nil, errors.Annotatef(err, "invalid backup storage URL %q", target)
+	}
+	remoteStorageMu.RLock()
+	provider, ok := remoteStorageProviders[parsed.Scheme]
+	remoteStorageMu.RUnlock()
+	if !ok {
+		return nil, errors.NotSupportedf("backup storage scheme %q", parsed.Scheme)
+	}
+	stor, err := provider(parsed)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return stor, nil
+}
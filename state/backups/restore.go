@@ -0,0 +1,126 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2"
+	"github.com/juju/mgo/v2/bson"
+)
+
+const restoreName = "mongorestore"
+
+// jujuDBName is the name of the database holding juju state documents.
+const jujuDBName = "juju"
+
+var getMongorestorePath = func() (string, error) {
+	return getMongoToolPath(restoreName, os.Stat, exec.LookPath)
+}
+
+// DBRestorer is any type that restores a database dump into a target
+// database.
+type DBRestorer interface {
+	// Restore loads the juju database dump found in dumpDir into the
+	// restorer's target database.
+	Restore(dumpDir string) error
+}
+
+type mongoRestorer struct {
+	*DBInfo
+	// binPath is the path to the restore executable.
+	binPath string
+	// targetDB is the database the dump is restored into.
+	targetDB string
+}
+
+// NewDBRestorer returns a new value with a Restore method for loading
+// a juju database dump into the named scratch database.
+func NewDBRestorer(info *DBInfo, targetDB string) (DBRestorer, error) {
+	mongorestorePath, err := getMongorestorePath()
+	if err != nil {
+		return nil, errors.Annotate(err, "mongorestore not available")
+	}
+
+	restorer := mongoRestorer{
+		DBInfo:   info,
+		binPath:  mongorestorePath,
+		targetDB: targetDB,
+	}
+	return &restorer, nil
+}
+
+func (mr *mongoRestorer) options(dumpDir string) []string {
+	options := []string{
+		"--ssl",
+		"--tlsInsecure",
+		"--authenticationDatabase", "admin",
+		"--host", mr.Address,
+		"--username", mr.Username,
+		"--password", mr.Password,
+		"--db", mr.targetDB,
+		filepath.Join(dumpDir, jujuDBName),
+	}
+	return options
+}
+
+// Restore implements DBRestorer.
+func (mr *mongoRestorer) Restore(dumpDir string) error {
+	logger.Tracef("restoring Mongo dump %q into database %q", dumpDir, mr.targetDB)
+	options := mr.options(dumpDir)
+	if err := runCommandFn(mr.binPath, options...); err != nil {
+		return errors.Annotate(err, "error restoring database dump")
+	}
+	return nil
+}
+
+// RestoreModelDocs copies the documents belonging to the given model
+// from the scratch database (populated from a backup dump) into the
+// live juju database. Documents that already exist are left alone, so
+// a partially restored model can be retried. Leases are deliberately
+// not reconstructed; holders re-claim them once the model's agents
+// reconnect. The number of documents copied is returned.
+func RestoreModelDocs(session *mgo.Session, scratchDB, modelUUID string) (int, error) {
+	scratch := session.DB(scratchDB)
+	live := session.DB(jujuDBName)
+
+	collections, err := scratch.CollectionNames()
+	if err != nil {
+		return 0, errors.Annotate(err, "while listing dump collections")
+	}
+
+	// The model document itself is keyed on the model UUID; everything
+	// else carries a model-uuid field.
+	query := bson.D{{"$or", []bson.D{
+		{{"model-uuid", modelUUID}},
+		{{"_id", modelUUID}},
+	}}}
+
+	restored := 0
+	for _, collName := range collections {
+		if collName == "system.indexes" {
+			continue
+		}
+		iter := scratch.C(collName).Find(query).Iter()
+		var doc bson.D
+		for iter.Next(&doc) {
+			err := live.C(collName).Insert(doc)
+			if mgo.IsDup(err) {
+				continue
+			}
+			if err != nil {
+				_ = iter.Close()
+				return restored, errors.Annotatef(err, "while restoring %q documents", collName)
+			}
+			restored++
+		}
+		if err := iter.Close(); err != nil {
+			return restored, errors.Annotatef(err, "while reading %q documents from the dump", collName)
+		}
+	}
+	return restored, nil
+}
@@ -38,6 +38,28 @@ func (st *State) ToolsStorage() (binarystorage.StorageCloser, error) {
 	}}, nil
 }
 
+// SharedToolsStorage returns a content-addressed StorageCloser whose
+// blobs live in the controller-wide bucket, deduplicating identical
+// agent binaries across models and versions. Version metadata remains
+// scoped to this model.
+func (st *State) SharedToolsStorage() (binarystorage.StorageCloser, error) {
+	db, closer1 := st.database.CopyForModel(st.ModelUUID())
+	metadataCollection, closer2 := db.GetCollection(toolsmetadataC)
+	txnRunner, closer3 := db.TransactionRunner()
+	closer := func() {
+		closer3()
+		closer2()
+		closer1()
+	}
+	rawDB := metadataCollection.Writeable().Underlying().Database
+	rs := blobstore.NewGridFS(blobstoreDB, blobstoreDB, rawDB.Session)
+	managedStorage := blobstore.NewManagedStorage(rawDB, rs)
+	storage := binarystorage.NewContentAddressed(
+		st.ControllerModelUUID(), managedStorage, metadataCollection, txnRunner,
+	)
+	return &storageCloser{storage, closer}, nil
+}
+
 func newBinaryStorageCloser(db Database, collectionName, uuid string) binarystorage.StorageCloser {
 	db, closer1 := db.CopyForModel(uuid)
 	metadataCollection, closer2 := db.GetCollection(collectionName)
@@ -0,0 +1,300 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package binarystorage
+
+import (
+	"crypto/sha512"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/juju/blobstore/v2"
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2"
+	"github.com/juju/mgo/v2/bson"
+	"github.com/juju/mgo/v2/txn"
+	jujutxn "github.com/juju/txn/v2"
+
+	"github.com/juju/juju/mongo"
+)
+
+// contentAddressedPathPrefix is the path prefix under which blobs are
+// stored in the shared bucket, keyed by their SHA-384 digest.
+const contentAddressedPathPrefix = "tools/sha384-"
+
+// refCountIdPrefix namespaces the per-digest reference count documents
+// kept alongside the version metadata documents.
+const refCountIdPrefix = "blobref#"
+
+type contentAddressedStorage struct {
+	bucketUUID         string
+	managedStorage     blobstore.ManagedStorage
+	metadataCollection mongo.Collection
+	txnRunner          jujutxn.Runner
+}
+
+var _ ContentAddressedStorage = (*contentAddressedStorage)(nil)
+
+// NewContentAddressed constructs a Storage that stores binary files once
+// per unique content in the shared bucket identified by bucketUUID,
+// addressed by their SHA-384 digest. Blobs are reference counted across
+// the versions that point at them so that unreferenced content can be
+// garbage collected, deduplicating identical agent binaries across models
+// and versions.
+func NewContentAddressed(
+	bucketUUID string,
+	managedStorage blobstore.ManagedStorage,
+	metadataCollection mongo.Collection,
+	runner jujutxn.Runner,
+) ContentAddressedStorage {
+	return &contentAddressedStorage{
+		bucketUUID:         bucketUUID,
+		managedStorage:     managedStorage,
+		metadataCollection: metadataCollection,
+		txnRunner:          runner,
+	}
+}
+
+// Add implements Storage.Add. The binary file is spooled to disk to
+// compute its SHA-384 digest before being stored at the digest-derived
+// path, so adding content that is already present is a metadata-only
+// operation for the blob store.
+func (s *contentAddressedStorage) Add(r io.Reader, metadata Metadata) (resultErr error) {
+	digest, spooled, cleanup, err := spoolToDigest(r)
+	if err != nil {
+		return errors.Annotate(err, "cannot compute binary file digest")
+	}
+	defer cleanup()
+
+	path := contentAddressedPathPrefix + digest
+	if err := s.managedStorage.PutForBucket(s.bucketUUID, path, spooled, metadata.Size); err != nil {
+		return errors.Annotate(err, "cannot store binary file")
+	}
+
+	newDoc := metadataDoc{
+		Id:      metadata.Version,
+		Version: metadata.Version,
+		Size:    metadata.Size,
+		SHA256:  metadata.SHA256,
+		Path:    path,
+	}
+
+	// Add or replace metadata, and keep the per-digest reference counts
+	// in step. If replacing, the old digest loses a reference; the blob
+	// itself is left for garbage collection.
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		op := txn.Op{
+			C:  s.metadataCollection.Name(),
+			Id: newDoc.Id,
+		}
+		if attempt == 0 {
+			op.Assert = txn.DocMissing
+			op.Insert = &newDoc
+			ops, err := s.adjustRefCountOps(digest, 1)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			return append([]txn.Op{op}, ops...), nil
+		}
+
+		oldDoc, err := s.findMetadata(metadata.Version)
+		if err != nil {
+			return nil, err
+		}
+		op.Assert = bson.D{{"path", oldDoc.Path}}
+		if oldDoc.Path == path {
+			return []txn.Op{op}, nil
+		}
+		op.Update = bson.D{{
+			"$set", bson.D{
+				{"size", metadata.Size},
+				{"sha256", metadata.SHA256},
+				{"path", path},
+			},
+		}}
+		ops := []txn.Op{op}
+		incOps, err := s.adjustRefCountOps(digest, 1)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		decOps, err := s.adjustRefCountOps(digestFromPath(oldDoc.Path), -1)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return append(append(ops, incOps...), decOps...), nil
+	}
+	if err := s.txnRunner.Run(buildTxn); err != nil {
+		return errors.Annotate(err, "cannot store binary metadata")
+	}
+	return nil
+}
+
+// Open implements Storage.Open.
+func (s *contentAddressedStorage) Open(version string) (Metadata, io.ReadCloser, error) {
+	doc, err := s.findMetadata(version)
+	if err != nil {
+		return Metadata{}, nil, err
+	}
+	r, _, err := s.managedStorage.GetForBucket(s.bucketUUID, doc.Path)
+	if err != nil {
+		return Metadata{}, nil, err
+	}
+	return Metadata{
+		Version: doc.Version,
+		Size:    doc.Size,
+		SHA256:  doc.SHA256,
+	}, r, nil
+}
+
+// Metadata implements Storage.Metadata.
+func (s *contentAddressedStorage) Metadata(version string) (Metadata, error) {
+	doc, err := s.findMetadata(version)
+	if err != nil {
+		return Metadata{}, err
+	}
+	return Metadata{
+		Version: doc.Version,
+		Size:    doc.Size,
+		SHA256:  doc.SHA256,
+	}, nil
+}
+
+// AllMetadata implements Storage.AllMetadata.
+func (s *contentAddressedStorage) AllMetadata() ([]Metadata, error) {
+	var docs []metadataDoc
+	query := bson.D{{"version", bson.D{{"$exists", true}}}}
+	if err := s.metadataCollection.Find(query).All(&docs); err != nil {
+		return nil, err
+	}
+	list := make([]Metadata, len(docs))
+	for i, doc := range docs {
+		list[i] = Metadata{
+			Version: doc.Version,
+			Size:    doc.Size,
+			SHA256:  doc.SHA256,
+		}
+	}
+	return list, nil
+}
+
+// GarbageCollect implements ContentAddressedStorage.GarbageCollect.
+func (s *contentAddressedStorage) GarbageCollect() (int, error) {
+	var refDocs []refCountDoc
+	query := bson.D{
+		{"_id", bson.D{{"$regex", "^" + refCountIdPrefix}}},
+		{"refcount", bson.D{{"$lte", 0}}},
+	}
+	if err := s.metadataCollection.Find(query).All(&refDocs); err != nil {
+		return 0, errors.Annotate(err, "cannot find unreferenced blobs")
+	}
+
+	removed := 0
+	for _, refDoc := range refDocs {
+		digest := refDoc.Digest
+		ops := []txn.Op{{
+			C:      s.metadataCollection.Name(),
+			Id:     refDoc.Id,
+			Assert: bson.D{{"refcount", bson.D{{"$lte", 0}}}},
+			Remove: true,
+		}}
+		if err := s.txnRunner.RunTransaction(&jujutxn.Transaction{Ops: ops}); err != nil {
+			// Something re-referenced the blob concurrently; leave it.
+			logger.Debugf("not removing blob %q: %v", digest, err)
+			continue
+		}
+		path := contentAddressedPathPrefix + digest
+		if err := s.managedStorage.RemoveForBucket(s.bucketUUID, path); err != nil && !errors.IsNotFound(err) {
+			logger.Errorf("failed to remove unreferenced blob %q: %v", digest, err)
+			continue
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+type refCountDoc struct {
+	Id       string `bson:"_id"`
+	Digest   string `bson:"digest"`
+	RefCount int    `bson:"refcount"`
+}
+
+// adjustRefCountOps returns transaction operations that adjust the
+// reference count for the given digest by delta, creating the reference
+// document on first use.
+func (s *contentAddressedStorage) adjustRefCountOps(digest string, delta int) ([]txn.Op, error) {
+	if digest == "" {
+		return nil, nil
+	}
+	refId := refCountIdPrefix + digest
+	var doc refCountDoc
+	err := s.metadataCollection.FindId(refId).One(&doc)
+	if err == mgo.ErrNotFound {
+		if delta < 0 {
+			return nil, nil
+		}
+		return []txn.Op{{
+			C:      s.metadataCollection.Name(),
+			Id:     refId,
+			Assert: txn.DocMissing,
+			Insert: &refCountDoc{
+				Id:       refId,
+				Digest:   digest,
+				RefCount: delta,
+			},
+		}}, nil
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return []txn.Op{{
+		C:      s.metadataCollection.Name(),
+		Id:     refId,
+		Assert: txn.DocExists,
+		Update: bson.D{{"$inc", bson.D{{"refcount", delta}}}},
+	}}, nil
+}
+
+func (s *contentAddressedStorage) findMetadata(version string) (metadataDoc, error) {
+	var doc metadataDoc
+	err := s.metadataCollection.FindId(version).One(&doc)
+	if err == mgo.ErrNotFound {
+		return doc, errors.NotFoundf("%v binary metadata", version)
+	}
+	return doc, err
+}
+
+// digestFromPath recovers the SHA-384 digest from a blob path previously
+// produced by Add, or returns "" for paths written by the non
+// content-addressed storage.
+func digestFromPath(path string) string {
+	if len(path) <= len(contentAddressedPathPrefix) || path[:len(contentAddressedPathPrefix)] != contentAddressedPathPrefix {
+		return ""
+	}
+	return path[len(contentAddressedPathPrefix):]
+}
+
+// spoolToDigest copies the reader to a temporary file while computing its
+// SHA-384 digest, returning the digest and a reader positioned at the
+// start of the spooled content. The cleanup function removes the
+// temporary file.
+func spoolToDigest(r io.Reader) (digest string, spooled io.Reader, cleanup func(), err error) {
+	f, err := ioutil.TempFile("", "juju-binary-spool")
+	if err != nil {
+		return "", nil, nil, errors.Trace(err)
+	}
+	cleanup = func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}
+	hash := sha512.New384()
+	if _, err := io.Copy(io.MultiWriter(f, hash), r); err != nil {
+		cleanup()
+		return "", nil, nil, errors.Trace(err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		cleanup()
+		return "", nil, nil, errors.Trace(err)
+	}
+	return fmt.Sprintf("%x", hash.Sum(nil)), f, cleanup, nil
+}
@@ -0,0 +1,134 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package binarystorage_test
+
+import (
+	"bytes"
+	"io/ioutil"
+
+	"github.com/juju/blobstore/v2"
+	gitjujutesting "github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	jujutxn "github.com/juju/txn/v2"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/mongo"
+	"github.com/juju/juju/state/binarystorage"
+)
+
+type contentAddressedStorageSuite struct {
+	gitjujutesting.IsolatedMgoSuite
+
+	storage            binarystorage.ContentAddressedStorage
+	managedStorage     blobstore.ManagedStorage
+	metadataCollection mongo.Collection
+	txnRunner          jujutxn.Runner
+
+	cleanUps []func(*gc.C)
+}
+
+var _ = gc.Suite(&contentAddressedStorageSuite{})
+
+func (s *contentAddressedStorageSuite) SetUpTest(c *gc.C) {
+	s.IsolatedMgoSuite.SetUpTest(c)
+
+	catalogue := s.Session.DB("catalogue")
+	rs := blobstore.NewGridFS("blobstore", "blobstore", catalogue.Session)
+	var closer func()
+	s.metadataCollection, closer = mongo.CollectionFromName(catalogue, "binarymetadata")
+	s.addCleanup(func(*gc.C) { closer() })
+	s.managedStorage = blobstore.NewManagedStorage(s.metadataCollection.Writeable().Underlying().Database, rs)
+	s.txnRunner = jujutxn.NewRunner(jujutxn.RunnerParams{Database: catalogue})
+	s.storage = binarystorage.NewContentAddressed("controller-uuid", s.managedStorage, s.metadataCollection, s.txnRunner)
+}
+
+func (s *contentAddressedStorageSuite) addCleanup(f func(*gc.C)) {
+	s.cleanUps = append(s.cleanUps, f)
+}
+
+func (s *contentAddressedStorageSuite) TearDownTest(c *gc.C) {
+	for _, f := range s.cleanUps {
+		f(c)
+	}
+
+	s.storage = nil
+	s.managedStorage = nil
+	s.metadataCollection = nil
+	s.txnRunner = nil
+	s.IsolatedMgoSuite.TearDownTest(c)
+}
+
+func (s *contentAddressedStorageSuite) add(c *gc.C, version, content string) {
+	err := s.storage.Add(bytes.NewReader([]byte(content)), binarystorage.Metadata{
+		Version: version,
+		Size:    int64(len(content)),
+		SHA256:  "hash(" + content + ")",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *contentAddressedStorageSuite) TestAddAndOpen(c *gc.C) {
+	s.add(c, current, "some-binary")
+
+	metadata, rc, err := s.storage.Open(current)
+	c.Assert(err, jc.ErrorIsNil)
+	defer rc.Close()
+	c.Assert(metadata, gc.Equals, binarystorage.Metadata{
+		Version: current,
+		Size:    int64(len("some-binary")),
+		SHA256:  "hash(some-binary)",
+	})
+
+	data, err := ioutil.ReadAll(rc)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(string(data), gc.Equals, "some-binary")
+}
+
+func (s *contentAddressedStorageSuite) TestAddDeduplicatesContent(c *gc.C) {
+	// Two versions with identical content share a single blob.
+	s.add(c, current, "same-content")
+	s.add(c, bumpVersion(current), "same-content")
+
+	metadata, err := s.storage.AllMetadata()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(metadata, gc.HasLen, 2)
+
+	// Neither blob is eligible for collection while referenced.
+	removed, err := s.storage.GarbageCollect()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(removed, gc.Equals, 0)
+}
+
+func (s *contentAddressedStorageSuite) TestReplaceReleasesOldBlob(c *gc.C) {
+	s.add(c, current, "old-content")
+	s.add(c, current, "new-content")
+
+	// The old blob is no longer referenced and can be collected.
+	removed, err := s.storage.GarbageCollect()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(removed, gc.Equals, 1)
+
+	// The replacement is still readable.
+	_, rc, err := s.storage.Open(current)
+	c.Assert(err, jc.ErrorIsNil)
+	defer rc.Close()
+	data, err := ioutil.ReadAll(rc)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(string(data), gc.Equals, "new-content")
+}
+
+func (s *contentAddressedStorageSuite) TestGarbageCollectNothingToDo(c *gc.C) {
+	removed, err := s.storage.GarbageCollect()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(removed, gc.Equals, 0)
+}
+
+func (s *contentAddressedStorageSuite) TestAllMetadataExcludesRefCounts(c *gc.C) {
+	s.add(c, current, "abc")
+
+	metadata, err := s.storage.AllMetadata()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(metadata, gc.HasLen, 1)
+	c.Assert(metadata[0].Version, gc.Equals, current)
+}
@@ -33,6 +33,16 @@ type Storage interface {
 	Metadata(version string) (Metadata, error)
 }
 
+// ContentAddressedStorage extends Storage with garbage collection of
+// blobs that are no longer referenced by any stored version.
+type ContentAddressedStorage interface {
+	Storage
+
+	// GarbageCollect removes blobs whose reference count has dropped to
+	// zero and returns the number of blobs removed.
+	GarbageCollect() (int, error)
+}
+
 // StorageCloser extends the Storage interface with a Close method.
 type StorageCloser interface {
 	Storage
@@ -68,6 +68,31 @@ type Platform struct {
 	Series       string `bson:"series,omitempty"`
 }
 
+// SupportedBase identifies an operating system base a charm declares
+// support for in its manifest. The bases are denormalised onto the
+// application document so series upgrade prechecks can evaluate
+// compatibility without reading the charm archive.
+type SupportedBase struct {
+	Name    string `bson:"name"`
+	Channel string `bson:"channel"`
+}
+
+// supportedBases returns the bases declared in the given charm manifest
+// in a form suitable for persisting on the application document.
+func supportedBases(manifest *charm.Manifest) []SupportedBase {
+	if manifest == nil {
+		return nil
+	}
+	var bases []SupportedBase
+	for _, b := range manifest.Bases {
+		bases = append(bases, SupportedBase{
+			Name:    b.Name,
+			Channel: b.Channel.String(),
+		})
+	}
+	return bases
+}
+
 // CharmOrigin holds the original source of a charm. Information about where the
 // charm was installed from (charm-hub, charm-store, local) and any additional
 // information we can utilise when making modelling decisions for upgrading or
@@ -0,0 +1,248 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2"
+	"github.com/juju/mgo/v2/bson"
+	"github.com/juju/mgo/v2/txn"
+)
+
+// CharmSyncFile describes a single charm file pushed to an
+// application's units by `juju refresh --path --watch` without a full
+// charm upgrade.
+type CharmSyncFile struct {
+	// Path is the file path relative to the charm root.
+	Path string
+
+	// Content is the file content. It is empty when Removed is true.
+	Content []byte
+
+	// Mode is the file mode bits to apply.
+	Mode os.FileMode
+
+	// Removed indicates the file has been deleted from the charm
+	// directory and should be removed on the units.
+	Removed bool
+}
+
+// CharmSync is the accumulated overlay of files synced onto an
+// application's charm since its last full refresh. Each update bumps
+// the generation so units can tell whether they have applied the
+// latest content.
+type CharmSync struct {
+	appName    string
+	generation int
+	files      []CharmSyncFile
+	updated    time.Time
+}
+
+// ApplicationName returns the name of the application the overlay
+// belongs to.
+func (s *CharmSync) ApplicationName() string {
+	return s.appName
+}
+
+// Generation returns the overlay's generation, which increments on
+// every update.
+func (s *CharmSync) Generation() int {
+	return s.generation
+}
+
+// Files returns the files in the overlay.
+func (s *CharmSync) Files() []CharmSyncFile {
+	return s.files
+}
+
+// Updated returns when the overlay last changed.
+func (s *CharmSync) Updated() time.Time {
+	return s.updated
+}
+
+type charmSyncDoc struct {
+	DocID      string             `bson:"_id"`
+	AppName    string             `bson:"application-name"`
+	Generation int                `bson:"generation"`
+	Files      []charmSyncFileDoc `bson:"files"`
+	Updated    time.Time          `bson:"updated"`
+}
+
+type charmSyncFileDoc struct {
+	Path    string `bson:"path"`
+	Content []byte `bson:"content,omitempty"`
+	Mode    uint32 `bson:"mode"`
+	Removed bool   `bson:"removed,omitempty"`
+}
+
+func (d *charmSyncDoc) toCharmSync() *CharmSync {
+	files := make([]CharmSyncFile, len(d.Files))
+	for i, f := range d.Files {
+		files[i] = CharmSyncFile{
+			Path:    f.Path,
+			Content: f.Content,
+			Mode:    os.FileMode(f.Mode),
+			Removed: f.Removed,
+		}
+	}
+	return &CharmSync{
+		appName:    d.AppName,
+		generation: d.Generation,
+		files:      files,
+		updated:    d.Updated,
+	}
+}
+
+func validateCharmSyncPath(p string) error {
+	if p == "" {
+		return errors.NotValidf("empty charm file path")
+	}
+	if path.IsAbs(p) {
+		return errors.NotValidf("absolute charm file path %q", p)
+	}
+	for _, part := range strings.Split(path.Clean(p), "/") {
+		if part == ".." {
+			return errors.NotValidf("charm file path %q", p)
+		}
+	}
+	return nil
+}
+
+// UpdateCharmSync merges the supplied files into the application's
+// charm sync overlay, creating it if necessary, and bumps its
+// generation. Entries replace any previous entry for the same path;
+// removed entries supersede earlier content for that path.
+func (st *State) UpdateCharmSync(appName string, files []CharmSyncFile) error {
+	if len(files) == 0 {
+		return errors.New("no files to sync")
+	}
+	for _, f := range files {
+		if err := validateCharmSyncPath(f.Path); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if err := checkModelActive(st); err != nil {
+		return errors.Trace(err)
+	}
+	buildTxn := func(int) ([]txn.Op, error) {
+		app, err := st.Application(appName)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if app.Life() != Alive {
+			return nil, errors.Errorf("application %q is not alive", appName)
+		}
+		now := st.nowToTheSecond()
+		existing, err := st.CharmSync(appName)
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, errors.Trace(err)
+		}
+		var merged []charmSyncFileDoc
+		if err == nil {
+			incoming := make(map[string]bool, len(files))
+			for _, f := range files {
+				incoming[f.Path] = true
+			}
+			for _, f := range existing.Files() {
+				if !incoming[f.Path] {
+					merged = append(merged, charmSyncFileDoc{
+						Path:    f.Path,
+						Content: f.Content,
+						Mode:    uint32(f.Mode),
+						Removed: f.Removed,
+					})
+				}
+			}
+		}
+		for _, f := range files {
+			doc := charmSyncFileDoc{
+				Path:    f.Path,
+				Mode:    uint32(f.Mode),
+				Removed: f.Removed,
+			}
+			if !f.Removed {
+				doc.Content = f.Content
+			}
+			merged = append(merged, doc)
+		}
+		var ops []txn.Op
+		if err == nil {
+			ops = []txn.Op{{
+				C:      charmSyncC,
+				Id:     st.docID(appName),
+				Assert: bson.D{{"generation", existing.Generation()}},
+				Update: bson.D{{"$set", bson.D{
+					{"generation", existing.Generation() + 1},
+					{"files", merged},
+					{"updated", now},
+				}}},
+			}}
+		} else {
+			ops = []txn.Op{{
+				C:      charmSyncC,
+				Id:     st.docID(appName),
+				Assert: txn.DocMissing,
+				Insert: charmSyncDoc{
+					DocID:      st.docID(appName),
+					AppName:    appName,
+					Generation: 1,
+					Files:      merged,
+					Updated:    now,
+				},
+			}}
+		}
+		return append(ops, txn.Op{
+			C:      applicationsC,
+			Id:     app.doc.DocID,
+			Assert: isAliveDoc,
+		}), nil
+	}
+	if err := st.db().Run(buildTxn); err != nil {
+		return errors.Annotatef(err, "cannot sync charm files for application %q", appName)
+	}
+	return nil
+}
+
+// CharmSync returns the charm sync overlay for the named application.
+func (st *State) CharmSync(appName string) (*CharmSync, error) {
+	coll, closer := st.db().GetCollection(charmSyncC)
+	defer closer()
+
+	var doc charmSyncDoc
+	err := coll.FindId(appName).One(&doc)
+	if err == mgo.ErrNotFound {
+		return nil, errors.NotFoundf("charm sync for application %q", appName)
+	}
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return doc.toCharmSync(), nil
+}
+
+// ClearCharmSync removes the application's charm sync overlay, if any.
+// It is called when the application's charm is upgraded for real, at
+// which point the overlay is superseded by the new charm content.
+func (st *State) ClearCharmSync(appName string) error {
+	ops := []txn.Op{{
+		C:      charmSyncC,
+		Id:     st.docID(appName),
+		Remove: true,
+	}}
+	err := st.db().RunTransaction(ops)
+	if err == txn.ErrAborted {
+		return nil
+	}
+	return errors.Trace(err)
+}
+
+// WatchCharmSync returns a watcher that notifies of changes to the
+// application's charm sync overlay.
+func (a *Application) WatchCharmSync() NotifyWatcher {
+	return newEntityWatcher(a.st, charmSyncC, a.st.docID(a.doc.Name))
+}
@@ -0,0 +1,136 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state_test
+
+import (
+	"os"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/state"
+	statetesting "github.com/juju/juju/state/testing"
+)
+
+type CharmSyncSuite struct {
+	ConnSuite
+	app *state.Application
+}
+
+var _ = gc.Suite(&CharmSyncSuite{})
+
+func (s *CharmSyncSuite) SetUpTest(c *gc.C) {
+	s.ConnSuite.SetUpTest(c)
+	s.app = s.Factory.MakeApplication(c, nil)
+}
+
+func (s *CharmSyncSuite) TestUpdateCharmSyncCreates(c *gc.C) {
+	err := s.State.UpdateCharmSync(s.app.Name(), []state.CharmSyncFile{{
+		Path:    "src/charm.py",
+		Content: []byte("#!/usr/bin/env python3"),
+		Mode:    os.FileMode(0755),
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+
+	sync, err := s.State.CharmSync(s.app.Name())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(sync.ApplicationName(), gc.Equals, s.app.Name())
+	c.Assert(sync.Generation(), gc.Equals, 1)
+	c.Assert(sync.Files(), jc.DeepEquals, []state.CharmSyncFile{{
+		Path:    "src/charm.py",
+		Content: []byte("#!/usr/bin/env python3"),
+		Mode:    os.FileMode(0755),
+	}})
+}
+
+func (s *CharmSyncSuite) TestUpdateCharmSyncMergesAndBumpsGeneration(c *gc.C) {
+	err := s.State.UpdateCharmSync(s.app.Name(), []state.CharmSyncFile{
+		{Path: "src/charm.py", Content: []byte("v1"), Mode: os.FileMode(0755)},
+		{Path: "README.md", Content: []byte("readme"), Mode: os.FileMode(0644)},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.State.UpdateCharmSync(s.app.Name(), []state.CharmSyncFile{
+		{Path: "src/charm.py", Content: []byte("v2"), Mode: os.FileMode(0755)},
+		{Path: "README.md", Removed: true},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	sync, err := s.State.CharmSync(s.app.Name())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(sync.Generation(), gc.Equals, 2)
+	c.Assert(sync.Files(), jc.DeepEquals, []state.CharmSyncFile{
+		{Path: "src/charm.py", Content: []byte("v2"), Mode: os.FileMode(0755)},
+		{Path: "README.md", Removed: true},
+	})
+}
+
+func (s *CharmSyncSuite) TestUpdateCharmSyncValidatesPaths(c *gc.C) {
+	err := s.State.UpdateCharmSync(s.app.Name(), []state.CharmSyncFile{{
+		Path: "../outside",
+	}})
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+
+	err = s.State.UpdateCharmSync(s.app.Name(), []state.CharmSyncFile{{
+		Path: "/etc/passwd",
+	}})
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+}
+
+func (s *CharmSyncSuite) TestUpdateCharmSyncNoFiles(c *gc.C) {
+	err := s.State.UpdateCharmSync(s.app.Name(), nil)
+	c.Assert(err, gc.ErrorMatches, "no files to sync")
+}
+
+func (s *CharmSyncSuite) TestUpdateCharmSyncUnknownApplication(c *gc.C) {
+	err := s.State.UpdateCharmSync("no-such-app", []state.CharmSyncFile{{
+		Path: "hooks/install",
+	}})
+	c.Assert(errors.Cause(err), jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *CharmSyncSuite) TestCharmSyncNotFound(c *gc.C) {
+	_, err := s.State.CharmSync(s.app.Name())
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *CharmSyncSuite) TestClearCharmSync(c *gc.C) {
+	err := s.State.UpdateCharmSync(s.app.Name(), []state.CharmSyncFile{{
+		Path: "hooks/install", Content: []byte("#!/bin/sh"), Mode: os.FileMode(0755),
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.State.ClearCharmSync(s.app.Name())
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.State.CharmSync(s.app.Name())
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+
+	// Clearing an absent overlay is a no-op.
+	err = s.State.ClearCharmSync(s.app.Name())
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *CharmSyncSuite) TestWatchCharmSync(c *gc.C) {
+	w := s.app.WatchCharmSync()
+	defer statetesting.AssertStop(c, w)
+
+	// Initial event.
+	wc := statetesting.NewNotifyWatcherC(c, s.State, w)
+	wc.AssertOneChange()
+
+	err := s.State.UpdateCharmSync(s.app.Name(), []state.CharmSyncFile{{
+		Path: "hooks/install", Content: []byte("#!/bin/sh"), Mode: os.FileMode(0755),
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertOneChange()
+
+	err = s.State.UpdateCharmSync(s.app.Name(), []state.CharmSyncFile{{
+		Path: "hooks/install", Removed: true,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertOneChange()
+
+	statetesting.AssertStop(c, w)
+	wc.AssertClosed()
+}
@@ -61,6 +61,14 @@ const (
 	cleanupStorageForDyingModel  cleanupKind = "modelStorage"
 	cleanupForceStorage          cleanupKind = "forceStorage"
 	cleanupBranchesForDyingModel cleanupKind = "branches"
+
+	// cleanupUnitMove progresses a unit move operation towards removal
+	// of the original unit.
+	cleanupUnitMove cleanupKind = "unitMove"
+
+	// cleanupMoveStorage attaches storage to the destination unit of a
+	// storage move once its previous attachment has been removed.
+	cleanupMoveStorage cleanupKind = "moveStorage"
 )
 
 // cleanupDoc originally represented a set of documents that should be
@@ -206,6 +214,10 @@ func (st *State) Cleanup() (err error) {
 			err = st.cleanupForceStorage(args)
 		case cleanupBranchesForDyingModel:
 			err = st.cleanupBranchesForDyingModel(args)
+		case cleanupUnitMove:
+			err = st.cleanupUnitMove(doc.Prefix)
+		case cleanupMoveStorage:
+			err = st.cleanupMoveStorage(doc.Prefix, args)
 		default:
 			err = errors.Errorf("unknown cleanup kind %q", doc.Kind)
 		}
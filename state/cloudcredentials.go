@@ -35,6 +35,40 @@ func (c Credential) IsValid() bool {
 	return !c.cloudCredentialDoc.Invalid
 }
 
+// StagedContent returns the candidate credential content staged for
+// rotation, if any.
+func (c Credential) StagedContent() (cloud.Credential, bool) {
+	if c.cloudCredentialDoc.Staged == nil {
+		return cloud.Credential{}, false
+	}
+	return cloud.NewCredential(
+		cloud.AuthType(c.cloudCredentialDoc.Staged.AuthType),
+		c.cloudCredentialDoc.Staged.Attributes,
+	), true
+}
+
+// SetStagedContent stages candidate content on the credential value.
+// It only changes the in-memory value; staging content on a stored
+// credential is done with State.StageCloudCredential.
+func (c *Credential) SetStagedContent(credential cloud.Credential) {
+	c.cloudCredentialDoc.Staged = &stagedCredentialDoc{
+		AuthType:   string(credential.AuthType()),
+		Attributes: credential.Attributes(),
+	}
+}
+
+// RollbackContent returns the credential content that was active before
+// the last staged rotation was committed, if it is still retained.
+func (c Credential) RollbackContent() (cloud.Credential, bool) {
+	if c.cloudCredentialDoc.Rollback == nil {
+		return cloud.Credential{}, false
+	}
+	return cloud.NewCredential(
+		cloud.AuthType(c.cloudCredentialDoc.Rollback.AuthType),
+		c.cloudCredentialDoc.Rollback.Attributes,
+	), true
+}
+
 // cloudCredentialDoc records information about a user's cloud credentials.
 type cloudCredentialDoc struct {
 	DocID      string            `bson:"_id"`
@@ -60,6 +94,22 @@ type cloudCredentialDoc struct {
 	// This can range from cloud messages such as an expired credential to
 	// commercial reasons set via CLI or api calls.
 	InvalidReason string `bson:"invalid-reason,omitempty"`
+
+	// Staged holds candidate credential content uploaded as part of a
+	// staged rotation. It has no effect on the active content until it
+	// is committed.
+	Staged *stagedCredentialDoc `bson:"staged,omitempty"`
+
+	// Rollback holds the content that was active before the last staged
+	// rotation was committed, retained so the rotation can be rolled back.
+	Rollback *stagedCredentialDoc `bson:"rollback,omitempty"`
+}
+
+// stagedCredentialDoc records credential content used by the staged
+// rotation workflow, either a candidate or previously active content.
+type stagedCredentialDoc struct {
+	AuthType   string            `bson:"auth-type"`
+	Attributes map[string]string `bson:"attributes,omitempty"`
 }
 
 // CloudCredential returns the cloud credential for the given tag.
@@ -285,6 +335,139 @@ func (st *State) RemoveCloudCredential(tag names.CloudCredentialTag) error {
 	return nil
 }
 
+// StageCloudCredential stores candidate content for an existing cloud
+// credential without changing the active content. The candidate can later
+// be committed with CommitStagedCloudCredential or dropped with
+// DiscardStagedCloudCredential.
+func (st *State) StageCloudCredential(tag names.CloudCredentialTag, credential cloud.Credential) error {
+	candidate := map[names.CloudCredentialTag]Credential{
+		tag: convertCloudCredentialToState(tag, credential),
+	}
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		if _, err := st.CloudCredential(tag); err != nil {
+			return nil, errors.Trace(err)
+		}
+		aCloud, err := st.Cloud(tag.Cloud().Id())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		ops, err := validateCloudCredentials(aCloud, candidate)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		ops = append(ops, txn.Op{
+			C:      cloudCredentialsC,
+			Id:     cloudCredentialDocID(tag),
+			Assert: txn.DocExists,
+			Update: bson.D{{"$set", bson.D{{"staged", stagedCredentialDoc{
+				AuthType:   string(credential.AuthType()),
+				Attributes: credential.Attributes(),
+			}}}}},
+		})
+		return ops, nil
+	}
+	if err := st.db().Run(buildTxn); err != nil {
+		return errors.Annotatef(err, "staging cloud credential %v", tag.Id())
+	}
+	return nil
+}
+
+// CommitStagedCloudCredential atomically switches a cloud credential's
+// active content to the content previously staged for it, retaining the
+// old content so the switch can be rolled back. The credential is marked
+// valid as a result.
+func (st *State) CommitStagedCloudCredential(tag names.CloudCredentialTag) error {
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		existing, err := st.CloudCredential(tag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		staged := existing.cloudCredentialDoc.Staged
+		if staged == nil {
+			return nil, errors.NotFoundf("staged content for cloud credential %q", tag.Id())
+		}
+		return []txn.Op{{
+			C:      cloudCredentialsC,
+			Id:     cloudCredentialDocID(tag),
+			Assert: bson.D{{"staged.auth-type", staged.AuthType}},
+			Update: bson.D{
+				{"$set", bson.D{
+					{"auth-type", staged.AuthType},
+					{"attributes", staged.Attributes},
+					{"invalid", false},
+					{"invalid-reason", ""},
+					{"rollback", stagedCredentialDoc{
+						AuthType:   existing.AuthType,
+						Attributes: existing.Attributes,
+					}},
+				}},
+				{"$unset", bson.D{{"staged", nil}}},
+			},
+		}}, nil
+	}
+	if err := st.db().Run(buildTxn); err != nil {
+		return errors.Annotatef(err, "committing staged cloud credential %v", tag.Id())
+	}
+	return nil
+}
+
+// RollbackCloudCredential restores a cloud credential's active content to
+// the content it had before the last staged rotation was committed.
+func (st *State) RollbackCloudCredential(tag names.CloudCredentialTag) error {
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		existing, err := st.CloudCredential(tag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		rollback := existing.cloudCredentialDoc.Rollback
+		if rollback == nil {
+			return nil, errors.NotFoundf("rollback content for cloud credential %q", tag.Id())
+		}
+		return []txn.Op{{
+			C:      cloudCredentialsC,
+			Id:     cloudCredentialDocID(tag),
+			Assert: bson.D{{"rollback.auth-type", rollback.AuthType}},
+			Update: bson.D{
+				{"$set", bson.D{
+					{"auth-type", rollback.AuthType},
+					{"attributes", rollback.Attributes},
+					{"invalid", false},
+					{"invalid-reason", ""},
+				}},
+				{"$unset", bson.D{{"rollback", nil}}},
+			},
+		}}, nil
+	}
+	if err := st.db().Run(buildTxn); err != nil {
+		return errors.Annotatef(err, "rolling back cloud credential %v", tag.Id())
+	}
+	return nil
+}
+
+// DiscardStagedCloudCredential drops any candidate content staged for a
+// cloud credential without touching the active content.
+func (st *State) DiscardStagedCloudCredential(tag names.CloudCredentialTag) error {
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		existing, err := st.CloudCredential(tag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if existing.cloudCredentialDoc.Staged == nil {
+			return nil, jujutxn.ErrNoOperations
+		}
+		return []txn.Op{{
+			C:      cloudCredentialsC,
+			Id:     cloudCredentialDocID(tag),
+			Assert: txn.DocExists,
+			Update: bson.D{{"$unset", bson.D{{"staged", nil}}}},
+		}}, nil
+	}
+	if err := st.db().Run(buildTxn); err != nil {
+		return errors.Annotatef(err, "discarding staged cloud credential %v", tag.Id())
+	}
+	return nil
+}
+
 // createCloudCredentialOp returns a txn.Op that will create
 // a cloud credential.
 func createCloudCredentialOp(tag names.CloudCredentialTag, cred cloud.Credential) txn.Op {
@@ -447,6 +630,26 @@ func (st *State) AllCloudCredentials(user names.UserTag) ([]Credential, error) {
 	return credentials, nil
 }
 
+// AllControllerCloudCredentials returns the cloud credentials stored on
+// the controller for all users. The secret attribute values are included;
+// callers exposing the result outside the controller must redact them.
+func (st *State) AllControllerCloudCredentials() ([]Credential, error) {
+	coll, cleanup := st.db().GetCollection(cloudCredentialsC)
+	defer cleanup()
+
+	var docs []cloudCredentialDoc
+	err := coll.Find(nil).Sort("cloud").All(&docs)
+	if err != nil {
+		return nil, errors.Annotate(err, "getting all cloud credentials")
+	}
+
+	credentials := make([]Credential, len(docs))
+	for i, doc := range docs {
+		credentials[i] = Credential{doc}
+	}
+	return credentials, nil
+}
+
 func (st *State) modelsWithCredential(tag names.CloudCredentialTag) ([]modelDoc, error) {
 	coll, cleanup := st.db().GetCollection(modelsC)
 	defer cleanup()
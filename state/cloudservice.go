@@ -10,6 +10,7 @@ import (
 	"github.com/juju/mgo/v2/txn"
 
 	"github.com/juju/juju/core/network"
+	mongoutils "github.com/juju/juju/mongo/utils"
 )
 
 // CloudServicer represents the state of a CAAS service.
@@ -21,6 +22,14 @@ type CloudServicer interface {
 	// Addresses returns the service addresses.
 	Addresses() network.SpaceAddresses
 
+	// ExternalNames returns any DNS names by which the
+	// service is reachable from outside the cluster.
+	ExternalNames() []string
+
+	// PortAnnotations returns provider annotations
+	// keyed on service port name.
+	PortAnnotations() map[string]map[string]string
+
 	// Generation returns the service config generation.
 	Generation() int64
 
@@ -41,6 +50,14 @@ type cloudServiceDoc struct {
 	ProviderId string    `bson:"provider-id"`
 	Addresses  []address `bson:"addresses"`
 
+	// ExternalNames holds any DNS names by which the service
+	// is reachable from outside the cluster.
+	ExternalNames []string `bson:"external-names,omitempty"`
+
+	// PortAnnotations holds provider annotations keyed on service
+	// port name. Annotation keys are escaped for mongo storage.
+	PortAnnotations map[string]map[string]string `bson:"port-annotations,omitempty"`
+
 	// Generation is the version of current service configuration.
 	// It prevents the scale updated to replicas of the older/previous generations of deployment/statefulset.
 	// Currently only DesiredScale is versioned.
@@ -75,6 +92,46 @@ func (c *CloudService) Addresses() network.SpaceAddresses {
 	return networkAddresses(c.doc.Addresses)
 }
 
+// ExternalNames implements CloudServicer.
+func (c *CloudService) ExternalNames() []string {
+	return c.doc.ExternalNames
+}
+
+// PortAnnotations implements CloudServicer.
+func (c *CloudService) PortAnnotations() map[string]map[string]string {
+	return unescapePortAnnotations(c.doc.PortAnnotations)
+}
+
+func escapePortAnnotations(portAnnotations map[string]map[string]string) map[string]map[string]string {
+	if len(portAnnotations) == 0 {
+		return nil
+	}
+	escaped := make(map[string]map[string]string, len(portAnnotations))
+	for port, annotations := range portAnnotations {
+		escapedAnnotations := make(map[string]string, len(annotations))
+		for k, v := range annotations {
+			escapedAnnotations[mongoutils.EscapeKey(k)] = v
+		}
+		escaped[mongoutils.EscapeKey(port)] = escapedAnnotations
+	}
+	return escaped
+}
+
+func unescapePortAnnotations(portAnnotations map[string]map[string]string) map[string]map[string]string {
+	if len(portAnnotations) == 0 {
+		return nil
+	}
+	unescaped := make(map[string]map[string]string, len(portAnnotations))
+	for port, annotations := range portAnnotations {
+		unescapedAnnotations := make(map[string]string, len(annotations))
+		for k, v := range annotations {
+			unescapedAnnotations[mongoutils.UnescapeKey(k)] = v
+		}
+		unescaped[mongoutils.UnescapeKey(port)] = unescapedAnnotations
+	}
+	return unescaped
+}
+
 // Generation implements CloudServicer.
 func (c *CloudService) Generation() int64 {
 	return c.doc.Generation
@@ -145,6 +202,12 @@ func buildCloudServiceOps(st *State, doc cloudServiceDoc) ([]txn.Op, error) {
 	if len(doc.Addresses) > 0 {
 		addField(bson.DocElem{"addresses", doc.Addresses})
 	}
+	if len(doc.ExternalNames) > 0 {
+		addField(bson.DocElem{"external-names", doc.ExternalNames})
+	}
+	if len(doc.PortAnnotations) > 0 {
+		addField(bson.DocElem{"port-annotations", doc.PortAnnotations})
+	}
 	if doc.Generation > existing.Generation {
 		addField(bson.DocElem{"generation", doc.Generation})
 	}
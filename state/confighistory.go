@@ -0,0 +1,134 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/juju/charm/v9"
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2"
+	"github.com/juju/mgo/v2/bson"
+
+	"github.com/juju/juju/core/model"
+)
+
+// maxConfigHistorySize bounds the number of config revisions retained
+// per application. Older revisions are dropped as new ones are recorded.
+const maxConfigHistorySize = 20
+
+// configHistoryDoc records one application charm config change.
+type configHistoryDoc struct {
+	DocID       string `bson:"_id"`
+	ModelUUID   string `bson:"model-uuid"`
+	Application string `bson:"application"`
+	Revision    int    `bson:"revision"`
+	UpdatedBy   string `bson:"updated-by"`
+	Updated     int64  `bson:"updated"`
+
+	// Changes holds the values that the change set, keyed by option
+	// name. Previous holds the values those options had before the
+	// change; a nil value means the option was unset.
+	Changes  map[string]interface{} `bson:"changes"`
+	Previous map[string]interface{} `bson:"previous"`
+}
+
+// ConfigHistoryEntry describes one recorded application charm config
+// change.
+type ConfigHistoryEntry struct {
+	Revision  int
+	UpdatedBy string
+	Updated   time.Time
+	Changes   charm.Settings
+	Previous  charm.Settings
+}
+
+// recordConfigHistory appends an entry to the application's config
+// history and trims revisions beyond the retained bound. History is
+// advisory; failures here must not fail the config change itself.
+func (a *Application) recordConfigHistory(updatedBy string, changes, previous charm.Settings) error {
+	coll, closer := a.st.db().GetCollection(configHistoryC)
+	defer closer()
+
+	revision := 1
+	var latest configHistoryDoc
+	err := coll.Find(bson.D{{"application", a.doc.Name}}).Sort("-revision").One(&latest)
+	if err == nil {
+		revision = latest.Revision + 1
+	} else if err != mgo.ErrNotFound {
+		return errors.Trace(err)
+	}
+
+	w := coll.Writeable()
+	err = w.Insert(configHistoryDoc{
+		DocID:       fmt.Sprintf("%s#%d", a.doc.Name, revision),
+		Application: a.doc.Name,
+		Revision:    revision,
+		UpdatedBy:   updatedBy,
+		Updated:     a.st.clock().Now().UnixNano(),
+		Changes:     changes,
+		Previous:    previous,
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	if revision > maxConfigHistorySize {
+		_, err := w.RemoveAll(bson.D{
+			{"application", a.doc.Name},
+			{"revision", bson.D{{"$lte", revision - maxConfigHistorySize}}},
+		})
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// ConfigHistory returns the application's recorded charm config
+// changes, newest first. A limit of zero returns the full retained
+// history.
+func (a *Application) ConfigHistory(limit int) ([]ConfigHistoryEntry, error) {
+	coll, closer := a.st.db().GetCollection(configHistoryC)
+	defer closer()
+
+	query := coll.Find(bson.D{{"application", a.doc.Name}}).Sort("-revision")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	var docs []configHistoryDoc
+	if err := query.All(&docs); err != nil {
+		return nil, errors.Annotatef(err, "config history for application %q", a.doc.Name)
+	}
+
+	entries := make([]ConfigHistoryEntry, len(docs))
+	for i, doc := range docs {
+		entries[i] = ConfigHistoryEntry{
+			Revision:  doc.Revision,
+			UpdatedBy: doc.UpdatedBy,
+			Updated:   time.Unix(0, doc.Updated),
+			Changes:   charm.Settings(doc.Changes),
+			Previous:  charm.Settings(doc.Previous),
+		}
+	}
+	return entries, nil
+}
+
+// RollbackCharmConfig reverts the config change recorded as the given
+// revision by reapplying the values it replaced. The rollback is itself
+// recorded as a new revision attributed to the given user.
+func (a *Application) RollbackCharmConfig(revision int, updatedBy string) error {
+	coll, closer := a.st.db().GetCollection(configHistoryC)
+	defer closer()
+
+	var doc configHistoryDoc
+	err := coll.Find(bson.D{{"application", a.doc.Name}, {"revision", revision}}).One(&doc)
+	if err == mgo.ErrNotFound {
+		return errors.NotFoundf("config revision %d for application %q", revision, a.doc.Name)
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(a.UpdateCharmConfigAs(model.GenerationMaster, updatedBy, charm.Settings(doc.Previous)))
+}
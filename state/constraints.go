@@ -26,6 +26,7 @@ type constraintsDoc struct {
 	RootDisk         *uint64
 	RootDiskSource   *string
 	InstanceType     *string
+	PlacementGroup   *string
 	Container        *instance.ContainerType
 	Tags             *[]string
 	Spaces           *[]string
@@ -44,6 +45,7 @@ func newConstraintsDoc(cons constraints.Value, id string) constraintsDoc {
 		RootDisk:         cons.RootDisk,
 		RootDiskSource:   cons.RootDiskSource,
 		InstanceType:     cons.InstanceType,
+		PlacementGroup:   cons.PlacementGroup,
 		Container:        cons.Container,
 		Tags:             cons.Tags,
 		Spaces:           cons.Spaces,
@@ -63,6 +65,7 @@ func (doc constraintsDoc) value() constraints.Value {
 		RootDisk:         doc.RootDisk,
 		RootDiskSource:   doc.RootDiskSource,
 		InstanceType:     doc.InstanceType,
+		PlacementGroup:   doc.PlacementGroup,
 		Container:        doc.Container,
 		Tags:             doc.Tags,
 		Spaces:           doc.Spaces,
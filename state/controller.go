@@ -14,6 +14,7 @@ import (
 	"github.com/juju/names/v4"
 
 	jujucontroller "github.com/juju/juju/controller"
+	"github.com/juju/juju/state/docstore"
 )
 
 const (
@@ -135,6 +136,18 @@ func (st *State) checkValidControllerConfig(updateAttrs map[string]interface{},
 				return errors.Annotatef(err, "invalid config %q=%q", k, cVal)
 			}
 		}
+
+		if k == jujucontroller.StateStorageBackend {
+			cVal, ok := updateAttrs[k].(string)
+			if !ok {
+				return errors.NotValidf("non-string %q value", k)
+			}
+			// A backend this binary cannot serve would leave the
+			// document store unreadable, so refuse it up front.
+			if cVal != docstore.MongoBackend && !docstore.SQLBackendAvailable() {
+				return errors.Errorf("cannot set %q to %q: no SQL document store is available in this binary", k, cVal)
+			}
+		}
 	}
 	for _, r := range removeAttrs {
 		if err := checkUpdateControllerConfig(r); err != nil {
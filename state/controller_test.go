@@ -61,6 +61,10 @@ func (s *ControllerSuite) TestControllerAndModelConfigInitialisation(c *gc.C) {
 		controller.PublicDNSAddress,
 		controller.MaxCharmStateSize,
 		controller.MaxAgentStateSize,
+		controller.MaxRelationSettingsSize,
+		controller.ControllerResourceDownloadLimit,
+		controller.ApplicationResourceDownloadLimit,
+		controller.BackupStorageURL,
 		controller.NonSyncedWritesToRaftLog,
 		controller.MigrationMinionWaitMax,
 	)
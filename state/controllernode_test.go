@@ -4,6 +4,7 @@
 package state_test
 
 import (
+	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
@@ -53,3 +54,39 @@ func (s *ControllerNodeSuite) TestAgentTools(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 	testAgentTools(c, node, "controller "+node.Id())
 }
+
+func (s *ControllerNodeSuite) TestDrainControllerNode(c *gc.C) {
+	node0, err := s.State.AddControllerNode()
+	c.Assert(err, jc.ErrorIsNil)
+	node1, err := s.State.AddControllerNode()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(node0.IsDraining(), jc.IsFalse)
+
+	err = s.State.DrainControllerNode(node0.Id())
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(node0.Refresh(), jc.ErrorIsNil)
+	c.Assert(node0.IsDraining(), jc.IsTrue)
+	c.Assert(node0.WantsVote(), jc.IsFalse)
+	c.Assert(node1.Refresh(), jc.ErrorIsNil)
+	c.Assert(node1.WantsVote(), jc.IsTrue)
+
+	// Draining again is a no-op.
+	err = s.State.DrainControllerNode(node0.Id())
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *ControllerNodeSuite) TestDrainControllerNodeOnlyVoter(c *gc.C) {
+	node, err := s.State.AddControllerNode()
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.State.DrainControllerNode(node.Id())
+	c.Assert(err, gc.ErrorMatches, "cannot drain controller node 0: controller node 0 is the only one that wants a vote")
+	c.Assert(node.Refresh(), jc.ErrorIsNil)
+	c.Assert(node.IsDraining(), jc.IsFalse)
+}
+
+func (s *ControllerNodeSuite) TestDrainControllerNodeNotFound(c *gc.C) {
+	err := s.State.DrainControllerNode("42")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
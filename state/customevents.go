@@ -0,0 +1,169 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2"
+	"github.com/juju/mgo/v2/bson"
+	"github.com/juju/mgo/v2/txn"
+	"github.com/juju/names/v4"
+)
+
+// customEventDoc describes a charm-defined custom event that has been
+// queued for a unit or application. Unlike actions, custom events carry
+// no results; they are consumed by the uniter, which dispatches a
+// corresponding charm hook.
+type customEventDoc struct {
+	// DocId is the model-scoped event id.
+	DocId string `bson:"_id"`
+
+	// ModelUUID is the model identifier.
+	ModelUUID string `bson:"model-uuid"`
+
+	// Receiver is the name of the unit or application the event is
+	// queued for.
+	Receiver string `bson:"receiver"`
+
+	// Name identifies the event; it should match a custom event
+	// defined by the receiver's charm.
+	Name string `bson:"name"`
+
+	// Params holds any parameters passed when the event was triggered.
+	Params map[string]interface{} `bson:"params,omitempty"`
+
+	// Enqueued is the time the event was added.
+	Enqueued time.Time `bson:"enqueued"`
+}
+
+// CustomEvent represents a charm-defined custom event queued for a unit
+// or application.
+type CustomEvent struct {
+	st  *State
+	doc customEventDoc
+}
+
+// Id returns the id of the event.
+func (e *CustomEvent) Id() string {
+	return e.st.localID(e.doc.DocId)
+}
+
+// Receiver returns the name of the unit or application the event is
+// queued for.
+func (e *CustomEvent) Receiver() string {
+	return e.doc.Receiver
+}
+
+// Name returns the name of the event.
+func (e *CustomEvent) Name() string {
+	return e.doc.Name
+}
+
+// Params returns the parameters the event was triggered with.
+func (e *CustomEvent) Params() map[string]interface{} {
+	return e.doc.Params
+}
+
+// Enqueued returns the time the event was added.
+func (e *CustomEvent) Enqueued() time.Time {
+	return e.doc.Enqueued
+}
+
+// EnqueueCustomEvent queues a custom event for the unit or application
+// identified by the given tag.
+func (st *State) EnqueueCustomEvent(receiver names.Tag, name string, params map[string]interface{}) (*CustomEvent, error) {
+	var receiverOp txn.Op
+	switch tag := receiver.(type) {
+	case names.UnitTag:
+		receiverOp = txn.Op{
+			C:      unitsC,
+			Id:     tag.Id(),
+			Assert: isAliveDoc,
+		}
+	case names.ApplicationTag:
+		receiverOp = txn.Op{
+			C:      applicationsC,
+			Id:     st.docID(tag.Id()),
+			Assert: isAliveDoc,
+		}
+	default:
+		return nil, errors.NotValidf("custom event receiver tag type %T", receiver)
+	}
+
+	id, err := sequence(st, "customevent")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	eventId := strconv.Itoa(id)
+	doc := customEventDoc{
+		DocId:     st.docID(eventId),
+		ModelUUID: st.ModelUUID(),
+		Receiver:  receiver.Id(),
+		Name:      name,
+		Params:    params,
+		Enqueued:  st.nowToTheSecond(),
+	}
+	ops := []txn.Op{receiverOp, {
+		C:      customEventsC,
+		Id:     doc.DocId,
+		Assert: txn.DocMissing,
+		Insert: doc,
+	}}
+	if err := st.db().RunTransaction(ops); err != nil {
+		if err == txn.ErrAborted {
+			return nil, errors.Errorf("%s %q not found or not alive", receiver.Kind(), receiver.Id())
+		}
+		return nil, errors.Annotatef(err, "cannot enqueue custom event %q for %q", name, receiver.Id())
+	}
+	return &CustomEvent{st: st, doc: doc}, nil
+}
+
+// CustomEvent returns the custom event with the given id.
+func (st *State) CustomEvent(id string) (*CustomEvent, error) {
+	events, closer := st.db().GetCollection(customEventsC)
+	defer closer()
+
+	var doc customEventDoc
+	err := events.FindId(st.docID(id)).One(&doc)
+	if err == mgo.ErrNotFound {
+		return nil, errors.NotFoundf("custom event %q", id)
+	}
+	if err != nil {
+		return nil, errors.Annotatef(err, "cannot get custom event %q", id)
+	}
+	return &CustomEvent{st: st, doc: doc}, nil
+}
+
+// PendingCustomEvents returns the custom events queued for the given
+// unit or application name, oldest first.
+func (st *State) PendingCustomEvents(receiver string) ([]*CustomEvent, error) {
+	events, closer := st.db().GetCollection(customEventsC)
+	defer closer()
+
+	var docs []customEventDoc
+	err := events.Find(bson.D{{"receiver", receiver}}).Sort("enqueued", "_id").All(&docs)
+	if err != nil {
+		return nil, errors.Annotatef(err, "cannot get custom events for %q", receiver)
+	}
+	result := make([]*CustomEvent, len(docs))
+	for i, doc := range docs {
+		result[i] = &CustomEvent{st: st, doc: doc}
+	}
+	return result, nil
+}
+
+// Consume removes the event from the queue once the uniter has
+// dispatched its hook. It is not an error to consume an event that has
+// already been removed.
+func (e *CustomEvent) Consume() error {
+	ops := []txn.Op{{
+		C:      customEventsC,
+		Id:     e.doc.DocId,
+		Remove: true,
+	}}
+	return errors.Annotatef(e.st.db().RunTransaction(ops), "cannot consume custom event %q", e.Id())
+}
@@ -0,0 +1,173 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2"
+	"github.com/juju/mgo/v2/bson"
+	"github.com/juju/mgo/v2/txn"
+	"github.com/juju/names/v4"
+	jujutxn "github.com/juju/txn/v2"
+)
+
+// disabledWorkersDoc holds the names of the agent workers that have
+// been administratively disabled for a single entity - a machine or
+// the model - keyed by the entity's tag.
+type disabledWorkersDoc struct {
+	DocID     string `bson:"_id"`
+	ModelUUID string `bson:"model-uuid"`
+
+	EntityTag string   `bson:"entity-tag"`
+	Workers   []string `bson:"workers"`
+}
+
+// checkDisabledWorkersTag ensures that the input tag identifies this
+// model or one of its machines.
+func (st *State) checkDisabledWorkersTag(tag names.Tag) error {
+	switch tag := tag.(type) {
+	case names.ModelTag:
+		if tag.Id() != st.ModelUUID() {
+			return errors.NotFoundf("model %q", tag.Id())
+		}
+	case names.MachineTag:
+		if _, err := st.Machine(tag.Id()); err != nil {
+			return errors.Trace(err)
+		}
+	default:
+		return errors.NotSupportedf("disabling workers for %q", tag)
+	}
+	return nil
+}
+
+// SetDisabledWorkers replaces the set of agent workers disabled for the
+// given machine or model. Agents stop the named workers without being
+// restarted or reconfigured. Passing no workers re-enables everything.
+func (st *State) SetDisabledWorkers(tag names.Tag, workers []string) error {
+	if err := st.checkDisabledWorkersTag(tag); err != nil {
+		return errors.Trace(err)
+	}
+	for _, name := range workers {
+		if name == "" {
+			return errors.NotValidf("empty worker name")
+		}
+	}
+	workers = set.NewStrings(workers...).SortedValues()
+
+	docID := st.docID(tag.String())
+	buildTxn := func(int) ([]txn.Op, error) {
+		col, closer := st.db().GetCollection(disabledWorkersC)
+		defer closer()
+
+		count, err := col.FindId(docID).Count()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if len(workers) == 0 {
+			if count == 0 {
+				return nil, jujutxn.ErrNoOperations
+			}
+			return []txn.Op{{
+				C:      disabledWorkersC,
+				Id:     docID,
+				Assert: txn.DocExists,
+				Remove: true,
+			}}, nil
+		}
+		if count == 0 {
+			return []txn.Op{{
+				C:      disabledWorkersC,
+				Id:     docID,
+				Assert: txn.DocMissing,
+				Insert: &disabledWorkersDoc{
+					DocID:     docID,
+					ModelUUID: st.ModelUUID(),
+					EntityTag: tag.String(),
+					Workers:   workers,
+				},
+			}}, nil
+		}
+		return []txn.Op{{
+			C:      disabledWorkersC,
+			Id:     docID,
+			Assert: txn.DocExists,
+			Update: bson.D{{"$set", bson.D{{"workers", workers}}}},
+		}}, nil
+	}
+	return errors.Annotatef(st.db().Run(buildTxn), "setting disabled workers for %q", tag)
+}
+
+// DisabledWorkers returns the agent workers disabled directly for the
+// given machine or model. An entity with no disabled workers yields an
+// empty result without error.
+func (st *State) DisabledWorkers(tag names.Tag) ([]string, error) {
+	if err := st.checkDisabledWorkersTag(tag); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	col, closer := st.db().GetCollection(disabledWorkersC)
+	defer closer()
+
+	var doc disabledWorkersDoc
+	err := col.FindId(st.docID(tag.String())).One(&doc)
+	if err == mgo.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Annotatef(err, "getting disabled workers for %q", tag)
+	}
+	return doc.Workers, nil
+}
+
+// AllDisabledWorkers returns the disabled agent workers for every
+// entity in the model that has any, keyed by entity tag.
+func (st *State) AllDisabledWorkers() (map[string][]string, error) {
+	col, closer := st.db().GetCollection(disabledWorkersC)
+	defer closer()
+
+	var docs []disabledWorkersDoc
+	if err := col.Find(nil).All(&docs); err != nil {
+		return nil, errors.Annotate(err, "getting all disabled workers")
+	}
+	result := make(map[string][]string, len(docs))
+	for _, doc := range docs {
+		result[doc.EntityTag] = doc.Workers
+	}
+	return result, nil
+}
+
+// MachineDisabledWorkers returns the union of the agent workers
+// disabled for the given machine and those disabled model-wide,
+// sorted by name.
+func (st *State) MachineDisabledWorkers(machineId string) ([]string, error) {
+	all := set.NewStrings()
+	for _, tag := range []names.Tag{
+		names.NewMachineTag(machineId),
+		names.NewModelTag(st.ModelUUID()),
+	} {
+		workers, err := st.DisabledWorkers(tag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		all = all.Union(set.NewStrings(workers...))
+	}
+	if all.IsEmpty() {
+		return nil, nil
+	}
+	return all.SortedValues(), nil
+}
+
+// WatchDisabledWorkers returns a watcher that notifies when the set of
+// agent workers disabled for the given machine changes - via either the
+// machine's own entry or the model-wide one.
+func (st *State) WatchDisabledWorkers(tag names.MachineTag) NotifyWatcher {
+	return newDocWatcher(st, []docKey{{
+		disabledWorkersC,
+		st.docID(names.NewModelTag(st.ModelUUID()).String()),
+	}, {
+		disabledWorkersC,
+		st.docID(tag.String()),
+	}})
+}
@@ -0,0 +1,144 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state_test
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/state/testing"
+)
+
+type DisabledWorkersSuite struct {
+	ConnSuite
+
+	machine *state.Machine
+}
+
+var _ = gc.Suite(&DisabledWorkersSuite{})
+
+func (s *DisabledWorkersSuite) SetUpTest(c *gc.C) {
+	s.ConnSuite.SetUpTest(c)
+	s.machine = s.Factory.MakeMachine(c, nil)
+}
+
+func (s *DisabledWorkersSuite) TestNoneDisabled(c *gc.C) {
+	workers, err := s.State.DisabledWorkers(s.machine.Tag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(workers, gc.HasLen, 0)
+}
+
+func (s *DisabledWorkersSuite) TestSetDisabledWorkers(c *gc.C) {
+	err := s.State.SetDisabledWorkers(s.machine.Tag(), []string{
+		"instance-mutater", "fan-configurer",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	workers, err := s.State.DisabledWorkers(s.machine.Tag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(workers, jc.DeepEquals, []string{"fan-configurer", "instance-mutater"})
+}
+
+func (s *DisabledWorkersSuite) TestSetDisabledWorkersReplaces(c *gc.C) {
+	err := s.State.SetDisabledWorkers(s.machine.Tag(), []string{"fan-configurer"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.State.SetDisabledWorkers(s.machine.Tag(), []string{"disk-manager"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	workers, err := s.State.DisabledWorkers(s.machine.Tag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(workers, jc.DeepEquals, []string{"disk-manager"})
+}
+
+func (s *DisabledWorkersSuite) TestSetDisabledWorkersEmptyRemoves(c *gc.C) {
+	err := s.State.SetDisabledWorkers(s.machine.Tag(), []string{"fan-configurer"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.State.SetDisabledWorkers(s.machine.Tag(), nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	workers, err := s.State.DisabledWorkers(s.machine.Tag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(workers, gc.HasLen, 0)
+
+	all, err := s.State.AllDisabledWorkers()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(all, gc.HasLen, 0)
+}
+
+func (s *DisabledWorkersSuite) TestSetDisabledWorkersEmptyName(c *gc.C) {
+	err := s.State.SetDisabledWorkers(s.machine.Tag(), []string{""})
+	c.Assert(err, gc.ErrorMatches, "empty worker name not valid")
+}
+
+func (s *DisabledWorkersSuite) TestSetDisabledWorkersUnsupportedEntity(c *gc.C) {
+	err := s.State.SetDisabledWorkers(names.NewUnitTag("mysql/0"), []string{"uniter"})
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+}
+
+func (s *DisabledWorkersSuite) TestSetDisabledWorkersUnknownMachine(c *gc.C) {
+	err := s.State.SetDisabledWorkers(names.NewMachineTag("42"), []string{"fan-configurer"})
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *DisabledWorkersSuite) TestMachineDisabledWorkersUnion(c *gc.C) {
+	model, err := s.State.Model()
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.State.SetDisabledWorkers(model.ModelTag(), []string{"instance-mutater"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.State.SetDisabledWorkers(s.machine.Tag(), []string{"fan-configurer"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	workers, err := s.State.MachineDisabledWorkers(s.machine.Id())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(workers, jc.DeepEquals, []string{"fan-configurer", "instance-mutater"})
+}
+
+func (s *DisabledWorkersSuite) TestAllDisabledWorkers(c *gc.C) {
+	model, err := s.State.Model()
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.State.SetDisabledWorkers(model.ModelTag(), []string{"instance-mutater"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.State.SetDisabledWorkers(s.machine.Tag(), []string{"fan-configurer"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	all, err := s.State.AllDisabledWorkers()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(all, jc.DeepEquals, map[string][]string{
+		model.ModelTag().String(): {"instance-mutater"},
+		s.machine.Tag().String():  {"fan-configurer"},
+	})
+}
+
+func (s *DisabledWorkersSuite) TestWatchDisabledWorkers(c *gc.C) {
+	w := s.State.WatchDisabledWorkers(s.machine.Tag().(names.MachineTag))
+	defer testing.AssertStop(c, w)
+
+	// Initial event.
+	wc := testing.NewNotifyWatcherC(c, s.State, w)
+	wc.AssertOneChange()
+
+	// A change to the machine's own entry notifies.
+	err := s.State.SetDisabledWorkers(s.machine.Tag(), []string{"fan-configurer"})
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertOneChange()
+
+	// A model-wide change notifies too.
+	model, err := s.State.Model()
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.State.SetDisabledWorkers(model.ModelTag(), []string{"instance-mutater"})
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertOneChange()
+
+	// Another machine's entry does not.
+	other := s.Factory.MakeMachine(c, nil)
+	err = s.State.SetDisabledWorkers(other.Tag(), []string{"disk-manager"})
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertNoChange()
+
+	testing.AssertStop(c, w)
+	wc.AssertClosed()
+}
@@ -0,0 +1,103 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+/*
+Package docstore provides a minimal document-store abstraction for the
+newest state subsystems (secrets, firewall rules, external controllers)
+as a stepping stone towards the dqlite database transition. Documents
+are opaque blobs keyed by collection and id; subsystems keep ownership
+of their own serialization. Both a mongo and a dqlite-ready SQL
+implementation are provided, selectable via the controller config
+state-storage-backend attribute, so subsystems can move between
+backends without a big-bang rewrite.
+*/
+package docstore
+
+import (
+	"github.com/juju/errors"
+)
+
+const (
+	// MongoBackend selects the mongo document store implementation.
+	MongoBackend = "mongo"
+
+	// DqliteBackend selects the SQL document store implementation,
+	// backed by a dqlite database on the controller.
+	DqliteBackend = "dqlite"
+
+	// SecretValues is the collection holding secret value blobs.
+	// It is named after, and replaces, the raw mongo collection the
+	// secrets subsystem used before moving to the document store.
+	SecretValues = "secretValues"
+
+	// LeaseHolders is the collection holding the document store
+	// mirror of the lease holder records. The raw mongo leaseholders
+	// collection stays authoritative for the leadership transaction
+	// assertions as long as state transactions run on mongo; this
+	// mirror is what the dqlite backend reads.
+	LeaseHolders = "leaseHolders"
+)
+
+// ManagedCollections returns the names of the collections whose
+// documents are written through this package, and which therefore
+// carry the opaque blob shape the migrator can copy faithfully
+// between backends.
+func ManagedCollections() []string {
+	return []string{LeaseHolders, SecretValues}
+}
+
+// ValidateBackend returns an error if the given backend kind is not
+// one this package can provide.
+func ValidateBackend(kind string) error {
+	switch kind {
+	case MongoBackend, DqliteBackend:
+		return nil
+	}
+	return errors.NotValidf("storage backend %q", kind)
+}
+
+// Store is a minimal document store. Implementations must return a
+// NotFound error from Get, Update and Remove when no document has the
+// given id.
+type Store interface {
+	// Insert adds a new document. It returns an AlreadyExists error
+	// if a document with the same id is present.
+	Insert(collection, id string, data []byte) error
+
+	// Get returns the document with the given id.
+	Get(collection, id string) ([]byte, error)
+
+	// Update replaces the document with the given id.
+	Update(collection, id string, data []byte) error
+
+	// Remove deletes the document with the given id.
+	Remove(collection, id string) error
+
+	// All returns every document in the collection, keyed by id.
+	All(collection string) (map[string][]byte, error)
+}
+
+// Migrate copies every document in the named collections from src to
+// dst, replacing documents that already exist in dst. It returns the
+// number of documents copied. Migration is idempotent, so an
+// interrupted run can simply be repeated.
+func Migrate(src, dst Store, collections []string) (int, error) {
+	migrated := 0
+	for _, collection := range collections {
+		docs, err := src.All(collection)
+		if err != nil {
+			return migrated, errors.Annotatef(err, "reading %q documents", collection)
+		}
+		for id, data := range docs {
+			err := dst.Insert(collection, id, data)
+			if errors.IsAlreadyExists(err) {
+				err = dst.Update(collection, id, data)
+			}
+			if err != nil {
+				return migrated, errors.Annotatef(err, "writing %q document %q", collection, id)
+			}
+			migrated++
+		}
+	}
+	return migrated, nil
+}
@@ -0,0 +1,121 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package docstore_test
+
+import (
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/state/docstore"
+)
+
+type DocStoreSuite struct{}
+
+var _ = gc.Suite(&DocStoreSuite{})
+
+// memStore is a simple in-memory Store used to exercise the
+// backend-agnostic helpers.
+type memStore struct {
+	collections map[string]map[string][]byte
+}
+
+func newMemStore() *memStore {
+	return &memStore{collections: make(map[string]map[string][]byte)}
+}
+
+func (s *memStore) collection(name string) map[string][]byte {
+	if s.collections[name] == nil {
+		s.collections[name] = make(map[string][]byte)
+	}
+	return s.collections[name]
+}
+
+func (s *memStore) Insert(collection, id string, data []byte) error {
+	docs := s.collection(collection)
+	if _, ok := docs[id]; ok {
+		return errors.AlreadyExistsf("document %q", id)
+	}
+	docs[id] = data
+	return nil
+}
+
+func (s *memStore) Get(collection, id string) ([]byte, error) {
+	data, ok := s.collection(collection)[id]
+	if !ok {
+		return nil, errors.NotFoundf("document %q", id)
+	}
+	return data, nil
+}
+
+func (s *memStore) Update(collection, id string, data []byte) error {
+	docs := s.collection(collection)
+	if _, ok := docs[id]; !ok {
+		return errors.NotFoundf("document %q", id)
+	}
+	docs[id] = data
+	return nil
+}
+
+func (s *memStore) Remove(collection, id string) error {
+	docs := s.collection(collection)
+	if _, ok := docs[id]; !ok {
+		return errors.NotFoundf("document %q", id)
+	}
+	delete(docs, id)
+	return nil
+}
+
+func (s *memStore) All(collection string) (map[string][]byte, error) {
+	docs := s.collection(collection)
+	result := make(map[string][]byte, len(docs))
+	for id, data := range docs {
+		result[id] = data
+	}
+	return result, nil
+}
+
+func (s *DocStoreSuite) TestValidateBackend(c *gc.C) {
+	c.Check(docstore.ValidateBackend("mongo"), jc.ErrorIsNil)
+	c.Check(docstore.ValidateBackend("dqlite"), jc.ErrorIsNil)
+	c.Check(docstore.ValidateBackend("postgres"), gc.ErrorMatches, `storage backend "postgres" not valid`)
+}
+
+func (s *DocStoreSuite) TestMigrate(c *gc.C) {
+	src := newMemStore()
+	c.Assert(src.Insert("secrets", "a", []byte("secret-a")), jc.ErrorIsNil)
+	c.Assert(src.Insert("secrets", "b", []byte("secret-b")), jc.ErrorIsNil)
+	c.Assert(src.Insert("firewallRules", "ssh", []byte("0.0.0.0/0")), jc.ErrorIsNil)
+	c.Assert(src.Insert("ignored", "x", []byte("nope")), jc.ErrorIsNil)
+
+	dst := newMemStore()
+	migrated, err := docstore.Migrate(src, dst, []string{"secrets", "firewallRules"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(migrated, gc.Equals, 3)
+
+	data, err := dst.Get("secrets", "a")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(data), gc.Equals, "secret-a")
+	_, err = dst.Get("ignored", "x")
+	c.Check(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *DocStoreSuite) TestMigrateIdempotent(c *gc.C) {
+	src := newMemStore()
+	c.Assert(src.Insert("secrets", "a", []byte("old")), jc.ErrorIsNil)
+
+	dst := newMemStore()
+	_, err := docstore.Migrate(src, dst, []string{"secrets"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// A repeated run replaces what is already there.
+	c.Assert(src.Update("secrets", "a", []byte("new")), jc.ErrorIsNil)
+	migrated, err := docstore.Migrate(src, dst, []string{"secrets"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(migrated, gc.Equals, 1)
+
+	data, err := dst.Get("secrets", "a")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(data), gc.Equals, "new")
+}
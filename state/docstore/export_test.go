@@ -0,0 +1,13 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package docstore
+
+// ResetSQLOpener clears the registered SQL opener and the shared
+// store so tests can exercise registration from a clean slate.
+func ResetSQLOpener() {
+	sqlMu.Lock()
+	defer sqlMu.Unlock()
+	sqlOpener = nil
+	sqlShared = nil
+}
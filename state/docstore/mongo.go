@@ -0,0 +1,80 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package docstore
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2"
+	"github.com/juju/mgo/v2/bson"
+)
+
+// mongoDoc is the shape documents take in mongo collections managed
+// by this package.
+type mongoDoc struct {
+	ID   string `bson:"_id"`
+	Data []byte `bson:"data"`
+}
+
+type mongoStore struct {
+	db *mgo.Database
+}
+
+// NewMongoStore returns a Store persisting documents in collections of
+// the given mongo database.
+func NewMongoStore(db *mgo.Database) Store {
+	return &mongoStore{db: db}
+}
+
+// Insert implements Store.
+func (s *mongoStore) Insert(collection, id string, data []byte) error {
+	err := s.db.C(collection).Insert(mongoDoc{ID: id, Data: data})
+	if mgo.IsDup(err) {
+		return errors.AlreadyExistsf("document %q", id)
+	}
+	return errors.Trace(err)
+}
+
+// Get implements Store.
+func (s *mongoStore) Get(collection, id string) ([]byte, error) {
+	var doc mongoDoc
+	err := s.db.C(collection).FindId(id).One(&doc)
+	if err == mgo.ErrNotFound {
+		return nil, errors.NotFoundf("document %q", id)
+	}
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return doc.Data, nil
+}
+
+// Update implements Store.
+func (s *mongoStore) Update(collection, id string, data []byte) error {
+	err := s.db.C(collection).UpdateId(id, bson.M{"$set": bson.M{"data": data}})
+	if err == mgo.ErrNotFound {
+		return errors.NotFoundf("document %q", id)
+	}
+	return errors.Trace(err)
+}
+
+// Remove implements Store.
+func (s *mongoStore) Remove(collection, id string) error {
+	err := s.db.C(collection).RemoveId(id)
+	if err == mgo.ErrNotFound {
+		return errors.NotFoundf("document %q", id)
+	}
+	return errors.Trace(err)
+}
+
+// All implements Store.
+func (s *mongoStore) All(collection string) (map[string][]byte, error) {
+	var docs []mongoDoc
+	if err := s.db.C(collection).Find(nil).All(&docs); err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := make(map[string][]byte, len(docs))
+	for _, doc := range docs {
+		result[doc.ID] = doc.Data
+	}
+	return result, nil
+}
@@ -0,0 +1,157 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package docstore
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/juju/errors"
+)
+
+// validCollectionName constrains collection names to characters that
+// are safe to interpolate into a table name. Collection names come
+// from juju code, never from users, so a violation is a bug.
+var validCollectionName = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
+
+type sqlStore struct {
+	db *sql.DB
+
+	mu      sync.Mutex
+	created map[string]bool
+}
+
+// NewSQLStore returns a Store persisting documents in tables of the
+// given SQL database, one table per collection. The database handle is
+// expected to be backed by dqlite on the controller, but any
+// database/sql driver with standard syntax will do, which keeps the
+// implementation testable without the real cluster.
+func NewSQLStore(db *sql.DB) Store {
+	return &sqlStore{
+		db:      db,
+		created: make(map[string]bool),
+	}
+}
+
+// table returns the table name for a collection, creating the table
+// on first use.
+func (s *sqlStore) table(collection string) (string, error) {
+	if !validCollectionName.MatchString(collection) {
+		return "", errors.NotValidf("collection name %q", collection)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.created[collection] {
+		_, err := s.db.Exec(fmt.Sprintf(
+			"CREATE TABLE IF NOT EXISTS %s (id TEXT PRIMARY KEY, data BLOB)", collection))
+		if err != nil {
+			return "", errors.Annotatef(err, "creating table for collection %q", collection)
+		}
+		s.created[collection] = true
+	}
+	return collection, nil
+}
+
+// Insert implements Store.
+func (s *sqlStore) Insert(collection, id string, data []byte) error {
+	table, err := s.table(collection)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	// Rely on the primary key constraint to reject duplicates
+	// atomically; a racing insert could slip between a separate
+	// existence check and the write.
+	_, err = s.db.Exec(fmt.Sprintf("INSERT INTO %s (id, data) VALUES (?, ?)", table), id, data)
+	if err == nil {
+		return nil
+	}
+	// The error shape of a constraint violation is driver-specific,
+	// so classify it by looking for the conflicting row instead.
+	if _, getErr := s.Get(collection, id); getErr == nil {
+		return errors.AlreadyExistsf("document %q", id)
+	}
+	return errors.Trace(err)
+}
+
+// Get implements Store.
+func (s *sqlStore) Get(collection, id string) ([]byte, error) {
+	table, err := s.table(collection)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var data []byte
+	err = s.db.QueryRow(fmt.Sprintf("SELECT data FROM %s WHERE id = ?", table), id).Scan(&data)
+	if err == sql.ErrNoRows {
+		return nil, errors.NotFoundf("document %q", id)
+	}
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return data, nil
+}
+
+// Update implements Store.
+func (s *sqlStore) Update(collection, id string, data []byte) error {
+	table, err := s.table(collection)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	result, err := s.db.Exec(fmt.Sprintf("UPDATE %s SET data = ? WHERE id = ?", table), data, id)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if affected == 0 {
+		return errors.NotFoundf("document %q", id)
+	}
+	return nil
+}
+
+// Remove implements Store.
+func (s *sqlStore) Remove(collection, id string) error {
+	table, err := s.table(collection)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	result, err := s.db.Exec(fmt.Sprintf("DELETE FROM %s WHERE id = ?", table), id)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if affected == 0 {
+		return errors.NotFoundf("document %q", id)
+	}
+	return nil
+}
+
+// All implements Store.
+func (s *sqlStore) All(collection string) (map[string][]byte, error) {
+	table, err := s.table(collection)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	rows, err := s.db.Query(fmt.Sprintf("SELECT id, data FROM %s", table))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer rows.Close()
+	result := make(map[string][]byte)
+	for rows.Next() {
+		var id string
+		var data []byte
+		if err := rows.Scan(&id, &data); err != nil {
+			return nil, errors.Trace(err)
+		}
+		result[id] = data
+	}
+	return result, errors.Trace(rows.Err())
+}
@@ -0,0 +1,65 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package docstore
+
+import (
+	"database/sql"
+	"sync"
+
+	"github.com/juju/errors"
+)
+
+var (
+	sqlMu     sync.Mutex
+	sqlOpener func() (*sql.DB, error)
+	sqlShared Store
+)
+
+// RegisterSQLOpener registers the function used to open the SQL
+// database backing the dqlite document store. It is intended to be
+// called once, from an init function of the package that links the
+// dqlite driver into the binary; this package deliberately depends on
+// no driver itself. Registering a second opener panics, mirroring
+// database/sql driver registration.
+func RegisterSQLOpener(open func() (*sql.DB, error)) {
+	sqlMu.Lock()
+	defer sqlMu.Unlock()
+	if open == nil {
+		panic("docstore: RegisterSQLOpener with nil opener")
+	}
+	if sqlOpener != nil {
+		panic("docstore: SQL opener already registered")
+	}
+	sqlOpener = open
+}
+
+// SQLBackendAvailable reports whether an SQL opener has been
+// registered, and so whether this binary can actually serve the
+// dqlite storage backend.
+func SQLBackendAvailable() bool {
+	sqlMu.Lock()
+	defer sqlMu.Unlock()
+	return sqlOpener != nil
+}
+
+// SQLStore returns the shared SQL document store, opening the
+// database on first use. Every caller sees the same store, so the
+// subsystems reading and writing documents and the migrator copying
+// them always agree on the underlying database.
+func SQLStore() (Store, error) {
+	sqlMu.Lock()
+	defer sqlMu.Unlock()
+	if sqlShared != nil {
+		return sqlShared, nil
+	}
+	if sqlOpener == nil {
+		return nil, errors.NotSupportedf("dqlite document store (no SQL opener registered)")
+	}
+	db, err := sqlOpener()
+	if err != nil {
+		return nil, errors.Annotate(err, "opening document store database")
+	}
+	sqlShared = NewSQLStore(db)
+	return sqlShared, nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package docstore_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/state/docstore"
+)
+
+type SQLOpenerSuite struct{}
+
+var _ = gc.Suite(&SQLOpenerSuite{})
+
+func (s *SQLOpenerSuite) SetUpTest(c *gc.C) {
+	docstore.ResetSQLOpener()
+}
+
+func (s *SQLOpenerSuite) TearDownTest(c *gc.C) {
+	docstore.ResetSQLOpener()
+}
+
+// stubConnector is a driver.Connector that is never actually asked to
+// connect; it just lets the tests mint a *sql.DB without a driver.
+type stubConnector struct{}
+
+func (stubConnector) Connect(context.Context) (driver.Conn, error) {
+	return nil, errors.New("not connectable")
+}
+
+func (stubConnector) Driver() driver.Driver {
+	return nil
+}
+
+func (s *SQLOpenerSuite) TestSQLBackendAvailable(c *gc.C) {
+	c.Check(docstore.SQLBackendAvailable(), jc.IsFalse)
+	docstore.RegisterSQLOpener(func() (*sql.DB, error) {
+		return sql.OpenDB(stubConnector{}), nil
+	})
+	c.Check(docstore.SQLBackendAvailable(), jc.IsTrue)
+}
+
+func (s *SQLOpenerSuite) TestSQLStoreWithoutOpener(c *gc.C) {
+	_, err := docstore.SQLStore()
+	c.Check(err, jc.Satisfies, errors.IsNotSupported)
+}
+
+func (s *SQLOpenerSuite) TestSQLStoreShared(c *gc.C) {
+	opened := 0
+	docstore.RegisterSQLOpener(func() (*sql.DB, error) {
+		opened++
+		return sql.OpenDB(stubConnector{}), nil
+	})
+	first, err := docstore.SQLStore()
+	c.Assert(err, jc.ErrorIsNil)
+	second, err := docstore.SQLStore()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(second, gc.Equals, first)
+	c.Check(opened, gc.Equals, 1)
+}
+
+func (s *SQLOpenerSuite) TestSQLStoreOpenError(c *gc.C) {
+	docstore.RegisterSQLOpener(func() (*sql.DB, error) {
+		return nil, errors.New("boom")
+	})
+	_, err := docstore.SQLStore()
+	c.Check(err, gc.ErrorMatches, "opening document store database: boom")
+}
+
+func (s *SQLOpenerSuite) TestRegisterTwicePanics(c *gc.C) {
+	open := func() (*sql.DB, error) {
+		return sql.OpenDB(stubConnector{}), nil
+	}
+	docstore.RegisterSQLOpener(open)
+	c.Check(func() { docstore.RegisterSQLOpener(open) }, gc.PanicMatches,
+		"docstore: SQL opener already registered")
+}
@@ -0,0 +1,126 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/lease"
+	"github.com/juju/juju/core/raftlease"
+	"github.com/juju/juju/state/docstore"
+	raftleasestore "github.com/juju/juju/state/raftlease"
+)
+
+// leaseHolderBlob is the document store shape of a lease holder
+// record. Unlike the raw leaseholders collection it is an opaque JSON
+// blob, so the migrator can copy it between backends faithfully.
+type leaseHolderBlob struct {
+	Namespace string `json:"namespace"`
+	ModelUUID string `json:"model-uuid"`
+	Lease     string `json:"lease"`
+	Holder    string `json:"holder"`
+}
+
+// leaseHolderBlobId mirrors the _id scheme of the raw leaseholders
+// collection so documents stay correlated across backends.
+func leaseHolderBlobId(key lease.Key) string {
+	return fmt.Sprintf("%s:%s#%s#", key.ModelUUID, key.Namespace, key.Lease)
+}
+
+// docstoreLeaseTarget is a raftlease.NotifyTarget that applies lease
+// changes to the raw mongo collection and mirrors them into the
+// document store. The raw collection stays authoritative for the
+// assertion ops built by LeaseTrapdoorFunc, which tie into mgo/txn
+// state transactions; the mirror is what serves reads when the
+// controller is configured for a non-mongo storage backend.
+type docstoreLeaseTarget struct {
+	st     *State
+	mongo  raftlease.NotifyTarget
+	logger raftleasestore.Logger
+}
+
+// Claimed is part of raftlease.NotifyTarget.
+func (t *docstoreLeaseTarget) Claimed(key lease.Key, holder string) {
+	t.mongo.Claimed(key, holder)
+
+	docId := leaseHolderBlobId(key)
+	blob, err := json.Marshal(leaseHolderBlob{
+		Namespace: key.Namespace,
+		ModelUUID: key.ModelUUID,
+		Lease:     key.Lease,
+		Holder:    holder,
+	})
+	if err != nil {
+		t.logger.Errorf("couldn't mirror claim of lease %q for %q: %s", docId, holder, err.Error())
+		return
+	}
+	store, err := t.st.DocStore()
+	if err != nil {
+		t.logger.Errorf("couldn't mirror claim of lease %q for %q: %s", docId, holder, err.Error())
+		return
+	}
+	err = store.Update(docstore.LeaseHolders, docId, blob)
+	if errors.IsNotFound(err) {
+		err = store.Insert(docstore.LeaseHolders, docId, blob)
+	}
+	if err != nil {
+		t.logger.Errorf("couldn't mirror claim of lease %q for %q: %s", docId, holder, err.Error())
+	}
+}
+
+// Expired is part of raftlease.NotifyTarget.
+func (t *docstoreLeaseTarget) Expired(key lease.Key) {
+	t.mongo.Expired(key)
+
+	docId := leaseHolderBlobId(key)
+	store, err := t.st.DocStore()
+	if err != nil {
+		t.logger.Errorf("couldn't mirror expiry of lease %q: %s", docId, err.Error())
+		return
+	}
+	if err := store.Remove(docstore.LeaseHolders, docId); err != nil && !errors.IsNotFound(err) {
+		t.logger.Errorf("couldn't mirror expiry of lease %q: %s", docId, err.Error())
+	}
+}
+
+// leaseHolders returns the lease holders in the given namespace for
+// this model. On the mongo backend it reads the raw leaseholders
+// collection as it always has; on other backends it reads the
+// document store mirror maintained by the lease notify target.
+func (st *State) leaseHolders(namespace string) (map[string]string, error) {
+	controllerConfig, err := st.ControllerConfig()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if controllerConfig.StateStorageBackend() == docstore.MongoBackend {
+		return raftleasestore.LeaseHolders(
+			&environMongo{st},
+			leaseHoldersC,
+			namespace,
+			st.ModelUUID(),
+		)
+	}
+	store, err := st.DocStore()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	docs, err := store.All(docstore.LeaseHolders)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := make(map[string]string)
+	for docId, data := range docs {
+		var doc leaseHolderBlob
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return nil, errors.Annotatef(err, "decoding lease holder %q", docId)
+		}
+		if doc.Namespace == namespace && doc.ModelUUID == st.ModelUUID() {
+			result[doc.Lease] = doc.Holder
+		}
+	}
+	return result, nil
+}
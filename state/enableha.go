@@ -92,6 +92,37 @@ func (st *State) maintainControllersOps(newIds []string, bootstrapOnly bool) ([]
 func (st *State) EnableHA(
 	numControllers int, cons constraints.Value, series string, placement []string,
 ) (ControllersChanges, error) {
+	return st.enableHA(numControllers, cons, series, placement, "")
+}
+
+// EnableHAReplacing is like EnableHA, but additionally replaces the
+// controller machine with the given id, whose host is assumed to be dead.
+// The machine is force destroyed if it is still alive, its controller node
+// stops wanting a vote, and a replacement machine is added in the same
+// transaction. The peergrouper then removes the dead peer from the replica
+// set and raft configuration, and the cleanup worker reaps the machine as
+// it would after a forced removal.
+func (st *State) EnableHAReplacing(
+	numControllers int, cons constraints.Value, series string, placement []string, replaceId string,
+) (ControllersChanges, error) {
+	m, err := st.Machine(replaceId)
+	if err != nil {
+		return ControllersChanges{}, errors.Trace(err)
+	}
+	if !m.IsManager() {
+		return ControllersChanges{}, errors.Errorf("machine %s is not a controller", replaceId)
+	}
+	if m.Life() == Alive {
+		if err := m.ForceDestroy(0); err != nil {
+			return ControllersChanges{}, errors.Annotatef(err, "cannot destroy controller machine %s", replaceId)
+		}
+	}
+	return st.enableHA(numControllers, cons, series, placement, replaceId)
+}
+
+func (st *State) enableHA(
+	numControllers int, cons constraints.Value, series string, placement []string, replaceId string,
+) (ControllersChanges, error) {
 
 	if numControllers < 0 || (numControllers != 0 && numControllers%2 != 1) {
 		return ControllersChanges{}, errors.New("number of controllers must be odd and non-negative")
@@ -125,13 +156,27 @@ func (st *State) EnableHA(
 		if err != nil {
 			return nil, err
 		}
+		var replaceOps []txn.Op
+		if replaceId != "" {
+			// The machine being replaced must not be maintained as a
+			// voting controller; demote its node if it still wants a vote.
+			var maintain []ControllerNode
+			for _, node := range intent.maintain {
+				if node.Id() == replaceId {
+					replaceOps = append(replaceOps, setControllerWantsVoteOp(st, replaceId, false))
+					continue
+				}
+				maintain = append(maintain, node)
+			}
+			intent.maintain = maintain
+		}
 		voteCount := 0
 		for _, m := range intent.maintain {
 			if m.WantsVote() {
 				voteCount++
 			}
 		}
-		if voteCount == desiredControllerCount {
+		if voteCount == desiredControllerCount && len(replaceOps) == 0 {
 			return nil, jujutxn.ErrNoOperations
 		}
 
@@ -146,7 +191,14 @@ func (st *State) EnableHA(
 
 		var ops []txn.Op
 		ops, change, err = st.enableHAIntentionOps(intent, cons, series)
-		return ops, err
+		if err != nil {
+			return nil, err
+		}
+		if replaceId != "" {
+			ops = append(ops, replaceOps...)
+			change.Removed = append(change.Removed, replaceId)
+		}
+		return ops, nil
 	}
 	if err := st.db().Run(buildTxn); err != nil {
 		err = errors.Annotate(err, "failed to create new controller machines")
@@ -398,6 +450,7 @@ type ControllerNode interface {
 	WantsVote() bool
 	HasVote() bool
 	SetHasVote(hasVote bool) error
+	IsDraining() bool
 	Watch() NotifyWatcher
 	SetMongoPassword(password string) error
 }
@@ -455,6 +508,54 @@ func (st *State) ControllerNode(id string) (ControllerNode, error) {
 	return &controllerNode{*cdoc, st}, nil
 }
 
+// DrainControllerNode flags the controller node with the given id as
+// draining ahead of maintenance and withdraws its desire to vote, so
+// that the peergrouper hands its replica set vote, and with it any
+// singular worker leases, to another controller. A node that wants a
+// vote can only be drained while at least one other node also wants
+// one; the drain is complete once the node no longer has a vote.
+func (st *State) DrainControllerNode(id string) error {
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		doc, err := st.getControllerNodeDoc(id)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if doc.Draining && !doc.WantsVote {
+			return nil, jujutxn.ErrNoOperations
+		}
+		ops := []txn.Op{{
+			C:      controllerNodesC,
+			Id:     doc.DocID,
+			Assert: bson.D{{"wants-vote", doc.WantsVote}},
+			Update: bson.D{{"$set", bson.D{{"draining", true}, {"wants-vote", false}}}},
+		}}
+		if doc.WantsVote {
+			// Make sure another voter remains to take over.
+			nodes, err := st.ControllerNodes()
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			var otherVoter *controllerNode
+			for _, node := range nodes {
+				if node.Id() != id && node.WantsVote() {
+					otherVoter = node
+					break
+				}
+			}
+			if otherVoter == nil {
+				return nil, errors.Errorf("controller node %s is the only one that wants a vote", id)
+			}
+			ops = append(ops, txn.Op{
+				C:      controllerNodesC,
+				Id:     otherVoter.doc.DocID,
+				Assert: bson.D{{"wants-vote", true}},
+			})
+		}
+		return ops, nil
+	}
+	return errors.Annotatef(st.db().Run(buildTxn), "cannot drain controller node %s", id)
+}
+
 // AddControllerNode creates a new controller node.
 func (st *State) AddControllerNode() (*controllerNode, error) {
 	seq, err := sequence(st, "controller")
@@ -544,6 +645,7 @@ type controllerNodeDoc struct {
 	DocID        string       `bson:"_id"`
 	HasVote      bool         `bson:"has-vote"`
 	WantsVote    bool         `bson:"wants-vote"`
+	Draining     bool         `bson:"draining,omitempty"`
 	PasswordHash string       `bson:"password-hash"`
 	AgentVersion *tools.Tools `bson:"agent-version,omitempty"`
 }
@@ -665,6 +767,12 @@ func (c *controllerNode) HasVote() bool {
 	return c.doc.HasVote
 }
 
+// IsDraining reports whether the controller node has been flagged
+// for draining ahead of maintenance.
+func (c *controllerNode) IsDraining() bool {
+	return c.doc.Draining
+}
+
 // SetHasVote sets whether the controller is currently a voting
 // member of the replica set. It should only be called
 // from the worker that maintains the replica set.
@@ -531,6 +531,54 @@ func (s *EnableHASuite) TestForceDestroyFromHA(c *gc.C) {
 	c.Assert(node.WantsVote(), jc.IsFalse)
 }
 
+func (s *EnableHASuite) TestEnableHAReplacing(c *gc.C) {
+	m0, err := s.State.AddMachine("bionic", state.JobHostUnits, state.JobManageModel)
+	c.Assert(err, jc.ErrorIsNil)
+	changes, err := s.State.EnableHA(3, constraints.Value{}, "bionic", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(changes.Added, gc.HasLen, 2)
+	s.assertControllerInfo(c, []string{"0", "1", "2"}, []string{"0", "1", "2"}, nil)
+	for _, id := range []string{"0", "1", "2"} {
+		node, err := s.State.ControllerNode(id)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Assert(node.SetHasVote(true), jc.ErrorIsNil)
+	}
+
+	// Pretend the host of machine 1 died, and replace it.
+	changes, err = s.State.EnableHAReplacing(0, constraints.Value{}, "bionic", nil, "1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(changes.Added, gc.DeepEquals, []string{"3"})
+	c.Check(changes.Removed, gc.DeepEquals, []string{"1"})
+	s.assertControllerInfo(c, []string{"0", "1", "2", "3"}, []string{"0", "2", "3"}, nil)
+
+	m1, err := s.State.Machine("1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(m1.Life(), gc.Equals, state.Dying)
+	node, err := s.State.ControllerNode("1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(node.WantsVote(), jc.IsFalse)
+	c.Check(m0.Refresh(), jc.ErrorIsNil)
+	c.Check(m0.Life(), gc.Equals, state.Alive)
+}
+
+func (s *EnableHASuite) TestEnableHAReplacingNotController(c *gc.C) {
+	_, err := s.State.AddMachine("bionic", state.JobHostUnits, state.JobManageModel)
+	c.Assert(err, jc.ErrorIsNil)
+	m1, err := s.State.AddMachine("bionic", state.JobHostUnits)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.State.EnableHAReplacing(0, constraints.Value{}, "bionic", nil, m1.Id())
+	c.Assert(err, gc.ErrorMatches, "machine 1 is not a controller")
+}
+
+func (s *EnableHASuite) TestEnableHAReplacingSoleController(c *gc.C) {
+	m0, err := s.State.AddMachine("bionic", state.JobHostUnits, state.JobManageModel)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.State.EnableHAReplacing(0, constraints.Value{}, "bionic", nil, m0.Id())
+	c.Assert(err, gc.ErrorMatches, "cannot destroy controller machine 0: controller 0 is the only controller")
+}
+
 func (s *EnableHASuite) TestDestroyRaceLastController(c *gc.C) {
 	m0, err := s.State.AddMachine("bionic", state.JobHostUnits, state.JobManageModel)
 	c.Assert(err, jc.ErrorIsNil)
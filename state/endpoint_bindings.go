@@ -5,6 +5,7 @@ package state
 
 import (
 	"fmt"
+	"path"
 
 	"github.com/juju/charm/v9"
 	"github.com/juju/collections/set"
@@ -15,6 +16,7 @@ import (
 	jujutxn "github.com/juju/txn/v2"
 
 	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/mongo/utils"
 )
 
@@ -489,9 +491,65 @@ func DefaultEndpointBindingsForCharm(st EndpointBinding, charmMeta *charm.Meta)
 	for name := range charmMeta.ExtraBindings {
 		bindings[name] = defaultBindingSpaceID
 	}
+	if err := applyModelDefaultBindings(st, bindings); err != nil {
+		return nil, errors.Trace(err)
+	}
 	return bindings, nil
 }
 
+// modelDefaultBindings is implemented by backends that can supply
+// model-level pattern → space default binding rules.
+type modelDefaultBindings interface {
+	defaultBindingRules() ([]config.DefaultBindingRule, error)
+}
+
+func (st *State) defaultBindingRules() ([]config.DefaultBindingRule, error) {
+	model, err := st.Model()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	cfg, err := model.Config()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return cfg.DefaultBindings(), nil
+}
+
+// applyModelDefaultBindings rebinds endpoints matching the model's
+// default-bindings rules. Rules are applied in order; the first pattern
+// matching an endpoint name wins. Rules naming unknown spaces are
+// ignored so a stale rule does not block deployment.
+func applyModelDefaultBindings(st EndpointBinding, bindings map[string]string) error {
+	resolver, ok := st.(modelDefaultBindings)
+	if !ok {
+		return nil
+	}
+	rules, err := resolver.defaultBindingRules()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+	spaceInfos, err := st.AllSpaceInfos()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for name := range bindings {
+		for _, rule := range rules {
+			matched, err := path.Match(rule.Pattern, name)
+			if err != nil || !matched {
+				continue
+			}
+			if info := spaceInfos.GetByName(rule.Space); info != nil {
+				bindings[name] = info.ID
+			}
+			break
+		}
+	}
+	return nil
+}
+
 // EndpointBinding are the methods necessary for exported methods of
 // Bindings to work.
 //
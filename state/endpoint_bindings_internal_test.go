@@ -0,0 +1,99 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/environs/config"
+)
+
+type ModelDefaultBindingsSuite struct{}
+
+var _ = gc.Suite(&ModelDefaultBindingsSuite{})
+
+// fakeBindingBackend implements EndpointBinding and supplies canned
+// model default binding rules.
+type fakeBindingBackend struct {
+	spaces network.SpaceInfos
+	rules  []config.DefaultBindingRule
+}
+
+func (f *fakeBindingBackend) AllSpaceInfos() (network.SpaceInfos, error) {
+	return f.spaces, nil
+}
+
+func (f *fakeBindingBackend) DefaultEndpointBindingSpace() (string, error) {
+	return network.AlphaSpaceId, nil
+}
+
+func (f *fakeBindingBackend) Space(id string) (*Space, error) {
+	return nil, nil
+}
+
+func (f *fakeBindingBackend) defaultBindingRules() ([]config.DefaultBindingRule, error) {
+	return f.rules, nil
+}
+
+func testBindingSpaces() network.SpaceInfos {
+	return network.SpaceInfos{
+		{ID: network.AlphaSpaceId, Name: network.AlphaSpaceName},
+		{ID: "1", Name: "internal"},
+		{ID: "2", Name: "public"},
+	}
+}
+
+func (s *ModelDefaultBindingsSuite) TestApplyFirstMatchingRuleWins(c *gc.C) {
+	backend := &fakeBindingBackend{
+		spaces: testBindingSpaces(),
+		rules: []config.DefaultBindingRule{
+			{Pattern: "db*", Space: "internal"},
+			{Pattern: "*", Space: "public"},
+		},
+	}
+	bindings := map[string]string{
+		"db-admin": network.AlphaSpaceId,
+		"website":  network.AlphaSpaceId,
+	}
+	err := applyModelDefaultBindings(backend, bindings)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(bindings, gc.DeepEquals, map[string]string{
+		"db-admin": "1",
+		"website":  "2",
+	})
+}
+
+func (s *ModelDefaultBindingsSuite) TestApplyUnknownSpaceIgnored(c *gc.C) {
+	backend := &fakeBindingBackend{
+		spaces: testBindingSpaces(),
+		rules: []config.DefaultBindingRule{
+			{Pattern: "db*", Space: "ghost"},
+			{Pattern: "*", Space: "public"},
+		},
+	}
+	bindings := map[string]string{
+		"db-admin": network.AlphaSpaceId,
+		"website":  network.AlphaSpaceId,
+	}
+	err := applyModelDefaultBindings(backend, bindings)
+	c.Assert(err, jc.ErrorIsNil)
+	// The first matching rule still wins, but as it names a space
+	// this model does not have, the endpoint keeps its binding.
+	c.Assert(bindings, gc.DeepEquals, map[string]string{
+		"db-admin": network.AlphaSpaceId,
+		"website":  "2",
+	})
+}
+
+func (s *ModelDefaultBindingsSuite) TestApplyNoRules(c *gc.C) {
+	backend := &fakeBindingBackend{spaces: testBindingSpaces()}
+	bindings := map[string]string{"db-admin": network.AlphaSpaceId}
+	err := applyModelDefaultBindings(backend, bindings)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(bindings, gc.DeepEquals, map[string]string{
+		"db-admin": network.AlphaSpaceId,
+	})
+}
@@ -26,6 +26,11 @@ var (
 	// scope due to a required and pre-existing subordinate unit that is not Alive.
 	// Once that subordinate has been removed, a new one can be created.
 	ErrCannotEnterScopeYet = stderrors.New("cannot enter scope yet: non-alive subordinate unit has not been removed")
+
+	// ErrRelationFrozen indicates that a relation unit failed to enter its
+	// scope because the relation has been frozen by an operator. Scope
+	// entry will be possible again once the relation is resumed.
+	ErrRelationFrozen = stderrors.New("cannot enter scope: relation is frozen")
 )
 
 // errCharmAlreadyUploaded is returned by UpdateUploadedCharm() when
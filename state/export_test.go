@@ -1085,3 +1085,33 @@ func (st *State) SetClockForTesting(clock clock.Clock) error {
 	}
 	return nil
 }
+
+// SetModelFeatureFlags writes the model's feature flag document
+// directly, bypassing validation, so that tests can simulate flags
+// that have since graduated.
+func SetModelFeatureFlags(m *Model, flags []string) error {
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		_, found, err := m.featureFlags()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if !found {
+			return []txn.Op{{
+				C:      modelFeatureFlagsC,
+				Id:     m.st.docID(modelGlobalKey),
+				Assert: txn.DocMissing,
+				Insert: &modelFeatureFlagsDoc{
+					ModelUUID: m.UUID(),
+					Flags:     flags,
+				},
+			}}, nil
+		}
+		return []txn.Op{{
+			C:      modelFeatureFlagsC,
+			Id:     m.st.docID(modelGlobalKey),
+			Assert: txn.DocExists,
+			Update: bson.D{{"$set", bson.D{{"flags", flags}}}},
+		}}, nil
+	}
+	return m.st.db().Run(buildTxn)
+}
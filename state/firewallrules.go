@@ -5,15 +5,21 @@ package state
 
 import (
 	"net"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/mgo/v2"
 	"github.com/juju/mgo/v2/bson"
 	"github.com/juju/mgo/v2/txn"
+	jujutxn "github.com/juju/txn/v2"
 
 	"github.com/juju/juju/core/network/firewall"
 )
 
+// maxFirewallRuleHistory is the number of historical changes retained
+// for each firewall rule.
+const maxFirewallRuleHistory = 10
+
 // FirewallRule instances describe the ingress networks
 // whitelist/blacklist for a given well known service.
 // Primarily, whitelisting allowed ingress subnets is the
@@ -31,6 +37,9 @@ type FirewallRule struct {
 	id               string
 	wellKnownService firewall.WellKnownServiceType
 	whitelistCIDRs   []string
+	disabled         bool
+	updatedBy        string
+	updated          time.Time
 }
 
 // NewFirewallRule will create a new FirewallRule using the service type and
@@ -58,10 +67,53 @@ func (f FirewallRule) WhitelistCIDRs() []string {
 	return f.whitelistCIDRs
 }
 
+// Disabled reports whether the rule is currently disabled. Disabled
+// rules are retained but ignored by the firewaller.
+func (f FirewallRule) Disabled() bool {
+	return f.disabled
+}
+
+// UpdatedBy returns who last changed the rule, if recorded.
+func (f FirewallRule) UpdatedBy() string {
+	return f.updatedBy
+}
+
+// UpdatedAt returns when the rule was last changed, if recorded.
+func (f FirewallRule) UpdatedAt() time.Time {
+	return f.updated
+}
+
+// FirewallRuleChange records a single historical change to a
+// firewall rule.
+type FirewallRuleChange struct {
+	// WhitelistCIDRs is the rule's whitelist after the change.
+	WhitelistCIDRs []string
+
+	// Disabled is the rule's disabled flag after the change.
+	Disabled bool
+
+	// UpdatedBy identifies who made the change.
+	UpdatedBy string
+
+	// Updated is when the change was made.
+	Updated time.Time
+}
+
 type firewallRulesDoc struct {
-	Id               string   `bson:"_id"`
-	WellKnownService string   `bson:"known-service"`
-	WhitelistCIDRS   []string `bson:"whitelist-cidrs"`
+	Id               string                  `bson:"_id"`
+	WellKnownService string                  `bson:"known-service"`
+	WhitelistCIDRS   []string                `bson:"whitelist-cidrs"`
+	Disabled         bool                    `bson:"disabled,omitempty"`
+	UpdatedBy        string                  `bson:"updated-by,omitempty"`
+	Updated          time.Time               `bson:"updated,omitempty"`
+	History          []firewallRuleChangeDoc `bson:"history,omitempty"`
+}
+
+type firewallRuleChangeDoc struct {
+	WhitelistCIDRS []string  `bson:"whitelist-cidrs"`
+	Disabled       bool      `bson:"disabled"`
+	UpdatedBy      string    `bson:"updated-by"`
+	Updated        time.Time `bson:"updated"`
 }
 
 func (r *firewallRulesDoc) toRule() *FirewallRule {
@@ -69,14 +121,19 @@ func (r *firewallRulesDoc) toRule() *FirewallRule {
 		id:               r.Id,
 		wellKnownService: firewall.WellKnownServiceType(r.WellKnownService),
 		whitelistCIDRs:   r.WhitelistCIDRS,
+		disabled:         r.Disabled,
+		updatedBy:        r.UpdatedBy,
+		updated:          r.Updated,
 	}
 }
 
 // FirewallRuler instances provide access to firewall rules in state.
 type FirewallRuler interface {
-	Save(service firewall.WellKnownServiceType, whiteListCidrs []string) (FirewallRule, error)
-	Rule(service firewall.WellKnownServiceType) (FirewallRule, error)
-	AllRules() ([]FirewallRule, error)
+	Save(rule FirewallRule, updatedBy string) error
+	SetEnabled(service firewall.WellKnownServiceType, enabled bool, updatedBy string) error
+	Rule(service firewall.WellKnownServiceType) (*FirewallRule, error)
+	AllRules() ([]*FirewallRule, error)
+	RuleHistory(service firewall.WellKnownServiceType) ([]FirewallRuleChange, error)
 }
 
 type firewallRulesState struct {
@@ -88,8 +145,10 @@ func NewFirewallRules(st *State) *firewallRulesState {
 	return &firewallRulesState{st: st}
 }
 
-// Save stores the specified firewall rule.
-func (fw *firewallRulesState) Save(rule FirewallRule) error {
+// Save stores the specified firewall rule, recording who made the
+// change. Saving an existing rule updates its whitelist but leaves
+// its disabled flag alone; use SetEnabled to change that.
+func (fw *firewallRulesState) Save(rule FirewallRule, updatedBy string) error {
 	if err := checkModelActive(fw.st); err != nil {
 		return errors.Trace(err)
 	}
@@ -103,32 +162,49 @@ func (fw *firewallRulesState) Save(rule FirewallRule) error {
 			}
 		}
 		serviceStr := string(rule.WellKnownService())
-		doc := firewallRulesDoc{
-			Id:               serviceStr,
-			WellKnownService: serviceStr,
-			WhitelistCIDRS:   rule.WhitelistCIDRs(),
-		}
+		now := fw.st.nowToTheSecond()
 
 		model, err := fw.st.Model()
 		if err != nil {
 			return nil, errors.Annotate(err, "failed to load model")
 		}
-		_, err = fw.Rule(rule.WellKnownService())
+		existing, err := fw.Rule(rule.WellKnownService())
 		if err != nil && !errors.IsNotFound(err) {
 			return nil, errors.Trace(err)
 		}
+		change := firewallRuleChangeDoc{
+			WhitelistCIDRS: rule.WhitelistCIDRs(),
+			UpdatedBy:      updatedBy,
+			Updated:        now,
+		}
 		var ops []txn.Op
 		if err == nil {
+			change.Disabled = existing.Disabled()
 			ops = []txn.Op{{
 				C:      firewallRulesC,
 				Id:     serviceStr,
 				Assert: txn.DocExists,
 				Update: bson.D{
-					{"$set", bson.D{{"whitelist-cidrs", rule.WhitelistCIDRs()}}},
+					{"$set", bson.D{
+						{"whitelist-cidrs", rule.WhitelistCIDRs()},
+						{"updated-by", updatedBy},
+						{"updated", now},
+					}},
+					{"$push", bson.D{{"history", bson.D{
+						{"$each", []firewallRuleChangeDoc{change}},
+						{"$slice", -maxFirewallRuleHistory},
+					}}}},
 				},
 			}}
 		} else {
-			doc.WhitelistCIDRS = rule.WhitelistCIDRs()
+			doc := firewallRulesDoc{
+				Id:               serviceStr,
+				WellKnownService: serviceStr,
+				WhitelistCIDRS:   rule.WhitelistCIDRs(),
+				UpdatedBy:        updatedBy,
+				Updated:          now,
+				History:          []firewallRuleChangeDoc{change},
+			}
 			ops = []txn.Op{{
 				C:      firewallRulesC,
 				Id:     doc.Id,
@@ -145,6 +221,80 @@ func (fw *firewallRulesState) Save(rule FirewallRule) error {
 	return nil
 }
 
+// SetEnabled enables or disables the firewall rule for the specified
+// service, recording who made the change. A disabled rule is ignored
+// by the firewaller but keeps its whitelist for later re-enabling.
+func (fw *firewallRulesState) SetEnabled(service firewall.WellKnownServiceType, enabled bool, updatedBy string) error {
+	if err := checkModelActive(fw.st); err != nil {
+		return errors.Trace(err)
+	}
+	buildTxn := func(int) ([]txn.Op, error) {
+		rule, err := fw.Rule(service)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if rule.Disabled() == !enabled {
+			return nil, jujutxn.ErrNoOperations
+		}
+		model, err := fw.st.Model()
+		if err != nil {
+			return nil, errors.Annotate(err, "failed to load model")
+		}
+		now := fw.st.nowToTheSecond()
+		change := firewallRuleChangeDoc{
+			WhitelistCIDRS: rule.WhitelistCIDRs(),
+			Disabled:       !enabled,
+			UpdatedBy:      updatedBy,
+			Updated:        now,
+		}
+		ops := []txn.Op{{
+			C:      firewallRulesC,
+			Id:     string(service),
+			Assert: txn.DocExists,
+			Update: bson.D{
+				{"$set", bson.D{
+					{"disabled", !enabled},
+					{"updated-by", updatedBy},
+					{"updated", now},
+				}},
+				{"$push", bson.D{{"history", bson.D{
+					{"$each", []firewallRuleChangeDoc{change}},
+					{"$slice", -maxFirewallRuleHistory},
+				}}}},
+			},
+		}}
+		return append(ops, model.assertActiveOp()), nil
+	}
+	err := fw.st.db().Run(buildTxn)
+	return errors.Annotatef(err, "failed to set firewall rule for %v enabled to %v", service, enabled)
+}
+
+// RuleHistory returns the recorded changes for the specified service's
+// firewall rule, oldest first.
+func (fw *firewallRulesState) RuleHistory(service firewall.WellKnownServiceType) ([]FirewallRuleChange, error) {
+	coll, closer := fw.st.db().GetCollection(firewallRulesC)
+	defer closer()
+
+	var doc firewallRulesDoc
+	err := coll.FindId(string(service)).One(&doc)
+	if err == mgo.ErrNotFound {
+		return nil, errors.NotFoundf("firewall rules for service %v", service)
+	}
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := make([]FirewallRuleChange, len(doc.History))
+	for i, change := range doc.History {
+		result[i] = FirewallRuleChange{
+			WhitelistCIDRs: change.WhitelistCIDRS,
+			Disabled:       change.Disabled,
+			UpdatedBy:      change.UpdatedBy,
+			Updated:        change.Updated,
+		}
+	}
+	return result, nil
+}
+
 // Remove deletes the specified firewall rule.
 func (fw *firewallRulesState) Remove(firewallRuleID firewall.WellKnownServiceType) error {
 	ops := []txn.Op{{
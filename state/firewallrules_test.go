@@ -13,6 +13,7 @@ import (
 
 	"github.com/juju/juju/core/network/firewall"
 	"github.com/juju/juju/state"
+	statetesting "github.com/juju/juju/state/testing"
 )
 
 type FirewallRulesSuite struct {
@@ -23,7 +24,7 @@ var _ = gc.Suite(&FirewallRulesSuite{})
 
 func (s *FirewallRulesSuite) TestSaveInvalidWhitelistCIDR(c *gc.C) {
 	rules := state.NewFirewallRules(s.State)
-	err := rules.Save(state.NewFirewallRule(firewall.JujuControllerRule, []string{"192.168.1"}))
+	err := rules.Save(state.NewFirewallRule(firewall.JujuControllerRule, []string{"192.168.1"}), "admin")
 	c.Assert(errors.Cause(err), gc.ErrorMatches, regexp.QuoteMeta(`CIDR "192.168.1" not valid`))
 }
 
@@ -44,14 +45,14 @@ func (s *FirewallRulesSuite) assertSavedRules(c *gc.C, service firewall.WellKnow
 
 func (s *FirewallRulesSuite) TestSaveInvalid(c *gc.C) {
 	rules := state.NewFirewallRules(s.State)
-	err := rules.Save(state.NewFirewallRule("foo", []string{"192.168.1.0/16"}))
+	err := rules.Save(state.NewFirewallRule("foo", []string{"192.168.1.0/16"}), "admin")
 	c.Assert(err, jc.Satisfies, errors.IsNotValid)
 	c.Assert(errors.Cause(err), gc.ErrorMatches, `well known service type "foo" not valid`)
 }
 
 func (s *FirewallRulesSuite) TestSave(c *gc.C) {
 	rules := state.NewFirewallRules(s.State)
-	err := rules.Save(state.NewFirewallRule(firewall.SSHRule, []string{"192.168.1.0/16"}))
+	err := rules.Save(state.NewFirewallRule(firewall.SSHRule, []string{"192.168.1.0/16"}), "admin")
 	c.Assert(err, jc.ErrorIsNil)
 	s.assertSavedRules(c, firewall.SSHRule, []string{"192.168.1.0/16"})
 }
@@ -59,16 +60,16 @@ func (s *FirewallRulesSuite) TestSave(c *gc.C) {
 func (s *FirewallRulesSuite) TestSaveIdempotent(c *gc.C) {
 	rules := state.NewFirewallRules(s.State)
 	rule := state.NewFirewallRule(firewall.SSHRule, []string{"192.168.1.0/16"})
-	err := rules.Save(rule)
+	err := rules.Save(rule, "admin")
 	c.Assert(err, jc.ErrorIsNil)
-	err = rules.Save(rule)
+	err = rules.Save(rule, "admin")
 	c.Assert(err, jc.ErrorIsNil)
 	s.assertSavedRules(c, firewall.SSHRule, []string{"192.168.1.0/16"})
 }
 
 func (s *FirewallRulesSuite) TestRule(c *gc.C) {
 	rules := state.NewFirewallRules(s.State)
-	err := rules.Save(state.NewFirewallRule(firewall.JujuApplicationOfferRule, []string{"192.168.1.0/16"}))
+	err := rules.Save(state.NewFirewallRule(firewall.JujuApplicationOfferRule, []string{"192.168.1.0/16"}), "admin")
 	c.Assert(err, jc.ErrorIsNil)
 	result, err := rules.Rule(firewall.JujuApplicationOfferRule)
 	c.Assert(err, jc.ErrorIsNil)
@@ -79,9 +80,9 @@ func (s *FirewallRulesSuite) TestRule(c *gc.C) {
 
 func (s *FirewallRulesSuite) TestAllRules(c *gc.C) {
 	rules := state.NewFirewallRules(s.State)
-	err := rules.Save(state.NewFirewallRule(firewall.JujuApplicationOfferRule, []string{"192.168.1.0/16"}))
+	err := rules.Save(state.NewFirewallRule(firewall.JujuApplicationOfferRule, []string{"192.168.1.0/16"}), "admin")
 	c.Assert(err, jc.ErrorIsNil)
-	err = rules.Save(state.NewFirewallRule(firewall.JujuControllerRule, []string{"192.168.2.0/16"}))
+	err = rules.Save(state.NewFirewallRule(firewall.JujuControllerRule, []string{"192.168.2.0/16"}), "admin")
 	c.Assert(err, jc.ErrorIsNil)
 	result, err := rules.AllRules()
 	c.Assert(err, jc.ErrorIsNil)
@@ -100,18 +101,126 @@ func (s *FirewallRulesSuite) TestAllRules(c *gc.C) {
 
 func (s *FirewallRulesSuite) TestUpdate(c *gc.C) {
 	rules := state.NewFirewallRules(s.State)
-	err := rules.Save(state.NewFirewallRule(firewall.JujuApplicationOfferRule, []string{"192.168.1.0/16"}))
+	err := rules.Save(state.NewFirewallRule(firewall.JujuApplicationOfferRule, []string{"192.168.1.0/16"}), "admin")
 	c.Assert(err, jc.ErrorIsNil)
-	err = rules.Save(state.NewFirewallRule(firewall.JujuApplicationOfferRule, []string{"192.168.2.0/16"}))
+	err = rules.Save(state.NewFirewallRule(firewall.JujuApplicationOfferRule, []string{"192.168.2.0/16"}), "admin")
 	c.Assert(err, jc.ErrorIsNil)
 	s.assertSavedRules(c, firewall.JujuApplicationOfferRule, []string{"192.168.2.0/16"})
 }
 
+func (s *FirewallRulesSuite) TestSaveRecordsAudit(c *gc.C) {
+	rules := state.NewFirewallRules(s.State)
+	err := rules.Save(state.NewFirewallRule(firewall.SSHRule, []string{"192.168.1.0/16"}), "fred")
+	c.Assert(err, jc.ErrorIsNil)
+	result, err := rules.Rule(firewall.SSHRule)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.UpdatedBy(), gc.Equals, "fred")
+	c.Assert(result.UpdatedAt().IsZero(), jc.IsFalse)
+	c.Assert(result.Disabled(), jc.IsFalse)
+}
+
+func (s *FirewallRulesSuite) TestSetEnabled(c *gc.C) {
+	rules := state.NewFirewallRules(s.State)
+	err := rules.Save(state.NewFirewallRule(firewall.SSHRule, []string{"192.168.1.0/16"}), "admin")
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = rules.SetEnabled(firewall.SSHRule, false, "mary")
+	c.Assert(err, jc.ErrorIsNil)
+	result, err := rules.Rule(firewall.SSHRule)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Disabled(), jc.IsTrue)
+	c.Assert(result.UpdatedBy(), gc.Equals, "mary")
+	// The whitelist is retained while the rule is disabled.
+	c.Assert(result.WhitelistCIDRs(), jc.DeepEquals, []string{"192.168.1.0/16"})
+
+	err = rules.SetEnabled(firewall.SSHRule, true, "mary")
+	c.Assert(err, jc.ErrorIsNil)
+	result, err = rules.Rule(firewall.SSHRule)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Disabled(), jc.IsFalse)
+}
+
+func (s *FirewallRulesSuite) TestSetEnabledIdempotent(c *gc.C) {
+	rules := state.NewFirewallRules(s.State)
+	err := rules.Save(state.NewFirewallRule(firewall.SSHRule, []string{"192.168.1.0/16"}), "admin")
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = rules.SetEnabled(firewall.SSHRule, true, "mary")
+	c.Assert(err, jc.ErrorIsNil)
+	result, err := rules.Rule(firewall.SSHRule)
+	c.Assert(err, jc.ErrorIsNil)
+	// The no-op change is not recorded as an update.
+	c.Assert(result.UpdatedBy(), gc.Equals, "admin")
+
+	// Disabling an already disabled rule succeeds without
+	// recording anything either.
+	err = rules.SetEnabled(firewall.SSHRule, false, "mary")
+	c.Assert(err, jc.ErrorIsNil)
+	err = rules.SetEnabled(firewall.SSHRule, false, "fred")
+	c.Assert(err, jc.ErrorIsNil)
+	result, err = rules.Rule(firewall.SSHRule)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Disabled(), jc.IsTrue)
+	c.Assert(result.UpdatedBy(), gc.Equals, "mary")
+}
+
+func (s *FirewallRulesSuite) TestSetEnabledNotFound(c *gc.C) {
+	rules := state.NewFirewallRules(s.State)
+	err := rules.SetEnabled(firewall.SSHRule, false, "mary")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *FirewallRulesSuite) TestRuleHistory(c *gc.C) {
+	rules := state.NewFirewallRules(s.State)
+	err := rules.Save(state.NewFirewallRule(firewall.SSHRule, []string{"192.168.1.0/16"}), "fred")
+	c.Assert(err, jc.ErrorIsNil)
+	err = rules.Save(state.NewFirewallRule(firewall.SSHRule, []string{"192.168.2.0/16"}), "mary")
+	c.Assert(err, jc.ErrorIsNil)
+	err = rules.SetEnabled(firewall.SSHRule, false, "admin")
+	c.Assert(err, jc.ErrorIsNil)
+
+	history, err := rules.RuleHistory(firewall.SSHRule)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(history, gc.HasLen, 3)
+	c.Assert(history[0].WhitelistCIDRs, jc.DeepEquals, []string{"192.168.1.0/16"})
+	c.Assert(history[0].UpdatedBy, gc.Equals, "fred")
+	c.Assert(history[0].Disabled, jc.IsFalse)
+	c.Assert(history[1].WhitelistCIDRs, jc.DeepEquals, []string{"192.168.2.0/16"})
+	c.Assert(history[1].UpdatedBy, gc.Equals, "mary")
+	c.Assert(history[2].Disabled, jc.IsTrue)
+	c.Assert(history[2].UpdatedBy, gc.Equals, "admin")
+}
+
+func (s *FirewallRulesSuite) TestRuleHistoryNotFound(c *gc.C) {
+	rules := state.NewFirewallRules(s.State)
+	_, err := rules.RuleHistory(firewall.SSHRule)
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *FirewallRulesSuite) TestWatchFirewallRules(c *gc.C) {
+	w := s.State.WatchFirewallRules()
+	defer statetesting.AssertStop(c, w)
+	wc := statetesting.NewStringsWatcherC(c, s.State, w)
+	wc.AssertChange()
+	wc.AssertNoChange()
+
+	rules := state.NewFirewallRules(s.State)
+	err := rules.Save(state.NewFirewallRule(firewall.SSHRule, []string{"192.168.1.0/16"}), "admin")
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertChange(string(firewall.SSHRule))
+	wc.AssertNoChange()
+
+	err = rules.SetEnabled(firewall.SSHRule, false, "admin")
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertChange(string(firewall.SSHRule))
+	wc.AssertNoChange()
+}
+
 func (s *FirewallRulesSuite) TestRemove(c *gc.C) {
 	rules := state.NewFirewallRules(s.State)
-	err := rules.Save(state.NewFirewallRule(firewall.SSHRule, []string{"192.168.1.0/16"}))
+	err := rules.Save(state.NewFirewallRule(firewall.SSHRule, []string{"192.168.1.0/16"}), "admin")
 	c.Assert(err, jc.ErrorIsNil)
-	err = rules.Save(state.NewFirewallRule(firewall.JujuControllerRule, []string{"192.168.1.1/24"}))
+	err = rules.Save(state.NewFirewallRule(firewall.JujuControllerRule, []string{"192.168.1.1/24"}), "admin")
 	c.Assert(err, jc.ErrorIsNil)
 
 	err = rules.Remove(firewall.SSHRule)
@@ -469,18 +469,21 @@ type LogRecord struct {
 // LogTailerParams specifies the filtering a LogTailer should apply to
 // logs in order to decide which to return.
 type LogTailerParams struct {
-	StartID       int64
-	StartTime     time.Time
-	MinLevel      loggo.Level
-	InitialLines  int
-	NoTail        bool
-	IncludeEntity []string
-	ExcludeEntity []string
-	IncludeModule []string
-	ExcludeModule []string
-	IncludeLabel  []string
-	ExcludeLabel  []string
-	Oplog         *mgo.Collection // For testing only
+	StartID            int64
+	StartTime          time.Time
+	MinLevel           loggo.Level
+	MaxLevel           loggo.Level
+	InitialLines       int
+	NoTail             bool
+	IncludeEntity      []string
+	ExcludeEntity      []string
+	IncludeModule      []string
+	ExcludeModule      []string
+	IncludeModuleRegex []string
+	ExcludeModuleRegex []string
+	IncludeLabel       []string
+	ExcludeLabel       []string
+	Oplog              *mgo.Collection // For testing only
 }
 
 // oplogOverlap is used to decide on the initial oplog timestamp to
@@ -780,8 +783,15 @@ func (t *logTailer) paramsToSelector(params LogTailerParams, prefix string) bson
 	if !params.StartTime.IsZero() {
 		sel = append(sel, bson.DocElem{"t", bson.M{"$gte": params.StartTime.UnixNano()}})
 	}
-	if params.MinLevel > loggo.UNSPECIFIED {
-		sel = append(sel, bson.DocElem{"v", bson.M{"$gte": int(params.MinLevel)}})
+	if params.MinLevel > loggo.UNSPECIFIED || params.MaxLevel > loggo.UNSPECIFIED {
+		level := bson.M{}
+		if params.MinLevel > loggo.UNSPECIFIED {
+			level["$gte"] = int(params.MinLevel)
+		}
+		if params.MaxLevel > loggo.UNSPECIFIED {
+			level["$lte"] = int(params.MaxLevel)
+		}
+		sel = append(sel, bson.DocElem{"v", level})
 	}
 	if len(params.IncludeEntity) > 0 {
 		sel = append(sel,
@@ -799,6 +809,14 @@ func (t *logTailer) paramsToSelector(params LogTailerParams, prefix string) bson
 		sel = append(sel,
 			bson.DocElem{"m", bson.M{"$not": bson.RegEx{Pattern: makeModulePattern(params.ExcludeModule)}}})
 	}
+	if len(params.IncludeModuleRegex) > 0 {
+		sel = append(sel,
+			bson.DocElem{"m", bson.RegEx{Pattern: makeRegexPattern(params.IncludeModuleRegex)}})
+	}
+	if len(params.ExcludeModuleRegex) > 0 {
+		sel = append(sel,
+			bson.DocElem{"m", bson.M{"$not": bson.RegEx{Pattern: makeRegexPattern(params.ExcludeModuleRegex)}}})
+	}
 	if len(params.IncludeLabel) > 0 {
 		sel = append(sel,
 			bson.DocElem{"c", bson.M{"$in": params.IncludeLabel}})
@@ -833,6 +851,19 @@ func makeModulePattern(modules []string) string {
 	return `^(` + strings.Join(patterns, "|") + `)(\..+)?$`
 }
 
+func makeRegexPattern(patterns []string) string {
+	if len(patterns) == 1 {
+		return patterns[0]
+	}
+	// The patterns are already regular expressions so group each one
+	// before combining them, rather than quoting as above.
+	grouped := make([]string, len(patterns))
+	for i, pattern := range patterns {
+		grouped[i] = `(?:` + pattern + `)`
+	}
+	return strings.Join(grouped, "|")
+}
+
 func newRecentIdTracker(maxLen int) *recentIdTracker {
 	return &recentIdTracker{
 		ids: deque.NewWithMaxLen(maxLen),
@@ -5,6 +5,8 @@ package state
 
 import (
 	"fmt"
+	"net"
+	"reflect"
 	"sort"
 	"strings"
 	"time"
@@ -142,6 +144,19 @@ type machineDoc struct {
 	// an instance for the machine.
 	Placement string `bson:",omitempty"`
 
+	// ContainerNetworkDevices names the host devices or bridges that
+	// containers on this machine should use, overriding the automatic
+	// bridge selection. Empty means no override.
+	ContainerNetworkDevices []string `bson:"container-network-devices,omitempty"`
+
+	// DNSServers and DNSSearchDomains hold machine-specific DNS
+	// configuration, overriding the model's dns-servers and
+	// dns-search-domains settings. Empty means no override.
+	// TODO(migration) include these in model migrations once the
+	// description package can represent them.
+	DNSServers       []string `bson:"dns-servers,omitempty"`
+	DNSSearchDomains []string `bson:"dns-search-domains,omitempty"`
+
 	// AgentStartedAt records the time when the machine agent started.
 	AgentStartedAt time.Time `bson:"agent-started-at,omitempty"`
 
@@ -256,6 +271,26 @@ type instanceData struct {
 	// CharmProfiles contains the names of LXD profiles used by this machine.
 	// Profiles would have been defined in the charm deployed to this machine.
 	CharmProfiles []string `bson:"charm-profiles,omitempty"`
+
+	// KernelVersion, OSPatchLevel and RebootRequired are reported
+	// periodically by the machine agent.
+	KernelVersion  string `bson:"kernelversion,omitempty"`
+	OSPatchLevel   string `bson:"ospatchlevel,omitempty"`
+	RebootRequired bool   `bson:"rebootrequired,omitempty"`
+}
+
+// MachineOSInfo describes the operating system state of a machine, as
+// reported by its agent.
+type MachineOSInfo struct {
+	// KernelVersion is the version of the running kernel.
+	KernelVersion string
+
+	// OSPatchLevel is the patch level of the host operating system.
+	OSPatchLevel string
+
+	// RebootRequired is true if the host has indicated that a reboot
+	// is required to apply pending updates.
+	RebootRequired bool
 }
 
 func hardwareCharacteristics(instData instanceData) *instance.HardwareCharacteristics {
@@ -295,6 +330,103 @@ func getInstanceData(st *State, id string) (instanceData, error) {
 	return instData, nil
 }
 
+// SetOSInfo records the operating system information reported by the
+// machine agent in the machine's instance data.
+func (m *Machine) SetOSInfo(info MachineOSInfo) error {
+	ops := []txn.Op{{
+		C:      instanceDataC,
+		Id:     m.doc.DocID,
+		Assert: txn.DocExists,
+		Update: bson.D{{"$set", bson.D{
+			{"kernelversion", info.KernelVersion},
+			{"ospatchlevel", info.OSPatchLevel},
+			{"rebootrequired", info.RebootRequired},
+		}}},
+	}}
+	if err := m.st.db().RunTransaction(ops); err != nil {
+		// If the instance data doc doesn't exist, the machine has not
+		// been provisioned; there is nowhere to record the info.
+		return errors.Annotatef(onAbort(err, errors.NotProvisionedf("machine %v", m.Id())), "cannot set OS info for machine %q", m)
+	}
+	return nil
+}
+
+// SetHardwareCharacteristics replaces the hardware characteristics
+// recorded for the machine's provisioned instance. It is used to
+// refresh the data from the provider after provisioning, for example
+// when an instance has been resized or recommissioned. A change is
+// recorded in the machine's instance status history so operators can
+// see when the hardware data was updated.
+func (m *Machine) SetHardwareCharacteristics(hc instance.HardwareCharacteristics) error {
+	current, err := m.HardwareCharacteristics()
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return errors.NotProvisionedf("machine %v", m.Id())
+		}
+		return errors.Trace(err)
+	}
+	if reflect.DeepEqual(*current, hc) {
+		return nil
+	}
+	set := bson.D{}
+	unset := bson.D{}
+	field := func(name string, value interface{}, present bool) {
+		if present {
+			set = append(set, bson.DocElem{Name: name, Value: value})
+		} else {
+			unset = append(unset, bson.DocElem{Name: name, Value: nil})
+		}
+	}
+	field("arch", hc.Arch, hc.Arch != nil)
+	field("mem", hc.Mem, hc.Mem != nil)
+	field("rootdisk", hc.RootDisk, hc.RootDisk != nil)
+	field("rootdisksource", hc.RootDiskSource, hc.RootDiskSource != nil)
+	field("cpucores", hc.CpuCores, hc.CpuCores != nil)
+	field("cpupower", hc.CpuPower, hc.CpuPower != nil)
+	field("tags", hc.Tags, hc.Tags != nil)
+	field("availzone", hc.AvailabilityZone, hc.AvailabilityZone != nil)
+	update := bson.D{}
+	if len(set) > 0 {
+		update = append(update, bson.DocElem{Name: "$set", Value: set})
+	}
+	if len(unset) > 0 {
+		update = append(update, bson.DocElem{Name: "$unset", Value: unset})
+	}
+	ops := []txn.Op{{
+		C:      instanceDataC,
+		Id:     m.doc.DocID,
+		Assert: txn.DocExists,
+		Update: update,
+	}}
+	if err := m.st.db().RunTransaction(ops); err != nil {
+		return errors.Annotatef(onAbort(err, errors.NotProvisionedf("machine %v", m.Id())), "cannot set hardware characteristics for machine %q", m)
+	}
+	// Leave a marker in the instance status history; failure to do so
+	// does not invalidate the update itself.
+	if instStatus, err := m.InstanceStatus(); err == nil {
+		_, _ = probablyUpdateStatusHistory(m.st.db(), m.globalInstanceKey(), statusDoc{
+			Status:     instStatus.Status,
+			StatusInfo: "hardware characteristics refreshed",
+			Updated:    m.st.clock().Now().UnixNano(),
+		})
+	}
+	return nil
+}
+
+// OSInfo returns the operating system information most recently
+// reported by the machine agent.
+func (m *Machine) OSInfo() (MachineOSInfo, error) {
+	instData, err := getInstanceData(m.st, m.Id())
+	if err != nil {
+		return MachineOSInfo{}, errors.Trace(err)
+	}
+	return MachineOSInfo{
+		KernelVersion:  instData.KernelVersion,
+		OSPatchLevel:   instData.OSPatchLevel,
+		RebootRequired: instData.RebootRequired,
+	}, nil
+}
+
 // removeInstanceDataOp returns the operation needed to remove the
 // instance data document associated with the given globalKey.
 func removeInstanceDataOp(globalKey string) txn.Op {
@@ -404,6 +536,64 @@ func (m *Machine) SetKeepInstance(keepInstance bool) error {
 	return nil
 }
 
+// ContainerNetworkDevices returns the names of the host devices or
+// bridges that containers on this machine should use, overriding the
+// automatic bridge selection. An empty result means no override is
+// configured.
+func (m *Machine) ContainerNetworkDevices() []string {
+	return m.doc.ContainerNetworkDevices
+}
+
+// SetContainerNetworkDevices configures which host devices or bridges
+// containers on this machine should use, overriding the automatic
+// bridge selection. Passing an empty slice removes the override.
+func (m *Machine) SetContainerNetworkDevices(devices []string) error {
+	ops := []txn.Op{{
+		C:      machinesC,
+		Id:     m.doc.DocID,
+		Assert: notDeadDoc,
+		Update: bson.D{{"$set", bson.D{{"container-network-devices", devices}}}},
+	}}
+	if err := m.st.db().RunTransaction(ops); err != nil {
+		return errors.Annotatef(onAbort(err, stateerrors.ErrDead), "cannot set container network devices on machine %v", m)
+	}
+	m.doc.ContainerNetworkDevices = devices
+	return nil
+}
+
+// DNSConfigOverride returns the machine-specific DNS servers and
+// search domains, if any have been set. Empty results mean the
+// model's DNS configuration applies.
+func (m *Machine) DNSConfigOverride() (servers, searchDomains []string) {
+	return m.doc.DNSServers, m.doc.DNSSearchDomains
+}
+
+// SetDNSConfigOverride sets machine-specific DNS servers and search
+// domains, overriding the model's dns-servers and dns-search-domains
+// configuration for this machine. Empty values clear the override.
+func (m *Machine) SetDNSConfigOverride(servers, searchDomains []string) error {
+	for _, server := range servers {
+		if net.ParseIP(server) == nil {
+			return errors.NotValidf("DNS server %q", server)
+		}
+	}
+	ops := []txn.Op{{
+		C:      machinesC,
+		Id:     m.doc.DocID,
+		Assert: notDeadDoc,
+		Update: bson.D{{"$set", bson.D{
+			{"dns-servers", servers},
+			{"dns-search-domains", searchDomains},
+		}}},
+	}}
+	if err := m.st.db().RunTransaction(ops); err != nil {
+		return errors.Annotatef(onAbort(err, stateerrors.ErrDead), "cannot set DNS configuration on machine %v", m)
+	}
+	m.doc.DNSServers = servers
+	m.doc.DNSSearchDomains = searchDomains
+	return nil
+}
+
 // KeepInstance reports whether a machine, when removed from
 // Juju, will cause the corresponding cloud instance to be stopped.
 func (m *Machine) KeepInstance() (bool, error) {
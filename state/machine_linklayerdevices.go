@@ -600,9 +600,29 @@ func (m *Machine) SetDevicesAddresses(devicesAddresses ...LinkLayerDeviceAddress
 	if err := m.st.db().Run(buildTxn); err != nil {
 		return errors.Trace(err)
 	}
+	m.refreshUnitSubnetMappings()
 	return nil
 }
 
+// refreshUnitSubnetMappings refreshes the materialised unit-subnet
+// mappings of the units hosted on this machine after its device
+// addresses changed. The mappings are a derived cache, so failures are
+// logged rather than failing the address update that triggered them.
+func (m *Machine) refreshUnitSubnetMappings() {
+	units, err := m.Units()
+	if err != nil {
+		logger.Warningf("cannot get units of machine %q to refresh subnet mappings: %v", m.Id(), err)
+		return
+	}
+	unitNames := make([]string, len(units))
+	for i, unit := range units {
+		unitNames[i] = unit.Name()
+	}
+	if err := m.st.RefreshUnitSubnets(unitNames...); err != nil {
+		logger.Warningf("cannot refresh subnet mappings for units of machine %q: %v", m.Id(), err)
+	}
+}
+
 func (m *Machine) prepareToSetDevicesAddresses(devicesAddresses []LinkLayerDeviceAddress) ([]ipAddressDoc, error) {
 	var pendingDocs []ipAddressDoc
 	for _, args := range devicesAddresses {
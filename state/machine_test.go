@@ -817,6 +817,65 @@ func (s *MachineSuite) TestMachineSetProvisionedUpdatesCharacteristics(c *gc.C)
 	c.Assert(*md, gc.DeepEquals, *expected)
 }
 
+func (s *MachineSuite) TestMachineSetHardwareCharacteristics(c *gc.C) {
+	arch := arch.DefaultArchitecture
+	mem := uint64(4096)
+	err := s.machine.SetProvisioned("umbrella/0", "", "fake_nonce", &instance.HardwareCharacteristics{
+		Arch: &arch,
+		Mem:  &mem,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.machine.SetInstanceStatus(status.StatusInfo{Status: status.Running})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// A refresh with larger memory and no architecture replaces the
+	// recorded characteristics wholesale.
+	newMem := uint64(8192)
+	cores := uint64(4)
+	err = s.machine.SetHardwareCharacteristics(instance.HardwareCharacteristics{
+		Mem:      &newMem,
+		CpuCores: &cores,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	md, err := s.machine.HardwareCharacteristics()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(*md, gc.DeepEquals, instance.HardwareCharacteristics{
+		Mem:      &newMem,
+		CpuCores: &cores,
+	})
+
+	// The refresh is recorded in the instance status history.
+	history, err := s.machine.InstanceStatusHistory(status.StatusHistoryFilter{Size: 10})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(history, gc.Not(gc.HasLen), 0)
+	c.Assert(history[0].Message, gc.Equals, "hardware characteristics refreshed")
+}
+
+func (s *MachineSuite) TestMachineSetHardwareCharacteristicsNoChange(c *gc.C) {
+	mem := uint64(4096)
+	hwc := instance.HardwareCharacteristics{Mem: &mem}
+	err := s.machine.SetProvisioned("umbrella/0", "", "fake_nonce", &hwc)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.machine.SetInstanceStatus(status.StatusInfo{Status: status.Running})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.machine.SetHardwareCharacteristics(hwc)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// No spurious history entry for a no-op refresh.
+	history, err := s.machine.InstanceStatusHistory(status.StatusHistoryFilter{Size: 10})
+	c.Assert(err, jc.ErrorIsNil)
+	for _, entry := range history {
+		c.Assert(entry.Message, gc.Not(gc.Equals), "hardware characteristics refreshed")
+	}
+}
+
+func (s *MachineSuite) TestMachineSetHardwareCharacteristicsNotProvisioned(c *gc.C) {
+	err := s.machine.SetHardwareCharacteristics(instance.HardwareCharacteristics{})
+	c.Assert(err, jc.Satisfies, errors.IsNotProvisioned)
+}
+
 func (s *MachineSuite) TestMachineCharmProfiles(c *gc.C) {
 	hwc := &instance.HardwareCharacteristics{}
 	err := s.machine.SetProvisioned("umbrella/0", "", "fake_nonce", hwc)
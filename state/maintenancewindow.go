@@ -0,0 +1,257 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2"
+	"github.com/juju/mgo/v2/bson"
+	"github.com/juju/mgo/v2/txn"
+	"github.com/juju/names/v4"
+	jujutxn "github.com/juju/txn/v2"
+)
+
+// Maintenance window repeat schedules.
+const (
+	// MaintenanceRepeatNone indicates a one-off window.
+	MaintenanceRepeatNone = ""
+
+	// MaintenanceRepeatDaily repeats the window at the same time of day,
+	// every day from its start time.
+	MaintenanceRepeatDaily = "daily"
+
+	// MaintenanceRepeatWeekly repeats the window at the same time on the
+	// same day of the week, every week from its start time.
+	MaintenanceRepeatWeekly = "weekly"
+)
+
+// MaintenanceWindow describes a period during which Juju defers
+// non-urgent automatic operations against a machine, or against every
+// machine in the model when defined model-wide.
+type MaintenanceWindow struct {
+	// Start is the beginning of the window.
+	Start time.Time
+
+	// Duration is how long the window remains open from Start.
+	Duration time.Duration
+
+	// Repeat is one of the MaintenanceRepeat* schedules.
+	Repeat string
+
+	// Comment is an optional operator-supplied note, typically a change
+	// ticket reference.
+	Comment string
+}
+
+// Active reports whether the window is open at the given time.
+func (w MaintenanceWindow) Active(now time.Time) bool {
+	if now.Before(w.Start) {
+		return false
+	}
+	elapsed := now.Sub(w.Start)
+	switch w.Repeat {
+	case MaintenanceRepeatDaily:
+		elapsed %= 24 * time.Hour
+	case MaintenanceRepeatWeekly:
+		elapsed %= 7 * 24 * time.Hour
+	}
+	return elapsed < w.Duration
+}
+
+// Validate returns an error if the window is not well formed.
+func (w MaintenanceWindow) Validate() error {
+	if w.Start.IsZero() {
+		return errors.NotValidf("maintenance window with no start time")
+	}
+	if w.Duration <= 0 {
+		return errors.NotValidf("maintenance window duration %v", w.Duration)
+	}
+	switch w.Repeat {
+	case MaintenanceRepeatNone, MaintenanceRepeatDaily, MaintenanceRepeatWeekly:
+	default:
+		return errors.NotValidf("maintenance window repeat %q", w.Repeat)
+	}
+	return nil
+}
+
+// maintenanceWindowDoc holds the maintenance windows for a single
+// entity - a machine or the model - keyed by the entity's tag.
+type maintenanceWindowDoc struct {
+	DocID     string `bson:"_id"`
+	ModelUUID string `bson:"model-uuid"`
+
+	EntityTag string                  `bson:"entity-tag"`
+	Windows   []maintenanceWindowItem `bson:"windows"`
+}
+
+type maintenanceWindowItem struct {
+	Start    time.Time `bson:"start"`
+	Duration int64     `bson:"duration"`
+	Repeat   string    `bson:"repeat,omitempty"`
+	Comment  string    `bson:"comment,omitempty"`
+}
+
+func maintenanceWindowItems(windows []MaintenanceWindow) []maintenanceWindowItem {
+	items := make([]maintenanceWindowItem, len(windows))
+	for i, w := range windows {
+		items[i] = maintenanceWindowItem{
+			Start:    w.Start.UTC(),
+			Duration: int64(w.Duration),
+			Repeat:   w.Repeat,
+			Comment:  w.Comment,
+		}
+	}
+	return items
+}
+
+func (doc maintenanceWindowDoc) windows() []MaintenanceWindow {
+	windows := make([]MaintenanceWindow, len(doc.Windows))
+	for i, item := range doc.Windows {
+		windows[i] = MaintenanceWindow{
+			Start:    item.Start,
+			Duration: time.Duration(item.Duration),
+			Repeat:   item.Repeat,
+			Comment:  item.Comment,
+		}
+	}
+	return windows
+}
+
+// checkMaintenanceWindowTag ensures that the input tag identifies this
+// model or one of its machines.
+func (st *State) checkMaintenanceWindowTag(tag names.Tag) error {
+	switch tag := tag.(type) {
+	case names.ModelTag:
+		if tag.Id() != st.ModelUUID() {
+			return errors.NotFoundf("model %q", tag.Id())
+		}
+	case names.MachineTag:
+		if _, err := st.Machine(tag.Id()); err != nil {
+			return errors.Trace(err)
+		}
+	default:
+		return errors.NotSupportedf("maintenance windows for %q", tag)
+	}
+	return nil
+}
+
+// SetMaintenanceWindows replaces the maintenance windows for the given
+// machine or model. Passing no windows removes any existing ones.
+func (st *State) SetMaintenanceWindows(tag names.Tag, windows []MaintenanceWindow) error {
+	if err := st.checkMaintenanceWindowTag(tag); err != nil {
+		return errors.Trace(err)
+	}
+	for _, w := range windows {
+		if err := w.Validate(); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	docID := st.docID(tag.String())
+	buildTxn := func(int) ([]txn.Op, error) {
+		col, closer := st.db().GetCollection(maintenanceWindowsC)
+		defer closer()
+
+		count, err := col.FindId(docID).Count()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if len(windows) == 0 {
+			if count == 0 {
+				return nil, jujutxn.ErrNoOperations
+			}
+			return []txn.Op{{
+				C:      maintenanceWindowsC,
+				Id:     docID,
+				Assert: txn.DocExists,
+				Remove: true,
+			}}, nil
+		}
+		if count == 0 {
+			return []txn.Op{{
+				C:      maintenanceWindowsC,
+				Id:     docID,
+				Assert: txn.DocMissing,
+				Insert: &maintenanceWindowDoc{
+					DocID:     docID,
+					ModelUUID: st.ModelUUID(),
+					EntityTag: tag.String(),
+					Windows:   maintenanceWindowItems(windows),
+				},
+			}}, nil
+		}
+		return []txn.Op{{
+			C:      maintenanceWindowsC,
+			Id:     docID,
+			Assert: txn.DocExists,
+			Update: bson.D{{"$set", bson.D{{"windows", maintenanceWindowItems(windows)}}}},
+		}}, nil
+	}
+	return errors.Annotatef(st.db().Run(buildTxn), "setting maintenance windows for %q", tag)
+}
+
+// MaintenanceWindows returns the maintenance windows defined for the
+// given machine or model. An entity with no windows yields an empty
+// result without error.
+func (st *State) MaintenanceWindows(tag names.Tag) ([]MaintenanceWindow, error) {
+	if err := st.checkMaintenanceWindowTag(tag); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	col, closer := st.db().GetCollection(maintenanceWindowsC)
+	defer closer()
+
+	var doc maintenanceWindowDoc
+	err := col.FindId(st.docID(tag.String())).One(&doc)
+	if err == mgo.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Annotatef(err, "getting maintenance windows for %q", tag)
+	}
+	return doc.windows(), nil
+}
+
+// AllMaintenanceWindows returns the maintenance windows for every entity
+// in the model that has any, keyed by entity tag.
+func (st *State) AllMaintenanceWindows() (map[string][]MaintenanceWindow, error) {
+	col, closer := st.db().GetCollection(maintenanceWindowsC)
+	defer closer()
+
+	var docs []maintenanceWindowDoc
+	if err := col.Find(nil).All(&docs); err != nil {
+		return nil, errors.Annotate(err, "getting all maintenance windows")
+	}
+	result := make(map[string][]MaintenanceWindow, len(docs))
+	for _, doc := range docs {
+		result[doc.EntityTag] = doc.windows()
+	}
+	return result, nil
+}
+
+// MachineInMaintenanceWindow reports whether the given machine is
+// currently inside a maintenance window - either one of its own, or one
+// defined for the model as a whole. Workers performing non-urgent
+// automatic operations consult this to defer them until the window
+// closes.
+func (st *State) MachineInMaintenanceWindow(machineId string, now time.Time) (bool, error) {
+	model, err := st.Model()
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	for _, tag := range []names.Tag{names.NewMachineTag(machineId), model.ModelTag()} {
+		windows, err := st.MaintenanceWindows(tag)
+		if err != nil {
+			return false, errors.Trace(err)
+		}
+		for _, w := range windows {
+			if w.Active(now) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
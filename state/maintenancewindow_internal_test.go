@@ -0,0 +1,69 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+type maintenanceWindowInternalSuite struct{}
+
+var _ = gc.Suite(&maintenanceWindowInternalSuite{})
+
+func (s *maintenanceWindowInternalSuite) TestActive(c *gc.C) {
+	start := time.Date(2022, 3, 7, 22, 0, 0, 0, time.UTC)
+	for i, t := range []struct {
+		window   MaintenanceWindow
+		now      time.Time
+		expected bool
+	}{{
+		window:   MaintenanceWindow{Start: start, Duration: 2 * time.Hour},
+		now:      start.Add(time.Hour),
+		expected: true,
+	}, {
+		window:   MaintenanceWindow{Start: start, Duration: 2 * time.Hour},
+		now:      start.Add(-time.Minute),
+		expected: false,
+	}, {
+		window:   MaintenanceWindow{Start: start, Duration: 2 * time.Hour},
+		now:      start.Add(2 * time.Hour),
+		expected: false,
+	}, {
+		window:   MaintenanceWindow{Start: start, Duration: 2 * time.Hour, Repeat: MaintenanceRepeatDaily},
+		now:      start.AddDate(0, 0, 3).Add(time.Hour),
+		expected: true,
+	}, {
+		window:   MaintenanceWindow{Start: start, Duration: 2 * time.Hour, Repeat: MaintenanceRepeatDaily},
+		now:      start.AddDate(0, 0, 3).Add(3 * time.Hour),
+		expected: false,
+	}, {
+		window:   MaintenanceWindow{Start: start, Duration: 2 * time.Hour, Repeat: MaintenanceRepeatWeekly},
+		now:      start.AddDate(0, 0, 7).Add(time.Hour),
+		expected: true,
+	}, {
+		window:   MaintenanceWindow{Start: start, Duration: 2 * time.Hour, Repeat: MaintenanceRepeatWeekly},
+		now:      start.AddDate(0, 0, 3).Add(time.Hour),
+		expected: false,
+	}} {
+		c.Logf("test %d", i)
+		c.Check(t.window.Active(t.now), gc.Equals, t.expected)
+	}
+}
+
+func (s *maintenanceWindowInternalSuite) TestValidate(c *gc.C) {
+	start := time.Date(2022, 3, 7, 22, 0, 0, 0, time.UTC)
+
+	c.Check(MaintenanceWindow{Start: start, Duration: time.Hour}.Validate(), jc.ErrorIsNil)
+	c.Check(MaintenanceWindow{Start: start, Duration: time.Hour, Repeat: MaintenanceRepeatWeekly}.Validate(), jc.ErrorIsNil)
+
+	err := MaintenanceWindow{Duration: time.Hour}.Validate()
+	c.Check(err, gc.ErrorMatches, "maintenance window with no start time not valid")
+	err = MaintenanceWindow{Start: start}.Validate()
+	c.Check(err, gc.ErrorMatches, "maintenance window duration 0s not valid")
+	err = MaintenanceWindow{Start: start, Duration: time.Hour, Repeat: "hourly"}.Validate()
+	c.Check(err, gc.ErrorMatches, `maintenance window repeat "hourly" not valid`)
+}
@@ -0,0 +1,263 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"bytes"
+	"io/ioutil"
+
+	"github.com/juju/charm/v9"
+	"github.com/juju/description/v3"
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+
+	"github.com/juju/juju/state/storage"
+)
+
+// MoveApplication exports the named application, along with its units'
+// state, from the model managed by st and imports it into the model
+// managed by targetSt on the same controller. The application's charm is
+// copied across if the target model does not already have it, peer
+// relations are re-established in the target model, and the application
+// is removed from the source model once the import has succeeded.
+//
+// Relations with other applications must be severed before the
+// application can move; passing severRelations destroys them as part of
+// the move. Applications with units assigned to machines, storage,
+// resources or offers cannot be moved.
+func (st *State) MoveApplication(targetSt *State, appName string, severRelations bool) error {
+	sourceModel, err := st.Model()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	targetModel, err := targetSt.Model()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if sourceModel.Type() != targetModel.Type() {
+		return errors.Errorf("cannot move application from %s model to %s model",
+			sourceModel.Type(), targetModel.Type())
+	}
+	if targetModel.Life() != Alive {
+		return errors.Errorf("target model %q is not alive", targetModel.Name())
+	}
+
+	app, err := st.Application(appName)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := st.checkApplicationMovable(app); err != nil {
+		return errors.Trace(err)
+	}
+	if _, err := targetSt.Application(appName); err == nil {
+		return errors.AlreadyExistsf("application %q in target model", appName)
+	} else if !errors.IsNotFound(err) {
+		return errors.Trace(err)
+	}
+
+	if err := severApplicationRelations(app, severRelations); err != nil {
+		return errors.Trace(err)
+	}
+
+	// Export after severing relations so that the exported application
+	// carries only its peer relations.
+	model, err := st.Export()
+	if err != nil {
+		return errors.Annotate(err, "exporting source model")
+	}
+	exApp := exportedApplication(model, appName)
+	if exApp == nil {
+		return errors.NotFoundf("application %q in exported model", appName)
+	}
+
+	ch, _, err := app.Charm()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := copyCharm(st, targetSt, ch); err != nil {
+		return errors.Annotatef(err, "copying charm %q to target model", ch.URL())
+	}
+
+	ctrlCfg, err := targetSt.ControllerConfig()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	restore := importer{
+		st:      targetSt,
+		dbModel: targetModel,
+		model:   model,
+		logger:  loggo.GetLogger("juju.state.move-application"),
+	}
+	if err := restore.application(exApp, ctrlCfg); err != nil {
+		return errors.Annotatef(err, "importing application %q into target model", appName)
+	}
+
+	// Peer relations are re-created empty; the units re-enter scope
+	// when their agents come up in the target model.
+	if peers := ch.Meta().Peers; len(peers) > 0 {
+		peerOps, err := targetSt.addPeerRelationsOps(appName, peers)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if err := targetSt.db().RunTransaction(peerOps); err != nil {
+			return errors.Annotate(err, "adding peer relations in target model")
+		}
+	}
+
+	// Preserve the unit name sequence so that units added in the target
+	// model don't reuse the names of the units that moved.
+	if seq, ok := model.Sequences()[app.Tag().String()]; ok {
+		if _, err := sequenceWithMin(targetSt, app.Tag().String(), seq); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	if err := app.Destroy(); err != nil {
+		return errors.Annotatef(err, "removing application %q from source model", appName)
+	}
+	return nil
+}
+
+// exportedApplication returns the named application from the exported
+// model description, or nil if it is not present.
+func exportedApplication(model description.Model, appName string) description.Application {
+	for _, app := range model.Applications() {
+		if app.Name() == appName {
+			return app
+		}
+	}
+	return nil
+}
+
+// checkApplicationMovable returns an error if the application carries
+// state that moving between models does not (yet) support.
+func (st *State) checkApplicationMovable(app *Application) error {
+	if app.doc.Subordinate {
+		return errors.NotSupportedf("moving subordinate application %q", app.Name())
+	}
+	sb, err := NewStorageBackend(st)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	units, err := app.AllUnits()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, unit := range units {
+		if _, err := unit.AssignedMachineId(); err == nil {
+			return errors.NotSupportedf("moving application %q with units assigned to machines", app.Name())
+		} else if !errors.IsNotAssigned(err) && !errors.IsNotFound(err) {
+			return errors.Trace(err)
+		}
+		attachments, err := sb.UnitStorageAttachments(unit.UnitTag())
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if len(attachments) > 0 {
+			return errors.NotSupportedf("moving application %q with storage attached", app.Name())
+		}
+	}
+	resources, err := st.Resources()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	appResources, err := resources.ListResources(app.Name())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(appResources.Resources) > 0 {
+		return errors.NotSupportedf("moving application %q with resources", app.Name())
+	}
+	offers, err := NewApplicationOffers(st).AllApplicationOffers()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, offer := range offers {
+		if offer.ApplicationName == app.Name() {
+			return errors.NotSupportedf("moving application %q with offers", app.Name())
+		}
+	}
+	return nil
+}
+
+// severApplicationRelations ensures the application has no relations
+// with other applications, destroying them if sever is true. Peer
+// relations move with the application and are left alone.
+func severApplicationRelations(app *Application, sever bool) error {
+	regular, err := regularRelations(app)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(regular) == 0 {
+		return nil
+	}
+	if !sever {
+		return errors.Errorf("application %q has relations with other applications", app.Name())
+	}
+	for _, rel := range regular {
+		if err := rel.Destroy(); err != nil {
+			return errors.Annotatef(err, "destroying relation %q", rel)
+		}
+	}
+	// Relations with units still in scope are removed asynchronously
+	// once the units have left; in that case the move must be retried.
+	remaining, err := regularRelations(app)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(remaining) > 0 {
+		return errors.Errorf("relations for application %q are still being removed; try again once they are gone", app.Name())
+	}
+	return nil
+}
+
+// regularRelations returns the application's relations with other
+// applications, excluding its peer relations.
+func regularRelations(app *Application) ([]*Relation, error) {
+	rels, err := app.Relations()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var regular []*Relation
+	for _, rel := range rels {
+		eps := rel.Endpoints()
+		if len(eps) == 1 && eps[0].Role == charm.RolePeer {
+			continue
+		}
+		regular = append(regular, rel)
+	}
+	return regular, nil
+}
+
+// copyCharm makes the charm used by the application available in the
+// target model, copying the archive between the models' blob stores.
+func copyCharm(st, targetSt *State, ch *Charm) error {
+	if _, err := targetSt.Charm(ch.URL()); err == nil {
+		return nil
+	} else if !errors.IsNotFound(err) {
+		return errors.Trace(err)
+	}
+	srcStor := storage.NewStorage(st.ModelUUID(), st.MongoSession())
+	reader, _, err := srcStor.Get(ch.StoragePath())
+	if err != nil {
+		return errors.Annotate(err, "reading charm archive")
+	}
+	defer func() { _ = reader.Close() }()
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	dstStor := storage.NewStorage(targetSt.ModelUUID(), targetSt.MongoSession())
+	if err := dstStor.Put(ch.StoragePath(), bytes.NewReader(data), int64(len(data))); err != nil {
+		return errors.Annotate(err, "writing charm archive")
+	}
+	_, err = targetSt.AddCharm(CharmInfo{
+		Charm:       ch,
+		ID:          ch.URL(),
+		StoragePath: ch.StoragePath(),
+		SHA256:      ch.BundleSha256(),
+		Version:     ch.Version(),
+	})
+	return errors.Trace(err)
+}
@@ -0,0 +1,113 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state_test
+
+import (
+	"bytes"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/version/v2"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/state/storage"
+	"github.com/juju/juju/testing/factory"
+)
+
+type MigrationApplicationSuite struct {
+	ConnSuite
+}
+
+var _ = gc.Suite(&MigrationApplicationSuite{})
+
+func (s *MigrationApplicationSuite) makeTargetModel(c *gc.C) *state.State {
+	targetSt := s.Factory.MakeModel(c, nil)
+	s.AddCleanup(func(*gc.C) { targetSt.Close() })
+	return targetSt
+}
+
+// putFakeCharmArchive stores a blob at the storage path used by
+// factory-created charms, so that the move can copy it across.
+func (s *MigrationApplicationSuite) putFakeCharmArchive(c *gc.C) {
+	stor := storage.NewStorage(s.State.ModelUUID(), s.State.MongoSession())
+	data := []byte("fake charm archive")
+	err := stor.Put("fake-storage-path", bytes.NewReader(data), int64(len(data)))
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *MigrationApplicationSuite) TestMoveApplication(c *gc.C) {
+	s.putFakeCharmArchive(c)
+	app := s.Factory.MakeApplication(c, nil)
+	unit, err := app.AddUnit(state.AddUnitParams{})
+	c.Assert(err, jc.ErrorIsNil)
+	err = unit.SetAgentVersion(version.MustParseBinary("2.0.1-ubuntu-amd64"))
+	c.Assert(err, jc.ErrorIsNil)
+
+	targetSt := s.makeTargetModel(c)
+	err = s.State.MoveApplication(targetSt, app.Name(), false)
+	c.Assert(err, jc.ErrorIsNil)
+
+	moved, err := targetSt.Application(app.Name())
+	c.Assert(err, jc.ErrorIsNil)
+	units, err := moved.AllUnits()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(units, gc.HasLen, 1)
+	c.Assert(units[0].Name(), gc.Equals, unit.Name())
+	curl, _ := app.CharmURL()
+	_, err = targetSt.Charm(curl)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The source application is on its way out.
+	c.Assert(app.Refresh(), jc.ErrorIsNil)
+	c.Assert(app.Life(), gc.Equals, state.Dying)
+}
+
+func (s *MigrationApplicationSuite) TestMoveApplicationSeverRelations(c *gc.C) {
+	s.putFakeCharmArchive(c)
+	mysql := s.Factory.MakeApplication(c, &factory.ApplicationParams{
+		Charm: s.Factory.MakeCharm(c, &factory.CharmParams{Name: "mysql"}),
+	})
+	s.Factory.MakeApplication(c, &factory.ApplicationParams{
+		Charm: s.Factory.MakeCharm(c, &factory.CharmParams{Name: "wordpress"}),
+	})
+	eps, err := s.State.InferEndpoints("wordpress", "mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.State.AddRelation(eps...)
+	c.Assert(err, jc.ErrorIsNil)
+
+	targetSt := s.makeTargetModel(c)
+	err = s.State.MoveApplication(targetSt, mysql.Name(), false)
+	c.Assert(err, gc.ErrorMatches, `application "mysql" has relations with other applications`)
+
+	err = s.State.MoveApplication(targetSt, mysql.Name(), true)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = targetSt.Application("mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	rels, err := s.State.AllRelations()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rels, gc.HasLen, 0)
+}
+
+func (s *MigrationApplicationSuite) TestMoveApplicationSubordinate(c *gc.C) {
+	logging := s.Factory.MakeApplication(c, &factory.ApplicationParams{
+		Charm: s.Factory.MakeCharm(c, &factory.CharmParams{Name: "logging"}),
+	})
+	targetSt := s.makeTargetModel(c)
+	err := s.State.MoveApplication(targetSt, logging.Name(), false)
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+}
+
+func (s *MigrationApplicationSuite) TestMoveApplicationAlreadyExists(c *gc.C) {
+	s.putFakeCharmArchive(c)
+	app := s.Factory.MakeApplication(c, nil)
+
+	targetSt := s.makeTargetModel(c)
+	targetFactory := factory.NewFactory(targetSt, s.StatePool)
+	targetFactory.MakeApplication(c, &factory.ApplicationParams{
+		Charm: targetFactory.MakeCharm(c, &factory.CharmParams{Name: "mysql"}),
+	})
+	err := s.State.MoveApplication(targetSt, app.Name(), false)
+	c.Assert(err, jc.Satisfies, errors.IsAlreadyExists)
+}
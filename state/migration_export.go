@@ -54,11 +54,67 @@ import (
 
 const maxStatusHistoryEntries = 20
 
+// ExportKind names an aspect of the model that can be excluded from
+// an export.
+type ExportKind string
+
+const (
+	ExportActions              ExportKind = "actions"
+	ExportAnnotations          ExportKind = "annotations"
+	ExportCloudImageMetadata   ExportKind = "cloud-image-metadata"
+	ExportCredentials          ExportKind = "credentials"
+	ExportIPAddresses          ExportKind = "ip-addresses"
+	ExportSettings             ExportKind = "settings"
+	ExportSSHHostKeys          ExportKind = "ssh-host-keys"
+	ExportStatusHistory        ExportKind = "status-history"
+	ExportLinkLayerDevices     ExportKind = "link-layer-devices"
+	ExportUnitAgentBinaries    ExportKind = "unit-agent-binaries"
+	ExportMachineAgentBinaries ExportKind = "machine-agent-binaries"
+	ExportRelationData         ExportKind = "relation-data"
+	ExportInstanceData         ExportKind = "instance-data"
+	ExportApplicationOffers    ExportKind = "application-offers"
+	ExportOfferConnections     ExportKind = "offer-connections"
+	ExportExternalControllers  ExportKind = "external-controllers"
+)
+
+var allExportKinds = []ExportKind{
+	ExportActions,
+	ExportAnnotations,
+	ExportCloudImageMetadata,
+	ExportCredentials,
+	ExportIPAddresses,
+	ExportSettings,
+	ExportSSHHostKeys,
+	ExportStatusHistory,
+	ExportLinkLayerDevices,
+	ExportUnitAgentBinaries,
+	ExportMachineAgentBinaries,
+	ExportRelationData,
+	ExportInstanceData,
+	ExportApplicationOffers,
+	ExportOfferConnections,
+	ExportExternalControllers,
+}
+
+// ParseExportKind returns the ExportKind named by value, or a
+// NotValid error if there is no such kind.
+func ParseExportKind(value string) (ExportKind, error) {
+	for _, kind := range allExportKinds {
+		if string(kind) == value {
+			return kind, nil
+		}
+	}
+	return "", errors.NotValidf("export kind %q", value)
+}
+
 // ExportConfig allows certain aspects of the model to be skipped
 // during the export. The intent of this is to be able to get a partial
 // export to support other API calls, like status.
 type ExportConfig struct {
-	IgnoreIncompleteModel    bool
+	IgnoreIncompleteModel bool
+
+	// The Skip fields below are deprecated; new callers should use
+	// Exclude or Only instead.
 	SkipActions              bool
 	SkipAnnotations          bool
 	SkipCloudImageMetadata   bool
@@ -75,6 +131,88 @@ type ExportConfig struct {
 	SkipApplicationOffers    bool
 	SkipOfferConnections     bool
 	SkipExternalControllers  bool
+
+	excluded set.Strings
+}
+
+// Exclude marks the given kinds to be left out of the export.
+func (c *ExportConfig) Exclude(kinds ...ExportKind) {
+	if c.excluded == nil {
+		c.excluded = set.NewStrings()
+	}
+	for _, kind := range kinds {
+		c.excluded.Add(string(kind))
+	}
+}
+
+// Only excludes every kind except those given.
+func (c *ExportConfig) Only(kinds ...ExportKind) {
+	include := set.NewStrings()
+	for _, kind := range kinds {
+		include.Add(string(kind))
+	}
+	for _, kind := range allExportKinds {
+		if !include.Contains(string(kind)) {
+			c.Exclude(kind)
+		}
+	}
+}
+
+// Excludes reports whether kind is left out of the export, either via
+// Exclude and Only or via the legacy Skip fields.
+func (c ExportConfig) Excludes(kind ExportKind) bool {
+	if c.excluded.Contains(string(kind)) {
+		return true
+	}
+	switch kind {
+	case ExportActions:
+		return c.SkipActions
+	case ExportAnnotations:
+		return c.SkipAnnotations
+	case ExportCloudImageMetadata:
+		return c.SkipCloudImageMetadata
+	case ExportCredentials:
+		return c.SkipCredentials
+	case ExportIPAddresses:
+		return c.SkipIPAddresses
+	case ExportSettings:
+		return c.SkipSettings
+	case ExportSSHHostKeys:
+		return c.SkipSSHHostKeys
+	case ExportStatusHistory:
+		return c.SkipStatusHistory
+	case ExportLinkLayerDevices:
+		return c.SkipLinkLayerDevices
+	case ExportUnitAgentBinaries:
+		return c.SkipUnitAgentBinaries
+	case ExportMachineAgentBinaries:
+		return c.SkipMachineAgentBinaries
+	case ExportRelationData:
+		return c.SkipRelationData
+	case ExportInstanceData:
+		return c.SkipInstanceData
+	case ExportApplicationOffers:
+		return c.SkipApplicationOffers
+	case ExportOfferConnections:
+		return c.SkipOfferConnections
+	case ExportExternalControllers:
+		return c.SkipExternalControllers
+	}
+	return false
+}
+
+// IsPartial reports whether any aspect of the model is left out of
+// the export.
+func (c ExportConfig) IsPartial() bool {
+	if !c.excluded.IsEmpty() {
+		return true
+	}
+	for _, kind := range allExportKinds {
+		if c.Excludes(kind) {
+			return true
+		}
+	}
+	return false
 }
 
 // ExportPartial the current model for the State optionally skipping
@@ -119,7 +257,7 @@ func (st *State) exportImpl(cfg ExportConfig) (description.Model, error) {
 	}
 
 	modelConfig, found := export.modelSettings[modelGlobalKey]
-	if !found && !cfg.SkipSettings {
+	if !found && !cfg.Excludes(ExportSettings) {
 		return nil, errors.New("missing model config")
 	}
 	delete(export.modelSettings, modelGlobalKey)
@@ -141,7 +279,7 @@ func (st *State) exportImpl(cfg ExportConfig) (description.Model, error) {
 		Blocks:             blocks,
 	}
 	export.model = description.NewModel(args)
-	if credsTag, credsSet := dbModel.CloudCredentialTag(); credsSet && !cfg.SkipCredentials {
+	if credsTag, credsSet := dbModel.CloudCredentialTag(); credsSet && !cfg.Excludes(ExportCredentials) {
 		creds, err := st.CloudCredential(credsTag)
 		if err != nil {
 			return nil, errors.Trace(err)
@@ -227,8 +365,7 @@ func (st *State) exportImpl(cfg ExportConfig) (description.Model, error) {
 
 	// If we are doing a partial export, it doesn't really make sense
 	// to validate the model.
-	fullExport := ExportConfig{}
-	if cfg == fullExport {
+	if !cfg.IsPartial() && !cfg.IgnoreIncompleteModel {
 		if err := export.model.Validate(); err != nil {
 			return nil, errors.Trace(err)
 		}
@@ -492,7 +629,7 @@ func (e *exporter) newMachine(exParent description.Machine, machine *Machine, in
 
 	// We fully expect the machine to have tools set, and that there is
 	// some instance data.
-	if !e.cfg.SkipInstanceData {
+	if !e.cfg.Excludes(ExportInstanceData) {
 		instData, found := instances[machine.doc.Id]
 		if !found && !e.cfg.IgnoreIncompleteModel {
 			return nil, errors.NotValidf("missing instance data for machine %s", machine.Id())
@@ -544,7 +681,7 @@ func (e *exporter) newMachine(exParent description.Machine, machine *Machine, in
 	exMachine.SetStatus(statusArgs)
 	exMachine.SetStatusHistory(e.statusHistoryArgs(globalKey))
 
-	if !e.cfg.SkipMachineAgentBinaries {
+	if !e.cfg.Excludes(ExportMachineAgentBinaries) {
 		tools, err := machine.AgentTools()
 		if err != nil && !e.cfg.IgnoreIncompleteModel {
 			// This means the tools aren't set, but they should be.
@@ -808,7 +945,7 @@ func (e *exporter) addApplication(ctx addApplicationContext) error {
 
 	var charmConfig map[string]interface{}
 	applicationCharmSettingsDoc, found := e.modelSettings[charmConfigKey]
-	if !found && !e.cfg.SkipSettings && !e.cfg.IgnoreIncompleteModel {
+	if !found && !e.cfg.Excludes(ExportSettings) && !e.cfg.IgnoreIncompleteModel {
 		return errors.Errorf("missing charm settings for application %q", appName)
 	}
 	if found {
@@ -818,7 +955,7 @@ func (e *exporter) addApplication(ctx addApplicationContext) error {
 
 	var applicationConfig map[string]interface{}
 	applicationConfigDoc, found := e.modelSettings[appConfigKey]
-	if !found && !e.cfg.SkipSettings && !e.cfg.IgnoreIncompleteModel {
+	if !found && !e.cfg.Excludes(ExportSettings) && !e.cfg.IgnoreIncompleteModel {
 		return errors.Errorf("missing config for application %q", appName)
 	}
 	if found {
@@ -828,7 +965,7 @@ func (e *exporter) addApplication(ctx addApplicationContext) error {
 
 	var leadershipSettings map[string]interface{}
 	leadershipSettingsDoc, found := e.modelSettings[leadershipKey]
-	if !found && !e.cfg.SkipSettings && !e.cfg.IgnoreIncompleteModel {
+	if !found && !e.cfg.Excludes(ExportSettings) && !e.cfg.IgnoreIncompleteModel {
 		return errors.Errorf("missing leadership settings for application %q", appName)
 	}
 	if found {
@@ -957,6 +1094,15 @@ func (e *exporter) addApplication(ctx addApplicationContext) error {
 	}
 
 	for _, unit := range ctx.units {
+		// The description model records a single series per
+		// application, so refuse to export units on another series
+		// rather than silently changing them on import.
+		if unit.doc.Series != application.doc.Series {
+			return errors.NotSupportedf(
+				"exporting unit %q on series %q (application uses %q)",
+				unit.Name(), unit.doc.Series, application.doc.Series,
+			)
+		}
 		agentKey := unit.globalAgentKey()
 		unitMeterStatus, found := ctx.meterStatus[agentKey]
 		if !found {
@@ -1036,7 +1182,7 @@ func (e *exporter) addApplication(ctx addApplicationContext) error {
 		workloadVersionKey := unit.globalWorkloadVersionKey()
 		exUnit.SetWorkloadVersionHistory(e.statusHistoryArgs(workloadVersionKey))
 
-		if (e.dbModel.Type() != ModelTypeCAAS && !e.cfg.SkipUnitAgentBinaries) || isSidecar {
+		if (e.dbModel.Type() != ModelTypeCAAS && !e.cfg.Excludes(ExportUnitAgentBinaries)) || isSidecar {
 			tools, err := unit.AgentTools()
 			if err != nil && !e.cfg.IgnoreIncompleteModel {
 				// This means the tools aren't set, but they should be.
@@ -1190,7 +1336,7 @@ func (e *exporter) relations() error {
 	e.logger.Debugf("read %d relations", len(rels))
 
 	relationScopes := set.NewStrings()
-	if !e.cfg.SkipRelationData {
+	if !e.cfg.Excludes(ExportRelationData) {
 		relationScopes, err = e.readAllRelationScopes()
 		if err != nil {
 			return errors.Trace(err)
@@ -1223,7 +1369,7 @@ func (e *exporter) relations() error {
 
 			key := relationApplicationSettingsKey(relation.Id(), ep.ApplicationName)
 			appSettingsDoc, found := e.modelSettings[key]
-			if !found && !e.cfg.SkipSettings && !e.cfg.SkipRelationData {
+			if !found && !e.cfg.Excludes(ExportSettings) && !e.cfg.Excludes(ExportRelationData) {
 				return errors.Errorf("missing application settings for %q application %q", relation, ep.ApplicationName)
 			}
 			delete(e.modelSettings, key)
@@ -1251,11 +1397,11 @@ func (e *exporter) relations() error {
 					continue
 				}
 				key := ru.key()
-				if !e.cfg.SkipRelationData && !relationScopes.Contains(key) && !e.cfg.IgnoreIncompleteModel {
+				if !e.cfg.Excludes(ExportRelationData) && !relationScopes.Contains(key) && !e.cfg.IgnoreIncompleteModel {
 					return errors.Errorf("missing relation scope for %s and %s", relation, unit.Name())
 				}
 				settingsDoc, found := e.modelSettings[key]
-				if !found && !e.cfg.SkipSettings && !e.cfg.SkipRelationData && !e.cfg.IgnoreIncompleteModel {
+				if !found && !e.cfg.Excludes(ExportSettings) && !e.cfg.Excludes(ExportRelationData) && !e.cfg.IgnoreIncompleteModel {
 					return errors.Errorf("missing relation settings for %s and %s", relation, unit.Name())
 				}
 				delete(e.modelSettings, key)
@@ -1339,7 +1485,7 @@ func (s offerConnectionsShim) AllOfferConnections() ([]migrations.MigrationOffer
 }
 
 func (e *exporter) offerConnections() error {
-	if e.cfg.SkipOfferConnections {
+	if e.cfg.Excludes(ExportOfferConnections) {
 		return nil
 	}
 
@@ -1509,7 +1655,7 @@ func (e *exporter) spaces() error {
 }
 
 func (e *exporter) linklayerdevices() error {
-	if e.cfg.SkipLinkLayerDevices {
+	if e.cfg.Excludes(ExportLinkLayerDevices) {
 		return nil
 	}
 	linklayerdevices, err := e.st.AllLinkLayerDevices()
@@ -1560,7 +1706,7 @@ func (e *exporter) subnets() error {
 }
 
 func (e *exporter) ipAddresses() error {
-	if e.cfg.SkipIPAddresses {
+	if e.cfg.Excludes(ExportIPAddresses) {
 		return nil
 	}
 	ipaddresses, err := e.st.AllIPAddresses()
@@ -1588,7 +1734,7 @@ func (e *exporter) ipAddresses() error {
 }
 
 func (e *exporter) sshHostKeys() error {
-	if e.cfg.SkipSSHHostKeys {
+	if e.cfg.Excludes(ExportSSHHostKeys) {
 		return nil
 	}
 	machines, err := e.st.AllMachines()
@@ -1614,7 +1760,7 @@ func (e *exporter) sshHostKeys() error {
 }
 
 func (e *exporter) cloudimagemetadata() error {
-	if e.cfg.SkipCloudImageMetadata {
+	if e.cfg.Excludes(ExportCloudImageMetadata) {
 		return nil
 	}
 	cloudimagemetadata, err := e.st.CloudImageMetadataStorage.AllCloudImageMetadata()
@@ -1642,7 +1788,7 @@ func (e *exporter) cloudimagemetadata() error {
 }
 
 func (e *exporter) actions() error {
-	if e.cfg.SkipActions {
+	if e.cfg.Excludes(ExportActions) {
 		return nil
 	}
 
@@ -1684,7 +1830,7 @@ func (e *exporter) actions() error {
 }
 
 func (e *exporter) operations() error {
-	if e.cfg.SkipActions {
+	if e.cfg.Excludes(ExportActions) {
 		return nil
 	}
 
@@ -1876,7 +2022,7 @@ func (e *exporter) readLastConnectionTimes() (map[string]time.Time, error) {
 
 func (e *exporter) readAllAnnotations() error {
 	e.annotations = make(map[string]annotatorDoc)
-	if e.cfg.SkipAnnotations {
+	if e.cfg.Excludes(ExportAnnotations) {
 		return nil
 	}
 
@@ -2010,7 +2156,7 @@ func deduceOrigin(url *charm.URL) (description.CharmOriginArgs, error) {
 
 func (e *exporter) readAllSettings() error {
 	e.modelSettings = make(map[string]settingsDoc)
-	if e.cfg.SkipSettings {
+	if e.cfg.Excludes(ExportSettings) {
 		return nil
 	}
 
@@ -2059,7 +2205,7 @@ func (e *exporter) readAllStatusHistory() error {
 
 	count := 0
 	e.statusHistory = make(map[string][]historicalStatusDoc)
-	if e.cfg.SkipStatusHistory {
+	if e.cfg.Excludes(ExportStatusHistory) {
 		return nil
 	}
 	var doc historicalStatusDoc
@@ -2230,13 +2376,13 @@ func (e *exporter) checkUnexportedValues() error {
 	}
 
 	for key := range e.status {
-		if !e.cfg.SkipInstanceData && !strings.HasSuffix(key, "#instance") {
+		if !e.cfg.Excludes(ExportInstanceData) && !strings.HasSuffix(key, "#instance") {
 			missing = append(missing, fmt.Sprintf("unexported status for %s", key))
 		}
 	}
 
 	for key := range e.statusHistory {
-		if !e.cfg.SkipInstanceData && !(strings.HasSuffix(key, "#instance") || strings.HasSuffix(key, "#modification")) {
+		if !e.cfg.Excludes(ExportInstanceData) && !(strings.HasSuffix(key, "#instance") || strings.HasSuffix(key, "#modification")) {
 			missing = append(missing, fmt.Sprintf("unexported status history for %s", key))
 		}
 	}
@@ -2722,7 +2868,7 @@ func (e *exporter) storagePools() error {
 }
 
 func (e *exporter) groupOffersByApplicationName() (map[string][]*crossmodel.ApplicationOffer, error) {
-	if e.cfg.SkipApplicationOffers {
+	if e.cfg.Excludes(ExportApplicationOffers) {
 		return nil, nil
 	}
 
@@ -43,7 +43,6 @@ import (
 	"github.com/juju/juju/storage"
 	"github.com/juju/juju/storage/poolmanager"
 	"github.com/juju/juju/storage/provider"
-	dummystorage "github.com/juju/juju/storage/provider/dummy"
 	"github.com/juju/juju/testing/factory"
 	jujuversion "github.com/juju/juju/version"
 )
@@ -115,36 +114,14 @@ func (s *MigrationBaseSuite) makeUnitApplicationLeader(c *gc.C, unitName, applic
 }
 
 func (s *MigrationBaseSuite) makeUnitWithStorage(c *gc.C) (*state.Application, *state.Unit, names.StorageTag) {
-	pool := "modelscoped"
-	kind := "block"
-	// Create a default pool for block devices.
-	pm := poolmanager.New(state.NewStateSettings(s.State), storage.ChainedProviderRegistry{
-		dummystorage.StorageProviders(),
-		provider.CommonStorageProviders(),
+	application, unit, storageTags := s.Factory.MakeUnitWithStorage(c, &factory.UnitWithStorageParams{
+		Pool: "modelscoped",
 	})
-	_, err := pm.Create(pool, provider.LoopProviderType, map[string]interface{}{})
-	c.Assert(err, jc.ErrorIsNil)
-
-	// There are test charms called "storage-block" and
-	// "storage-filesystem" which are what you'd expect.
-	ch := s.AddTestingCharm(c, "storage-"+kind)
-	storage := map[string]state.StorageConstraints{
-		"data": makeStorageCons(pool, 1024, 1),
-	}
-	application := s.AddTestingApplicationWithStorage(c, "storage-"+kind, ch, storage)
-	unit, err := application.AddUnit(state.AddUnitParams{})
-	c.Assert(err, jc.ErrorIsNil)
-
-	machine := s.Factory.MakeMachine(c, nil)
-	err = unit.AssignToMachine(machine)
-	c.Assert(err, jc.ErrorIsNil)
-
-	c.Assert(err, jc.ErrorIsNil)
-	storageTag := names.NewStorageTag("data/0")
+	c.Assert(storageTags, gc.HasLen, 1)
 	agentVersion := version.MustParseBinary("2.0.1-ubuntu-and64")
-	err = unit.SetAgentVersion(agentVersion)
+	err := unit.SetAgentVersion(agentVersion)
 	c.Assert(err, jc.ErrorIsNil)
-	return application, unit, storageTag
+	return application, unit, storageTags[0]
 }
 
 type MigrationExportSuite struct {
@@ -687,6 +664,17 @@ func (s *MigrationExportSuite) TestMultipleApplications(c *gc.C) {
 	c.Assert(applications, gc.HasLen, 3)
 }
 
+func (s *MigrationExportSuite) TestMixedUnitSeriesNotSupported(c *gc.C) {
+	ch := state.AddTestingCharmMultiSeries(c, s.State, "multi-series")
+	app := state.AddTestingApplicationForSeries(c, s.State, "trusty", "multi-series", ch)
+	_, err := app.AddUnit(state.AddUnitParams{Series: "xenial"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.State.Export()
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+	c.Assert(err, gc.ErrorMatches, `exporting unit "multi-series/0" on series "xenial" \(application uses "trusty"\) not supported`)
+}
+
 func (s *MigrationExportSuite) TestApplicationExposeParameters(c *gc.C) {
 	serverSpace, err := s.State.AddSpace("server", "", nil, true)
 	c.Assert(err, jc.ErrorIsNil)
@@ -925,21 +913,12 @@ func (s *MigrationExportSuite) assertMigrateUnits(c *gc.C, st *state.State) {
 	if dbModel.Type() == state.ModelTypeCAAS {
 		// need to set a cloud container status so that SetStatus for
 		// the unit doesn't throw away the history writes.
-		var updateUnits state.UpdateUnitsOperation
-		updateUnits.Updates = []*state.UpdateUnitOperation{
-			unit.UpdateOperation(state.UnitUpdateProperties{
-				ProviderId: strPtr("provider-id"),
-				Address:    strPtr("192.168.1.1"),
-				Ports:      &[]string{"80"},
-				CloudContainerStatus: &status.StatusInfo{
-					Status:  status.Running,
-					Message: "cloud container running",
-				},
-			})}
-		app, err := unit.Application()
-		c.Assert(err, jc.ErrorIsNil)
-		err = app.UpdateUnits(&updateUnits)
-		c.Assert(err, jc.ErrorIsNil)
+		f.MakeCloudContainer(c, &factory.CloudContainerParams{
+			Unit:       unit,
+			ProviderId: "provider-id",
+			Address:    "192.168.1.1",
+			Ports:      []string{"80"},
+		})
 	}
 
 	err = dbModel.SetAnnotations(unit, testAnnotations)
@@ -1097,7 +1076,7 @@ func (s *MigrationExportSuite) TestFirewallRules(c *gc.C) {
 
 	frst := state.NewFirewallRules(s.State)
 	rule := state.NewFirewallRule(firewall.SSHRule, cidrs)
-	err := frst.Save(rule)
+	err := frst.Save(rule, "admin")
 	c.Assert(err, jc.ErrorIsNil)
 
 	model, err := s.State.Export()
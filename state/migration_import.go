@@ -41,7 +41,17 @@ import (
 )
 
 // Import the database agnostic model representation into the database.
-func (ctrl *Controller) Import(model description.Model) (_ *Model, _ *State, err error) {
+func (ctrl *Controller) Import(model description.Model) (*Model, *State, error) {
+	return ctrl.ImportWithRemap(model, ImportRemapSpec{})
+}
+
+// ImportWithRemap imports the model as Import does, additionally remapping
+// source space and storage pool names to their target controller
+// equivalents as described by the supplied spec.
+func (ctrl *Controller) ImportWithRemap(model description.Model, remap ImportRemapSpec) (_ *Model, _ *State, err error) {
+	if err := remap.Validate(); err != nil {
+		return nil, nil, errors.Trace(err)
+	}
 	st := ctrl.pool.SystemState()
 	modelUUID := model.Tag().Id()
 	logger := loggo.GetLogger("juju.state.import-model")
@@ -145,6 +155,7 @@ func (ctrl *Controller) Import(model description.Model) (_ *Model, _ *State, err
 		dbModel: dbModel,
 		model:   model,
 		logger:  logger,
+		remap:   remap,
 	}
 	if err := restore.sequences(); err != nil {
 		return nil, nil, errors.Annotate(err, "sequences")
@@ -273,6 +284,7 @@ type importer struct {
 	dbModel *Model
 	model   description.Model
 	logger  loggo.Logger
+	remap   ImportRemapSpec
 	// applicationUnits is populated at the end of loading the applications, and is a
 	// map of application name to the units of that application.
 	applicationUnits map[string]map[string]*Unit
@@ -993,7 +1005,12 @@ func (i *importer) parseBindings(bindingsMap map[string]string) (*Bindings, erro
 		if spNameOrID == "" {
 			defaultMappingsAreIds = false
 			bindingsMap[epName] = network.AlphaSpaceName
+			continue
 		}
+		// Name-based bindings must track any space renames performed
+		// when the spaces were imported. ID-based bindings are
+		// unaffected as spaces keep their IDs across the migration.
+		bindingsMap[epName] = i.remapSpaceName(spNameOrID)
 	}
 
 	// 2.6 controllers only populate the default space key if set to the
@@ -1080,7 +1097,7 @@ func (i *importer) storageConstraints(cons map[string]description.StorageConstra
 	result := make(map[string]StorageConstraints)
 	for key, value := range cons {
 		result[key] = StorageConstraints{
-			Pool:  value.Pool(),
+			Pool:  i.remapPoolName(value.Pool()),
 			Size:  value.Size(),
 			Count: value.Count(),
 		}
@@ -1880,22 +1897,23 @@ func (i *importer) spaces() error {
 	for _, s := range i.model.Spaces() {
 		// The default space should not have been exported, but be defensive.
 		// Any subnets added to the space will be imported subsequently.
-		if s.Name() == network.AlphaSpaceName {
+		name := i.remapSpaceName(s.Name())
+		if name == network.AlphaSpaceName {
 			continue
 		}
 
 		if s.Id() == "" {
-			if _, err := i.st.AddSpace(s.Name(), network.Id(s.ProviderID()), nil, s.Public()); err != nil {
-				i.logger.Errorf("error importing space %s: %s", s.Name(), err)
-				return errors.Annotate(err, s.Name())
+			if _, err := i.st.AddSpace(name, network.Id(s.ProviderID()), nil, s.Public()); err != nil {
+				i.logger.Errorf("error importing space %s: %s", name, err)
+				return errors.Annotate(err, name)
 			}
 			continue
 		}
 
-		ops := i.st.addSpaceTxnOps(s.Id(), s.Name(), network.Id(s.ProviderID()), s.Public())
+		ops := i.st.addSpaceTxnOps(s.Id(), name, network.Id(s.ProviderID()), s.Public())
 		if err := i.st.db().RunTransaction(ops); err != nil {
-			i.logger.Errorf("error importing space %s: %s", s.Name(), err)
-			return errors.Annotate(err, s.Name())
+			i.logger.Errorf("error importing space %s: %s", name, err)
+			return errors.Annotate(err, name)
 		}
 	}
 
@@ -1965,7 +1983,7 @@ func (i *importer) subnets() error {
 
 			// SpaceName will only be present when migrating from pre-2.7
 			// models. We use it to look up a space ID.
-			SpaceName: subnet.SpaceName(),
+			SpaceName: i.remapSpaceName(subnet.SpaceName()),
 		}
 		info.SetFan(subnet.FanLocalUnderlay(), subnet.FanOverlay())
 
@@ -2305,6 +2323,9 @@ func (i *importer) constraints(cons description.Constraints) constraints.Value {
 		result.RootDiskSource = &source
 	}
 	if spaces := cons.Spaces(); len(spaces) > 0 {
+		for idx, space := range spaces {
+			spaces[idx] = i.remapSpaceConstraint(space)
+		}
 		result.Spaces = &spaces
 	}
 	if tags := cons.Tags(); len(tags) > 0 {
@@ -2401,7 +2422,9 @@ func (i *importer) addStorageInstance(storage description.Storage) error {
 
 func (i *importer) storageInstanceConstraints(storage description.Storage) storageInstanceConstraints {
 	if cons, ok := storage.Constraints(); ok {
-		return storageInstanceConstraints(cons)
+		remapped := storageInstanceConstraints(cons)
+		remapped.Pool = i.remapPoolName(remapped.Pool)
+		return remapped
 	}
 	// Older versions of Juju did not record storage constraints on the
 	// storage instance, so we must do what we do during upgrade steps:
@@ -2459,6 +2482,7 @@ func (i *importer) storageInstanceConstraints(storage description.Storage) stora
 			names.ReadableString(storage.Tag()),
 		)
 	}
+	cons.Pool = i.remapPoolName(cons.Pool)
 	return cons
 }
 
@@ -2491,14 +2515,14 @@ func (i *importer) addVolume(volume description.Volume, sb *storageBackend) erro
 			HardwareId: volume.HardwareID(),
 			WWN:        volume.WWN(),
 			Size:       volume.Size(),
-			Pool:       volume.Pool(),
+			Pool:       i.remapPoolName(volume.Pool()),
 			VolumeId:   volume.VolumeID(),
 			Persistent: volume.Persistent(),
 		}
 	} else {
 		params = &VolumeParams{
 			Size: volume.Size(),
-			Pool: volume.Pool(),
+			Pool: i.remapPoolName(volume.Pool()),
 		}
 	}
 	doc := volumeDoc{
@@ -2509,7 +2533,7 @@ func (i *importer) addVolume(volume description.Volume, sb *storageBackend) erro
 		Info:            info,
 		AttachmentCount: len(attachments),
 	}
-	if detachable, err := isDetachableVolumePool(sb, volume.Pool()); err != nil {
+	if detachable, err := isDetachableVolumePool(sb, i.remapPoolName(volume.Pool())); err != nil {
 		return errors.Trace(err)
 	} else if !detachable && len(attachments) == 1 {
 		doc.HostId = attachments[0].Host().Id()
@@ -2646,13 +2670,13 @@ func (i *importer) addFilesystem(filesystem description.Filesystem, sb *storageB
 	if filesystem.Provisioned() {
 		info = &FilesystemInfo{
 			Size:         filesystem.Size(),
-			Pool:         filesystem.Pool(),
+			Pool:         i.remapPoolName(filesystem.Pool()),
 			FilesystemId: filesystem.FilesystemID(),
 		}
 	} else {
 		params = &FilesystemParams{
 			Size: filesystem.Size(),
-			Pool: filesystem.Pool(),
+			Pool: i.remapPoolName(filesystem.Pool()),
 		}
 	}
 	doc := filesystemDoc{
@@ -2664,7 +2688,7 @@ func (i *importer) addFilesystem(filesystem description.Filesystem, sb *storageB
 		Info:            info,
 		AttachmentCount: len(attachments),
 	}
-	if detachable, err := isDetachableFilesystemPool(sb, filesystem.Pool()); err != nil {
+	if detachable, err := isDetachableFilesystemPool(sb, i.remapPoolName(filesystem.Pool())); err != nil {
 		return errors.Trace(err)
 	} else if !detachable && len(attachments) == 1 {
 		doc.HostId = attachments[0].Host().Id()
@@ -2724,9 +2748,23 @@ func (i *importer) storagePools() error {
 	pm := poolmanager.New(NewStateSettings(i.st), registry)
 
 	for _, pool := range i.model.StoragePools() {
-		_, err := pm.Create(pool.Name(), storage.ProviderType(pool.Provider()), pool.Attributes())
+		name := i.remapPoolName(pool.Name())
+		if name != pool.Name() {
+			// The pool was remapped to a target equivalent, which must
+			// already be a pool or provider type known to the target.
+			if _, err := pm.Get(name); err != nil {
+				if !errors.IsNotFound(err) {
+					return errors.Annotatef(err, "remapping pool %q to %q", pool.Name(), name)
+				}
+				if _, err := registry.StorageProvider(storage.ProviderType(name)); err != nil {
+					return errors.Annotatef(err, "remapping pool %q to %q", pool.Name(), name)
+				}
+			}
+			continue
+		}
+		_, err := pm.Create(name, storage.ProviderType(pool.Provider()), pool.Attributes())
 		if err != nil {
-			return errors.Annotatef(err, "creating pool %q", pool.Name())
+			return errors.Annotatef(err, "creating pool %q", name)
 		}
 	}
 	return nil
@@ -0,0 +1,80 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/storage"
+)
+
+// ImportRemapSpec describes how source model entities should be renamed to
+// their target controller equivalents while a model is being imported. It
+// enables cross-cloud migrations where the target cloud uses different
+// space names or storage pools than the source.
+type ImportRemapSpec struct {
+	// Spaces maps source space names to their target equivalents.
+	Spaces map[string]string
+
+	// StoragePools maps source storage pool names to their target
+	// equivalents. A target may name an existing pool or a storage
+	// provider type known to the target model.
+	StoragePools map[string]string
+}
+
+// IsZero reports whether the spec contains no remappings.
+func (spec ImportRemapSpec) IsZero() bool {
+	return len(spec.Spaces) == 0 && len(spec.StoragePools) == 0
+}
+
+// Validate returns an error if the spec contains invalid names.
+func (spec ImportRemapSpec) Validate() error {
+	for source, target := range spec.Spaces {
+		if !names.IsValidSpace(source) {
+			return errors.NotValidf("source space name %q", source)
+		}
+		if !names.IsValidSpace(target) {
+			return errors.NotValidf("target space name %q for space %q", target, source)
+		}
+	}
+	for source, target := range spec.StoragePools {
+		if !storage.IsValidPoolName(source) {
+			return errors.NotValidf("source storage pool name %q", source)
+		}
+		if !storage.IsValidPoolName(target) {
+			return errors.NotValidf("target storage pool name %q for pool %q", target, source)
+		}
+	}
+	return nil
+}
+
+// remapSpaceName returns the target equivalent for the given source space
+// name, or the name unchanged if no remapping was specified for it.
+func (i *importer) remapSpaceName(name string) string {
+	if target, ok := i.remap.Spaces[name]; ok {
+		return target
+	}
+	return name
+}
+
+// remapSpaceConstraint remaps a spaces constraint value, preserving any
+// exclusion ("^") prefix.
+func (i *importer) remapSpaceConstraint(value string) string {
+	if strings.HasPrefix(value, "^") {
+		return "^" + i.remapSpaceName(value[1:])
+	}
+	return i.remapSpaceName(value)
+}
+
+// remapPoolName returns the target equivalent for the given source storage
+// pool name, or the name unchanged if no remapping was specified for it.
+func (i *importer) remapPoolName(name string) string {
+	if target, ok := i.remap.StoragePools[name]; ok {
+		return target
+	}
+	return name
+}
@@ -0,0 +1,68 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+type ImportRemapSpecSuite struct{}
+
+var _ = gc.Suite(&ImportRemapSpecSuite{})
+
+func (s *ImportRemapSpecSuite) TestIsZero(c *gc.C) {
+	c.Check(ImportRemapSpec{}.IsZero(), jc.IsTrue)
+	c.Check(ImportRemapSpec{Spaces: map[string]string{"a": "b"}}.IsZero(), jc.IsFalse)
+	c.Check(ImportRemapSpec{StoragePools: map[string]string{"a": "b"}}.IsZero(), jc.IsFalse)
+}
+
+func (s *ImportRemapSpecSuite) TestValidate(c *gc.C) {
+	for i, test := range []struct {
+		spec     ImportRemapSpec
+		errMatch string
+	}{
+		{
+			spec: ImportRemapSpec{},
+		}, {
+			spec: ImportRemapSpec{
+				Spaces:       map[string]string{"db-space": "target-db"},
+				StoragePools: map[string]string{"fast-ssd": "premium"},
+			},
+		}, {
+			spec:     ImportRemapSpec{Spaces: map[string]string{"": "target"}},
+			errMatch: `source space name "" not valid`,
+		}, {
+			spec:     ImportRemapSpec{Spaces: map[string]string{"db-space": "not a space"}},
+			errMatch: `target space name "not a space" for space "db-space" not valid`,
+		}, {
+			spec:     ImportRemapSpec{StoragePools: map[string]string{"fast ssd": "premium"}},
+			errMatch: `source storage pool name "fast ssd" not valid`,
+		}, {
+			spec:     ImportRemapSpec{StoragePools: map[string]string{"fast-ssd": "premium pool"}},
+			errMatch: `target storage pool name "premium pool" for pool "fast-ssd" not valid`,
+		},
+	} {
+		c.Logf("test %d", i)
+		err := test.spec.Validate()
+		if test.errMatch == "" {
+			c.Check(err, jc.ErrorIsNil)
+		} else {
+			c.Check(err, gc.ErrorMatches, test.errMatch)
+		}
+	}
+}
+
+func (s *ImportRemapSpecSuite) TestRemapHelpers(c *gc.C) {
+	imp := &importer{remap: ImportRemapSpec{
+		Spaces:       map[string]string{"db-space": "target-db"},
+		StoragePools: map[string]string{"fast-ssd": "premium"},
+	}}
+	c.Check(imp.remapSpaceName("db-space"), gc.Equals, "target-db")
+	c.Check(imp.remapSpaceName("other"), gc.Equals, "other")
+	c.Check(imp.remapSpaceConstraint("^db-space"), gc.Equals, "^target-db")
+	c.Check(imp.remapSpaceConstraint("db-space"), gc.Equals, "target-db")
+	c.Check(imp.remapPoolName("fast-ssd"), gc.Equals, "premium")
+	c.Check(imp.remapPoolName("other"), gc.Equals, "other")
+}
@@ -5,6 +5,7 @@ package state_test
 
 import (
 	"fmt"
+	"math/rand"
 	"strconv"
 	"time" // only uses time.Time values
 
@@ -37,6 +38,7 @@ import (
 	"github.com/juju/juju/state"
 	"github.com/juju/juju/state/cloudimagemetadata"
 	"github.com/juju/juju/state/mocks"
+	statetesting "github.com/juju/juju/state/testing"
 	"github.com/juju/juju/storage"
 	"github.com/juju/juju/storage/poolmanager"
 	"github.com/juju/juju/storage/provider"
@@ -64,6 +66,18 @@ func (s *MigrationImportSuite) checkStatusHistory(c *gc.C, exported, imported st
 	}
 }
 
+func (s *MigrationImportSuite) TestRandomisedModelRoundTrip(c *gc.C) {
+	// A fixed seed keeps the test reproducible while still exercising
+	// a model shape that no hand-written test spells out field by
+	// field.
+	statetesting.PopulateRandomModel(c, s.Factory, rand.New(rand.NewSource(42)))
+
+	_, newSt := statetesting.ExportImportRoundTrip(c, s.Controller, s.State)
+	s.AddCleanup(func(c *gc.C) {
+		c.Check(newSt.Close(), jc.ErrorIsNil)
+	})
+}
+
 func (s *MigrationImportSuite) TestExisting(c *gc.C) {
 	out, err := s.State.Export()
 	c.Assert(err, jc.ErrorIsNil)
@@ -1558,10 +1572,10 @@ func (s *MigrationImportSuite) TestFirewallRules(c *gc.C) {
 	fwRule1 := state.NewFirewallRule(serviceType, cidr1)
 
 	firewallRuleService := state.NewFirewallRules(s.State)
-	err := firewallRuleService.Save(fwRule0)
+	err := firewallRuleService.Save(fwRule0, "admin")
 	c.Assert(err, jc.ErrorIsNil)
 
-	err = firewallRuleService.Save(fwRule1)
+	err = firewallRuleService.Save(fwRule1, "admin")
 	c.Assert(err, jc.ErrorIsNil)
 
 	_, newSt := s.importModel(c, s.State, func(map[string]interface{}) {
@@ -35,12 +35,16 @@ func (s *MigrationSuite) TestKnownCollections(c *gc.C) {
 		// machine
 		instanceDataC,
 		machineUpgradeSeriesLocksC,
+		upgradeSeriesPlansC,
 		machinesC,
+		maintenanceWindowsC,
 		openedPortsC,
 
 		// application / unit
 		applicationsC,
 		unitsC,
+		unitMovesC,
+		unitSubnetsC,
 		meterStatusC, // red / green status for metrics of units
 		payloadsC,
 		"resources",
@@ -171,10 +175,18 @@ func (s *MigrationSuite) TestKnownCollections(c *gc.C) {
 		// Recreated whilst migrating actions.
 		actionNotificationsC,
 
+		// Custom events are transient operational triggers and are not
+		// carried across migrations.
+		customEventsC,
+
 		// Global settings store controller specific configuration settings
 		// and are not to be migrated.
 		globalSettingsC,
 
+		// Model templates are controller-level defaults for new models
+		// and are not to be migrated.
+		modelTemplatesC,
+
 		// There is a precheck to ensure that there are no pending reboots
 		// for the model being migrated, and as such, there is no need to
 		// migrate that information.
@@ -215,6 +227,7 @@ func (s *MigrationSuite) TestKnownCollections(c *gc.C) {
 		// sure the leader units' leases are claimed in the target
 		// controller when leases are managed in raft.
 		leaseHoldersC,
+		leaseHolderBlobsC,
 
 		// secrets
 		secretMetadataC,
@@ -410,6 +410,24 @@ func (ctlr *Controller) NewModel(args ModelArgs) (_ *Model, _ *State, err error)
 
 	ops := append(prereqOps, modelOps...)
 
+	// Create any default storage pools configured for the cloud region
+	// hosting the new model.
+	if args.StorageProviderRegistry != nil {
+		var regionPools map[string]storage.Attrs
+		regionPools, err = st.RegionStoragePoolDefaults(args.CloudName, args.CloudRegion)
+		if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+		if len(regionPools) > 0 {
+			var regionPoolOps []txn.Op
+			regionPoolOps, err = newSt.createCustomStoragePoolsOps(args.StorageProviderRegistry, regionPools)
+			if err != nil {
+				return nil, nil, errors.Annotate(err, "failed to create new model")
+			}
+			ops = append(ops, regionPoolOps...)
+		}
+	}
+
 	// Increment the model count for the cloud to which this model belongs.
 	incCloudRefOp, err := incCloudModelRefOp(st, args.CloudName)
 	if err != nil {
@@ -0,0 +1,127 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2"
+	"github.com/juju/mgo/v2/bson"
+	"github.com/juju/mgo/v2/txn"
+	jujutxn "github.com/juju/txn/v2"
+
+	"github.com/juju/juju/feature"
+)
+
+// modelFeatureFlagsDoc records the feature flags enabled on a model.
+// There is at most one document per model, keyed on modelGlobalKey.
+type modelFeatureFlagsDoc struct {
+	DocID     string   `bson:"_id"`
+	ModelUUID string   `bson:"model-uuid"`
+	Flags     []string `bson:"flags"`
+}
+
+// FeatureFlags returns the names of the feature flags enabled on the
+// model, in the order they were enabled. Flags that have graduated to
+// default behaviour since they were enabled are removed from state as
+// a side effect and not reported.
+func (m *Model) FeatureFlags() ([]string, error) {
+	flags, _, err := m.featureFlags()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	known := set.NewStrings(feature.ModelScoped()...)
+	current := make([]string, 0, len(flags))
+	var graduated []string
+	for _, flag := range flags {
+		if known.Contains(flag) {
+			current = append(current, flag)
+		} else {
+			graduated = append(graduated, flag)
+		}
+	}
+	if len(graduated) > 0 {
+		logger.Infof("removing graduated feature flags %v from model %s", graduated, m.UUID())
+		if err := m.removeFeatureFlags(graduated); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	return current, nil
+}
+
+// EnableFeatureFlag enables the named feature flag on the model. The
+// flag must be one of those reported by feature.ModelScoped.
+func (m *Model) EnableFeatureFlag(flag string) error {
+	if !set.NewStrings(feature.ModelScoped()...).Contains(flag) {
+		return errors.NotValidf("feature flag %q", flag)
+	}
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		flags, found, err := m.featureFlags()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if !found {
+			return []txn.Op{{
+				C:      modelFeatureFlagsC,
+				Id:     m.st.docID(modelGlobalKey),
+				Assert: txn.DocMissing,
+				Insert: &modelFeatureFlagsDoc{
+					ModelUUID: m.UUID(),
+					Flags:     []string{flag},
+				},
+			}}, nil
+		}
+		if set.NewStrings(flags...).Contains(flag) {
+			return nil, jujutxn.ErrNoOperations
+		}
+		return []txn.Op{{
+			C:      modelFeatureFlagsC,
+			Id:     m.st.docID(modelGlobalKey),
+			Assert: txn.DocExists,
+			Update: bson.D{{"$addToSet", bson.D{{"flags", flag}}}},
+		}}, nil
+	}
+	return errors.Annotatef(m.st.db().Run(buildTxn), "cannot enable feature flag %q", flag)
+}
+
+// DisableFeatureFlag disables the named feature flag on the model. It
+// is not an error to disable a flag that is not enabled.
+func (m *Model) DisableFeatureFlag(flag string) error {
+	return errors.Annotatef(m.removeFeatureFlags([]string{flag}), "cannot disable feature flag %q", flag)
+}
+
+func (m *Model) removeFeatureFlags(remove []string) error {
+	removing := set.NewStrings(remove...)
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		flags, found, err := m.featureFlags()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if !found || removing.Intersection(set.NewStrings(flags...)).IsEmpty() {
+			return nil, jujutxn.ErrNoOperations
+		}
+		return []txn.Op{{
+			C:      modelFeatureFlagsC,
+			Id:     m.st.docID(modelGlobalKey),
+			Assert: txn.DocExists,
+			Update: bson.D{{"$pull", bson.D{{"flags", bson.D{{"$in", remove}}}}}},
+		}}, nil
+	}
+	return errors.Trace(m.st.db().Run(buildTxn))
+}
+
+func (m *Model) featureFlags() ([]string, bool, error) {
+	coll, closer := m.st.db().GetCollection(modelFeatureFlagsC)
+	defer closer()
+
+	var doc modelFeatureFlagsDoc
+	err := coll.FindId(modelGlobalKey).One(&doc)
+	if err == mgo.ErrNotFound {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, errors.Trace(err)
+	}
+	return doc.Flags, true, nil
+}
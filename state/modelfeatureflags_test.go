@@ -0,0 +1,106 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/feature"
+	"github.com/juju/juju/state"
+)
+
+type ModelFeatureFlagsSuite struct {
+	ConnSuite
+}
+
+var _ = gc.Suite(&ModelFeatureFlagsSuite{})
+
+func (s *ModelFeatureFlagsSuite) TestFeatureFlagsEmpty(c *gc.C) {
+	flags, err := s.Model.FeatureFlags()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(flags, gc.HasLen, 0)
+}
+
+func (s *ModelFeatureFlagsSuite) TestEnableFeatureFlag(c *gc.C) {
+	err := s.Model.EnableFeatureFlag(feature.StrictMigration)
+	c.Assert(err, jc.ErrorIsNil)
+
+	flags, err := s.Model.FeatureFlags()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(flags, jc.DeepEquals, []string{feature.StrictMigration})
+
+	// Enabling again is a no-op.
+	err = s.Model.EnableFeatureFlag(feature.StrictMigration)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.Model.EnableFeatureFlag(feature.ActionsV2)
+	c.Assert(err, jc.ErrorIsNil)
+
+	flags, err = s.Model.FeatureFlags()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(flags, jc.DeepEquals, []string{feature.StrictMigration, feature.ActionsV2})
+}
+
+func (s *ModelFeatureFlagsSuite) TestEnableFeatureFlagUnknown(c *gc.C) {
+	err := s.Model.EnableFeatureFlag("warp-drive")
+	c.Assert(err, gc.ErrorMatches, `feature flag "warp-drive" not valid`)
+}
+
+func (s *ModelFeatureFlagsSuite) TestDisableFeatureFlag(c *gc.C) {
+	err := s.Model.EnableFeatureFlag(feature.StrictMigration)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.Model.EnableFeatureFlag(feature.ActionsV2)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.Model.DisableFeatureFlag(feature.StrictMigration)
+	c.Assert(err, jc.ErrorIsNil)
+
+	flags, err := s.Model.FeatureFlags()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(flags, jc.DeepEquals, []string{feature.ActionsV2})
+
+	// Disabling a flag that isn't enabled is not an error.
+	err = s.Model.DisableFeatureFlag(feature.StrictMigration)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *ModelFeatureFlagsSuite) TestFeatureFlagsPrunesGraduated(c *gc.C) {
+	err := state.SetModelFeatureFlags(s.Model, []string{
+		feature.StrictMigration, "long-since-graduated",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	flags, err := s.Model.FeatureFlags()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(flags, jc.DeepEquals, []string{feature.StrictMigration})
+
+	// The graduated flag has been removed from state, not just the result.
+	err = s.Model.DisableFeatureFlag(feature.StrictMigration)
+	c.Assert(err, jc.ErrorIsNil)
+	flags, err = s.Model.FeatureFlags()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(flags, gc.HasLen, 0)
+}
+
+func (s *ModelFeatureFlagsSuite) TestFeatureFlagsPerModel(c *gc.C) {
+	err := s.Model.EnableFeatureFlag(feature.StrictMigration)
+	c.Assert(err, jc.ErrorIsNil)
+
+	otherSt := s.Factory.MakeModel(c, nil)
+	defer otherSt.Close()
+	otherModel, err := otherSt.Model()
+	c.Assert(err, jc.ErrorIsNil)
+
+	flags, err := otherModel.FeatureFlags()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(flags, gc.HasLen, 0)
+
+	err = otherModel.EnableFeatureFlag(feature.ActionsV2)
+	c.Assert(err, jc.ErrorIsNil)
+
+	flags, err = s.Model.FeatureFlags()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(flags, jc.DeepEquals, []string{feature.StrictMigration})
+}
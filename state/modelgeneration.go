@@ -71,7 +71,17 @@ type generationDoc struct {
 	// Config is all changes made to charm configuration under this branch.
 	Config map[string][]itemChange `bson:"charm-config"`
 
-	// TODO (manadart 2019-04-02): CharmURLs, Resources.
+	// CharmRevisions holds staged charm revision changes for applications
+	// under this branch, keyed by application name.
+	// The revisions are applied to the applications when the branch is
+	// committed.
+	CharmRevisions map[string]int `bson:"charm-revisions,omitempty"`
+
+	// TrackingTimes records when each unit began tracking this branch,
+	// as Unix timestamps keyed by unit name.
+	TrackingTimes map[string]int64 `bson:"tracking-times,omitempty"`
+
+	// TODO (manadart 2019-04-02): Resources.
 
 	// Created is a Unix timestamp indicating when this generation was created.
 	Created int64 `bson:"created"`
@@ -129,6 +139,26 @@ func (g *Generation) Config() map[string]settings.ItemChanges {
 	return changes
 }
 
+// CharmRevisions returns the staged charm revision changes for the
+// generation, keyed by application name.
+func (g *Generation) CharmRevisions() map[string]int {
+	revisions := make(map[string]int, len(g.doc.CharmRevisions))
+	for appName, revision := range g.doc.CharmRevisions {
+		revisions[appName] = revision
+	}
+	return revisions
+}
+
+// TrackingTimes returns the times at which each assigned unit began
+// tracking the generation, keyed by unit name.
+func (g *Generation) TrackingTimes() map[string]time.Time {
+	times := make(map[string]time.Time, len(g.doc.TrackingTimes))
+	for unitName, stamp := range g.doc.TrackingTimes {
+		times[unitName] = time.Unix(stamp, 0).UTC()
+	}
+	return times
+}
+
 // Created returns the Unix timestamp at generation creation.
 func (g *Generation) Created() int64 {
 	return g.doc.Created
@@ -221,6 +251,10 @@ func (g *Generation) AssignUnits(appName string, numUnits int) error {
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
+		now, err := g.st.ControllerTimestamp()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
 		ops := []txn.Op{
 			{
 				C:  applicationsC,
@@ -246,7 +280,7 @@ func (g *Generation) AssignUnits(appName string, numUnits int) error {
 				if err != nil {
 					return nil, errors.Trace(err)
 				}
-				ops = append(ops, assignGenerationUnitTxnOps(g.doc.DocId, appName, unit)...)
+				ops = append(ops, assignGenerationUnitTxnOps(g.doc.DocId, appName, unit, now)...)
 				assigned++
 			}
 		}
@@ -283,15 +317,20 @@ func (g *Generation) AssignUnit(unitName string) error {
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
-		return assignGenerationUnitTxnOps(g.doc.DocId, appName, unit), nil
+		now, err := g.st.ControllerTimestamp()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return assignGenerationUnitTxnOps(g.doc.DocId, appName, unit, now), nil
 	}
 
 	return errors.Trace(g.st.db().Run(buildTxn))
 }
 
-func assignGenerationUnitTxnOps(id, appName string, unit *Unit) []txn.Op {
+func assignGenerationUnitTxnOps(id, appName string, unit *Unit, now *time.Time) []txn.Op {
 	assignedField := "assigned-units"
 	appField := fmt.Sprintf("%s.%s", assignedField, appName)
+	trackingField := fmt.Sprintf("tracking-times.%s", unit.Name())
 
 	return []txn.Op{
 		{
@@ -309,6 +348,7 @@ func assignGenerationUnitTxnOps(id, appName string, unit *Unit) []txn.Op {
 			}}},
 			Update: bson.D{
 				{"$push", bson.D{{appField, unit.Name()}}},
+				{"$set", bson.D{{trackingField, now.Unix()}}},
 			},
 		},
 	}
@@ -372,6 +412,66 @@ func (g *Generation) UpdateCharmConfig(appName string, master *Settings, validCh
 	return errors.Trace(g.st.db().Run(buildTxn))
 }
 
+// UpdateCharmRevision stages an upgrade of the input application's charm to
+// the input revision under this branch.
+// The charm at the target revision must already be present in the model.
+// The upgrade is applied to the application when the branch is committed.
+func (g *Generation) UpdateCharmRevision(appName string, revision int) error {
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		if attempt > 0 {
+			if err := g.Refresh(); err != nil {
+				return nil, errors.Trace(err)
+			}
+		}
+		if err := g.CheckNotComplete(); err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		app, err := g.st.Application(appName)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		curl, _ := app.CharmURL()
+		if curl == nil {
+			return nil, errors.Errorf("application %q has no charm URL set", appName)
+		}
+		if curl.Revision == revision {
+			if _, staged := g.doc.CharmRevisions[appName]; !staged {
+				return nil, jujutxn.ErrNoOperations
+			}
+		}
+		if _, err := g.st.Charm(curl.WithRevision(revision)); err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		set := bson.D{{"charm-revisions." + appName, revision}}
+		if _, ok := g.doc.AssignedUnits[appName]; !ok {
+			// Ensure the application is recorded as
+			// having changes under the branch.
+			set = append(set, bson.DocElem{Name: "assigned-units." + appName, Value: []string{}})
+		}
+
+		return []txn.Op{
+			{
+				C:      applicationsC,
+				Id:     app.doc.DocID,
+				Assert: bson.D{{"life", Alive}, {"charmurl", curl}},
+			},
+			{
+				C:  generationsC,
+				Id: g.doc.DocId,
+				Assert: bson.D{{"$and", []bson.D{
+					{{"completed", 0}},
+					{{"txn-revno", g.doc.TxnRevno}},
+				}}},
+				Update: bson.D{{"$set", set}},
+			},
+		}, nil
+	}
+
+	return errors.Trace(g.st.db().Run(buildTxn))
+}
+
 // Commit marks the generation as completed and assigns it the next value from
 // the generation sequence. The new generation ID is returned.
 func (g *Generation) Commit(userName string) (int, error) {
@@ -438,9 +538,41 @@ func (g *Generation) Commit(userName string) (int, error) {
 	if err := g.st.db().Run(buildTxn); err != nil {
 		return 0, errors.Trace(err)
 	}
+
+	// Staged charm upgrades are applied after the branch is marked
+	// complete. Changing an application's charm involves far too many
+	// operations to be folded into the commit transaction.
+	if newGenId > 0 {
+		if err := g.applyCharmRevisions(); err != nil {
+			return newGenId, errors.Trace(err)
+		}
+	}
 	return newGenId, nil
 }
 
+// applyCharmRevisions upgrades each application with a staged charm
+// revision change to the charm at its target revision.
+func (g *Generation) applyCharmRevisions() error {
+	for appName, revision := range g.doc.CharmRevisions {
+		app, err := g.st.Application(appName)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		curl, _ := app.CharmURL()
+		if curl == nil || curl.Revision == revision {
+			continue
+		}
+		ch, err := g.st.Charm(curl.WithRevision(revision))
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if err := app.SetCharm(SetCharmConfig{Charm: ch}); err != nil {
+			return errors.Annotatef(err, "upgrading application %q to charm revision %d", appName, revision)
+		}
+	}
+	return nil
+}
+
 // assignedWithAllUnits generates a new value for the branch's
 // AssignedUnits field, to indicate that all units of changed applications
 // are tracking the branch.
@@ -543,6 +675,52 @@ func (g *Generation) Abort(userName string) error {
 	return errors.Trace(g.st.db().Run(buildTxn))
 }
 
+// AbortWithRevert marks the generation as completed without a generation
+// ID being assigned, as per Abort, except that tracking units are
+// permitted. Branch changes are never applied to master settings until
+// commit, so removing the tracking data reverts any such units to the
+// pre-branch charm configuration.
+func (g *Generation) AbortWithRevert(userName string) error {
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		if attempt > 0 {
+			if err := g.Refresh(); err != nil {
+				return nil, errors.Trace(err)
+			}
+		}
+
+		if g.IsCompleted() {
+			if g.GenerationId() > 0 {
+				return nil, errors.New("branch was already committed")
+			}
+			return nil, jujutxn.ErrNoOperations
+		}
+
+		now, err := g.st.ControllerTimestamp()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		// As a proxy for checking that the generation has not changed,
+		// Assert that the txn rev-no has not changed since we materialised
+		// this generation object.
+		ops := []txn.Op{{
+			C:      generationsC,
+			Id:     g.doc.DocId,
+			Assert: bson.D{{"txn-revno", g.doc.TxnRevno}},
+			Update: bson.D{
+				{"$set", bson.D{
+					{"assigned-units", map[string][]string{}},
+					{"completed", now.Unix()},
+					{"completed-by", userName},
+				}},
+				{"$unset", bson.D{{"tracking-times", 1}}},
+			},
+		}}
+		return ops, nil
+	}
+
+	return errors.Trace(g.st.db().Run(buildTxn))
+}
+
 // CheckNotComplete returns an error if this
 // generation was committed or aborted.
 func (g *Generation) CheckNotComplete() error {
@@ -594,6 +772,7 @@ func (g *Generation) unassignUnitOps(unitName, appName string) []txn.Op {
 		Assert: bson.D{{"txn-revno", g.doc.TxnRevno}},
 		Update: bson.D{
 			{"$pull", bson.D{{appField, unitName}}},
+			{"$unset", bson.D{{fmt.Sprintf("tracking-times.%s", unitName), 1}}},
 		},
 	}}
 }
@@ -609,6 +788,10 @@ func (g *Generation) HasChangesFor(appName string) bool {
 // for the application from the generation.
 func (g *Generation) unassignAppOps(appName string) []txn.Op {
 	assigned := g.doc.AssignedUnits
+	unset := bson.D{{fmt.Sprintf("charm-revisions.%s", appName), 1}}
+	for _, unitName := range assigned[appName] {
+		unset = append(unset, bson.DocElem{Name: fmt.Sprintf("tracking-times.%s", unitName), Value: 1})
+	}
 	delete(assigned, appName)
 	ops := []txn.Op{{
 		C:      generationsC,
@@ -616,6 +799,7 @@ func (g *Generation) unassignAppOps(appName string) []txn.Op {
 		Assert: bson.D{{"txn-revno", g.doc.TxnRevno}},
 		Update: bson.D{
 			{"$set", bson.D{{"assigned-units", assigned}}},
+			{"$unset", unset},
 		},
 	}}
 	currentCfg := g.doc.Config
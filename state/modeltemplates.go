@@ -0,0 +1,137 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2"
+	"github.com/juju/mgo/v2/bson"
+	"github.com/juju/mgo/v2/txn"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/mongo/utils"
+)
+
+// modelTemplateDoc describes a named set of model defaults stored at
+// the controller level. A template captures model config values and a
+// constraints string that are applied together when a model is created
+// from the template.
+type modelTemplateDoc struct {
+	// DocID is the template name.
+	DocID string `bson:"_id"`
+	Name  string `bson:"name"`
+
+	// Config holds model config values applied to models created from
+	// this template. Keys are escaped for mongo.
+	Config map[string]interface{} `bson:"config,omitempty"`
+
+	// Constraints holds a constraints string applied as the model
+	// constraints of models created from this template.
+	Constraints string `bson:"constraints,omitempty"`
+}
+
+// ModelTemplate represents a named set of model defaults that can be
+// applied when creating a model.
+type ModelTemplate struct {
+	st  *State
+	doc modelTemplateDoc
+}
+
+// Name returns the name of the template.
+func (t *ModelTemplate) Name() string {
+	return t.doc.Name
+}
+
+// Config returns the model config values captured by the template.
+func (t *ModelTemplate) Config() map[string]interface{} {
+	return utils.UnescapeKeys(t.doc.Config)
+}
+
+// Constraints returns the constraints string captured by the template.
+func (t *ModelTemplate) Constraints() string {
+	return t.doc.Constraints
+}
+
+// SetModelTemplate creates or replaces the named model template.
+func (st *State) SetModelTemplate(name string, config map[string]interface{}, cons string) error {
+	if !names.IsValidModelName(name) {
+		return errors.NotValidf("model template name %q", name)
+	}
+	doc := modelTemplateDoc{
+		DocID:       name,
+		Name:        name,
+		Config:      utils.EscapeKeys(config),
+		Constraints: cons,
+	}
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		col, closer := st.db().GetCollection(modelTemplatesC)
+		defer closer()
+		count, err := col.FindId(name).Count()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if count == 0 {
+			return []txn.Op{{
+				C:      modelTemplatesC,
+				Id:     name,
+				Assert: txn.DocMissing,
+				Insert: doc,
+			}}, nil
+		}
+		return []txn.Op{{
+			C:      modelTemplatesC,
+			Id:     name,
+			Assert: txn.DocExists,
+			Update: bson.D{{"$set", bson.D{
+				{"config", doc.Config},
+				{"constraints", doc.Constraints},
+			}}},
+		}}, nil
+	}
+	return errors.Annotatef(st.db().Run(buildTxn), "cannot set model template %q", name)
+}
+
+// ModelTemplate returns the named model template.
+func (st *State) ModelTemplate(name string) (*ModelTemplate, error) {
+	col, closer := st.db().GetCollection(modelTemplatesC)
+	defer closer()
+
+	var doc modelTemplateDoc
+	err := col.FindId(name).One(&doc)
+	if err == mgo.ErrNotFound {
+		return nil, errors.NotFoundf("model template %q", name)
+	}
+	if err != nil {
+		return nil, errors.Annotatef(err, "cannot get model template %q", name)
+	}
+	return &ModelTemplate{st: st, doc: doc}, nil
+}
+
+// AllModelTemplates returns all model templates defined on the
+// controller.
+func (st *State) AllModelTemplates() ([]*ModelTemplate, error) {
+	col, closer := st.db().GetCollection(modelTemplatesC)
+	defer closer()
+
+	var docs []modelTemplateDoc
+	if err := col.Find(nil).Sort("name").All(&docs); err != nil {
+		return nil, errors.Annotate(err, "cannot get all model templates")
+	}
+	result := make([]*ModelTemplate, len(docs))
+	for i, doc := range docs {
+		result[i] = &ModelTemplate{st: st, doc: doc}
+	}
+	return result, nil
+}
+
+// RemoveModelTemplate removes the named model template. It is not an
+// error to remove a template that does not exist.
+func (st *State) RemoveModelTemplate(name string) error {
+	ops := []txn.Op{{
+		C:      modelTemplatesC,
+		Id:     name,
+		Remove: true,
+	}}
+	return errors.Annotatef(st.db().RunTransaction(ops), "cannot remove model template %q", name)
+}
@@ -0,0 +1,174 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2/bson"
+	"github.com/juju/mgo/v2/txn"
+	"github.com/juju/names/v4"
+)
+
+// storageMoveCheckInterval is how long the cleanup machinery waits
+// between checks on a storage move whose detachment has not yet
+// completed.
+const storageMoveCheckInterval = 30 * time.Second
+
+// storageInstanceDetachable reports whether the storage instance's
+// underlying volume or filesystem can be detached from its host, as
+// determined by the storage provider.
+func (sb *storageBackend) storageInstanceDetachable(si *storageInstance) (bool, error) {
+	switch si.Kind() {
+	case StorageKindBlock:
+		volume, err := sb.storageInstanceVolume(si.StorageTag())
+		if errors.IsNotFound(err) {
+			return false, nil
+		} else if err != nil {
+			return false, errors.Trace(err)
+		}
+		return volume.Detachable(), nil
+	case StorageKindFilesystem:
+		filesystem, err := sb.storageInstanceFilesystem(si.StorageTag())
+		if errors.IsNotFound(err) {
+			return false, nil
+		} else if err != nil {
+			return false, errors.Trace(err)
+		}
+		return filesystem.Detachable(), nil
+	}
+	return false, nil
+}
+
+// MoveStorage moves the given storage instance to a unit of the same
+// application on the specified machine. The storage is detached from
+// its current unit; once the machine agent has unmounted it and the
+// attachment records have been removed, the cleanup machinery attaches
+// it to the destination unit. The storage provider must support
+// detaching the underlying volume or filesystem.
+func (sb *storageBackend) MoveStorage(storage names.StorageTag, machineId string) error {
+	si, err := sb.storageInstance(storage)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	detachable, err := sb.storageInstanceDetachable(si)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !detachable {
+		return errors.NotSupportedf("moving non-detachable storage %s", storage.Id())
+	}
+
+	attachments, err := sb.StorageAttachments(storage)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(attachments) == 0 {
+		return errors.Errorf("storage %s is not attached to a unit", storage.Id())
+	}
+	if len(attachments) > 1 {
+		return errors.Errorf("storage %s is attached to multiple units", storage.Id())
+	}
+	oldUnitTag := attachments[0].Unit()
+
+	appName, err := names.UnitApplication(oldUnitTag.Id())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	destUnit, err := sb.unitOnMachine(appName, machineId)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if destUnit.Name() == oldUnitTag.Id() {
+		return errors.Errorf("storage %s is already attached to a unit on machine %s", storage.Id(), machineId)
+	}
+
+	if err := sb.DetachStorage(storage, oldUnitTag, false, 0); err != nil {
+		return errors.Trace(err)
+	}
+	ops := []txn.Op{newCleanupAtOp(
+		sb.mb.clock().Now().Add(storageMoveCheckInterval),
+		cleanupMoveStorage, storage.Id(), destUnit.Name(),
+	)}
+	return errors.Annotatef(sb.mb.db().RunTransaction(ops), "scheduling attachment of %s", storage.Id())
+}
+
+// unitOnMachine returns the unit of the named application assigned to
+// the given machine.
+func (sb *storageBackend) unitOnMachine(appName, machineId string) (*Unit, error) {
+	machine, err := sb.machine(machineId)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if machine.Life() != Alive {
+		return nil, errors.Errorf("machine %s is not alive", machineId)
+	}
+	units, err := machine.Units()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, unit := range units {
+		if unit.ApplicationName() == appName && unit.Life() == Alive {
+			return unit, nil
+		}
+	}
+	return nil, errors.NotFoundf("unit of application %q on machine %s", appName, machineId)
+}
+
+// cleanupMoveStorage attaches the given storage instance to the
+// destination unit of a storage move, rescheduling itself while the
+// detachment from the previous unit is still in progress.
+func (st *State) cleanupMoveStorage(storageId string, args []bson.Raw) error {
+	var unitName string
+	if n := len(args); n != 1 {
+		return errors.Errorf("expected 1 argument, got %d", n)
+	}
+	if err := args[0].Unmarshal(&unitName); err != nil {
+		return errors.Annotate(err, "unmarshalling cleanup args")
+	}
+	storageTag := names.NewStorageTag(storageId)
+
+	sb, err := NewStorageBackend(st)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	attachments, err := sb.StorageAttachments(storageTag)
+	if errors.IsNotFound(err) {
+		// The storage was removed after detaching; there is nothing
+		// left to move.
+		return nil
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(attachments) > 0 {
+		// The machine agent has not finished detaching the storage
+		// from its previous unit; check again later.
+		ops := []txn.Op{newCleanupAtOp(
+			st.stateClock.Now().Add(storageMoveCheckInterval),
+			cleanupMoveStorage, storageId, unitName,
+		)}
+		return errors.Trace(st.db().RunTransaction(ops))
+	}
+	if _, err := sb.storageInstance(storageTag); errors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+
+	unit, err := st.Unit(unitName)
+	if errors.IsNotFound(err) {
+		logger.Warningf("destination unit %q for storage %s was removed", unitName, storageId)
+		return nil
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if unit.Life() != Alive {
+		logger.Warningf("destination unit %q for storage %s is %s", unitName, storageId, unit.Life())
+		return nil
+	}
+	return errors.Trace(sb.AttachStorage(storageTag, unit.UnitTag()))
+}
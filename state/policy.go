@@ -103,11 +103,17 @@ func (st *State) constraintsValidator() (constraints.Validator, error) {
 	if err != nil {
 		return nil, errors.Annotate(err, "getting model")
 	}
+	cfg, err := model.ModelConfig()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	// The model config can name list-valued attributes whose values
+	// are merged additively with model constraints instead of being
+	// overridden by application or machine constraints.
+	if additive := cfg.AdditiveConstraints(); len(additive) > 0 {
+		validator.RegisterAdditive(additive)
+	}
 	if region := model.CloudRegion(); region != "" {
-		cfg, err := model.ModelConfig()
-		if err != nil {
-			return nil, errors.Trace(err)
-		}
 		arches, err := st.CloudImageMetadataStorage.SupportedArchitectures(
 			cloudimagemetadata.MetadataFilter{
 				Stream: cfg.AgentStream(),
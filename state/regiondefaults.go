@@ -0,0 +1,108 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2/bson"
+
+	"github.com/juju/juju/storage"
+	"github.com/juju/juju/storage/poolmanager"
+)
+
+// regionStoragePoolDefaultsKey returns the key used in the global
+// settings collection for the default storage pools of a cloud or,
+// if region is not empty, of one of its regions.
+func regionStoragePoolDefaultsKey(cloud, region string) string {
+	if region == "" {
+		return cloudGlobalKey(cloud) + "#storage-pools"
+	}
+	return regionSettingsGlobalKey(cloud, region) + "#storage-pools"
+}
+
+// RegionStoragePoolDefaults returns the default storage pools to create
+// in new models on the given cloud region. Pools defined for the cloud
+// as a whole are overlaid with any pools defined for the specific
+// region, keyed by pool name.
+func (st *State) RegionStoragePoolDefaults(cloud, region string) (map[string]storage.Attrs, error) {
+	pools := make(map[string]storage.Attrs)
+	keys := []string{regionStoragePoolDefaultsKey(cloud, "")}
+	if region != "" {
+		keys = append(keys, regionStoragePoolDefaultsKey(cloud, region))
+	}
+	for _, key := range keys {
+		settings, err := readSettings(st.db(), globalSettingsC, key)
+		if errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, errors.Annotatef(err, "reading default storage pools for cloud %q", cloud)
+		}
+		for name, value := range settings.Map() {
+			attrs, err := poolDefaultAttrs(value)
+			if err != nil {
+				return nil, errors.Annotatef(err, "invalid default storage pool %q", name)
+			}
+			pools[name] = attrs
+		}
+	}
+	return pools, nil
+}
+
+// poolDefaultAttrs converts a settings value back into storage pool
+// attributes. Nested settings values are decoded as bson maps.
+func poolDefaultAttrs(value interface{}) (storage.Attrs, error) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		return storage.Attrs(value), nil
+	case bson.M:
+		attrs := make(storage.Attrs, len(value))
+		for k, v := range value {
+			attrs[k] = v
+		}
+		return attrs, nil
+	}
+	return nil, errors.Errorf("expected pool attributes, got %T", value)
+}
+
+// UpdateRegionStoragePoolDefaults updates the default storage pools
+// created in new models on the given cloud region. Each entry in update
+// adds or replaces the definition of the named pool; each name in
+// remove drops it. Passing an empty region updates the defaults for the
+// cloud as a whole.
+func (st *State) UpdateRegionStoragePoolDefaults(
+	cloud, region string,
+	update map[string]storage.Attrs,
+	remove []string,
+) error {
+	if _, err := st.Cloud(cloud); err != nil {
+		return errors.Trace(err)
+	}
+	for name, attrs := range update {
+		pType, _ := attrs[poolmanager.Type].(string)
+		if pType == "" {
+			return errors.Errorf("missing provider type for storage pool %q", name)
+		}
+	}
+	key := regionStoragePoolDefaultsKey(cloud, region)
+	updateAttrs := make(map[string]interface{}, len(update))
+	for name, attrs := range update {
+		updateAttrs[name] = map[string]interface{}(attrs)
+	}
+	settings, err := readSettings(st.db(), globalSettingsC, key)
+	if errors.IsNotFound(err) {
+		if len(update) == 0 {
+			return nil
+		}
+		_, err := createSettings(st.db(), globalSettingsC, key, updateAttrs)
+		return errors.Annotatef(err, "creating default storage pools for cloud %q", cloud)
+	} else if err != nil {
+		return errors.Annotatef(err, "reading default storage pools for cloud %q", cloud)
+	}
+	settings.Update(updateAttrs)
+	for _, name := range remove {
+		settings.Delete(name)
+	}
+	_, err = settings.Write()
+	return errors.Annotatef(err, "updating default storage pools for cloud %q", cloud)
+}
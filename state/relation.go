@@ -49,6 +49,8 @@ type relationDoc struct {
 	UnitCount       int        `bson:"unitcount"`
 	Suspended       bool       `bson:"suspended"`
 	SuspendedReason string     `bson:"suspended-reason"`
+	Frozen          bool       `bson:"frozen,omitempty"`
+	FrozenReason    string     `bson:"frozen-reason,omitempty"`
 }
 
 // Relation represents a relation between one or two application endpoints.
@@ -88,6 +90,19 @@ func (r *Relation) SuspendedReason() string {
 	return r.doc.SuspendedReason
 }
 
+// Frozen returns true if the relation is frozen. A frozen relation
+// remains established, but does not admit new units into scope, so
+// operators can quiesce a relation across a large topology change
+// without removing it.
+func (r *Relation) Frozen() bool {
+	return r.doc.Frozen
+}
+
+// FrozenReason returns the reason why the relation is frozen.
+func (r *Relation) FrozenReason() string {
+	return r.doc.FrozenReason
+}
+
 // Refresh refreshes the contents of the relation from the underlying
 // state. It returns an error that satisfies errors.IsNotFound if the
 // relation has been removed.
@@ -245,6 +260,48 @@ func (r *Relation) SetSuspended(suspended bool, suspendedReason string) error {
 	return err
 }
 
+// SetFrozen sets whether the relation is frozen. A frozen relation does
+// not admit new units into scope until it is resumed, allowing operators
+// to quiesce the relation during large topology changes without removing
+// it. Freezing requires the relation to be alive.
+func (r *Relation) SetFrozen(frozen bool, frozenReason string) error {
+	if r.doc.Frozen == frozen {
+		return nil
+	}
+	if !frozen && frozenReason != "" {
+		return errors.New("cannot set frozen reason if not frozen")
+	}
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		if attempt > 0 {
+			if err := r.Refresh(); err != nil {
+				return nil, errors.Trace(err)
+			}
+			if r.doc.Frozen == frozen {
+				return nil, jujutxn.ErrNoOperations
+			}
+		}
+		assert := bson.D{{"frozen", bson.D{{"$ne", frozen}}}}
+		if frozen {
+			assert = append(assert, isAliveDoc...)
+		}
+		return []txn.Op{{
+			C:      relationsC,
+			Id:     r.doc.DocID,
+			Assert: assert,
+			Update: bson.D{{"$set", bson.D{
+				{"frozen", frozen},
+				{"frozen-reason", frozenReason},
+			}}},
+		}}, nil
+	}
+	if err := r.st.db().Run(buildTxn); err != nil {
+		return errors.Annotatef(err, "cannot set frozen status of relation %q", r)
+	}
+	r.doc.Frozen = frozen
+	r.doc.FrozenReason = frozenReason
+	return nil
+}
+
 func (r *Relation) checkConsumePermission(offerUUID, userId string) (bool, error) {
 	perm, err := r.st.GetOfferAccess(offerUUID, names.NewUserTag(userId))
 	if err != nil && !errors.IsNotFound(err) {
@@ -902,6 +959,10 @@ func (r *Relation) UpdateApplicationSettingsOperation(appName string, token lead
 		return nil, errors.Trace(err)
 	}
 
+	if err := r.validateSettings(updates); err != nil {
+		return nil, errors.Trace(err)
+	}
+
 	key := relationApplicationSettingsKey(r.Id(), ep.ApplicationName)
 	return newUpdateLeaderSettingsOperation(r.st.db(), token, key, updates), nil
 }
@@ -0,0 +1,159 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2/bson"
+	"github.com/juju/mgo/v2/txn"
+)
+
+// OrphanedRelationDocs describes relation scope and relation settings
+// documents whose relation no longer exists. Such documents can be left
+// behind by force-removals that were interrupted part way through, and
+// are never cleaned up by the regular cleanup machinery because the
+// relation they would be cleaned up with is already gone.
+type OrphanedRelationDocs struct {
+	// ScopeDocs holds the local ids of orphaned documents in the
+	// relation scopes collection.
+	ScopeDocs []string
+
+	// SettingsDocs holds the local ids of orphaned relation unit and
+	// application settings documents in the settings collection.
+	SettingsDocs []string
+}
+
+// IsEmpty reports whether the report contains no orphaned documents.
+func (d OrphanedRelationDocs) IsEmpty() bool {
+	return len(d.ScopeDocs) == 0 && len(d.SettingsDocs) == 0
+}
+
+// OrphanedRelationDocs returns a report of relation scope and settings
+// documents in this model that refer to relations that no longer exist.
+// No documents are modified.
+func (st *State) OrphanedRelationDocs() (OrphanedRelationDocs, error) {
+	var report OrphanedRelationDocs
+	liveIDs, err := st.liveRelationIDs()
+	if err != nil {
+		return report, errors.Trace(err)
+	}
+	report.ScopeDocs, err = st.orphanedRelationDocIDs(relationScopesC, liveIDs)
+	if err != nil {
+		return report, errors.Trace(err)
+	}
+	report.SettingsDocs, err = st.orphanedRelationDocIDs(settingsC, liveIDs)
+	if err != nil {
+		return report, errors.Trace(err)
+	}
+	return report, nil
+}
+
+// RemoveOrphanedRelationDocs removes relation scope and settings
+// documents in this model that refer to relations that no longer
+// exist, issuing transactions of at most batchSize removals at a time.
+// It returns a report of the documents removed.
+func (st *State) RemoveOrphanedRelationDocs(batchSize int) (OrphanedRelationDocs, error) {
+	if batchSize <= 0 {
+		return OrphanedRelationDocs{}, errors.NotValidf("batch size %d", batchSize)
+	}
+	report, err := st.OrphanedRelationDocs()
+	if err != nil {
+		return OrphanedRelationDocs{}, errors.Trace(err)
+	}
+	if err := st.removeDocsInBatches(relationScopesC, report.ScopeDocs, batchSize); err != nil {
+		return OrphanedRelationDocs{}, errors.Trace(err)
+	}
+	if err := st.removeDocsInBatches(settingsC, report.SettingsDocs, batchSize); err != nil {
+		return OrphanedRelationDocs{}, errors.Trace(err)
+	}
+	return report, nil
+}
+
+// liveRelationIDs returns the ids of all relations in the model.
+func (st *State) liveRelationIDs() (map[int]bool, error) {
+	relations, closer := st.db().GetCollection(relationsC)
+	defer closer()
+
+	var docs []struct {
+		Id int `bson:"id"`
+	}
+	if err := relations.Find(nil).Select(bson.D{{"id", 1}}).All(&docs); err != nil {
+		return nil, errors.Annotate(err, "reading relations")
+	}
+	ids := make(map[int]bool, len(docs))
+	for _, doc := range docs {
+		ids[doc.Id] = true
+	}
+	return ids, nil
+}
+
+// orphanedRelationDocIDs returns the local ids of documents in the
+// given collection whose keys name a relation id not in liveIDs.
+func (st *State) orphanedRelationDocIDs(collName string, liveIDs map[int]bool) (_ []string, err error) {
+	coll, closer := st.db().GetCollection(collName)
+	defer closer()
+
+	sel := bson.D{{"_id", bson.D{{"$regex", "^" + st.docID("r#")}}}}
+	iter := coll.Find(sel).Select(bson.D{{"_id", 1}}).Iter()
+	defer closeIter(iter, &err, "reading "+collName)
+
+	var orphaned []string
+	var doc struct {
+		DocID string `bson:"_id"`
+	}
+	for iter.Next(&doc) {
+		localID := st.localID(doc.DocID)
+		relationID, ok := relationIDFromKey(localID)
+		if !ok {
+			continue
+		}
+		if !liveIDs[relationID] {
+			orphaned = append(orphaned, localID)
+		}
+	}
+	return orphaned, nil
+}
+
+// relationIDFromKey extracts the relation id from a relation scope or
+// settings document key of the form "r#<relation id>#...".
+func relationIDFromKey(key string) (int, bool) {
+	parts := strings.SplitN(key, "#", 3)
+	if len(parts) < 2 || parts[0] != "r" {
+		return 0, false
+	}
+	id, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
+
+// removeDocsInBatches removes the documents with the given local ids
+// from the collection, at most batchSize at a time. The documents are
+// known to be orphaned, so the removals carry no assertions and a
+// document that has already gone is not an error.
+func (st *State) removeDocsInBatches(collName string, localIDs []string, batchSize int) error {
+	for len(localIDs) > 0 {
+		n := batchSize
+		if n > len(localIDs) {
+			n = len(localIDs)
+		}
+		ops := make([]txn.Op, n)
+		for i, localID := range localIDs[:n] {
+			ops[i] = txn.Op{
+				C:      collName,
+				Id:     st.docID(localID),
+				Remove: true,
+			}
+		}
+		if err := st.db().RunRawTransaction(ops); err != nil {
+			return errors.Annotatef(err, "removing orphaned documents from %s", collName)
+		}
+		localIDs = localIDs[n:]
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/juju/mgo/v2/bson"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+type RelationCleanupSuite struct {
+	internalStateSuite
+}
+
+var _ = gc.Suite(&RelationCleanupSuite{})
+
+// addOrphanedRelationDocs inserts scope and settings documents for a
+// relation id that does not exist, simulating the debris left behind
+// by an interrupted force-removal.
+func (s *RelationCleanupSuite) addOrphanedRelationDocs(c *gc.C, relationID int) (scopeKey, settingsKey string) {
+	scopeKey = fmt.Sprintf("r#%d#peer#riak/0", relationID)
+	settingsKey = scopeKey
+
+	scopes, closer := s.state.db().GetCollection(relationScopesC)
+	defer closer()
+	err := scopes.Writeable().Insert(relationScopeDoc{
+		DocID:     s.state.docID(scopeKey),
+		Key:       scopeKey,
+		ModelUUID: s.state.ModelUUID(),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	settings, closer := s.state.db().GetCollection(settingsC)
+	defer closer()
+	err = settings.Writeable().Insert(bson.D{
+		{"_id", s.state.docID(settingsKey)},
+		{"model-uuid", s.state.ModelUUID()},
+		{"settings", bson.M{"private-address": "1.2.3.4"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	return scopeKey, settingsKey
+}
+
+func (s *RelationCleanupSuite) TestOrphanedRelationDocsEmpty(c *gc.C) {
+	report, err := s.state.OrphanedRelationDocs()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(report.IsEmpty(), jc.IsTrue)
+}
+
+func (s *RelationCleanupSuite) TestOrphanedRelationDocsReports(c *gc.C) {
+	scopeKey, settingsKey := s.addOrphanedRelationDocs(c, 42)
+
+	report, err := s.state.OrphanedRelationDocs()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(report.ScopeDocs, gc.DeepEquals, []string{scopeKey})
+	c.Check(report.SettingsDocs, gc.DeepEquals, []string{settingsKey})
+}
+
+func (s *RelationCleanupSuite) TestRemoveOrphanedRelationDocs(c *gc.C) {
+	s.addOrphanedRelationDocs(c, 42)
+	s.addOrphanedRelationDocs(c, 47)
+
+	// A batch size smaller than the number of documents exercises the
+	// batching loop.
+	report, err := s.state.RemoveOrphanedRelationDocs(1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(report.ScopeDocs, gc.HasLen, 2)
+	c.Check(report.SettingsDocs, gc.HasLen, 2)
+
+	report, err = s.state.OrphanedRelationDocs()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(report.IsEmpty(), jc.IsTrue)
+}
+
+func (s *RelationCleanupSuite) TestRemoveOrphanedRelationDocsBadBatchSize(c *gc.C) {
+	_, err := s.state.RemoveOrphanedRelationDocs(0)
+	c.Check(err, gc.ErrorMatches, "batch size 0 not valid")
+}
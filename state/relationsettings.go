@@ -0,0 +1,72 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"sync"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/quota"
+)
+
+// RelationSettingsValidator validates settings that a charm attempts to
+// write into a relation. Validators are registered against a relation
+// interface name, so that charms implementing a well-known interface can
+// have their settings checked against the interface's schema.
+type RelationSettingsValidator func(settings map[string]interface{}) error
+
+var (
+	relationSettingsValidatorsMu sync.RWMutex
+	relationSettingsValidators   = make(map[string]RelationSettingsValidator)
+)
+
+// RegisterRelationSettingsValidator registers a validator for settings
+// written to relations whose endpoints use the given interface name. Any
+// previously registered validator for the interface is replaced.
+func RegisterRelationSettingsValidator(interfaceName string, validator RelationSettingsValidator) {
+	relationSettingsValidatorsMu.Lock()
+	defer relationSettingsValidatorsMu.Unlock()
+	relationSettingsValidators[interfaceName] = validator
+}
+
+func relationSettingsValidator(interfaceName string) (RelationSettingsValidator, bool) {
+	relationSettingsValidatorsMu.RLock()
+	defer relationSettingsValidatorsMu.RUnlock()
+	validator, ok := relationSettingsValidators[interfaceName]
+	return validator, ok
+}
+
+// validateSettings checks settings about to be written into the relation
+// against the controller-configured size limit and against any validator
+// registered for the relation's interfaces. The size limit guards the
+// settings collection against a single charm writing unbounded data.
+func (r *Relation) validateSettings(settings map[string]interface{}) error {
+	cfg, err := r.st.ControllerConfig()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	checker := quota.NewBSONTotalSizeChecker(cfg.MaxRelationSettingsSize())
+	checker.Check(settings)
+	if err := checker.Outcome(); err != nil {
+		return errors.Annotatef(err, "settings for relation %q", r)
+	}
+
+	seen := set.NewStrings()
+	for _, ep := range r.Endpoints() {
+		if seen.Contains(ep.Interface) {
+			continue
+		}
+		seen.Add(ep.Interface)
+		validator, ok := relationSettingsValidator(ep.Interface)
+		if !ok {
+			continue
+		}
+		if err := validator(settings); err != nil {
+			return errors.Annotatef(err, "invalid settings for relation %q", r)
+		}
+	}
+	return nil
+}
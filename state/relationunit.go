@@ -83,6 +83,13 @@ func (ru *RelationUnit) EnterScope(settings map[string]interface{}) error {
 		return nil
 	}
 
+	// Verify that the settings the unit is bringing into scope are
+	// within the configured size limit and satisfy any validator
+	// registered for the relation's interfaces.
+	if err := ru.relation.validateSettings(settings); err != nil {
+		return errors.Trace(err)
+	}
+
 	// Collect the operations necessary to enter scope, as follows:
 	// * Check unit and relation state, and incref the relation.
 	// * TODO(fwereade): check unit status == params.StatusActive (this
@@ -97,10 +104,11 @@ func (ru *RelationUnit) EnterScope(settings map[string]interface{}) error {
 			Assert: isAliveDoc,
 		})
 	}
+	notFrozen := bson.D{{"frozen", bson.D{{"$ne", true}}}}
 	ops = append(ops, txn.Op{
 		C:      relationsC,
 		Id:     relationDocID,
-		Assert: isAliveDoc,
+		Assert: append(notFrozen, isAliveDoc...),
 		Update: bson.D{{"$inc", bson.D{{"unitcount", 1}}}},
 	})
 
@@ -166,6 +174,12 @@ func (ru *RelationUnit) EnterScope(settings map[string]interface{}) error {
 	} else if !alive {
 		return stateerrors.ErrCannotEnterScope
 	}
+	// The relation may have been frozen by an operator while we were
+	// attempting to enter scope.
+	var relDoc relationDoc
+	if err := relations.FindId(relationDocID).One(&relDoc); err == nil && relDoc.Frozen {
+		return stateerrors.ErrRelationFrozen
+	}
 	if ru.isLocalUnit {
 		units, closer := db.GetCollection(unitsC)
 		defer closer()
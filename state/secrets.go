@@ -4,6 +4,7 @@
 package state
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -18,7 +19,7 @@ import (
 
 	"github.com/juju/juju/core/secrets"
 	corewatcher "github.com/juju/juju/core/watcher"
-	"github.com/juju/juju/mongo/utils"
+	"github.com/juju/juju/state/docstore"
 	"github.com/juju/juju/state/watcher"
 )
 
@@ -69,6 +70,9 @@ type SecretsStore interface {
 }
 
 // NewSecretsStore creates a new mongo backed secrets store.
+// Secret metadata is kept under transaction control, while the
+// values themselves are opaque blobs held in the document store so
+// they can follow the controller's configured storage backend.
 func NewSecretsStore(st *State) *secretsStore {
 	return &secretsStore{st: st}
 }
@@ -91,32 +95,18 @@ type secretMetadataDoc struct {
 	UpdateTime     time.Time         `bson:"update-time"`
 }
 
-type secretValueDoc struct {
-	DocID string `bson:"_id"`
-
-	Data secretsDataMap `bson:"data"`
-}
-
-type secretsDataMap map[string]interface{}
-
-func (m *secretsDataMap) SetBSON(raw bson.Raw) error {
-	rawMap := make(map[string]interface{})
-	if err := raw.Unmarshal(rawMap); err != nil {
-		return err
-	}
-	*m = utils.UnescapeKeys(rawMap)
-	return nil
-}
-
-func (m secretsDataMap) GetBSON() (interface{}, error) {
-	escapedMap := utils.EscapeKeys(m)
-	return escapedMap, nil
-}
-
 type secretsStore struct {
 	st *State
 }
 
+// docStore returns the document store to read and write secret values
+// through. It is resolved per operation rather than held, because the
+// state-storage-backend controller config attribute can change at
+// runtime.
+func (s *secretsStore) docStore() (docstore.Store, error) {
+	return s.st.DocStore()
+}
+
 func (s *secretsStore) secretMetadataDoc(baseURL *secrets.URL, p *CreateSecretParams) (*secrets.URL, *secretMetadataDoc, error) {
 	id, err := sequenceWithMin(s.st, "secret", 1)
 	if err != nil {
@@ -166,15 +156,24 @@ func (s *secretsStore) updateSecretMetadataDoc(doc *secretMetadataDoc, baseURL *
 	return &updatedURL
 }
 
-func (s *secretsStore) secretValueDoc(url *secrets.URL, data secrets.SecretData) *secretValueDoc {
-	dataCopy := make(secretsDataMap)
-	for k, v := range data {
-		dataCopy[k] = v
+// putSecretValue writes the value for the given revisioned URL to the
+// document store, replacing any blob left behind by an interrupted
+// earlier write. Values are serialized as JSON so they read the same
+// from whichever backend the store is using.
+func (s *secretsStore) putSecretValue(url *secrets.URL, data secrets.SecretData) error {
+	blob, err := json.Marshal(data)
+	if err != nil {
+		return errors.Trace(err)
 	}
-	return &secretValueDoc{
-		DocID: url.String(),
-		Data:  dataCopy,
+	docs, err := s.docStore()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	err = docs.Insert(secretValuesC, url.ID(), blob)
+	if errors.IsAlreadyExists(err) {
+		err = docs.Update(secretValuesC, url.ID(), blob)
 	}
+	return errors.Trace(err)
 }
 
 // CreateSecret creates a new secret.
@@ -183,11 +182,10 @@ func (s *secretsStore) CreateSecret(baseURL *secrets.URL, p CreateSecretParams)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	valueDoc := s.secretValueDoc(URL, p.Data)
 	buildTxn := func(attempt int) ([]txn.Op, error) {
 		if attempt > 0 {
-			if _, err := s.GetSecretValue(URL); err == nil {
-				return nil, errors.AlreadyExistsf("secret value for %q", URL.ID())
+			if _, err := s.GetSecret(baseURL); err == nil {
+				return nil, errors.AlreadyExistsf("secret %q", baseURL.ID())
 			}
 		}
 		ops := []txn.Op{
@@ -196,11 +194,6 @@ func (s *secretsStore) CreateSecret(baseURL *secrets.URL, p CreateSecretParams)
 				Id:     metadataDoc.DocID,
 				Assert: txn.DocMissing,
 				Insert: *metadataDoc,
-			}, {
-				C:      secretValuesC,
-				Id:     valueDoc.DocID,
-				Assert: txn.DocMissing,
-				Insert: *valueDoc,
 			},
 		}
 		rotateOps, err := s.secretRotationOps(metadataDoc.ID, URL, metadataDoc.Owner, p.RotateInterval)
@@ -214,6 +207,9 @@ func (s *secretsStore) CreateSecret(baseURL *secrets.URL, p CreateSecretParams)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	if err := s.putSecretValue(URL, p.Data); err != nil {
+		return nil, errors.Trace(err)
+	}
 	return s.toSecretMetadata(metadataDoc)
 }
 
@@ -229,6 +225,7 @@ func (s *secretsStore) UpdateSecret(url *secrets.URL, p UpdateSecretParams) (*se
 	defer closer()
 
 	var metadataDoc secretMetadataDoc
+	var updatedURL *secrets.URL
 	buildTxn := func(attempt int) ([]txn.Op, error) {
 		err := secretMetadataCollection.FindId(url.WithRevision(0).ID()).One(&metadataDoc)
 		if errors.Cause(err) == mgo.ErrNotFound {
@@ -237,27 +234,19 @@ func (s *secretsStore) UpdateSecret(url *secrets.URL, p UpdateSecretParams) (*se
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
-		updatedURL := s.updateSecretMetadataDoc(&metadataDoc, url, &p)
+		currentRevision := metadataDoc.Revision
+		updatedURL = s.updateSecretMetadataDoc(&metadataDoc, url, &p)
 		ops := []txn.Op{
 			{
-				C:      secretMetadataC,
-				Id:     metadataDoc.DocID,
-				Assert: txn.DocExists,
+				C:  secretMetadataC,
+				Id: metadataDoc.DocID,
+				// Asserting on the read revision stops a racing
+				// update from minting the same revision, and so
+				// the same value document id.
+				Assert: bson.D{{"revision", currentRevision}},
 				Update: bson.M{"$set": metadataDoc},
 			},
 		}
-		if len(p.Data) > 0 {
-			if _, err := s.GetSecretValue(updatedURL); err == nil {
-				return nil, errors.AlreadyExistsf("secret value for %q", url.ID())
-			}
-			valueDoc := s.secretValueDoc(updatedURL, p.Data)
-			ops = append(ops, txn.Op{
-				C:      secretValuesC,
-				Id:     valueDoc.DocID,
-				Assert: txn.DocMissing,
-				Insert: *valueDoc,
-			})
-		}
 		if p.RotateInterval != nil {
 			rotateOps, err := s.secretRotationOps(metadataDoc.ID, url, metadataDoc.Owner, *p.RotateInterval)
 			if err != nil {
@@ -271,6 +260,11 @@ func (s *secretsStore) UpdateSecret(url *secrets.URL, p UpdateSecretParams) (*se
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	if len(p.Data) > 0 {
+		if err := s.putSecretValue(updatedURL, p.Data); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
 	return s.toSecretMetadata(&metadataDoc)
 }
 
@@ -298,23 +292,27 @@ func (s *secretsStore) toSecretMetadata(doc *secretMetadataDoc) (*secrets.Secret
 
 // GetSecretValue gets the secret value for the specified URL.
 func (s *secretsStore) GetSecretValue(URL *secrets.URL) (secrets.SecretValue, error) {
-	secretValuesCollection, closer := s.st.db().GetCollection(secretValuesC)
-	defer closer()
-
-	var doc secretValueDoc
-	err := secretValuesCollection.FindId(URL.ID()).One(&doc)
-	if errors.Cause(err) == mgo.ErrNotFound {
+	docs, err := s.docStore()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	blob, err := docs.Get(secretValuesC, URL.ID())
+	if errors.IsNotFound(err) {
 		return nil, errors.NotFoundf("secret %q", URL.ID())
 	}
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	allData := make(secrets.SecretData)
+	if err := json.Unmarshal(blob, &allData); err != nil {
+		return nil, errors.Annotatef(err, "reading value for secret %q", URL.ID())
+	}
 	data := make(secrets.SecretData)
-	for k, v := range doc.Data {
+	for k, v := range allData {
 		if URL.Attribute != "" && k != URL.Attribute {
 			continue
 		}
-		data[k] = fmt.Sprintf("%v", v)
+		data[k] = v
 	}
 	if URL.Attribute != "" && len(data) == 0 {
 		return nil, errors.NotFoundf("secret attribute %q", URL.Attribute)
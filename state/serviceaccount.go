@@ -0,0 +1,323 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2"
+	"github.com/juju/mgo/v2/bson"
+	"github.com/juju/mgo/v2/txn"
+	"github.com/juju/names/v4"
+	"github.com/juju/utils/v2"
+)
+
+// ServiceAccountDomain is the user domain that service accounts
+// authenticate under. A service account named "ci-deploy" acts as the
+// user "ci-deploy@serviceaccount", which is the identity recorded in
+// audit logs and used for permission grants.
+const ServiceAccountDomain = "serviceaccount"
+
+// ServiceAccount represents a non-human principal on the controller.
+// Unlike regular users, service accounts have no password; they
+// authenticate with long-lived, individually revocable tokens that may
+// be scoped to particular models and facades.
+type ServiceAccount struct {
+	st  *State
+	doc serviceAccountDoc
+}
+
+type serviceAccountDoc struct {
+	DocID       string                   `bson:"_id"`
+	Name        string                   `bson:"name"`
+	CreatedBy   string                   `bson:"createdby"`
+	DateCreated time.Time                `bson:"datecreated"`
+	Tokens      []serviceAccountTokenDoc `bson:"tokens"`
+}
+
+type serviceAccountTokenDoc struct {
+	ID          string     `bson:"id"`
+	Hash        string     `bson:"hash"`
+	Models      []string   `bson:"models,omitempty"`
+	Facades     []string   `bson:"facades,omitempty"`
+	DateCreated time.Time  `bson:"datecreated"`
+	ExpiryTime  *time.Time `bson:"expirytime,omitempty"`
+}
+
+// AddServiceAccount creates a new service account with the given name.
+// The account starts with no tokens; see ServiceAccount.AddToken.
+func (st *State) AddServiceAccount(name, creator string) (*ServiceAccount, error) {
+	if !names.IsValidUserName(name) {
+		return nil, errors.Errorf("invalid service account name %q", name)
+	}
+	lowercaseName := strings.ToLower(name)
+
+	account := &ServiceAccount{
+		st: st,
+		doc: serviceAccountDoc{
+			DocID:       lowercaseName,
+			Name:        name,
+			CreatedBy:   creator,
+			DateCreated: st.nowToTheSecond(),
+			Tokens:      []serviceAccountTokenDoc{},
+		},
+	}
+	ops := []txn.Op{{
+		C:      serviceAccountsC,
+		Id:     lowercaseName,
+		Assert: txn.DocMissing,
+		Insert: &account.doc,
+	}}
+	if err := st.db().RunTransaction(ops); err != nil {
+		if err == txn.ErrAborted {
+			return nil, errors.AlreadyExistsf("service account %q", name)
+		}
+		return nil, errors.Trace(err)
+	}
+	return account, nil
+}
+
+// ServiceAccount returns the service account with the given name.
+func (st *State) ServiceAccount(name string) (*ServiceAccount, error) {
+	accounts, closer := st.db().GetCollection(serviceAccountsC)
+	defer closer()
+
+	var doc serviceAccountDoc
+	err := accounts.FindId(strings.ToLower(name)).One(&doc)
+	if err == mgo.ErrNotFound {
+		return nil, errors.NotFoundf("service account %q", name)
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &ServiceAccount{st: st, doc: doc}, nil
+}
+
+// AllServiceAccounts returns all service accounts on the controller.
+func (st *State) AllServiceAccounts() ([]*ServiceAccount, error) {
+	accounts, closer := st.db().GetCollection(serviceAccountsC)
+	defer closer()
+
+	var docs []serviceAccountDoc
+	if err := accounts.Find(nil).Sort("name").All(&docs); err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := make([]*ServiceAccount, len(docs))
+	for i, doc := range docs {
+		result[i] = &ServiceAccount{st: st, doc: doc}
+	}
+	return result, nil
+}
+
+// ServiceAccountByToken returns the service account that the supplied
+// token authenticates, along with the matching token. An unauthorized
+// error is returned if the token is unknown, revoked or expired.
+func (st *State) ServiceAccountByToken(token string) (*ServiceAccount, ServiceAccountToken, error) {
+	hash := hashServiceAccountToken(token)
+
+	accounts, closer := st.db().GetCollection(serviceAccountsC)
+	defer closer()
+
+	var doc serviceAccountDoc
+	err := accounts.Find(bson.D{{"tokens.hash", hash}}).One(&doc)
+	if err == mgo.ErrNotFound {
+		return nil, ServiceAccountToken{}, errors.Unauthorizedf("invalid service account token")
+	} else if err != nil {
+		return nil, ServiceAccountToken{}, errors.Trace(err)
+	}
+	for _, tokenDoc := range doc.Tokens {
+		if tokenDoc.Hash != hash {
+			continue
+		}
+		if tokenDoc.ExpiryTime != nil && st.clock().Now().After(*tokenDoc.ExpiryTime) {
+			return nil, ServiceAccountToken{}, errors.Unauthorizedf("service account token expired")
+		}
+		return &ServiceAccount{st: st, doc: doc}, ServiceAccountToken{doc: tokenDoc}, nil
+	}
+	return nil, ServiceAccountToken{}, errors.Unauthorizedf("invalid service account token")
+}
+
+// Name returns the service account name.
+func (a *ServiceAccount) Name() string {
+	return a.doc.Name
+}
+
+// Tag returns the user tag that the service account acts as.
+func (a *ServiceAccount) Tag() names.Tag {
+	return a.UserTag()
+}
+
+// UserTag returns the user tag that the service account acts as. The
+// tag is in the service account domain, keeping the namespace disjoint
+// from both local and external users.
+func (a *ServiceAccount) UserTag() names.UserTag {
+	return names.NewUserTag(a.doc.Name + "@" + ServiceAccountDomain)
+}
+
+// CreatedBy returns the name of the user that created the account.
+func (a *ServiceAccount) CreatedBy() string {
+	return a.doc.CreatedBy
+}
+
+// DateCreated returns when the account was created.
+func (a *ServiceAccount) DateCreated() time.Time {
+	return a.doc.DateCreated.UTC()
+}
+
+// Tokens returns the account's tokens. The secrets are not recoverable;
+// only metadata is returned.
+func (a *ServiceAccount) Tokens() []ServiceAccountToken {
+	result := make([]ServiceAccountToken, len(a.doc.Tokens))
+	for i, doc := range a.doc.Tokens {
+		result[i] = ServiceAccountToken{doc: doc}
+	}
+	return result
+}
+
+// AddToken mints a new token for the service account, scoped to the
+// supplied model UUIDs and facade names. Empty scopes leave the
+// corresponding dimension unrestricted. If expiry is non-nil the token
+// stops authenticating after that time. The token secret is returned
+// exactly once; only its hash is persisted.
+func (a *ServiceAccount) AddToken(models, facades []string, expiry *time.Time) (string, ServiceAccountToken, error) {
+	secretKey, err := generateSecretKey()
+	if err != nil {
+		return "", ServiceAccountToken{}, errors.Trace(err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(secretKey)
+
+	id, err := utils.NewUUID()
+	if err != nil {
+		return "", ServiceAccountToken{}, errors.Trace(err)
+	}
+	tokenDoc := serviceAccountTokenDoc{
+		ID:          id.String(),
+		Hash:        hashServiceAccountToken(token),
+		Models:      models,
+		Facades:     facades,
+		DateCreated: a.st.nowToTheSecond(),
+		ExpiryTime:  expiry,
+	}
+
+	ops := []txn.Op{{
+		C:      serviceAccountsC,
+		Id:     a.doc.DocID,
+		Assert: txn.DocExists,
+		Update: bson.D{{"$push", bson.D{{"tokens", tokenDoc}}}},
+	}}
+	err = a.st.db().RunTransaction(ops)
+	if err == txn.ErrAborted {
+		return "", ServiceAccountToken{}, errors.NotFoundf("service account %q", a.Name())
+	} else if err != nil {
+		return "", ServiceAccountToken{}, errors.Trace(err)
+	}
+	a.doc.Tokens = append(a.doc.Tokens, tokenDoc)
+	return token, ServiceAccountToken{doc: tokenDoc}, nil
+}
+
+// RevokeToken removes the token with the given ID from the account,
+// immediately invalidating it.
+func (a *ServiceAccount) RevokeToken(id string) error {
+	ops := []txn.Op{{
+		C:      serviceAccountsC,
+		Id:     a.doc.DocID,
+		Assert: bson.D{{"tokens.id", id}},
+		Update: bson.D{{"$pull", bson.D{{"tokens", bson.D{{"id", id}}}}}},
+	}}
+	err := a.st.db().RunTransaction(ops)
+	if err == txn.ErrAborted {
+		return errors.NotFoundf("token %q on service account %q", id, a.Name())
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	for i, doc := range a.doc.Tokens {
+		if doc.ID == id {
+			a.doc.Tokens = append(a.doc.Tokens[:i], a.doc.Tokens[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// Remove deletes the service account along with all of its tokens.
+func (a *ServiceAccount) Remove() error {
+	ops := []txn.Op{{
+		C:      serviceAccountsC,
+		Id:     a.doc.DocID,
+		Remove: true,
+	}}
+	return errors.Trace(a.st.db().RunTransaction(ops))
+}
+
+// Refresh refreshes information about the service account from state.
+func (a *ServiceAccount) Refresh() error {
+	account, err := a.st.ServiceAccount(a.doc.Name)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	a.doc = account.doc
+	return nil
+}
+
+// ServiceAccountToken holds the metadata of a single service account
+// token. The token secret itself is never stored.
+type ServiceAccountToken struct {
+	doc serviceAccountTokenDoc
+}
+
+// ID returns the token's identifier, used for revocation.
+func (t ServiceAccountToken) ID() string {
+	return t.doc.ID
+}
+
+// Models returns the model UUIDs the token is scoped to.
+// An empty result means the token is not model-restricted.
+func (t ServiceAccountToken) Models() []string {
+	return t.doc.Models
+}
+
+// Facades returns the facade names the token is scoped to.
+// An empty result means the token is not facade-restricted.
+func (t ServiceAccountToken) Facades() []string {
+	return t.doc.Facades
+}
+
+// DateCreated returns when the token was minted.
+func (t ServiceAccountToken) DateCreated() time.Time {
+	return t.doc.DateCreated.UTC()
+}
+
+// ExpiryTime returns the token's expiry time, or nil if it does not
+// expire.
+func (t ServiceAccountToken) ExpiryTime() *time.Time {
+	return t.doc.ExpiryTime
+}
+
+// AllowsModel reports whether the token may be used against the model
+// with the given UUID.
+func (t ServiceAccountToken) AllowsModel(uuid string) bool {
+	if len(t.doc.Models) == 0 {
+		return true
+	}
+	return set.NewStrings(t.doc.Models...).Contains(uuid)
+}
+
+// AllowsFacade reports whether the token may be used to call the named
+// facade.
+func (t ServiceAccountToken) AllowsFacade(name string) bool {
+	if len(t.doc.Facades) == 0 {
+		return true
+	}
+	return set.NewStrings(t.doc.Facades...).Contains(name)
+}
+
+func hashServiceAccountToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,162 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/state"
+)
+
+type ServiceAccountSuite struct {
+	ConnSuite
+}
+
+var _ = gc.Suite(&ServiceAccountSuite{})
+
+func (s *ServiceAccountSuite) TestAddServiceAccount(c *gc.C) {
+	account, err := s.State.AddServiceAccount("ci-deploy", "admin")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(account.Name(), gc.Equals, "ci-deploy")
+	c.Assert(account.CreatedBy(), gc.Equals, "admin")
+	c.Assert(account.UserTag().String(), gc.Equals, "user-ci-deploy@serviceaccount")
+	c.Assert(account.Tokens(), gc.HasLen, 0)
+}
+
+func (s *ServiceAccountSuite) TestAddServiceAccountInvalidName(c *gc.C) {
+	_, err := s.State.AddServiceAccount("b^b", "admin")
+	c.Assert(err, gc.ErrorMatches, `invalid service account name "b\^b"`)
+}
+
+func (s *ServiceAccountSuite) TestAddServiceAccountAlreadyExists(c *gc.C) {
+	_, err := s.State.AddServiceAccount("ci-deploy", "admin")
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.State.AddServiceAccount("ci-deploy", "admin")
+	c.Assert(err, jc.Satisfies, errors.IsAlreadyExists)
+}
+
+func (s *ServiceAccountSuite) TestServiceAccountNotFound(c *gc.C) {
+	_, err := s.State.ServiceAccount("nope")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *ServiceAccountSuite) TestAllServiceAccounts(c *gc.C) {
+	_, err := s.State.AddServiceAccount("deploy", "admin")
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.State.AddServiceAccount("audit", "admin")
+	c.Assert(err, jc.ErrorIsNil)
+
+	accounts, err := s.State.AllServiceAccounts()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(accounts, gc.HasLen, 2)
+	c.Assert(accounts[0].Name(), gc.Equals, "audit")
+	c.Assert(accounts[1].Name(), gc.Equals, "deploy")
+}
+
+func (s *ServiceAccountSuite) TestAddToken(c *gc.C) {
+	account, err := s.State.AddServiceAccount("ci-deploy", "admin")
+	c.Assert(err, jc.ErrorIsNil)
+
+	secret, token, err := account.AddToken(
+		[]string{s.State.ModelUUID()}, []string{"Client", "Application"}, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(secret, gc.Not(gc.Equals), "")
+	c.Assert(token.ID(), gc.Not(gc.Equals), "")
+	c.Assert(token.Models(), jc.DeepEquals, []string{s.State.ModelUUID()})
+	c.Assert(token.Facades(), jc.DeepEquals, []string{"Client", "Application"})
+	c.Assert(token.ExpiryTime(), gc.IsNil)
+
+	c.Assert(account.Tokens(), gc.HasLen, 1)
+}
+
+func (s *ServiceAccountSuite) TestServiceAccountByToken(c *gc.C) {
+	account, err := s.State.AddServiceAccount("ci-deploy", "admin")
+	c.Assert(err, jc.ErrorIsNil)
+	secret, token, err := account.AddToken(nil, nil, nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	found, foundToken, err := s.State.ServiceAccountByToken(secret)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(found.Name(), gc.Equals, "ci-deploy")
+	c.Assert(foundToken.ID(), gc.Equals, token.ID())
+}
+
+func (s *ServiceAccountSuite) TestServiceAccountByTokenUnknown(c *gc.C) {
+	_, _, err := s.State.ServiceAccountByToken("not-a-token")
+	c.Assert(err, jc.Satisfies, errors.IsUnauthorized)
+}
+
+func (s *ServiceAccountSuite) TestServiceAccountByTokenExpired(c *gc.C) {
+	account, err := s.State.AddServiceAccount("ci-deploy", "admin")
+	c.Assert(err, jc.ErrorIsNil)
+	expiry := time.Now().Add(-time.Hour)
+	secret, _, err := account.AddToken(nil, nil, &expiry)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, _, err = s.State.ServiceAccountByToken(secret)
+	c.Assert(err, jc.Satisfies, errors.IsUnauthorized)
+	c.Assert(err, gc.ErrorMatches, "service account token expired")
+}
+
+func (s *ServiceAccountSuite) TestRevokeToken(c *gc.C) {
+	account, err := s.State.AddServiceAccount("ci-deploy", "admin")
+	c.Assert(err, jc.ErrorIsNil)
+	secret, token, err := account.AddToken(nil, nil, nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = account.RevokeToken(token.ID())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(account.Tokens(), gc.HasLen, 0)
+
+	_, _, err = s.State.ServiceAccountByToken(secret)
+	c.Assert(err, jc.Satisfies, errors.IsUnauthorized)
+}
+
+func (s *ServiceAccountSuite) TestRevokeTokenNotFound(c *gc.C) {
+	account, err := s.State.AddServiceAccount("ci-deploy", "admin")
+	c.Assert(err, jc.ErrorIsNil)
+	err = account.RevokeToken("deadbeef")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *ServiceAccountSuite) TestTokenScopes(c *gc.C) {
+	account, err := s.State.AddServiceAccount("ci-deploy", "admin")
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, scoped, err := account.AddToken([]string{"uuid-1"}, []string{"Client"}, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(scoped.AllowsModel("uuid-1"), jc.IsTrue)
+	c.Assert(scoped.AllowsModel("uuid-2"), jc.IsFalse)
+	c.Assert(scoped.AllowsFacade("Client"), jc.IsTrue)
+	c.Assert(scoped.AllowsFacade("Application"), jc.IsFalse)
+
+	_, open, err := account.AddToken(nil, nil, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(open.AllowsModel("uuid-2"), jc.IsTrue)
+	c.Assert(open.AllowsFacade("Application"), jc.IsTrue)
+}
+
+func (s *ServiceAccountSuite) TestRemove(c *gc.C) {
+	account, err := s.State.AddServiceAccount("ci-deploy", "admin")
+	c.Assert(err, jc.ErrorIsNil)
+	secret, _, err := account.AddToken(nil, nil, nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = account.Remove()
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.State.ServiceAccount("ci-deploy")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+	_, _, err = s.State.ServiceAccountByToken(secret)
+	c.Assert(err, jc.Satisfies, errors.IsUnauthorized)
+}
+
+func (s *ServiceAccountSuite) TestUserTagDomain(c *gc.C) {
+	account, err := s.State.AddServiceAccount("ci-deploy", "admin")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(account.UserTag().Domain(), gc.Equals, state.ServiceAccountDomain)
+}
@@ -42,6 +42,7 @@ import (
 	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/mongo"
 	"github.com/juju/juju/state/cloudimagemetadata"
+	"github.com/juju/juju/state/docstore"
 	stateerrors "github.com/juju/juju/state/errors"
 	raftleasestore "github.com/juju/juju/state/raftlease"
 	"github.com/juju/juju/state/watcher"
@@ -369,8 +370,9 @@ func (st *State) removeInCollectionOps(name string, sel interface{}) ([]txn.Op,
 }
 
 // startWorkers starts the worker backends on the *State
-//   * txn log watcher
-//   * txn log pruner
+//   - txn log watcher
+//   - txn log pruner
+//
 // startWorkers will close the *State if it fails.
 func (st *State) startWorkers(hub *pubsub.SimpleHub) (err error) {
 	defer func() {
@@ -395,22 +397,26 @@ func (st *State) startWorkers(hub *pubsub.SimpleHub) (err error) {
 // ApplicationLeaders returns a map of the application name to the
 // unit name that is the current leader.
 func (st *State) ApplicationLeaders() (map[string]string, error) {
-	return raftleasestore.LeaseHolders(
-		&environMongo{st},
-		leaseHoldersC,
-		lease.ApplicationLeadershipNamespace,
-		st.ModelUUID(),
-	)
+	return st.leaseHolders(lease.ApplicationLeadershipNamespace)
 }
 
 // LeaseNotifyTarget returns a raftlease.NotifyTarget for storing
-// lease changes in the database.
+// lease changes in the database. Changes are applied to the raw mongo
+// collection backing the leadership transaction assertions and
+// mirrored into the document store so the configured storage backend
+// is never stale.
 func (st *State) LeaseNotifyTarget(logger raftleasestore.Logger) raftlease.NotifyTarget {
-	return raftleasestore.NewNotifyTarget(&environMongo{st}, leaseHoldersC, logger)
+	return &docstoreLeaseTarget{
+		st:     st,
+		mongo:  raftleasestore.NewNotifyTarget(&environMongo{st}, leaseHoldersC, logger),
+		logger: logger,
+	}
 }
 
 // LeaseTrapdoorFunc returns a raftlease.TrapdoorFunc for checking
-// lease state in a database.
+// lease state in a database. The trapdoor emits mgo/txn assertion
+// ops, so it stays on the raw mongo collection for as long as state
+// transactions do, whatever storage backend is configured.
 func (st *State) LeaseTrapdoorFunc() raftlease.TrapdoorFunc {
 	return raftleasestore.MakeTrapdoorFunc(&environMongo{st}, leaseHoldersC)
 }
@@ -505,6 +511,31 @@ func (st *State) MongoSession() *mgo.Session {
 	return st.session
 }
 
+// DocStore returns the document store holding the blobs written by
+// subsystems that have moved off raw mongo collections, currently
+// secret values and the lease holder mirror. The backend is selected
+// by the state-storage-backend controller config attribute, so once
+// an operator switches to dqlite (and the migrator has copied the
+// existing documents) reads and writes follow.
+func (st *State) DocStore() (docstore.Store, error) {
+	controllerConfig, err := st.ControllerConfig()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if controllerConfig.StateStorageBackend() == docstore.DqliteBackend {
+		store, err := docstore.SQLStore()
+		return store, errors.Trace(err)
+	}
+	return st.MongoDocStore(), nil
+}
+
+// MongoDocStore returns the mongo document store regardless of the
+// configured storage backend. The migrator worker uses it as the
+// source when copying documents to a different backend.
+func (st *State) MongoDocStore() docstore.Store {
+	return docstore.NewMongoStore(st.session.DB(jujuDB))
+}
+
 // WatchParams defines config to control which
 // entites are included when watching a model.
 type WatchParams struct {
@@ -1001,6 +1032,14 @@ type SaveCloudServiceArgs struct {
 	ProviderId string
 	Addresses  corenetwork.SpaceAddresses
 
+	// ExternalNames holds any DNS names by which the service
+	// is reachable from outside the cluster.
+	ExternalNames []string
+
+	// PortAnnotations holds provider annotations keyed on
+	// service port name.
+	PortAnnotations map[string]map[string]string
+
 	Generation            int64
 	DesiredScaleProtected bool
 }
@@ -1013,6 +1052,8 @@ func (st *State) SaveCloudService(args SaveCloudServiceArgs) (_ *CloudService, e
 		DocID:                 applicationGlobalKey(args.Id),
 		ProviderId:            args.ProviderId,
 		Addresses:             fromNetworkAddresses(args.Addresses, corenetwork.OriginProvider),
+		ExternalNames:         args.ExternalNames,
+		PortAnnotations:       escapePortAnnotations(args.PortAnnotations),
 		Generation:            args.Generation,
 		DesiredScaleProtected: args.DesiredScaleProtected,
 	}
@@ -1173,17 +1214,18 @@ func (st *State) AddApplication(args AddApplicationArgs) (_ *Application, err er
 	// The doc defaults to CharmModifiedVersion = 0, which is correct, since it
 	// has, by definition, at its initial state.
 	appDoc := &applicationDoc{
-		DocID:         applicationID,
-		Name:          args.Name,
-		ModelUUID:     st.ModelUUID(),
-		Series:        args.Series,
-		Subordinate:   args.Charm.Meta().Subordinate,
-		CharmURL:      args.Charm.URL(),
-		CharmOrigin:   args.CharmOrigin,
-		Channel:       string(args.Channel),
-		RelationCount: len(peers),
-		Life:          Alive,
-		UnitCount:     args.NumUnits,
+		DocID:               applicationID,
+		Name:                args.Name,
+		ModelUUID:           st.ModelUUID(),
+		Series:              args.Series,
+		Subordinate:         args.Charm.Meta().Subordinate,
+		CharmURL:            args.Charm.URL(),
+		CharmOrigin:         args.CharmOrigin,
+		CharmSupportedBases: supportedBases(args.Charm.Manifest()),
+		Channel:             string(args.Channel),
+		RelationCount:       len(peers),
+		Life:                Alive,
+		UnitCount:           args.NumUnits,
 
 		// CAAS
 		DesiredScale: scale,
@@ -4,9 +4,12 @@
 package storage
 
 import (
+	"crypto/sha512"
+	"fmt"
 	"io"
 
 	"github.com/juju/blobstore/v2"
+	"github.com/juju/errors"
 	"github.com/juju/mgo/v2"
 )
 
@@ -37,6 +40,15 @@ type Storage interface {
 	// hash.
 	PutAndCheckHash(path string, r io.Reader, length int64, hash string) error
 
+	// PutByHash records a model-scoped reference at path to content
+	// the blobstore already holds with the given hex-encoded SHA-384
+	// hash, without writing the data again. The reader must supply
+	// the same content; it is used to prove access to the data. A
+	// NotFound error is returned when the blobstore holds no content
+	// with that hash, in which case the caller should fall back to
+	// Put.
+	PutByHash(path string, r io.ReadSeeker, hash string) error
+
 	// Remove removes data at path, namespaced to the model.
 	Remove(path string) error
 }
@@ -80,6 +92,26 @@ func (s stateStorage) PutAndCheckHash(path string, r io.Reader, length int64, ha
 	return ms.PutForBucketAndCheckHash(s.modelUUID, path, r, length, hash)
 }
 
+func (s stateStorage) PutByHash(path string, r io.ReadSeeker, hash string) error {
+	session, ms := s.blobstore()
+	defer session.Close()
+	resp, err := ms.PutForBucketRequest(s.modelUUID, path, hash)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	// Prove that we hold the content by hashing the random byte range
+	// chosen by the blobstore.
+	if _, err := r.Seek(resp.RangeStart, io.SeekStart); err != nil {
+		return errors.Trace(err)
+	}
+	rangeHash := sha512.New384()
+	if _, err := io.CopyN(rangeHash, r, resp.RangeLength); err != nil {
+		return errors.Trace(err)
+	}
+	proof := blobstore.NewPutResponse(resp.RequestId, fmt.Sprintf("%x", rangeHash.Sum(nil)))
+	return errors.Trace(ms.ProofOfAccessResponse(proof))
+}
+
 func (s stateStorage) Remove(path string) error {
 	session, ms := s.blobstore()
 	defer session.Close()
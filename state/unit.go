@@ -1608,6 +1608,12 @@ func (u *Unit) assignToMachine(m *Machine, unused bool) (err error) {
 	}
 	u.doc.MachineId = m.doc.Id
 	m.doc.Clean = false
+	// Refresh the materialised unit-subnet mapping for the newly
+	// assigned unit. The mapping is a derived cache, so a failure to
+	// update it must not fail the assignment itself.
+	if err := u.st.RefreshUnitSubnets(u.Name()); err != nil {
+		logger.Warningf("cannot refresh subnet mapping for unit %q: %v", u.Name(), err)
+	}
 	return nil
 }
 
@@ -2677,6 +2683,11 @@ func (u *Unit) UnassignFromMachine() (err error) {
 		return fmt.Errorf("cannot unassign unit %q from machine: %v", u, onAbort(err, errors.NotFoundf("machine")))
 	}
 	u.doc.MachineId = ""
+	// The unit no longer has a machine, so its materialised subnet
+	// mapping is removed; a failure here only leaves a stale cache.
+	if err := u.st.RefreshUnitSubnets(u.Name()); err != nil {
+		logger.Warningf("cannot refresh subnet mapping for unit %q: %v", u.Name(), err)
+	}
 	return nil
 }
 
@@ -0,0 +1,317 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2"
+	"github.com/juju/mgo/v2/bson"
+	"github.com/juju/mgo/v2/txn"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/core/status"
+)
+
+// UnitMoveStatus describes the progress of a unit move operation.
+type UnitMoveStatus string
+
+const (
+	// UnitMoveWaiting indicates that the replacement unit has been added
+	// to the target machine and the move is waiting for it to become
+	// healthy before the original unit is removed.
+	UnitMoveWaiting UnitMoveStatus = "waiting"
+
+	// UnitMoveComplete indicates that the original unit has been removed
+	// and the move has finished.
+	UnitMoveComplete UnitMoveStatus = "complete"
+
+	// UnitMoveFailed indicates that the move could not be completed.
+	UnitMoveFailed UnitMoveStatus = "failed"
+)
+
+// unitMoveCheckInterval is how long the cleanup machinery waits between
+// checks on a move that is not yet ready to progress.
+const unitMoveCheckInterval = 30 * time.Second
+
+// unitMoveDoc records a single unit move operation.
+type unitMoveDoc struct {
+	DocID     string `bson:"_id"`
+	ModelUUID string `bson:"model-uuid"`
+
+	MoveId        string         `bson:"move-id"`
+	OldUnit       string         `bson:"old-unit"`
+	NewUnit       string         `bson:"new-unit"`
+	TargetMachine string         `bson:"target-machine"`
+	Storage       []string       `bson:"storage,omitempty"`
+	Status        UnitMoveStatus `bson:"status"`
+	Message       string         `bson:"message,omitempty"`
+	Started       time.Time      `bson:"started"`
+	Completed     time.Time      `bson:"completed,omitempty"`
+}
+
+// UnitMove represents an in-progress or finished reassignment of a unit
+// to another machine.
+type UnitMove struct {
+	st  *State
+	doc unitMoveDoc
+}
+
+// Id returns the move's identifier, unique within the model.
+func (m *UnitMove) Id() string { return m.doc.MoveId }
+
+// OldUnit returns the name of the unit being replaced.
+func (m *UnitMove) OldUnit() string { return m.doc.OldUnit }
+
+// NewUnit returns the name of the replacement unit.
+func (m *UnitMove) NewUnit() string { return m.doc.NewUnit }
+
+// TargetMachine returns the id of the machine the unit is moving to.
+func (m *UnitMove) TargetMachine() string { return m.doc.TargetMachine }
+
+// Storage returns the ids of the storage instances that will be
+// reattached to the replacement unit where possible.
+func (m *UnitMove) Storage() []string { return m.doc.Storage }
+
+// Status returns the progress of the move.
+func (m *UnitMove) Status() UnitMoveStatus { return m.doc.Status }
+
+// Message returns a human readable description of the move's progress.
+func (m *UnitMove) Message() string { return m.doc.Message }
+
+// Started returns the time the move was requested.
+func (m *UnitMove) Started() time.Time { return m.doc.Started }
+
+// Completed returns the time the move finished, or the zero time if it
+// has not.
+func (m *UnitMove) Completed() time.Time { return m.doc.Completed }
+
+func (m *UnitMove) setProgress(moveStatus UnitMoveStatus, message string) error {
+	update := bson.D{{"status", moveStatus}, {"message", message}}
+	if moveStatus == UnitMoveComplete || moveStatus == UnitMoveFailed {
+		update = append(update, bson.DocElem{"completed", m.st.stateClock.Now()})
+	}
+	ops := []txn.Op{{
+		C:      unitMovesC,
+		Id:     m.doc.DocID,
+		Assert: txn.DocExists,
+		Update: bson.D{{"$set", update}},
+	}}
+	if err := m.st.db().RunTransaction(ops); err != nil {
+		return errors.Annotatef(err, "updating unit move %q", m.doc.MoveId)
+	}
+	m.doc.Status = moveStatus
+	m.doc.Message = message
+	return nil
+}
+
+// MoveUnit adds a new unit of the same application to the target
+// machine to replace the given unit. The returned move tracks the
+// remaining orchestration, which is driven by the cleanup machinery:
+// once the replacement unit reports an active workload the original
+// unit is removed, and any storage it released is reattached to the
+// replacement where the provider allows it.
+func (st *State) MoveUnit(unitName, machineId string) (*UnitMove, error) {
+	unit, err := st.Unit(unitName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if unit.Life() != Alive {
+		return nil, errors.Errorf("unit %q is not alive", unitName)
+	}
+	if !unit.IsPrincipal() {
+		return nil, errors.Errorf("cannot move subordinate unit %q", unitName)
+	}
+	currentId, err := unit.AssignedMachineId()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if currentId == machineId {
+		return nil, errors.Errorf("unit %q is already assigned to machine %s", unitName, machineId)
+	}
+	machine, err := st.Machine(machineId)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if machine.Life() != Alive {
+		return nil, errors.Errorf("machine %s is not alive", machineId)
+	}
+	app, err := unit.Application()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if app.Life() != Alive {
+		return nil, errors.Errorf("application %q is not alive", app.Name())
+	}
+
+	sb, err := NewStorageBackend(st)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	attachments, err := sb.UnitStorageAttachments(unit.UnitTag())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var storageIds []string
+	for _, a := range attachments {
+		storageIds = append(storageIds, a.StorageInstance().Id())
+	}
+
+	newUnit, err := app.AddUnit(AddUnitParams{})
+	if err != nil {
+		return nil, errors.Annotatef(err, "adding replacement unit for %q", unitName)
+	}
+	if err := newUnit.AssignToMachine(machine); err != nil {
+		if dErr := newUnit.Destroy(); dErr != nil {
+			logger.Warningf("cannot remove unassigned replacement unit %q: %v", newUnit.Name(), dErr)
+		}
+		return nil, errors.Annotatef(err, "assigning replacement unit to machine %s", machineId)
+	}
+
+	seq, err := sequence(st, "unitmove")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	id := strconv.Itoa(seq)
+	now := st.stateClock.Now()
+	doc := unitMoveDoc{
+		DocID:         st.docID(id),
+		ModelUUID:     st.ModelUUID(),
+		MoveId:        id,
+		OldUnit:       unitName,
+		NewUnit:       newUnit.Name(),
+		TargetMachine: machineId,
+		Storage:       storageIds,
+		Status:        UnitMoveWaiting,
+		Message:       fmt.Sprintf("waiting for unit %q to become active", newUnit.Name()),
+		Started:       now,
+	}
+	ops := []txn.Op{{
+		C:      unitMovesC,
+		Id:     doc.DocID,
+		Assert: txn.DocMissing,
+		Insert: &doc,
+	},
+		newCleanupAtOp(now.Add(unitMoveCheckInterval), cleanupUnitMove, id),
+	}
+	if err := st.db().RunTransaction(ops); err != nil {
+		return nil, errors.Annotatef(err, "recording move of unit %q", unitName)
+	}
+	return &UnitMove{st: st, doc: doc}, nil
+}
+
+// UnitMove returns the unit move with the given id.
+func (st *State) UnitMove(id string) (*UnitMove, error) {
+	col, closer := st.db().GetCollection(unitMovesC)
+	defer closer()
+
+	var doc unitMoveDoc
+	err := col.FindId(st.docID(id)).One(&doc)
+	if err == mgo.ErrNotFound {
+		return nil, errors.NotFoundf("unit move %q", id)
+	}
+	if err != nil {
+		return nil, errors.Annotatef(err, "getting unit move %q", id)
+	}
+	return &UnitMove{st: st, doc: doc}, nil
+}
+
+// UnitMoves returns all unit moves in the model, oldest first.
+func (st *State) UnitMoves() ([]*UnitMove, error) {
+	col, closer := st.db().GetCollection(unitMovesC)
+	defer closer()
+
+	var docs []unitMoveDoc
+	if err := col.Find(nil).Sort("started").All(&docs); err != nil {
+		return nil, errors.Annotate(err, "getting unit moves")
+	}
+	moves := make([]*UnitMove, len(docs))
+	for i, doc := range docs {
+		moves[i] = &UnitMove{st: st, doc: doc}
+	}
+	return moves, nil
+}
+
+// cleanupUnitMove progresses the unit move with the given id. It
+// reschedules itself while the move is waiting on the replacement unit
+// becoming active or the original unit being removed.
+func (st *State) cleanupUnitMove(id string) error {
+	move, err := st.UnitMove(id)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if move.Status() != UnitMoveWaiting {
+		return nil
+	}
+	requeue := func(message string) error {
+		if err := move.setProgress(UnitMoveWaiting, message); err != nil {
+			return errors.Trace(err)
+		}
+		ops := []txn.Op{newCleanupAtOp(st.stateClock.Now().Add(unitMoveCheckInterval), cleanupUnitMove, id)}
+		return errors.Trace(st.db().RunTransaction(ops))
+	}
+
+	newUnit, err := st.Unit(move.NewUnit())
+	if errors.IsNotFound(err) {
+		return move.setProgress(UnitMoveFailed, fmt.Sprintf("replacement unit %q was removed", move.NewUnit()))
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if newUnit.Life() != Alive {
+		return move.setProgress(UnitMoveFailed, fmt.Sprintf("replacement unit %q is %s", move.NewUnit(), newUnit.Life()))
+	}
+	statusInfo, err := newUnit.Status()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if statusInfo.Status != status.Active {
+		return requeue(fmt.Sprintf("waiting for unit %q to become active", move.NewUnit()))
+	}
+
+	oldUnit, err := st.Unit(move.OldUnit())
+	if err == nil {
+		if oldUnit.Life() == Alive {
+			if err := oldUnit.Destroy(); err != nil {
+				return errors.Annotatef(err, "removing unit %q", move.OldUnit())
+			}
+		}
+		return requeue(fmt.Sprintf("waiting for unit %q to be removed", move.OldUnit()))
+	}
+	if !errors.IsNotFound(err) {
+		return errors.Trace(err)
+	}
+
+	message := fmt.Sprintf("unit %q replaced by %q", move.OldUnit(), move.NewUnit())
+	if len(move.Storage()) > 0 {
+		sb, err := NewStorageBackend(st)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		var skipped []string
+		for _, storageId := range move.Storage() {
+			err := sb.AttachStorage(names.NewStorageTag(storageId), newUnit.UnitTag())
+			if errors.IsNotFound(err) {
+				// The storage was removed along with the old unit;
+				// nothing to reattach.
+				continue
+			}
+			if err != nil {
+				logger.Warningf("cannot reattach storage %q to unit %q: %v", storageId, newUnit.Name(), err)
+				skipped = append(skipped, storageId)
+			}
+		}
+		if len(skipped) > 0 {
+			message += "; storage not reattached: " + strings.Join(skipped, ", ")
+		}
+	}
+	return move.setProgress(UnitMoveComplete, message)
+}
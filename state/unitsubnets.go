@@ -0,0 +1,202 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2"
+	"github.com/juju/mgo/v2/bson"
+	"github.com/juju/mgo/v2/txn"
+)
+
+// unitSubnetsDoc is a materialised mapping from a unit to the subnets
+// (and their spaces) reachable from the machine hosting it. It is
+// derived from the unit's assigned machine and that machine's link-layer
+// device addresses, and is refreshed incrementally as units change, so
+// consumers such as the firewaller can recompute rules for just the
+// affected units instead of walking the whole model.
+type unitSubnetsDoc struct {
+	// DocID is the unit name.
+	DocID     string `bson:"_id"`
+	ModelUUID string `bson:"model-uuid"`
+
+	UnitName  string   `bson:"unit-name"`
+	MachineId string   `bson:"machine-id"`
+	SubnetIDs []string `bson:"subnet-ids"`
+	SpaceIDs  []string `bson:"space-ids"`
+}
+
+// UnitSubnets represents the materialised unit-to-subnet mapping for a
+// single unit.
+type UnitSubnets struct {
+	st  *State
+	doc unitSubnetsDoc
+}
+
+// UnitName returns the name of the unit this mapping is for.
+func (u *UnitSubnets) UnitName() string {
+	return u.doc.UnitName
+}
+
+// MachineID returns the ID of the machine the unit was assigned to when
+// the mapping was last refreshed.
+func (u *UnitSubnets) MachineID() string {
+	return u.doc.MachineId
+}
+
+// SubnetIDs returns the IDs of the subnets reachable from the unit's
+// machine, in sorted order.
+func (u *UnitSubnets) SubnetIDs() []string {
+	return u.doc.SubnetIDs
+}
+
+// SpaceIDs returns the IDs of the spaces containing the unit's subnets,
+// in sorted order.
+func (u *UnitSubnets) SpaceIDs() []string {
+	return u.doc.SpaceIDs
+}
+
+// UnitSubnets returns the materialised subnet mapping for the named
+// unit, if one has been recorded.
+func (st *State) UnitSubnets(unitName string) (*UnitSubnets, error) {
+	col, closer := st.db().GetCollection(unitSubnetsC)
+	defer closer()
+
+	var doc unitSubnetsDoc
+	err := col.FindId(unitName).One(&doc)
+	if err == mgo.ErrNotFound {
+		return nil, errors.NotFoundf("subnet mapping for unit %q", unitName)
+	}
+	if err != nil {
+		return nil, errors.Annotatef(err, "cannot get subnet mapping for unit %q", unitName)
+	}
+	return &UnitSubnets{st: st, doc: doc}, nil
+}
+
+// AllUnitSubnets returns the materialised subnet mappings for all units
+// in the model.
+func (st *State) AllUnitSubnets() ([]*UnitSubnets, error) {
+	col, closer := st.db().GetCollection(unitSubnetsC)
+	defer closer()
+
+	var docs []unitSubnetsDoc
+	if err := col.Find(nil).All(&docs); err != nil {
+		return nil, errors.Annotate(err, "cannot get all unit subnet mappings")
+	}
+	result := make([]*UnitSubnets, len(docs))
+	for i, doc := range docs {
+		result[i] = &UnitSubnets{st: st, doc: doc}
+	}
+	return result, nil
+}
+
+// RefreshUnitSubnets recomputes and stores the subnet mappings for the
+// named units. Only the given units are touched, so callers reacting to
+// a change notification pay a cost proportional to the units that
+// changed rather than the size of the model. Units that are not
+// assigned to a machine have their mapping removed.
+func (st *State) RefreshUnitSubnets(unitNames ...string) error {
+	for _, unitName := range unitNames {
+		if err := st.refreshUnitSubnets(unitName); err != nil {
+			return errors.Annotatef(err, "refreshing subnet mapping for unit %q", unitName)
+		}
+	}
+	return nil
+}
+
+func (st *State) refreshUnitSubnets(unitName string) error {
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		unit, err := st.Unit(unitName)
+		if errors.IsNotFound(err) {
+			return removeUnitSubnetsOps(unitName), nil
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		doc, err := st.computeUnitSubnets(unit)
+		if errors.IsNotAssigned(err) || errors.IsNotFound(err) {
+			return removeUnitSubnetsOps(unitName), nil
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		col, closer := st.db().GetCollection(unitSubnetsC)
+		defer closer()
+		count, err := col.FindId(unitName).Count()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if count == 0 {
+			return []txn.Op{{
+				C:      unitSubnetsC,
+				Id:     unitName,
+				Assert: txn.DocMissing,
+				Insert: doc,
+			}}, nil
+		}
+		return []txn.Op{{
+			C:      unitSubnetsC,
+			Id:     unitName,
+			Assert: txn.DocExists,
+			Update: bson.D{{"$set", bson.D{
+				{"machine-id", doc.MachineId},
+				{"subnet-ids", doc.SubnetIDs},
+				{"space-ids", doc.SpaceIDs},
+			}}},
+		}}, nil
+	}
+	return st.db().Run(buildTxn)
+}
+
+// computeUnitSubnets derives the subnet mapping for the given unit from
+// its assigned machine's link-layer device addresses.
+func (st *State) computeUnitSubnets(unit *Unit) (*unitSubnetsDoc, error) {
+	machineID, err := unit.AssignedMachineId()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	machine, err := st.Machine(machineID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	addresses, err := machine.AllDeviceAddresses()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	subnetIDs := set.NewStrings()
+	spaceIDs := set.NewStrings()
+	for _, address := range addresses {
+		if address.SubnetCIDR() == "" {
+			continue
+		}
+		subnet, err := address.Subnet()
+		if errors.IsNotFound(err) {
+			// Loopback and other machine-local addresses have no
+			// subnet recorded in state.
+			continue
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		subnetIDs.Add(subnet.ID())
+		spaceIDs.Add(subnet.SpaceID())
+	}
+	return &unitSubnetsDoc{
+		DocID:     unit.Name(),
+		UnitName:  unit.Name(),
+		MachineId: machineID,
+		SubnetIDs: subnetIDs.SortedValues(),
+		SpaceIDs:  spaceIDs.SortedValues(),
+	}, nil
+}
+
+// removeUnitSubnetsOps returns the operations required to remove the
+// materialised subnet mapping for the named unit, if any.
+func removeUnitSubnetsOps(unitName string) []txn.Op {
+	return []txn.Op{{
+		C:      unitSubnetsC,
+		Id:     unitName,
+		Remove: true,
+	}}
+}
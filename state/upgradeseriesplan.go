@@ -0,0 +1,308 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"sort"
+	"time"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2"
+	"github.com/juju/mgo/v2/bson"
+	"github.com/juju/mgo/v2/txn"
+)
+
+// UpgradeSeriesPlanStatus is the progress status of a batched series
+// upgrade plan.
+type UpgradeSeriesPlanStatus string
+
+const (
+	// UpgradeSeriesPlanPending indicates the plan has been created but no
+	// batch has been started yet.
+	UpgradeSeriesPlanPending UpgradeSeriesPlanStatus = "pending"
+
+	// UpgradeSeriesPlanRunning indicates a batch of machines is currently
+	// being upgraded.
+	UpgradeSeriesPlanRunning UpgradeSeriesPlanStatus = "running"
+
+	// UpgradeSeriesPlanHalted indicates the plan was stopped, typically
+	// because a hook failed on one of the machines in the current batch.
+	UpgradeSeriesPlanHalted UpgradeSeriesPlanStatus = "halted"
+
+	// UpgradeSeriesPlanCompleted indicates all batches have been upgraded.
+	UpgradeSeriesPlanCompleted UpgradeSeriesPlanStatus = "completed"
+)
+
+// upgradeSeriesPlanDoc describes a batched series upgrade of the machines
+// hosting an application's units. The machines are partitioned into
+// batches which are upgraded in order; with a canary policy the first
+// batch holds a single machine so problems surface before the bulk of
+// the application is touched.
+type upgradeSeriesPlanDoc struct {
+	ApplicationName string                  `bson:"application-name"`
+	ToSeries        string                  `bson:"to-series"`
+	Canary          bool                    `bson:"canary"`
+	Batches         [][]string              `bson:"batches"`
+	CurrentBatch    int                     `bson:"current-batch"`
+	Status          UpgradeSeriesPlanStatus `bson:"status"`
+	HaltReason      string                  `bson:"halt-reason,omitempty"`
+	TimeStamp       time.Time               `bson:"timestamp"`
+}
+
+// UpgradeSeriesPlan represents a state-backed plan for upgrading the
+// series of an application's machines in batches.
+type UpgradeSeriesPlan struct {
+	st  *State
+	doc upgradeSeriesPlanDoc
+}
+
+// ApplicationName returns the name of the application the plan covers.
+func (p *UpgradeSeriesPlan) ApplicationName() string {
+	return p.doc.ApplicationName
+}
+
+// ToSeries returns the series the machines are being upgraded to.
+func (p *UpgradeSeriesPlan) ToSeries() string {
+	return p.doc.ToSeries
+}
+
+// Canary returns true if the first batch is a single canary machine.
+func (p *UpgradeSeriesPlan) Canary() bool {
+	return p.doc.Canary
+}
+
+// Batches returns the machine ids to upgrade, partitioned into the
+// batches in which they will be processed.
+func (p *UpgradeSeriesPlan) Batches() [][]string {
+	batches := make([][]string, len(p.doc.Batches))
+	for i, batch := range p.doc.Batches {
+		batches[i] = append([]string(nil), batch...)
+	}
+	return batches
+}
+
+// CurrentBatch returns the index of the batch currently being upgraded.
+func (p *UpgradeSeriesPlan) CurrentBatch() int {
+	return p.doc.CurrentBatch
+}
+
+// CurrentBatchMachines returns the machine ids in the batch currently
+// being upgraded, or nil if the plan has finished.
+func (p *UpgradeSeriesPlan) CurrentBatchMachines() []string {
+	if p.doc.CurrentBatch >= len(p.doc.Batches) {
+		return nil
+	}
+	return append([]string(nil), p.doc.Batches[p.doc.CurrentBatch]...)
+}
+
+// Status returns the progress status of the plan.
+func (p *UpgradeSeriesPlan) Status() UpgradeSeriesPlanStatus {
+	return p.doc.Status
+}
+
+// HaltReason returns the reason the plan was halted, if it was.
+func (p *UpgradeSeriesPlan) HaltReason() string {
+	return p.doc.HaltReason
+}
+
+// Refresh refreshes the plan from state.
+func (p *UpgradeSeriesPlan) Refresh() error {
+	plan, err := p.st.UpgradeSeriesPlan(p.doc.ApplicationName)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	p.doc = plan.doc
+	return nil
+}
+
+// CreateUpgradeSeriesPlan creates a plan for upgrading the machines
+// hosting the named application's units to the given series, in batches
+// of at most batchSize machines. If canary is true the first batch is
+// reduced to a single machine so that problems are caught before the
+// rest of the machines are touched.
+func (st *State) CreateUpgradeSeriesPlan(appName, toSeries string, batchSize int, canary bool) (*UpgradeSeriesPlan, error) {
+	if batchSize < 1 {
+		return nil, errors.NotValidf("batch size %d", batchSize)
+	}
+	var doc upgradeSeriesPlanDoc
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		if _, err := st.UpgradeSeriesPlan(appName); err == nil {
+			return nil, errors.AlreadyExistsf("upgrade series plan for application %q", appName)
+		} else if !errors.IsNotFound(err) {
+			return nil, errors.Trace(err)
+		}
+		app, err := st.Application(appName)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		machineIds, err := applicationMachineIds(app)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if len(machineIds) == 0 {
+			return nil, errors.Errorf("application %q has no machines to upgrade", appName)
+		}
+		doc = upgradeSeriesPlanDoc{
+			ApplicationName: appName,
+			ToSeries:        toSeries,
+			Canary:          canary,
+			Batches:         partitionMachineBatches(machineIds, batchSize, canary),
+			CurrentBatch:    0,
+			Status:          UpgradeSeriesPlanPending,
+			TimeStamp:       st.clock().Now(),
+		}
+		return []txn.Op{{
+			C:      applicationsC,
+			Id:     app.doc.DocID,
+			Assert: isAliveDoc,
+		}, {
+			C:      upgradeSeriesPlansC,
+			Id:     appName,
+			Assert: txn.DocMissing,
+			Insert: doc,
+		}}, nil
+	}
+	if err := st.db().Run(buildTxn); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &UpgradeSeriesPlan{st: st, doc: doc}, nil
+}
+
+// UpgradeSeriesPlan returns the upgrade series plan for the named
+// application, if one exists.
+func (st *State) UpgradeSeriesPlan(appName string) (*UpgradeSeriesPlan, error) {
+	coll, closer := st.db().GetCollection(upgradeSeriesPlansC)
+	defer closer()
+
+	var doc upgradeSeriesPlanDoc
+	err := coll.FindId(appName).One(&doc)
+	if err == mgo.ErrNotFound {
+		return nil, errors.NotFoundf("upgrade series plan for application %q", appName)
+	}
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &UpgradeSeriesPlan{st: st, doc: doc}, nil
+}
+
+// AdvanceBatch marks the current batch as done and moves the plan on to
+// the next one, completing the plan when no batches remain. It is an
+// error to advance a halted or completed plan.
+func (p *UpgradeSeriesPlan) AdvanceBatch() error {
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		if attempt > 0 {
+			if err := p.Refresh(); err != nil {
+				return nil, errors.Trace(err)
+			}
+		}
+		switch p.doc.Status {
+		case UpgradeSeriesPlanPending, UpgradeSeriesPlanRunning:
+		default:
+			return nil, errors.Errorf("cannot advance %s upgrade series plan for application %q",
+				p.doc.Status, p.doc.ApplicationName)
+		}
+		next := p.doc.CurrentBatch
+		status := UpgradeSeriesPlanRunning
+		if p.doc.Status == UpgradeSeriesPlanRunning {
+			next++
+		}
+		if next >= len(p.doc.Batches) {
+			status = UpgradeSeriesPlanCompleted
+		}
+		return []txn.Op{{
+			C:      upgradeSeriesPlansC,
+			Id:     p.doc.ApplicationName,
+			Assert: bson.D{{"status", p.doc.Status}, {"current-batch", p.doc.CurrentBatch}},
+			Update: bson.D{{"$set", bson.D{
+				{"current-batch", next},
+				{"status", status},
+				{"timestamp", p.st.clock().Now()},
+			}}},
+		}}, nil
+	}
+	if err := p.st.db().Run(buildTxn); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(p.Refresh())
+}
+
+// Halt stops the plan, recording the reason; typically a hook failure
+// on one of the machines in the current batch. A halted plan is not
+// advanced further.
+func (p *UpgradeSeriesPlan) Halt(reason string) error {
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		if attempt > 0 {
+			if err := p.Refresh(); err != nil {
+				return nil, errors.Trace(err)
+			}
+		}
+		if p.doc.Status == UpgradeSeriesPlanCompleted {
+			return nil, errors.Errorf("cannot halt completed upgrade series plan for application %q",
+				p.doc.ApplicationName)
+		}
+		return []txn.Op{{
+			C:      upgradeSeriesPlansC,
+			Id:     p.doc.ApplicationName,
+			Assert: bson.D{{"status", p.doc.Status}},
+			Update: bson.D{{"$set", bson.D{
+				{"status", UpgradeSeriesPlanHalted},
+				{"halt-reason", reason},
+				{"timestamp", p.st.clock().Now()},
+			}}},
+		}}, nil
+	}
+	if err := p.st.db().Run(buildTxn); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(p.Refresh())
+}
+
+// Remove deletes the plan from state.
+func (p *UpgradeSeriesPlan) Remove() error {
+	ops := []txn.Op{{
+		C:      upgradeSeriesPlansC,
+		Id:     p.doc.ApplicationName,
+		Remove: true,
+	}}
+	return errors.Trace(p.st.db().RunTransaction(ops))
+}
+
+// applicationMachineIds returns the sorted, de-duplicated ids of the
+// machines hosting the application's units.
+func applicationMachineIds(app *Application) ([]string, error) {
+	units, err := app.AllUnits()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	machineIds := set.NewStrings()
+	for _, unit := range units {
+		machineId, err := unit.AssignedMachineId()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		machineIds.Add(machineId)
+	}
+	return machineIds.SortedValues(), nil
+}
+
+// partitionMachineBatches splits the machine ids into batches of at most
+// batchSize, with a leading single-machine canary batch if requested.
+func partitionMachineBatches(machineIds []string, batchSize int, canary bool) [][]string {
+	sort.Strings(machineIds)
+	var batches [][]string
+	if canary && len(machineIds) > 1 {
+		batches = append(batches, machineIds[:1])
+		machineIds = machineIds[1:]
+	}
+	for len(machineIds) > 0 {
+		n := batchSize
+		if n > len(machineIds) {
+			n = len(machineIds)
+		}
+		batches = append(batches, machineIds[:n])
+		machineIds = machineIds[n:]
+	}
+	return batches
+}
@@ -0,0 +1,43 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+type upgradeSeriesPlanInternalSuite struct{}
+
+var _ = gc.Suite(&upgradeSeriesPlanInternalSuite{})
+
+func (s *upgradeSeriesPlanInternalSuite) TestPartitionMachineBatches(c *gc.C) {
+	for i, t := range []struct {
+		machines  []string
+		batchSize int
+		canary    bool
+		expected  [][]string
+	}{{
+		machines:  []string{"0", "1", "2", "3"},
+		batchSize: 2,
+		expected:  [][]string{{"0", "1"}, {"2", "3"}},
+	}, {
+		machines:  []string{"0", "1", "2", "3"},
+		batchSize: 2,
+		canary:    true,
+		expected:  [][]string{{"0"}, {"1", "2"}, {"3"}},
+	}, {
+		machines:  []string{"0"},
+		batchSize: 3,
+		canary:    true,
+		expected:  [][]string{{"0"}},
+	}, {
+		machines:  []string{"2", "0", "1"},
+		batchSize: 5,
+		expected:  [][]string{{"0", "1", "2"}},
+	}} {
+		c.Logf("test %d", i)
+		c.Check(partitionMachineBatches(t.machines, t.batchSize, t.canary), jc.DeepEquals, t.expected)
+	}
+}
@@ -180,12 +180,13 @@ type VolumeParams struct {
 
 // VolumeInfo describes information about a volume.
 type VolumeInfo struct {
-	HardwareId string `bson:"hardwareid,omitempty"`
-	WWN        string `bson:"wwn,omitempty"`
-	Size       uint64 `bson:"size"`
-	Pool       string `bson:"pool"`
-	VolumeId   string `bson:"volumeid"`
-	Persistent bool   `bson:"persistent"`
+	HardwareId       string `bson:"hardwareid,omitempty"`
+	WWN              string `bson:"wwn,omitempty"`
+	Size             uint64 `bson:"size"`
+	Pool             string `bson:"pool"`
+	VolumeId         string `bson:"volumeid"`
+	Persistent       bool   `bson:"persistent"`
+	AvailabilityZone string `bson:"availabilityzone,omitempty"`
 }
 
 // VolumeAttachmentInfo describes information about a volume attachment.
@@ -1924,6 +1924,13 @@ func (st *State) WatchControllerConfig() NotifyWatcher {
 	return newEntityWatcher(st, controllersC, ControllerSettingsGlobalKey)
 }
 
+// WatchFirewallRules returns a StringsWatcher that notifies of changes
+// to the firewall rules collection, reporting the well known service
+// of each changed rule.
+func (st *State) WatchFirewallRules() StringsWatcher {
+	return newCollectionWatcher(st, colWCfg{col: firewallRulesC})
+}
+
 // Watch returns a watcher for observing changes to a controller service.
 func (c *CloudService) Watch() NotifyWatcher {
 	return newEntityWatcher(c.st, cloudServicesC, c.doc.DocID)
@@ -3,6 +3,10 @@
 
 package watcher
 
+import (
+	"time"
+)
+
 const (
 	TxnWatcherShortWait      = txnWatcherShortWait
 	TxnWatcherErrorShortWait = txnWatcherErrorShortWait
@@ -11,5 +15,9 @@ const (
 var OutOfSyncError = outOfSyncError{}
 
 func NewTestHubWatcher(hub HubSource, clock Clock, modelUUID string, logger Logger) (*HubWatcher, <-chan struct{}) {
-	return newHubWatcher(hub, clock, modelUUID, logger)
+	return newHubWatcher(hub, clock, modelUUID, 0, logger)
+}
+
+func NewTestCoalescingHubWatcher(hub HubSource, clock Clock, modelUUID string, window time.Duration, logger Logger) (*HubWatcher, <-chan struct{}) {
+	return newHubWatcher(hub, clock, modelUUID, window, logger)
 }
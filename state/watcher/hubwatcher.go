@@ -46,6 +46,11 @@ type HubWatcher struct {
 	idleFunc  func(string)
 	logger    Logger
 
+	// coalesceWindow is how long the watcher keeps draining further
+	// incoming changes before flushing notifications. Zero disables
+	// coalescing.
+	coalesceWindow time.Duration
+
 	tomb tomb.Tomb
 
 	// watches holds the observers managed by Watch/Unwatch.
@@ -85,6 +90,10 @@ type HubWatcher struct {
 	// changeCount is the number of change events we've processed
 	changeCount uint64
 
+	// coalescedCount is the number of change events that were folded
+	// into an already queued notification instead of queueing a new one
+	coalescedCount uint64
+
 	// revnoMapBytes tracks how big our revnomap is in approximate bytes
 	revnoMapBytes uintptr
 }
@@ -101,6 +110,12 @@ type HubWatcherConfig struct {
 	ModelUUID string
 	// Logger is used to control where the log messages for this watcher go.
 	Logger Logger
+	// CoalesceWindow, if non-zero, is how long the watcher keeps
+	// draining further incoming changes before flushing notifications.
+	// Changes to the same document within the window are folded into a
+	// single notification, preserving the order in which the documents
+	// were first seen.
+	CoalesceWindow time.Duration
 }
 
 // Validate ensures that all the values that have to be set are set.
@@ -123,7 +138,7 @@ func NewHubWatcher(config HubWatcherConfig) (*HubWatcher, error) {
 	if err := config.Validate(); err != nil {
 		return nil, errors.Annotate(err, "new HubWatcher invalid config")
 	}
-	watcher, _ := newHubWatcher(config.Hub, config.Clock, config.ModelUUID, config.Logger)
+	watcher, _ := newHubWatcher(config.Hub, config.Clock, config.ModelUUID, config.CoalesceWindow, config.Logger)
 	return watcher, nil
 }
 
@@ -137,20 +152,21 @@ func NewDead(err error) *HubWatcher {
 	return w
 }
 
-func newHubWatcher(hub HubSource, clock Clock, modelUUID string, logger Logger) (*HubWatcher, <-chan struct{}) {
+func newHubWatcher(hub HubSource, clock Clock, modelUUID string, coalesceWindow time.Duration, logger Logger) (*HubWatcher, <-chan struct{}) {
 	if logger == nil {
 		logger = noOpLogger{}
 	}
 	started := make(chan struct{})
 	w := &HubWatcher{
-		hub:       hub,
-		clock:     clock,
-		modelUUID: modelUUID,
-		idleFunc:  HubWatcherIdleFunc,
-		logger:    logger,
-		watches:   make(map[watchKey][]watchInfo),
-		request:   make(chan interface{}),
-		changes:   make(chan Change),
+		hub:            hub,
+		clock:          clock,
+		modelUUID:      modelUUID,
+		coalesceWindow: coalesceWindow,
+		idleFunc:       HubWatcherIdleFunc,
+		logger:         logger,
+		watches:        make(map[watchKey][]watchInfo),
+		request:        make(chan interface{}),
+		changes:        make(chan Change),
 	}
 	w.tomb.Go(func() error {
 		unsub := hub.SubscribeMatch(
@@ -349,6 +365,9 @@ type HubWatcherStats struct {
 	RequestCount uint64
 	// ChangeCount is the number of changes we've processed
 	ChangeCount uint64
+	// CoalescedCount is the number of changes folded into an already
+	// queued notification instead of queueing a new one
+	CoalescedCount uint64
 }
 
 type reqStats struct {
@@ -385,6 +404,7 @@ func (w *HubWatcher) Report() map[string]interface{} {
 		"sync-event-coll-count": stats.SyncEventCollCount,
 		"request-count":         stats.RequestCount,
 		"change-count":          stats.ChangeCount,
+		"coalesced-count":       stats.CoalescedCount,
 	}
 }
 
@@ -407,6 +427,25 @@ func (w *HubWatcher) loop() error {
 			return errors.Trace(tomb.ErrDying)
 		case inChange := <-w.changes:
 			w.queueChange(inChange)
+			if w.coalesceWindow > 0 {
+				// Keep draining changes for the coalescing window so
+				// that bursts of changes are folded into as few
+				// notifications as possible before we start flushing.
+				window := w.clock.After(w.coalesceWindow)
+			drain:
+				for {
+					select {
+					case <-w.tomb.Dying():
+						return errors.Trace(tomb.ErrDying)
+					case inChange := <-w.changes:
+						w.queueChange(inChange)
+					case req := <-w.request:
+						w.handle(req)
+					case <-window:
+						break drain
+					}
+				}
+			}
 			if w.idleFunc != nil {
 				idle.Reset(HubWatcherIdleTime)
 			}
@@ -462,6 +501,7 @@ allevents:
 			case e.ch <- outChange:
 				w.logger.Tracef("%p e.ch=%v has been notified %v", w, e.ch, outChange)
 				watchersNotified = true
+				e.sent = true
 			case <-deadline:
 				w.logger.Criticalf("%p programming error, e.ch=%v did not accept %v - missing Unwatch?\nwatch source:\n%s",
 					w, e.ch, outChange, e.watchSource)
@@ -573,6 +613,7 @@ func (w *HubWatcher) handle(req interface{}) {
 			SyncEventCollCount: w.syncEventCollectionCount,
 			SyncEventDocCount:  w.syncEventDocCount,
 			RequestCount:       w.requestCount,
+			CoalescedCount:     w.coalescedCount,
 		}
 		select {
 		case <-w.tomb.Dying():
@@ -596,6 +637,9 @@ func (w *HubWatcher) queueChange(change Change) {
 		if info.filter != nil && !info.filter(change.Id) {
 			continue
 		}
+		if w.coalesceEvent(info.ch, key, revno) {
+			continue
+		}
 		evt := event{
 			ch:          info.ch,
 			key:         key,
@@ -612,6 +656,9 @@ func (w *HubWatcher) queueChange(change Change) {
 	for i, info := range infos {
 		if revno > info.revno || revno < 0 && info.revno >= 0 {
 			infos[i].revno = revno
+			if w.coalesceEvent(info.ch, key, revno) {
+				continue
+			}
 			evt := event{
 				ch:          info.ch,
 				key:         key,
@@ -624,3 +671,26 @@ func (w *HubWatcher) queueChange(change Change) {
 		}
 	}
 }
+
+// coalesceEvent folds the change into an already queued notification
+// for the same document and channel, if there is one that hasn't been
+// delivered yet. The queued event keeps its position, preserving the
+// order in which documents were first seen, but carries the latest
+// revno. It reports whether the change was coalesced. Coalescing only
+// happens when a coalesce window is configured.
+func (w *HubWatcher) coalesceEvent(ch chan<- Change, key watchKey, revno int64) bool {
+	if w.coalesceWindow <= 0 {
+		return false
+	}
+	for i := range w.syncEvents {
+		e := &w.syncEvents[i]
+		if e.sent || e.ch != ch || e.key != key {
+			continue
+		}
+		e.revno = revno
+		w.coalescedCount++
+		w.logger.Tracef("%p coalesced change for %v watch %v at queue position %d", w, key, ch, i)
+		return true
+	}
+	return false
+}
@@ -459,3 +459,59 @@ func (s *HubWatcherSuite) TestWatchCollectionDeadReceivers(c *gc.C) {
 		`0x.......... programming error, e.ch=0x.......... did not accept {test b 3} - missing Unwatch\?\nwatch source:\ngoroutine .*`,
 	}})
 }
+
+func (s *HubWatcherSuite) TestCoalesceWindowFoldsChanges(c *gc.C) {
+	logger := loggo.GetLogger("HubWatcherSuite")
+	w, started := watcher.NewTestCoalescingHubWatcher(s.hub, s.clock, "model-uuid", 10*time.Millisecond, logger)
+	s.AddCleanup(func(c *gc.C) {
+		worker.Stop(w)
+	})
+	select {
+	case <-started:
+	case <-time.After(testing.LongWait):
+		c.Fatalf("hub watcher worker didn't start")
+	}
+
+	w.Watch("test", "a", s.ch)
+	s.publish(c, watcher.Change{"test", "a", 2}, watcher.Change{"test", "a", 3})
+
+	// Nothing is delivered until the coalesce window has passed.
+	assertNoChange(c, s.ch)
+	c.Assert(s.clock.WaitAdvance(10*time.Millisecond, testing.LongWait, 1), jc.ErrorIsNil)
+
+	// Both changes were folded into a single notification carrying
+	// the latest revno.
+	assertChange(c, s.ch, watcher.Change{"test", "a", 3})
+	assertNoChange(c, s.ch)
+
+	stats := w.Stats()
+	c.Assert(stats.CoalescedCount, gc.Equals, uint64(1))
+}
+
+func (s *HubWatcherSuite) TestCoalesceWindowPreservesOrdering(c *gc.C) {
+	logger := loggo.GetLogger("HubWatcherSuite")
+	w, started := watcher.NewTestCoalescingHubWatcher(s.hub, s.clock, "model-uuid", 10*time.Millisecond, logger)
+	s.AddCleanup(func(c *gc.C) {
+		worker.Stop(w)
+	})
+	select {
+	case <-started:
+	case <-time.After(testing.LongWait):
+		c.Fatalf("hub watcher worker didn't start")
+	}
+
+	coll := make(chan watcher.Change)
+	w.WatchCollection("test", coll)
+	s.publish(c,
+		watcher.Change{"test", "a", 2},
+		watcher.Change{"test", "b", 2},
+		watcher.Change{"test", "a", 3},
+	)
+	c.Assert(s.clock.WaitAdvance(10*time.Millisecond, testing.LongWait, 1), jc.ErrorIsNil)
+
+	// Documents are notified in the order they were first seen, with
+	// the coalesced change carrying the latest revno.
+	assertChange(c, coll, watcher.Change{"test", "a", 3})
+	assertChange(c, coll, watcher.Change{"test", "b", 2})
+	assertNoChange(c, coll)
+}
@@ -120,6 +120,9 @@ type event struct {
 	key         watchKey
 	revno       int64
 	watchSource []byte
+	// sent is set once the event has been delivered to its channel;
+	// pending events may still be coalesced with later changes.
+	sent bool
 }
 
 // Period is the delay between each sync.
@@ -49,11 +49,19 @@ func newWorkers(st *State, hub *pubsub.SimpleHub) (*workers, error) {
 		}),
 	}
 	_ = ws.StartWorker(txnLogWorker, func() (worker.Worker, error) {
+		// The coalesce window is read best-effort: during bootstrap
+		// the controller config doesn't exist yet, in which case
+		// coalescing is left disabled.
+		var coalesceWindow time.Duration
+		if cfg, err := st.ControllerConfig(); err == nil {
+			coalesceWindow = cfg.WatcherCoalesceWindow()
+		}
 		return watcher.NewHubWatcher(watcher.HubWatcherConfig{
-			Hub:       hub,
-			Clock:     st.clock(),
-			ModelUUID: st.modelUUID(),
-			Logger:    loggo.GetLogger("juju.state.watcher"),
+			Hub:            hub,
+			Clock:          st.clock(),
+			ModelUUID:      st.modelUUID(),
+			Logger:         loggo.GetLogger("juju.state.watcher"),
+			CoalesceWindow: coalesceWindow,
 		})
 	})
 	// The controller also needs to prune the txn log collection.
@@ -41,6 +41,11 @@ type VolumeInfo struct {
 	// Persistent reflects whether the volume is destroyed with the
 	// machine to which it is attached.
 	Persistent bool
+
+	// AvailabilityZone is the availability zone the volume was
+	// provisioned into. Not all providers report a zone, so this
+	// may be left blank.
+	AvailabilityZone string
 }
 
 // VolumeAttachment identifies and describes machine-specific volume
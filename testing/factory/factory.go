@@ -12,6 +12,7 @@ import (
 
 	"github.com/juju/charm/v9"
 	charmresource "github.com/juju/charm/v9/resource"
+	"github.com/juju/errors"
 	"github.com/juju/names/v4"
 	jc "github.com/juju/testing/checkers"
 	"github.com/juju/utils/v2"
@@ -32,6 +33,7 @@ import (
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/state"
 	"github.com/juju/juju/storage"
+	"github.com/juju/juju/storage/poolmanager"
 	"github.com/juju/juju/storage/provider"
 	"github.com/juju/juju/testcharms"
 	"github.com/juju/juju/testing"
@@ -393,9 +395,11 @@ func (factory *Factory) makeMachineReturningPassword(c *gc.C, params *MachinePar
 // Sensible default values are substituted for missing ones.
 // Supported charms depend on the charm/testing package.
 // Currently supported charms:
-//   all-hooks, category, dummy, format2, logging, monitoring, mysql,
-//   mysql-alternative, riak, terracotta, upgrade1, upgrade2, varnish,
-//   varnish-alternative, wordpress.
+//
+//	all-hooks, category, dummy, format2, logging, monitoring, mysql,
+//	mysql-alternative, riak, terracotta, upgrade1, upgrade2, varnish,
+//	varnish-alternative, wordpress.
+//
 // If params is not specified, defaults are used.
 func (factory *Factory) MakeCharm(c *gc.C, params *CharmParams) *state.Charm {
 	if params == nil {
@@ -654,6 +658,172 @@ func (factory *Factory) MakeUnitReturningPassword(c *gc.C, params *UnitParams) (
 	return unit, params.Password
 }
 
+// MakeCAASSidecarApplication creates a sidecar application in a CAAS
+// model with the specified params, filling in sane defaults for missing
+// values. Unless a charm is supplied, the application is built from the
+// "storage-sidecar" test charm and given a single rootfs-backed
+// filesystem storage instance. If params is not specified, defaults are
+// used.
+func (factory *Factory) MakeCAASSidecarApplication(c *gc.C, params *ApplicationParams) *state.Application {
+	if params == nil {
+		params = &ApplicationParams{}
+	}
+	model, err := factory.st.Model()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(model.Type(), gc.Equals, state.ModelTypeCAAS)
+	if params.Charm == nil {
+		params.Charm = factory.MakeCharmV2(c, &CharmParams{Name: "storage-sidecar"})
+		if params.Storage == nil {
+			params.Storage = map[string]state.StorageConstraints{
+				"data": {Pool: string(provider.RootfsProviderType), Size: 1024, Count: 1},
+			}
+		}
+	}
+	return factory.MakeApplication(c, params)
+}
+
+// CloudContainerParams are used when recording cloud container details
+// against a unit in a CAAS model.
+type CloudContainerParams struct {
+	Unit       *state.Unit
+	ProviderId string
+	Address    string
+	Ports      []string
+	Status     *status.StatusInfo
+}
+
+// MakeCloudContainer records cloud container details - provider id,
+// address and opened ports - against a unit in a CAAS model, filling in
+// sane defaults for missing values. If params is not specified, defaults
+// are used.
+func (factory *Factory) MakeCloudContainer(c *gc.C, params *CloudContainerParams) *state.Unit {
+	if params == nil {
+		params = &CloudContainerParams{}
+	}
+	if params.Unit == nil {
+		params.Unit = factory.MakeUnit(c, nil)
+	}
+	if params.ProviderId == "" {
+		params.ProviderId = uniqueString("provider-id")
+	}
+	if params.Address == "" {
+		params.Address = "10.0.0.1"
+	}
+	if params.Ports == nil {
+		params.Ports = []string{"80"}
+	}
+	if params.Status == nil {
+		params.Status = &status.StatusInfo{
+			Status:  status.Running,
+			Message: "cloud container running",
+		}
+	}
+	var update state.UpdateUnitsOperation
+	update.Updates = []*state.UpdateUnitOperation{
+		params.Unit.UpdateOperation(state.UnitUpdateProperties{
+			ProviderId:           &params.ProviderId,
+			Address:              &params.Address,
+			Ports:                &params.Ports,
+			CloudContainerStatus: params.Status,
+		}),
+	}
+	app, err := params.Unit.Application()
+	c.Assert(err, jc.ErrorIsNil)
+	err = app.UpdateUnits(&update)
+	c.Assert(err, jc.ErrorIsNil)
+	err = params.Unit.Refresh()
+	c.Assert(err, jc.ErrorIsNil)
+	return params.Unit
+}
+
+// UnitWithStorageParams are used when creating an IAAS unit with
+// attached storage instances.
+type UnitWithStorageParams struct {
+	// Kind is the kind of storage to attach, "block" or "filesystem".
+	Kind string
+	// Pool is the storage pool to draw the instances from. The pool is
+	// created, backed by the loop or rootfs provider as appropriate for
+	// Kind, if it does not already exist.
+	Pool string
+	// Size is the size of each storage instance, in MiB.
+	Size uint64
+	// Count is the total number of storage instances to attach. The
+	// "storage-block" test charm fixes its "data" store at a single
+	// instance, so additional block instances are drawn from its
+	// "allecto" store.
+	Count uint64
+	// Machine is the machine to assign the unit to. A fresh machine is
+	// created if it is not specified.
+	Machine *state.Machine
+}
+
+// MakeUnitWithStorage creates an application from one of the
+// "storage-block" and "storage-filesystem" test charms with the given
+// storage constraints, adds a unit assigned to a machine, and returns
+// the application, the unit and the tags of the storage instances
+// attached to the unit. Sane defaults are substituted for missing
+// values: a single 1GiB block instance from a loop-backed pool. If
+// params is not specified, defaults are used.
+func (factory *Factory) MakeUnitWithStorage(c *gc.C, params *UnitWithStorageParams) (*state.Application, *state.Unit, []names.StorageTag) {
+	if params == nil {
+		params = &UnitWithStorageParams{}
+	}
+	if params.Kind == "" {
+		params.Kind = "block"
+	}
+	providerType := provider.LoopProviderType
+	if params.Kind == "filesystem" {
+		providerType = provider.RootfsProviderType
+	}
+	if params.Pool == "" {
+		params.Pool = fmt.Sprintf("%s-pool", providerType)
+	}
+	if params.Size == 0 {
+		params.Size = 1024
+	}
+	if params.Count == 0 {
+		params.Count = 1
+	}
+
+	pm := poolmanager.New(state.NewStateSettings(factory.st), provider.CommonStorageProviders())
+	if _, err := pm.Get(params.Pool); errors.IsNotFound(err) {
+		_, err := pm.Create(params.Pool, providerType, map[string]interface{}{})
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	cons := map[string]state.StorageConstraints{
+		"data": {Pool: params.Pool, Size: params.Size, Count: params.Count},
+	}
+	if params.Kind == "block" && params.Count > 1 {
+		cons["data"] = state.StorageConstraints{Pool: params.Pool, Size: params.Size, Count: 1}
+		cons["allecto"] = state.StorageConstraints{Pool: params.Pool, Size: params.Size, Count: params.Count - 1}
+	}
+	ch := factory.MakeCharm(c, &CharmParams{Name: "storage-" + params.Kind})
+	app := factory.MakeApplication(c, &ApplicationParams{
+		Charm:   ch,
+		Storage: cons,
+	})
+	unit, err := app.AddUnit(state.AddUnitParams{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	machine := params.Machine
+	if machine == nil {
+		machine = factory.MakeMachine(c, nil)
+	}
+	err = unit.AssignToMachine(machine)
+	c.Assert(err, jc.ErrorIsNil)
+
+	sb, err := state.NewStorageBackend(factory.st)
+	c.Assert(err, jc.ErrorIsNil)
+	attachments, err := sb.UnitStorageAttachments(unit.UnitTag())
+	c.Assert(err, jc.ErrorIsNil)
+	storageTags := make([]names.StorageTag, len(attachments))
+	for i, a := range attachments {
+		storageTags[i] = a.StorageInstance()
+	}
+	return app, unit, storageTags
+}
+
 // MakeMetric makes a metric with specified params, filling in
 // sane defaults for missing values.
 // If params is not specified, defaults are used.
@@ -405,6 +405,71 @@ func (s *factorySuite) TestMakeUnit(c *gc.C) {
 	c.Assert(unitCharmURL, gc.DeepEquals, applicationCharmURL)
 }
 
+func (s *factorySuite) TestMakeUnitWithStorageNil(c *gc.C) {
+	application, unit, storageTags := s.Factory.MakeUnitWithStorage(c, nil)
+	c.Assert(application.Name(), gc.Equals, "storage-block")
+	c.Assert(storageTags, gc.HasLen, 1)
+	c.Assert(storageTags[0], gc.Equals, names.NewStorageTag("data/0"))
+
+	machineId, err := unit.AssignedMachineId()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(machineId, gc.Not(gc.Equals), "")
+
+	sb, err := state.NewStorageBackend(s.State)
+	c.Assert(err, jc.ErrorIsNil)
+	instance, err := sb.StorageInstance(storageTags[0])
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(instance.Pool(), gc.Equals, "loop-pool")
+}
+
+func (s *factorySuite) TestMakeUnitWithStorageMultiple(c *gc.C) {
+	_, unit, storageTags := s.Factory.MakeUnitWithStorage(c, &factory.UnitWithStorageParams{
+		Count: 3,
+	})
+	c.Assert(storageTags, gc.HasLen, 3)
+
+	sb, err := state.NewStorageBackend(s.State)
+	c.Assert(err, jc.ErrorIsNil)
+	attachments, err := sb.UnitStorageAttachments(unit.UnitTag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(attachments, gc.HasLen, 3)
+}
+
+func (s *factorySuite) TestMakeCloudContainer(c *gc.C) {
+	st := s.Factory.MakeCAASModel(c, nil)
+	defer st.Close()
+	f := factory.NewFactory(st, s.StatePool)
+
+	unit := f.MakeCloudContainer(c, &factory.CloudContainerParams{
+		Unit:       f.MakeUnit(c, nil),
+		ProviderId: "provider-id",
+		Address:    "192.168.1.1",
+		Ports:      []string{"80"},
+	})
+	info, err := unit.ContainerInfo()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(info.ProviderId(), gc.Equals, "provider-id")
+	c.Assert(info.Ports(), jc.DeepEquals, []string{"80"})
+	addr := info.Address()
+	c.Assert(addr, gc.NotNil)
+	c.Assert(addr.Value, gc.Equals, "192.168.1.1")
+}
+
+func (s *factorySuite) TestMakeCAASSidecarApplicationNil(c *gc.C) {
+	st := s.Factory.MakeCAASModel(c, nil)
+	defer st.Close()
+	f := factory.NewFactory(st, s.StatePool)
+
+	application := f.MakeCAASSidecarApplication(c, nil)
+	c.Assert(application.Name(), gc.Equals, "storage-sidecar")
+
+	cons, err := application.StorageConstraints()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cons["data"], jc.DeepEquals, state.StorageConstraints{
+		Pool: "rootfs", Size: 1024, Count: 1,
+	})
+}
+
 func (s *factorySuite) TestMakeRelationNil(c *gc.C) {
 	relation := s.Factory.MakeRelation(c, nil)
 	c.Assert(relation, gc.NotNil)
@@ -0,0 +1,103 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package upgrades
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/version/v2"
+
+	"github.com/juju/juju/state"
+)
+
+// ModelPrecheck is a named readiness check run before a model upgrade
+// is started. Checks are registered by the packages that own them and
+// run together via the client facade, so that readiness can be reported
+// without starting the upgrade.
+type ModelPrecheck struct {
+	// Name identifies the check in the readiness report.
+	Name string
+
+	// Run performs the check against the model, returning an error
+	// describing why the model is not ready to be upgraded to the
+	// target version. It must not change any state.
+	Run func(st *state.State, targetVersion version.Number) error
+}
+
+// PrecheckResult holds the outcome of a single upgrade precheck.
+type PrecheckResult struct {
+	// Name is the name of the check that produced this result.
+	Name string
+
+	// Err is nil if the check passed, otherwise the reason it failed.
+	Err error
+}
+
+var modelPrechecks = []ModelPrecheck{
+	{Name: "agent-version", Run: checkAgentVersionUpgradable},
+	{Name: "model-migration", Run: checkNoActiveMigration},
+}
+
+// RegisterModelPrecheck adds a precheck to the set run before model
+// upgrades. It is intended to be called from init functions and is not
+// safe for concurrent use.
+func RegisterModelPrecheck(check ModelPrecheck) error {
+	if check.Name == "" || check.Run == nil {
+		return errors.NotValidf("model precheck with empty name or nil Run")
+	}
+	for _, existing := range modelPrechecks {
+		if existing.Name == check.Name {
+			return errors.AlreadyExistsf("model precheck %q", check.Name)
+		}
+	}
+	modelPrechecks = append(modelPrechecks, check)
+	return nil
+}
+
+// RunModelPrechecks runs all registered prechecks against the model,
+// returning one result per check in registration order.
+func RunModelPrechecks(st *state.State, targetVersion version.Number) []PrecheckResult {
+	results := make([]PrecheckResult, len(modelPrechecks))
+	for i, check := range modelPrechecks {
+		results[i] = PrecheckResult{
+			Name: check.Name,
+			Err:  check.Run(st, targetVersion),
+		}
+	}
+	return results
+}
+
+// checkAgentVersionUpgradable ensures an upgrade path exists from the
+// model's current agent version to the target version.
+func checkAgentVersionUpgradable(st *state.State, targetVersion version.Number) error {
+	model, err := st.Model()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	current, err := model.AgentVersion()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	allowed, minVer, err := UpgradeAllowed(current, targetVersion)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !allowed {
+		return errors.Errorf(
+			"upgrade from %v to %v requires the model to first be upgraded to at least %v",
+			current, targetVersion, minVer)
+	}
+	return nil
+}
+
+// checkNoActiveMigration ensures the model is not being migrated.
+func checkNoActiveMigration(st *state.State, _ version.Number) error {
+	model, err := st.Model()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if mode := model.MigrationMode(); mode != state.MigrationModeNone {
+		return errors.Errorf("model is %s, upgrade blocked", mode)
+	}
+	return nil
+}
@@ -147,6 +147,33 @@ func runUpgradeSteps(ops *opsIterator, targets []Target, context Context) error
 	return nil
 }
 
+// PlanStateUpgrade returns the descriptions of the state-based
+// (database schema) upgrade steps that would run for the given targets
+// when upgrading between the two versions, in the order they would run.
+// No steps are performed.
+func PlanStateUpgrade(from, to version.Number, targets ...Target) []string {
+	return planSteps(newOpsIterator(from, to, stateUpgradeOperations()), targets)
+}
+
+// PlanUpgrade returns the descriptions of the API-based upgrade steps
+// that would run for the given targets when upgrading between the two
+// versions, in the order they would run. No steps are performed.
+func PlanUpgrade(from, to version.Number, targets ...Target) []string {
+	return planSteps(newOpsIterator(from, to, upgradeOperations()), targets)
+}
+
+func planSteps(ops *opsIterator, targets []Target) []string {
+	var descriptions []string
+	for ops.Next() {
+		for _, step := range ops.Get().Steps() {
+			if targetsMatch(targets, step.Targets()) {
+				descriptions = append(descriptions, step.Description())
+			}
+		}
+	}
+	return descriptions
+}
+
 // targetsMatch returns true if any machineTargets match any of
 // stepTargets.
 func targetsMatch(machineTargets []Target, stepTargets []Target) bool {
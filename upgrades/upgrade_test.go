@@ -497,6 +497,32 @@ func (s *upgradeSuite) TestPerformUpgrade(c *gc.C) {
 	}
 }
 
+func (s *upgradeSuite) TestPlanUpgradeReportsStepsWithoutRunning(c *gc.C) {
+	s.PatchValue(upgrades.StateUpgradeOperations, stateUpgradeOperations)
+	s.PatchValue(upgrades.UpgradeOperations, upgradeOperations)
+
+	steps := upgrades.PlanStateUpgrade(
+		version.MustParse("1.20.0"), version.MustParse("1.21.0"),
+		upgrades.DatabaseMaster, upgrades.Controller,
+	)
+	c.Check(steps, jc.DeepEquals, []string{
+		"state step 1 - 1.21.0", "state step 2 - 1.21.0",
+	})
+
+	steps = upgrades.PlanUpgrade(
+		version.MustParse("1.16.0"), version.MustParse("1.17.0"),
+		upgrades.HostMachine,
+	)
+	c.Check(steps, jc.DeepEquals, []string{"step 1 - 1.17.0"})
+
+	// Nothing to do when the versions match.
+	steps = upgrades.PlanStateUpgrade(
+		version.MustParse("1.21.0"), version.MustParse("1.21.0"),
+		upgrades.DatabaseMaster,
+	)
+	c.Check(steps, gc.HasLen, 0)
+}
+
 type contextStep struct {
 	useAPI bool
 }
@@ -0,0 +1,129 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package agenthealthreporter
+
+import (
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	"github.com/juju/worker/v3"
+	"github.com/juju/worker/v3/dependency"
+
+	"github.com/juju/juju/apiserver/params"
+	jworker "github.com/juju/juju/worker"
+)
+
+// ReportInterval is how often the agent's worker health is reported
+// to the controller.
+const ReportInterval = 5 * time.Minute
+
+// Facade exposes the API method used to report worker health.
+type Facade interface {
+	RecordHealth(tag names.Tag, workers []params.WorkerHealth) error
+}
+
+// Reporter provides the dependency engine report that is summarised
+// into the health report.
+type Reporter interface {
+	Report() map[string]interface{}
+}
+
+// Logger represents the methods used by the worker to log details.
+type Logger interface {
+	Debugf(string, ...interface{})
+}
+
+// Config holds the information necessary to run an agent health
+// reporter worker.
+type Config struct {
+	Tag      names.Tag
+	Facade   Facade
+	Reporter Reporter
+	Interval time.Duration
+	Logger   Logger
+}
+
+// Validate returns an error if the config cannot drive a worker.
+func (config Config) Validate() error {
+	if config.Tag == nil {
+		return errors.NotValidf("nil Tag")
+	}
+	if config.Facade == nil {
+		return errors.NotValidf("nil Facade")
+	}
+	if config.Reporter == nil {
+		return errors.NotValidf("nil Reporter")
+	}
+	if config.Interval <= 0 {
+		return errors.NotValidf("non-positive Interval")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	return nil
+}
+
+// New returns a worker that periodically summarises the agent's
+// dependency engine report and records it on the controller.
+func New(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	w := &healthReporter{config: config}
+	f := func(stop <-chan struct{}) error {
+		return w.report()
+	}
+	return jworker.NewPeriodicWorker(f, config.Interval, jworker.NewTimer), nil
+}
+
+type healthReporter struct {
+	config Config
+	last   []params.WorkerHealth
+}
+
+func (w *healthReporter) report() error {
+	workers := SummariseEngineReport(w.config.Reporter.Report())
+	if reflect.DeepEqual(workers, w.last) {
+		w.config.Logger.Debugf("worker health unchanged, not reporting")
+		return nil
+	}
+	err := w.config.Facade.RecordHealth(w.config.Tag, workers)
+	if errors.IsNotSupported(err) {
+		w.config.Logger.Debugf("controller does not support agent health reports")
+		return nil
+	}
+	if err != nil {
+		return errors.Annotate(err, "recording agent health")
+	}
+	w.last = workers
+	return nil
+}
+
+// SummariseEngineReport extracts the per-worker health from a
+// dependency engine report, sorted by worker name.
+func SummariseEngineReport(report map[string]interface{}) []params.WorkerHealth {
+	manifolds, ok := report[dependency.KeyManifolds].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	result := make([]params.WorkerHealth, 0, len(manifolds))
+	for name, raw := range manifolds {
+		info, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		health := params.WorkerHealth{Name: name}
+		health.State, _ = info[dependency.KeyState].(string)
+		health.Error, _ = info[dependency.KeyError].(string)
+		health.StartCount, _ = info[dependency.KeyStartCount].(int)
+		result = append(result, health)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
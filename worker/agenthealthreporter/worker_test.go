@@ -0,0 +1,195 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package agenthealthreporter_test
+
+import (
+	"sync"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v3/workertest"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/params"
+	coretesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/worker/agenthealthreporter"
+)
+
+type WorkerSuite struct {
+	coretesting.BaseSuite
+}
+
+var _ = gc.Suite(&WorkerSuite{})
+
+func validConfig() agenthealthreporter.Config {
+	return agenthealthreporter.Config{
+		Tag:      names.NewMachineTag("1"),
+		Facade:   &fakeFacade{},
+		Reporter: &fakeReporter{},
+		Interval: time.Minute,
+		Logger:   loggo.GetLogger("test"),
+	}
+}
+
+func (s *WorkerSuite) TestValidateNilTag(c *gc.C) {
+	config := validConfig()
+	config.Tag = nil
+	s.checkNotValid(c, config, "nil Tag not valid")
+}
+
+func (s *WorkerSuite) TestValidateNilFacade(c *gc.C) {
+	config := validConfig()
+	config.Facade = nil
+	s.checkNotValid(c, config, "nil Facade not valid")
+}
+
+func (s *WorkerSuite) TestValidateNilReporter(c *gc.C) {
+	config := validConfig()
+	config.Reporter = nil
+	s.checkNotValid(c, config, "nil Reporter not valid")
+}
+
+func (s *WorkerSuite) TestValidateZeroInterval(c *gc.C) {
+	config := validConfig()
+	config.Interval = 0
+	s.checkNotValid(c, config, "non-positive Interval not valid")
+}
+
+func (s *WorkerSuite) TestValidateNilLogger(c *gc.C) {
+	config := validConfig()
+	config.Logger = nil
+	s.checkNotValid(c, config, "nil Logger not valid")
+}
+
+func (s *WorkerSuite) checkNotValid(c *gc.C, config agenthealthreporter.Config, expect string) {
+	w, err := agenthealthreporter.New(config)
+	c.Check(w, gc.IsNil)
+	c.Check(err, gc.ErrorMatches, expect)
+	c.Check(err, jc.Satisfies, errors.IsNotValid)
+}
+
+func (s *WorkerSuite) TestSummariseEngineReport(c *gc.C) {
+	workers := agenthealthreporter.SummariseEngineReport(map[string]interface{}{
+		"state": "started",
+		"manifolds": map[string]interface{}{
+			"upgrader": map[string]interface{}{
+				"state":       "stopped",
+				"error":       "boom",
+				"start-count": 3,
+			},
+			"machiner": map[string]interface{}{
+				"state":       "started",
+				"start-count": 1,
+				"inputs":      []string{"agent"},
+			},
+		},
+	})
+	c.Assert(workers, jc.DeepEquals, []params.WorkerHealth{{
+		Name:       "machiner",
+		State:      "started",
+		StartCount: 1,
+	}, {
+		Name:       "upgrader",
+		State:      "stopped",
+		Error:      "boom",
+		StartCount: 3,
+	}})
+}
+
+func (s *WorkerSuite) TestSummariseEngineReportEmpty(c *gc.C) {
+	c.Assert(agenthealthreporter.SummariseEngineReport(nil), gc.HasLen, 0)
+}
+
+func (s *WorkerSuite) TestReportsHealth(c *gc.C) {
+	facade := &fakeFacade{recorded: make(chan []params.WorkerHealth, 1)}
+	reporter := &fakeReporter{}
+	reporter.setReport(map[string]interface{}{
+		"manifolds": map[string]interface{}{
+			"machiner": map[string]interface{}{
+				"state": "starting",
+			},
+		},
+	})
+	config := validConfig()
+	config.Facade = facade
+	config.Reporter = reporter
+	config.Interval = coretesting.ShortWait
+	w, err := agenthealthreporter.New(config)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	select {
+	case workers := <-facade.recorded:
+		c.Assert(workers, jc.DeepEquals, []params.WorkerHealth{{
+			Name:  "machiner",
+			State: "starting",
+		}})
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for health report")
+	}
+
+	// A changed report is sent on the next firing.
+	reporter.setReport(map[string]interface{}{
+		"manifolds": map[string]interface{}{
+			"machiner": map[string]interface{}{
+				"state": "started",
+			},
+		},
+	})
+	select {
+	case workers := <-facade.recorded:
+		c.Assert(workers, jc.DeepEquals, []params.WorkerHealth{{
+			Name:  "machiner",
+			State: "started",
+		}})
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for second health report")
+	}
+	c.Assert(facade.tag(), gc.Equals, names.NewMachineTag("1"))
+}
+
+type fakeFacade struct {
+	mu       sync.Mutex
+	lastTag  names.Tag
+	recorded chan []params.WorkerHealth
+}
+
+func (f *fakeFacade) RecordHealth(tag names.Tag, workers []params.WorkerHealth) error {
+	f.mu.Lock()
+	f.lastTag = tag
+	f.mu.Unlock()
+	if f.recorded != nil {
+		select {
+		case f.recorded <- workers:
+		default:
+		}
+	}
+	return nil
+}
+
+func (f *fakeFacade) tag() names.Tag {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.lastTag
+}
+
+type fakeReporter struct {
+	mu     sync.Mutex
+	report map[string]interface{}
+}
+
+func (r *fakeReporter) setReport(report map[string]interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.report = report
+}
+
+func (r *fakeReporter) Report() map[string]interface{} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.report
+}
@@ -33,6 +33,11 @@ type Config struct {
 	Addresser APIAddresser
 	Setter    APIAddressSetter
 	Logger    Logger
+
+	// Policy holds the model's address selection preferences, applied
+	// to the API addresses before they are set on the agent. The zero
+	// value leaves the addresses untouched.
+	Policy corenetwork.AddressSelectionPolicy
 }
 
 // Validate returns an error if config cannot drive a Worker.
@@ -147,6 +152,10 @@ func (c *APIAddressUpdater) getAddresses() ([]corenetwork.ProviderHostPorts, err
 		}
 	}
 
+	// Apply the model's address selection policy so that dual-homed
+	// machines dial the controller on the intended network.
+	hpsToSet = c.config.Policy.ApplyToHostPorts(hpsToSet)
+
 	// Logging to identify lp: 1888453
 	if len(hpsToSet) == 0 {
 		c.config.Logger.Warningf("get address returning zero results after filtering, non filtered list: %v", addresses)
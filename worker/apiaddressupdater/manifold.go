@@ -10,11 +10,13 @@ import (
 	"github.com/juju/worker/v3/dependency"
 
 	"github.com/juju/juju/agent"
+	apiagent "github.com/juju/juju/api/agent"
 	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/api/caasoperator"
 	"github.com/juju/juju/api/machiner"
 	"github.com/juju/juju/api/uniter"
 	"github.com/juju/juju/cmd/jujud/agent/engine"
+	corenetwork "github.com/juju/juju/core/network"
 )
 
 // Logger represents the methods used for logging messages.
@@ -50,6 +52,7 @@ func (config ManifoldConfig) newWorker(a agent.Agent, apiCaller base.APICaller)
 
 	// TODO(fwereade): use appropriate facade!
 	var facade APIAddresser
+	var policy corenetwork.AddressSelectionPolicy
 	switch apiTag := tag.(type) {
 	case names.UnitTag:
 		facade = uniter.NewState(apiCaller, apiTag)
@@ -61,11 +64,29 @@ func (config ManifoldConfig) newWorker(a agent.Agent, apiCaller base.APICaller)
 		return nil, errors.Errorf("expected a unit or machine tag; got %q", tag)
 	}
 
+	// Machine and unit agents apply the model's address selection
+	// policy when recording API addresses. The policy is read once at
+	// worker start, so changes take effect the next time the worker
+	// is restarted.
+	switch tag.(type) {
+	case names.UnitTag, names.MachineTag:
+		agentFacade, err := apiagent.NewState(apiCaller)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		modelConfig, err := agentFacade.ModelConfig()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		policy = modelConfig.AddressSelectionPolicy()
+	}
+
 	setter := agent.APIHostPortsSetter{Agent: a}
 	w, err := NewAPIAddressUpdater(Config{
 		Addresser: facade,
 		Setter:    setter,
 		Logger:    config.Logger,
+		Policy:    policy,
 	})
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -57,3 +57,11 @@ type metricCollectorWrapper struct {
 func (o metricCollectorWrapper) APIRequestDuration() metricobserver.SummaryVec {
 	return o.collector.APIRequestDuration
 }
+
+func (o metricCollectorWrapper) APIRequestSize() metricobserver.SummaryVec {
+	return o.collector.APIRequestSize
+}
+
+func (o metricCollectorWrapper) APIReplySize() metricobserver.SummaryVec {
+	return o.collector.APIReplySize
+}
@@ -722,6 +722,7 @@ func (a *appWorker) alive(app caas.Application) error {
 		CharmModifiedVersion: provisionInfo.CharmModifiedVersion,
 		Trust:                provisionInfo.Trust,
 		InitialScale:         provisionInfo.Scale,
+		WorkloadPatch:        provisionInfo.WorkloadPatch,
 	}
 	reason := "unchanged"
 	// TODO(sidecar): implement Equals method for caas.ApplicationConfig
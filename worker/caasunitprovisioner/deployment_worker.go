@@ -263,11 +263,13 @@ func updateApplicationService(appTag names.ApplicationTag, svc *caas.Service, up
 	}
 	return updater.UpdateApplicationService(
 		params.UpdateApplicationServiceArg{
-			ApplicationTag: appTag.String(),
-			ProviderId:     svc.Id,
-			Addresses:      params.FromProviderAddresses(svc.Addresses...),
-			Scale:          svc.Scale,
-			Generation:     svc.Generation,
+			ApplicationTag:  appTag.String(),
+			ProviderId:      svc.Id,
+			Addresses:       params.FromProviderAddresses(svc.Addresses...),
+			ExternalNames:   svc.ExternalNames,
+			PortAnnotations: svc.PortAnnotations,
+			Scale:           svc.Scale,
+			Generation:      svc.Generation,
 		},
 	)
 }
@@ -0,0 +1,160 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package dnsconfigurer
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	"github.com/juju/worker/v3/catacomb"
+
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/utils/scriptrunner"
+)
+
+var logger = loggo.GetLogger("juju.worker.dnsconfigurer")
+
+// resolvedConfDir is the directory the systemd-resolved drop-in is
+// written to. It is a variable so tests can redirect it.
+var resolvedConfDir = "/etc/systemd/resolved.conf.d"
+
+const resolvedConfFile = "90-juju.conf"
+
+// DNSConfigurerFacade exposes the DNS configuration for the machine.
+type DNSConfigurerFacade interface {
+	DNSConfig() (servers, searchDomains []string, err error)
+	WatchForDNSConfigChanges() (watcher.NotifyWatcher, error)
+}
+
+// DNSConfigurerConfig holds the dependencies of a DNSConfigurer.
+type DNSConfigurerConfig struct {
+	Facade DNSConfigurerFacade
+}
+
+// DNSConfigurer keeps the machine's resolver configuration in step
+// with the model's DNS configuration.
+type DNSConfigurer struct {
+	catacomb catacomb.Catacomb
+	config   DNSConfigurerConfig
+	clock    clock.Clock
+}
+
+// processNewConfig applies the current DNS configuration to the machine.
+func (dc *DNSConfigurer) processNewConfig() error {
+	servers, searchDomains, err := dc.config.Facade.DNSConfig()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	target := filepath.Join(resolvedConfDir, resolvedConfFile)
+	if len(servers) == 0 && len(searchDomains) == 0 {
+		if _, err := os.Stat(target); os.IsNotExist(err) {
+			// No configuration and nothing to clean up; leave the
+			// provider's DNS configuration alone.
+			return nil
+		}
+		logger.Infof("removing juju DNS configuration %s", target)
+		if err := os.Remove(target); err != nil {
+			return errors.Trace(err)
+		}
+		return dc.reloadResolved()
+	}
+
+	content := fmt.Sprintf(
+		"# Managed by juju; do not edit.\n[Resolve]\nDNS=%s\nDomains=%s\n",
+		strings.Join(servers, " "),
+		strings.Join(searchDomains, " "),
+	)
+	current, err := ioutil.ReadFile(target)
+	if err == nil && string(current) == content {
+		logger.Debugf("DNS configuration %s already up to date", target)
+		return nil
+	}
+	logger.Infof("writing DNS configuration %s", target)
+	if err := os.MkdirAll(resolvedConfDir, 0755); err != nil {
+		return errors.Trace(err)
+	}
+	if err := ioutil.WriteFile(target, []byte(content), 0644); err != nil {
+		return errors.Trace(err)
+	}
+	return dc.reloadResolved()
+}
+
+func (dc *DNSConfigurer) reloadResolved() error {
+	result, err := scriptrunner.RunCommand(
+		"systemctl try-reload-or-restart systemd-resolved",
+		os.Environ(), dc.clock, 5000*time.Millisecond)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if result.Code != 0 {
+		// Not all machines run systemd-resolved; the drop-in is
+		// still in place for when it starts.
+		logger.Warningf("reloading systemd-resolved failed (%d): %s", result.Code, string(result.Stderr))
+	}
+	return nil
+}
+
+// NewDNSConfigurer returns a worker that applies the model's DNS
+// configuration and reapplies it whenever it changes.
+func NewDNSConfigurer(config DNSConfigurerConfig, clock clock.Clock) (*DNSConfigurer, error) {
+	dc := &DNSConfigurer{
+		config: config,
+		clock:  clock,
+	}
+	// Apply the configuration once up front so the machine is right
+	// before other networking workers report addresses.
+	if err := dc.processNewConfig(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Site: &dc.catacomb,
+		Work: dc.loop,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return dc, nil
+}
+
+func (dc *DNSConfigurer) loop() error {
+	configWatcher, err := dc.config.Facade.WatchForDNSConfigChanges()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := dc.catacomb.Add(configWatcher); err != nil {
+		return errors.Trace(err)
+	}
+
+	for {
+		select {
+		case <-dc.catacomb.Dying():
+			return dc.catacomb.ErrDying()
+		case _, ok := <-configWatcher.Changes():
+			if !ok {
+				return errors.New("DNS configuration watcher closed")
+			}
+			if err = dc.processNewConfig(); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	}
+}
+
+// Kill implements Worker.Kill()
+func (dc *DNSConfigurer) Kill() {
+	dc.catacomb.Kill(nil)
+}
+
+// Wait implements Worker.Wait()
+func (dc *DNSConfigurer) Wait() error {
+	return dc.catacomb.Wait()
+}
@@ -0,0 +1,45 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package dnsconfigurer
+
+import (
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v3"
+	"github.com/juju/worker/v3/dependency"
+
+	"github.com/juju/juju/api/base"
+	apidnsconfigurer "github.com/juju/juju/api/dnsconfigurer"
+)
+
+// ManifoldConfig defines the names of the manifolds on which a
+// Manifold will depend.
+type ManifoldConfig struct {
+	// These are the dependency resource names.
+	APICallerName string
+	Clock         clock.Clock
+}
+
+// Manifold returns a dependency manifold that runs a DNS configurer
+// worker, using the resource names defined in the supplied config.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.APICallerName,
+		},
+		Start: func(context dependency.Context) (worker.Worker, error) {
+			var apiCaller base.APICaller
+			if err := context.Get(config.APICallerName, &apiCaller); err != nil {
+				return nil, errors.Trace(err)
+			}
+
+			facade := apidnsconfigurer.NewFacade(apiCaller)
+
+			w, err := NewDNSConfigurer(DNSConfigurerConfig{
+				Facade: facade,
+			}, config.Clock)
+			return w, errors.Annotate(err, "creating dnsconfigurer worker")
+		},
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package docstoremigrator
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/worker/v3"
+	"github.com/juju/worker/v3/dependency"
+
+	"github.com/juju/juju/state/docstore"
+	workerstate "github.com/juju/juju/worker/state"
+)
+
+// ManifoldConfig holds the information necessary to run a document
+// store migrator in a dependency.Engine.
+type ManifoldConfig struct {
+	StateName string
+
+	Logger         Logger
+	NewWorker      func(Config) (worker.Worker, error)
+	NewTargetStore func() (docstore.Store, error)
+}
+
+// Validate is called by start to check for bad configuration.
+func (config ManifoldConfig) Validate() error {
+	if config.StateName == "" {
+		return errors.NotValidf("empty StateName")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	if config.NewWorker == nil {
+		return errors.NotValidf("nil NewWorker")
+	}
+	if config.NewTargetStore == nil {
+		return errors.NotValidf("nil NewTargetStore")
+	}
+	return nil
+}
+
+// Manifold returns a dependency.Manifold that will run a document
+// store migrator worker. The worker uninstalls itself when the
+// controller is not configured for a non-mongo storage backend, or
+// once the migration has been completed.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.StateName,
+		},
+		Start:  config.start,
+		Filter: filterErr,
+	}
+}
+
+func filterErr(err error) error {
+	if errors.Cause(err) == ErrMigrated {
+		return dependency.ErrUninstall
+	}
+	return err
+}
+
+// start is a method on ManifoldConfig because it's more readable than a closure.
+func (config ManifoldConfig) start(context dependency.Context) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var stTracker workerstate.StateTracker
+	if err := context.Get(config.StateName, &stTracker); err != nil {
+		return nil, errors.Trace(err)
+	}
+	statePool, err := stTracker.Use()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	st := statePool.SystemState()
+
+	controllerConfig, err := st.ControllerConfig()
+	if err != nil {
+		_ = stTracker.Done()
+		return nil, errors.Trace(err)
+	}
+	if controllerConfig.StateStorageBackend() != docstore.DqliteBackend {
+		// Documents already live where they are read from;
+		// there is nothing to migrate.
+		_ = stTracker.Done()
+		return nil, dependency.ErrUninstall
+	}
+
+	target, err := config.NewTargetStore()
+	if err != nil {
+		_ = stTracker.Done()
+		return nil, errors.Trace(err)
+	}
+	w, err := config.NewWorker(Config{
+		// The source is explicitly the mongo store: once the backend
+		// is switched, st.DocStore() already serves the target.
+		Source:      st.MongoDocStore(),
+		Target:      target,
+		Collections: docstore.ManagedCollections(),
+		Logger:      config.Logger,
+	})
+	if err != nil {
+		_ = stTracker.Done()
+		return nil, errors.Trace(err)
+	}
+	go func() {
+		_ = w.Wait()
+		_ = stTracker.Done()
+	}()
+	return w, nil
+}
+
+// NewTargetStore returns the SQL document store documents are
+// migrated into. It is the shared store the rest of the system reads
+// once the backend is switched, opened through whatever SQL opener
+// the binary registered; on a controller without one the open fails
+// here rather than migrating somewhere nothing will read.
+func NewTargetStore() (docstore.Store, error) {
+	return docstore.SQLStore()
+}
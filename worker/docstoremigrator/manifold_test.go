@@ -0,0 +1,84 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package docstoremigrator_test
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v3"
+	"github.com/juju/worker/v3/dependency"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/state/docstore"
+	"github.com/juju/juju/worker/docstoremigrator"
+)
+
+type ManifoldSuite struct {
+	testing.IsolationSuite
+	config docstoremigrator.ManifoldConfig
+}
+
+var _ = gc.Suite(&ManifoldSuite{})
+
+func (s *ManifoldSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.config = s.validConfig()
+}
+
+func (s *ManifoldSuite) validConfig() docstoremigrator.ManifoldConfig {
+	return docstoremigrator.ManifoldConfig{
+		StateName: "state",
+		Logger:    loggo.GetLogger("test"),
+		NewWorker: func(config docstoremigrator.Config) (worker.Worker, error) {
+			return nil, errors.NotImplementedf("NewWorker")
+		},
+		NewTargetStore: func() (docstore.Store, error) {
+			return nil, errors.NotImplementedf("NewTargetStore")
+		},
+	}
+}
+
+func (s *ManifoldSuite) TestValid(c *gc.C) {
+	c.Check(s.config.Validate(), jc.ErrorIsNil)
+}
+
+func (s *ManifoldSuite) TestMissingStateName(c *gc.C) {
+	s.config.StateName = ""
+	s.checkNotValid(c, "empty StateName not valid")
+}
+
+func (s *ManifoldSuite) TestMissingLogger(c *gc.C) {
+	s.config.Logger = nil
+	s.checkNotValid(c, "nil Logger not valid")
+}
+
+func (s *ManifoldSuite) TestMissingNewWorker(c *gc.C) {
+	s.config.NewWorker = nil
+	s.checkNotValid(c, "nil NewWorker not valid")
+}
+
+func (s *ManifoldSuite) TestMissingNewTargetStore(c *gc.C) {
+	s.config.NewTargetStore = nil
+	s.checkNotValid(c, "nil NewTargetStore not valid")
+}
+
+func (s *ManifoldSuite) checkNotValid(c *gc.C, expect string) {
+	err := s.config.Validate()
+	c.Check(err, gc.ErrorMatches, expect)
+	c.Check(err, jc.Satisfies, errors.IsNotValid)
+}
+
+func (s *ManifoldSuite) TestInputs(c *gc.C) {
+	manifold := docstoremigrator.Manifold(s.config)
+	c.Check(manifold.Inputs, jc.DeepEquals, []string{"state"})
+}
+
+func (s *ManifoldSuite) TestFilterMigrated(c *gc.C) {
+	manifold := docstoremigrator.Manifold(s.config)
+	c.Check(manifold.Filter(docstoremigrator.ErrMigrated), gc.Equals, dependency.ErrUninstall)
+	other := errors.New("boom")
+	c.Check(manifold.Filter(other), gc.Equals, other)
+}
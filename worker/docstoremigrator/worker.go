@@ -0,0 +1,79 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package docstoremigrator
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/worker/v3"
+
+	"github.com/juju/juju/state/docstore"
+	jujuworker "github.com/juju/juju/worker"
+)
+
+// ErrMigrated is reported by the worker once it has copied every
+// configured collection, so the manifold can uninstall it instead of
+// running the migration again.
+var ErrMigrated = errors.New("document store migration complete")
+
+// Logger represents the methods used by the worker to log details.
+type Logger interface {
+	Infof(string, ...interface{})
+	Debugf(string, ...interface{})
+}
+
+// Config holds the configuration and dependencies for a worker.
+type Config struct {
+	// Source is the store documents are copied from.
+	Source docstore.Store
+
+	// Target is the store documents are copied to.
+	Target docstore.Store
+
+	// Collections names the collections to migrate.
+	Collections []string
+
+	Logger Logger
+}
+
+// Validate returns an error if the config cannot be expected
+// to drive a functional worker.
+func (config Config) Validate() error {
+	if config.Source == nil {
+		return errors.NotValidf("nil Source")
+	}
+	if config.Target == nil {
+		return errors.NotValidf("nil Target")
+	}
+	if len(config.Collections) == 0 {
+		return errors.NotValidf("empty Collections")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	return nil
+}
+
+// NewWorker returns a worker that copies the configured collections
+// from the source store to the target store and then exits with
+// ErrMigrated. The copy is idempotent, so the worker can safely be
+// restarted if it is killed part way through.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return jujuworker.NewSimpleWorker(func(stopCh <-chan struct{}) error {
+		select {
+		case <-stopCh:
+			return nil
+		default:
+		}
+		config.Logger.Debugf("migrating collections %v", config.Collections)
+		migrated, err := docstore.Migrate(config.Source, config.Target, config.Collections)
+		if err != nil {
+			return errors.Annotate(err, "migrating document collections")
+		}
+		config.Logger.Infof("migrated %d documents in %d collections", migrated, len(config.Collections))
+		return ErrMigrated
+	}), nil
+}
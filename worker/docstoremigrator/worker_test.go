@@ -0,0 +1,131 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package docstoremigrator_test
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v3/workertest"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/worker/docstoremigrator"
+)
+
+type WorkerSuite struct{}
+
+var _ = gc.Suite(&WorkerSuite{})
+
+func (s *WorkerSuite) TestValidate(c *gc.C) {
+	config := validConfig()
+	config.Source = nil
+	_, err := docstoremigrator.NewWorker(config)
+	c.Check(err, gc.ErrorMatches, "nil Source not valid")
+
+	config = validConfig()
+	config.Target = nil
+	_, err = docstoremigrator.NewWorker(config)
+	c.Check(err, gc.ErrorMatches, "nil Target not valid")
+
+	config = validConfig()
+	config.Collections = nil
+	_, err = docstoremigrator.NewWorker(config)
+	c.Check(err, gc.ErrorMatches, "empty Collections not valid")
+
+	config = validConfig()
+	config.Logger = nil
+	_, err = docstoremigrator.NewWorker(config)
+	c.Check(err, gc.ErrorMatches, "nil Logger not valid")
+}
+
+func (s *WorkerSuite) TestMigrates(c *gc.C) {
+	source := newMemStore()
+	c.Assert(source.Insert("secrets", "a", []byte("secret-a")), jc.ErrorIsNil)
+	c.Assert(source.Insert("firewallRules", "ssh", []byte("0.0.0.0/0")), jc.ErrorIsNil)
+	target := newMemStore()
+
+	config := validConfig()
+	config.Source = source
+	config.Target = target
+	w, err := docstoremigrator.NewWorker(config)
+	c.Assert(err, jc.ErrorIsNil)
+	err = workertest.CheckKilled(c, w)
+	c.Assert(errors.Cause(err), gc.Equals, docstoremigrator.ErrMigrated)
+
+	data, err := target.Get("secrets", "a")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(data), gc.Equals, "secret-a")
+	data, err = target.Get("firewallRules", "ssh")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(data), gc.Equals, "0.0.0.0/0")
+}
+
+func validConfig() docstoremigrator.Config {
+	return docstoremigrator.Config{
+		Source:      newMemStore(),
+		Target:      newMemStore(),
+		Collections: []string{"secrets", "firewallRules"},
+		Logger:      loggo.GetLogger("test"),
+	}
+}
+
+// memStore is a simple in-memory docstore.Store.
+type memStore struct {
+	collections map[string]map[string][]byte
+}
+
+func newMemStore() *memStore {
+	return &memStore{collections: make(map[string]map[string][]byte)}
+}
+
+func (s *memStore) collection(name string) map[string][]byte {
+	if s.collections[name] == nil {
+		s.collections[name] = make(map[string][]byte)
+	}
+	return s.collections[name]
+}
+
+func (s *memStore) Insert(collection, id string, data []byte) error {
+	docs := s.collection(collection)
+	if _, ok := docs[id]; ok {
+		return errors.AlreadyExistsf("document %q", id)
+	}
+	docs[id] = data
+	return nil
+}
+
+func (s *memStore) Get(collection, id string) ([]byte, error) {
+	data, ok := s.collection(collection)[id]
+	if !ok {
+		return nil, errors.NotFoundf("document %q", id)
+	}
+	return data, nil
+}
+
+func (s *memStore) Update(collection, id string, data []byte) error {
+	docs := s.collection(collection)
+	if _, ok := docs[id]; !ok {
+		return errors.NotFoundf("document %q", id)
+	}
+	docs[id] = data
+	return nil
+}
+
+func (s *memStore) Remove(collection, id string) error {
+	docs := s.collection(collection)
+	if _, ok := docs[id]; !ok {
+		return errors.NotFoundf("document %q", id)
+	}
+	delete(docs, id)
+	return nil
+}
+
+func (s *memStore) All(collection string) (map[string][]byte, error) {
+	docs := s.collection(collection)
+	result := make(map[string][]byte, len(docs))
+	for id, data := range docs {
+		result[id] = data
+	}
+	return result, nil
+}
@@ -50,6 +50,7 @@ type FirewallerAPI interface {
 	MacaroonForRelation(relationKey string) (*macaroon.Macaroon, error)
 	SetRelationStatus(relationKey string, status relation.Status, message string) error
 	FirewallRules(applicationNames ...string) ([]params.FirewallRule, error)
+	WatchFirewallRules() (watcher.StringsWatcher, error)
 	AllSpaceInfos() (network.SpaceInfos, error)
 	WatchSubnets() (watcher.StringsWatcher, error)
 }
@@ -147,6 +148,7 @@ type Firewaller struct {
 	machinesWatcher      watcher.StringsWatcher
 	portsWatcher         watcher.StringsWatcher
 	subnetWatcher        watcher.StringsWatcher
+	firewallRulesWatcher watcher.StringsWatcher
 	machineds            map[names.MachineTag]*machineData
 	unitsChange          chan *unitsChange
 	unitds               map[names.UnitTag]*unitData
@@ -270,6 +272,16 @@ func (fw *Firewaller) setUp() error {
 		return errors.Trace(err)
 	}
 
+	fw.firewallRulesWatcher, err = fw.firewallerApi.WatchFirewallRules()
+	if err != nil && !errors.IsNotImplemented(err) {
+		return errors.Annotatef(err, "failed to start firewall rules watcher")
+	}
+	if fw.firewallRulesWatcher != nil {
+		if err := fw.catacomb.Add(fw.firewallRulesWatcher); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
 	if fw.spaceInfos, err = fw.firewallerApi.AllSpaceInfos(); err != nil {
 		return errors.Trace(err)
 	}
@@ -284,6 +296,10 @@ func (fw *Firewaller) loop() error {
 	}
 	var reconciled bool
 	portsChange := fw.portsWatcher.Changes()
+	var firewallRulesChange watcher.StringsChannel
+	if fw.firewallRulesWatcher != nil {
+		firewallRulesChange = fw.firewallRulesWatcher.Changes()
+	}
 	for {
 		select {
 		case <-fw.catacomb.Dying():
@@ -336,6 +352,14 @@ func (fw *Firewaller) loop() error {
 			if err := fw.subnetsChanged(); err != nil {
 				return errors.Trace(err)
 			}
+		case _, ok := <-firewallRulesChange:
+			if !ok {
+				return errors.New("firewall rules watcher closed")
+			}
+
+			if err := fw.firewallRulesChanged(); err != nil {
+				return errors.Trace(err)
+			}
 		case change := <-fw.localRelationsChange:
 			// We have a notification that the remote (consuming) model
 			// has changed egress networks so need to update the local
@@ -711,6 +735,18 @@ func (fw *Firewaller) unitsChanged(change *unitsChange) error {
 }
 
 // openedPortsChanged handles port change notifications
+// firewallRulesChanged re-evaluates the ingress rules for all known
+// machines so that enabling or disabling a firewall rule takes effect
+// without waiting for another port or exposure change.
+func (fw *Firewaller) firewallRulesChanged() error {
+	for _, machined := range fw.machineds {
+		if err := fw.flushMachine(machined); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
 func (fw *Firewaller) openedPortsChanged(machineTag names.MachineTag) (err error) {
 	defer func() {
 		if params.IsCodeNotFound(err) {
@@ -176,6 +176,18 @@ func (mr *MockMachineMockRecorder) SetInstanceStatus(arg0, arg1, arg2 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInstanceStatus", reflect.TypeOf((*MockMachine)(nil).SetInstanceStatus), arg0, arg1, arg2)
 }
 
+// SetHardwareCharacteristics mocks base method
+func (m *MockMachine) SetHardwareCharacteristics(arg0 instance.HardwareCharacteristics) error {
+	ret := m.ctrl.Call(m, "SetHardwareCharacteristics", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHardwareCharacteristics indicates an expected call of SetHardwareCharacteristics
+func (mr *MockMachineMockRecorder) SetHardwareCharacteristics(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHardwareCharacteristics", reflect.TypeOf((*MockMachine)(nil).SetHardwareCharacteristics), arg0)
+}
+
 // SetProviderNetworkConfig mocks base method
 func (m *MockMachine) SetProviderNetworkConfig(arg0 network.InterfaceInfos) (network.ProviderAddresses, bool, error) {
 	ret := m.ctrl.Call(m, "SetProviderNetworkConfig", arg0)
@@ -45,6 +45,18 @@ var (
 	LongPoll         = 15 * time.Minute
 )
 
+// LongPollBackoff and LongPollCap control how the poll interval for a
+// machine in the long poll group grows as it remains stable. Each time
+// a stable machine is polled its interval is multiplied by
+// LongPollBackoff up to a maximum of LongPollCap; any change to the
+// machine moves it back to the short poll group which resets the
+// backoff. This keeps the provider API load proportional to the rate
+// of change of the model rather than its size.
+var (
+	LongPollBackoff = 2.0
+	LongPollCap     = 6 * time.Hour
+)
+
 // Environ specifies the provider-specific methods needed by the instance
 // poller.
 type Environ interface {
@@ -58,6 +70,7 @@ type Machine interface {
 	Id() string
 	InstanceId() (instance.Id, error)
 	SetProviderNetworkConfig(network.InterfaceInfos) (network.ProviderAddresses, bool, error)
+	SetHardwareCharacteristics(instance.HardwareCharacteristics) error
 	InstanceStatus() (params.StatusResult, error)
 	SetInstanceStatus(status.Status, string, map[string]interface{}) error
 	String() string
@@ -120,6 +133,9 @@ type pollGroupEntry struct {
 
 	shortPollInterval time.Duration
 	shortPollAt       time.Time
+
+	longPollInterval time.Duration
+	longPollAt       time.Time
 }
 
 func (e *pollGroupEntry) resetShortPollInterval(clk clock.Clock) {
@@ -135,6 +151,19 @@ func (e *pollGroupEntry) bumpShortPollInterval(clk clock.Clock) {
 	e.shortPollAt = clk.Now().Add(e.shortPollInterval)
 }
 
+func (e *pollGroupEntry) resetLongPollInterval(clk clock.Clock) {
+	e.longPollInterval = LongPoll
+	e.longPollAt = clk.Now().Add(e.longPollInterval)
+}
+
+func (e *pollGroupEntry) bumpLongPollInterval(clk clock.Clock) {
+	e.longPollInterval = time.Duration(float64(e.longPollInterval) * LongPollBackoff)
+	if e.longPollInterval > LongPollCap {
+		e.longPollInterval = LongPollCap
+	}
+	e.longPollAt = clk.Now().Add(e.longPollInterval)
+}
+
 type updaterWorker struct {
 	config   Config
 	catacomb catacomb.Catacomb
@@ -185,14 +214,31 @@ func (u *updaterWorker) Wait() error {
 }
 
 func (u *updaterWorker) loop() error {
-	watcher, err := u.config.Facade.WatchModelMachines()
+	machinesWatcher, err := u.config.Facade.WatchModelMachines()
 	if err != nil {
 		return errors.Trace(err)
 	}
-	if err := u.catacomb.Add(watcher); err != nil {
+	if err := u.catacomb.Add(machinesWatcher); err != nil {
 		return errors.Trace(err)
 	}
 
+	// If the provider can push notifications about instance changes,
+	// subscribe so that changed instances are promoted to the short
+	// poll group immediately instead of waiting for the next long poll.
+	var instanceChanges watcher.StringsChannel
+	if notifier, ok := u.config.Environ.(environs.InstanceNotifier); ok {
+		instanceWatcher, err := notifier.WatchInstances(u.callContextFunc(stdcontext.Background()))
+		if err != nil && !errors.IsNotSupported(err) {
+			return errors.Trace(err)
+		} else if err == nil {
+			if err := u.catacomb.Add(instanceWatcher); err != nil {
+				return errors.Trace(err)
+			}
+			instanceChanges = instanceWatcher.Changes()
+			u.config.Logger.Debugf("provider supports instance change notifications")
+		}
+	}
+
 	shortPollTimer := u.config.Clock.NewTimer(ShortPoll)
 	longPollTimer := u.config.Clock.NewTimer(LongPoll)
 	defer func() {
@@ -204,7 +250,20 @@ func (u *updaterWorker) loop() error {
 		select {
 		case <-u.catacomb.Dying():
 			return u.catacomb.ErrDying()
-		case ids, ok := <-watcher.Changes():
+		case ids, ok := <-instanceChanges:
+			if !ok {
+				return errors.New("instance watcher closed")
+			}
+
+			for _, id := range ids {
+				entry, found := u.instanceIDToGroupEntry[instance.Id(id)]
+				if !found {
+					continue
+				}
+				u.config.Logger.Debugf("provider reported a change for instance ID %q; moving machine %q to short poll group", entry.instanceID, entry.m)
+				u.moveEntryToPollGroup(shortPollGroup, entry)
+			}
+		case ids, ok := <-machinesWatcher.Changes():
 			if !ok {
 				return errors.New("machines watcher closed")
 			}
@@ -310,13 +369,19 @@ func (u *updaterWorker) appendToShortPollGroup(tag names.MachineTag, m Machine)
 }
 
 func (u *updaterWorker) moveEntryToPollGroup(toGroup pollGroupType, entry *pollGroupEntry) {
+	_, alreadyInGroup := u.pollGroup[toGroup][entry.tag]
+
 	// Ensure that the entry is not present in the other group
 	delete(u.pollGroup[1-toGroup], entry.tag)
 	u.pollGroup[toGroup][entry.tag] = entry
 
-	// If moving to the short poll group reset the poll interval
+	// If moving to the short poll group reset the poll interval. The
+	// long poll interval is only reset when the entry enters the group;
+	// stable machines that remain in it back off towards LongPollCap.
 	if toGroup == shortPollGroup {
 		entry.resetShortPollInterval(u.config.Clock)
+	} else if !alreadyInGroup {
+		entry.resetLongPollInterval(u.config.Clock)
 	}
 }
 
@@ -336,6 +401,8 @@ func (u *updaterWorker) pollGroupMembers(groupType pollGroupType) error {
 	for _, entry := range u.pollGroup[groupType] {
 		if groupType == shortPollGroup && now.Before(entry.shortPollAt) {
 			continue // we shouldn't poll this entry yet
+		} else if groupType == longPollGroup && now.Before(entry.longPollAt) {
+			continue // the machine is stable; poll it less frequently
 		}
 
 		if err := u.resolveInstanceID(entry); err != nil {
@@ -349,6 +416,13 @@ func (u *updaterWorker) pollGroupMembers(groupType pollGroupType) error {
 			return errors.Trace(err)
 		}
 
+		// Back off the next poll for stable machines; any observed
+		// change moves the machine to the short poll group which
+		// resets the backoff.
+		if groupType == longPollGroup {
+			entry.bumpLongPollInterval(u.config.Clock)
+		}
+
 		instList = append(instList, entry.instanceID)
 	}
 
@@ -387,6 +461,15 @@ func (u *updaterWorker) pollGroupMembers(groupType pollGroupType) error {
 			return errors.Trace(err)
 		}
 
+		// Refresh the recorded hardware characteristics of stable
+		// machines so post-provisioning changes (e.g. a resize or
+		// recommission) eventually become visible. Restricting this
+		// to the long poll group keeps the extra provider calls off
+		// the short poll cycle.
+		if groupType == longPollGroup {
+			u.maybeRefreshHardware(entry, info)
+		}
+
 		machineStatus, err := entry.m.Status()
 		if err != nil {
 			return errors.Trace(err)
@@ -398,6 +481,27 @@ func (u *updaterWorker) pollGroupMembers(groupType pollGroupType) error {
 	return nil
 }
 
+// maybeRefreshHardware updates the machine's recorded hardware
+// characteristics from the provider, for providers whose instances can
+// report hardware after provisioning. Failures are logged and retried
+// on the next poll.
+func (u *updaterWorker) maybeRefreshHardware(entry *pollGroupEntry, info instances.Instance) {
+	hw, ok := info.(instances.InstanceHardware)
+	if !ok {
+		return
+	}
+	hc, err := hw.HardwareCharacteristics(u.callContextFunc(stdcontext.Background()))
+	if err != nil {
+		u.config.Logger.Warningf("cannot get hardware characteristics for machine %q (instance ID %q): %v", entry.m, entry.instanceID, err)
+		return
+	} else if hc == nil {
+		return
+	}
+	if err := entry.m.SetHardwareCharacteristics(*hc); err != nil {
+		u.config.Logger.Warningf("cannot update hardware characteristics for machine %q (instance ID %q): %v", entry.m, entry.instanceID, err)
+	}
+}
+
 func (u *updaterWorker) resolveInstanceID(entry *pollGroupEntry) error {
 	if entry.instanceID != "" {
 		return nil // already resolved
@@ -25,6 +25,7 @@ import (
 	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/environs"
+	"github.com/juju/juju/environs/context"
 	"github.com/juju/juju/environs/instances"
 	coretesting "github.com/juju/juju/testing"
 	"github.com/juju/juju/worker/instancepoller/mocks"
@@ -136,6 +137,27 @@ func (s *pollGroupEntrySuite) TestShortPollIntervalLogic(c *gc.C) {
 	c.Assert(entry.shortPollAt, gc.Equals, clock.Now().Add(ShortPollCap))
 }
 
+func (s *pollGroupEntrySuite) TestLongPollIntervalLogic(c *gc.C) {
+	clock := testclock.NewClock(time.Now())
+	entry := new(pollGroupEntry)
+
+	// Test reset logic.
+	entry.resetLongPollInterval(clock)
+	c.Assert(entry.longPollInterval, gc.Equals, LongPoll)
+	c.Assert(entry.longPollAt, gc.Equals, clock.Now().Add(LongPoll))
+
+	// Ensure that bumping the long poll duration caps at LongPollCap.
+	for i := 0; entry.longPollInterval < LongPollCap && i < 100; i++ {
+		entry.bumpLongPollInterval(clock)
+	}
+	c.Assert(entry.longPollInterval, gc.Equals, LongPollCap, gc.Commentf("long poll interval did not reach long poll cap interval after 100 interval bumps"))
+
+	// Check that once we reach the long poll cap interval we stay capped at it.
+	entry.bumpLongPollInterval(clock)
+	c.Assert(entry.longPollInterval, gc.Equals, LongPollCap, gc.Commentf("long poll should have been capped at the long poll cap interval"))
+	c.Assert(entry.longPollAt, gc.Equals, clock.Now().Add(LongPollCap))
+}
+
 type workerSuite struct{}
 
 func (s *workerSuite) TestQueueingNewMachineAddsItToShortPollGroup(c *gc.C) {
@@ -570,6 +592,49 @@ func (s *workerSuite) TestLongPollNoMachineInGroupKnownByProvider(c *gc.C) {
 	})
 }
 
+func (s *workerSuite) TestProviderInstanceChangeMovesMachineToShortPollGroup(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	instChangeCh := make(chan []string)
+	instWatcher := mocks.NewMockStringsWatcher(ctrl)
+	instWatcher.EXPECT().Changes().DoAndReturn(func() watcher.StringsChannel {
+		return instChangeCh
+	}).AnyTimes()
+	instWatcher.EXPECT().Kill().AnyTimes()
+	instWatcher.EXPECT().Wait().AnyTimes()
+
+	w, _ := s.startWorkerWithEnviron(c, ctrl, func(base Environ) Environ {
+		return &notifyEnviron{Environ: base, watcher: instWatcher}
+	})
+	defer workertest.CleanKill(c, w)
+	updWorker := w.(*updaterWorker)
+
+	// Start with an entry for machine "0" in the long poll group.
+	machineTag := names.NewMachineTag("0")
+	machine := mocks.NewMockMachine(ctrl)
+	entry := &pollGroupEntry{
+		tag:        machineTag,
+		m:          machine,
+		instanceID: "b4dc0ffee",
+	}
+	updWorker.pollGroup[longPollGroup][machineTag] = entry
+	updWorker.instanceIDToGroupEntry[entry.instanceID] = entry
+
+	// A provider notification for the instance should move the machine
+	// to the short poll group without waiting for the next long poll.
+	s.assertWorkerCompletesLoop(c, updWorker, func() {
+		select {
+		case instChangeCh <- []string{"b4dc0ffee", "not-tracked"}:
+		case <-time.After(coretesting.ShortWait):
+			c.Fatal("timed out waiting for worker to pick up instance change")
+		}
+	})
+
+	c.Assert(updWorker.pollGroup[shortPollGroup], gc.HasLen, 1, gc.Commentf("machine didn't end up in short poll group"))
+	c.Assert(updWorker.pollGroup[longPollGroup], gc.HasLen, 0)
+}
+
 func (s *workerSuite) assertWorkerCompletesLoop(c *gc.C, w *updaterWorker, triggerFn func()) {
 	s.assertWorkerCompletesLoops(c, w, 1, triggerFn)
 }
@@ -597,6 +662,13 @@ type workerMocks struct {
 }
 
 func (s *workerSuite) startWorker(c *gc.C, ctrl *gomock.Controller) (worker.Worker, workerMocks) {
+	return s.startWorkerWithEnviron(c, ctrl, nil)
+}
+
+// startWorkerWithEnviron starts a worker whose environ is the mock environ
+// optionally wrapped by wrapEnviron, allowing tests to layer extra optional
+// interfaces (e.g. environs.InstanceNotifier) on top of the mock.
+func (s *workerSuite) startWorkerWithEnviron(c *gc.C, ctrl *gomock.Controller, wrapEnviron func(Environ) Environ) (worker.Worker, workerMocks) {
 	workerMainLoopEnteredCh := make(chan struct{}, 1)
 	mocked := workerMocks{
 		clock:     testclock.NewClock(time.Now()),
@@ -604,10 +676,15 @@ func (s *workerSuite) startWorker(c *gc.C, ctrl *gomock.Controller) (worker.Work
 		environ:   mocks.NewMockEnviron(ctrl),
 	}
 
+	environ := Environ(mocked.environ)
+	if wrapEnviron != nil {
+		environ = wrapEnviron(environ)
+	}
+
 	w, err := NewWorker(Config{
 		Clock:         mocked.clock,
 		Facade:        mocked.facadeAPI,
-		Environ:       mocked.environ,
+		Environ:       environ,
 		CredentialAPI: mocks.NewMockCredentialAPI(ctrl),
 		Logger:        loggo.GetLogger("juju.worker.instancepoller"),
 	})
@@ -669,3 +746,15 @@ func (api *mockFacadeAPI) Machine(tag names.MachineTag) (Machine, error) {
 	}
 	return nil, errors.NotFoundf(tag.String())
 }
+
+// notifyEnviron layers an environs.InstanceNotifier implementation on top
+// of a worker Environ so tests can feed provider instance change events to
+// the worker.
+type notifyEnviron struct {
+	Environ
+	watcher watcher.StringsWatcher
+}
+
+func (e *notifyEnviron) WatchInstances(_ context.ProviderCallContext) (watcher.StringsWatcher, error) {
+	return e.watcher, nil
+}
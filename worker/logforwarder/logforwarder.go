@@ -70,6 +70,11 @@ type OpenLogForwarderArgs struct {
 	// Name is the name given to the log sink.
 	Name string
 
+	// SinkConfig is the function used to extract the sink's config
+	// from the model's log forwarding config. If not set, the syslog
+	// config is used.
+	SinkConfig SinkConfigFn
+
 	// OpenSink is the function that opens the underlying log sink that
 	// will be wrapped.
 	OpenSink LogSinkFn
@@ -96,12 +101,12 @@ func (lf *LogForwarder) processNewConfig(currentSender SendCloser) (SendCloser,
 	}
 
 	// Get the new config and set up log forwarding if enabled.
-	cfg, ok, err := lf.args.LogForwardConfig.LogForwardConfig()
+	cfg, enabled, err := lf.args.SinkConfig(lf.args.LogForwardConfig)
 	if err != nil {
 		_ = closeExisting()
 		return nil, errors.Trace(err)
 	}
-	if !ok || !cfg.Enabled {
+	if !enabled {
 		lf.args.Logger.Infof("config change - log forwarding not enabled")
 		return nil, closeExisting()
 	}
@@ -150,7 +155,7 @@ func (lf *LogForwarder) waitForEnabled() (bool, error) {
 	defer lf.mu.Unlock()
 
 	if !lf.enabled && enabled {
-		lf.args.Logger.Infof("log forward enabled, starting to stream logs to syslog sink")
+		lf.args.Logger.Infof("log forward enabled, starting to stream logs to %s sink", lf.args.Name)
 	}
 	lf.enabled = enabled
 	return enabled, nil
@@ -159,6 +164,15 @@ func (lf *LogForwarder) waitForEnabled() (bool, error) {
 // NewLogForwarder returns a worker that forwards logs received from
 // the stream to the sender.
 func NewLogForwarder(args OpenLogForwarderArgs) (*LogForwarder, error) {
+	if args.SinkConfig == nil {
+		args.SinkConfig = func(c LogForwardConfig) (SinkConfig, bool, error) {
+			cfg, ok, err := c.LogForwardConfig()
+			if err != nil || !ok {
+				return nil, false, errors.Trace(err)
+			}
+			return cfg, cfg.Enabled, nil
+		}
+	}
 	lf := &LogForwarder{
 		args:      args,
 		enabledCh: make(chan bool, 1),
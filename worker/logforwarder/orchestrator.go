@@ -10,7 +10,7 @@ import (
 )
 
 type orchestrator struct {
-	*LogForwarder // For now its just a single forwarder.
+	forwarders []*LogForwarder
 }
 
 // OrchestratorArgs holds the info needed to open a log forwarding
@@ -40,22 +40,47 @@ type OrchestratorArgs struct {
 }
 
 func newOrchestratorForController(args OrchestratorArgs) (*orchestrator, error) {
-	// For now we work with only 1 forwarder. Later we can have a proper
-	// orchestrator that spawns a sub-worker for each log sink.
 	if len(args.Sinks) == 0 {
 		return nil, nil
 	}
-	if len(args.Sinks) > 1 {
-		return nil, errors.Errorf("multiple log forwarding targets not supported (yet)")
+	// Each sink gets its own forwarder, streaming and tracking records
+	// independently of the other sinks.
+	orc := &orchestrator{}
+	for _, sink := range args.Sinks {
+		lf, err := args.OpenLogForwarder(OpenLogForwarderArgs{
+			ControllerUUID:   args.ControllerUUID,
+			LogForwardConfig: args.LogForwardConfig,
+			Caller:           args.Caller,
+			Name:             sink.Name,
+			SinkConfig:       sink.ConfigFn,
+			OpenSink:         sink.OpenFn,
+			OpenLogStream:    args.OpenLogStream,
+			Logger:           args.Logger,
+		})
+		if err != nil {
+			orc.Kill()
+			_ = orc.Wait()
+			return nil, errors.Annotatef(err, "opening %q log forwarder", sink.Name)
+		}
+		orc.forwarders = append(orc.forwarders, lf)
 	}
-	lf, err := args.OpenLogForwarder(OpenLogForwarderArgs{
-		ControllerUUID:   args.ControllerUUID,
-		LogForwardConfig: args.LogForwardConfig,
-		Caller:           args.Caller,
-		Name:             args.Sinks[0].Name,
-		OpenSink:         args.Sinks[0].OpenFn,
-		OpenLogStream:    args.OpenLogStream,
-		Logger:           args.Logger,
-	})
-	return &orchestrator{lf}, errors.Annotate(err, "opening log forwarder")
+	return orc, nil
+}
+
+// Kill implements Worker.Kill()
+func (o *orchestrator) Kill() {
+	for _, lf := range o.forwarders {
+		lf.Kill()
+	}
+}
+
+// Wait implements Worker.Wait()
+func (o *orchestrator) Wait() error {
+	var firstErr error
+	for _, lf := range o.forwarders {
+		if err := lf.Wait(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
 }
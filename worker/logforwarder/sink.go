@@ -5,6 +5,7 @@ package logforwarder
 
 import (
 	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/logfwd/loki"
 	"github.com/juju/juju/logfwd/syslog"
 )
 
@@ -14,20 +15,36 @@ type LogForwardConfig interface {
 	// log forward configuration to change.
 	WatchForLogForwardConfigChanges() (watcher.NotifyWatcher, error)
 
-	// LogForwardConfig returns the current log forward configuration.
+	// LogForwardConfig returns the current syslog log forward configuration.
 	LogForwardConfig() (*syslog.RawConfig, bool, error)
+
+	// LogForwardLokiConfig returns the current Loki log forward configuration.
+	LogForwardLokiConfig() (*loki.RawConfig, bool, error)
+}
+
+// SinkConfig is the sink-specific portion of the log forwarding config.
+type SinkConfig interface {
+	// Validate ensures that the config is currently valid.
+	Validate() error
 }
 
+// SinkConfigFn returns a sink's config from the model's log forwarding
+// config, and whether forwarding to the sink is enabled.
+type SinkConfigFn func(LogForwardConfig) (SinkConfig, bool, error)
+
 type LogSinkSpec struct {
 	// Name is the name of the log sink.
 	Name string
 
+	// ConfigFn is a function that returns the sink's config.
+	ConfigFn SinkConfigFn
+
 	// OpenFn is a function that opens a log sink.
 	OpenFn LogSinkFn
 }
 
 // LogSinkFn is a function that opens a log sink.
-type LogSinkFn func(cfg *syslog.RawConfig) (*LogSink, error)
+type LogSinkFn func(cfg SinkConfig) (*LogSink, error)
 
 // LogSink is a single log sink, to which log records may be sent.
 type LogSink struct {
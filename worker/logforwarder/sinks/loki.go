@@ -0,0 +1,39 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sinks
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/logfwd/loki"
+	"github.com/juju/juju/worker/logforwarder"
+)
+
+// LokiConfig returns the Loki sink config from the model's log
+// forwarding config.
+func LokiConfig(fwdConfig logforwarder.LogForwardConfig) (logforwarder.SinkConfig, bool, error) {
+	cfg, ok, err := fwdConfig.LogForwardLokiConfig()
+	if err != nil || !ok {
+		return nil, false, errors.Trace(err)
+	}
+	return cfg, cfg.Enabled, nil
+}
+
+// OpenLoki returns a sink that pushes log messages to a Loki server.
+func OpenLoki(sinkCfg logforwarder.SinkConfig) (*logforwarder.LogSink, error) {
+	cfg, ok := sinkCfg.(*loki.RawConfig)
+	if !ok {
+		return nil, errors.NotValidf("config type %T", sinkCfg)
+	}
+	if !cfg.Enabled {
+		return nil, errors.New("log forwarding not enabled")
+	}
+	client, err := loki.Open(*cfg)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &logforwarder.LogSink{
+		SendCloser: client,
+	}, nil
+}
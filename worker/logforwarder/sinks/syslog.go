@@ -11,8 +11,22 @@ import (
 	"github.com/juju/juju/worker/logforwarder"
 )
 
+// SyslogConfig returns the syslog sink config from the model's log
+// forwarding config.
+func SyslogConfig(fwdConfig logforwarder.LogForwardConfig) (logforwarder.SinkConfig, bool, error) {
+	cfg, ok, err := fwdConfig.LogForwardConfig()
+	if err != nil || !ok {
+		return nil, false, errors.Trace(err)
+	}
+	return cfg, cfg.Enabled, nil
+}
+
 // OpenSyslog returns a sink used to receive log messages to be forwarded.
-func OpenSyslog(cfg *syslog.RawConfig) (*logforwarder.LogSink, error) {
+func OpenSyslog(sinkCfg logforwarder.SinkConfig) (*logforwarder.LogSink, error) {
+	cfg, ok := sinkCfg.(*syslog.RawConfig)
+	if !ok {
+		return nil, errors.NotValidf("config type %T", sinkCfg)
+	}
 	if !cfg.Enabled {
 		return nil, errors.New("log forwarding not enabled")
 	}
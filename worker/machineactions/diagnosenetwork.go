@@ -0,0 +1,99 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package machineactions
+
+import (
+	"context"
+	"net"
+	"time"
+)
+
+// defaultDiagnoseEndpoints are the egress endpoints every machine is
+// expected to be able to reach for charm and agent binary downloads.
+var defaultDiagnoseEndpoints = []string{
+	"api.charmhub.io:443",
+	"streams.canonical.com:443",
+}
+
+// defaultDiagnoseTimeout bounds each individual connectivity check.
+const defaultDiagnoseTimeout = 10 * time.Second
+
+// Patched out for testing.
+var (
+	lookupHost  = func(ctx context.Context, host string) ([]string, error) { return net.DefaultResolver.LookupHost(ctx, host) }
+	dialTimeout = net.DialTimeout
+)
+
+// handleDiagnoseNetworkAction runs connectivity checks from the machine
+// against the controller API addresses and the required egress endpoints,
+// returning a structured report suitable for ActionFinish.
+func handleDiagnoseNetworkAction(apiAddresses []string, params map[string]interface{}) (map[string]interface{}, error) {
+	timeout := defaultDiagnoseTimeout
+	// Due to serialization the timeout comes out as float64.
+	if t, _ := params["timeout"].(float64); t > 0 {
+		timeout = time.Duration(t)
+	}
+	endpoints := append([]string(nil), defaultDiagnoseEndpoints...)
+	if extra, ok := params["endpoints"].([]interface{}); ok {
+		for _, e := range extra {
+			if endpoint, ok := e.(string); ok {
+				endpoints = append(endpoints, endpoint)
+			}
+		}
+	}
+
+	var reachable, unreachable int
+	check := func(targets []string) []map[string]interface{} {
+		var checks []map[string]interface{}
+		for _, target := range targets {
+			result := checkEndpoint(target, timeout)
+			if ok, _ := result["reachable"].(bool); ok {
+				reachable++
+			} else {
+				unreachable++
+			}
+			checks = append(checks, result)
+		}
+		return checks
+	}
+
+	results := map[string]interface{}{}
+	if controllerChecks := check(apiAddresses); len(controllerChecks) > 0 {
+		results["controller"] = controllerChecks
+	}
+	results["endpoints"] = check(endpoints)
+	results["reachable"] = reachable
+	results["unreachable"] = unreachable
+	return results, nil
+}
+
+// checkEndpoint resolves and dials a single host:port endpoint.
+func checkEndpoint(endpoint string, timeout time.Duration) map[string]interface{} {
+	result := map[string]interface{}{
+		"endpoint":  endpoint,
+		"reachable": false,
+	}
+	host, _, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		host = endpoint
+	}
+	if net.ParseIP(host) == nil {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		addrs, err := lookupHost(ctx, host)
+		cancel()
+		if err != nil {
+			result["dns-error"] = err.Error()
+			return result
+		}
+		result["resolved"] = addrs
+	}
+	conn, err := dialTimeout("tcp", endpoint, timeout)
+	if err != nil {
+		result["error"] = err.Error()
+		return result
+	}
+	_ = conn.Close()
+	result["reachable"] = true
+	return result
+}
@@ -0,0 +1,10 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package machineactions
+
+var (
+	DefaultDiagnoseEndpoints = &defaultDiagnoseEndpoints
+	LookupHost               = &lookupHost
+	DialTimeout              = &dialTimeout
+)
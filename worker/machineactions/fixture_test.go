@@ -15,8 +15,8 @@ import (
 	"github.com/juju/juju/core/watcher"
 )
 
-func mockHandleAction(stub *testing.Stub) func(string, map[string]interface{}) (map[string]interface{}, error) {
-	return func(name string, params map[string]interface{}) (map[string]interface{}, error) {
+func mockHandleAction(stub *testing.Stub) func(string, map[string]interface{}, func(string)) (map[string]interface{}, error) {
+	return func(name string, params map[string]interface{}, sendMessage func(string)) (map[string]interface{}, error) {
 		stub.AddCall("HandleAction", name)
 		return nil, stub.NextErr()
 	}
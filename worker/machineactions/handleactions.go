@@ -6,6 +6,8 @@ package machineactions
 
 import (
 	"encoding/base64"
+	"fmt"
+	"io/ioutil"
 	"os"
 	"time"
 	"unicode/utf8"
@@ -20,9 +22,29 @@ import (
 // RunAsUser is the user that the machine juju-exec action is executed as.
 var RunAsUser = "ubuntu"
 
+// MaxArtifactSize limits the size of each file captured from a machine
+// after a juju-exec action completes. The contents are recorded with the
+// action results, so they need to fit comfortably within a single
+// document; larger files are truncated.
+var MaxArtifactSize = 1024 * 1024
+
 // HandleAction receives a name and a map of parameters for a given machine action.
 // It will handle that action in a specific way and return a results map suitable for ActionFinish.
-func HandleAction(name string, params map[string]interface{}) (results map[string]interface{}, err error) {
+// Progress messages are reported through sendMessage, which may be nil.
+func HandleAction(name string, params map[string]interface{}, sendMessage func(string)) (results map[string]interface{}, err error) {
+	return handleActionWithAPIAddresses(nil, name, params, sendMessage)
+}
+
+// NewHandleAction returns a HandleAction variant which also knows the
+// controller API addresses the agent uses, so the network diagnostics
+// action can report on controller reachability.
+func NewHandleAction(apiAddresses []string) func(string, map[string]interface{}, func(string)) (map[string]interface{}, error) {
+	return func(name string, params map[string]interface{}, sendMessage func(string)) (map[string]interface{}, error) {
+		return handleActionWithAPIAddresses(apiAddresses, name, params, sendMessage)
+	}
+}
+
+func handleActionWithAPIAddresses(apiAddresses []string, name string, params map[string]interface{}, sendMessage func(string)) (results map[string]interface{}, err error) {
 	spec, ok := actions.PredefinedActionsSpec[name]
 	if !ok {
 		return nil, errors.Errorf("unexpected action %s", name)
@@ -30,15 +52,21 @@ func HandleAction(name string, params map[string]interface{}) (results map[strin
 	if err := spec.ValidateParams(params); err != nil {
 		return nil, errors.Errorf("invalid action parameters")
 	}
+	if sendMessage == nil {
+		sendMessage = func(string) {}
+	}
 
-	if actions.IsJujuExecAction(name) {
-		return handleJujuExecAction(params)
-	} else {
+	switch {
+	case actions.IsJujuExecAction(name):
+		return handleJujuExecAction(params, sendMessage)
+	case actions.IsJujuDiagnoseNetworkAction(name):
+		return handleDiagnoseNetworkAction(apiAddresses, params)
+	default:
 		return nil, errors.Errorf("unexpected action %s", name)
 	}
 }
 
-func handleJujuExecAction(params map[string]interface{}) (results map[string]interface{}, err error) {
+func handleJujuExecAction(params map[string]interface{}, sendMessage func(string)) (results map[string]interface{}, err error) {
 	// The spec checks that the parameters are available so we don't need to check again here
 	command, _ := params["command"].(string)
 	logger.Tracef("juju run %q", command)
@@ -47,19 +75,63 @@ func handleJujuExecAction(params map[string]interface{}) (results map[string]int
 	// But due to serialization it comes out as float64
 	timeout, _ := params["timeout"].(float64)
 
+	// The exec package buffers the process output until it exits, so the
+	// output itself cannot be streamed incrementally; progress messages
+	// cover the lifecycle events around it instead.
 	res, err := runCommandWithTimeout(command, time.Duration(timeout), clock.WallClock)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 
+	outputLimit, _ := params["output-limit"].(float64)
+
 	actionResults := map[string]interface{}{}
 	actionResults["return-code"] = res.Code
-	storeOutput(actionResults, "stdout", res.Stdout)
-	storeOutput(actionResults, "stderr", res.Stderr)
+	if storeOutputLimited(actionResults, "stdout", res.Stdout, int(outputLimit)) {
+		sendMessage(fmt.Sprintf("stdout truncated to %d bytes", int(outputLimit)))
+	}
+	if storeOutputLimited(actionResults, "stderr", res.Stderr, int(outputLimit)) {
+		sendMessage(fmt.Sprintf("stderr truncated to %d bytes", int(outputLimit)))
+	}
+
+	if files, _ := params["capture-files"].([]interface{}); len(files) > 0 {
+		artifacts := make(map[string]interface{})
+		for _, f := range files {
+			path, _ := f.(string)
+			if path == "" {
+				continue
+			}
+			artifacts[path] = captureArtifact(path, sendMessage)
+		}
+		actionResults["artifacts"] = artifacts
+	}
 
 	return actionResults, nil
 }
 
+// captureArtifact reads the named file so its contents can be recorded
+// with the action results. Failure to read an artifact is reported in
+// the results for that file rather than failing the whole action.
+func captureArtifact(path string, sendMessage func(string)) map[string]interface{} {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		sendMessage(fmt.Sprintf("could not capture %s: %v", path, err))
+		return map[string]interface{}{"error": err.Error()}
+	}
+	artifact := map[string]interface{}{"size": len(content)}
+	if len(content) > MaxArtifactSize {
+		content = content[:MaxArtifactSize]
+		artifact["truncated"] = true
+	}
+	value, encoding := encodeBytes(content)
+	artifact["content"] = value
+	if encoding != "utf8" {
+		artifact["encoding"] = encoding
+	}
+	sendMessage(fmt.Sprintf("captured %s (%d bytes)", path, len(content)))
+	return artifact
+}
+
 func runCommandWithTimeout(command string, timeout time.Duration, clock clock.Clock) (*exec.ExecResponse, error) {
 	cmd := exec.RunParams{
 		Commands:    command,
@@ -96,10 +168,21 @@ func encodeBytes(input []byte) (value string, encoding string) {
 	return value, encoding
 }
 
-func storeOutput(values map[string]interface{}, key string, input []byte) {
+// storeOutputLimited records the output stream in the results, truncating
+// it to limit bytes when a positive limit is given. It reports whether the
+// output was truncated; truncation is also marked in the results so it is
+// visible to anyone inspecting them later.
+func storeOutputLimited(values map[string]interface{}, key string, input []byte, limit int) bool {
+	truncated := false
+	if limit > 0 && len(input) > limit {
+		input = input[:limit]
+		values[key+"-truncated"] = true
+		truncated = true
+	}
 	value, encoding := encodeBytes(input)
 	values[key] = value
 	if encoding != "utf8" {
 		values[key+"Encoding"] = encoding
 	}
+	return truncated
 }
@@ -5,7 +5,13 @@
 package machineactions_test
 
 import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/testing"
@@ -31,13 +37,13 @@ func (s *HandleSuite) SetUpTest(c *gc.C) {
 }
 
 func (s *HandleSuite) TestInvalidAction(c *gc.C) {
-	results, err := machineactions.HandleAction("invalid", nil)
+	results, err := machineactions.HandleAction("invalid", nil, nil)
 	c.Assert(err, gc.ErrorMatches, "unexpected action invalid")
 	c.Assert(results, gc.IsNil)
 }
 
 func (s *HandleSuite) TestValidActionInvalidParams(c *gc.C) {
-	results, err := machineactions.HandleAction(actions.JujuExecActionName, nil)
+	results, err := machineactions.HandleAction(actions.JujuExecActionName, nil, nil)
 	c.Assert(err, gc.ErrorMatches, "invalid action parameters")
 	c.Assert(results, gc.IsNil)
 }
@@ -48,7 +54,7 @@ func (s *HandleSuite) TestTimeoutRun(c *gc.C) {
 		"timeout": float64(1),
 	}
 
-	results, err := machineactions.HandleAction(actions.JujuExecActionName, params)
+	results, err := machineactions.HandleAction(actions.JujuExecActionName, params, nil)
 	c.Assert(errors.Cause(err), gc.Equals, exec.ErrCancelled)
 	c.Assert(results, gc.IsNil)
 }
@@ -59,22 +65,161 @@ func (s *HandleSuite) TestSuccessfulRun(c *gc.C) {
 		"timeout": float64(0),
 	}
 
-	results, err := machineactions.HandleAction(actions.JujuExecActionName, params)
+	results, err := machineactions.HandleAction(actions.JujuExecActionName, params, nil)
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(results["return-code"], gc.Equals, 0)
 	c.Assert(strings.TrimRight(results["stdout"].(string), "\r\n"), gc.Equals, "1")
 	c.Assert(results["stderr"], gc.Equals, "")
 }
 
+func (s *HandleSuite) TestOutputLimitedRun(c *gc.C) {
+	params := map[string]interface{}{
+		"command":      "echo 0123456789",
+		"timeout":      float64(0),
+		"output-limit": float64(4),
+	}
+
+	var messages []string
+	sendMessage := func(message string) {
+		messages = append(messages, message)
+	}
+
+	results, err := machineactions.HandleAction(actions.JujuExecActionName, params, sendMessage)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results["return-code"], gc.Equals, 0)
+	c.Assert(results["stdout"], gc.Equals, "0123")
+	c.Assert(results["stdout-truncated"], gc.Equals, true)
+	c.Assert(results["stderr"], gc.Equals, "")
+	c.Assert(results["stderr-truncated"], gc.IsNil)
+	c.Assert(messages, jc.DeepEquals, []string{"stdout truncated to 4 bytes"})
+}
+
+func (s *HandleSuite) TestCaptureFilesRun(c *gc.C) {
+	dir := c.MkDir()
+	path := filepath.Join(dir, "artifact.txt")
+	err := ioutil.WriteFile(path, []byte("captured content"), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+	missing := filepath.Join(dir, "missing.txt")
+
+	params := map[string]interface{}{
+		"command":       "true",
+		"timeout":       float64(0),
+		"capture-files": []interface{}{path, missing},
+	}
+
+	var messages []string
+	sendMessage := func(message string) {
+		messages = append(messages, message)
+	}
+
+	results, err := machineactions.HandleAction(actions.JujuExecActionName, params, sendMessage)
+	c.Assert(err, jc.ErrorIsNil)
+	artifacts := results["artifacts"].(map[string]interface{})
+	c.Assert(artifacts[path], jc.DeepEquals, map[string]interface{}{
+		"content": "captured content",
+		"size":    16,
+	})
+	missingResult := artifacts[missing].(map[string]interface{})
+	c.Assert(missingResult["error"], gc.Matches, ".*no such file or directory")
+	c.Assert(messages, gc.HasLen, 2)
+	c.Assert(messages[0], gc.Equals, fmt.Sprintf("captured %s (16 bytes)", path))
+	c.Assert(messages[1], gc.Matches, "could not capture .*missing.txt: .*")
+}
+
+func (s *HandleSuite) TestCaptureFilesTruncated(c *gc.C) {
+	s.PatchValue(&machineactions.MaxArtifactSize, 4)
+	path := filepath.Join(c.MkDir(), "artifact.txt")
+	err := ioutil.WriteFile(path, []byte("0123456789"), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	params := map[string]interface{}{
+		"command":       "true",
+		"timeout":       float64(0),
+		"capture-files": []interface{}{path},
+	}
+
+	results, err := machineactions.HandleAction(actions.JujuExecActionName, params, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	artifacts := results["artifacts"].(map[string]interface{})
+	c.Assert(artifacts[path], jc.DeepEquals, map[string]interface{}{
+		"content":   "0123",
+		"size":      10,
+		"truncated": true,
+	})
+}
+
 func (s *HandleSuite) TestErrorRun(c *gc.C) {
 	params := map[string]interface{}{
 		"command": "exit 42",
 		"timeout": float64(0),
 	}
 
-	results, err := machineactions.HandleAction(actions.JujuExecActionName, params)
+	results, err := machineactions.HandleAction(actions.JujuExecActionName, params, nil)
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(results["return-code"], gc.Equals, 42)
 	c.Assert(results["stdout"], gc.Equals, "")
 	c.Assert(results["stderr"], gc.Equals, "")
 }
+
+func (s *HandleSuite) setupDiagnoseNetwork(c *gc.C) {
+	s.PatchValue(machineactions.DefaultDiagnoseEndpoints, []string{
+		"api.charmhub.io:443",
+		"bad.example.com:443",
+	})
+	s.PatchValue(machineactions.LookupHost, func(ctx context.Context, host string) ([]string, error) {
+		if host == "api.charmhub.io" {
+			return []string{"1.2.3.4"}, nil
+		}
+		return nil, errors.New("no such host")
+	})
+	s.PatchValue(machineactions.DialTimeout, func(network, addr string, timeout time.Duration) (net.Conn, error) {
+		switch addr {
+		case "api.charmhub.io:443", "10.0.0.1:17070":
+			client, server := net.Pipe()
+			go func() { _ = server.Close() }()
+			return client, nil
+		}
+		return nil, errors.New("connection refused")
+	})
+}
+
+func (s *HandleSuite) TestDiagnoseNetwork(c *gc.C) {
+	s.setupDiagnoseNetwork(c)
+
+	handleAction := machineactions.NewHandleAction([]string{"10.0.0.1:17070"})
+	results, err := handleAction(actions.JujuDiagnoseNetworkActionName, map[string]interface{}{}, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results["reachable"], gc.Equals, 2)
+	c.Assert(results["unreachable"], gc.Equals, 1)
+	c.Assert(results["controller"], jc.DeepEquals, []map[string]interface{}{{
+		"endpoint":  "10.0.0.1:17070",
+		"reachable": true,
+	}})
+	c.Assert(results["endpoints"], jc.DeepEquals, []map[string]interface{}{{
+		"endpoint":  "api.charmhub.io:443",
+		"reachable": true,
+		"resolved":  []string{"1.2.3.4"},
+	}, {
+		"endpoint":  "bad.example.com:443",
+		"reachable": false,
+		"dns-error": "no such host",
+	}})
+}
+
+func (s *HandleSuite) TestDiagnoseNetworkExtraEndpoints(c *gc.C) {
+	s.setupDiagnoseNetwork(c)
+
+	params := map[string]interface{}{
+		"endpoints": []interface{}{"10.20.30.40:8080"},
+	}
+	results, err := machineactions.HandleAction(actions.JujuDiagnoseNetworkActionName, params, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results["controller"], gc.IsNil)
+	endpoints := results["endpoints"].([]map[string]interface{})
+	c.Assert(endpoints, gc.HasLen, 3)
+	c.Assert(endpoints[2], jc.DeepEquals, map[string]interface{}{
+		"endpoint":  "10.20.30.40:8080",
+		"reachable": false,
+		"error":     "connection refused",
+	})
+}
@@ -32,12 +32,16 @@ func (config ManifoldConfig) start(a agent.Agent, apiCaller base.APICaller) (wor
 	if !ok {
 		return nil, errors.Errorf("this manifold can only be used inside a machine")
 	}
+	apiAddresses, err := a.CurrentConfig().APIAddresses()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 	machineActionsFacade := config.NewFacade(apiCaller)
 	return config.NewWorker(WorkerConfig{
 		Facade:       machineActionsFacade,
 		MachineTag:   machineTag,
 		MachineLock:  config.MachineLock,
-		HandleAction: HandleAction,
+		HandleAction: NewHandleAction(apiAddresses),
 	})
 }
 
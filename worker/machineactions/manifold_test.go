@@ -57,7 +57,7 @@ func (s *ManifoldSuite) SetUpSuite(c *gc.C) {
 		return func(wc machineactions.WorkerConfig) (worker.Worker, error) {
 			c.Assert(wc.Facade, gc.Equals, s.fakeFacade)
 			c.Assert(wc.MachineTag, gc.Equals, fakeTag)
-			c.Assert(wc.HandleAction, gc.Equals, fakeHandleAction)
+			c.Assert(wc.HandleAction, gc.NotNil)
 			c.Assert(wc.MachineLock, gc.Equals, s.fakeLock)
 			return w, err
 		}
@@ -170,6 +170,10 @@ func (mock *fakeConfig) Tag() names.Tag {
 	return mock.tag
 }
 
+func (mock *fakeConfig) APIAddresses() ([]string, error) {
+	return []string{"10.6.6.6:17070"}, nil
+}
+
 type fakeCaller struct {
 	base.APICaller
 }
@@ -182,6 +186,3 @@ type fakeWorker struct {
 	worker.Worker
 }
 
-var fakeHandleAction = func(name string, params map[string]interface{}) (results map[string]interface{}, err error) {
-	return nil, nil
-}
@@ -80,6 +80,20 @@ func (mr *MockFacadeMockRecorder) ActionFinish(arg0, arg1, arg2, arg3 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActionFinish", reflect.TypeOf((*MockFacade)(nil).ActionFinish), arg0, arg1, arg2, arg3)
 }
 
+// ActionLog mocks base method
+func (m *MockFacade) ActionLog(arg0 names.ActionTag, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActionLog", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ActionLog indicates an expected call of ActionLog
+func (mr *MockFacadeMockRecorder) ActionLog(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActionLog", reflect.TypeOf((*MockFacade)(nil).ActionLog), arg0, arg1)
+}
+
 // RunningActions mocks base method
 func (m *MockFacade) RunningActions(arg0 names.MachineTag) ([]params.ActionResult, error) {
 	m.ctrl.T.Helper()
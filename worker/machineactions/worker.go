@@ -28,6 +28,7 @@ type Facade interface {
 
 	Action(names.ActionTag) (*machineactions.Action, error)
 	ActionBegin(names.ActionTag) error
+	ActionLog(tag names.ActionTag, message string) error
 	ActionFinish(tag names.ActionTag, status string, results map[string]interface{}, message string) error
 }
 
@@ -36,7 +37,7 @@ type WorkerConfig struct {
 	Facade       Facade
 	MachineTag   names.MachineTag
 	MachineLock  machinelock.Lock
-	HandleAction func(name string, params map[string]interface{}) (results map[string]interface{}, err error)
+	HandleAction func(name string, params map[string]interface{}, sendMessage func(string)) (results map[string]interface{}, err error)
 }
 
 // Validate returns an error if the configuration is not complete.
@@ -164,7 +165,15 @@ func (h *handler) Handle(abort <-chan struct{}, actionsSlice []string) error {
 				actionErr = errors.Annotatef(err, "could not begin action %s", action.Name())
 				return
 			}
-			results, actionErr = h.config.HandleAction(action.Name(), action.Params())
+			// Progress messages are streamed to anyone watching the
+			// action; failing to deliver one is not worth failing
+			// the action over.
+			sendMessage := func(message string) {
+				if err := h.config.Facade.ActionLog(actionTag, message); err != nil {
+					logger.Warningf("could not log message for action %s: %v", action.ID(), err)
+				}
+			}
+			results, actionErr = h.config.HandleAction(action.Name(), action.Params(), sendMessage)
 		}(*action)
 	}
 	return nil
@@ -105,6 +105,14 @@ func (mr *Machiner) SetUp() (watcher.NotifyWatcher, error) {
 		}
 	}
 
+	// Report the host's OS information. By this point the machine is
+	// known to be alive, so the data is worth recording.
+	if info := gatherOSInfo(); info != (params.MachineOSInfo{}) {
+		if err := m.SetOSInfo(info); err != nil {
+			return nil, errors.Annotate(err, "recording OS info")
+		}
+	}
+
 	// Mark the machine as started and log it.
 	if err := m.SetStatus(status.Started, "", nil); err != nil {
 		return nil, errors.Annotatef(err, "%s failed to set status started", mr.config.Tag)
@@ -201,6 +209,12 @@ func (mr *Machiner) Handle(_ <-chan struct{}) error {
 		}
 		logger.Debugf("observed network config updated for %q to %+v", mr.config.Tag, observedConfig)
 
+		if info := gatherOSInfo(); info != (params.MachineOSInfo{}) {
+			if err := mr.machine.SetOSInfo(info); err != nil {
+				return errors.Annotate(err, "cannot update OS info")
+			}
+		}
+
 		return nil
 	}
 
@@ -55,6 +55,9 @@ func (s *MachinerSuite) SetUpTest(c *gc.C) {
 	s.PatchValue(machiner.GetObservedNetworkConfig, func(_ corenetwork.ConfigSource) ([]params.NetworkConfig, error) {
 		return nil, nil
 	})
+	s.PatchValue(machiner.GatherOSInfo, func() params.MachineOSInfo {
+		return params.MachineOSInfo{}
+	})
 }
 
 func (s *MachinerSuite) TestMachinerConfigValidate(c *gc.C) {
@@ -307,6 +310,29 @@ func (s *MachinerSuite) TestStartSetsStatus(c *gc.C) {
 	)
 }
 
+func (s *MachinerSuite) TestStartSetsOSInfo(c *gc.C) {
+	osInfo := params.MachineOSInfo{
+		KernelVersion:  "5.4.0-100-generic",
+		OSPatchLevel:   "20.04.4 LTS (Focal Fossa)",
+		RebootRequired: true,
+	}
+	s.PatchValue(machiner.GatherOSInfo, func() params.MachineOSInfo {
+		return osInfo
+	})
+
+	mr := s.makeMachiner(c, false)
+	err := stopWorker(mr)
+	c.Assert(err, jc.ErrorIsNil)
+	s.accessor.machine.CheckCallNames(c,
+		"Life",
+		"SetMachineAddresses",
+		"SetOSInfo",
+		"SetStatus",
+		"Watch",
+	)
+	s.accessor.machine.CheckCall(c, 2, "SetOSInfo", osInfo)
+}
+
 func (s *MachinerSuite) TestSetDead(c *gc.C) {
 	s.accessor.machine.life = life.Dying
 	mr := s.makeMachiner(c, false)
@@ -61,6 +61,11 @@ func (m *mockMachine) SetObservedNetworkConfig(netConfig []params.NetworkConfig)
 	return m.NextErr()
 }
 
+func (m *mockMachine) SetOSInfo(info params.MachineOSInfo) error {
+	m.MethodCall(m, "SetOSInfo", info)
+	return m.NextErr()
+}
+
 func (m *mockMachine) SetStatus(status status.Status, info string, data map[string]interface{}) error {
 	m.MethodCall(m, "SetStatus", status, info, data)
 	return m.NextErr()
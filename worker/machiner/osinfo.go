@@ -0,0 +1,51 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package machiner
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/juju/juju/apiserver/params"
+)
+
+// Paths consulted when gathering the host's OS information.
+// Variables so they can be overridden in tests.
+var (
+	kernelVersionFile  = "/proc/sys/kernel/osrelease"
+	osReleaseFile      = "/etc/os-release"
+	rebootRequiredFile = "/var/run/reboot-required"
+)
+
+// gatherOSInfo collects the host's kernel version, OS patch level and
+// reboot-required flag. Values that cannot be determined are left
+// empty, so the result is empty on platforms that do not expose the
+// files consulted.
+var gatherOSInfo = func() params.MachineOSInfo {
+	var info params.MachineOSInfo
+	if data, err := ioutil.ReadFile(kernelVersionFile); err == nil {
+		info.KernelVersion = strings.TrimSpace(string(data))
+	}
+	info.OSPatchLevel = osPatchLevel()
+	if _, err := os.Stat(rebootRequiredFile); err == nil {
+		info.RebootRequired = true
+	}
+	return info
+}
+
+// osPatchLevel returns the VERSION field of the host's os-release data,
+// e.g. "20.04.4 LTS (Focal Fossa)".
+func osPatchLevel() string {
+	data, err := ioutil.ReadFile(osReleaseFile)
+	if err != nil {
+		return ""
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.HasPrefix(line, "VERSION=") {
+			return strings.Trim(strings.TrimPrefix(line, "VERSION="), `"`)
+		}
+	}
+	return ""
+}
@@ -26,6 +26,7 @@ type Machine interface {
 	SetStatus(machineStatus status.Status, info string, data map[string]interface{}) error
 	Watch() (watcher.NotifyWatcher, error)
 	SetObservedNetworkConfig(netConfig []params.NetworkConfig) error
+	SetOSInfo(info params.MachineOSInfo) error
 }
 
 type APIMachineAccessor struct {
@@ -237,6 +237,7 @@ func (s *PresenceSuite) TestConnectTopic(c *gc.C) {
 		Status:          corepresence.Alive,
 		ControllerAgent: true,
 		UserData:        "test",
+		ConnectionCount: 1,
 	})
 }
 
@@ -325,7 +326,10 @@ func (s *PresenceSuite) TestPresenceResponse(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 	s.AssertDone(c, pubsub.Wait(done))
 
-	s.AssertConnections(c, alive(agent1), alive(agent2), alive(agent3), alive(agent4))
+	// The response re-added the connections for the other server, so the
+	// recorder has now observed two connections for those agents.
+	s.AssertConnections(c, alive(agent1), connectionCount(alive(agent2), 2),
+		alive(agent3), connectionCount(alive(agent4), 2))
 }
 
 func (s *PresenceSuite) AssertDone(c *gc.C, called <-chan struct{}) {
@@ -380,6 +384,11 @@ func missing(v corepresence.Value) corepresence.Value {
 	return v
 }
 
+func connectionCount(v corepresence.Value, count uint64) corepresence.Value {
+	v.ConnectionCount = count
+	return v
+}
+
 func connect(r corepresence.Recorder, values ...corepresence.Value) {
 	for _, info := range values {
 		r.Connect(info.Server, info.Model, info.Agent, info.ConnectionID, info.ControllerAgent, info.UserData)
@@ -393,31 +402,35 @@ var (
 	ourServer   = ourTag.String()
 	otherServer = "machine-2"
 	agent1      = corepresence.Value{
-		Model:        modelUUID,
-		Server:       ourServer,
-		Agent:        "machine-0",
-		ConnectionID: 1237,
-		UserData:     "foo",
+		Model:           modelUUID,
+		Server:          ourServer,
+		Agent:           "machine-0",
+		ConnectionID:    1237,
+		UserData:        "foo",
+		ConnectionCount: 1,
 	}
 	agent2 = corepresence.Value{
-		Model:        modelUUID,
-		Server:       otherServer,
-		Agent:        "machine-1",
-		ConnectionID: 1238,
-		UserData:     "bar",
+		Model:           modelUUID,
+		Server:          otherServer,
+		Agent:           "machine-1",
+		ConnectionID:    1238,
+		UserData:        "bar",
+		ConnectionCount: 1,
 	}
 	agent3 = corepresence.Value{
-		Model:        modelUUID,
-		Server:       ourServer,
-		Agent:        "unit-ubuntu-0",
-		ConnectionID: 1239,
-		UserData:     "baz",
+		Model:           modelUUID,
+		Server:          ourServer,
+		Agent:           "unit-ubuntu-0",
+		ConnectionID:    1239,
+		UserData:        "baz",
+		ConnectionCount: 1,
 	}
 	agent4 = corepresence.Value{
-		Model:        modelUUID,
-		Server:       otherServer,
-		Agent:        "unit-ubuntu-1",
-		ConnectionID: 1240,
-		UserData:     "splat",
+		Model:           modelUUID,
+		Server:          otherServer,
+		Agent:           "unit-ubuntu-1",
+		ConnectionID:    1240,
+		UserData:        "splat",
+		ConnectionCount: 1,
 	}
 )
@@ -4,8 +4,6 @@
 package pruner
 
 import (
-	"time"
-
 	"github.com/juju/clock"
 	"github.com/juju/errors"
 )
@@ -15,19 +13,26 @@ type Logger interface {
 	Infof(string, ...interface{})
 }
 
-// Config holds all necessary attributes to start a pruner worker.
+// Config holds all necessary attributes to start the retention engine.
 type Config struct {
-	Facade        Facade
-	PruneInterval time.Duration
-	Clock         clock.Clock
-	Logger        Logger
+	Policies []PolicyFacade
+	Clock    clock.Clock
+	Logger   Logger
 }
 
 // Validate will err unless basic requirements for a valid
 // config are met.
 func (c *Config) Validate() error {
-	if c.Facade == nil {
-		return errors.New("missing Facade")
+	if len(c.Policies) == 0 {
+		return errors.New("missing Policies")
+	}
+	for _, p := range c.Policies {
+		if err := p.Policy.Validate(); err != nil {
+			return errors.Trace(err)
+		}
+		if p.Facade == nil {
+			return errors.Errorf("missing Facade for %s policy", p.Policy.Name)
+		}
 	}
 	if c.Clock == nil {
 		return errors.New("missing Clock")
@@ -37,10 +42,3 @@ func (c *Config) Validate() error {
 	}
 	return nil
 }
-
-// New returns a worker.Worker for history Pruner.
-func New(conf Config) PrunerWorker {
-	return PrunerWorker{
-		config: conf,
-	}
-}
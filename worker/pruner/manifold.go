@@ -4,8 +4,6 @@
 package pruner
 
 import (
-	"time"
-
 	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/worker/v3"
@@ -15,17 +13,17 @@ import (
 )
 
 // ManifoldConfig describes the resources and configuration on which the
-// statushistorypruner worker depends.
+// retention engine worker depends.
 type ManifoldConfig struct {
 	APICallerName string
 	Clock         clock.Clock
-	PruneInterval time.Duration
+	Policies      []Policy
 	NewWorker     func(Config) (worker.Worker, error)
-	NewClient     func(base.APICaller) Facade
 	Logger        Logger
 }
 
-// Manifold returns a Manifold that encapsulates the statushistorypruner worker.
+// Manifold returns a Manifold that encapsulates the retention engine
+// worker.
 func Manifold(config ManifoldConfig) dependency.Manifold {
 	return dependency.Manifold{
 		Inputs: []string{config.APICallerName},
@@ -43,14 +41,18 @@ func (config ManifoldConfig) start(context dependency.Context) (worker.Worker, e
 		return nil, errors.Trace(err)
 	}
 
-	facade := config.NewClient(apiCaller)
-	prunerConfig := Config{
-		Facade:        facade,
-		PruneInterval: config.PruneInterval,
-		Clock:         config.Clock,
-		Logger:        config.Logger,
+	policies := make([]PolicyFacade, len(config.Policies))
+	for i, p := range config.Policies {
+		policies[i] = PolicyFacade{
+			Policy: p,
+			Facade: p.NewClient(apiCaller),
+		}
 	}
-	w, err := config.NewWorker(prunerConfig)
+	w, err := config.NewWorker(Config{
+		Policies: policies,
+		Clock:    config.Clock,
+		Logger:   config.Logger,
+	})
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -65,12 +67,17 @@ func (config ManifoldConfig) Validate() error {
 	if config.Clock == nil {
 		return errors.NotValidf("nil Clock")
 	}
+	if len(config.Policies) == 0 {
+		return errors.NotValidf("empty Policies")
+	}
+	for _, p := range config.Policies {
+		if err := p.Validate(); err != nil {
+			return errors.Trace(err)
+		}
+	}
 	if config.NewWorker == nil {
 		return errors.NotValidf("nil NewWorker")
 	}
-	if config.NewClient == nil {
-		return errors.NotValidf("nil NewClient")
-	}
 	if config.Logger == nil {
 		return errors.NotValidf("nil Logger")
 	}
@@ -4,6 +4,8 @@
 package pruner_test
 
 import (
+	"time"
+
 	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/loggo"
@@ -13,6 +15,7 @@ import (
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/worker/pruner"
 )
 
@@ -38,9 +41,14 @@ func (s *ManifoldConfigSuite) validConfig() pruner.ManifoldConfig {
 	return pruner.ManifoldConfig{
 		APICallerName: "api-caller",
 		Clock:         clock.WallClock,
-		NewWorker:     func(pruner.Config) (worker.Worker, error) { return nil, nil },
-		NewClient:     func(caller base.APICaller) pruner.Facade { return nil },
-		Logger:        loggo.GetLogger("test"),
+		Policies: []pruner.Policy{{
+			Name:          "statushistory",
+			PruneInterval: time.Hour,
+			NewClient:     func(caller base.APICaller) pruner.Facade { return nil },
+			ConfigValues:  func(*config.Config) (time.Duration, uint) { return 0, 0 },
+		}},
+		NewWorker: func(pruner.Config) (worker.Worker, error) { return nil, nil },
+		Logger:    loggo.GetLogger("test"),
 	}
 }
 
@@ -58,16 +66,36 @@ func (s *ManifoldConfigSuite) TestMissingClock(c *gc.C) {
 	s.checkNotValid(c, "nil Clock not valid")
 }
 
+func (s *ManifoldConfigSuite) TestMissingPolicies(c *gc.C) {
+	s.config.Policies = nil
+	s.checkNotValid(c, "empty Policies not valid")
+}
+
+func (s *ManifoldConfigSuite) TestPolicyMissingName(c *gc.C) {
+	s.config.Policies[0].Name = ""
+	s.checkNotValid(c, "policy with empty Name not valid")
+}
+
+func (s *ManifoldConfigSuite) TestPolicyMissingInterval(c *gc.C) {
+	s.config.Policies[0].PruneInterval = 0
+	s.checkNotValid(c, "statushistory policy prune interval 0s not valid")
+}
+
+func (s *ManifoldConfigSuite) TestPolicyMissingNewClient(c *gc.C) {
+	s.config.Policies[0].NewClient = nil
+	s.checkNotValid(c, "statushistory policy with nil NewClient not valid")
+}
+
+func (s *ManifoldConfigSuite) TestPolicyMissingConfigValues(c *gc.C) {
+	s.config.Policies[0].ConfigValues = nil
+	s.checkNotValid(c, "statushistory policy with nil ConfigValues not valid")
+}
+
 func (s *ManifoldConfigSuite) TestMissingNewWorker(c *gc.C) {
 	s.config.NewWorker = nil
 	s.checkNotValid(c, "nil NewWorker not valid")
 }
 
-func (s *ManifoldConfigSuite) TestMissingNewClient(c *gc.C) {
-	s.config.NewClient = nil
-	s.checkNotValid(c, "nil NewClient not valid")
-}
-
 func (s *ManifoldConfigSuite) TestMissingLogger(c *gc.C) {
 	s.config.Logger = nil
 	s.checkNotValid(c, "nil Logger not valid")
@@ -0,0 +1,61 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package pruner
+
+import (
+	"sync"
+	"time"
+)
+
+// Metrics tracks what the retention engine has pruned for each class
+// of data. The prune facades do not report how many documents were
+// removed, so the engine records prune runs, failures and durations.
+type Metrics struct {
+	mu      sync.Mutex
+	classes map[string]*classMetrics
+}
+
+type classMetrics struct {
+	prunes        uint64
+	failures      uint64
+	lastDuration  time.Duration
+	totalDuration time.Duration
+}
+
+func newMetrics() *Metrics {
+	return &Metrics{classes: make(map[string]*classMetrics)}
+}
+
+func (m *Metrics) observe(class string, elapsed time.Duration, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	c := m.classes[class]
+	if c == nil {
+		c = &classMetrics{}
+		m.classes[class] = c
+	}
+	c.prunes++
+	if err != nil {
+		c.failures++
+	}
+	c.lastDuration = elapsed
+	c.totalDuration += elapsed
+}
+
+// report returns the metrics in a form suitable for inclusion in
+// the dependency engine report.
+func (m *Metrics) report() map[string]interface{} {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := make(map[string]interface{})
+	for class, c := range m.classes {
+		result[class] = map[string]interface{}{
+			"prunes":         c.prunes,
+			"failures":       c.failures,
+			"last-duration":  c.lastDuration.String(),
+			"total-duration": c.totalDuration.String(),
+		}
+	}
+	return result
+}
@@ -0,0 +1,86 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package pruner
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/action"
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/api/statushistory"
+	"github.com/juju/juju/environs/config"
+)
+
+// Policy describes the retention policy for one class of pruned data.
+type Policy struct {
+	// Name identifies the class of data the policy covers.
+	Name string
+
+	// PruneInterval is how often the policy is applied.
+	PruneInterval time.Duration
+
+	// NewClient returns the facade used to apply the policy.
+	NewClient func(base.APICaller) Facade
+
+	// ConfigValues extracts the maximum age and collection size
+	// for the data class from the model config.
+	ConfigValues func(*config.Config) (time.Duration, uint)
+}
+
+// Validate will err unless basic requirements for a valid
+// policy are met.
+func (p Policy) Validate() error {
+	if p.Name == "" {
+		return errors.NotValidf("policy with empty Name")
+	}
+	if p.PruneInterval <= 0 {
+		return errors.NotValidf("%s policy prune interval %v", p.Name, p.PruneInterval)
+	}
+	if p.NewClient == nil {
+		return errors.NotValidf("%s policy with nil NewClient", p.Name)
+	}
+	if p.ConfigValues == nil {
+		return errors.NotValidf("%s policy with nil ConfigValues", p.Name)
+	}
+	return nil
+}
+
+// PolicyFacade pairs a retention policy with the facade used to
+// apply it.
+type PolicyFacade struct {
+	Policy Policy
+	Facade Facade
+}
+
+// StatusHistoryPolicy returns the retention policy for status
+// history records.
+func StatusHistoryPolicy(interval time.Duration) Policy {
+	return Policy{
+		Name:          "statushistory",
+		PruneInterval: interval,
+		NewClient: func(caller base.APICaller) Facade {
+			return statushistory.NewClient(caller)
+		},
+		ConfigValues: func(cfg *config.Config) (time.Duration, uint) {
+			return cfg.MaxStatusHistoryAge(), cfg.MaxStatusHistorySizeMB()
+		},
+	}
+}
+
+// ActionPolicy returns the retention policy for completed actions
+// and the operations that contain them.
+func ActionPolicy(interval time.Duration) Policy {
+	return Policy{
+		Name:          "action",
+		PruneInterval: interval,
+		NewClient: func(caller base.APICaller) Facade {
+			return action.NewPruner(caller)
+		},
+		ConfigValues: func(cfg *config.Config) (time.Duration, uint) {
+			return cfg.MaxActionResultsAge(), cfg.MaxActionResultsSizeMB()
+		},
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/juju/clock"
 	"github.com/juju/errors"
+	"github.com/juju/worker/v3"
 	"github.com/juju/worker/v3/catacomb"
 
 	"github.com/juju/juju/core/watcher"
@@ -24,42 +25,65 @@ var (
 
 //go:generate go run github.com/golang/mock/mockgen -package mocks -destination mocks/mocks_facade.go github.com/juju/juju/worker/pruner Facade
 
-// Facade represents an API that implements status history pruning.
+// Facade represents an API that implements pruning of one class
+// of data.
 type Facade interface {
 	Prune(time.Duration, int) error
 	WatchForModelConfigChanges() (watcher.NotifyWatcher, error)
 	ModelConfig() (*config.Config, error)
 }
 
-// PrunerWorker prunes status history or action records at regular intervals.
-type PrunerWorker struct {
+// Worker is the retention engine. It applies each of the configured
+// retention policies at that policy's prune interval, recording
+// shared metrics on the prune runs.
+type Worker struct {
 	catacomb catacomb.Catacomb
 	config   Config
+	metrics  *Metrics
+}
+
+// New returns a worker that applies the configured retention policies.
+func New(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	w := &Worker{
+		config:  config,
+		metrics: newMetrics(),
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	return w, errors.Trace(err)
 }
 
 // Kill is defined on worker.Worker.
-func (w *PrunerWorker) Kill() {
+func (w *Worker) Kill() {
 	w.catacomb.Kill(nil)
 }
 
 // Wait is defined on worker.Worker.
-func (w *PrunerWorker) Wait() error {
+func (w *Worker) Wait() error {
 	return w.catacomb.Wait()
 }
 
-// Catacomb returns the prune worker's catacomb.
-func (w *PrunerWorker) Catacomb() *catacomb.Catacomb {
-	return &w.catacomb
+// Report shows the prune metrics in the dependency engine report.
+func (w *Worker) Report() map[string]interface{} {
+	return w.metrics.report()
 }
 
-// Config return the prune worker's config.
-func (w *PrunerWorker) Config() *Config {
-	return &w.config
+// policyState holds the current limits and schedule for one policy.
+type policyState struct {
+	maxAge  time.Duration
+	maxMB   uint
+	nextRun time.Time
 }
 
-// Work is the main body of generic pruner loop.
-func (w *PrunerWorker) Work(getPrunerConfig func(*config.Config) (time.Duration, uint)) error {
-	modelConfigWatcher, err := w.config.Facade.WatchForModelConfigChanges()
+func (w *Worker) loop() error {
+	// All of the facades watch the same model config; use the first
+	// rather than running one watcher per policy.
+	modelConfigWatcher, err := w.config.Policies[0].Facade.WatchForModelConfigChanges()
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -68,13 +92,10 @@ func (w *PrunerWorker) Work(getPrunerConfig func(*config.Config) (time.Duration,
 		return errors.Trace(err)
 	}
 
-	var (
-		maxAge             time.Duration
-		maxCollectionMB    uint
-		modelConfigChanges = modelConfigWatcher.Changes()
-		// We will also get an initial event, but need to ensure that event is
-		// received before doing any pruning.
-	)
+	states := make([]policyState, len(w.config.Policies))
+	modelConfigChanges := modelConfigWatcher.Changes()
+	// We will also get an initial event, but need to ensure that event
+	// is received before doing any pruning.
 
 	var timer clock.Timer
 	var timerCh <-chan time.Time
@@ -87,30 +108,59 @@ func (w *PrunerWorker) Work(getPrunerConfig func(*config.Config) (time.Duration,
 			if !ok {
 				return errors.New("model configuration watcher closed")
 			}
-			modelConfig, err := w.config.Facade.ModelConfig()
+			modelConfig, err := w.config.Policies[0].Facade.ModelConfig()
 			if err != nil {
 				return errors.Annotate(err, "cannot load model configuration")
 			}
 
-			newMaxAge, newMaxCollectionMB := getPrunerConfig(modelConfig)
-
-			if newMaxAge != maxAge || newMaxCollectionMB != maxCollectionMB {
-				w.config.Logger.Infof("pruner config: max age: %v, max collection size %dM for %s (%s)",
-					newMaxAge, newMaxCollectionMB, modelConfig.Name(), modelConfig.UUID())
-				maxAge = newMaxAge
-				maxCollectionMB = newMaxCollectionMB
+			for i, p := range w.config.Policies {
+				newMaxAge, newMaxMB := p.Policy.ConfigValues(modelConfig)
+				if newMaxAge != states[i].maxAge || newMaxMB != states[i].maxMB {
+					w.config.Logger.Infof("%s pruner config: max age: %v, max collection size %dM for %s (%s)",
+						p.Policy.Name, newMaxAge, newMaxMB, modelConfig.Name(), modelConfig.UUID())
+					states[i].maxAge = newMaxAge
+					states[i].maxMB = newMaxMB
+				}
 			}
 			if timer == nil {
-				timer = w.config.Clock.NewTimer(w.config.PruneInterval)
+				now := w.config.Clock.Now()
+				for i, p := range w.config.Policies {
+					states[i].nextRun = now.Add(p.Policy.PruneInterval)
+				}
+				timer = w.config.Clock.NewTimer(nextDeadline(states).Sub(now))
 				timerCh = timer.Chan()
 			}
 
 		case <-timerCh:
-			err := w.config.Facade.Prune(maxAge, int(maxCollectionMB))
-			if err != nil {
-				return errors.Trace(err)
+			now := w.config.Clock.Now()
+			for i, p := range w.config.Policies {
+				if now.Before(states[i].nextRun) {
+					continue
+				}
+				if err := w.prune(p, states[i]); err != nil {
+					return errors.Trace(err)
+				}
+				states[i].nextRun = now.Add(p.Policy.PruneInterval)
 			}
-			timer.Reset(w.config.PruneInterval)
+			timer.Reset(nextDeadline(states).Sub(now))
+		}
+	}
+}
+
+func (w *Worker) prune(p PolicyFacade, s policyState) error {
+	start := w.config.Clock.Now()
+	err := p.Facade.Prune(s.maxAge, int(s.maxMB))
+	elapsed := w.config.Clock.Now().Sub(start)
+	w.metrics.observe(p.Policy.Name, elapsed, err)
+	return errors.Annotatef(err, "pruning %s", p.Policy.Name)
+}
+
+func nextDeadline(states []policyState) time.Time {
+	deadline := states[0].nextRun
+	for _, s := range states[1:] {
+		if s.nextRun.Before(deadline) {
+			deadline = s.nextRun
 		}
 	}
+	return deadline
 }
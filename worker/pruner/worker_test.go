@@ -14,11 +14,11 @@ import (
 	"github.com/juju/worker/v3"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/environs/config"
 	coretesting "github.com/juju/juju/testing"
 	"github.com/juju/juju/worker/pruner"
-	"github.com/juju/juju/worker/statushistorypruner"
 )
 
 type PrunerSuite struct {
@@ -27,9 +27,18 @@ type PrunerSuite struct {
 
 var _ = gc.Suite(&PrunerSuite{})
 
-type newPrunerFunc func(pruner.Config) (worker.Worker, error)
+func statusHistoryPolicy(interval time.Duration) pruner.Policy {
+	return pruner.Policy{
+		Name:          "statushistory",
+		PruneInterval: interval,
+		NewClient:     func(base.APICaller) pruner.Facade { return nil },
+		ConfigValues: func(cfg *config.Config) (time.Duration, uint) {
+			return cfg.MaxStatusHistoryAge(), cfg.MaxStatusHistorySizeMB()
+		},
+	}
+}
 
-func (s *PrunerSuite) setupPruner(c *gc.C, newPruner newPrunerFunc) (*fakeFacade, *testclock.Clock) {
+func (s *PrunerSuite) setupPruner(c *gc.C, policies ...pruner.PolicyFacade) (*fakeFacade, *testclock.Clock) {
 	facade := newFakeFacade()
 	attrs := coretesting.FakeConfig()
 	attrs["max-status-history-age"] = "1s"
@@ -38,18 +47,27 @@ func (s *PrunerSuite) setupPruner(c *gc.C, newPruner newPrunerFunc) (*fakeFacade
 	c.Assert(err, jc.ErrorIsNil)
 	facade.modelConfig = cfg
 
+	if len(policies) == 0 {
+		policies = []pruner.PolicyFacade{{
+			Policy: statusHistoryPolicy(coretesting.ShortWait),
+		}}
+	}
+	for i := range policies {
+		if policies[i].Facade == nil {
+			policies[i].Facade = facade
+		}
+	}
 	testClock := testclock.NewClock(time.Time{})
 	conf := pruner.Config{
-		Facade:        facade,
-		PruneInterval: coretesting.ShortWait,
-		Clock:         testClock,
-		Logger:        loggo.GetLogger("test"),
+		Policies: policies,
+		Clock:    testClock,
+		Logger:   loggo.GetLogger("test"),
 	}
 
-	pruner, err := newPruner(conf)
+	w, err := pruner.New(conf)
 	c.Check(err, jc.ErrorIsNil)
 	s.AddCleanup(func(*gc.C) {
-		c.Assert(worker.Stop(pruner), jc.ErrorIsNil)
+		c.Assert(worker.Stop(w), jc.ErrorIsNil)
 	})
 
 	facade.modelChangesWatcher.changes <- struct{}{}
@@ -75,12 +93,12 @@ func (s *PrunerSuite) assertWorkerCallsPrune(c *gc.C, facade *fakeFacade, testCl
 }
 
 func (s *PrunerSuite) TestWorkerCallsPrune(c *gc.C) {
-	facade, clock := s.setupPruner(c, statushistorypruner.New)
+	facade, clock := s.setupPruner(c)
 	s.assertWorkerCallsPrune(c, facade, clock, 3)
 }
 
 func (s *PrunerSuite) TestWorkerWontCallPruneBeforeFiringTimer(c *gc.C) {
-	facade, _ := s.setupPruner(c, statushistorypruner.New)
+	facade, _ := s.setupPruner(c)
 
 	select {
 	case <-facade.pruned:
@@ -90,7 +108,7 @@ func (s *PrunerSuite) TestWorkerWontCallPruneBeforeFiringTimer(c *gc.C) {
 }
 
 func (s *PrunerSuite) TestModelConfigChange(c *gc.C) {
-	facade, clock := s.setupPruner(c, statushistorypruner.New)
+	facade, clock := s.setupPruner(c)
 	s.assertWorkerCallsPrune(c, facade, clock, 3)
 
 	var err error
@@ -101,6 +119,52 @@ func (s *PrunerSuite) TestModelConfigChange(c *gc.C) {
 	s.assertWorkerCallsPrune(c, facade, clock, 4)
 }
 
+func (s *PrunerSuite) TestEachPolicyPrunedAtItsOwnInterval(c *gc.C) {
+	slowFacade := newFakeFacade()
+	fastFacade, clock := s.setupPruner(c,
+		pruner.PolicyFacade{Policy: statusHistoryPolicy(coretesting.ShortWait)},
+		pruner.PolicyFacade{
+			Policy: pruner.Policy{
+				Name:          "slow",
+				PruneInterval: 2 * coretesting.ShortWait,
+				NewClient:     func(base.APICaller) pruner.Facade { return nil },
+				ConfigValues: func(*config.Config) (time.Duration, uint) {
+					return time.Hour, 7
+				},
+			},
+			Facade: slowFacade,
+		},
+	)
+
+	// After one interval only the fast policy has been pruned.
+	clock.WaitAdvance(coretesting.ShortWait, coretesting.LongWait, 1)
+	select {
+	case <-fastFacade.pruned:
+	case <-time.After(coretesting.LongWait):
+		c.Fatal("timed out waiting for fast policy to be pruned")
+	}
+	select {
+	case <-slowFacade.pruned:
+		c.Fatal("slow policy pruned too early")
+	case <-time.After(coretesting.ShortWait):
+	}
+
+	// After a second interval the slow policy is due as well.
+	clock.WaitAdvance(coretesting.ShortWait, coretesting.LongWait, 1)
+	select {
+	case <-fastFacade.pruned:
+	case <-time.After(coretesting.LongWait):
+		c.Fatal("timed out waiting for fast policy to be pruned again")
+	}
+	select {
+	case args := <-slowFacade.pruned:
+		c.Assert(args.maxAge, gc.Equals, time.Hour)
+		c.Assert(args.maxHistoryMB, gc.Equals, 7)
+	case <-time.After(coretesting.LongWait):
+		c.Fatal("timed out waiting for slow policy to be pruned")
+	}
+}
+
 type fakeFacade struct {
 	pruned              chan pruneParams
 	modelChangesWatcher *mockNotifyWatcher
@@ -0,0 +1,74 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package remoterelations
+
+import (
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+)
+
+// ConnectBackoffMin and ConnectBackoffMax bound the exponential backoff
+// applied to reconnection attempts to an offering controller. The first
+// retry happens after ConnectBackoffMin; each subsequent failure doubles
+// the delay up to ConnectBackoffMax.
+var (
+	ConnectBackoffMin = 5 * time.Second
+	ConnectBackoffMax = 5 * time.Minute
+)
+
+// circuitBreaker tracks consecutive failures connecting to an offering
+// controller for a remote application and computes the exponential
+// backoff to apply before the next attempt. A breaker is shared across
+// restarts of the application worker so that the backoff state survives
+// worker bounces.
+type circuitBreaker struct {
+	clock clock.Clock
+
+	mu       sync.Mutex
+	failures int
+	downAt   time.Time
+}
+
+func newCircuitBreaker(clock clock.Clock) *circuitBreaker {
+	return &circuitBreaker{clock: clock}
+}
+
+// recordFailure notes another failed connection attempt and returns the
+// delay to wait before the next attempt.
+func (b *circuitBreaker) recordFailure() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures == 0 {
+		b.downAt = b.clock.Now()
+	}
+	b.failures++
+
+	delay := ConnectBackoffMin
+	for i := 1; i < b.failures && delay < ConnectBackoffMax; i++ {
+		delay *= 2
+	}
+	if delay > ConnectBackoffMax {
+		delay = ConnectBackoffMax
+	}
+	return delay
+}
+
+// recordSuccess closes the breaker, resetting the failure count.
+func (b *circuitBreaker) recordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures = 0
+	b.downAt = time.Time{}
+}
+
+// downSince returns the time of the first failure in the current run of
+// consecutive failures, and whether the breaker is currently open.
+func (b *circuitBreaker) downSince() (time.Time, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.downAt, b.failures > 0
+}
@@ -0,0 +1,64 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package remoterelations
+
+import (
+	"time"
+
+	"github.com/juju/clock/testclock"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+type circuitBreakerSuite struct{}
+
+var _ = gc.Suite(&circuitBreakerSuite{})
+
+func (s *circuitBreakerSuite) TestBackoffDoublesToCap(c *gc.C) {
+	clk := testclock.NewClock(time.Now())
+	b := newCircuitBreaker(clk)
+
+	expected := ConnectBackoffMin
+	for i := 0; i < 10; i++ {
+		delay := b.recordFailure()
+		c.Check(delay, gc.Equals, expected)
+		if expected < ConnectBackoffMax {
+			expected *= 2
+			if expected > ConnectBackoffMax {
+				expected = ConnectBackoffMax
+			}
+		}
+	}
+	c.Check(b.recordFailure(), gc.Equals, ConnectBackoffMax)
+}
+
+func (s *circuitBreakerSuite) TestDownSince(c *gc.C) {
+	clk := testclock.NewClock(time.Now())
+	b := newCircuitBreaker(clk)
+
+	_, down := b.downSince()
+	c.Check(down, jc.IsFalse)
+
+	firstFailure := clk.Now()
+	b.recordFailure()
+	clk.Advance(time.Minute)
+	b.recordFailure()
+
+	downAt, down := b.downSince()
+	c.Check(down, jc.IsTrue)
+	c.Check(downAt, gc.Equals, firstFailure)
+}
+
+func (s *circuitBreakerSuite) TestRecordSuccessResets(c *gc.C) {
+	clk := testclock.NewClock(time.Now())
+	b := newCircuitBreaker(clk)
+
+	b.recordFailure()
+	b.recordFailure()
+	b.recordSuccess()
+
+	_, down := b.downSince()
+	c.Check(down, jc.IsFalse)
+	c.Check(b.recordFailure(), gc.Equals, ConnectBackoffMin)
+}
@@ -4,9 +4,12 @@
 package remoterelations
 
 import (
+	"fmt"
 	"sync"
+	"time"
 
 	"github.com/go-macaroon-bakery/macaroon-bakery/v3/bakery"
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/names/v4"
 	"github.com/juju/worker/v3"
@@ -57,6 +60,11 @@ type remoteApplicationWorker struct {
 
 	newRemoteModelRelationsFacadeFunc newRemoteRelationsFacadeFunc
 
+	// breaker records connection failures to the offering controller
+	// and supplies the reconnect backoff. It outlives worker restarts.
+	breaker *circuitBreaker
+
+	clock  clock.Clock
 	logger Logger
 }
 
@@ -143,7 +151,7 @@ func (w *remoteApplicationWorker) loop() (err error) {
 		offerStatusChanges watcher.OfferStatusChannel
 	)
 	if !w.isConsumerProxy {
-		if err := w.newRemoteRelationsFacadeWithRedirect(); err != nil {
+		if err := w.connectRemoteModelFacade(); err != nil {
 			return errors.Trace(err)
 		}
 		defer func() { _ = w.remoteModelFacade.Close() }()
@@ -242,6 +250,38 @@ func (w *remoteApplicationWorker) loop() (err error) {
 	}
 }
 
+// connectRemoteModelFacade opens an API connection to the offering
+// controller, retrying with exponential backoff while it is unreachable.
+// While the connection is down the remote application status reports how
+// long the offering controller has been unreachable, rather than the
+// worker silently bouncing and retrying in the logs.
+func (w *remoteApplicationWorker) connectRemoteModelFacade() error {
+	for {
+		err := w.newRemoteRelationsFacadeWithRedirect()
+		if err == nil {
+			w.breaker.recordSuccess()
+			return nil
+		}
+
+		delay := w.breaker.recordFailure()
+		downAt, _ := w.breaker.downSince()
+		msg := fmt.Sprintf("offer controller unreachable since %s: %v",
+			downAt.Format(time.RFC1123), err)
+		if err := w.localModelFacade.SetRemoteApplicationStatus(w.applicationName, status.Error, msg); err != nil {
+			w.logger.Errorf("updating remote application %v status: %v", w.applicationName, err)
+		}
+		w.logger.Warningf(
+			"connecting to offering controller for %v failed, retrying in %v: %v",
+			w.applicationName, delay, err)
+
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case <-w.clock.After(delay):
+		}
+	}
+}
+
 // newRemoteRelationsFacadeWithRedirect attempts to open an API connection to
 // the remote model for the watcher's application.
 // If a redirect error is returned, we attempt to open a connection to the new
@@ -288,6 +288,10 @@ func (w *Worker) handleApplicationChanges(applicationIds []string) error {
 			}
 		}
 
+		// The breaker is created outside the start func so that the
+		// reconnect backoff state survives restarts of the application
+		// worker by the runner.
+		breaker := newCircuitBreaker(w.config.Clock)
 		startFunc := func() (worker.Worker, error) {
 			appWorker := &remoteApplicationWorker{
 				offerUUID:                         remoteApp.OfferUUID,
@@ -301,6 +305,8 @@ func (w *Worker) handleApplicationChanges(applicationIds []string) error {
 				remoteRelationChanges:             make(chan RelationUnitChangeEvent),
 				localModelFacade:                  w.config.RelationsFacade,
 				newRemoteModelRelationsFacadeFunc: w.config.NewRemoteModelFacadeFunc,
+				breaker:                           breaker,
+				clock:                             w.config.Clock,
 				logger:                            logger,
 			}
 			if err := catacomb.Invoke(catacomb.Plan{
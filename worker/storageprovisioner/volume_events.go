@@ -559,6 +559,7 @@ func volumesFromStorage(in []storage.Volume) []params.Volume {
 				"", // pool
 				v.Size,
 				v.Persistent,
+				v.AvailabilityZone,
 			},
 		}
 	}
@@ -603,6 +604,7 @@ func volumeFromParams(in params.Volume) (storage.Volume, error) {
 			in.Info.WWN,
 			in.Info.Size,
 			in.Info.Persistent,
+			in.Info.AvailabilityZone,
 		},
 	}, nil
 }
@@ -0,0 +1,129 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package uniter
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/juju/errors"
+	"github.com/juju/worker/v3"
+	"gopkg.in/tomb.v2"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/core/watcher"
+)
+
+// CharmSyncerApplication describes the subset of the uniter API
+// application client needed to apply live-synced charm files.
+type CharmSyncerApplication interface {
+	WatchCharmSync() (watcher.NotifyWatcher, error)
+	CharmSync() (int, []params.CharmSyncFile, error)
+}
+
+// charmSyncer watches the application's charm sync overlay and applies
+// its files on top of the unit's charm directory, so that a charm
+// author running `juju refresh --path --watch` sees edits on the units
+// without a full charm upgrade.
+type charmSyncer struct {
+	tomb     tomb.Tomb
+	app      CharmSyncerApplication
+	charmDir string
+	logger   Logger
+
+	appliedGeneration int
+}
+
+// NewCharmSyncer starts a worker that applies live-synced charm files
+// to the given charm directory as they arrive.
+func NewCharmSyncer(app CharmSyncerApplication, charmDir string, logger Logger) worker.Worker {
+	s := &charmSyncer{
+		app:      app,
+		charmDir: charmDir,
+		logger:   logger,
+	}
+	s.tomb.Go(s.run)
+	return s
+}
+
+// Kill is part of the worker.Worker interface.
+func (s *charmSyncer) Kill() {
+	s.tomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (s *charmSyncer) Wait() error {
+	return s.tomb.Wait()
+}
+
+func (s *charmSyncer) run() error {
+	w, err := s.app.WatchCharmSync()
+	if errors.IsNotImplemented(err) {
+		// The controller predates live charm sync; wait quietly so
+		// the uniter keeps running.
+		s.logger.Debugf("charm sync not supported by the controller")
+		<-s.tomb.Dying()
+		return tomb.ErrDying
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = worker.Stop(w) }()
+	for {
+		select {
+		case <-s.tomb.Dying():
+			return tomb.ErrDying
+		case _, ok := <-w.Changes():
+			if !ok {
+				return errors.New("charm sync watcher closed")
+			}
+			if err := s.sync(); err != nil {
+				s.logger.Errorf("applying live-synced charm files: %v", err)
+			}
+		}
+	}
+}
+
+func (s *charmSyncer) sync() error {
+	generation, files, err := s.app.CharmSync()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if generation == 0 || generation == s.appliedGeneration {
+		return nil
+	}
+	if err := applyCharmSyncFiles(s.charmDir, files); err != nil {
+		return errors.Trace(err)
+	}
+	s.appliedGeneration = generation
+	s.logger.Infof("applied %d live-synced charm file(s) (generation %d)", len(files), generation)
+	return nil
+}
+
+// applyCharmSyncFiles writes the overlay files into the charm
+// directory, removing any files the overlay marks as deleted.
+func applyCharmSyncFiles(charmDir string, files []params.CharmSyncFile) error {
+	for _, f := range files {
+		target := filepath.Join(charmDir, filepath.FromSlash(f.Path))
+		if f.Removed {
+			if err := os.Remove(target); err != nil && !os.IsNotExist(err) {
+				return errors.Annotatef(err, "removing %q", f.Path)
+			}
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return errors.Trace(err)
+		}
+		mode := os.FileMode(f.Mode).Perm()
+		if err := ioutil.WriteFile(target, f.Content, mode); err != nil {
+			return errors.Annotatef(err, "writing %q", f.Path)
+		}
+		// WriteFile only applies the mode on creation.
+		if err := os.Chmod(target, mode); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
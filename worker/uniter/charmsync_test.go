@@ -0,0 +1,133 @@
+// Copyright 2021 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package uniter_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/juju/loggo"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v3/workertest"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/watchertest"
+	coretesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/worker/uniter"
+)
+
+type charmSyncSuite struct{}
+
+var _ = gc.Suite(&charmSyncSuite{})
+
+func (s *charmSyncSuite) TestApplyWritesAndRemovesFiles(c *gc.C) {
+	charmDir := c.MkDir()
+	existing := filepath.Join(charmDir, "stale.txt")
+	err := ioutil.WriteFile(existing, []byte("stale"), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = uniter.ApplyCharmSyncFiles(charmDir, []params.CharmSyncFile{
+		{Path: "src/charm.py", Content: []byte("#!/usr/bin/env python3"), Mode: 0755},
+		{Path: "README.md", Content: []byte("readme"), Mode: 0644},
+		{Path: "stale.txt", Removed: true},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	content, err := ioutil.ReadFile(filepath.Join(charmDir, "src", "charm.py"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(string(content), gc.Equals, "#!/usr/bin/env python3")
+	info, err := os.Stat(filepath.Join(charmDir, "src", "charm.py"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(info.Mode().Perm(), gc.Equals, os.FileMode(0755))
+
+	content, err = ioutil.ReadFile(filepath.Join(charmDir, "README.md"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(string(content), gc.Equals, "readme")
+
+	_, err = os.Stat(existing)
+	c.Assert(err, jc.Satisfies, os.IsNotExist)
+}
+
+func (s *charmSyncSuite) TestApplyOverwritesAndChangesMode(c *gc.C) {
+	charmDir := c.MkDir()
+	target := filepath.Join(charmDir, "hooks", "install")
+	err := os.MkdirAll(filepath.Dir(target), 0755)
+	c.Assert(err, jc.ErrorIsNil)
+	err = ioutil.WriteFile(target, []byte("old"), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = uniter.ApplyCharmSyncFiles(charmDir, []params.CharmSyncFile{
+		{Path: "hooks/install", Content: []byte("new"), Mode: 0755},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	content, err := ioutil.ReadFile(target)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(string(content), gc.Equals, "new")
+	info, err := os.Stat(target)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(info.Mode().Perm(), gc.Equals, os.FileMode(0755))
+}
+
+func (s *charmSyncSuite) TestApplyRemoveMissingFileIsNoop(c *gc.C) {
+	charmDir := c.MkDir()
+	err := uniter.ApplyCharmSyncFiles(charmDir, []params.CharmSyncFile{
+		{Path: "gone.txt", Removed: true},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+type fakeCharmSyncApp struct {
+	watcher    watcher.NotifyWatcher
+	generation int
+	files      []params.CharmSyncFile
+}
+
+func (a *fakeCharmSyncApp) WatchCharmSync() (watcher.NotifyWatcher, error) {
+	return a.watcher, nil
+}
+
+func (a *fakeCharmSyncApp) CharmSync() (int, []params.CharmSyncFile, error) {
+	return a.generation, a.files, nil
+}
+
+func (s *charmSyncSuite) TestSyncerAppliesOnChange(c *gc.C) {
+	charmDir := c.MkDir()
+	ch := make(chan struct{}, 1)
+	app := &fakeCharmSyncApp{
+		watcher:    watchertest.NewMockNotifyWatcher(ch),
+		generation: 1,
+		files: []params.CharmSyncFile{
+			{Path: "metadata.yaml", Content: []byte("name: foo"), Mode: 0644},
+		},
+	}
+	syncer := uniter.NewCharmSyncer(app, charmDir, loggo.GetLogger("test.charmsync"))
+	defer workertest.CleanKill(c, syncer)
+
+	ch <- struct{}{}
+	target := filepath.Join(charmDir, "metadata.yaml")
+	for a := coretesting.LongAttempt.Start(); a.Next(); {
+		if _, err := os.Stat(target); err == nil {
+			break
+		}
+		if !a.HasNext() {
+			c.Fatalf("synced file never appeared")
+		}
+	}
+	content, err := ioutil.ReadFile(target)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(string(content), gc.Equals, "name: foo")
+
+	// An unchanged generation must not rewrite files.
+	err = os.Remove(target)
+	c.Assert(err, jc.ErrorIsNil)
+	ch <- struct{}{}
+	time.Sleep(coretesting.ShortWait)
+	_, err = os.Stat(target)
+	c.Assert(err, jc.Satisfies, os.IsNotExist)
+}
@@ -901,6 +901,15 @@ func (u *Uniter) init(unitTag names.UnitTag) (err error) {
 			return errors.Trace(err)
 		}
 	}
+
+	app, err := u.unit.Application()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	charmSyncer := NewCharmSyncer(app, u.paths.State.CharmDir, u.logger.Child("charmsync"))
+	if err := u.catacomb.Add(charmSyncer); err != nil {
+		return errors.Trace(err)
+	}
 	return nil
 }
 
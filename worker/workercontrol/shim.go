@@ -0,0 +1,30 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package workercontrol
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/worker/v3"
+
+	apiagent "github.com/juju/juju/api/agent"
+	"github.com/juju/juju/api/base"
+)
+
+// NewFacade creates an *agent.State and returns it as a Facade.
+func NewFacade(apiCaller base.APICaller) (Facade, error) {
+	facade, err := apiagent.NewState(apiCaller)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return facade, nil
+}
+
+// NewWorker creates a *Worker and returns it as a worker.Worker.
+func NewWorker(config Config) (worker.Worker, error) {
+	worker, err := New(config)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return worker, nil
+}
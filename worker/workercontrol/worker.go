@@ -0,0 +1,151 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package workercontrol tracks the set of agent workers that have been
+// administratively disabled for the running machine, either directly or
+// model-wide. Manifolds wrapped to depend on it refuse to start while
+// their name is in the set, giving operators a way to stop a
+// misbehaving worker fleet-wide without editing agent configs or
+// bouncing agents.
+package workercontrol
+
+import (
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	"github.com/juju/worker/v3/catacomb"
+
+	"github.com/juju/juju/core/watcher"
+)
+
+// ErrChanged indicates that a Worker has stopped because the set of
+// disabled workers changed, so its dependents must be re-evaluated.
+var ErrChanged = errors.New("disabled workers changed")
+
+// Facade exposes controller functionality required by a Worker.
+type Facade interface {
+	DisabledWorkers(tag names.Tag) ([]string, error)
+	WatchDisabledWorkers(tag names.Tag) (watcher.NotifyWatcher, error)
+}
+
+// Logger represents the methods used by the worker to log information.
+type Logger interface {
+	Infof(string, ...interface{})
+	Debugf(string, ...interface{})
+}
+
+// Check reports whether the worker with the given name has been
+// administratively disabled.
+type Check func(name string) bool
+
+// Config holds the dependencies and configuration for a Worker.
+type Config struct {
+	Facade Facade
+	Tag    names.Tag
+	Logger Logger
+}
+
+// Validate returns an error if the config cannot be expected to
+// drive a functional Worker.
+func (config Config) Validate() error {
+	if config.Facade == nil {
+		return errors.NotValidf("nil Facade")
+	}
+	if config.Tag == nil {
+		return errors.NotValidf("nil Tag")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	return nil
+}
+
+// New returns a Worker that tracks the set of disabled workers for the
+// configured agent, as exposed by the Facade. A controller too old to
+// disable workers yields an inert Worker that disables nothing, so
+// dependents start normally.
+func New(config Config) (*Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	supported := true
+	disabled, err := config.Facade.DisabledWorkers(config.Tag)
+	if errors.IsNotSupported(err) {
+		config.Logger.Infof("controller does not support disabling workers")
+		supported = false
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(disabled) > 0 {
+		config.Logger.Infof("administratively disabled workers: %v", disabled)
+	}
+
+	w := &Worker{
+		config:    config,
+		supported: supported,
+		disabled:  set.NewStrings(disabled...),
+	}
+	err = catacomb.Invoke(catacomb.Plan{
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
+
+// Worker implements worker.Worker, and exits with ErrChanged whenever
+// the set of disabled workers changes. The set held by a Worker never
+// changes over its lifetime, so Disabled is safe for concurrent use.
+type Worker struct {
+	catacomb  catacomb.Catacomb
+	config    Config
+	supported bool
+	disabled  set.Strings
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *Worker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *Worker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+// Disabled implements Check.
+func (w *Worker) Disabled(name string) bool {
+	return w.disabled.Contains(name)
+}
+
+func (w *Worker) loop() error {
+	if !w.supported {
+		<-w.catacomb.Dying()
+		return w.catacomb.ErrDying()
+	}
+	watcher, err := w.config.Facade.WatchDisabledWorkers(w.config.Tag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := w.catacomb.Add(watcher); err != nil {
+		return errors.Trace(err)
+	}
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case <-watcher.Changes():
+			current, err := w.config.Facade.DisabledWorkers(w.config.Tag)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			currentSet := set.NewStrings(current...)
+			if currentSet.Size() != w.disabled.Size() ||
+				!currentSet.Difference(w.disabled).IsEmpty() {
+				return ErrChanged
+			}
+		}
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package workercontrol_test
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	"github.com/juju/names/v4"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v3"
+	"github.com/juju/worker/v3/workertest"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/worker/workercontrol"
+)
+
+type WorkerSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&WorkerSuite{})
+
+func validConfig() workercontrol.Config {
+	return workercontrol.Config{
+		Facade: struct{ workercontrol.Facade }{},
+		Tag:    names.NewMachineTag("0"),
+		Logger: loggo.GetLogger("test"),
+	}
+}
+
+func (s *WorkerSuite) checkNotValid(c *gc.C, config workercontrol.Config, expect string) {
+	check := func(err error) {
+		c.Check(err, gc.ErrorMatches, expect)
+		c.Check(err, jc.Satisfies, errors.IsNotValid)
+	}
+	check(config.Validate())
+
+	w, err := workercontrol.New(config)
+	c.Check(w, gc.IsNil)
+	check(err)
+}
+
+func (s *WorkerSuite) TestValidateFacade(c *gc.C) {
+	config := validConfig()
+	config.Facade = nil
+	s.checkNotValid(c, config, "nil Facade not valid")
+}
+
+func (s *WorkerSuite) TestValidateTag(c *gc.C) {
+	config := validConfig()
+	config.Tag = nil
+	s.checkNotValid(c, config, "nil Tag not valid")
+}
+
+func (s *WorkerSuite) TestValidateLogger(c *gc.C) {
+	config := validConfig()
+	config.Logger = nil
+	s.checkNotValid(c, config, "nil Logger not valid")
+}
+
+func (s *WorkerSuite) TestDisabled(c *gc.C) {
+	facade := newMockFacade(&testing.Stub{},
+		[]string{"fan-configurer"},
+		[]string{"fan-configurer"},
+	)
+	w, err := workercontrol.New(workercontrol.Config{
+		Facade: facade,
+		Tag:    names.NewMachineTag("0"),
+		Logger: loggo.GetLogger("test"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	c.Check(w.Disabled("fan-configurer"), jc.IsTrue)
+	c.Check(w.Disabled("machiner"), jc.IsFalse)
+	workertest.CheckAlive(c, w)
+}
+
+func (s *WorkerSuite) TestUnchanged(c *gc.C) {
+	facade := newMockFacade(&testing.Stub{},
+		[]string{"fan-configurer"},
+		[]string{"fan-configurer"},
+	)
+	w, err := workercontrol.New(workercontrol.Config{
+		Facade: facade,
+		Tag:    names.NewMachineTag("0"),
+		Logger: loggo.GetLogger("test"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	workertest.CheckAlive(c, w)
+}
+
+func (s *WorkerSuite) TestChanged(c *gc.C) {
+	facade := newMockFacade(&testing.Stub{},
+		[]string{"fan-configurer"},
+		[]string{"fan-configurer", "instance-mutater"},
+	)
+	w, err := workercontrol.New(workercontrol.Config{
+		Facade: facade,
+		Tag:    names.NewMachineTag("0"),
+		Logger: loggo.GetLogger("test"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = workertest.CheckKilled(c, w)
+	c.Check(err, gc.Equals, workercontrol.ErrChanged)
+}
+
+func (s *WorkerSuite) TestNotSupported(c *gc.C) {
+	stub := &testing.Stub{}
+	stub.SetErrors(errors.NotSupportedf("disabled workers on server"))
+	facade := newMockFacade(stub)
+	w, err := workercontrol.New(workercontrol.Config{
+		Facade: facade,
+		Tag:    names.NewMachineTag("0"),
+		Logger: loggo.GetLogger("test"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	c.Check(w.Disabled("fan-configurer"), jc.IsFalse)
+	workertest.CheckAlive(c, w)
+	stub.CheckCallNames(c, "DisabledWorkers")
+}
+
+// newMockFacade returns a mock Facade that will add calls to the
+// supplied testing.Stub, and return errors in the sequences it
+// specifies; if any DisabledWorkers call does not return an error, it
+// will return a set consumed from the head of the supplied list (or
+// panic if it's empty).
+func newMockFacade(stub *testing.Stub, disabled ...[]string) *mockFacade {
+	return &mockFacade{
+		stub:     stub,
+		disabled: disabled,
+	}
+}
+
+// mockFacade implements workercontrol.Facade for use in the tests.
+type mockFacade struct {
+	stub     *testing.Stub
+	disabled [][]string
+}
+
+// DisabledWorkers is part of the workercontrol.Facade interface.
+func (mock *mockFacade) DisabledWorkers(tag names.Tag) ([]string, error) {
+	mock.stub.AddCall("DisabledWorkers", tag)
+	if err := mock.stub.NextErr(); err != nil {
+		return nil, err
+	}
+	disabled := mock.disabled[0]
+	mock.disabled = mock.disabled[1:]
+	return disabled, nil
+}
+
+// WatchDisabledWorkers is part of the workercontrol.Facade interface.
+func (mock *mockFacade) WatchDisabledWorkers(tag names.Tag) (watcher.NotifyWatcher, error) {
+	mock.stub.AddCall("WatchDisabledWorkers", tag)
+	if err := mock.stub.NextErr(); err != nil {
+		return nil, err
+	}
+	return newMockWatcher(), nil
+}
+
+// newMockWatcher returns a watcher.NotifyWatcher that sends a single
+// change and then sits quietly until killed.
+func newMockWatcher() *mockWatcher {
+	changes := make(chan struct{}, 1)
+	changes <- struct{}{}
+	return &mockWatcher{
+		Worker:  workertest.NewErrorWorker(nil),
+		changes: changes,
+	}
+}
+
+// mockWatcher implements watcher.NotifyWatcher for use in the tests.
+type mockWatcher struct {
+	worker.Worker
+	changes chan struct{}
+}
+
+// Changes is part of the watcher.NotifyWatcher interface.
+func (mock *mockWatcher) Changes() watcher.NotifyChannel {
+	return mock.changes
+}